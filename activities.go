@@ -66,10 +66,7 @@ func (s *Activities) GetServerActivities(ctx context.Context, opts ...operations
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -92,13 +89,7 @@ func (s *Activities) GetServerActivities(ctx context.Context, opts ...operations
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -197,7 +188,7 @@ func (s *Activities) GetServerActivities(ctx context.Context, opts ...operations
 			}
 
 			var out operations.GetServerActivitiesResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -258,13 +249,13 @@ func (s *Activities) GetServerActivities(ctx context.Context, opts ...operations
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -315,10 +306,7 @@ func (s *Activities) CancelServerActivities(ctx context.Context, activityUUID st
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "DELETE", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -341,13 +329,7 @@ func (s *Activities) CancelServerActivities(ctx context.Context, activityUUID st
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -487,13 +469,13 @@ func (s *Activities) CancelServerActivities(ctx context.Context, activityUUID st
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -0,0 +1,162 @@
+package plexgo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Activity is a server activity as sent in activity notifications, e.g. a library scan
+type Activity struct {
+	UUID        string
+	Type        string // e.g. "library.update.section" or "library.refresh.items"
+	Title       string
+	Subtitle    string
+	Progress    float64 // Percent complete
+	Cancellable bool
+	UserID      int
+	SectionID   int // Library section the activity works on, 0 if none
+}
+
+// activityNotification is an element of the ActivityNotification list of an activity notification
+type activityNotification struct {
+	Event    string `json:"event"` // "started", "updated" or "ended"
+	UUID     string `json:"uuid"`
+	Activity struct {
+		UUID        string  `json:"uuid"`
+		Type        string  `json:"type"`
+		Title       string  `json:"title"`
+		Subtitle    string  `json:"subtitle"`
+		Progress    float64 `json:"progress"`
+		Cancellable bool    `json:"cancellable"`
+		UserID      float64 `json:"userID"`
+		Context     struct {
+			LibrarySectionID string `json:"librarySectionID"`
+		} `json:"Context"`
+	} `json:"Activity"`
+}
+
+// toActivity converts the activity of a notification to an Activity
+func (n activityNotification) toActivity() Activity {
+	activity := Activity{
+		UUID:        n.Activity.UUID,
+		Type:        n.Activity.Type,
+		Title:       n.Activity.Title,
+		Subtitle:    n.Activity.Subtitle,
+		Progress:    n.Activity.Progress,
+		Cancellable: n.Activity.Cancellable,
+		UserID:      int(n.Activity.UserID),
+	}
+	if activity.UUID == "" {
+		activity.UUID = n.UUID
+	}
+	activity.SectionID, _ = strconv.Atoi(n.Activity.Context.LibrarySectionID)
+	return activity
+}
+
+// SectionActivity matches the activities of a type working on a library section, e.g.
+// SectionActivity(1, "library.update.section") for a scan of section 1, for use with
+// Activities.WaitFor. Any type matches if activityType is "".
+func SectionActivity(sectionID int, activityType string) func(Activity) bool {
+	return func(activity Activity) bool {
+		return activity.SectionID == sectionID && (activityType == "" || activity.Type == activityType)
+	}
+}
+
+// WaitFor waits for up to timeout for an activity matching predicate to complete, and returns it
+// as it was when it ended. The activities come from the server's event stream rather than polling,
+// and the stream is reopened if it ends before then. Only activities ending after WaitFor is called
+// are matched, so to wait for an activity started by another call, e.g. a scan triggered with
+// Library.GetRefreshLibraryMetadata, call WaitFor first in a goroutine. ErrActivityTimeout is
+// returned if no matching activity completes within the timeout, and an error is returned if the
+// first connection fails.
+func (s *Activities) WaitFor(ctx context.Context, predicate func(Activity) bool, timeout time.Duration, opts ...operations.Option) (*Activity, error) {
+	ctx, finish, err := s.sdkConfiguration.track(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stream, err := openNotificationStream(waitCtx, s.sdkConfiguration, "activity", "waitForActivity", opts...)
+	if err != nil {
+		if ctx.Err() == nil && waitCtx.Err() != nil {
+			return nil, fmt.Errorf("%w: no matching activity completed within %s", ErrActivityTimeout, timeout)
+		}
+		return nil, err
+	}
+
+	wait := logTailMinRetryInterval
+	for {
+		if stream != nil {
+			activity := readActivityStream(stream, predicate)
+			stream.Close()
+			if activity != nil {
+				return activity, nil
+			}
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("%w: no matching activity completed within %s", ErrActivityTimeout, timeout)
+		case <-time.After(wait):
+		}
+
+		stream, err = openNotificationStream(waitCtx, s.sdkConfiguration, "activity", "waitForActivity", opts...)
+		if err != nil {
+			stream = nil
+			if wait *= 2; wait > logTailMaxRetryInterval {
+				wait = logTailMaxRetryInterval
+			}
+		} else {
+			wait = logTailMinRetryInterval
+		}
+	}
+}
+
+// readActivityStream reads the activity notifications of an event stream until an activity
+// matching predicate ends, which it returns, or the stream ends, when it returns nil
+func readActivityStream(stream io.Reader, predicate func(Activity) bool) *Activity {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+
+		var notification struct {
+			NotificationContainer struct {
+				Type                 string                 `json:"type"`
+				ActivityNotification []activityNotification `json:"ActivityNotification"`
+			} `json:"NotificationContainer"`
+		}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &notification); err != nil || notification.NotificationContainer.Type != "activity" {
+			continue
+		}
+
+		for _, n := range notification.NotificationContainer.ActivityNotification {
+			if n.Event != "ended" {
+				continue
+			}
+			if activity := n.toActivity(); predicate(activity) {
+				return &activity
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestActivitiesWaitFor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/:/eventsource/notifications" || r.URL.Query().Get("filters") != "activity" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, `data: {"NotificationContainer":{"type":"activity","size":1,"ActivityNotification":[{"event":"started","uuid":"a","Activity":{"uuid":"a","type":"library.update.section","progress":0,"Context":{"librarySectionID":"1"}}}]}}`+"\n\n")
+		fmt.Fprint(w, `data: {"NotificationContainer":{"type":"activity","size":1,"ActivityNotification":[{"event":"ended","uuid":"b","Activity":{"uuid":"b","type":"library.update.section","progress":100,"Context":{"librarySectionID":"2"}}}]}}`+"\n\n")
+		fmt.Fprint(w, `data: {"NotificationContainer":{"type":"activity","size":1,"ActivityNotification":[{"event":"ended","uuid":"a","Activity":{"uuid":"a","type":"library.update.section","title":"Scanning Movies","progress":100,"userID":1,"Context":{"librarySectionID":"1"}}}]}}`+"\n\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	activity, err := client.Activities.WaitFor(ctx, SectionActivity(1, "library.update.section"), time.Second)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if activity.UUID != "a" || activity.Title != "Scanning Movies" || activity.SectionID != 1 || activity.UserID != 1 {
+		t.Errorf("Unexpected activity: %+v", activity)
+	}
+
+	_, err = client.Activities.WaitFor(ctx, SectionActivity(3, ""), 200*time.Millisecond)
+	if !errors.Is(err, ErrActivityTimeout) {
+		t.Errorf("Expected ErrActivityTimeout, got: %v", err)
+	}
+}
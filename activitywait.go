@@ -0,0 +1,103 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// activityHeader names the server activity started by a write, e.g. a collection being rebuilt
+const activityHeader = "X-Plex-Activity"
+
+// activityPollInterval is how often server activities are polled while waiting for one to complete
+var activityPollInterval = 500 * time.Millisecond
+
+// writeSettleDelay is the fixed delay writes wait for the server to process a change when
+// WithWaitForActivity is not set
+var writeSettleDelay = 2 * time.Second
+
+// ErrActivityTimeout is returned by writes made with WithWaitForActivity when the server activity
+// they started doesn't complete within the timeout. The write itself has succeeded.
+var ErrActivityTimeout = errors.New("server activity did not complete")
+
+// WithWaitForActivity makes a collection write wait for the server activity named in the response's
+// X-Plex-Activity header to complete, for up to timeout, instead of waiting a fixed delay. Writes
+// that don't start an activity return immediately.
+func WithWaitForActivity(timeout time.Duration) operations.Option {
+	return helperOption(func(opts *helperOptions) {
+		opts.WaitForActivity = &timeout
+	})
+}
+
+// settleWrite waits for the server to process a write whose response named activityID (which may
+// be empty) in its X-Plex-Activity header
+func settleWrite(ctx context.Context, sdkConfig sdkConfiguration, activityID string, opts []operations.Option) error {
+	options := processOptions(opts)
+	if options.WaitForActivity == nil {
+		// The write has succeeded, so a canceled context only cuts the wait short
+		select {
+		case <-ctx.Done():
+		case <-time.After(writeSettleDelay):
+		}
+		return nil
+	}
+
+	if activityID == "" {
+		return nil
+	}
+
+	return waitForActivity(ctx, sdkConfig, activityID, *options.WaitForActivity, options)
+}
+
+// waitForActivity polls the server's activities until the activity is no longer listed
+func waitForActivity(ctx context.Context, sdkConfig sdkConfiguration, activityID string, timeout time.Duration, options *helperOptions) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var activityOpts []operations.Option
+	if options.ServerURL != nil {
+		activityOpts = append(activityOpts, operations.WithServerURL(*options.ServerURL))
+	}
+
+	activities := newActivities(sdkConfig)
+	for {
+		res, err := activities.GetServerActivities(waitCtx, activityOpts...)
+		if err != nil {
+			if ctx.Err() == nil && waitCtx.Err() != nil {
+				return fmt.Errorf("%w: activity %s is still running after %s", ErrActivityTimeout, activityID, timeout)
+			}
+			return fmt.Errorf("error getting server activities: %w", err)
+		}
+
+		if !activityRunning(res, activityID) {
+			return nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("%w: activity %s is still running after %s", ErrActivityTimeout, activityID, timeout)
+		case <-time.After(activityPollInterval):
+		}
+	}
+}
+
+// activityRunning returns true if the activity is listed in the server's activities
+func activityRunning(res *operations.GetServerActivitiesResponse, activityID string) bool {
+	if res.Object == nil || res.Object.MediaContainer == nil {
+		return false
+	}
+
+	for _, activity := range res.Object.MediaContainer.Activity {
+		if activity.UUID != nil && *activity.UUID == activityID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithWaitForActivity(t *testing.T) {
+	defer func(interval time.Duration) { activityPollInterval = interval }(activityPollInterval)
+	activityPollInterval = time.Millisecond
+
+	polls := 0
+	runningPolls := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "DELETE" && r.URL.Path == "/library/collections/5":
+			w.Header().Set("X-Plex-Activity", "f1c2d3")
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "DELETE" && r.URL.Path == "/library/collections/6":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "GET" && r.URL.Path == "/activities":
+			polls++
+			w.Header().Set("Content-Type", "application/json")
+			if runningPolls < 0 || polls <= runningPolls {
+				w.Write([]byte(`{"MediaContainer":{"size":1,"Activity":[{"uuid":"f1c2d3","type":"library.update.section","title":"Updating collection"}]}}`))
+			} else {
+				w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+			}
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	start := time.Now()
+	if err := client.Collections.DeleteCollection(context.Background(), 5, WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if polls != runningPolls+1 {
+		t.Errorf("Expected %d activity polls, got: %d", runningPolls+1, polls)
+	}
+	if elapsed := time.Since(start); elapsed >= writeSettleDelay {
+		t.Errorf("Expected no fixed delay, took %s", elapsed)
+	}
+
+	// Writes that don't start an activity return without polling
+	polls = 0
+	if err := client.Collections.DeleteCollection(context.Background(), 6, WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if polls != 0 {
+		t.Errorf("Expected no activity polls, got: %d", polls)
+	}
+
+	// The activity never completes
+	runningPolls = -1
+	err := client.Collections.DeleteCollection(context.Background(), 5, WithWaitForActivity(20*time.Millisecond))
+	if !errors.Is(err, ErrActivityTimeout) {
+		t.Errorf("Expected ErrActivityTimeout, got: %v", err)
+	}
+}
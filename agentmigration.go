@@ -0,0 +1,239 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// Metadata agents of library sections
+const (
+	AgentPlexMovie   = "tv.plex.agents.movie"
+	AgentPlexSeries  = "tv.plex.agents.series"
+	AgentPlexMusic   = "tv.plex.agents.music"
+	AgentLegacyIMDb  = "com.plexapp.agents.imdb"
+	AgentLegacyTMDB  = "com.plexapp.agents.themoviedb"
+	AgentLegacyTVDB  = "com.plexapp.agents.thetvdb"
+	AgentLegacyMusic = "com.plexapp.agents.lastfm"
+	AgentNone        = "com.plexapp.agents.none"
+)
+
+// agentRefreshPollInterval is how often MigrateAgent checks whether the section is still refreshing,
+// and agentRefreshStartTimeout how long it waits for the refresh to start before assuming it finished
+var (
+	agentRefreshPollInterval = 5 * time.Second
+	agentRefreshStartTimeout = 30 * time.Second
+)
+
+// librarySection is a library section as listed by /library/sections
+type librarySection struct {
+	Key        string `json:"key"`
+	Type       string `json:"type"`
+	Title      string `json:"title"`
+	Agent      string `json:"agent"`
+	Language   string `json:"language"`
+	Refreshing bool   `json:"refreshing"`
+	ScannedAt  int64  `json:"scannedAt"`
+}
+
+// AgentMigration is the result of switching the metadata agent of a library section
+type AgentMigration struct {
+	SectionID     int
+	PreviousAgent string
+	Agent         string
+	Items         int        // Number of items checked after the refresh
+	Unmapped      []Metadata // Items whose GUIDs were not mapped to the new agent, to fix with a manual match
+}
+
+// MigrateAgent switches the metadata agent of a library section, e.g. from a legacy agent to the
+// new Plex agents (AgentPlexMovie, AgentPlexSeries or AgentPlexMusic), refreshes its metadata and
+// reports the items whose GUIDs failed to map to the new agent. The refresh of a large section
+// can take a long time; it is waited for until ctx is done, after which the error is returned with
+// the migration so far. Items are mapped when their GUID uses the new agent's scheme, plex:// for
+// the Plex agents.
+func (s *Library) MigrateAgent(ctx context.Context, sectionID int, targetAgent string, opts ...operations.Option) (*AgentMigration, error) {
+	section, err := s.getSection(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	migration := &AgentMigration{
+		SectionID:     sectionID,
+		PreviousAgent: section.Agent,
+		Agent:         targetAgent,
+		Unmapped:      []Metadata{},
+	}
+
+	if section.Agent != targetAgent {
+		if err := s.setSectionAgent(ctx, sectionID, targetAgent, section.Language, opts...); err != nil {
+			return migration, fmt.Errorf("error switching agent: %w", err)
+		}
+	}
+
+	if _, err := s.GetRefreshLibraryMetadata(ctx, sectionID, operations.ForceOne.ToPointer(), opts...); err != nil {
+		return migration, fmt.Errorf("error refreshing section: %w", err)
+	}
+
+	if err := s.waitForSectionRefresh(ctx, sectionID, opts...); err != nil {
+		return migration, err
+	}
+
+	items, err := s.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), nil, "getLibraryItems", opts...)
+	if err != nil {
+		return migration, fmt.Errorf("error getting items: %w", err)
+	}
+
+	migration.Items = len(items)
+	for _, item := range items {
+		if !agentMapped(item.GUID, targetAgent) {
+			migration.Unmapped = append(migration.Unmapped, item)
+		}
+	}
+
+	return migration, nil
+}
+
+// agentMapped returns true if a GUID uses the scheme of an agent
+func agentMapped(guid string, agent string) bool {
+	if strings.HasPrefix(agent, "tv.plex.agents.") {
+		return strings.HasPrefix(guid, "plex://")
+	}
+	return strings.HasPrefix(guid, agent+"://")
+}
+
+// getSection gets a library section from the list of sections
+func (s *Library) getSection(ctx context.Context, sectionID int, opts ...operations.Option) (*librarySection, error) {
+	var out struct {
+		MediaContainer struct {
+			Directory []librarySection `json:"Directory"`
+		} `json:"MediaContainer"`
+	}
+	if err := s.getJSON(ctx, "/library/sections", nil, "getAllLibraries", &out, opts...); err != nil {
+		return nil, fmt.Errorf("error getting library sections: %w", err)
+	}
+
+	for _, section := range out.MediaContainer.Directory {
+		if section.Key == strconv.Itoa(sectionID) {
+			return &section, nil
+		}
+	}
+
+	return nil, fmt.Errorf("library section %d not found", sectionID)
+}
+
+// waitForSectionRefresh waits until a section that was asked to refresh has finished refreshing
+func (s *Library) waitForSectionRefresh(ctx context.Context, sectionID int, opts ...operations.Option) error {
+	started := false
+	deadline := time.Now().Add(agentRefreshStartTimeout)
+
+	for {
+		section, err := s.getSection(ctx, sectionID, opts...)
+		if err != nil {
+			return fmt.Errorf("error checking refresh: %w", err)
+		}
+
+		if section.Refreshing {
+			started = true
+		} else if started || time.Now().After(deadline) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("error waiting for the refresh of section %d: %w", sectionID, ctx.Err())
+		case <-time.After(agentRefreshPollInterval):
+		}
+	}
+}
+
+// setSectionAgent sets the metadata agent of a library section
+func (s *Library) setSectionAgent(ctx context.Context, sectionID int, agent string, language string, opts ...operations.Option) error {
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/sections/%d", sectionID))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("agent", agent)
+	if language != "" {
+		queryParams.Add("language", language)
+	}
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "setSectionAgent",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		_, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
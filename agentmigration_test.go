@@ -0,0 +1,69 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMigrateAgent(t *testing.T) {
+	defer func(interval time.Duration) { agentRefreshPollInterval = interval }(agentRefreshPollInterval)
+	agentRefreshPollInterval = time.Millisecond
+
+	agent := AgentLegacyIMDb
+	refreshPolls := 0
+	refreshed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections":
+			// The refresh shows up on the second poll and runs for two polls
+			refreshing := false
+			if refreshed {
+				refreshPolls++
+				refreshing = refreshPolls >= 2 && refreshPolls <= 3
+			}
+			fmt.Fprintf(w, `{"MediaContainer":{"size":1,"Directory":[{"key":"1","type":"movie","title":"Movies","agent":%q,"language":"en-US","refreshing":%t}]}}`, agent, refreshing)
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1":
+			if r.URL.Query().Get("agent") != AgentPlexMovie || r.URL.Query().Get("language") != "en-US" {
+				t.Errorf("Unexpected section update: %s", r.URL.RawQuery)
+			}
+			agent = r.URL.Query().Get("agent")
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/refresh":
+			if r.URL.Query().Get("force") != "1" {
+				t.Errorf("Expected a forced refresh, got: %s", r.URL.RawQuery)
+			}
+			refreshed = true
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			if refreshPolls < 4 {
+				t.Errorf("Expected the items to be checked after the refresh finished, got %d polls", refreshPolls)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"ratingKey":"1","title":"The Matrix","guid":"plex://movie/5d7768"},
+				{"ratingKey":"2","title":"Home Video","guid":"local://2"},
+				{"ratingKey":"3","title":"Obscure","guid":"com.plexapp.agents.imdb://tt0000001?lang=en"}
+			]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	migration, err := client.Library.MigrateAgent(context.Background(), 1, AgentPlexMovie)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if migration.PreviousAgent != AgentLegacyIMDb || migration.Agent != AgentPlexMovie || migration.Items != 3 {
+		t.Errorf("Unexpected migration: %+v", migration)
+	}
+	if len(migration.Unmapped) != 2 || migration.Unmapped[0].RatingKey != "2" || migration.Unmapped[1].RatingKey != "3" {
+		t.Errorf("Expected the local and legacy items to be unmapped, got: %+v", migration.Unmapped)
+	}
+}
@@ -0,0 +1,300 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Kinds of artwork found in an asset directory
+const (
+	AssetPoster     = "poster"
+	AssetBackground = "background"
+)
+
+// assetImageExtensions are the image files read from an asset directory
+var assetImageExtensions = map[string]bool{".jpg": true, ".jpeg": true, ".png": true, ".webp": true}
+
+// assetGUIDPattern matches external IDs in asset names, e.g. {tmdb-603} or [imdb-tt0133093]
+var assetGUIDPattern = regexp.MustCompile(`[\[{](tmdb|tvdb|imdb)-([A-Za-z0-9]+)[\]}]`)
+
+// assetYearPattern matches a trailing release year in asset names, e.g. "Heat (1995)"
+var assetYearPattern = regexp.MustCompile(`\s*\((\d{4})\)\s*$`)
+
+// AssetOptions configures ApplyAssets
+type AssetOptions struct {
+	DryRun bool // Report the matches without uploading any artwork
+}
+
+// AssetMatch is an image from an asset directory matched to an item or collection
+type AssetMatch struct {
+	Path       string // Path of the image in the asset directory
+	Kind       string // AssetPoster or AssetBackground
+	RatingKey  string
+	Title      string
+	Collection bool   // The image was matched to a collection rather than an item
+	MatchedBy  string // "guid", "title and year" or "title"
+}
+
+// AssetReport is the result of ApplyAssets
+type AssetReport struct {
+	Matched   []AssetMatch // Sorted by path
+	Unmatched []string     // Images that matched no item or collection
+	Ambiguous []string     // Images whose title matched several items; add the year or an ID to the name
+	Applied   int          // Number of images uploaded, 0 for a dry run
+}
+
+// assetImage is an image found in an asset directory
+type assetImage struct {
+	path string
+	kind string
+	name string // Folder or file name identifying the item, e.g. "The Matrix (1999) {tmdb-603}"
+}
+
+// ApplyAssets uploads the artwork of an asset directory to the items and collections of a library
+// section, the classic "assets directory" workflow. Each item has either a folder holding
+// poster.jpg and background.jpg, or flat files named after it, e.g. "Heat (1995).jpg" and
+// "Heat (1995)_background.jpg"; folders may be nested. PNG and WebP images work as well.
+//
+// Names are matched by an external ID in braces or brackets ({tmdb-603}, {imdb-tt0133093} or
+// {tvdb-81189}), then by title and year, then by title alone if only one item has it. Collections
+// are matched by title. With DryRun set the matches are only reported. If an upload fails, the
+// report of the images applied before it is returned with the error.
+func (s *Library) ApplyAssets(ctx context.Context, sectionID int, assets fs.FS, assetOptions AssetOptions, opts ...operations.Option) (*AssetReport, error) {
+	images, err := findAssetImages(assets)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("includeGuids", "1")
+	items, err := s.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), queryParams, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting library items: %w", err)
+	}
+
+	collections, err := newCollections(s.sdkConfiguration).GetAllCollections(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collections: %w", err)
+	}
+
+	index := newAssetIndex(items, collections)
+	report := &AssetReport{Matched: []AssetMatch{}, Unmatched: []string{}, Ambiguous: []string{}}
+	for _, image := range images {
+		match, ambiguous := index.match(image)
+		switch {
+		case ambiguous:
+			report.Ambiguous = append(report.Ambiguous, image.path)
+		case match == nil:
+			report.Unmatched = append(report.Unmatched, image.path)
+		default:
+			report.Matched = append(report.Matched, *match)
+		}
+	}
+
+	if assetOptions.DryRun {
+		return report, nil
+	}
+
+	options := processOptions(opts)
+
+	reportProgress(options, 0, len(report.Matched), "applying assets")
+	for i, match := range report.Matched {
+		if err := s.uploadAsset(ctx, assets, match, opts...); err != nil {
+			return report, fmt.Errorf("error applying %s to %s: %w", match.Path, match.Title, err)
+		}
+		report.Applied++
+		reportProgress(options, i+1, len(report.Matched), "applying assets")
+	}
+
+	return report, nil
+}
+
+// uploadAsset uploads a matched image as the poster or background of its item
+func (s *Library) uploadAsset(ctx context.Context, assets fs.FS, match AssetMatch, opts ...operations.Option) error {
+	data, err := fs.ReadFile(assets, match.Path)
+	if err != nil {
+		return err
+	}
+
+	ratingKey, err := strconv.ParseInt(match.RatingKey, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error converting rating key to int: %w", err)
+	}
+
+	var body any = data
+	if match.Kind == AssetBackground {
+		_, err = s.PostMediaArts(ctx, ratingKey, nil, &body, opts...)
+	} else {
+		_, err = s.PostMediaPoster(ctx, ratingKey, nil, &body, opts...)
+	}
+	return err
+}
+
+// findAssetImages walks an asset directory for posters and backgrounds, sorted by path
+func findAssetImages(assets fs.FS) ([]assetImage, error) {
+	var images []assetImage
+	err := fs.WalkDir(assets, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := strings.ToLower(path.Ext(p))
+		if d.IsDir() || !assetImageExtensions[ext] {
+			return nil
+		}
+
+		base := strings.TrimSuffix(path.Base(p), path.Ext(p))
+		folder := path.Base(path.Dir(p))
+		switch strings.ToLower(base) {
+		case "poster":
+			if folder != "." {
+				images = append(images, assetImage{path: p, kind: AssetPoster, name: folder})
+			}
+		case "background":
+			if folder != "." {
+				images = append(images, assetImage{path: p, kind: AssetBackground, name: folder})
+			}
+		default:
+			if name, ok := cutSuffixFold(base, "_background"); ok {
+				images = append(images, assetImage{path: p, kind: AssetBackground, name: name})
+			} else {
+				images = append(images, assetImage{path: p, kind: AssetPoster, name: base})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading asset directory: %w", err)
+	}
+
+	sort.Slice(images, func(i, j int) bool { return images[i].path < images[j].path })
+	return images, nil
+}
+
+// cutSuffixFold removes a suffix, ignoring case
+func cutSuffixFold(s, suffix string) (string, bool) {
+	if len(s) > len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix) {
+		return s[:len(s)-len(suffix)], true
+	}
+	return s, false
+}
+
+// assetTarget is an item or collection artwork can be applied to
+type assetTarget struct {
+	ratingKey  string
+	title      string
+	collection bool
+}
+
+// assetIndex looks up items and collections by the identifiers used in asset names
+type assetIndex struct {
+	guids       map[string]assetTarget
+	titleYears  map[string][]assetTarget
+	titles      map[string][]assetTarget
+	collections map[string]assetTarget
+}
+
+func newAssetIndex(items []Metadata, collections []Collection) *assetIndex {
+	index := &assetIndex{
+		guids:       map[string]assetTarget{},
+		titleYears:  map[string][]assetTarget{},
+		titles:      map[string][]assetTarget{},
+		collections: map[string]assetTarget{},
+	}
+
+	for _, item := range items {
+		target := assetTarget{ratingKey: item.RatingKey, title: item.Title}
+		for _, source := range []string{"tmdb", "tvdb", "imdb"} {
+			if id := item.ExternalID(source); id != "" {
+				index.guids[source+"-"+strings.ToLower(id)] = target
+			}
+		}
+		title := normalizeAssetName(item.Title)
+		if item.Year > 0 {
+			key := fmt.Sprintf("%s|%d", title, item.Year)
+			index.titleYears[key] = append(index.titleYears[key], target)
+		}
+		index.titles[title] = append(index.titles[title], target)
+	}
+
+	for _, collection := range collections {
+		index.collections[normalizeAssetName(collection.Title)] = assetTarget{
+			ratingKey:  collection.RatingKey,
+			title:      collection.Title,
+			collection: true,
+		}
+	}
+
+	return index
+}
+
+// match finds the item or collection an image belongs to. ambiguous is true if its title matched
+// several items.
+func (x *assetIndex) match(image assetImage) (match *AssetMatch, ambiguous bool) {
+	found := func(target assetTarget, matchedBy string) *AssetMatch {
+		return &AssetMatch{
+			Path:       image.path,
+			Kind:       image.kind,
+			RatingKey:  target.ratingKey,
+			Title:      target.title,
+			Collection: target.collection,
+			MatchedBy:  matchedBy,
+		}
+	}
+
+	name := image.name
+	for _, m := range assetGUIDPattern.FindAllStringSubmatch(name, -1) {
+		if target, ok := x.guids[m[1]+"-"+strings.ToLower(m[2])]; ok {
+			return found(target, "guid"), false
+		}
+	}
+	name = strings.TrimSpace(assetGUIDPattern.ReplaceAllString(name, ""))
+
+	year := ""
+	if m := assetYearPattern.FindStringSubmatch(name); m != nil {
+		year = m[1]
+		name = assetYearPattern.ReplaceAllString(name, "")
+	}
+	title := normalizeAssetName(name)
+
+	if year != "" {
+		if targets := x.titleYears[title+"|"+year]; len(targets) == 1 {
+			return found(targets[0], "title and year"), false
+		} else if len(targets) > 1 {
+			return nil, true
+		}
+	}
+
+	if target, ok := x.collections[title]; ok && year == "" {
+		return found(target, "title"), false
+	}
+
+	switch targets := x.titles[title]; {
+	case len(targets) == 1:
+		return found(targets[0], "title"), false
+	case len(targets) > 1:
+		return nil, true
+	}
+	return nil, false
+}
+
+// normalizeAssetName reduces a title to lowercase letters and digits, since file names can't
+// hold characters such as ':' or '/'
+func normalizeAssetName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,104 @@
+package plexgo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"testing/fstest"
+)
+
+func TestApplyAssets(t *testing.T) {
+	uploads := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("includeGuids") != "1" {
+				t.Errorf("Expected GUIDs to be included: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"1","type":"movie","title":"The Matrix","year":1999,"Guid":[{"id":"tmdb://603"},{"id":"imdb://tt0133093"}]},
+				{"ratingKey":"2","type":"movie","title":"Heat","year":1995},
+				{"ratingKey":"3","type":"movie","title":"Dune","year":1984},
+				{"ratingKey":"4","type":"movie","title":"Dune","year":2021},
+				{"ratingKey":"5","type":"movie","title":"Mission: Impossible","year":1996}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"100","title":"Heist Movies","subtype":"movie"}]}}`))
+		case r.Method == "POST":
+			body, _ := io.ReadAll(r.Body)
+			uploads[r.URL.Path] = string(body)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	assets := fstest.MapFS{
+		"movies/Matrix {tmdb-603}/poster.jpg":     {Data: []byte("matrix poster")},
+		"movies/Matrix {tmdb-603}/background.png": {Data: []byte("matrix background")},
+		"movies/Heat (1995).jpg":                  {Data: []byte("heat poster")},
+		"movies/Heat (1995)_background.jpg":       {Data: []byte("heat background")},
+		"movies/Dune (2021)/poster.jpg":           {Data: []byte("dune poster")},
+		"movies/Dune/background.jpg":              {Data: []byte("dune background")},
+		"movies/Mission Impossible.webp":          {Data: []byte("mi poster")},
+		"movies/Unknown (2001).jpg":               {Data: []byte("unknown")},
+		"movies/notes.txt":                        {Data: []byte("not an image")},
+		"collections/Heist Movies/poster.jpg":     {Data: []byte("heist poster")},
+	}
+
+	client := New(WithServerURL(server.URL))
+
+	report, err := client.Library.ApplyAssets(context.Background(), 1, assets, AssetOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(uploads) != 0 || report.Applied != 0 {
+		t.Errorf("Expected a dry run not to upload, got: %v", uploads)
+	}
+
+	matched := map[string]string{}
+	for _, match := range report.Matched {
+		matched[match.Path] = match.Kind + " " + match.RatingKey + " " + match.MatchedBy
+	}
+	want := map[string]string{
+		"collections/Heist Movies/poster.jpg":     "poster 100 title",
+		"movies/Dune (2021)/poster.jpg":           "poster 4 title and year",
+		"movies/Heat (1995).jpg":                  "poster 2 title and year",
+		"movies/Heat (1995)_background.jpg":       "background 2 title and year",
+		"movies/Matrix {tmdb-603}/background.png": "background 1 guid",
+		"movies/Matrix {tmdb-603}/poster.jpg":     "poster 1 guid",
+		"movies/Mission Impossible.webp":          "poster 5 title",
+	}
+	if len(matched) != len(want) {
+		t.Errorf("Expected %d matches, got: %v", len(want), matched)
+	}
+	for path, expected := range want {
+		if matched[path] != expected {
+			t.Errorf("Expected %s to match %q, got: %q", path, expected, matched[path])
+		}
+	}
+	if !sort.SliceIsSorted(report.Matched, func(i, j int) bool { return report.Matched[i].Path < report.Matched[j].Path }) {
+		t.Errorf("Expected matches sorted by path")
+	}
+	if len(report.Unmatched) != 1 || report.Unmatched[0] != "movies/Unknown (2001).jpg" {
+		t.Errorf("Unexpected unmatched images: %v", report.Unmatched)
+	}
+	if len(report.Ambiguous) != 1 || report.Ambiguous[0] != "movies/Dune/background.jpg" {
+		t.Errorf("Unexpected ambiguous images: %v", report.Ambiguous)
+	}
+
+	report, err = client.Library.ApplyAssets(context.Background(), 1, assets, AssetOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if report.Applied != len(want) {
+		t.Errorf("Expected %d images applied, got: %d", len(want), report.Applied)
+	}
+	if uploads["/library/metadata/1/posters"] != "matrix poster" || uploads["/library/metadata/1/arts"] != "matrix background" ||
+		uploads["/library/metadata/100/posters"] != "heist poster" {
+		t.Errorf("Unexpected uploads: %v", uploads)
+	}
+}
@@ -0,0 +1,175 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// legacyAgentSources maps the sources of legacy agent GUIDs (e.g. "com.plexapp.agents.imdb://tt0133093?lang=en")
+// to their external GUID source
+var legacyAgentSources = map[string]string{
+	"imdb":       "imdb",
+	"themoviedb": "tmdb",
+	"thetvdb":    "tvdb",
+	"hama":       "anidb",
+}
+
+// CollectionAudit compares a collection against an external list of GUIDs, e.g. a Trakt or
+// Letterboxd list
+type CollectionAudit struct {
+	CollectionID          int
+	MissingFromPlex       []string   // Expected GUIDs that match no item in the library section
+	MissingFromCollection []Metadata // Items in the section matching an expected GUID, but not in the collection
+	Extra                 []Metadata // Items in the collection that match no expected GUID
+	Unmatched             []string   // Expected entries that are not GUIDs, e.g. "tmdb://603" or "plex://movie/5d7768..."
+}
+
+// InSync returns true if the collection holds exactly the expected items
+func (a *CollectionAudit) InSync() bool {
+	return len(a.MissingFromPlex) == 0 && len(a.MissingFromCollection) == 0 && len(a.Extra) == 0 && len(a.Unmatched) == 0
+}
+
+// Audit compares the items of a collection against the GUIDs an external source of truth says it
+// should contain. Expected GUIDs can be plex:// GUIDs or external ones such as "imdb://tt0133093",
+// "tmdb://603" or "tvdb://81189"; items are matched on any of their GUIDs, including the GUIDs of
+// legacy agents. The report is read-only: use AddToCollection and RemoveFromCollection to act on it.
+func (s *Collections) Audit(ctx context.Context, collectionID int, expectedGUIDs []string, opts ...operations.Option) (*CollectionAudit, error) {
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection: %w", err)
+	}
+
+	library := newLibrary(s.sdkConfiguration)
+
+	queryParams := url.Values{}
+	queryParams.Add("includeGuids", "1")
+
+	members, err := library.listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), queryParams, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection items: %w", err)
+	}
+
+	sectionParams := url.Values{}
+	sectionParams.Add("includeGuids", "1")
+	if itemType := collectionItemTypeFromName(collection.SubType); itemType != 0 {
+		sectionParams.Add("type", strconv.Itoa(int(itemType)))
+	}
+
+	sectionItems, err := library.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", collection.SectionID), sectionParams, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting library items: %w", err)
+	}
+
+	audit := &CollectionAudit{
+		CollectionID:          collectionID,
+		MissingFromPlex:       []string{},
+		MissingFromCollection: []Metadata{},
+		Extra:                 []Metadata{},
+		Unmatched:             []string{},
+	}
+
+	expected := make(map[string]bool, len(expectedGUIDs))
+	for _, guid := range expectedGUIDs {
+		normalized := normalizeGUID(guid)
+		if normalized == "" {
+			audit.Unmatched = append(audit.Unmatched, guid)
+			continue
+		}
+		expected[normalized] = true
+	}
+
+	inCollection := make(map[string]bool, len(members))
+	for _, item := range members {
+		inCollection[item.RatingKey] = true
+		if !metadataMatchesGUIDs(item, expected) {
+			audit.Extra = append(audit.Extra, item)
+		}
+	}
+
+	found := make(map[string]bool, len(expected))
+	for _, item := range sectionItems {
+		matched := false
+		for _, guid := range metadataGUIDs(item) {
+			if expected[guid] {
+				found[guid] = true
+				matched = true
+			}
+		}
+
+		if matched && !inCollection[item.RatingKey] {
+			audit.MissingFromCollection = append(audit.MissingFromCollection, item)
+		}
+	}
+
+	// Collection members count as found even if the section listing didn't return them
+	for _, item := range members {
+		for _, guid := range metadataGUIDs(item) {
+			found[guid] = found[guid] || expected[guid]
+		}
+	}
+
+	seen := make(map[string]bool, len(expectedGUIDs))
+	for _, guid := range expectedGUIDs {
+		normalized := normalizeGUID(guid)
+		if normalized == "" || found[normalized] || seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		audit.MissingFromPlex = append(audit.MissingFromPlex, guid)
+	}
+
+	return audit, nil
+}
+
+// metadataGUIDs returns the normalized GUIDs of an item
+func metadataGUIDs(item Metadata) []string {
+	guids := make([]string, 0, len(item.ExternalGUIDs)+1)
+	if guid := normalizeGUID(item.GUID); guid != "" {
+		guids = append(guids, guid)
+	}
+	for _, external := range item.ExternalGUIDs {
+		if guid := normalizeGUID(external.ID); guid != "" {
+			guids = append(guids, guid)
+		}
+	}
+	return guids
+}
+
+// metadataMatchesGUIDs returns true if any GUID of the item is in guids
+func metadataMatchesGUIDs(item Metadata, guids map[string]bool) bool {
+	for _, guid := range metadataGUIDs(item) {
+		if guids[guid] {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeGUID returns a GUID in the "source://id" form of new agents, lower-casing the source and
+// converting legacy agent GUIDs, or "" if it is not a GUID
+func normalizeGUID(guid string) string {
+	source, id, ok := strings.Cut(strings.TrimSpace(guid), "://")
+	if !ok || source == "" || id == "" {
+		return ""
+	}
+
+	source = strings.ToLower(source)
+	if strings.HasPrefix(source, "com.plexapp.agents.") {
+		agent := strings.TrimPrefix(source, "com.plexapp.agents.")
+		if mapped, ok := legacyAgentSources[agent]; ok {
+			source = mapped
+		} else {
+			source = agent
+		}
+
+		// Legacy GUIDs carry the agent language, e.g. "?lang=en"
+		id, _, _ = strings.Cut(id, "?")
+	}
+
+	return source + "://" + id
+}
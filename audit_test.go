@@ -0,0 +1,76 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestAuditCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/5":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"5","title":"Cyberpunk","type":"collection","subtype":"movie","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/5/children":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"101","type":"movie","title":"The Matrix","guid":"plex://movie/5d7768","Guid":[{"id":"imdb://tt0133093"},{"id":"tmdb://603"}]},
+				{"ratingKey":"102","type":"movie","title":"Hackers","guid":"com.plexapp.agents.imdb://tt0113243?lang=en"}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("type") != "1" || r.URL.Query().Get("includeGuids") != "1" {
+				t.Errorf("Expected type=1 and includeGuids=1, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"101","type":"movie","title":"The Matrix","guid":"plex://movie/5d7768","Guid":[{"id":"imdb://tt0133093"},{"id":"tmdb://603"}]},
+				{"ratingKey":"102","type":"movie","title":"Hackers","guid":"com.plexapp.agents.imdb://tt0113243?lang=en"},
+				{"ratingKey":"103","type":"movie","title":"Blade Runner","guid":"com.plexapp.agents.themoviedb://78?lang=en"}
+			]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	audit, err := client.Collections.Audit(context.Background(), 5, []string{"TMDB://603", "tmdb://78", "imdb://tt0137523", "Fight Club"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(audit.MissingFromPlex, []string{"imdb://tt0137523"}) {
+		t.Errorf("Expected imdb://tt0137523 to be missing from Plex, got: %v", audit.MissingFromPlex)
+	}
+	if len(audit.MissingFromCollection) != 1 || audit.MissingFromCollection[0].RatingKey != "103" {
+		t.Errorf("Expected Blade Runner to be missing from the collection, got: %+v", audit.MissingFromCollection)
+	}
+	if len(audit.Extra) != 1 || audit.Extra[0].RatingKey != "102" {
+		t.Errorf("Expected Hackers to be extra, got: %+v", audit.Extra)
+	}
+	if !reflect.DeepEqual(audit.Unmatched, []string{"Fight Club"}) {
+		t.Errorf("Expected Fight Club to be unmatched, got: %v", audit.Unmatched)
+	}
+	if audit.InSync() {
+		t.Error("Expected the collection not to be in sync")
+	}
+}
+
+func TestNormalizeGUID(t *testing.T) {
+	tests := map[string]string{
+		"plex://movie/5d7768":                            "plex://movie/5d7768",
+		"IMDB://tt0133093":                               "imdb://tt0133093",
+		"com.plexapp.agents.themoviedb://603?lang=en":    "tmdb://603",
+		"com.plexapp.agents.thetvdb://81189/1/1?lang=en": "tvdb://81189/1/1",
+		"tt0133093": "",
+	}
+
+	for guid, expected := range tests {
+		if normalized := normalizeGUID(guid); normalized != expected {
+			t.Errorf("Expected %q for %q, got: %q", expected, guid, normalized)
+		}
+	}
+}
@@ -65,10 +65,7 @@ func (s *Authentication) GetTransientToken(ctx context.Context, type_ operations
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -95,13 +92,7 @@ func (s *Authentication) GetTransientToken(ctx context.Context, type_ operations
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -241,13 +232,13 @@ func (s *Authentication) GetTransientToken(ctx context.Context, type_ operations
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -299,10 +290,7 @@ func (s *Authentication) GetSourceConnectionInformation(ctx context.Context, sou
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -329,13 +317,7 @@ func (s *Authentication) GetSourceConnectionInformation(ctx context.Context, sou
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -475,13 +457,13 @@ func (s *Authentication) GetSourceConnectionInformation(ctx context.Context, sou
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -527,10 +509,7 @@ func (s *Authentication) GetTokenDetails(ctx context.Context, opts ...operations
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -553,13 +532,7 @@ func (s *Authentication) GetTokenDetails(ctx context.Context, opts ...operations
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -658,7 +631,7 @@ func (s *Authentication) GetTokenDetails(ctx context.Context, opts ...operations
 			}
 
 			var out operations.GetTokenDetailsUserPlexAccount
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -719,13 +692,13 @@ func (s *Authentication) GetTokenDetails(ctx context.Context, opts ...operations
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -775,10 +748,7 @@ func (s *Authentication) PostUsersSignInData(ctx context.Context, request operat
 		return nil, err
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -802,13 +772,7 @@ func (s *Authentication) PostUsersSignInData(ctx context.Context, request operat
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -907,7 +871,7 @@ func (s *Authentication) PostUsersSignInData(ctx context.Context, request operat
 			}
 
 			var out operations.PostUsersSignInDataUserPlexAccount
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -968,13 +932,13 @@ func (s *Authentication) PostUsersSignInData(ctx context.Context, request operat
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
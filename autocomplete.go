@@ -0,0 +1,188 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// AutocompleteResult is a lightweight search result for type-ahead UIs
+type AutocompleteResult struct {
+	RatingKey    string  `json:"ratingKey"`
+	Key          string  `json:"key"`
+	Title        string  `json:"title"`
+	Type         string  `json:"type"`
+	Year         int     `json:"year,omitempty"`
+	Thumb        string  `json:"thumb,omitempty"`
+	SectionID    int     `json:"librarySectionID,omitempty"`
+	SectionTitle string  `json:"librarySectionTitle,omitempty"`
+	Score        float64 `json:"score,omitempty"`    // Relevance score, higher is better
+	Distance     int     `json:"distance,omitempty"` // Levenshtein distance to the query, lower is better
+}
+
+// UnmarshalJSON decodes a search result, accepting score and distance as either numbers or strings
+func (r *AutocompleteResult) UnmarshalJSON(data []byte) error {
+	type alias AutocompleteResult
+	aux := struct {
+		*alias
+		Score    interface{} `json:"score,omitempty"`
+		Distance interface{} `json:"distance,omitempty"`
+		Year     interface{} `json:"year,omitempty"`
+	}{alias: (*alias)(r)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	r.Score = flexFloat(aux.Score)
+	r.Distance = int(flexFloat(aux.Distance))
+	r.Year = int(flexFloat(aux.Year))
+	return nil
+}
+
+// flexFloat converts a JSON number or numeric string into a float64, returning 0 for anything else
+func flexFloat(v interface{}) float64 {
+	switch val := v.(type) {
+	case float64:
+		return val
+	case string:
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return 0
+		}
+		return f
+	default:
+		return 0
+	}
+}
+
+// Autocomplete performs a type-ahead search for the given prefix using the voice search endpoint,
+// which tolerates partial and imprecise input. Results from all hubs are flattened and ordered by
+// score (and then distance), returning at most limit results.
+func (s *Search) Autocomplete(ctx context.Context, prefix string, limit int, opts ...operations.Option) ([]AutocompleteResult, error) {
+	if prefix == "" {
+		return []AutocompleteResult{}, nil
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, "/hubs/search/voice")
+	if err != nil {
+		return nil, fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("query", prefix)
+	if limit > 0 {
+		queryParams.Add("limit", strconv.Itoa(limit))
+	}
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "autocomplete",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return nil, err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return nil, err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rawBody, err := utils.ConsumeRawBody(httpRes)
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		MediaContainer struct {
+			Hub []struct {
+				Type     string               `json:"type"`
+				Metadata []AutocompleteResult `json:"Metadata,omitempty"`
+			} `json:"Hub,omitempty"`
+		} `json:"MediaContainer"`
+	}
+	if err := s.sdkConfiguration.decodeJSON(rawBody, &out); err != nil {
+		return nil, err
+	}
+
+	results := []AutocompleteResult{}
+	for _, hub := range out.MediaContainer.Hub {
+		results = append(results, hub.Metadata...)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Distance < results[j].Distance
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
@@ -0,0 +1,75 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAutocomplete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/hubs/search/voice" || r.Method != "GET" {
+			t.Errorf("Expected GET /hubs/search/voice, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		if r.URL.Query().Get("query") != "star" {
+			t.Errorf("Expected query=star, got: %s", r.URL.Query().Get("query"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":2,"Hub":[
+			{"type":"movie","Metadata":[
+				{"ratingKey":"1","title":"Stardust","type":"movie","year":2007,"score":"0.52"},
+				{"ratingKey":"2","title":"Star Wars","type":"movie","year":"1977","score":0.91}
+			]},
+			{"type":"show","Metadata":[
+				{"ratingKey":"3","title":"Star Trek","type":"show","score":0.75,"distance":2}
+			]}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	results, err := client.Search.Autocomplete(context.Background(), "star", 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got: %d", len(results))
+	}
+
+	if results[0].Title != "Star Wars" || results[1].Title != "Star Trek" {
+		t.Errorf("Expected results ordered by score, got: %s, %s", results[0].Title, results[1].Title)
+	}
+
+	if results[0].Year != 1977 {
+		t.Errorf("Expected year 1977, got: %d", results[0].Year)
+	}
+}
+
+func FuzzAutocompleteResultUnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`{"title":"Alien","score":"0.92","distance":2,"year":"1979"}`))
+	f.Add([]byte(`{"score":1e400,"distance":"-3","year":true}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var result AutocompleteResult
+		if err := json.Unmarshal(data, &result); err != nil {
+			return
+		}
+
+		// Numbers and numeric strings decode the same way
+		encoded, _ := json.Marshal(map[string]interface{}{"score": fmt.Sprint(result.Score), "distance": result.Distance, "year": fmt.Sprint(result.Year)})
+		var again AutocompleteResult
+		if err := json.Unmarshal(encoded, &again); err != nil {
+			t.Fatalf("Expected no error re-decoding %s, got: %v", encoded, err)
+		}
+		if again.Distance != result.Distance || again.Year != result.Year {
+			t.Errorf("Expected %+v to round-trip through strings, got %+v", result, again)
+		}
+	})
+}
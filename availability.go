@@ -0,0 +1,83 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// RatingKeyAvailability sorts rating keys by whether their items can still be added to
+// collections and played, each list in the order the keys were given
+type RatingKeyAvailability struct {
+	Available  []string // Items that exist, with media files if they are playable items
+	Missing    []string // Items that no longer exist, e.g. removed from the library or re-added with a new key
+	Unplayable []string // Movies, episodes, tracks and other playable items without media files
+}
+
+// playableTypes are the item types that are played from media files, as opposed to containers
+// such as shows, seasons and albums
+var playableTypes = map[string]bool{
+	"movie":   true,
+	"episode": true,
+	"track":   true,
+	"clip":    true,
+	"photo":   true,
+}
+
+// hasParts returns true if an item has a media file to play
+func (m Metadata) hasParts() bool {
+	for _, media := range m.Media {
+		if len(media.Part) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyRatingKeys checks which rating keys still exist and, for playable items such as movies
+// and episodes, still have media files, with one metadata request per 50 keys. Use it before
+// adding stored keys to a collection, so that stale keys can be skipped rather than failing the
+// sync halfway with a 404.
+func (s *Library) VerifyRatingKeys(ctx context.Context, ratingKeys []string, opts ...operations.Option) (*RatingKeyAvailability, error) {
+	found := make(map[string]Metadata, len(ratingKeys))
+
+	for start := 0; start < len(ratingKeys); start += metadataBatchSize {
+		end := start + metadataBatchSize
+		if end > len(ratingKeys) {
+			end = len(ratingKeys)
+		}
+
+		// A batch lists the items that exist, and is not found if none of them do
+		items, err := s.listMetadata(ctx, "/library/metadata/"+strings.Join(ratingKeys[start:end], ","), nil, "getMediaMetaData", opts...)
+		if err != nil {
+			var sdkErr *sdkerrors.SDKError
+			if errors.As(err, &sdkErr) && sdkErr.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("error verifying items: %w", err)
+		}
+		for _, item := range items {
+			found[item.RatingKey] = item
+		}
+	}
+
+	availability := &RatingKeyAvailability{Available: []string{}, Missing: []string{}, Unplayable: []string{}}
+	for _, ratingKey := range ratingKeys {
+		item, ok := found[ratingKey]
+		switch {
+		case !ok:
+			availability.Missing = append(availability.Missing, ratingKey)
+		case playableTypes[item.Type] && !item.hasParts():
+			availability.Unplayable = append(availability.Unplayable, ratingKey)
+		default:
+			availability.Available = append(availability.Available, ratingKey)
+		}
+	}
+
+	return availability, nil
+}
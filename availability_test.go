@@ -0,0 +1,61 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestVerifyRatingKeys(t *testing.T) {
+	library := map[string]string{
+		"10": `{"ratingKey":"10","type":"movie","Media":[{"id":1,"Part":[{"id":1,"key":"/library/parts/1/file.mkv"}]}]}`,
+		"11": `{"ratingKey":"11","type":"movie","Media":[]}`,
+		"12": `{"ratingKey":"12","type":"show","leafCount":10}`,
+		"20": `{"ratingKey":"20","type":"episode","Media":[{"id":2,"Part":[{"id":2,"key":"/library/parts/2/file.mkv"}]}]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || !strings.HasPrefix(r.URL.Path, "/library/metadata/") {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		items := []string{}
+		for _, key := range strings.Split(strings.TrimPrefix(r.URL.Path, "/library/metadata/"), ",") {
+			if item, ok := library[key]; ok {
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"MediaContainer":{"size":%d,"Metadata":[%s]}}`, len(items), strings.Join(items, ","))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	availability, err := client.Library.VerifyRatingKeys(ctx, []string{"99", "10", "11", "12", "20"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if fmt.Sprint(availability.Available) != "[10 12 20]" || fmt.Sprint(availability.Missing) != "[99]" || fmt.Sprint(availability.Unplayable) != "[11]" {
+		t.Errorf("Unexpected availability: %+v", availability)
+	}
+
+	// A batch of keys that all no longer exist is not found
+	availability, err = client.Library.VerifyRatingKeys(ctx, []string{"98", "99"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(availability.Available) != 0 || fmt.Sprint(availability.Missing) != "[98 99]" {
+		t.Errorf("Unexpected availability: %+v", availability)
+	}
+}
@@ -0,0 +1,209 @@
+package plexgo
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// The benchmarks in this file cover the hot paths of large libraries, so changes made for
+// performance can be measured: go test -run '^$' -bench . -benchmem
+
+// benchmarkLibrarySize is the number of items in the benchmarked library responses
+const benchmarkLibrarySize = 5000
+
+func BenchmarkDecodeLibraryItems(b *testing.B) {
+	data := libraryResponse(benchmarkLibrarySize)
+	config := sdkConfiguration{}
+
+	// The generated operations decode into their response models
+	b.Run("generated", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			var out operations.GetLibraryItemsResponseBody
+			if err := config.unmarshalResponse("getLibraryItems", data, &out); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	// The helpers decode into the lean Metadata model
+	b.Run("helpers", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			var out struct {
+				MediaContainer struct {
+					Metadata []Metadata `json:"Metadata"`
+				} `json:"MediaContainer"`
+			}
+			if err := config.decodeJSON(data, &out); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	// Unknown field detection walks the whole document a second time
+	b.Run("unknown fields", func(b *testing.B) {
+		config := sdkConfiguration{UnknownFieldsHandler: func(string, []string) {}}
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			var out operations.GetLibraryItemsResponseBody
+			if err := config.unmarshalResponse("getLibraryItems", data, &out); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkSmartFilter(b *testing.B) {
+	build := func() *SmartFilter {
+		return NewSmartFilter(CollectionItemTypeMovie).
+			Where("year", FilterOpGreaterThan, "1999").
+			Add(InTheLast("addedAt", 30*24*time.Hour), Unwatched()).
+			AnyOf(
+				Cond("genre", FilterOpContains, "55"),
+				AllOf(
+					Cond("studio", FilterOpIs, "A24"),
+					Cond("title", FilterOpNotContains, "the end"),
+				),
+			).
+			SortBy("titleSort", false).
+			WithLimit(100)
+	}
+	query := build().String()
+
+	b.Run("build", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = build().String()
+		}
+	})
+
+	b.Run("parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseSmartFilter(query); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("validate", func(b *testing.B) {
+		filter := build()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := filter.Validate(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// TestFilterBuildingAllocations guards the allocations of building filters, which runs for every
+// collection of a Kometa sync, against accidental regressions
+func TestFilterBuildingAllocations(t *testing.T) {
+	smartFilter := NewSmartFilter(CollectionItemTypeMovie).
+		Where("year", FilterOpGreaterThan, "1999").
+		AnyOf(Cond("genre", FilterOpContains, "55"), Cond("studio", FilterOpIs, "A24"))
+	if allocs := testing.AllocsPerRun(100, func() { _ = smartFilter.String() }); allocs > 24 {
+		t.Errorf("Expected building a smart filter to take at most 24 allocations, got %.0f", allocs)
+	}
+
+	libraryFilter := LibraryFilter{}.WithoutLabel("hidden-kids").WithoutContentRating("R")
+	if allocs := testing.AllocsPerRun(100, func() { _ = libraryFilter.String() }); allocs > 8 {
+		t.Errorf("Expected building a library filter to take at most 8 allocations, got %.0f", allocs)
+	}
+}
+
+func BenchmarkLibraryFilter(b *testing.B) {
+	filter := LibraryFilter{}.
+		WithLabel("kids", "family").
+		WithoutLabel("hidden-kids", "horror").
+		WithContentRating("G", "PG").
+		WithoutContentRating("R", "NC-17")
+	value := filter.String()
+
+	b.Run("build", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = filter.String()
+		}
+	})
+
+	b.Run("parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseLibraryFilter(value); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// collectionSnapshots returns snapshots of n collections of size items each, with the items of
+// every tenth collection shifted by shift
+func collectionSnapshots(n, size, shift int) map[int]collectionSnapshot {
+	snapshot := make(map[int]collectionSnapshot, n)
+	for c := 0; c < n; c++ {
+		offset := 0
+		if c%10 == 0 {
+			offset = shift
+		}
+
+		items := make(map[string]bool, size)
+		for i := 0; i < size; i++ {
+			items[fmt.Sprint(c*size+i+offset)] = true
+		}
+		snapshot[c] = collectionSnapshot{collection: Collection{RatingKey: fmt.Sprint(c), Title: fmt.Sprintf("Collection %d", c)}, items: items}
+	}
+	return snapshot
+}
+
+func BenchmarkDiffCollectionSnapshots(b *testing.B) {
+	previous := collectionSnapshots(500, 100, 0)
+	current := collectionSnapshots(500, 100, 5)
+	now := time.Now()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if changes := diffCollectionSnapshots(previous, current, now); len(changes) != 50 {
+			b.Fatalf("Expected 50 changes, got %d", len(changes))
+		}
+	}
+}
+
+func BenchmarkAuditMatching(b *testing.B) {
+	items := make([]Metadata, benchmarkLibrarySize)
+	expected := make(map[string]bool, benchmarkLibrarySize)
+	for i := range items {
+		items[i] = Metadata{
+			RatingKey:     fmt.Sprint(i),
+			GUID:          fmt.Sprintf("plex://movie/%08x", i),
+			ExternalGUIDs: []ExternalGUID{{ID: fmt.Sprintf("imdb://tt%07d", i)}, {ID: fmt.Sprintf("tmdb://%d", i)}},
+		}
+		if i%2 == 0 {
+			expected[normalizeGUID(fmt.Sprintf("tmdb://%d", i))] = true
+		}
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		matched := 0
+		for _, item := range items {
+			if metadataMatchesGUIDs(item, expected) {
+				matched++
+			}
+		}
+		if matched != benchmarkLibrarySize/2 {
+			b.Fatalf("Expected %d matches, got %d", benchmarkLibrarySize/2, matched)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// tagFields are the lockable fields holding tags, which are added and removed rather than set
+var tagFields = map[string]bool{
+	FieldGenre: true, FieldLabel: true, FieldCollection: true, FieldDirector: true, FieldWriter: true,
+	FieldProducer: true, FieldCountry: true, FieldMood: true, FieldStyle: true,
+}
+
+// EditParams is a metadata edit applied to many items by BulkEdit. Edited fields are locked, so
+// agent refreshes keep the new values.
+type EditParams struct {
+	Set        map[string]string   // Values of plain fields, e.g. {FieldStudio: "A24"}
+	AddTags    map[string][]string // Tags added to tag fields, keeping the existing ones, e.g. {FieldLabel: {"A24"}}
+	RemoveTags map[string][]string // Tags removed from tag fields
+	DryRun     bool                // Report the matching items without editing them
+}
+
+// fields returns the edit as metadata edit API fields
+func (e EditParams) fields() (map[string]string, error) {
+	fields := map[string]string{}
+
+	for field, value := range e.Set {
+		if !lockableFields[field] || tagFields[field] {
+			return nil, fmt.Errorf("field %q cannot be set", field)
+		}
+		fields[field+".value"] = value
+		fields[field+".locked"] = "1"
+	}
+
+	// Tags listed for several items are added to each item's own tags
+	for field, tags := range e.AddTags {
+		if !tagFields[field] {
+			return nil, fmt.Errorf("field %q has no tags", field)
+		}
+		for i, tag := range tags {
+			fields[fmt.Sprintf("%s[%d].tag.tag", field, i)] = tag
+		}
+		if len(tags) > 0 {
+			fields[field+".locked"] = "1"
+		}
+	}
+
+	for field, tags := range e.RemoveTags {
+		if !tagFields[field] {
+			return nil, fmt.Errorf("field %q has no tags", field)
+		}
+		if len(tags) == 0 {
+			continue
+		}
+		escaped := make([]string, len(tags))
+		for i, tag := range tags {
+			escaped[i] = url.QueryEscape(tag)
+		}
+		fields[field+"[].tag.tag-"] = strings.Join(escaped, ",")
+		fields[field+".locked"] = "1"
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields to edit")
+	}
+
+	return fields, nil
+}
+
+// BulkEdit applies a metadata edit to every item of a library section matching selector, e.g.
+// adding a label to everything from a studio, and returns the matched items. Items are edited in
+// chunks of 100, or the size set with WithChunkSize, with one request per chunk; with DryRun set
+// the matching items are only returned. If a chunk fails after others were edited, a
+// *PartialError tells which items were edited. Progress of the listing and the edits is reported
+// with WithProgress.
+func (s *Library) BulkEdit(ctx context.Context, sectionID int, selector ItemListOptions, edit EditParams, opts ...operations.Option) ([]Metadata, error) {
+	ctx, finish, err := s.sdkConfiguration.track(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+	ctx = withOperationCorrelationID(ctx)
+
+	fields, err := edit.fields()
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.ListItems(ctx, sectionID, selector, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if edit.DryRun || len(items) == 0 {
+		return items, nil
+	}
+
+	// Items can only be edited one type at a time
+	byType := map[MetadataType][]string{}
+	for _, item := range items {
+		itemType := collectionItemTypeFromName(item.Type)
+		if itemType == 0 {
+			return nil, fmt.Errorf("items of type %s cannot be edited", item.Type)
+		}
+		byType[itemType] = append(byType[itemType], item.RatingKey)
+	}
+	itemTypes := make([]MetadataType, 0, len(byType))
+	ratingKeys := make([]string, 0, len(items))
+	for itemType := range byType {
+		itemTypes = append(itemTypes, itemType)
+	}
+	sort.Slice(itemTypes, func(i, j int) bool { return itemTypes[i] < itemTypes[j] })
+	for _, itemType := range itemTypes {
+		ratingKeys = append(ratingKeys, byType[itemType]...)
+	}
+
+	options := processOptions(opts)
+
+	done := 0
+	reportProgress(options, 0, len(ratingKeys), "editing items")
+	for _, itemType := range itemTypes {
+		for _, chunk := range chunkItems(byType[itemType], options) {
+			if err := ctx.Err(); err != nil {
+				return nil, newPartialError(ratingKeys, done, err)
+			}
+			if err := s.editMetadataBulk(ctx, sectionID, itemType, chunk, fields, "bulkEdit", opts...); err != nil {
+				if done > 0 {
+					return nil, newPartialError(ratingKeys, done, err)
+				}
+				return nil, fmt.Errorf("error editing items of section %d: %w", sectionID, err)
+			}
+
+			done += len(chunk)
+			reportProgress(options, done, len(ratingKeys), "editing items")
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,105 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestBulkEdit(t *testing.T) {
+	var edits []url.Values
+	failEdits := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.RawQuery != "studio==A24&X-Plex-Container-Size=100&X-Plex-Container-Start=0" {
+				t.Errorf("Expected the studio filter, got: %s", r.URL.RawQuery)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":3,"totalSize":3,"Metadata":[
+				{"ratingKey":"1","type":"movie","title":"Moonlight"},
+				{"ratingKey":"2","type":"movie","title":"Lady Bird"},
+				{"ratingKey":"3","type":"movie","title":"Midsommar"}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			if failEdits && len(edits) > 0 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			edits = append(edits, r.URL.Query())
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+	selector := ItemListOptions{Filter: Cond("studio", FilterOpIs, "A24")}
+	edit := EditParams{
+		Set:        map[string]string{FieldContentRating: "R"},
+		AddTags:    map[string][]string{FieldLabel: {"A24", "Indie"}},
+		RemoveTags: map[string][]string{FieldGenre: {"Drama, Romance"}},
+		DryRun:     true,
+	}
+
+	items, err := client.Library.BulkEdit(ctx, 1, selector, edit)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(items) != 3 || len(edits) != 0 {
+		t.Fatalf("Expected the matches without edits, got: %v, %v", items, edits)
+	}
+
+	var progress []int
+	edit.DryRun = false
+	items, err = client.Library.BulkEdit(ctx, 1, selector, edit, WithChunkSize(2), WithProgress(func(done, total int, stage string) {
+		if stage == "editing items" {
+			progress = append(progress, done)
+		}
+	}))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(items) != 3 || len(edits) != 2 {
+		t.Fatalf("Expected two chunks, got: %v", edits)
+	}
+	if edits[0].Get("id") != "1,2" || edits[1].Get("id") != "3" || edits[0].Get("type") != "1" {
+		t.Errorf("Unexpected chunks: %v", edits)
+	}
+	expected := url.Values{
+		"type":                 {"1"},
+		"id":                   {"1,2"},
+		"contentRating.value":  {"R"},
+		"contentRating.locked": {"1"},
+		"label[0].tag.tag":     {"A24"},
+		"label[1].tag.tag":     {"Indie"},
+		"label.locked":         {"1"},
+		"genre[].tag.tag-":     {"Drama%2C+Romance"},
+		"genre.locked":         {"1"},
+	}
+	if edits[0].Encode() != expected.Encode() {
+		t.Errorf("Expected edit %s, got: %s", expected.Encode(), edits[0].Encode())
+	}
+	if len(progress) != 3 || progress[2] != 3 {
+		t.Errorf("Unexpected progress: %v", progress)
+	}
+
+	// A failure after the first chunk reports the edited items
+	edits = nil
+	failEdits = true
+	_, err = client.Library.BulkEdit(ctx, 1, selector, edit, WithChunkSize(2))
+	var partialErr *PartialError
+	if !errors.As(err, &partialErr) || len(partialErr.Completed) != 2 || len(partialErr.Remaining) != 1 {
+		t.Errorf("Expected a partial error, got: %v", err)
+	}
+
+	if _, err := client.Library.BulkEdit(ctx, 1, selector, EditParams{Set: map[string]string{FieldLabel: "A24"}}); err == nil {
+		t.Error("Expected an error for setting a tag field")
+	}
+	if _, err := client.Library.BulkEdit(ctx, 1, selector, EditParams{}); err == nil {
+		t.Error("Expected an error for an empty edit")
+	}
+}
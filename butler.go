@@ -60,10 +60,7 @@ func (s *Butler) GetButlerTasks(ctx context.Context, opts ...operations.Option)
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -86,13 +83,7 @@ func (s *Butler) GetButlerTasks(ctx context.Context, opts ...operations.Option)
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -191,7 +182,7 @@ func (s *Butler) GetButlerTasks(ctx context.Context, opts ...operations.Option)
 			}
 
 			var out operations.GetButlerTasksResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -252,13 +243,13 @@ func (s *Butler) GetButlerTasks(ctx context.Context, opts ...operations.Option)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -309,10 +300,7 @@ func (s *Butler) StartAllTasks(ctx context.Context, opts ...operations.Option) (
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -335,13 +323,7 @@ func (s *Butler) StartAllTasks(ctx context.Context, opts ...operations.Option) (
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -481,13 +463,13 @@ func (s *Butler) StartAllTasks(ctx context.Context, opts ...operations.Option) (
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -534,10 +516,7 @@ func (s *Butler) StopAllTasks(ctx context.Context, opts ...operations.Option) (*
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "DELETE", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -560,13 +539,7 @@ func (s *Butler) StopAllTasks(ctx context.Context, opts ...operations.Option) (*
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -706,13 +679,13 @@ func (s *Butler) StopAllTasks(ctx context.Context, opts ...operations.Option) (*
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -767,10 +740,7 @@ func (s *Butler) StartTask(ctx context.Context, taskName operations.TaskName, op
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -793,13 +763,7 @@ func (s *Butler) StartTask(ctx context.Context, taskName operations.TaskName, op
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -941,13 +905,13 @@ func (s *Butler) StartTask(ctx context.Context, taskName operations.TaskName, op
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -998,10 +962,7 @@ func (s *Butler) StopTask(ctx context.Context, taskName operations.PathParamTask
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "DELETE", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -1024,13 +985,7 @@ func (s *Butler) StopTask(ctx context.Context, taskName operations.PathParamTask
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -1172,13 +1127,13 @@ func (s *Butler) StopTask(ctx context.Context, taskName operations.PathParamTask
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -0,0 +1,191 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/retry"
+)
+
+// ProgressFunc receives progress updates from long-running bulk operations: done out of total
+// units of work have completed in the named stage.
+type ProgressFunc func(done, total int, stage string)
+
+// helperOptions are the options of a call of the SDK's helpers: the generated operations.Options,
+// and the settings of the options only the helpers support, such as WithProgress
+type helperOptions struct {
+	operations.Options
+	Progress          ProgressFunc
+	Verify            bool
+	IfUnmodifiedSince *int64
+	WaitForActivity   *time.Duration
+	RatingTable       map[string]int
+	Include           []string
+	ChunkSize         int
+	Idempotency       IdempotencyFunc
+}
+
+// pendingOptions maps the operations.Options being filled by processOptions to the helperOptions
+// around them, so helper options can set their settings
+var pendingOptions sync.Map
+
+// helperOption returns an operations.Option setting helper options with apply. Generated
+// operations don't support them, so it does nothing when passed to one.
+func helperOption(apply func(opts *helperOptions)) operations.Option {
+	return func(opts *operations.Options, supportedOptions ...string) error {
+		if pending, ok := pendingOptions.Load(opts); ok {
+			apply(pending.(*helperOptions))
+		}
+		return nil
+	}
+}
+
+// processOptions applies the options of a helper call
+func processOptions(opts []operations.Option) *helperOptions {
+	o := &helperOptions{}
+	pendingOptions.Store(&o.Options, o)
+	defer pendingOptions.Delete(&o.Options)
+
+	for _, opt := range opts {
+		// Note: We ignore errors here as we're not checking for supported options. Retries are
+		// supported so that non-idempotent operations can be retried on request.
+		_ = opt(&o.Options, operations.SupportedOptionRetries)
+	}
+	return o
+}
+
+// WithProgress reports the progress of long-running bulk operations to fn
+func WithProgress(fn ProgressFunc) operations.Option {
+	return helperOption(func(opts *helperOptions) {
+		opts.Progress = fn
+	})
+}
+
+// WithInclude sets include flags, e.g. IncludeCollections, on the metadata fetches of the SDK's
+// helpers, such as Library.GetItem and Library.ListItems
+func WithInclude(flags ...string) operations.Option {
+	return helperOption(func(opts *helperOptions) {
+		opts.Include = append(opts.Include, flags...)
+	})
+}
+
+// CallOptions holds the settings shared by every call, as a typed alternative to the variadic
+// operations.Option values. Empty fields are left unset. Options converts them back, so both
+// forms can be mixed in a call, e.g. client.Collections.GetCollection(ctx, 7, callOptions.Options()...).
+type CallOptions struct {
+	ServerURL         string            // Server to call instead of the SDK's
+	Retries           *retry.Config     // Retry policy of the call
+	Timeout           time.Duration     // Timeout of the call's requests
+	Headers           map[string]string // Extra request headers
+	Language          string            // Language of localized metadata, e.g. "de"
+	Include           []string          // Include flags, e.g. IncludeCollections
+	Progress          ProgressFunc      // Progress of bulk operations
+	WaitForActivity   time.Duration     // How long collection writes wait for their server activity
+	ChunkSize         int               // Items per request of AddToCollection and AddToPlaylist
+	Verify            bool              // Re-read collection settings after updating them
+	IfUnmodifiedSince int64             // Abort collection mutations if the collection changed since
+}
+
+// Options returns the settings as operations.Option values
+func (o CallOptions) Options() []operations.Option {
+	opts := []operations.Option{}
+	if o.ServerURL != "" {
+		opts = append(opts, operations.WithServerURL(o.ServerURL))
+	}
+	if o.Retries != nil {
+		opts = append(opts, operations.WithRetries(*o.Retries))
+	}
+	if o.Timeout > 0 {
+		opts = append(opts, operations.WithOperationTimeout(o.Timeout))
+	}
+	// Headers replace those set before them, so they go first and are copied, as the options
+	// below add to them
+	if len(o.Headers) > 0 {
+		headers := make(map[string]string, len(o.Headers))
+		for key, value := range o.Headers {
+			headers[key] = value
+		}
+		opts = append(opts, operations.WithSetHeaders(headers))
+	}
+	if o.Language != "" {
+		opts = append(opts, WithOperationLanguage(o.Language))
+	}
+	if len(o.Include) > 0 {
+		opts = append(opts, WithInclude(o.Include...))
+	}
+	if o.Progress != nil {
+		opts = append(opts, WithProgress(o.Progress))
+	}
+	if o.WaitForActivity > 0 {
+		opts = append(opts, WithWaitForActivity(o.WaitForActivity))
+	}
+	if o.ChunkSize > 0 {
+		opts = append(opts, WithChunkSize(o.ChunkSize))
+	}
+	if o.Verify {
+		opts = append(opts, WithVerify())
+	}
+	if o.IfUnmodifiedSince > 0 {
+		opts = append(opts, WithIfUnmodifiedSince(o.IfUnmodifiedSince))
+	}
+	return opts
+}
+
+// GetAllCollectionsOptions is the typed form of the options of GetAllCollectionsWithOptions
+type GetAllCollectionsOptions struct {
+	CallOptions
+	PageSize int    // Collections requested per page; all in one request if 0
+	Label    string // Only collections with this label
+}
+
+// GetAllCollectionsWithOptions lists the collections of a library section like GetAllCollections,
+// with typed options. Options passed in opts are applied after those of listOptions, so they take
+// precedence. Section listings don't include labels, so filtering by Label reads each collection.
+func (s *Collections) GetAllCollectionsWithOptions(ctx context.Context, sectionID int, listOptions GetAllCollectionsOptions, opts ...operations.Option) ([]Collection, error) {
+	opts = append(listOptions.Options(), opts...)
+
+	var collections []Collection
+	if listOptions.PageSize > 0 {
+		collections = []Collection{}
+		for cursor := (Cursor{PageSize: listOptions.PageSize}); ; {
+			page, next, err := s.GetCollectionsPage(ctx, sectionID, cursor, opts...)
+			if err != nil {
+				return nil, err
+			}
+			collections = append(collections, page...)
+			if !next.HasNext() {
+				break
+			}
+			cursor = next.Next()
+		}
+	} else {
+		var err error
+		if collections, err = s.GetAllCollections(ctx, sectionID, opts...); err != nil {
+			return nil, err
+		}
+	}
+
+	if listOptions.Label == "" {
+		return collections, nil
+	}
+
+	labeled := []Collection{}
+	for _, candidate := range collections {
+		collectionID, err := candidate.ID()
+		if err != nil {
+			return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+		}
+
+		collection, err := s.GetCollection(ctx, collectionID, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting labels of %s: %w", candidate.Title, err)
+		}
+		if collectionHasLabel(collection, listOptions.Label) {
+			labeled = append(labeled, *collection)
+		}
+	}
+	return labeled, nil
+}
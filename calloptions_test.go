@@ -0,0 +1,98 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestGetAllCollectionsWithOptions(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Plex-Language") != "de" {
+			t.Errorf("Expected the language of the options, got: %q", r.Header.Get("X-Plex-Language"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/sections/1/collections":
+			start := r.URL.Query().Get("X-Plex-Container-Start")
+			pages = append(pages, start+"+"+r.URL.Query().Get("X-Plex-Container-Size"))
+			if start == "0" {
+				w.Write([]byte(`{"MediaContainer":{"size":2,"totalSize":3,"Metadata":[{"ratingKey":"7","title":"Marvel","type":"collection"},{"ratingKey":"8","title":"DC","type":"collection"}]}}`))
+			} else {
+				w.Write([]byte(`{"MediaContainer":{"size":1,"totalSize":3,"Metadata":[{"ratingKey":"9","title":"Pixar","type":"collection"}]}}`))
+			}
+		case "/library/collections/7", "/library/collections/9":
+			key := strings.TrimPrefix(r.URL.Path, "/library/collections/")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"` + key + `","title":"Labeled","type":"collection","Label":[{"tag":"Kids"}]}]}}`))
+		case "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"DC","type":"collection"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL("http://unused.invalid"))
+
+	collections, err := client.Collections.GetAllCollectionsWithOptions(context.Background(), 1, GetAllCollectionsOptions{
+		CallOptions: CallOptions{ServerURL: server.URL, Language: "de"},
+		PageSize:    2,
+		Label:       "Kids",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(pages) != 2 || pages[0] != "0+2" || pages[1] != "2+2" {
+		t.Errorf("Expected two pages of 2, got: %v", pages)
+	}
+	if len(collections) != 2 || collections[0].RatingKey != "7" || collections[1].RatingKey != "9" {
+		t.Errorf("Expected the labeled collections, got: %+v", collections)
+	}
+
+	// Variadic options take precedence over the struct's
+	pages = nil
+	_, err = client.Collections.GetAllCollectionsWithOptions(context.Background(), 1, GetAllCollectionsOptions{
+		CallOptions: CallOptions{ServerURL: "http://unused.invalid", Language: "de"},
+		PageSize:    5,
+	}, (CallOptions{ServerURL: server.URL}).Options()...)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(pages) == 0 || pages[0] != "0+5" {
+		t.Errorf("Expected pages of 5 from the server of the variadic options, got: %v", pages)
+	}
+}
+
+func TestProcessOptions(t *testing.T) {
+	opts := []operations.Option{
+		operations.WithServerURL("http://plex.local:32400"),
+		WithChunkSize(10),
+		WithInclude(IncludeCollections),
+		WithInclude(IncludePreferences),
+		WithVerify(),
+	}
+
+	options := processOptions(opts)
+	if options.ServerURL == nil || *options.ServerURL != "http://plex.local:32400" {
+		t.Errorf("Expected the generated options to be applied, got: %v", options.ServerURL)
+	}
+	if options.ChunkSize != 10 || !options.Verify || strings.Join(options.Include, ",") != "includeCollections,includePreferences" {
+		t.Errorf("Expected the helper options to be applied, got: %+v", options)
+	}
+
+	// Generated operations don't support the helper options, which leave their options unchanged
+	var generated operations.Options
+	for _, opt := range opts[1:] {
+		if err := opt(&generated); err != nil {
+			t.Errorf("Expected no error, got: %v", err)
+		}
+	}
+	if generated.ServerURL != nil || len(generated.SetHeaders) != 0 {
+		t.Errorf("Expected the generated options to be unchanged, got: %+v", generated)
+	}
+}
@@ -0,0 +1,95 @@
+package plexgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Checkpoint is the progress of a long-running bulk operation in a form that can be saved, e.g.
+// with SaveCheckpoint, so that an operation interrupted by a restart of the process can be
+// resumed where it stopped instead of re-run from scratch. Operations process their items in a
+// fixed order, so the last item processed and the number processed are enough to resume.
+type Checkpoint struct {
+	Operation string    `json:"operation"`           // Operation the checkpoint belongs to, e.g. "removeFromCollection"
+	Scope     string    `json:"scope,omitempty"`     // What the operation runs on, e.g. a collection; a checkpoint only resumes the same scope
+	Offset    int       `json:"offset"`              // Items processed
+	Last      string    `json:"last,omitempty"`      // Last item processed, e.g. a rating key
+	Remaining []string  `json:"remaining,omitempty"` // Items not processed, for operations over items given by the caller
+	Updated   time.Time `json:"updated"`
+}
+
+// Matches returns true if the checkpoint resumes the operation with the given scope
+func (c *Checkpoint) Matches(operation, scope string) bool {
+	return c.Operation == operation && c.Scope == scope
+}
+
+// touch sets the time the checkpoint was last updated and returns it
+func (c *Checkpoint) touch() *Checkpoint {
+	c.Updated = time.Now().UTC()
+	return c
+}
+
+// Checkpoint returns the progress of the interrupted operation in a serializable form. Resume it
+// by passing Remaining to the operation again.
+func (e *PartialError) Checkpoint(operation, scope string) *Checkpoint {
+	checkpoint := &Checkpoint{
+		Operation: operation,
+		Scope:     scope,
+		Offset:    len(e.Completed),
+		Remaining: append([]string{}, e.Remaining...),
+		Updated:   time.Now().UTC(),
+	}
+	if len(e.Completed) > 0 {
+		checkpoint.Last = e.Completed[len(e.Completed)-1]
+	}
+	return checkpoint
+}
+
+// SaveCheckpoint writes a checkpoint to a file as JSON. The previous checkpoint is only replaced
+// once the new one is complete, so a crash while saving leaves the last good one.
+func SaveCheckpoint(path string, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("error encoding checkpoint: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("error saving checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("error saving checkpoint: %w", err)
+	}
+	return nil
+}
+
+// LoadCheckpoint reads a checkpoint written by SaveCheckpoint. It returns nil without an error if
+// there is none, i.e. the operation runs from the start.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading checkpoint: %w", err)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("error reading checkpoint %s: %w", path, err)
+	}
+	return &checkpoint, nil
+}
+
+// RemoveCheckpoint removes a checkpoint once its operation completed. A missing file is not an
+// error.
+func RemoveCheckpoint(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package plexgo
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckpoint(t *testing.T) {
+	partialErr := newPartialError([]string{"101", "102", "103"}, 2, context.Canceled)
+	checkpoint := partialErr.Checkpoint("removeFromCollection", "collection 5")
+	if checkpoint.Offset != 2 || checkpoint.Last != "102" || len(checkpoint.Remaining) != 1 || checkpoint.Remaining[0] != "103" {
+		t.Fatalf("Unexpected checkpoint: %+v", checkpoint)
+	}
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	if loaded, err := LoadCheckpoint(path); loaded != nil || err != nil {
+		t.Errorf("Expected no checkpoint before one is saved, got: %+v, %v", loaded, err)
+	}
+
+	if err := SaveCheckpoint(path, checkpoint); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	loaded, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !loaded.Matches("removeFromCollection", "collection 5") || loaded.Matches("removeFromCollection", "collection 6") {
+		t.Errorf("Expected the checkpoint to only match its own scope, got: %+v", loaded)
+	}
+	if loaded.Offset != 2 || loaded.Last != "102" || len(loaded.Remaining) != 1 || !loaded.Updated.Equal(checkpoint.Updated) {
+		t.Errorf("Expected the saved checkpoint, got: %+v", loaded)
+	}
+
+	if err := RemoveCheckpoint(path); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := RemoveCheckpoint(path); err != nil {
+		t.Errorf("Expected no error for a missing checkpoint, got: %v", err)
+	}
+	if loaded, err := LoadCheckpoint(path); loaded != nil || err != nil {
+		t.Errorf("Expected no checkpoint after removing it, got: %+v, %v", loaded, err)
+	}
+}
@@ -0,0 +1,83 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// defaultChunkSize is the number of items added per request when WithChunkSize is not set. The
+// rating keys are part of the request URL, and a hundred keep it well within server limits.
+const defaultChunkSize = 100
+
+// WithChunkSize sets the number of items AddToCollection and AddToPlaylist add, and BulkEdit
+// edits, per request. Smaller chunks keep URLs short for servers behind proxies with tight
+// limits; sizes below 1 use the default.
+func WithChunkSize(size int) operations.Option {
+	return helperOption(func(opts *helperOptions) {
+		opts.ChunkSize = size
+	})
+}
+
+// chunkItems splits items into chunks of the size set with WithChunkSize
+func chunkItems(items []string, options *helperOptions) [][]string {
+	size := options.ChunkSize
+	if size <= 0 {
+		size = defaultChunkSize
+	}
+
+	chunks := make([][]string, 0, (len(items)+size-1)/size)
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		chunks = append(chunks, items[start:end])
+	}
+	return chunks
+}
+
+// AddToPlaylist adds items to a playlist, in chunks of 100 or the size set with WithChunkSize so
+// the request URLs stay within server limits. If a chunk fails after others were added, a
+// *PartialError tells which items were added; unlike collections, playlists can hold an item
+// more than once, so only the remaining items should be retried.
+func (s *Playlists) AddToPlaylist(ctx context.Context, playlistID int, ratingKeys []string, opts ...operations.Option) error {
+	ctx, finish, err := s.sdkConfiguration.track(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+
+	if len(ratingKeys) == 0 {
+		return nil
+	}
+
+	identity, err := newServer(s.sdkConfiguration).GetIdentity(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting server identity: %w", err)
+	}
+	uriRoot := s.sdkConfiguration.GetURIRoot(identity.MachineIdentifier)
+
+	options := processOptions(opts)
+	chunks := chunkItems(ratingKeys, options)
+
+	done := 0
+	for _, chunk := range chunks {
+		uri := fmt.Sprintf("%s/library/metadata/%s", uriRoot, strings.Join(chunk, ","))
+		if _, err := s.AddPlaylistContents(ctx, float64(playlistID), uri, nil, opts...); err != nil {
+			if done > 0 {
+				return newPartialError(ratingKeys, done, err)
+			}
+			return fmt.Errorf("error adding items to playlist %d: %w", playlistID, err)
+		}
+
+		done += len(chunk)
+		if len(chunks) > 1 {
+			reportProgress(options, done, len(ratingKeys), "adding items")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAddToCollectionChunks(t *testing.T) {
+	var added []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/library/collections/13" && r.Method == "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"13","title":"Marvel","type":"collection","librarySectionID":1}]}}`))
+		case r.URL.Path == "/identity":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"machineIdentifier":"abc123"}}`))
+		case r.URL.Path == "/library/collections/13/items" && r.Method == "PUT":
+			uri := r.URL.Query().Get("uri")
+			keys := uri[strings.LastIndex(uri, "/")+1:]
+			if strings.Contains(keys, "5") {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			added = append(added, keys)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	err := client.Collections.AddToCollection(context.Background(), 13, []string{"1", "2", "3", "4"}, WithChunkSize(2), WithWaitForActivity(time.Second))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(added) != 2 || added[0] != "1,2" || added[1] != "3,4" {
+		t.Errorf("Expected two chunks of 2 items, got: %v", added)
+	}
+
+	added = nil
+	err = client.Collections.AddToCollection(context.Background(), 13, []string{"1", "2", "3", "4", "5", "6"}, WithChunkSize(2), WithWaitForActivity(time.Second))
+	var partial *PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("Expected a PartialError, got: %v", err)
+	}
+	if len(partial.Completed) != 4 || len(partial.Remaining) != 2 || partial.Remaining[0] != "5" {
+		t.Errorf("Expected items 5 and 6 to remain, got: %+v", partial)
+	}
+}
@@ -0,0 +1,134 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// CollectionMemberPlays counts the plays of an item of a collection. Plays of the episodes of a
+// show, or the tracks of an album or artist, count towards it.
+type CollectionMemberPlays struct {
+	RatingKey    string
+	Title        string
+	Plays        int
+	Viewers      int       // Accounts that played the item
+	LastViewedAt time.Time // Zero if the item wasn't played in the window
+}
+
+// CollectionPlayCounts reports how much the items of a collection were played over a time window
+type CollectionPlayCounts struct {
+	CollectionID  int
+	Title         string
+	Since         time.Time               // Zero if all history was counted
+	Members       []CollectionMemberPlays // By plays, most played first
+	TotalPlays    int
+	Viewers       int // Accounts that played any item of the collection
+	PlayedMembers int // Items played at least once
+}
+
+// GetCollectionPlayCounts cross-references the watch history of the last window with the items of
+// a collection, reporting the plays of each item and the engagement with the collection as a
+// whole, e.g. to decide which curated rows to keep. A window of 0 counts all history.
+func (s *Statistics) GetCollectionPlayCounts(ctx context.Context, collectionID int, window time.Duration, opts ...operations.Option) (*CollectionPlayCounts, error) {
+	collection, err := newCollections(s.sdkConfiguration).GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection: %w", err)
+	}
+
+	library := newLibrary(s.sdkConfiguration)
+	items, err := library.listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), nil, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting items of %s: %w", collection.Title, err)
+	}
+
+	var history struct {
+		MediaContainer struct {
+			Metadata []struct {
+				RatingKey      string `json:"ratingKey"`
+				ParentKey      string `json:"parentKey"`
+				GrandparentKey string `json:"grandparentKey"`
+				AccountID      int    `json:"accountID"`
+				ViewedAt       int64  `json:"viewedAt"`
+			} `json:"Metadata"`
+		} `json:"MediaContainer"`
+	}
+	historyParams := url.Values{}
+	if collection.SectionID > 0 {
+		historyParams.Add("librarySectionID", strconv.Itoa(collection.SectionID))
+	}
+	var since time.Time
+	if window > 0 {
+		since = time.Now().Add(-window)
+		historyParams.Add("viewedAt>", strconv.FormatInt(since.Unix(), 10))
+	}
+	if err := library.getJSON(ctx, "/status/sessions/history/all", historyParams, "getSessionHistory", &history, opts...); err != nil {
+		return nil, fmt.Errorf("error getting watch history: %w", err)
+	}
+
+	members := make(map[string]*CollectionMemberPlays, len(items))
+	report := &CollectionPlayCounts{
+		CollectionID: collectionID,
+		Title:        collection.Title,
+		Since:        since,
+		Members:      make([]CollectionMemberPlays, 0, len(items)),
+	}
+	for _, item := range items {
+		members[item.RatingKey] = &CollectionMemberPlays{RatingKey: item.RatingKey, Title: item.Title}
+	}
+
+	memberViewers := map[string]map[int]bool{}
+	viewers := map[int]bool{}
+	for _, entry := range history.MediaContainer.Metadata {
+		// Episodes and tracks are matched to the show, season, album or artist in the collection
+		var member *CollectionMemberPlays
+		for _, key := range []string{entry.RatingKey, ratingKeyFromKey(entry.ParentKey), ratingKeyFromKey(entry.GrandparentKey)} {
+			if member = members[key]; member != nil {
+				break
+			}
+		}
+		if member == nil {
+			continue
+		}
+
+		member.Plays++
+		if viewedAt := time.Unix(entry.ViewedAt, 0); viewedAt.After(member.LastViewedAt) {
+			member.LastViewedAt = viewedAt
+		}
+		if memberViewers[member.RatingKey] == nil {
+			memberViewers[member.RatingKey] = map[int]bool{}
+		}
+		memberViewers[member.RatingKey][entry.AccountID] = true
+		viewers[entry.AccountID] = true
+		report.TotalPlays++
+	}
+
+	for _, item := range items {
+		member := members[item.RatingKey]
+		member.Viewers = len(memberViewers[item.RatingKey])
+		if member.Plays > 0 {
+			report.PlayedMembers++
+		}
+		report.Members = append(report.Members, *member)
+	}
+	report.Viewers = len(viewers)
+
+	sort.SliceStable(report.Members, func(i, j int) bool { return report.Members[i].Plays > report.Members[j].Plays })
+
+	return report, nil
+}
+
+// ratingKeyFromKey returns the rating key of a metadata key such as "/library/metadata/123"
+func ratingKeyFromKey(key string) string {
+	ratingKey, ok := strings.CutPrefix(key, "/library/metadata/")
+	if !ok {
+		return ""
+	}
+	return ratingKey
+}
@@ -0,0 +1,64 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetCollectionPlayCounts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/library/collections/5":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"5","title":"Prestige TV","librarySectionID":2}]}}`))
+		case "/library/collections/5/children":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"10","type":"show","title":"Severance"},
+				{"ratingKey":"20","type":"show","title":"The Wire"},
+				{"ratingKey":"30","type":"show","title":"Deadwood"}]}}`))
+		case "/status/sessions/history/all":
+			query := r.URL.Query()
+			if query.Get("librarySectionID") != "2" || query.Get("viewedAt>") == "" {
+				t.Errorf("Unexpected history query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"101","parentKey":"/library/metadata/100","grandparentKey":"/library/metadata/10","accountID":1,"viewedAt":1000},
+				{"ratingKey":"102","parentKey":"/library/metadata/100","grandparentKey":"/library/metadata/10","accountID":2,"viewedAt":2000},
+				{"ratingKey":"103","parentKey":"/library/metadata/100","grandparentKey":"/library/metadata/10","accountID":1,"viewedAt":3000},
+				{"ratingKey":"201","parentKey":"/library/metadata/200","grandparentKey":"/library/metadata/20","accountID":1,"viewedAt":1500},
+				{"ratingKey":"901","parentKey":"/library/metadata/900","grandparentKey":"/library/metadata/90","accountID":3,"viewedAt":1500}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	report, err := client.Statistics.GetCollectionPlayCounts(context.Background(), 5, 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if report.Title != "Prestige TV" || report.TotalPlays != 4 || report.Viewers != 2 || report.PlayedMembers != 2 {
+		t.Errorf("Unexpected totals: %+v", report)
+	}
+	if len(report.Members) != 3 {
+		t.Fatalf("Expected 3 members, got: %+v", report.Members)
+	}
+
+	first := report.Members[0]
+	if first.Title != "Severance" || first.Plays != 3 || first.Viewers != 2 || first.LastViewedAt.Unix() != 3000 {
+		t.Errorf("Unexpected most played member: %+v", first)
+	}
+	if report.Members[1].Title != "The Wire" || report.Members[1].Plays != 1 {
+		t.Errorf("Unexpected second member: %+v", report.Members[1])
+	}
+	if last := report.Members[2]; last.Title != "Deadwood" || last.Plays != 0 || !last.LastViewedAt.IsZero() {
+		t.Errorf("Expected an unplayed member last, got: %+v", last)
+	}
+}
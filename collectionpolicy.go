@@ -0,0 +1,166 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// MinItemsAction is what a policy does with collections that have fewer items than its MinItems
+type MinItemsAction string
+
+const (
+	MinItemsHide   MinItemsAction = "hide"   // Hide the collection in the library and on the home screens
+	MinItemsDelete MinItemsAction = "delete" // Delete the collection
+)
+
+// CollectionPolicy is the mode, sort and visibility every collection of a library section should
+// have. Settings left empty are not enforced.
+type CollectionPolicy struct {
+	DefaultMode string                // A CollectionMode constant, e.g. CollectionModeHideItems
+	DefaultSort string                // A CollectionSort constant; custom order is not applied to smart collections
+	Visibility  *CollectionVisibility // Where the collections are shown on the home screen
+	// MinItems, if set, is the number of items below which collections are hidden, with
+	// CollectionModeHide and no visibility, or deleted, as set by BelowMinItems. Once a hidden
+	// collection has enough items again it gets DefaultMode and Visibility, if they are set.
+	MinItems      int
+	BelowMinItems MinItemsAction // MinItemsHide if not set
+}
+
+// CollectionPolicyChange is a setting of a collection changed to conform to a policy
+type CollectionPolicyChange struct {
+	CollectionID int
+	Title        string
+	Setting      string // "collectionMode", "collectionSort", "visibility" or "deleted" for collections deleted by MinItems
+	From         string
+	To           string
+}
+
+// ApplyPolicy updates the collections of a library section whose mode, sort or visibility differs
+// from the policy, hides or deletes those with fewer items than its MinItems, and returns the
+// changes it made. Collections that already conform aren't touched, so it can be run on demand or
+// periodically, e.g. after a sync; CollectionWatcher.Policy applies it to new collections as they
+// are created and, with MinItems, to collections whose items change.
+func (s *Collections) ApplyPolicy(ctx context.Context, sectionID int, policy CollectionPolicy, opts ...operations.Option) ([]CollectionPolicyChange, error) {
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	collections, err := s.GetAllCollections(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collections: %w", err)
+	}
+
+	options := processOptions(opts)
+
+	changes := []CollectionPolicyChange{}
+	for i, collection := range collections {
+		applied, err := s.applyPolicy(ctx, sectionID, collection, policy, opts...)
+		changes = append(changes, applied...)
+		if err != nil {
+			return changes, err
+		}
+		reportProgress(options, i+1, len(collections), "applying policy")
+	}
+
+	return changes, nil
+}
+
+// validate checks that the policy's mode and sort are known values
+func (p CollectionPolicy) validate() error {
+	if p.DefaultMode != "" && !knownCollectionSetting(p.DefaultMode, CollectionModeKeys) {
+		return fmt.Errorf("unknown collection mode %q", p.DefaultMode)
+	}
+	if p.DefaultSort != "" && !knownCollectionSetting(p.DefaultSort, CollectionSortKeys) {
+		return fmt.Errorf("unknown collection sort %q", p.DefaultSort)
+	}
+	if p.MinItems < 0 {
+		return fmt.Errorf("invalid minimum of %d items", p.MinItems)
+	}
+	if p.BelowMinItems != "" && p.BelowMinItems != MinItemsHide && p.BelowMinItems != MinItemsDelete {
+		return fmt.Errorf("unknown action %q for collections below the minimum items", p.BelowMinItems)
+	}
+	return nil
+}
+
+// knownCollectionSetting returns true if value is one of the constants in keys
+func knownCollectionSetting(value string, keys map[int]string) bool {
+	for _, name := range keys {
+		if name == value {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPolicy updates the settings of a collection that differ from the policy
+func (s *Collections) applyPolicy(ctx context.Context, sectionID int, collection Collection, policy CollectionPolicy, opts ...operations.Option) ([]CollectionPolicyChange, error) {
+	collectionID, err := collection.ID()
+	if err != nil {
+		return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+	}
+
+	var changes []CollectionPolicyChange
+	change := func(setting, from, to string) {
+		changes = append(changes, CollectionPolicyChange{
+			CollectionID: collectionID,
+			Title:        collection.Title,
+			Setting:      setting,
+			From:         from,
+			To:           to,
+		})
+	}
+
+	// Collections below the minimum are deleted, or hidden by enforcing a hidden mode and visibility
+	if policy.MinItems > 0 && collection.ChildCount < policy.MinItems {
+		if policy.BelowMinItems == MinItemsDelete {
+			if err := s.DeleteCollection(ctx, collectionID, opts...); err != nil {
+				return changes, fmt.Errorf("error deleting %s: %w", collection.Title, err)
+			}
+			change("deleted", fmt.Sprintf("%d items", collection.ChildCount), fmt.Sprintf("minimum %d items", policy.MinItems))
+			return changes, nil
+		}
+		policy.DefaultMode = CollectionModeHide
+		policy.Visibility = &CollectionVisibility{}
+	}
+
+	if policy.DefaultMode != "" {
+		if mode := collection.ModeEnum().String(); mode != policy.DefaultMode {
+			if _, err := s.UpdateCollectionMode(ctx, collectionID, policy.DefaultMode, opts...); err != nil {
+				return changes, fmt.Errorf("error updating mode of %s: %w", collection.Title, err)
+			}
+			change("collectionMode", mode, policy.DefaultMode)
+		}
+	}
+
+	// Smart collections are ordered by their filter and can't be sorted by hand
+	if policy.DefaultSort != "" && !(policy.DefaultSort == CollectionSortCustom && collection.IsSmartCollection()) {
+		if sort := collection.SortEnum().String(); sort != policy.DefaultSort {
+			if _, err := s.UpdateCollectionSort(ctx, collectionID, policy.DefaultSort, opts...); err != nil {
+				return changes, fmt.Errorf("error updating sort of %s: %w", collection.Title, err)
+			}
+			change("collectionSort", sort, policy.DefaultSort)
+		}
+	}
+
+	if policy.Visibility != nil {
+		visibility, err := s.GetCollectionVisibility(ctx, sectionID, collectionID, opts...)
+		if err != nil {
+			return changes, fmt.Errorf("error getting visibility of %s: %w", collection.Title, err)
+		}
+		if *visibility != *policy.Visibility {
+			if err := s.UpdateCollectionVisibility(ctx, sectionID, collectionID, policy.Visibility, opts...); err != nil {
+				return changes, fmt.Errorf("error updating visibility of %s: %w", collection.Title, err)
+			}
+			change("visibility", visibility.String(), policy.Visibility.String())
+		}
+	}
+
+	return changes, nil
+}
+
+// String returns the visibility as e.g. "library=true home=false shared=false"
+func (v CollectionVisibility) String() string {
+	return fmt.Sprintf("library=%t home=%t shared=%t", v.Library, v.Home, v.Shared)
+}
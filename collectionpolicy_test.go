@@ -0,0 +1,169 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestApplyPolicy(t *testing.T) {
+	var updates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"ratingKey":"1","title":"Marvel","collectionMode":"2","collectionSort":"1","librarySectionID":1},
+				{"ratingKey":"2","title":"Heist Movies","collectionMode":"-1","collectionSort":"0","librarySectionID":1},
+				{"ratingKey":"3","title":"Recent Horror","smart":"1","collectionMode":"2","collectionSort":"0","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/1":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"1","title":"Marvel","type":"collection"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/hubs/sections/1/manage":
+			promoted := "0"
+			if query.Get("metadataItemId") == "1" {
+				promoted = "1"
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"promotedToRecommended":"1","promotedToOwnHome":"` + promoted + `","promotedToSharedHome":"0"}]}}`))
+		case r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/prefs"):
+			updates = append(updates, r.URL.Path+"?"+r.URL.RawQuery)
+		case r.Method == "POST" && r.URL.Path == "/hubs/sections/1/manage":
+			updates = append(updates, r.URL.Path+"?id="+query.Get("metadataItemId"))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	changes, err := client.Collections.ApplyPolicy(context.Background(), 1, CollectionPolicy{
+		DefaultMode: CollectionModeShowItems,
+		DefaultSort: CollectionSortCustom,
+		Visibility:  &CollectionVisibility{Library: true, Home: true},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var got []string
+	for _, change := range changes {
+		got = append(got, change.Title+" "+change.Setting+" "+change.From+"->"+change.To)
+	}
+	want := []string{
+		"Marvel collectionSort alpha->custom",
+		"Heist Movies collectionMode default->showItems",
+		"Heist Movies collectionSort release->custom",
+		"Heist Movies visibility library=true home=false shared=false->library=true home=true shared=false",
+		"Recent Horror visibility library=true home=false shared=false->library=true home=true shared=false",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected changes:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+	if len(updates) != 5 {
+		t.Errorf("Expected 5 updates, got: %v", updates)
+	}
+
+	if _, err := client.Collections.ApplyPolicy(context.Background(), 1, CollectionPolicy{DefaultMode: "grid"}); err == nil {
+		t.Errorf("Expected an error for an unknown mode")
+	}
+}
+
+func TestCollectionWatcherPolicy(t *testing.T) {
+	polls := 0
+	var updates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			polls++
+			if polls == 1 {
+				w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"5","title":"Heist","collectionMode":"-1"}]}}`))
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"5","title":"Heist","collectionMode":"-1"},
+				{"ratingKey":"7","title":"Space","collectionMode":"-1"}]}}`))
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/children"):
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[]}}`))
+		case r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/prefs"):
+			updates = append(updates, r.URL.Path)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	watcher := client.Collections.NewWatcher(1)
+	watcher.Policy = &CollectionPolicy{DefaultMode: CollectionModeHideItems}
+
+	for i := 0; i < 2; i++ {
+		if _, err := watcher.Poll(context.Background()); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+
+	if len(updates) != 1 || updates[0] != "/library/collections/7/prefs" {
+		t.Errorf("Expected only the new collection to be updated, got: %v", updates)
+	}
+}
+
+func TestApplyPolicyMinItems(t *testing.T) {
+	var updates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"1","title":"Marvel","childCount":12,"collectionMode":"2","librarySectionID":1},
+				{"ratingKey":"2","title":"Heist Movies","childCount":2,"collectionMode":"-1","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/hubs/sections/1/manage":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"promotedToRecommended":"1","promotedToOwnHome":"1","promotedToSharedHome":"0"}]}}`))
+		case r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/prefs"):
+			updates = append(updates, r.URL.Path+"?"+r.URL.RawQuery)
+		case r.Method == "POST" && r.URL.Path == "/hubs/sections/1/manage":
+			updates = append(updates, "visibility "+query.Get("metadataItemId")+" "+query.Get("promotedToOwnHome"))
+		case r.Method == "DELETE":
+			updates = append(updates, "delete "+r.URL.Path)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	changes, err := client.Collections.ApplyPolicy(context.Background(), 1, CollectionPolicy{MinItems: 3})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(changes) != 2 || changes[0].Title != "Heist Movies" || changes[0].To != CollectionModeHide || changes[1].Setting != "visibility" {
+		t.Errorf("Expected Heist Movies to be hidden, got: %+v", changes)
+	}
+	if len(updates) != 2 || updates[0] != "/library/collections/2/prefs?collectionMode=0" || updates[1] != "visibility 2 0" {
+		t.Errorf("Unexpected updates: %v", updates)
+	}
+
+	updates = nil
+	changes, err = client.Collections.ApplyPolicy(context.Background(), 1, CollectionPolicy{MinItems: 3, BelowMinItems: MinItemsDelete})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Setting != "deleted" || changes[0].CollectionID != 2 {
+		t.Errorf("Expected Heist Movies to be deleted, got: %+v", changes)
+	}
+	if len(updates) != 1 || updates[0] != "delete /library/collections/2" {
+		t.Errorf("Unexpected updates: %v", updates)
+	}
+
+	if _, err := client.Collections.ApplyPolicy(context.Background(), 1, CollectionPolicy{MinItems: 3, BelowMinItems: "archive"}); err == nil {
+		t.Error("Expected an error for an unknown action")
+	}
+}
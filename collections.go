@@ -2,16 +2,17 @@
 package plexgo
 
 import (
-	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/unfaiyted/plexgo/internal/hooks"
 	"github.com/unfaiyted/plexgo/internal/utils"
 	"github.com/unfaiyted/plexgo/models/operations"
 	"github.com/unfaiyted/plexgo/models/sdkerrors"
+	"io"
 	// "log"
 
-	"github.com/unfaiyted/plexgo/retry"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -33,6 +34,7 @@ type Collection struct {
 	ContentRating   string      `json:"contentRating,omitempty"`
 	Thumb           string      `json:"thumb,omitempty"`
 	Art             string      `json:"art,omitempty"`
+	Theme           string      `json:"theme,omitempty"`
 	ChildCount      int         `json:"childCount,omitempty"`
 	CollectionMode  string      `json:"collectionMode,omitempty"`
 	CollectionSort  string      `json:"collectionSort,omitempty"`
@@ -41,9 +43,87 @@ type Collection struct {
 	SectionUUID     string      `json:"librarySectionUUID,omitempty"`
 	Type            string      `json:"type"`
 	SubType         string      `json:"subtype,omitempty"`
+	Label           []Tag       `json:"Label,omitempty"`
 	CollectionItems []string    `json:"-"` // Slice of rating keys for items in the collection
 }
 
+// UnmarshalJSON decodes a collection, normalizing collectionMode and collectionSort to the
+// CollectionMode and CollectionSort constants. Depending on the server version they are returned
+// as names, numeric strings or numbers.
+func (c *Collection) UnmarshalJSON(data []byte) error {
+	type collectionAlias Collection
+	aux := struct {
+		*collectionAlias
+		CollectionMode interface{} `json:"collectionMode,omitempty"`
+		CollectionSort interface{} `json:"collectionSort,omitempty"`
+	}{collectionAlias: (*collectionAlias)(c)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	c.CollectionMode = normalizeCollectionSetting(aux.CollectionMode, CollectionModeKeys)
+	c.CollectionSort = normalizeCollectionSetting(aux.CollectionSort, CollectionSortKeys)
+	return nil
+}
+
+// ModeEnum returns the collection mode as a typed enum. An unset mode is the default mode.
+func (c *Collection) ModeEnum() CollectionModeEnum {
+	if c.CollectionMode == "" {
+		return CollectionModeEnumDefault
+	}
+	name := normalizeCollectionSetting(c.CollectionMode, CollectionModeKeys)
+	for k, v := range CollectionModeKeys {
+		if v == name {
+			return CollectionModeEnum(k)
+		}
+	}
+	return CollectionModeEnumUnknown
+}
+
+// SortEnum returns the collection sort as a typed enum. An unset sort is release date order.
+func (c *Collection) SortEnum() CollectionSortEnum {
+	if c.CollectionSort == "" {
+		return CollectionSortEnumRelease
+	}
+	name := normalizeCollectionSetting(c.CollectionSort, CollectionSortKeys)
+	for k, v := range CollectionSortKeys {
+		if v == name {
+			return CollectionSortEnum(k)
+		}
+	}
+	return CollectionSortEnumUnknown
+}
+
+// normalizeCollectionSetting maps a collection mode or sort value (a name, numeric string or
+// number) to its constant in keys, leaving unknown values unchanged
+func normalizeCollectionSetting(value interface{}, keys map[int]string) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		if name, ok := keys[int(v)]; ok && float64(int(v)) == v {
+			return name
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		if n, err := strconv.Atoi(v); err == nil {
+			if name, ok := keys[n]; ok {
+				return name
+			}
+			return v
+		}
+		for _, name := range keys {
+			if strings.EqualFold(name, v) {
+				return name
+			}
+		}
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 // IsSmartCollection returns true if the collection is a smart collection
 func (c *Collection) IsSmartCollection() bool {
 	switch v := c.Smart.(type) {
@@ -60,6 +140,72 @@ func (c *Collection) IsSmartCollection() bool {
 	}
 }
 
+// ID returns the collection's rating key as the int ID taken by the Collections methods
+func (c *Collection) ID() (int, error) {
+	return ParseCollectionID(c.RatingKey)
+}
+
+// ParseCollectionID converts a collection rating key, which the API returns as a string, to the
+// int ID taken by the Collections methods
+func ParseCollectionID(ratingKey string) (int, error) {
+	collectionID, err := strconv.Atoi(ratingKey)
+	if err != nil || collectionID <= 0 {
+		return 0, fmt.Errorf("invalid collection rating key %q", ratingKey)
+	}
+	return collectionID, nil
+}
+
+// CanMutateMembers returns true if items can be added to, removed from and moved in the
+// collection, which is not the case for smart collections
+func (c *Collection) CanMutateMembers() bool {
+	return !c.IsSmartCollection()
+}
+
+// ErrSmartCollectionReadOnly is returned when adding, removing or moving items of a smart
+// collection, whose members come from its filter
+var ErrSmartCollectionReadOnly = errors.New("smart collection members cannot be changed manually")
+
+// ErrInvalidArgument is matched with errors.Is by the errors of arguments rejected before any
+// request is sent, e.g. items of types that can't share a collection or an invalid smart filter
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// argumentError is an error of a rejected argument. It matches ErrInvalidArgument with errors.Is.
+type argumentError struct {
+	err error
+}
+
+// invalidArgument formats an argumentError like fmt.Errorf
+func invalidArgument(format string, args ...interface{}) error {
+	return &argumentError{err: fmt.Errorf(format, args...)}
+}
+
+func (e *argumentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *argumentError) Unwrap() error {
+	return e.err
+}
+
+func (e *argumentError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// SmartCollectionError reports a change to the members of a smart collection. It matches
+// ErrSmartCollectionReadOnly with errors.Is.
+type SmartCollectionError struct {
+	CollectionID int
+	Operation    string // "add", "remove" or "move"
+}
+
+func (e *SmartCollectionError) Error() string {
+	return fmt.Sprintf("cannot %s items: collection %d is a smart collection", e.Operation, e.CollectionID)
+}
+
+func (e *SmartCollectionError) Is(target error) bool {
+	return target == ErrSmartCollectionReadOnly
+}
+
 // CollectionVisibility represents collection visibility settings
 type CollectionVisibility struct {
 	Library bool
@@ -69,9 +215,10 @@ type CollectionVisibility struct {
 
 // SmartFilterConfig represents smart filter configuration
 type SmartFilterConfig struct {
-	Type   int    // 1=movie, 2=show, etc.
-	Filter string // filter string
-	URI    string // full smart filter URI
+	Type   MetadataType // Item type of the filter, e.g. MetadataTypeMovie
+	Filter string       // filter string
+	URI    string       // full smart filter URI
+	Parsed *SmartFilter // parsed filter conditions, nil if the filter could not be parsed
 }
 
 // CollectionMode constants
@@ -104,6 +251,120 @@ var CollectionSortKeys = map[int]string{
 	2: CollectionSortCustom,
 }
 
+// CollectionModeEnum is a collection mode as a typed enum with Plex's numeric values, returned by
+// Collection.ModeEnum for reliable switch statements
+type CollectionModeEnum int
+
+// CollectionModeEnum values. CollectionModeEnumUnknown is returned for modes this SDK doesn't know.
+const (
+	CollectionModeEnumUnknown   CollectionModeEnum = -2
+	CollectionModeEnumDefault   CollectionModeEnum = -1
+	CollectionModeEnumHide      CollectionModeEnum = 0
+	CollectionModeEnumHideItems CollectionModeEnum = 1
+	CollectionModeEnumShowItems CollectionModeEnum = 2
+)
+
+// String returns the CollectionMode constant of the mode
+func (m CollectionModeEnum) String() string {
+	if name, ok := CollectionModeKeys[int(m)]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// CollectionSortEnum is a collection sort as a typed enum with Plex's numeric values, returned by
+// Collection.SortEnum for reliable switch statements
+type CollectionSortEnum int
+
+// CollectionSortEnum values. CollectionSortEnumUnknown is returned for sorts this SDK doesn't know.
+const (
+	CollectionSortEnumUnknown CollectionSortEnum = -1
+	CollectionSortEnumRelease CollectionSortEnum = 0
+	CollectionSortEnumAlpha   CollectionSortEnum = 1
+	CollectionSortEnumCustom  CollectionSortEnum = 2
+)
+
+// String returns the CollectionSort constant of the sort
+func (s CollectionSortEnum) String() string {
+	if name, ok := CollectionSortKeys[int(s)]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// CollectionItemType constants are the Plex metadata types a collection can hold
+const (
+	CollectionItemTypeMovie   = MetadataTypeMovie
+	CollectionItemTypeShow    = MetadataTypeShow
+	CollectionItemTypeSeason  = MetadataTypeSeason
+	CollectionItemTypeEpisode = MetadataTypeEpisode
+	CollectionItemTypeArtist  = MetadataTypeArtist
+	CollectionItemTypeAlbum   = MetadataTypeAlbum
+	CollectionItemTypeTrack   = MetadataTypeTrack
+)
+
+// CollectionItemTypeKeys maps the metadata types a collection can hold to their type names
+var CollectionItemTypeKeys = map[MetadataType]string{
+	CollectionItemTypeMovie:   "movie",
+	CollectionItemTypeShow:    "show",
+	CollectionItemTypeSeason:  "season",
+	CollectionItemTypeEpisode: "episode",
+	CollectionItemTypeArtist:  "artist",
+	CollectionItemTypeAlbum:   "album",
+	CollectionItemTypeTrack:   "track",
+}
+
+// collectionItemTypeFromName returns the metadata type for a Plex type name if a collection can
+// hold it, or 0
+func collectionItemTypeFromName(name string) MetadataType {
+	if itemType := metadataTypeFromName(name); CollectionItemTypeKeys[itemType] != "" {
+		return itemType
+	}
+	return 0
+}
+
+// collectionItemFamily returns the top-level type for an item type. Seasons and episodes
+// belong to shows, so they can share a collection; albums and tracks belong to artists.
+func collectionItemFamily(itemType MetadataType) MetadataType {
+	switch itemType {
+	case CollectionItemTypeShow, CollectionItemTypeSeason, CollectionItemTypeEpisode:
+		return CollectionItemTypeShow
+	case CollectionItemTypeArtist, CollectionItemTypeAlbum, CollectionItemTypeTrack:
+		return CollectionItemTypeArtist
+	default:
+		return itemType
+	}
+}
+
+// validateCollectionItemTypes checks that all items are known types from the same library family.
+// Music collections are typed by their members, so artists, albums and tracks can't share one.
+func validateCollectionItemTypes(itemTypes map[string]MetadataType) error {
+	var family, first MetadataType
+	for ratingKey, itemType := range itemTypes {
+		if _, ok := CollectionItemTypeKeys[itemType]; !ok {
+			return invalidArgument("item %s has a type that cannot be added to a collection", ratingKey)
+		}
+
+		if family == 0 {
+			family, first = collectionItemFamily(itemType), itemType
+		} else if collectionItemFamily(itemType) != family {
+			return invalidArgument("cannot mix %s items with %s items in a collection", CollectionItemTypeKeys[itemType], CollectionItemTypeKeys[family])
+		} else if family == CollectionItemTypeArtist && itemType != first {
+			return invalidArgument("cannot mix %s items with %s items in a music collection", CollectionItemTypeKeys[itemType], CollectionItemTypeKeys[first])
+		}
+	}
+	return nil
+}
+
+// collectionAccepts reports whether an item of itemType can be added to a collection of subType.
+// Show collections take any show, season or episode; music collections only take their own type.
+func collectionAccepts(subType MetadataType, itemType MetadataType) bool {
+	if collectionItemFamily(subType) == CollectionItemTypeArtist {
+		return itemType == subType
+	}
+	return collectionItemFamily(itemType) == collectionItemFamily(subType)
+}
+
 // Collections provides operations for working with collections
 type Collections struct {
 	sdkConfiguration sdkConfiguration
@@ -155,6 +416,13 @@ func (s *Collections) GetAllCollections(ctx context.Context, sectionID int, opts
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
@@ -162,6 +430,7 @@ func (s *Collections) GetAllCollections(ctx context.Context, sectionID int, opts
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return nil, err
@@ -187,7 +456,7 @@ func (s *Collections) GetAllCollections(ctx context.Context, sectionID int, opts
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -201,13 +470,23 @@ func (s *Collections) GetAllCollections(ctx context.Context, sectionID int, opts
 	}
 
 	var out CollectionResponse
-	if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+	if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 		return nil, err
 	}
 
 	return out.MediaContainer.Metadata, nil
 }
 
+// GetCollectionByKey gets a collection by its rating key as returned by the API, e.g. a
+// Collection's RatingKey
+func (s *Collections) GetCollectionByKey(ctx context.Context, ratingKey string, opts ...operations.Option) (*Collection, error) {
+	collectionID, err := ParseCollectionID(ratingKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCollection(ctx, collectionID, opts...)
+}
+
 // GetCollection gets a collection by ID
 func (s *Collections) GetCollection(ctx context.Context, collectionID int, opts ...operations.Option) (*Collection, error) {
 	options := processOptions(opts)
@@ -233,6 +512,13 @@ func (s *Collections) GetCollection(ctx context.Context, collectionID int, opts
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
@@ -240,6 +526,7 @@ func (s *Collections) GetCollection(ctx context.Context, collectionID int, opts
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return nil, err
@@ -265,7 +552,7 @@ func (s *Collections) GetCollection(ctx context.Context, collectionID int, opts
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -279,7 +566,7 @@ func (s *Collections) GetCollection(ctx context.Context, collectionID int, opts
 	}
 
 	var out CollectionResponse
-	if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+	if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 		return nil, err
 	}
 
@@ -344,6 +631,13 @@ func (s *Collections) GetCollectionItems(ctx context.Context, collectionID int,
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
@@ -351,6 +645,7 @@ func (s *Collections) GetCollectionItems(ctx context.Context, collectionID int,
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return nil, err
@@ -376,7 +671,7 @@ func (s *Collections) GetCollectionItems(ctx context.Context, collectionID int,
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -390,7 +685,7 @@ func (s *Collections) GetCollectionItems(ctx context.Context, collectionID int,
 	}
 
 	var out CollectionResponse
-	if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+	if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 		return nil, err
 	}
 
@@ -402,8 +697,34 @@ func (s *Collections) GetCollectionItems(ctx context.Context, collectionID int,
 	return items, nil
 }
 
-// CreateCollection creates a new collection with the given items
+// CreateCollection creates a new collection with the given items. The collection type is
+// derived from the items, so show libraries can hold shows, seasons and episodes.
 func (s *Collections) CreateCollection(ctx context.Context, sectionID int, title string, itemIDs []string, opts ...operations.Option) (*Collection, error) {
+	itemType := CollectionItemTypeMovie // Default to movie type for empty collections
+	if len(itemIDs) > 0 {
+		itemTypes, err := s.getItemTypes(ctx, itemIDs, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting item types: %w", err)
+		}
+
+		if err := validateCollectionItemTypes(itemTypes); err != nil {
+			return nil, err
+		}
+
+		// Plex types the collection after its first member
+		itemType = itemTypes[itemIDs[0]]
+	}
+
+	return s.CreateCollectionOfType(ctx, sectionID, title, itemType, itemIDs, opts...)
+}
+
+// CreateCollectionOfType creates a new collection with an explicit item type, e.g.
+// CollectionItemTypeShow for an empty collection in a TV library
+func (s *Collections) CreateCollectionOfType(ctx context.Context, sectionID int, title string, itemType MetadataType, itemIDs []string, opts ...operations.Option) (*Collection, error) {
+	if _, ok := CollectionItemTypeKeys[itemType]; !ok {
+		return nil, invalidArgument("invalid collection item type: %d", itemType)
+	}
+
 	options := processOptions(opts)
 
 	var baseURL string
@@ -428,21 +749,19 @@ func (s *Collections) CreateCollection(ctx context.Context, sectionID int, title
 	}
 
 	queryParams := url.Values{}
-	queryParams.Add("type", "1") // Default to movie type
+	queryParams.Add("type", strconv.Itoa(int(itemType)))
 	queryParams.Add("title", title)
 	queryParams.Add("smart", "0")
 	queryParams.Add("sectionId", strconv.Itoa(sectionID))
 
 	// Add item IDs as a comma-separated list
 	if len(itemIDs) > 0 {
-		itemList := ""
-		for i, id := range itemIDs {
-			if i > 0 {
-				itemList += ","
-			}
-			itemList += id
+		identity, err := newServer(s.sdkConfiguration).GetIdentity(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting server identity: %w", err)
 		}
-		// queryParams.Add("uri", fmt.Sprintf("server://%s/com.plexapp.plugins.library/library/metadata/%s", s.sdkConfiguration.GetServerMachineID(), itemList))
+
+		queryParams.Add("uri", fmt.Sprintf("%s/library/metadata/%s", s.sdkConfiguration.GetURIRoot(identity.MachineIdentifier), strings.Join(itemIDs, ",")))
 	} else {
 		// Empty collection
 		queryParams.Add("uri", fmt.Sprintf("%s/library/metadata", baseURL))
@@ -450,6 +769,13 @@ func (s *Collections) CreateCollection(ctx context.Context, sectionID int, title
 
 	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "POST", opURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
@@ -457,32 +783,28 @@ func (s *Collections) CreateCollection(ctx context.Context, sectionID int, title
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return nil, err
 	}
 
-	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
-	if err != nil {
-		return nil, err
+	// Creating a collection isn't idempotent, so it is only retried with a policy of its own or an
+	// idempotency check that finds the collection a failed attempt created
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, options.Retries)
+	if options.Idempotency != nil {
+		retryConfig = s.sdkConfiguration.retryPolicyFor(hookCtx.OperationID, req.Method, options.Retries)
 	}
 
-	httpRes, err := s.sdkConfiguration.Client.Do(req)
-	if err != nil || httpRes == nil {
-		if err != nil {
-			err = fmt.Errorf("error sending request: %w", err)
-		} else {
-			err = fmt.Errorf("error sending request: no response")
-		}
-
-		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+	httpRes, err := s.sdkConfiguration.send(ctx, hookCtx, req, retryConfig, options.Idempotency)
+	if err != nil {
 		return nil, err
 	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -495,17 +817,9 @@ func (s *Collections) CreateCollection(ctx context.Context, sectionID int, title
 	// Try to get the collection ID from the Location header first
 	location := httpRes.Header.Get("Location")
 	if location != "" {
-		// Location header should be something like /library/collections/12345
-		// Extract the ID
-		collectionIDStr := ""
-		_, err = fmt.Sscanf(location, "/library/collections/%s", &collectionIDStr)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing collection ID from location header: %w", err)
-		}
-
-		collectionID, err = strconv.Atoi(collectionIDStr)
+		collectionID, err = collectionIDFromLocation(location)
 		if err != nil {
-			return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+			return nil, err
 		}
 	} else {
 		// If no Location header, try to parse the response body
@@ -515,7 +829,7 @@ func (s *Collections) CreateCollection(ctx context.Context, sectionID int, title
 		}
 
 		var resp CollectionResponse
-		if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &resp, ""); err != nil {
+		if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &resp); err != nil {
 			return nil, err
 		}
 
@@ -530,16 +844,18 @@ func (s *Collections) CreateCollection(ctx context.Context, sectionID int, title
 		}
 	}
 
-	// Add a delay to allow Plex to process the changes
+	// Wait for Plex to process the changes
 	// This improves reliability when immediately checking collection contents after creation/modification
-	time.Sleep(2 * time.Second)
+	if err := settleWrite(ctx, s.sdkConfiguration, httpRes.Header.Get(activityHeader), opts); err != nil {
+		return nil, err
+	}
 
 	// Get the created collection
 	return s.GetCollection(ctx, collectionID, opts...)
 }
 
 // CreateSmartCollection creates a new smart collection with the given filter
-func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int, title string, smartType int, filterArgs string, opts ...operations.Option) (*Collection, error) {
+func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int, title string, smartType MetadataType, filterArgs string, opts ...operations.Option) (*Collection, error) {
 	options := processOptions(opts)
 
 	// Ensure filterArgs has a leading ? if not already present
@@ -547,6 +863,10 @@ func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int,
 		filterArgs = "?" + filterArgs
 	}
 
+	if err := validateSmartFilterArgs(smartType, filterArgs); err != nil {
+		return nil, err
+	}
+
 	// Test the smart filter first to ensure it returns results
 	hasResults, err := s.TestSmartFilter(ctx, sectionID, filterArgs, opts...)
 	if err != nil {
@@ -581,17 +901,27 @@ func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int,
 	}
 
 	queryParams := url.Values{}
-	queryParams.Add("type", strconv.Itoa(smartType))
+	queryParams.Add("type", strconv.Itoa(int(smartType)))
 	queryParams.Add("title", title)
 	queryParams.Add("smart", "1")
 	queryParams.Add("sectionId", strconv.Itoa(sectionID))
 
-	// Build the smart filter URI using our helper method
-	uri := s.BuildSmartFilterURI(sectionID, filterArgs, opts...)
+	// Build the smart filter URI in the configured style
+	uri, err := s.buildSmartFilterURI(ctx, sectionID, filterArgs, opts...)
+	if err != nil {
+		return nil, err
+	}
 	queryParams.Add("uri", uri)
 
 	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "POST", opURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
@@ -599,6 +929,7 @@ func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int,
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return nil, err
@@ -624,7 +955,7 @@ func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int,
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -637,17 +968,9 @@ func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int,
 	// Try to get the collection ID from the Location header first
 	location := httpRes.Header.Get("Location")
 	if location != "" {
-		// Location header should be something like /library/collections/12345
-		// Extract the ID
-		collectionIDStr := ""
-		_, err = fmt.Sscanf(location, "/library/collections/%s", &collectionIDStr)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing collection ID from location header: %w", err)
-		}
-
-		collectionID, err = strconv.Atoi(collectionIDStr)
+		collectionID, err = collectionIDFromLocation(location)
 		if err != nil {
-			return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+			return nil, err
 		}
 	} else {
 		// If no Location header, try to parse the response body
@@ -657,7 +980,7 @@ func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int,
 		}
 
 		var resp CollectionResponse
-		if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &resp, ""); err != nil {
+		if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &resp); err != nil {
 			return nil, err
 		}
 
@@ -672,102 +995,835 @@ func (s *Collections) CreateSmartCollection(ctx context.Context, sectionID int,
 		}
 	}
 
-	// Add a delay to allow Plex to process the changes
+	// Wait for Plex to process the changes
 	// This improves reliability when immediately checking collection contents after creation/modification
-	time.Sleep(2 * time.Second)
+	if err := settleWrite(ctx, s.sdkConfiguration, httpRes.Header.Get(activityHeader), opts); err != nil {
+		return nil, err
+	}
 
 	// Get the created collection
 	return s.GetCollection(ctx, collectionID, opts...)
 }
 
-// DeleteCollection deletes a collection
-func (s *Collections) DeleteCollection(ctx context.Context, collectionID int, opts ...operations.Option) error {
-	options := processOptions(opts)
+// CreateCollectionOptions holds settings applied to a collection right after it is created.
+// Empty fields are left at the Plex defaults.
+type CreateCollectionOptions struct {
+	Mode       string                // One of the CollectionMode constants
+	Sort       string                // One of the CollectionSort constants
+	Visibility *CollectionVisibility // Library, home and shared home promotion
+	PosterURL  string                // Remote image to use as the collection poster
+	Summary    string                // Collection summary, locked against agent refreshes
+
+	// Idempotent makes creation safe to retry: if a collection with exactly the same title already
+	// exists in the section it is reused (and the settings above re-applied) instead of creating a duplicate.
+	// It also lets the SDK's retry policies retry the creation, checking first whether a failed
+	// attempt created the collection.
+	Idempotent bool
+	// IdempotencyKey, when set with Idempotent, only reuses collections carrying this label, and
+	// labels newly created collections with it
+	IdempotencyKey string
+}
 
-	var baseURL string
-	if options.ServerURL == nil {
-		serverURL, params := s.sdkConfiguration.GetServerDetails()
-		baseURL = utils.ReplaceParameters(serverURL, params)
-	} else {
-		baseURL = *options.ServerURL
-	}
+// CreateCollectionWithOptions creates a new collection and applies the given settings to it
+func (s *Collections) CreateCollectionWithOptions(ctx context.Context, sectionID int, title string, itemIDs []string, createOptions CreateCollectionOptions, opts ...operations.Option) (*Collection, error) {
+	ctx = withOperationCorrelationID(ctx)
 
-	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d", collectionID))
-	if err != nil {
-		return fmt.Errorf("error generating URL: %w", err)
+	if createOptions.Idempotent {
+		existing, err := s.findIdempotentCollection(ctx, sectionID, title, createOptions.IdempotencyKey, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return s.applyCreateOptions(ctx, sectionID, existing, createOptions, opts...)
+		}
 	}
 
-	hookCtx := hooks.HookContext{
-		BaseURL:        baseURL,
-		Context:        ctx,
-		OperationID:    "deleteCollection",
-		OAuth2Scopes:   []string{},
-		SecuritySource: s.sdkConfiguration.Security,
+	createOpts := opts
+	var created *Collection
+	if createOptions.Idempotent {
+		check, err := s.createdCollectionCheck(ctx, sectionID, title, &created, opts...)
+		if err != nil {
+			return nil, err
+		}
+		createOpts = append(append([]operations.Option{}, opts...), WithIdempotency(check))
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "DELETE", opURL, nil)
-	if err != nil {
-		return fmt.Errorf("error creating request: %w", err)
+	collection, err := s.CreateCollection(ctx, sectionID, title, itemIDs, createOpts...)
+	if errors.Is(err, ErrAlreadyApplied) && created != nil {
+		collectionID, err := created.ID()
+		if err != nil {
+			return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+		}
+		collection, err = s.GetCollection(ctx, collectionID, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
 	}
 
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	return s.applyCreateOptions(ctx, sectionID, collection, createOptions, opts...)
+}
 
-	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
-		return err
+// createdCollectionCheck returns an idempotency check for creating a collection: it looks for a
+// collection with the title that didn't exist when the check was made, i.e. one created by an
+// attempt whose response was lost, and stores it in created
+func (s *Collections) createdCollectionCheck(ctx context.Context, sectionID int, title string, created **Collection, opts ...operations.Option) (IdempotencyFunc, error) {
+	collections, err := s.GetAllCollections(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error checking for an existing collection: %w", err)
 	}
 
-	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
-	if err != nil {
-		return err
+	existing := map[string]bool{}
+	for _, collection := range collections {
+		if collection.Title == title {
+			existing[collection.RatingKey] = true
+		}
 	}
 
-	httpRes, err := s.sdkConfiguration.Client.Do(req)
-	if err != nil || httpRes == nil {
+	return func(ctx context.Context) (bool, error) {
+		collections, err := s.GetAllCollections(ctx, sectionID, opts...)
 		if err != nil {
-			err = fmt.Errorf("error sending request: %w", err)
-		} else {
-			err = fmt.Errorf("error sending request: no response")
+			return false, err
 		}
 
-		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
-		return err
-	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
-		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
-		if err != nil {
-			return err
+		for i := range collections {
+			if collections[i].Title == title && !existing[collections[i].RatingKey] {
+				*created = &collections[i]
+				return true, nil
+			}
 		}
-		return sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
-	} else {
-		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		return false, nil
+	}, nil
+}
+
+// CreateSmartCollectionWithOptions creates a new smart collection and applies the given settings to it
+func (s *Collections) CreateSmartCollectionWithOptions(ctx context.Context, sectionID int, title string, smartType MetadataType, filterArgs string, createOptions CreateCollectionOptions, opts ...operations.Option) (*Collection, error) {
+	ctx = withOperationCorrelationID(ctx)
+
+	if createOptions.Idempotent {
+		existing, err := s.findIdempotentCollection(ctx, sectionID, title, createOptions.IdempotencyKey, opts...)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if existing != nil {
+			return s.applyCreateOptions(ctx, sectionID, existing, createOptions, opts...)
 		}
 	}
 
-	// Add a delay to allow Plex to process the deletion
-	// This improves reliability when immediately checking collection status after deletion
-	time.Sleep(2 * time.Second)
+	collection, err := s.CreateSmartCollection(ctx, sectionID, title, smartType, filterArgs, opts...)
+	if err != nil {
+		return nil, err
+	}
 
-	return nil
+	return s.applyCreateOptions(ctx, sectionID, collection, createOptions, opts...)
 }
 
-// AddToCollection adds items to a collection
-func (s *Collections) AddToCollection(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error {
-	// First, get the collection to check if it's a smart collection
-	collection, err := s.GetCollection(ctx, collectionID, opts...)
+// applyCreateOptions applies the settings of createOptions to a newly created collection and
+// returns the updated collection. If a setting fails the collection is returned with the error.
+func (s *Collections) applyCreateOptions(ctx context.Context, sectionID int, collection *Collection, createOptions CreateCollectionOptions, opts ...operations.Option) (*Collection, error) {
+	collectionID, err := collection.ID()
 	if err != nil {
-		return fmt.Errorf("error getting collection: %w", err)
+		return collection, fmt.Errorf("error converting collection ID to int: %w", err)
 	}
 
-	// Check if it's a smart collection - cannot manually add items to smart collections
-	if collection.IsSmartCollection() {
-		return fmt.Errorf("cannot manually add items to a smart collection")
+	if createOptions.Mode != "" {
+		if _, err := s.UpdateCollectionMode(ctx, collectionID, createOptions.Mode, opts...); err != nil {
+			return collection, fmt.Errorf("error setting collection mode: %w", err)
+		}
+	}
+
+	if createOptions.Sort != "" {
+		if _, err := s.UpdateCollectionSort(ctx, collectionID, createOptions.Sort, opts...); err != nil {
+			return collection, fmt.Errorf("error setting collection sort: %w", err)
+		}
+	}
+
+	if createOptions.Visibility != nil {
+		if err := s.UpdateCollectionVisibility(ctx, sectionID, collectionID, createOptions.Visibility, opts...); err != nil {
+			return collection, fmt.Errorf("error setting collection visibility: %w", err)
+		}
+	}
+
+	if createOptions.PosterURL != "" {
+		library := newLibrary(s.sdkConfiguration)
+		if _, err := library.PostMediaPoster(ctx, int64(collectionID), &createOptions.PosterURL, nil, opts...); err != nil {
+			return collection, fmt.Errorf("error setting collection poster: %w", err)
+		}
+	}
+
+	if createOptions.Summary != "" {
+		args := map[string]string{
+			"summary.value":  createOptions.Summary,
+			"summary.locked": "1",
+		}
+		if err := s.editCollection(ctx, collectionID, "updateCollectionSummary", args, opts...); err != nil {
+			return collection, fmt.Errorf("error setting collection summary: %w", err)
+		}
+	}
+
+	if createOptions.IdempotencyKey != "" {
+		if err := s.addCollectionLabel(ctx, collection, createOptions.IdempotencyKey, opts...); err != nil {
+			return collection, fmt.Errorf("error labeling collection: %w", err)
+		}
+	}
+
+	return s.GetCollection(ctx, collectionID, opts...)
+}
+
+// findIdempotentCollection finds an existing collection in the section with exactly the given
+// title and, if idempotencyKey is set, carrying it as a label. It returns nil if there is none.
+func (s *Collections) findIdempotentCollection(ctx context.Context, sectionID int, title string, idempotencyKey string, opts ...operations.Option) (*Collection, error) {
+	collections, err := s.GetAllCollections(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error checking for an existing collection: %w", err)
+	}
+
+	for _, candidate := range collections {
+		if candidate.Title != title {
+			continue
+		}
+
+		collectionID, err := candidate.ID()
+		if err != nil {
+			return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+		}
+
+		// Section listings don't include labels, so read the full collection
+		collection, err := s.GetCollection(ctx, collectionID, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error checking for an existing collection: %w", err)
+		}
+
+		if idempotencyKey == "" || collectionHasLabel(collection, idempotencyKey) {
+			return collection, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// collectionHasLabel returns true if the collection carries the given label
+func collectionHasLabel(collection *Collection, label string) bool {
+	for _, tag := range collection.Label {
+		if tag.Tag == label {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteCollectionByKey deletes a collection by its rating key as returned by the API
+func (s *Collections) DeleteCollectionByKey(ctx context.Context, ratingKey string, opts ...operations.Option) error {
+	collectionID, err := ParseCollectionID(ratingKey)
+	if err != nil {
+		return err
+	}
+	return s.DeleteCollection(ctx, collectionID, opts...)
+}
+
+// DeleteCollection deletes a collection. With WithCollectionRecycleBin the collection is first
+// exported to the recycle bin, and not deleted if that fails.
+func (s *Collections) DeleteCollection(ctx context.Context, collectionID int, opts ...operations.Option) error {
+	if err := s.checkUnmodified(ctx, collectionID, opts...); err != nil {
+		return err
+	}
+
+	if s.sdkConfiguration.CollectionRecycleBin != "" {
+		if _, err := s.recycleCollection(ctx, collectionID, opts...); err != nil {
+			return fmt.Errorf("error exporting collection %d before deleting it: %w", collectionID, err)
+		}
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d", collectionID))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "deleteCollection",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "DELETE", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Wait for Plex to process the deletion
+	// This improves reliability when immediately checking collection status after deletion
+	return settleWrite(ctx, s.sdkConfiguration, httpRes.Header.Get(activityHeader), opts)
+}
+
+// AddToCollection adds items to a collection. Long lists of items are added in chunks of 100, or
+// the size set with WithChunkSize, so the request URLs stay within server limits; if a chunk
+// fails after others were added, a *PartialError tells which items were added.
+func (s *Collections) AddToCollection(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error {
+	ctx, finish, err := s.sdkConfiguration.track(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+
+	// First, get the collection to check if it's a smart collection
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting collection: %w", err)
+	}
+
+	if err := checkCollectionUnmodified(collection, opts); err != nil {
+		return err
+	}
+
+	// The members of smart collections come from their filter and can't be changed manually
+	if !collection.CanMutateMembers() {
+		return &SmartCollectionError{CollectionID: collectionID, Operation: "add"}
+	}
+
+	// If no items to add, return early
+	if len(itemIDs) == 0 {
+		return nil
+	}
+
+	options := processOptions(opts)
+	chunks := chunkItems(itemIDs, options)
+
+	// Make sure the new items belong with the existing members, e.g. episodes into a show collection
+	if subType := collectionItemTypeFromName(collection.SubType); subType != 0 {
+		itemTypes := map[string]MetadataType{}
+		for _, chunk := range chunks {
+			chunkTypes, err := s.getItemTypes(ctx, chunk, opts...)
+			if err != nil {
+				return fmt.Errorf("error getting item types: %w", err)
+			}
+			for ratingKey, itemType := range chunkTypes {
+				itemTypes[ratingKey] = itemType
+			}
+		}
+
+		if err := validateCollectionItemTypes(itemTypes); err != nil {
+			return err
+		}
+
+		for ratingKey, itemType := range itemTypes {
+			if !collectionAccepts(subType, itemType) {
+				return invalidArgument("cannot add %s item %s to a %s collection", CollectionItemTypeKeys[itemType], ratingKey, collection.SubType)
+			}
+		}
+	}
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	// Build the metadata URI - first get the server machine ID
+	identity, err := newServer(s.sdkConfiguration).GetIdentity(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting server identity: %w", err)
+	}
+
+	done := 0
+	var activityID string
+	for _, chunk := range chunks {
+		activityID, err = s.addToCollectionChunk(ctx, baseURL, identity.MachineIdentifier, collectionID, chunk, options)
+		if err != nil {
+			// The items of the failed chunk can't be reported as completed. Adding items that are
+			// already in the collection is harmless, so all remaining items can be retried.
+			if done > 0 || ctx.Err() != nil {
+				return newPartialError(itemIDs, done, err)
+			}
+			return err
+		}
+
+		done += len(chunk)
+		if len(chunks) > 1 {
+			reportProgress(options, done, len(itemIDs), "adding items")
+		}
+	}
+
+	// Wait for Plex to process the changes
+	// This improves reliability when immediately checking collection contents after modification
+	return settleWrite(ctx, s.sdkConfiguration, activityID, opts)
+}
+
+// addToCollectionChunk adds a chunk of items to a collection in a single request, returning the
+// server activity the request started, if any
+func (s *Collections) addToCollectionChunk(ctx context.Context, baseURL string, machineID string, collectionID int, itemIDs []string, options *helperOptions) (string, error) {
+	// Join rating keys into comma-separated string
+	ratingKeys := strings.Join(itemIDs, ",")
+
+	// Build the complete URL
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/items", collectionID))
+	if err != nil {
+		return "", fmt.Errorf("error generating URL: %w", err)
+	}
+
+	// Create the URI using the server://{machineId}/com.plexapp.plugins.library format
+	uri := fmt.Sprintf("server://%s/com.plexapp.plugins.library/library/metadata/%s", machineID, ratingKeys)
+
+	// Add the URI as a query parameter
+	queryParams := url.Values{}
+	queryParams.Add("uri", uri)
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	// Set up the request context
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "addToCollection",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	// Create the request
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return "", err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return "", err
+	}
+
+	// Send the request
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return "", err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return "", err
+		}
+		return "", sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return httpRes.Header.Get(activityHeader), nil
+}
+
+// RemoveFromCollection removes items from a collection
+func (s *Collections) RemoveFromCollection(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error {
+	ctx, finish, err := s.sdkConfiguration.track(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+
+	// First, get the collection to check if it's a smart collection
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting collection: %w", err)
+	}
+
+	if err := checkCollectionUnmodified(collection, opts); err != nil {
+		return err
+	}
+
+	// The members of smart collections come from their filter and can't be changed manually
+	if !collection.CanMutateMembers() {
+		return &SmartCollectionError{CollectionID: collectionID, Operation: "remove"}
+	}
+
+	// If no items to remove, return early
+	if len(itemIDs) == 0 {
+		return nil
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "removeFromCollection",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	// Process each item to remove separately with a DELETE request
+	reportProgress(options, 0, len(itemIDs), "removing items")
+	for i, itemID := range itemIDs {
+		if ctx.Err() != nil {
+			return newPartialError(itemIDs, i, ctx.Err())
+		}
+
+		if err := s.removeCollectionItem(ctx, hookCtx, baseURL, collectionID, itemID, options); err != nil {
+			if ctx.Err() != nil {
+				return newPartialError(itemIDs, i, err)
+			}
+			return err
+		}
+
+		reportProgress(options, i+1, len(itemIDs), "removing items")
+	}
+
+	// Wait for Plex to process the changes. Removing items one at a time doesn't start an activity.
+	// This improves reliability when immediately checking collection contents after modification
+	return settleWrite(ctx, s.sdkConfiguration, "", opts)
+}
+
+// removeCollectionItem removes a single item from a collection
+func (s *Collections) removeCollectionItem(ctx context.Context, hookCtx hooks.HookContext, baseURL string, collectionID int, itemID string, options *helperOptions) error {
+	// Build the endpoint URL for removing this specific item
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/items/%s", collectionID, itemID))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "DELETE", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		// Don't return an error for 404, it just means the item wasn't in the collection
+		if httpRes.StatusCode != 404 {
+			return sdkerrors.NewAPIErrorFromResponse(httpRes)
+		}
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MoveCollectionItem moves an item to a new position in the collection
+func (s *Collections) MoveCollectionItem(ctx context.Context, collectionID int, itemID string, afterItemID string, opts ...operations.Option) error {
+	// First, get the collection to check if it's a smart collection
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting collection: %w", err)
+	}
+
+	if err := checkCollectionUnmodified(collection, opts); err != nil {
+		return err
+	}
+
+	// The members of smart collections come from their filter and can't be changed manually
+	if !collection.CanMutateMembers() {
+		return &SmartCollectionError{CollectionID: collectionID, Operation: "move"}
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	// Build the base URL for the move operation
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/items/%s/move", collectionID, itemID))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	// Add the after parameter if specified
+	if afterItemID != "" {
+		queryParams := url.Values{}
+		queryParams.Add("after", afterItemID)
+		opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "moveCollectionItem",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Wait for Plex to process the changes
+	return settleWrite(ctx, s.sdkConfiguration, httpRes.Header.Get(activityHeader), opts)
+}
+
+// UpdateCollectionMode updates the mode of a collection and returns it, e.g. CollectionModeHide.
+// With WithVerify the mode read back from the collection's preferences is returned, along with a
+// *CollectionSettingError if it isn't the requested one.
+func (s *Collections) UpdateCollectionMode(ctx context.Context, collectionID int, mode string, opts ...operations.Option) (string, error) {
+	if err := s.checkUnmodified(ctx, collectionID, opts...); err != nil {
+		return "", err
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/prefs", collectionID))
+	if err != nil {
+		return "", fmt.Errorf("error generating URL: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "updateCollectionMode",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	// Translate string mode to numeric mode
+	modeValue := "-1" // default
+	for k, v := range CollectionModeKeys {
+		if v == mode {
+			modeValue = strconv.Itoa(k)
+			break
+		}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("collectionMode", modeValue)
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
 	}
 
-	// If no items to add, return early
-	if len(itemIDs) == 0 {
-		return nil
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return "", err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return "", err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return "", err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return "", err
+		}
+		return "", sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	requested := normalizeCollectionSetting(modeValue, CollectionModeKeys)
+	if options.Verify {
+		return s.verifyCollectionSetting(ctx, collectionID, "collectionMode", requested, CollectionModeKeys, opts...)
+	}
+
+	return requested, nil
+}
+
+// UpdateCollectionSort updates the sort order of a collection and returns it, e.g.
+// CollectionSortAlpha. With WithVerify the sort order read back from the collection's preferences
+// is returned, along with a *CollectionSettingError if it isn't the requested one.
+func (s *Collections) UpdateCollectionSort(ctx context.Context, collectionID int, sort string, opts ...operations.Option) (string, error) {
+	if err := s.checkUnmodified(ctx, collectionID, opts...); err != nil {
+		return "", err
 	}
 
 	options := processOptions(opts)
@@ -780,63 +1836,57 @@ func (s *Collections) AddToCollection(ctx context.Context, collectionID int, ite
 		baseURL = *options.ServerURL
 	}
 
-	// Join rating keys into comma-separated string
-	ratingKeys := strings.Join(itemIDs, ",")
-
-	// Build the metadata URI - first get the server machine ID
-	serverIdentity, err := s.getServerIdentity(ctx)
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/prefs", collectionID))
 	if err != nil {
-		return fmt.Errorf("error getting server identity: %w", err)
+		return "", fmt.Errorf("error generating URL: %w", err)
 	}
 
-	if serverIdentity.Object == nil || serverIdentity.Object.MediaContainer == nil || serverIdentity.Object.MediaContainer.MachineIdentifier == nil {
-		return fmt.Errorf("could not get server machine identifier")
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "updateCollectionSort",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	machineID := *serverIdentity.Object.MediaContainer.MachineIdentifier
-
-	// Build the complete URL
-	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/items", collectionID))
-	if err != nil {
-		return fmt.Errorf("error generating URL: %w", err)
+	// Translate string sort to numeric sort
+	sortValue := "0" // default release
+	for k, v := range CollectionSortKeys {
+		if v == sort {
+			sortValue = strconv.Itoa(k)
+			break
+		}
 	}
 
-	// Create the URI using the server://{machineId}/com.plexapp.plugins.library format
-	uri := fmt.Sprintf("server://%s/com.plexapp.plugins.library/library/metadata/%s", machineID, ratingKeys)
-
-	// Add the URI as a query parameter
 	queryParams := url.Values{}
-	queryParams.Add("uri", uri)
+	queryParams.Add("collectionSort", sortValue)
 	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
 
-	// Set up the request context
-	hookCtx := hooks.HookContext{
-		BaseURL:        baseURL,
-		Context:        ctx,
-		OperationID:    "addToCollection",
-		OAuth2Scopes:   []string{},
-		SecuritySource: s.sdkConfiguration.Security,
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
 	}
 
-	// Create the request
 	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
 	if err != nil {
-		return fmt.Errorf("error creating request: %w", err)
+		return "", fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
-		return err
+		return "", err
 	}
 
 	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	// Send the request
 	httpRes, err := s.sdkConfiguration.Client.Do(req)
 	if err != nil || httpRes == nil {
 		if err != nil {
@@ -846,133 +1896,239 @@ func (s *Collections) AddToCollection(ctx context.Context, collectionID int, ite
 		}
 
 		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
-		return err
+		return "", err
 	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
 		if err != nil {
-			return err
+			return "", err
 		}
-		return sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return "", sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
-	// Add a delay to allow Plex to process the changes
-	// This improves reliability when immediately checking collection contents after modification
-	time.Sleep(2 * time.Second)
+	requested := normalizeCollectionSetting(sortValue, CollectionSortKeys)
+	if options.Verify {
+		return s.verifyCollectionSetting(ctx, collectionID, "collectionSort", requested, CollectionSortKeys, opts...)
+	}
 
-	return nil
+	return requested, nil
 }
 
-// RemoveFromCollection removes items from a collection
-func (s *Collections) RemoveFromCollection(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error {
-	// First, get the collection to check if it's a smart collection
-	collection, err := s.GetCollection(ctx, collectionID, opts...)
-	if err != nil {
-		return fmt.Errorf("error getting collection: %w", err)
-	}
+// collectionVerifyAttempts and collectionVerifyInterval control how often WithVerify re-reads a
+// collection's preferences, since the server doesn't always apply a change immediately
+var (
+	collectionVerifyAttempts = 3
+	collectionVerifyInterval = time.Second
+)
 
-	// Check if it's a smart collection - cannot manually remove items from smart collections
-	if collection.IsSmartCollection() {
-		return fmt.Errorf("cannot manually remove items from a smart collection")
+// ErrSettingNotApplied is returned by collection setting updates made with WithVerify when the
+// server's preferences don't reflect the change
+var ErrSettingNotApplied = errors.New("collection setting was not applied")
+
+// CollectionSettingError reports the effective value of a collection setting that WithVerify found
+// had not been applied. It matches ErrSettingNotApplied with errors.Is.
+type CollectionSettingError struct {
+	CollectionID int
+	Setting      string // e.g. "collectionMode"
+	Requested    string
+	Effective    string
+}
+
+func (e *CollectionSettingError) Error() string {
+	return fmt.Sprintf("%s of collection %d is %q, expected %q", e.Setting, e.CollectionID, e.Effective, e.Requested)
+}
+
+func (e *CollectionSettingError) Is(target error) bool {
+	return target == ErrSettingNotApplied
+}
+
+// WithVerify makes UpdateCollectionMode and UpdateCollectionSort re-read the collection's
+// preferences after the update and return the effective value, with a *CollectionSettingError if
+// the change was not applied
+func WithVerify() operations.Option {
+	return helperOption(func(opts *helperOptions) {
+		opts.Verify = true
+	})
+}
+
+// CollectionPreference is a setting in the preferences of a collection. Values are returned as
+// strings whichever JSON type the server used.
+type CollectionPreference struct {
+	ID         string `json:"id"`
+	Label      string `json:"label"`
+	Summary    string `json:"summary"`
+	Type       string `json:"type"` // e.g. "int", "text" or "bool"
+	Default    string `json:"default"`
+	Value      string `json:"value"`
+	Hidden     bool   `json:"hidden"`
+	Advanced   bool   `json:"advanced"`
+	Group      string `json:"group"`
+	EnumValues string `json:"enumValues"` // Allowed values, e.g. "0:Release date|1:Alphabetical"
+}
+
+// UnmarshalJSON decodes a preference, converting numeric and boolean values and defaults to strings
+func (p *CollectionPreference) UnmarshalJSON(data []byte) error {
+	type preferenceAlias CollectionPreference
+	aux := struct {
+		*preferenceAlias
+		Default interface{} `json:"default"`
+		Value   interface{} `json:"value"`
+	}{preferenceAlias: (*preferenceAlias)(p)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
 
-	// If no items to remove, return early
-	if len(itemIDs) == 0 {
-		return nil
+	p.Default = preferenceString(aux.Default)
+	p.Value = preferenceString(aux.Value)
+	return nil
+}
+
+// CollectionPreferences are the preferences of a collection, as returned by GetPreferences
+type CollectionPreferences struct {
+	Settings []CollectionPreference
+}
+
+// Get returns the value of a preference by ID
+func (p *CollectionPreferences) Get(id string) (string, bool) {
+	for _, setting := range p.Settings {
+		if setting.ID == id {
+			return setting.Value, true
+		}
 	}
+	return "", false
+}
 
-	options := processOptions(opts)
+// Mode returns the collection mode as a CollectionMode constant
+func (p *CollectionPreferences) Mode() string {
+	value, _ := p.Get("collectionMode")
+	return normalizeCollectionSetting(value, CollectionModeKeys)
+}
 
-	var baseURL string
-	if options.ServerURL == nil {
-		serverURL, params := s.sdkConfiguration.GetServerDetails()
-		baseURL = utils.ReplaceParameters(serverURL, params)
-	} else {
-		baseURL = *options.ServerURL
+// Sort returns the collection sort as a CollectionSort constant
+func (p *CollectionPreferences) Sort() string {
+	value, _ := p.Get("collectionSort")
+	return normalizeCollectionSetting(value, CollectionSortKeys)
+}
+
+// GetPreferences gets the preferences of a collection, including collectionMode and collectionSort.
+// Unlike the mode and sort in the collection's metadata, they are read from the server's
+// preferences container, so a settings round-trip is exact.
+func (s *Collections) GetPreferences(ctx context.Context, collectionID int, opts ...operations.Option) (*CollectionPreferences, error) {
+	var out struct {
+		MediaContainer struct {
+			Metadata []struct {
+				Preferences struct {
+					Setting []CollectionPreference `json:"Setting"`
+				} `json:"Preferences"`
+			} `json:"Metadata"`
+		} `json:"MediaContainer"`
+	}
+
+	// Preferences are only included in the collection's metadata when requested
+	queryParams := url.Values{}
+	queryParams.Add("includePreferences", "1")
+
+	path := fmt.Sprintf("/library/collections/%d", collectionID)
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, path, queryParams, "getCollectionPreferences", &out, opts...); err != nil {
+		return nil, err
 	}
 
-	hookCtx := hooks.HookContext{
-		BaseURL:        baseURL,
-		Context:        ctx,
-		OperationID:    "removeFromCollection",
-		OAuth2Scopes:   []string{},
-		SecuritySource: s.sdkConfiguration.Security,
+	if len(out.MediaContainer.Metadata) == 0 {
+		return nil, fmt.Errorf("collection %d not found", collectionID)
 	}
 
-	// Process each item to remove separately with a DELETE request
-	for _, itemID := range itemIDs {
-		// Build the endpoint URL for removing this specific item
-		opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/items/%s", collectionID, itemID))
-		if err != nil {
-			return fmt.Errorf("error generating URL: %w", err)
-		}
+	prefs := &CollectionPreferences{Settings: out.MediaContainer.Metadata[0].Preferences.Setting}
+	if prefs.Settings == nil {
+		prefs.Settings = []CollectionPreference{}
+	}
 
-		req, err := http.NewRequestWithContext(ctx, "DELETE", opURL, nil)
-		if err != nil {
-			return fmt.Errorf("error creating request: %w", err)
-		}
+	return prefs, nil
+}
 
-		req.Header.Set("Accept", "application/json")
-		req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+// preferenceString converts a preference value of any JSON type to a string
+func preferenceString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return boolToString(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
 
-		if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
-			return err
+// verifyCollectionSetting re-reads a collection's preferences until the setting has the requested
+// value, giving up after collectionVerifyAttempts reads, and returns the value read last
+func (s *Collections) verifyCollectionSetting(ctx context.Context, collectionID int, setting string, requested string, keys map[int]string, opts ...operations.Option) (string, error) {
+	for attempt := 1; ; attempt++ {
+		prefs, err := s.GetPreferences(ctx, collectionID, opts...)
+		if err != nil {
+			return "", fmt.Errorf("error verifying %s: %w", setting, err)
 		}
 
-		req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
-		if err != nil {
-			return err
+		value, _ := prefs.Get(setting)
+		effective := normalizeCollectionSetting(value, keys)
+
+		if effective == requested {
+			return effective, nil
 		}
 
-		httpRes, err := s.sdkConfiguration.Client.Do(req)
-		if err != nil || httpRes == nil {
-			if err != nil {
-				err = fmt.Errorf("error sending request: %w", err)
-			} else {
-				err = fmt.Errorf("error sending request: no response")
+		if attempt >= collectionVerifyAttempts {
+			return effective, &CollectionSettingError{
+				CollectionID: collectionID,
+				Setting:      setting,
+				Requested:    requested,
+				Effective:    effective,
 			}
+		}
 
-			_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
-			return err
-		} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
-			httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
-			if err != nil {
-				return err
-			}
-			// Don't return an error for 404, it just means the item wasn't in the collection
-			if httpRes.StatusCode != 404 {
-				return sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
-			}
-		} else {
-			httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
-			if err != nil {
-				return err
-			}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(collectionVerifyInterval):
 		}
 	}
+}
 
-	// Add a delay to allow Plex to process the changes
-	// This improves reliability when immediately checking collection contents after modification
-	time.Sleep(2 * time.Second)
+// UpdateContentRating updates the content rating of a collection. When locked is true the
+// field is locked so agent refreshes don't overwrite the value.
+func (s *Collections) UpdateContentRating(ctx context.Context, collectionID int, contentRating string, locked bool, opts ...operations.Option) error {
+	args := map[string]string{
+		"contentRating.value":  contentRating,
+		"contentRating.locked": boolToString(locked),
+	}
 
-	return nil
+	return s.editCollection(ctx, collectionID, "updateContentRating", args, opts...)
 }
 
-// MoveCollectionItem moves an item to a new position in the collection
-func (s *Collections) MoveCollectionItem(ctx context.Context, collectionID int, itemID string, afterItemID string, opts ...operations.Option) error {
-	// First, get the collection to check if it's a smart collection
+// SetContentRatingLocked locks or unlocks the content rating of a collection without changing its value
+func (s *Collections) SetContentRatingLocked(ctx context.Context, collectionID int, locked bool, opts ...operations.Option) error {
+	args := map[string]string{
+		"contentRating.locked": boolToString(locked),
+	}
+
+	return s.editCollection(ctx, collectionID, "setContentRatingLocked", args, opts...)
+}
+
+// editCollection applies field edits to a collection using the library section edit endpoint
+func (s *Collections) editCollection(ctx context.Context, collectionID int, operationID string, fields map[string]string, opts ...operations.Option) error {
+	// The edit endpoint is scoped to the library section, so look up the collection first
 	collection, err := s.GetCollection(ctx, collectionID, opts...)
 	if err != nil {
 		return fmt.Errorf("error getting collection: %w", err)
 	}
 
-	// Check if it's a smart collection - cannot manually move items in smart collections
-	if collection.IsSmartCollection() {
-		return fmt.Errorf("cannot manually move items in a smart collection")
+	if err := checkCollectionUnmodified(collection, opts); err != nil {
+		return err
 	}
 
 	options := processOptions(opts)
@@ -985,27 +2141,34 @@ func (s *Collections) MoveCollectionItem(ctx context.Context, collectionID int,
 		baseURL = *options.ServerURL
 	}
 
-	// Build the base URL for the move operation
-	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/items/%s/move", collectionID, itemID))
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/sections/%d/all", collection.SectionID))
 	if err != nil {
 		return fmt.Errorf("error generating URL: %w", err)
 	}
 
-	// Add the after parameter if specified
-	if afterItemID != "" {
-		queryParams := url.Values{}
-		queryParams.Add("after", afterItemID)
-		opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+	args := make(map[string]string, len(fields)+2)
+	for k, v := range fields {
+		args[k] = v
 	}
+	args["type"] = "18" // collection
+	args["id"] = strconv.Itoa(collectionID)
+	opURL += s.joinArgs(args)
 
 	hookCtx := hooks.HookContext{
 		BaseURL:        baseURL,
 		Context:        ctx,
-		OperationID:    "moveCollectionItem",
+		OperationID:    operationID,
 		OAuth2Scopes:   []string{},
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
@@ -1013,6 +2176,7 @@ func (s *Collections) MoveCollectionItem(ctx context.Context, collectionID int,
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return err
@@ -1038,7 +2202,7 @@ func (s *Collections) MoveCollectionItem(ctx context.Context, collectionID int,
 		if err != nil {
 			return err
 		}
-		return sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -1046,14 +2210,33 @@ func (s *Collections) MoveCollectionItem(ctx context.Context, collectionID int,
 		}
 	}
 
-	// Add a delay to allow Plex to process the changes
-	time.Sleep(2 * time.Second)
-
 	return nil
 }
 
-// UpdateCollectionMode updates the mode of a collection
-func (s *Collections) UpdateCollectionMode(ctx context.Context, collectionID int, mode string, opts ...operations.Option) error {
+// UploadTheme uploads theme music for a collection from the provided reader
+func (s *Collections) UploadTheme(ctx context.Context, collectionID int, r io.Reader, opts ...operations.Option) error {
+	if r == nil {
+		return fmt.Errorf("theme reader cannot be nil")
+	}
+
+	return s.uploadTheme(ctx, collectionID, "", r, opts...)
+}
+
+// UploadThemeFromURL sets theme music for a collection from a remote URL
+func (s *Collections) UploadThemeFromURL(ctx context.Context, collectionID int, themeURL string, opts ...operations.Option) error {
+	if themeURL == "" {
+		return fmt.Errorf("theme URL cannot be empty")
+	}
+
+	return s.uploadTheme(ctx, collectionID, themeURL, nil, opts...)
+}
+
+// uploadTheme posts theme music to a collection either as a request body or as a remote URL
+func (s *Collections) uploadTheme(ctx context.Context, collectionID int, themeURL string, body io.Reader, opts ...operations.Option) error {
+	if err := s.checkUnmodified(ctx, collectionID, opts...); err != nil {
+		return err
+	}
+
 	options := processOptions(opts)
 
 	var baseURL string
@@ -1064,39 +2247,43 @@ func (s *Collections) UpdateCollectionMode(ctx context.Context, collectionID int
 		baseURL = *options.ServerURL
 	}
 
-	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/prefs", collectionID))
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/metadata/%d/themes", collectionID))
 	if err != nil {
 		return fmt.Errorf("error generating URL: %w", err)
 	}
 
+	if themeURL != "" {
+		queryParams := url.Values{}
+		queryParams.Add("url", themeURL)
+		opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+	}
+
 	hookCtx := hooks.HookContext{
 		BaseURL:        baseURL,
 		Context:        ctx,
-		OperationID:    "updateCollectionMode",
+		OperationID:    "uploadCollectionTheme",
 		OAuth2Scopes:   []string{},
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	// Translate string mode to numeric mode
-	modeValue := "-1" // default
-	for k, v := range CollectionModeKeys {
-		if v == mode {
-			modeValue = strconv.Itoa(k)
-			break
-		}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
 	}
 
-	queryParams := url.Values{}
-	queryParams.Add("collectionMode", modeValue)
-	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
-
-	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", opURL, body)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
 
-	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept", "*/*")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return err
@@ -1122,7 +2309,7 @@ func (s *Collections) UpdateCollectionMode(ctx context.Context, collectionID int
 		if err != nil {
 			return err
 		}
-		return sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -1133,8 +2320,12 @@ func (s *Collections) UpdateCollectionMode(ctx context.Context, collectionID int
 	return nil
 }
 
-// UpdateCollectionSort updates the sort order of a collection
-func (s *Collections) UpdateCollectionSort(ctx context.Context, collectionID int, sort string, opts ...operations.Option) error {
+// RemoveTheme removes the theme music from a collection
+func (s *Collections) RemoveTheme(ctx context.Context, collectionID int, opts ...operations.Option) error {
+	if err := s.checkUnmodified(ctx, collectionID, opts...); err != nil {
+		return err
+	}
+
 	options := processOptions(opts)
 
 	var baseURL string
@@ -1145,7 +2336,7 @@ func (s *Collections) UpdateCollectionSort(ctx context.Context, collectionID int
 		baseURL = *options.ServerURL
 	}
 
-	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%d/prefs", collectionID))
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/metadata/%d/theme", collectionID))
 	if err != nil {
 		return fmt.Errorf("error generating URL: %w", err)
 	}
@@ -1153,31 +2344,26 @@ func (s *Collections) UpdateCollectionSort(ctx context.Context, collectionID int
 	hookCtx := hooks.HookContext{
 		BaseURL:        baseURL,
 		Context:        ctx,
-		OperationID:    "updateCollectionSort",
+		OperationID:    "removeCollectionTheme",
 		OAuth2Scopes:   []string{},
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	// Translate string sort to numeric sort
-	sortValue := "0" // default release
-	for k, v := range CollectionSortKeys {
-		if v == sort {
-			sortValue = strconv.Itoa(k)
-			break
-		}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "DELETE", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
 	}
 
-	queryParams := url.Values{}
-	queryParams.Add("collectionSort", sortValue)
-	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
-
-	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", opURL, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return err
@@ -1203,7 +2389,7 @@ func (s *Collections) UpdateCollectionSort(ctx context.Context, collectionID int
 		if err != nil {
 			return err
 		}
-		return sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -1216,6 +2402,17 @@ func (s *Collections) UpdateCollectionSort(ctx context.Context, collectionID int
 
 // GetCollectionVisibility gets the visibility of a collection
 func (s *Collections) GetCollectionVisibility(ctx context.Context, sectionID int, collectionID int, opts ...operations.Option) (*CollectionVisibility, error) {
+	hub, err := s.GetCollectionHub(ctx, sectionID, collectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hub.Visibility, nil
+}
+
+// GetCollectionHub gets the recommendation row of a collection, whether or not it is promoted.
+// Promoted is false for a collection that isn't shown in any row.
+func (s *Collections) GetCollectionHub(ctx context.Context, sectionID int, collectionID int, opts ...operations.Option) (*ManagedHub, error) {
 	options := processOptions(opts)
 
 	var baseURL string
@@ -1243,6 +2440,13 @@ func (s *Collections) GetCollectionVisibility(ctx context.Context, sectionID int
 	queryParams.Add("metadataItemId", strconv.Itoa(collectionID))
 	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
@@ -1250,6 +2454,7 @@ func (s *Collections) GetCollectionVisibility(ctx context.Context, sectionID int
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return nil, err
@@ -1275,7 +2480,7 @@ func (s *Collections) GetCollectionVisibility(ctx context.Context, sectionID int
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -1288,44 +2493,29 @@ func (s *Collections) GetCollectionVisibility(ctx context.Context, sectionID int
 		return nil, err
 	}
 
-	// This response has a complex structure, so we'll extract the fields we need
-	type Item struct {
-		PromotedToRecommended string `json:"promotedToRecommended"`
-		PromotedToOwnHome     string `json:"promotedToOwnHome"`
-		PromotedToSharedHome  string `json:"promotedToSharedHome"`
-	}
-
-	type Container struct {
-		Size     int    `json:"size"`
-		Elements []Item `json:"Directory"`
-	}
-
-	type Response struct {
-		MediaContainer Container `json:"MediaContainer"`
-	}
-
-	var resp Response
-	if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &resp, ""); err != nil {
+	var resp managedHubsResponse
+	if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &resp); err != nil {
 		return nil, err
 	}
 
-	if len(resp.MediaContainer.Elements) == 0 {
-		return nil, fmt.Errorf("no visibility information found for collection")
-	}
-
-	item := resp.MediaContainer.Elements[0]
-
-	visibility := &CollectionVisibility{
-		Library: item.PromotedToRecommended == "1",
-		Home:    item.PromotedToOwnHome == "1",
-		Shared:  item.PromotedToSharedHome == "1",
+	// The position of the row isn't part of the response, GetManagedHubs has it
+	elements := append(resp.MediaContainer.Directory, resp.MediaContainer.Hub...)
+	if len(elements) > 0 {
+		hub := elements[0].toManagedHub(sectionID, -1)
+		hub.Identifier = CollectionHubIdentifier(sectionID, collectionID)
+		hub.Promoted = hub.Visibility.Library || hub.Visibility.Home || hub.Visibility.Shared
+		return &hub, nil
 	}
 
-	return visibility, nil
+	return nil, fmt.Errorf("no visibility information found for collection")
 }
 
 // UpdateCollectionVisibility updates the visibility of a collection
 func (s *Collections) UpdateCollectionVisibility(ctx context.Context, sectionID int, collectionID int, visibility *CollectionVisibility, opts ...operations.Option) error {
+	if err := s.checkUnmodified(ctx, collectionID, opts...); err != nil {
+		return err
+	}
+
 	options := processOptions(opts)
 
 	var baseURL string
@@ -1356,6 +2546,13 @@ func (s *Collections) UpdateCollectionVisibility(ctx context.Context, sectionID
 	queryParams.Add("promotedToSharedHome", boolToString(visibility.Shared))
 	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "POST", opURL, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
@@ -1363,6 +2560,7 @@ func (s *Collections) UpdateCollectionVisibility(ctx context.Context, sectionID
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return err
@@ -1388,7 +2586,7 @@ func (s *Collections) UpdateCollectionVisibility(ctx context.Context, sectionID
 		if err != nil {
 			return err
 		}
-		return sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -1401,14 +2599,16 @@ func (s *Collections) UpdateCollectionVisibility(ctx context.Context, sectionID
 
 // UpdateSmartCollection updates the smart filter for a collection
 func (s *Collections) UpdateSmartCollection(ctx context.Context, collectionID int, filterURI string, opts ...operations.Option) error {
-	options := processOptions(opts)
-
 	// First, get the collection to verify it's a smart collection
 	collection, err := s.GetCollection(ctx, collectionID, opts...)
 	if err != nil {
 		return fmt.Errorf("error getting collection: %w", err)
 	}
 
+	if err := checkCollectionUnmodified(collection, opts); err != nil {
+		return err
+	}
+
 	if !collection.IsSmartCollection() {
 		return fmt.Errorf("cannot update smart filter for a non-smart collection")
 	}
@@ -1440,6 +2640,13 @@ func (s *Collections) UpdateSmartCollection(ctx context.Context, collectionID in
 		}
 	}
 
+	return s.putSmartFilterURI(ctx, collectionID, filterURI, opts...)
+}
+
+// putSmartFilterURI stores a smart filter URI in a smart collection
+func (s *Collections) putSmartFilterURI(ctx context.Context, collectionID int, filterURI string, opts ...operations.Option) error {
+	options := processOptions(opts)
+
 	var baseURL string
 	if options.ServerURL == nil {
 		serverURL, params := s.sdkConfiguration.GetServerDetails()
@@ -1465,6 +2672,13 @@ func (s *Collections) UpdateSmartCollection(ctx context.Context, collectionID in
 	queryParams.Add("uri", filterURI)
 	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
@@ -1472,6 +2686,7 @@ func (s *Collections) UpdateSmartCollection(ctx context.Context, collectionID in
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return err
@@ -1497,7 +2712,7 @@ func (s *Collections) UpdateSmartCollection(ctx context.Context, collectionID in
 		if err != nil {
 			return err
 		}
-		return sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -1508,6 +2723,81 @@ func (s *Collections) UpdateSmartCollection(ctx context.Context, collectionID in
 	return nil
 }
 
+// ErrConflict is returned by collection mutations made with WithIfUnmodifiedSince when the
+// collection has been modified since the caller's snapshot
+var ErrConflict = errors.New("collection was modified since it was read")
+
+// PartialError is returned when a bulk operation stops part way through because its context was
+// cancelled. Completed holds the items that were processed and Remaining the items that were not,
+// so the operation can be resumed with Remaining instead of re-run from scratch.
+type PartialError struct {
+	Completed []string
+	Remaining []string
+	Err       error
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("stopped after %d of %d items: %v", len(e.Completed), len(e.Completed)+len(e.Remaining), e.Err)
+}
+
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
+
+// newPartialError splits the items of a bulk operation at the first item that was not processed
+func newPartialError(items []string, done int, err error) *PartialError {
+	return &PartialError{
+		Completed: append([]string{}, items[:done]...),
+		Remaining: append([]string{}, items[done:]...),
+		Err:       err,
+	}
+}
+
+// WithIfUnmodifiedSince makes a collection mutation re-read the collection first and abort with
+// ErrConflict if its updatedAt is later than the given time (a collection's UpdatedAt field), so
+// concurrent tools don't overwrite each other's changes
+func WithIfUnmodifiedSince(updatedAt int64) operations.Option {
+	return helperOption(func(opts *helperOptions) {
+		opts.IfUnmodifiedSince = &updatedAt
+	})
+}
+
+// checkUnmodified re-reads the collection and checks it against WithIfUnmodifiedSince, if set
+func (s *Collections) checkUnmodified(ctx context.Context, collectionID int, opts ...operations.Option) error {
+	if processOptions(opts).IfUnmodifiedSince == nil {
+		return nil
+	}
+
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting collection: %w", err)
+	}
+
+	return checkCollectionUnmodified(collection, opts)
+}
+
+// checkCollectionUnmodified checks a freshly read collection against WithIfUnmodifiedSince, if set
+func checkCollectionUnmodified(collection *Collection, opts []operations.Option) error {
+	since := processOptions(opts).IfUnmodifiedSince
+	if since == nil {
+		return nil
+	}
+
+	if collection.UpdatedAt > *since {
+		return fmt.Errorf("%w: collection %s was updated at %d", ErrConflict, collection.RatingKey, collection.UpdatedAt)
+	}
+
+	return nil
+}
+
+// withoutIfUnmodifiedSince returns opts with WithIfUnmodifiedSince cleared, for the writes of an
+// operation that checked it once up front and then changes the collection several times
+func withoutIfUnmodifiedSince(opts []operations.Option) []operations.Option {
+	return append(append([]operations.Option{}, opts...), helperOption(func(o *helperOptions) {
+		o.IfUnmodifiedSince = nil
+	}))
+}
+
 // Helper function to convert bool to "0" or "1"
 func boolToString(b bool) string {
 	if b {
@@ -1516,14 +2806,41 @@ func boolToString(b bool) string {
 	return "0"
 }
 
-// Helper function to process options
-func processOptions(opts []operations.Option) *operations.Options {
-	o := &operations.Options{}
-	for _, opt := range opts {
-		// Note: We ignore errors here as we're not checking for supported options
-		_ = opt(o)
+// collectionIDFromLocation returns the ID of a collection from the Location header of its creation
+// response, e.g. "/library/collections/12345", which some proxies rewrite to an absolute URL
+func collectionIDFromLocation(location string) (int, error) {
+	u, err := url.Parse(strings.TrimSpace(location))
+	if err != nil {
+		return 0, fmt.Errorf("error parsing location header %q: %w", location, err)
+	}
+
+	idStr, ok := strings.CutPrefix(strings.TrimSuffix(u.Path, "/"), "/library/collections/")
+	if !ok || idStr == "" || strings.Contains(idStr, "/") {
+		return 0, fmt.Errorf("location header %q is not a collection", location)
+	}
+
+	collectionID, err := strconv.Atoi(idStr)
+	if err != nil || collectionID <= 0 {
+		return 0, fmt.Errorf("location header %q has an invalid collection ID", location)
+	}
+
+	return collectionID, nil
+}
+
+// withoutServerURL returns opts with any per-call server URL override removed, for the plex.tv
+// requests of operations whose override targets the media server
+func withoutServerURL(opts []operations.Option) []operations.Option {
+	return append(append([]operations.Option{}, opts...), func(o *operations.Options, supportedOptions ...string) error {
+		o.ServerURL = nil
+		return nil
+	})
+}
+
+// reportProgress reports bulk operation progress to the callback set with WithProgress, if any
+func reportProgress(options *helperOptions, done, total int, stage string) {
+	if options.Progress != nil {
+		options.Progress(done, total, stage)
 	}
-	return o
 }
 
 // joinArgs returns a query string where only the value is URL encoded.
@@ -1569,10 +2886,23 @@ func (s *Collections) lowerFirst(str string) string {
 	return strings.ToLower(str[0:1]) + str[1:]
 }
 
-// GetSmartFilter retrieves the smart filter URI for a smart collection
+// GetSmartFilter retrieves the smart filter query string for a smart collection
+//
+// Deprecated: use GetSmartFilterConfig, which also returns the type, full URI and parsed conditions.
 func (s *Collections) GetSmartFilter(ctx context.Context, collection *Collection, opts ...operations.Option) (string, error) {
+	config, err := s.GetSmartFilterConfig(ctx, collection, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	return config.Filter, nil
+}
+
+// GetSmartFilterConfig retrieves the smart filter of a smart collection, including its item type,
+// the filter query string, the full stored URI and the parsed filter conditions
+func (s *Collections) GetSmartFilterConfig(ctx context.Context, collection *Collection, opts ...operations.Option) (*SmartFilterConfig, error) {
 	if !collection.IsSmartCollection() {
-		return "", fmt.Errorf("collection is not a smart collection")
+		return nil, fmt.Errorf("collection is not a smart collection")
 	}
 
 	options := processOptions(opts)
@@ -1588,7 +2918,7 @@ func (s *Collections) GetSmartFilter(ctx context.Context, collection *Collection
 	// Get the collection content which contains the smart filter URI
 	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/collections/%s", collection.RatingKey))
 	if err != nil {
-		return "", fmt.Errorf("error generating URL: %w", err)
+		return nil, fmt.Errorf("error generating URL: %w", err)
 	}
 
 	hookCtx := hooks.HookContext{
@@ -1599,21 +2929,29 @@ func (s *Collections) GetSmartFilter(ctx context.Context, collection *Collection
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
 	if err != nil {
-		return "", fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
-		return "", err
+		return nil, err
 	}
 
 	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	httpRes, err := s.sdkConfiguration.Client.Do(req)
@@ -1625,43 +2963,56 @@ func (s *Collections) GetSmartFilter(ctx context.Context, collection *Collection
 		}
 
 		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
-		return "", err
+		return nil, err
 	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-		return "", sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 	}
 
 	rawBody, err := utils.ConsumeRawBody(httpRes)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	var out CollectionResponse
-	if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
-		return "", err
+	if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &out); err != nil {
+		return nil, err
 	}
 
 	// Extract filter from the content field
 	if out.MediaContainer.Content == "" {
-		return "", fmt.Errorf("smart filter not found in collection response")
+		return nil, fmt.Errorf("smart filter not found in collection response")
 	}
 
 	// The smart filter is usually in the format of a URL, we want to extract just the query part
 	parsedURL, err := url.Parse(out.MediaContainer.Content)
 	if err != nil {
-		return "", fmt.Errorf("error parsing smart filter URL: %w", err)
+		return nil, fmt.Errorf("error parsing smart filter URL: %w", err)
+	}
+
+	config := &SmartFilterConfig{
+		Filter: "?" + parsedURL.RawQuery,
+		URI:    out.MediaContainer.Content,
+	}
+
+	// Filters using syntax the parser does not understand are still returned, just without conditions
+	if parsed, err := ParseSmartFilter(config.Filter); err == nil {
+		config.Type = parsed.Type
+		config.Parsed = parsed
+	} else {
+		smartType, _ := strconv.Atoi(parsedURL.Query().Get("type"))
+		config.Type = MetadataType(smartType)
 	}
 
-	// Return just the query string part (with the '?' prefix)
-	return "?" + parsedURL.RawQuery, nil
+	return config, nil
 }
 
 // BuildSmartFilterURI creates a full URI for a smart filter
@@ -1701,10 +3052,12 @@ func (s *Collections) TestSmartFilter(ctx context.Context, sectionID int, filter
 		filterQuery = "?" + filterQuery
 	}
 
-	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/sections/%d/all%s", sectionID, filterQuery))
+	// The filter query is appended after joining so its '?' is not escaped into the path
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/sections/%d/all", sectionID))
 	if err != nil {
 		return false, fmt.Errorf("error generating URL: %w", err)
 	}
+	opURL += filterQuery
 
 	hookCtx := hooks.HookContext{
 		BaseURL:        baseURL,
@@ -1714,6 +3067,13 @@ func (s *Collections) TestSmartFilter(ctx context.Context, sectionID int, filter
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
 	if err != nil {
 		return false, fmt.Errorf("error creating request: %w", err)
@@ -1721,6 +3081,7 @@ func (s *Collections) TestSmartFilter(ctx context.Context, sectionID int, filter
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
 	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
 		return false, err
@@ -1746,7 +3107,7 @@ func (s *Collections) TestSmartFilter(ctx context.Context, sectionID int, filter
 		if err != nil {
 			return false, err
 		}
-		return false, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, "", httpRes)
+		return false, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
 		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
@@ -1760,7 +3121,7 @@ func (s *Collections) TestSmartFilter(ctx context.Context, sectionID int, filter
 	}
 
 	var out CollectionResponse
-	if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+	if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 		return false, err
 	}
 
@@ -1768,27 +3129,19 @@ func (s *Collections) TestSmartFilter(ctx context.Context, sectionID int, filter
 	return len(out.MediaContainer.Metadata) > 0, nil
 }
 
-func (s *Collections) getServerIdentity(ctx context.Context, opts ...operations.Option) (*operations.GetServerIdentityResponse, error) {
-	o := operations.Options{}
-
-	supportedOptions := []string{
-		operations.SupportedOptionRetries,
-		operations.SupportedOptionTimeout,
-	}
-
-	for _, opt := range opts {
-		if err := opt(&o, supportedOptions...); err != nil {
-			return nil, fmt.Errorf("error applying option: %w", err)
-		}
-	}
+// getItemTypes looks up the Plex metadata type of each item, keyed by rating key
+func (s *Collections) getItemTypes(ctx context.Context, itemIDs []string, opts ...operations.Option) (map[string]MetadataType, error) {
+	options := processOptions(opts)
 
 	var baseURL string
-	if o.ServerURL == nil {
-		baseURL = utils.ReplaceParameters(s.sdkConfiguration.GetServerDetails())
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
 	} else {
-		baseURL = *o.ServerURL
+		baseURL = *options.ServerURL
 	}
-	opURL, err := url.JoinPath(baseURL, "/identity")
+
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/metadata/%s", strings.Join(itemIDs, ",")))
 	if err != nil {
 		return nil, fmt.Errorf("error generating URL: %w", err)
 	}
@@ -1796,16 +3149,12 @@ func (s *Collections) getServerIdentity(ctx context.Context, opts ...operations.
 	hookCtx := hooks.HookContext{
 		BaseURL:        baseURL,
 		Context:        ctx,
-		OperationID:    "get-server-identity",
+		OperationID:    "getItemTypes",
 		OAuth2Scopes:   []string{},
-		SecuritySource: nil,
-	}
-
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
+		SecuritySource: s.sdkConfiguration.Security,
 	}
 
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
 	if timeout != nil {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, *timeout)
@@ -1816,171 +3165,125 @@ func (s *Collections) getServerIdentity(ctx context.Context, opts ...operations.
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
+
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
 
-	for k, v := range o.SetHeaders {
-		req.Header.Set(k, v)
-	}
-
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
-
-	var httpRes *http.Response
-	if retryConfig != nil {
-		httpRes, err = utils.Retry(ctx, utils.Retries{
-			Config: retryConfig,
-			StatusCodes: []string{
-				"429",
-				"500",
-				"502",
-				"503",
-				"504",
-			},
-		}, func() (*http.Response, error) {
-			if req.Body != nil {
-				copyBody, err := req.GetBody()
-				if err != nil {
-					return nil, err
-				}
-				req.Body = copyBody
-			}
-
-			req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
-			if err != nil {
-				if retry.IsPermanentError(err) || retry.IsTemporaryError(err) {
-					return nil, err
-				}
-
-				return nil, retry.Permanent(err)
-			}
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return nil, err
+	}
 
-			httpRes, err := s.sdkConfiguration.Client.Do(req)
-			if err != nil || httpRes == nil {
-				if err != nil {
-					err = fmt.Errorf("error sending request: %w", err)
-				} else {
-					err = fmt.Errorf("error sending request: no response")
-				}
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return nil, err
+	}
 
-				_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
-			}
-			return httpRes, err
-		})
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
 
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return nil, err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
 		if err != nil {
 			return nil, err
-		} else {
-			httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
-			if err != nil {
-				return nil, err
-			}
 		}
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
 	} else {
-		req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		httpRes, err = s.sdkConfiguration.Client.Do(req)
-		if err != nil || httpRes == nil {
-			if err != nil {
-				err = fmt.Errorf("error sending request: %w", err)
-			} else {
-				err = fmt.Errorf("error sending request: no response")
-			}
+	rawBody, err := utils.ConsumeRawBody(httpRes)
+	if err != nil {
+		return nil, err
+	}
 
-			_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
-			return nil, err
-		} else if utils.MatchStatusCodes([]string{"408", "4XX", "5XX"}, httpRes.StatusCode) {
-			_httpRes, err := s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
-			if err != nil {
-				return nil, err
-			} else if _httpRes != nil {
-				httpRes = _httpRes
-			}
-		} else {
-			httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
-			if err != nil {
-				return nil, err
-			}
+	var out CollectionResponse
+	if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &out); err != nil {
+		return nil, err
+	}
+
+	itemTypes := make(map[string]MetadataType, len(out.MediaContainer.Metadata))
+	for _, item := range out.MediaContainer.Metadata {
+		itemTypes[item.RatingKey] = collectionItemTypeFromName(item.Type)
+	}
+
+	for _, itemID := range itemIDs {
+		if _, ok := itemTypes[itemID]; !ok {
+			return nil, fmt.Errorf("item %s not found", itemID)
 		}
 	}
 
-	res := &operations.GetServerIdentityResponse{
-		StatusCode:  httpRes.StatusCode,
-		ContentType: httpRes.Header.Get("Content-Type"),
-		RawResponse: httpRes,
+	return itemTypes, nil
+}
+
+// PersonCollectionTitle returns the title used for collections generated by CreateForPerson, so
+// person collections are named consistently across libraries
+func PersonCollectionTitle(personName string, role PersonRole) string {
+	switch role {
+	case PersonRoleDirector:
+		return "Directed by " + personName
+	case PersonRoleWriter:
+		return "Written by " + personName
+	case PersonRoleProducer:
+		return "Produced by " + personName
+	default:
+		return personName
 	}
+}
 
-	switch {
-	case httpRes.StatusCode == 200:
-		switch {
-		case utils.MatchContentType(httpRes.Header.Get("Content-Type"), `application/json`):
-			rawBody, err := utils.ConsumeRawBody(httpRes)
-			if err != nil {
-				return nil, err
-			}
+// CreateForPerson creates a smart collection of the items in a library section where the named
+// person has the given role (e.g. all movies featuring an actor). The person's tag ID is resolved
+// by name, the collection is titled with PersonCollectionTitle and, when the person has a photo,
+// it is used as the collection poster.
+func (s *Collections) CreateForPerson(ctx context.Context, sectionID int, personName string, role PersonRole, opts ...operations.Option) (*Collection, error) {
+	library := newLibrary(s.sdkConfiguration)
 
-			var out operations.GetServerIdentityResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
-				return nil, err
-			}
+	person, err := library.FindPerson(ctx, sectionID, personName, role, opts...)
+	if err != nil {
+		return nil, err
+	}
 
-			res.Object = &out
-		default:
-			rawBody, err := utils.ConsumeRawBody(httpRes)
-			if err != nil {
-				return nil, err
-			}
-			return nil, sdkerrors.NewSDKError(fmt.Sprintf("unknown content-type received: %s", httpRes.Header.Get("Content-Type")), httpRes.StatusCode, string(rawBody), httpRes)
-		}
-	case httpRes.StatusCode == 408:
-		switch {
-		case utils.MatchContentType(httpRes.Header.Get("Content-Type"), `application/json`):
-			rawBody, err := utils.ConsumeRawBody(httpRes)
-			if err != nil {
-				return nil, err
-			}
+	items, err := library.GetItemsByPerson(ctx, sectionID, role, person.ID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting items for %s %s: %w", role, person.Tag, err)
+	}
 
-			var out sdkerrors.GetServerIdentityRequestTimeout
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
-				return nil, err
-			}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no items found for %s %s in section %d", role, person.Tag, sectionID)
+	}
 
-			out.RawResponse = httpRes
-			return nil, &out
-		default:
-			rawBody, err := utils.ConsumeRawBody(httpRes)
-			if err != nil {
-				return nil, err
-			}
-			return nil, sdkerrors.NewSDKError(fmt.Sprintf("unknown content-type received: %s", httpRes.Header.Get("Content-Type")), httpRes.StatusCode, string(rawBody), httpRes)
-		}
-	case httpRes.StatusCode >= 400 && httpRes.StatusCode < 500:
-		rawBody, err := utils.ConsumeRawBody(httpRes)
-		if err != nil {
-			return nil, err
-		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
-	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
-		rawBody, err := utils.ConsumeRawBody(httpRes)
+	smartType := collectionItemTypeFromName(items[0].Type)
+	if smartType == 0 {
+		return nil, fmt.Errorf("items of type %s cannot be added to a collection", items[0].Type)
+	}
+
+	filterArgs := fmt.Sprintf("?type=%d&%s=%d", smartType, role, person.ID)
+	collection, err := s.CreateSmartCollection(ctx, sectionID, PersonCollectionTitle(person.Tag, role), smartType, filterArgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only remote photos can be used as a poster source
+	if strings.HasPrefix(person.Thumb, "http") {
+		collectionID, err := strconv.ParseInt(collection.RatingKey, 10, 64)
 		if err != nil {
-			return nil, err
+			return collection, fmt.Errorf("error converting collection ID to int: %w", err)
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
-	default:
-		rawBody, err := utils.ConsumeRawBody(httpRes)
-		if err != nil {
-			return nil, err
+
+		if _, err := library.PostMediaPoster(ctx, collectionID, &person.Thumb, nil, opts...); err != nil {
+			return collection, fmt.Errorf("error setting collection poster: %w", err)
 		}
-		return nil, sdkerrors.NewSDKError("unknown status code returned", httpRes.StatusCode, string(rawBody), httpRes)
 	}
 
-	return res, nil
+	return collection, nil
 }
@@ -3,11 +3,16 @@ package plexgo
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
 )
 
 // MockHTTPClient is a mock HTTP client for testing
@@ -71,25 +76,25 @@ func TestGetAllCollections(t *testing.T) {
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	collections, err := client.Collections.GetAllCollections(context.Background(), 1)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Check the number of collections
 	if len(collections) != 2 {
 		t.Errorf("Expected 2 collections, got: %d", len(collections))
 	}
-	
+
 	// Check the collection titles
 	if collections[0].Title != "Test Collection 1" {
 		t.Errorf("Expected collection title 'Test Collection 1', got: %s", collections[0].Title)
 	}
-	
+
 	if collections[1].Title != "Test Collection 2" {
 		t.Errorf("Expected collection title 'Test Collection 2', got: %s", collections[1].Title)
 	}
@@ -98,20 +103,49 @@ func TestGetAllCollections(t *testing.T) {
 func TestCreateCollection(t *testing.T) {
 	// Create a mock HTTP server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Item types are resolved before the collection is created
+		if r.URL.Path == "/library/metadata/1234,5678" && r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(CollectionResponse{
+				MediaContainer: CollectionMediaContainer{
+					Size: 2,
+					Metadata: []Collection{
+						{RatingKey: "1234", Type: "movie"},
+						{RatingKey: "5678", Type: "movie"},
+					},
+				},
+			})
+			return
+		}
+
+		// The server machine identifier is needed to build the item URI
+		if r.URL.Path == "/identity" && r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+			return
+		}
+
 		// Check if the first request is to create the collection
 		if r.URL.Path == "/library/collections" && r.Method == "POST" {
+			if r.URL.Query().Get("type") != "1" {
+				t.Errorf("Expected type=1, got: %s", r.URL.Query().Get("type"))
+			}
+			if r.URL.Query().Get("uri") != "server://abc123/com.plexapp.plugins.library/library/metadata/1234,5678" {
+				t.Errorf("Expected uri with the item rating keys, got: %s", r.URL.Query().Get("uri"))
+			}
+
 			// Return a mock response with Location header
 			w.Header().Set("Location", "/library/collections/3")
 			w.WriteHeader(http.StatusCreated)
 			return
 		}
-		
+
 		// Check if the second request is to get the collection details
 		if r.URL.Path == "/library/collections/3" && r.Method == "GET" {
 			// Return a mock response with collection details
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
-			
+
 			// Create a mock response body
 			response := CollectionResponse{
 				MediaContainer: CollectionMediaContainer{
@@ -131,16 +165,16 @@ func TestCreateCollection(t *testing.T) {
 					Identifier: "com.plexapp.plugins.library",
 				},
 			}
-			
+
 			// Encode the response
 			json.NewEncoder(w).Encode(response)
 		}
 	}))
 	defer server.Close()
-	
+
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	collection, err := client.Collections.CreateCollection(
 		context.Background(),
@@ -148,21 +182,21 @@ func TestCreateCollection(t *testing.T) {
 		"New Collection",
 		[]string{"1234", "5678"},
 	)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Check the collection details
 	if collection.RatingKey != "3" {
 		t.Errorf("Expected collection RatingKey '3', got: %s", collection.RatingKey)
 	}
-	
+
 	if collection.Title != "New Collection" {
 		t.Errorf("Expected collection Title 'New Collection', got: %s", collection.Title)
 	}
-	
+
 	if collection.SectionID != 1 {
 		t.Errorf("Expected collection SectionID 1, got: %d", collection.SectionID)
 	}
@@ -192,21 +226,21 @@ func TestGetCollection(t *testing.T) {
 				TotalSize: 1,
 				Metadata: []Collection{
 					{
-						RatingKey:       "5",
-						Key:             "/library/collections/5/children",
-						GUID:            "collection://5",
-						Title:           "Action Movies",
-						Summary:         "Collection of action movies",
-						Smart:           false,
-						AddedAt:         1620000000,
-						UpdatedAt:       1620100000,
-						ChildCount:      10,
-						CollectionMode:  "default",
-						CollectionSort:  "release",
-						SectionID:       1,
-						SectionTitle:    "Movies",
-						SectionUUID:     "section-uuid",
-						Type:            "collection",
+						RatingKey:      "5",
+						Key:            "/library/collections/5/children",
+						GUID:           "collection://5",
+						Title:          "Action Movies",
+						Summary:        "Collection of action movies",
+						Smart:          false,
+						AddedAt:        1620000000,
+						UpdatedAt:      1620100000,
+						ChildCount:     10,
+						CollectionMode: "default",
+						CollectionSort: "release",
+						SectionID:      1,
+						SectionTitle:   "Movies",
+						SectionUUID:    "section-uuid",
+						Type:           "collection",
 					},
 				},
 				AllowSync:  true,
@@ -221,32 +255,32 @@ func TestGetCollection(t *testing.T) {
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	collection, err := client.Collections.GetCollection(context.Background(), 5)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Check the collection details
 	if collection.RatingKey != "5" {
 		t.Errorf("Expected collection RatingKey '5', got: %s", collection.RatingKey)
 	}
-	
+
 	if collection.Title != "Action Movies" {
 		t.Errorf("Expected collection Title 'Action Movies', got: %s", collection.Title)
 	}
-	
+
 	if collection.ChildCount != 10 {
 		t.Errorf("Expected collection ChildCount 10, got: %d", collection.ChildCount)
 	}
-	
+
 	if collection.CollectionMode != "default" {
 		t.Errorf("Expected collection CollectionMode 'default', got: %s", collection.CollectionMode)
 	}
-	
+
 	if collection.CollectionSort != "release" {
 		t.Errorf("Expected collection CollectionSort 'release', got: %s", collection.CollectionSort)
 	}
@@ -255,16 +289,16 @@ func TestGetCollection(t *testing.T) {
 func TestGetCollectionItems(t *testing.T) {
 	// This test needs two requests: first to get the collection, then to get the items
 	requestCount := 0
-	
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestCount++
-		
+
 		// First request: Get the collection to check if it's smart or not
 		if requestCount == 1 {
 			if r.URL.Path != "/library/collections/5" || r.Method != "GET" {
 				t.Errorf("Expected first request to GET /library/collections/5, got: %s %s", r.Method, r.URL.Path)
 			}
-			
+
 			// Return a mock collection (non-smart)
 			w.Header().Set("Content-Type", "application/json")
 			json.NewEncoder(w).Encode(CollectionResponse{
@@ -272,19 +306,19 @@ func TestGetCollectionItems(t *testing.T) {
 					Size: 1,
 					Metadata: []Collection{
 						{
-							RatingKey:    "5",
-							Key:          "/library/collections/5/children",
-							Title:        "Regular Collection",
-							Smart:        false,
-							SectionID:    1,
-							Type:         "collection",
+							RatingKey: "5",
+							Key:       "/library/collections/5/children",
+							Title:     "Regular Collection",
+							Smart:     false,
+							SectionID: 1,
+							Type:      "collection",
 						},
 					},
 				},
 			})
 			return
 		}
-		
+
 		// Second request: Get the collection items
 		if requestCount == 2 {
 			if r.URL.Path != "/library/collections/5/children" {
@@ -306,19 +340,19 @@ func TestGetCollectionItems(t *testing.T) {
 					TotalSize: 3,
 					Metadata: []Collection{
 						{
-							RatingKey:    "101",
-							Title:        "Movie 1",
-							Type:         "movie",
+							RatingKey: "101",
+							Title:     "Movie 1",
+							Type:      "movie",
 						},
 						{
-							RatingKey:    "102",
-							Title:        "Movie 2",
-							Type:         "movie",
+							RatingKey: "102",
+							Title:     "Movie 2",
+							Type:      "movie",
 						},
 						{
-							RatingKey:    "103",
-							Title:        "Movie 3",
-							Type:         "movie",
+							RatingKey: "103",
+							Title:     "Movie 3",
+							Type:      "movie",
 						},
 					},
 					AllowSync:  true,
@@ -330,32 +364,32 @@ func TestGetCollectionItems(t *testing.T) {
 			json.NewEncoder(w).Encode(response)
 			return
 		}
-		
+
 		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
 	}))
 	defer server.Close()
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	items, err := client.Collections.GetCollectionItems(context.Background(), 5)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Verify we made the expected requests
 	if requestCount != 2 {
 		t.Errorf("Expected 2 requests, got: %d", requestCount)
 	}
-	
+
 	// Check the items returned
 	if len(items) != 3 {
 		t.Errorf("Expected 3 items, got: %d", len(items))
 	}
-	
+
 	// Check the specific items
 	expectedItems := []string{"101", "102", "103"}
 	for i, expected := range expectedItems {
@@ -368,16 +402,16 @@ func TestGetCollectionItems(t *testing.T) {
 func TestCreateSmartCollection(t *testing.T) {
 	// For testing smart collections, we need to account for the smart filter test
 	requestCount := 0
-	
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestCount++
-		
+
 		// First request: Test the smart filter
 		if requestCount == 1 {
 			// Accept any library sections path with all query parameter
-			if strings.Contains(r.URL.Path, "/library/sections/") && 
-			   strings.Contains(r.URL.Path, "/all") && 
-			   r.Method == "GET" {
+			if strings.Contains(r.URL.Path, "/library/sections/") &&
+				strings.Contains(r.URL.Path, "/all") &&
+				r.Method == "GET" {
 				// Return a mock response with some items to indicate the filter is valid
 				w.Header().Set("Content-Type", "application/json")
 				json.NewEncoder(w).Encode(CollectionResponse{
@@ -386,13 +420,13 @@ func TestCreateSmartCollection(t *testing.T) {
 						Metadata: []Collection{
 							{
 								RatingKey: "101",
-								Title:    "Action Movie 1",
-								Type:     "movie",
+								Title:     "Action Movie 1",
+								Type:      "movie",
 							},
 							{
 								RatingKey: "102",
-								Title:    "Action Movie 2",
-								Type:     "movie",
+								Title:     "Action Movie 2",
+								Type:      "movie",
 							},
 						},
 					},
@@ -400,7 +434,7 @@ func TestCreateSmartCollection(t *testing.T) {
 				return
 			}
 		}
-		
+
 		// Second request: Create the smart collection
 		if requestCount == 2 {
 			if r.URL.Path == "/library/collections" && r.Method == "POST" {
@@ -408,26 +442,26 @@ func TestCreateSmartCollection(t *testing.T) {
 				if !strings.Contains(r.URL.RawQuery, "smart=1") {
 					t.Errorf("Expected smart=1 parameter for smart collection, got: %s", r.URL.RawQuery)
 				}
-				
+
 				// Check for the URI parameter with the filter
 				if !strings.Contains(r.URL.RawQuery, "uri=") {
 					t.Errorf("Expected uri parameter for smart collection, got: %s", r.URL.RawQuery)
 				}
-				
+
 				// Return a mock response with Location header
 				w.Header().Set("Location", "/library/collections/7")
 				w.WriteHeader(http.StatusCreated)
 				return
 			}
 		}
-		
+
 		// Third request: Get the collection details
 		if requestCount == 3 {
 			if r.URL.Path == "/library/collections/7" && r.Method == "GET" {
 				// Return a mock response with collection details
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(http.StatusOK)
-				
+
 				// Create a mock response body
 				response := CollectionResponse{
 					MediaContainer: CollectionMediaContainer{
@@ -448,48 +482,48 @@ func TestCreateSmartCollection(t *testing.T) {
 						Identifier: "com.plexapp.plugins.library",
 					},
 				}
-				
+
 				// Encode the response
 				json.NewEncoder(w).Encode(response)
 				return
 			}
 		}
-		
+
 		t.Errorf("Unexpected request #%d: %s %s", requestCount, r.Method, r.URL.Path)
 	}))
 	defer server.Close()
-	
+
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	collection, err := client.Collections.CreateSmartCollection(
 		context.Background(),
 		1,
 		"Smart Action Movies",
-		1, // type 1 for movies
+		1,               // type 1 for movies
 		"?genre=action", // filter for action movies
 	)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Ensure we made the expected requests
 	if requestCount != 3 {
 		t.Errorf("Expected 3 requests, got: %d", requestCount)
 	}
-	
+
 	// Check the collection details
 	if collection.RatingKey != "7" {
 		t.Errorf("Expected collection RatingKey '7', got: %s", collection.RatingKey)
 	}
-	
+
 	if collection.Title != "Smart Action Movies" {
 		t.Errorf("Expected collection Title 'Smart Action Movies', got: %s", collection.Title)
 	}
-	
+
 	if !collection.IsSmartCollection() {
 		t.Errorf("Expected collection to be smart, got: %v", collection.Smart)
 	}
@@ -515,10 +549,10 @@ func TestDeleteCollection(t *testing.T) {
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	err := client.Collections.DeleteCollection(context.Background(), 8)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
@@ -537,7 +571,7 @@ func TestUpdateCollectionMode(t *testing.T) {
 		if r.Method != "PUT" {
 			t.Errorf("Expected PUT request, got: %s", r.Method)
 		}
-		
+
 		// Check if the mode parameter is correct
 		if !strings.Contains(r.URL.RawQuery, "collectionMode=") {
 			t.Errorf("Expected collectionMode parameter, got: %s", r.URL.RawQuery)
@@ -550,14 +584,17 @@ func TestUpdateCollectionMode(t *testing.T) {
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
-	err := client.Collections.UpdateCollectionMode(context.Background(), 9, CollectionModeShowItems)
-	
+	mode, err := client.Collections.UpdateCollectionMode(context.Background(), 9, CollectionModeShowItems)
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
+	if mode != CollectionModeShowItems {
+		t.Errorf("Expected mode %q, got: %q", CollectionModeShowItems, mode)
+	}
 }
 
 func TestUpdateCollectionSort(t *testing.T) {
@@ -572,7 +609,7 @@ func TestUpdateCollectionSort(t *testing.T) {
 		if r.Method != "PUT" {
 			t.Errorf("Expected PUT request, got: %s", r.Method)
 		}
-		
+
 		// Check if the sort parameter is correct
 		if !strings.Contains(r.URL.RawQuery, "collectionSort=") {
 			t.Errorf("Expected collectionSort parameter, got: %s", r.URL.RawQuery)
@@ -585,14 +622,17 @@ func TestUpdateCollectionSort(t *testing.T) {
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
-	err := client.Collections.UpdateCollectionSort(context.Background(), 10, CollectionSortAlpha)
-	
+	sort, err := client.Collections.UpdateCollectionSort(context.Background(), 10, CollectionSortAlpha)
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
+	if sort != CollectionSortAlpha {
+		t.Errorf("Expected sort %q, got: %q", CollectionSortAlpha, sort)
+	}
 }
 
 func TestGetCollectionVisibility(t *testing.T) {
@@ -607,7 +647,7 @@ func TestGetCollectionVisibility(t *testing.T) {
 		if r.Method != "GET" {
 			t.Errorf("Expected GET request, got: %s", r.Method)
 		}
-		
+
 		// Check if the metadataItemId parameter is correct
 		if !strings.Contains(r.URL.RawQuery, "metadataItemId=11") {
 			t.Errorf("Expected metadataItemId=11 parameter, got: %s", r.URL.RawQuery)
@@ -616,7 +656,7 @@ func TestGetCollectionVisibility(t *testing.T) {
 		// Return a mock response for collection visibility
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		
+
 		// Format for collection visibility response
 		json.NewEncoder(w).Encode(map[string]interface{}{
 			"MediaContainer": map[string]interface{}{
@@ -624,8 +664,8 @@ func TestGetCollectionVisibility(t *testing.T) {
 				"Directory": []map[string]interface{}{
 					{
 						"promotedToRecommended": "1",
-						"promotedToOwnHome": "1",
-						"promotedToSharedHome": "0",
+						"promotedToOwnHome":     "1",
+						"promotedToSharedHome":  "0",
 					},
 				},
 			},
@@ -635,24 +675,24 @@ func TestGetCollectionVisibility(t *testing.T) {
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	visibility, err := client.Collections.GetCollectionVisibility(context.Background(), 1, 11)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Check the visibility settings
 	if !visibility.Library {
 		t.Errorf("Expected Library visibility to be true, got: %v", visibility.Library)
 	}
-	
+
 	if !visibility.Home {
 		t.Errorf("Expected Home visibility to be true, got: %v", visibility.Home)
 	}
-	
+
 	if visibility.Shared {
 		t.Errorf("Expected Shared visibility to be false, got: %v", visibility.Shared)
 	}
@@ -670,21 +710,21 @@ func TestUpdateCollectionVisibility(t *testing.T) {
 		if r.Method != "POST" {
 			t.Errorf("Expected POST request, got: %s", r.Method)
 		}
-		
+
 		// Check if the parameters are correct
 		query := r.URL.RawQuery
 		if !strings.Contains(query, "metadataItemId=12") {
 			t.Errorf("Expected metadataItemId=12 parameter, got: %s", query)
 		}
-		
+
 		if !strings.Contains(query, "promotedToRecommended=1") {
 			t.Errorf("Expected promotedToRecommended=1 parameter, got: %s", query)
 		}
-		
+
 		if !strings.Contains(query, "promotedToOwnHome=1") {
 			t.Errorf("Expected promotedToOwnHome=1 parameter, got: %s", query)
 		}
-		
+
 		if !strings.Contains(query, "promotedToSharedHome=1") {
 			t.Errorf("Expected promotedToSharedHome=1 parameter, got: %s", query)
 		}
@@ -696,17 +736,17 @@ func TestUpdateCollectionVisibility(t *testing.T) {
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Create visibility settings
 	visibility := &CollectionVisibility{
 		Library: true,
 		Home:    true,
 		Shared:  true,
 	}
-	
+
 	// Call the method being tested
 	err := client.Collections.UpdateCollectionVisibility(context.Background(), 1, 12, visibility)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
@@ -716,16 +756,16 @@ func TestUpdateCollectionVisibility(t *testing.T) {
 func TestAddToCollection(t *testing.T) {
 	// This is a multistep operation that requires mocking multiple requests
 	requestCount := 0
-	
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestCount++
-		
+
 		// First request: Get collection
 		if requestCount == 1 {
 			if r.URL.Path != "/library/collections/13" || r.Method != "GET" {
 				t.Errorf("Expected first request to GET /library/collections/13, got: %s %s", r.Method, r.URL.Path)
 			}
-			
+
 			// Return a mock collection
 			w.Header().Set("Content-Type", "application/json")
 			json.NewEncoder(w).Encode(CollectionResponse{
@@ -733,48 +773,48 @@ func TestAddToCollection(t *testing.T) {
 					Size: 1,
 					Metadata: []Collection{
 						{
-							RatingKey:    "13",
-							Title:        "Test Collection",
-							SectionID:    1,
-							Type:         "collection",
+							RatingKey: "13",
+							Title:     "Test Collection",
+							SectionID: 1,
+							Type:      "collection",
 						},
 					},
 				},
 			})
 			return
 		}
-		
+
 		// Second request: Add items to collection (PUT to items endpoint)
 		if requestCount == 2 {
 			if r.URL.Path != "/library/collections/13/items" || r.Method != "PUT" {
 				t.Errorf("Expected second request to PUT /library/collections/13/items, got: %s %s", r.Method, r.URL.Path)
 			}
-			
+
 			// Check that uri parameter is present
 			if !strings.Contains(r.URL.RawQuery, "uri=") {
 				t.Errorf("Expected uri parameter in URL, got: %s", r.URL.RawQuery)
 			}
-			
+
 			// Return success
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		
+
 		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
 	}))
 	defer server.Close()
-	
+
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	err := client.Collections.AddToCollection(context.Background(), 13, []string{"103"})
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Check that all expected requests were made
 	if requestCount != 2 {
 		t.Errorf("Expected 2 requests, got: %d", requestCount)
@@ -784,16 +824,16 @@ func TestAddToCollection(t *testing.T) {
 func TestRemoveFromCollection(t *testing.T) {
 	// This is a multistep operation that requires mocking multiple requests
 	requestCount := 0
-	
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestCount++
-		
+
 		// First request: Get collection
 		if requestCount == 1 {
 			if r.URL.Path != "/library/collections/14" || r.Method != "GET" {
 				t.Errorf("Expected first request to GET /library/collections/14, got: %s %s", r.Method, r.URL.Path)
 			}
-			
+
 			// Return a mock collection
 			w.Header().Set("Content-Type", "application/json")
 			json.NewEncoder(w).Encode(CollectionResponse{
@@ -801,44 +841,44 @@ func TestRemoveFromCollection(t *testing.T) {
 					Size: 1,
 					Metadata: []Collection{
 						{
-							RatingKey:    "14",
-							Title:        "Test Collection",
-							SectionID:    1,
-							Type:         "collection",
+							RatingKey: "14",
+							Title:     "Test Collection",
+							SectionID: 1,
+							Type:      "collection",
 						},
 					},
 				},
 			})
 			return
 		}
-		
+
 		// Second request: Remove item from collection (DELETE to items/itemID endpoint)
 		if requestCount == 2 {
 			expectedPath := "/library/collections/14/items/102"
 			if r.URL.Path != expectedPath || r.Method != "DELETE" {
 				t.Errorf("Expected second request to DELETE %s, got: %s %s", expectedPath, r.Method, r.URL.Path)
 			}
-			
+
 			// Return success
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		
+
 		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
 	}))
 	defer server.Close()
-	
+
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested
 	err := client.Collections.RemoveFromCollection(context.Background(), 14, []string{"102"})
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Check that all expected requests were made
 	if requestCount != 2 {
 		t.Errorf("Expected 2 requests, got: %d", requestCount)
@@ -848,16 +888,16 @@ func TestRemoveFromCollection(t *testing.T) {
 func TestUpdateSmartCollection(t *testing.T) {
 	// This test needs to handle the URI parsing step and the filter test step
 	requestCount := 0
-	
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestCount++
-		
+
 		// First request: Get collection to verify it's a smart collection
 		if requestCount == 1 {
 			if r.URL.Path != "/library/collections/15" || r.Method != "GET" {
 				t.Errorf("Expected first request to GET /library/collections/15, got: %s %s", r.Method, r.URL.Path)
 			}
-			
+
 			// Return a mock smart collection
 			w.Header().Set("Content-Type", "application/json")
 			json.NewEncoder(w).Encode(CollectionResponse{
@@ -865,24 +905,24 @@ func TestUpdateSmartCollection(t *testing.T) {
 					Size: 1,
 					Metadata: []Collection{
 						{
-							RatingKey:    "15",
-							Title:        "Smart Collection",
-							Smart:        true,
-							SectionID:    1,
-							Type:         "collection",
+							RatingKey: "15",
+							Title:     "Smart Collection",
+							Smart:     true,
+							SectionID: 1,
+							Type:      "collection",
 						},
 					},
 				},
 			})
 			return
 		}
-		
+
 		// Second request: Test the smart filter by checking for results
 		if requestCount == 2 {
 			// Accept any library sections path with all query parameter
-			if strings.Contains(r.URL.Path, "/library/sections/") && 
-			   strings.Contains(r.URL.Path, "/all") && 
-			   r.Method == "GET" {
+			if strings.Contains(r.URL.Path, "/library/sections/") &&
+				strings.Contains(r.URL.Path, "/all") &&
+				r.Method == "GET" {
 				// Return a mock response with some items to indicate the filter is valid
 				w.Header().Set("Content-Type", "application/json")
 				json.NewEncoder(w).Encode(CollectionResponse{
@@ -891,8 +931,8 @@ func TestUpdateSmartCollection(t *testing.T) {
 						Metadata: []Collection{
 							{
 								RatingKey: "101",
-								Title:    "New Action Movie",
-								Type:     "movie",
+								Title:     "New Action Movie",
+								Type:      "movie",
 							},
 						},
 					},
@@ -900,7 +940,7 @@ func TestUpdateSmartCollection(t *testing.T) {
 				return
 			}
 		}
-		
+
 		// Third request: Update the smart collection filter
 		if requestCount == 3 {
 			// Check if the request is for the expected endpoint
@@ -912,7 +952,7 @@ func TestUpdateSmartCollection(t *testing.T) {
 			if r.Method != "PUT" {
 				t.Errorf("Expected PUT request, got: %s", r.Method)
 			}
-			
+
 			// Check if the URI parameter is correct
 			if !strings.Contains(r.URL.RawQuery, "uri=") {
 				t.Errorf("Expected uri parameter, got: %s", r.URL.RawQuery)
@@ -922,23 +962,23 @@ func TestUpdateSmartCollection(t *testing.T) {
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		
+
 		t.Errorf("Unexpected request #%d: %s %s", requestCount, r.Method, r.URL.Path)
 	}))
 	defer server.Close()
 
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested - use a URI that includes both server and query part
 	filterURI := fmt.Sprintf("%s/library/sections/1/all?genre=action&year>=2020", server.URL)
 	err := client.Collections.UpdateSmartCollection(context.Background(), 15, filterURI)
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Check that all expected requests were made
 	if requestCount != 3 {
 		t.Errorf("Expected 3 requests, got: %d", requestCount)
@@ -949,16 +989,16 @@ func TestMoveCollectionItem(t *testing.T) {
 	// This test needs to get the collection first to check if it's a smart collection,
 	// then perform the move operation
 	requestCount := 0
-	
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestCount++
-		
+
 		// First request: Get collection to verify it's not a smart collection
 		if requestCount == 1 {
 			if r.URL.Path != "/library/collections/16" || r.Method != "GET" {
 				t.Errorf("Expected first request to GET /library/collections/16, got: %s %s", r.Method, r.URL.Path)
 			}
-			
+
 			// Return a mock regular collection
 			w.Header().Set("Content-Type", "application/json")
 			json.NewEncoder(w).Encode(CollectionResponse{
@@ -966,52 +1006,819 @@ func TestMoveCollectionItem(t *testing.T) {
 					Size: 1,
 					Metadata: []Collection{
 						{
-							RatingKey:    "16",
-							Title:        "Regular Collection",
-							Smart:        false,
-							SectionID:    1,
-							Type:         "collection",
+							RatingKey: "16",
+							Title:     "Regular Collection",
+							Smart:     false,
+							SectionID: 1,
+							Type:      "collection",
 						},
 					},
 				},
 			})
 			return
 		}
-		
+
 		// Second request: Move an item in the collection
 		if requestCount == 2 {
 			expectedPath := "/library/collections/16/items/102/move"
 			if r.URL.Path != expectedPath || r.Method != "PUT" {
 				t.Errorf("Expected second request to PUT %s, got: %s %s", expectedPath, r.Method, r.URL.Path)
 			}
-			
+
 			// Check for the after parameter
 			if !strings.Contains(r.URL.RawQuery, "after=101") {
 				t.Errorf("Expected after=101 parameter, got: %s", r.URL.RawQuery)
 			}
-			
+
 			// Return success
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		
+
 		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
 	}))
 	defer server.Close()
-	
+
 	// Create a client with the mock server URL
 	client := New(WithServerURL(server.URL))
-	
+
 	// Call the method being tested - move item 102 after item 101
 	err := client.Collections.MoveCollectionItem(context.Background(), 16, "102", "101")
-	
+
 	// Check for errors
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
-	
+
 	// Check that both expected requests were made
 	if requestCount != 2 {
 		t.Errorf("Expected 2 requests, got: %d", requestCount)
 	}
-}
\ No newline at end of file
+}
+
+func TestUpdateContentRating(t *testing.T) {
+	requestCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+
+		// First request: Get collection to resolve its library section
+		if requestCount == 1 {
+			if r.URL.Path != "/library/collections/16" || r.Method != "GET" {
+				t.Errorf("Expected first request to GET /library/collections/16, got: %s %s", r.Method, r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(CollectionResponse{
+				MediaContainer: CollectionMediaContainer{
+					Size: 1,
+					Metadata: []Collection{
+						{
+							RatingKey: "16",
+							Title:     "Kids Collection",
+							SectionID: 2,
+							Type:      "collection",
+						},
+					},
+				},
+			})
+			return
+		}
+
+		// Second request: Edit the collection through the section edit endpoint
+		if requestCount == 2 {
+			if r.URL.Path != "/library/sections/2/all" || r.Method != "PUT" {
+				t.Errorf("Expected second request to PUT /library/sections/2/all, got: %s %s", r.Method, r.URL.Path)
+			}
+
+			query := r.URL.Query()
+			if query.Get("type") != "18" || query.Get("id") != "16" {
+				t.Errorf("Expected type=18 and id=16, got: %s", r.URL.RawQuery)
+			}
+			if query.Get("contentRating.value") != "G" {
+				t.Errorf("Expected contentRating.value=G, got: %s", query.Get("contentRating.value"))
+			}
+			if query.Get("contentRating.locked") != "1" {
+				t.Errorf("Expected contentRating.locked=1, got: %s", query.Get("contentRating.locked"))
+			}
+
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	err := client.Collections.UpdateContentRating(context.Background(), 16, "G", true)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if requestCount != 2 {
+		t.Errorf("Expected 2 requests, got: %d", requestCount)
+	}
+}
+
+func TestUploadTheme(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/metadata/17/themes" || r.Method != "POST" {
+			t.Errorf("Expected POST /library/metadata/17/themes, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("Error reading request body: %v", err)
+		}
+		if string(body) != "theme-audio" {
+			t.Errorf("Expected uploaded theme body, got: %q", string(body))
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	err := client.Collections.UploadTheme(context.Background(), 17, strings.NewReader("theme-audio"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+}
+
+func TestRemoveTheme(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/metadata/17/theme" || r.Method != "DELETE" {
+			t.Errorf("Expected DELETE /library/metadata/17/theme, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	err := client.Collections.RemoveTheme(context.Background(), 17)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+}
+
+func TestCreateCollectionMixedShowLevels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/library/metadata/201,202,203" && r.Method == "GET":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(CollectionResponse{
+				MediaContainer: CollectionMediaContainer{
+					Size: 3,
+					Metadata: []Collection{
+						{RatingKey: "201", Type: "show"},
+						{RatingKey: "202", Type: "season"},
+						{RatingKey: "203", Type: "episode"},
+					},
+				},
+			})
+		case r.URL.Path == "/identity":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"machineIdentifier":"abc123"}}`))
+		case r.URL.Path == "/library/collections" && r.Method == "POST":
+			if r.URL.Query().Get("type") != "2" {
+				t.Errorf("Expected type=2 for a show collection, got: %s", r.URL.Query().Get("type"))
+			}
+			w.Header().Set("Location", "/library/collections/20")
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/library/collections/20" && r.Method == "GET":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(CollectionResponse{
+				MediaContainer: CollectionMediaContainer{
+					Size: 1,
+					Metadata: []Collection{
+						{RatingKey: "20", Title: "Mixed Show Collection", SectionID: 2, Type: "collection", SubType: "show"},
+					},
+				},
+			})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	collection, err := client.Collections.CreateCollection(context.Background(), 2, "Mixed Show Collection", []string{"201", "202", "203"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if collection.SubType != "show" {
+		t.Errorf("Expected subtype 'show', got: %s", collection.SubType)
+	}
+}
+
+func TestCreateCollectionRejectsMixedFamilies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/metadata/301,302" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CollectionResponse{
+			MediaContainer: CollectionMediaContainer{
+				Size: 2,
+				Metadata: []Collection{
+					{RatingKey: "301", Type: "movie"},
+					{RatingKey: "302", Type: "episode"},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	_, err := client.Collections.CreateCollection(context.Background(), 2, "Invalid Collection", []string{"301", "302"})
+	if err == nil {
+		t.Fatal("Expected an error when mixing movies and episodes, got nil")
+	}
+}
+
+func TestCreateForPerson(t *testing.T) {
+	posterSet := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/director":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[
+				{"key":"201","title":"Christopher Nolan","thumb":"https://metadata-static.plex.tv/people/nolan.jpg"}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("director") != "201" {
+				t.Errorf("Expected director=201, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","title":"Inception","type":"movie"}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			if r.URL.Query().Get("title") != "Directed by Christopher Nolan" {
+				t.Errorf("Expected title 'Directed by Christopher Nolan', got: %s", r.URL.Query().Get("title"))
+			}
+			if r.URL.Query().Get("type") != "1" {
+				t.Errorf("Expected type=1, got: %s", r.URL.Query().Get("type"))
+			}
+			w.Header().Set("Location", "/library/collections/7")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Directed by Christopher Nolan","smart":"1","type":"collection"}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/metadata/7/posters":
+			if r.URL.Query().Get("url") != "https://metadata-static.plex.tv/people/nolan.jpg" {
+				t.Errorf("Expected poster url of the director photo, got: %s", r.URL.RawQuery)
+			}
+			posterSet = true
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	collection, err := client.Collections.CreateForPerson(context.Background(), 1, "christopher nolan", PersonRoleDirector)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if collection.RatingKey != "7" {
+		t.Errorf("Expected collection RatingKey '7', got: %s", collection.RatingKey)
+	}
+
+	if !posterSet {
+		t.Error("Expected the director photo to be set as the collection poster")
+	}
+}
+
+func TestGetSmartFilterConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/collections/7" || r.Method != "GET" {
+			t.Errorf("Expected GET /library/collections/7, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":1,
+			"content":"server://abc123/com.plexapp.plugins.library/library/sections/1/all?type=1&push=1&genre=1&or=1&genre=2&pop=1&sort=rating:desc",
+			"Metadata":[{"ratingKey":"7","title":"Action or Comedy","smart":"1","type":"collection"}]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	collection := &Collection{RatingKey: "7", Smart: true}
+
+	config, err := client.Collections.GetSmartFilterConfig(context.Background(), collection)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if config.Type != CollectionItemTypeMovie {
+		t.Errorf("Expected type %d, got: %d", CollectionItemTypeMovie, config.Type)
+	}
+
+	if config.Filter != "?type=1&push=1&genre=1&or=1&genre=2&pop=1&sort=rating:desc" {
+		t.Errorf("Unexpected filter: %s", config.Filter)
+	}
+
+	if !strings.HasPrefix(config.URI, "server://abc123/") {
+		t.Errorf("Expected the stored URI, got: %s", config.URI)
+	}
+
+	if config.Parsed == nil || len(config.Parsed.Root.Nodes) != 1 || len(config.Parsed.Sort) != 1 {
+		t.Fatalf("Expected one parsed OR group and a sort, got: %+v", config.Parsed)
+	}
+
+	filter, err := client.Collections.GetSmartFilter(context.Background(), collection)
+	if err != nil || filter != config.Filter {
+		t.Errorf("Expected GetSmartFilter to return %s, got: %s (%v)", config.Filter, filter, err)
+	}
+}
+
+func TestCreateSmartCollectionWithOptions(t *testing.T) {
+	var applied []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","title":"Die Hard","type":"movie"}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			w.Header().Set("Location", "/library/collections/7")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Action","smart":"1","librarySectionID":1,"type":"collection"}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/7/prefs":
+			applied = append(applied, "prefs")
+		case r.Method == "POST" && r.URL.Path == "/hubs/sections/1/manage":
+			if r.URL.Query().Get("promotedToOwnHome") != "1" {
+				t.Errorf("Expected promotedToOwnHome=1, got: %s", r.URL.RawQuery)
+			}
+			applied = append(applied, "visibility")
+		case r.Method == "POST" && r.URL.Path == "/library/metadata/7/posters":
+			applied = append(applied, "poster")
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("summary.value") != "Explosions" {
+				t.Errorf("Expected summary.value=Explosions, got: %s", r.URL.RawQuery)
+			}
+			applied = append(applied, "summary")
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	collection, err := client.Collections.CreateSmartCollectionWithOptions(context.Background(), 1, "Action", 1, "?genre=action", CreateCollectionOptions{
+		Mode:       CollectionModeHideItems,
+		Sort:       CollectionSortAlpha,
+		Visibility: &CollectionVisibility{Library: true, Home: true},
+		PosterURL:  "https://example.com/action.jpg",
+		Summary:    "Explosions",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if collection.RatingKey != "7" {
+		t.Errorf("Expected collection RatingKey '7', got: %s", collection.RatingKey)
+	}
+
+	expected := "prefs,prefs,visibility,poster,summary"
+	if strings.Join(applied, ",") != expected {
+		t.Errorf("Expected settings %s to be applied, got: %s", expected, strings.Join(applied, ","))
+	}
+}
+
+func TestWithIfUnmodifiedSince(t *testing.T) {
+	updates := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// The snapshot is checked by the SDK, a proxy must not see it as a conditional request
+		if value := r.Header.Get("If-Unmodified-Since"); value != "" {
+			t.Errorf("Expected no If-Unmodified-Since header, got: %s", value)
+		}
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/5":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"5","title":"Marvel","updatedAt":2000,"type":"collection"}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/5/prefs":
+			updates++
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	_, err := client.Collections.UpdateCollectionMode(context.Background(), 5, CollectionModeHide, WithIfUnmodifiedSince(1000))
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected ErrConflict for a stale snapshot, got: %v", err)
+	}
+
+	if updates != 0 {
+		t.Errorf("Expected no update after a conflict, got: %d", updates)
+	}
+
+	if _, err := client.Collections.UpdateCollectionMode(context.Background(), 5, CollectionModeHide, WithIfUnmodifiedSince(2000)); err != nil {
+		t.Errorf("Expected no error for a current snapshot, got: %v", err)
+	}
+
+	if updates != 1 {
+		t.Errorf("Expected one update, got: %d", updates)
+	}
+
+	// Headers set in either order neither drop the check nor receive the snapshot
+	shared := map[string]string{"X-Tool": "sync"}
+	_, err = client.Collections.UpdateCollectionMode(context.Background(), 5, CollectionModeHide, WithIfUnmodifiedSince(1000), operations.WithSetHeaders(shared))
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected ErrConflict with headers set afterwards, got: %v", err)
+	}
+	_, err = client.Collections.UpdateCollectionMode(context.Background(), 5, CollectionModeHide, operations.WithSetHeaders(shared), WithIfUnmodifiedSince(1000))
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected ErrConflict with headers set before, got: %v", err)
+	}
+	if len(shared) != 1 {
+		t.Errorf("Expected the caller's headers to be unchanged, got: %v", shared)
+	}
+}
+
+func TestCreateCollectionIdempotent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"6","title":"Action Movies","type":"collection"},
+				{"ratingKey":"7","title":"Action","type":"collection"}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Action","smart":"1","librarySectionID":1,"type":"collection",
+				"Label":[{"tag":"nightly-sync"}]}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	collection, err := client.Collections.CreateSmartCollectionWithOptions(context.Background(), 1, "Action", 1, "?genre=action", CreateCollectionOptions{
+		Idempotent:     true,
+		IdempotencyKey: "nightly-sync",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if collection.RatingKey != "7" {
+		t.Errorf("Expected the existing collection to be reused, got: %s", collection.RatingKey)
+	}
+}
+
+func TestRemoveFromCollectionCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/14":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"14","title":"Test Collection","type":"collection"}]}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/library/collections/14/items/101":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	// Cancel after the first item has been removed
+	err := client.Collections.RemoveFromCollection(ctx, 14, []string{"101", "102", "103"},
+		WithProgress(func(done, total int, stage string) {
+			if done == 1 {
+				cancel()
+			}
+		}))
+
+	var partialErr *PartialError
+	if !errors.As(err, &partialErr) {
+		t.Fatalf("Expected a PartialError, got: %v", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the error to wrap context.Canceled, got: %v", err)
+	}
+	if strings.Join(partialErr.Completed, ",") != "101" || strings.Join(partialErr.Remaining, ",") != "102,103" {
+		t.Errorf("Unexpected completed %v and remaining %v items", partialErr.Completed, partialErr.Remaining)
+	}
+}
+
+func TestCollectionModeSortNormalization(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		wantMode string
+		wantSort string
+		mode     CollectionModeEnum
+		sort     CollectionSortEnum
+	}{
+		{"names", `{"collectionMode":"hideItems","collectionSort":"alpha"}`, CollectionModeHideItems, CollectionSortAlpha, CollectionModeEnumHideItems, CollectionSortEnumAlpha},
+		{"numeric strings", `{"collectionMode":"2","collectionSort":"2"}`, CollectionModeShowItems, CollectionSortCustom, CollectionModeEnumShowItems, CollectionSortEnumCustom},
+		{"numbers", `{"collectionMode":-1,"collectionSort":0}`, CollectionModeDefault, CollectionSortRelease, CollectionModeEnumDefault, CollectionSortEnumRelease},
+		{"unset", `{"title":"Unset"}`, "", "", CollectionModeEnumDefault, CollectionSortEnumRelease},
+		{"unknown", `{"collectionMode":"7","collectionSort":"shuffle"}`, "7", "shuffle", CollectionModeEnumUnknown, CollectionSortEnumUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var collection Collection
+			if err := json.Unmarshal([]byte(tt.json), &collection); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if collection.CollectionMode != tt.wantMode || collection.CollectionSort != tt.wantSort {
+				t.Errorf("Expected mode %q and sort %q, got %q and %q", tt.wantMode, tt.wantSort, collection.CollectionMode, collection.CollectionSort)
+			}
+			if collection.ModeEnum() != tt.mode || collection.SortEnum() != tt.sort {
+				t.Errorf("Expected enums %v and %v, got %v and %v", tt.mode, tt.sort, collection.ModeEnum(), collection.SortEnum())
+			}
+		})
+	}
+
+	var collection Collection
+	if err := json.Unmarshal([]byte(`{"ratingKey":"5","title":"Heist","collectionMode":"0"}`), &collection); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if collection.RatingKey != "5" || collection.Title != "Heist" {
+		t.Errorf("Expected other fields to be decoded, got: %+v", collection)
+	}
+	if collection.ModeEnum().String() != CollectionModeHide {
+		t.Errorf("Expected mode %q, got: %s", CollectionModeHide, collection.ModeEnum())
+	}
+}
+
+func TestUpdateCollectionModeWithVerify(t *testing.T) {
+	defer func(interval time.Duration) { collectionVerifyInterval = interval }(collectionVerifyInterval)
+	collectionVerifyInterval = time.Millisecond
+
+	effectiveMode := "2"
+	reads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/9/prefs":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && r.URL.Path == "/library/collections/9":
+			if r.URL.Query().Get("includePreferences") != "1" {
+				t.Errorf("Expected includePreferences=1, got: %s", r.URL.RawQuery)
+			}
+			reads++
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"MediaContainer":{"Metadata":[{"ratingKey":"9","Preferences":{"Setting":[{"id":"collectionMode","value":"%s"},{"id":"collectionSort","value":1}]}}]}}`, effectiveMode)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	mode, err := client.Collections.UpdateCollectionMode(context.Background(), 9, CollectionModeShowItems, WithVerify())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if mode != CollectionModeShowItems {
+		t.Errorf("Expected the mode read back to be %q, got: %q", CollectionModeShowItems, mode)
+	}
+	if reads != 1 {
+		t.Errorf("Expected 1 preferences read, got: %d", reads)
+	}
+
+	sort, err := client.Collections.UpdateCollectionSort(context.Background(), 9, CollectionSortAlpha, WithVerify())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sort != CollectionSortAlpha {
+		t.Errorf("Expected the sort read back to be %q, got: %q", CollectionSortAlpha, sort)
+	}
+
+	reads = 0
+	mode, err = client.Collections.UpdateCollectionMode(context.Background(), 9, CollectionModeHide, WithVerify())
+	if !errors.Is(err, ErrSettingNotApplied) {
+		t.Fatalf("Expected ErrSettingNotApplied, got: %v", err)
+	}
+	if mode != CollectionModeShowItems {
+		t.Errorf("Expected the effective mode %q to be returned, got: %q", CollectionModeShowItems, mode)
+	}
+	var settingErr *CollectionSettingError
+	if !errors.As(err, &settingErr) {
+		t.Fatalf("Expected *CollectionSettingError, got: %T", err)
+	}
+	if settingErr.Requested != CollectionModeHide || settingErr.Effective != CollectionModeShowItems {
+		t.Errorf("Expected requested %q and effective %q, got: %+v", CollectionModeHide, CollectionModeShowItems, settingErr)
+	}
+	if reads != collectionVerifyAttempts {
+		t.Errorf("Expected %d preferences reads, got: %d", collectionVerifyAttempts, reads)
+	}
+}
+
+func TestGetCollectionPreferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/collections/12" || r.URL.Query().Get("includePreferences") != "1" {
+			t.Errorf("Expected request to '/library/collections/12?includePreferences=1', got: %s", r.URL.String())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"12","Preferences":{"Setting":[
+			{"id":"collectionMode","label":"Collection mode","type":"int","default":-1,"value":1,"enumValues":"-1:Library default|0:Hide collection|1:Hide items in this collection|2:Show this collection and its items"},
+			{"id":"collectionSort","label":"Collection sort","type":"int","default":"0","value":"2"},
+			{"id":"collectionFilterBasedOnUser","type":"bool","default":false,"value":true,"hidden":true}
+		]}}]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	prefs, err := client.Collections.GetPreferences(context.Background(), 12)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(prefs.Settings) != 3 {
+		t.Fatalf("Expected 3 settings, got: %d", len(prefs.Settings))
+	}
+	if prefs.Mode() != CollectionModeHideItems {
+		t.Errorf("Expected mode %q, got: %q", CollectionModeHideItems, prefs.Mode())
+	}
+	if prefs.Sort() != CollectionSortCustom {
+		t.Errorf("Expected sort %q, got: %q", CollectionSortCustom, prefs.Sort())
+	}
+
+	mode := prefs.Settings[0]
+	if mode.Value != "1" || mode.Default != "-1" || mode.Label != "Collection mode" || mode.EnumValues == "" {
+		t.Errorf("Unexpected collectionMode setting: %+v", mode)
+	}
+
+	value, ok := prefs.Get("collectionFilterBasedOnUser")
+	if !ok || value != "1" || !prefs.Settings[2].Hidden {
+		t.Errorf("Expected hidden bool setting with value \"1\", got: %q %v", value, ok)
+	}
+
+	if _, ok := prefs.Get("missing"); ok {
+		t.Error("Expected missing setting not to be found")
+	}
+}
+
+func TestCollectionIDFromLocation(t *testing.T) {
+	valid := map[string]int{
+		"/library/collections/12345":                      12345,
+		"/library/collections/12345/":                     12345,
+		"http://10.0.0.5:32400/library/collections/7?x=1": 7,
+		" /library/collections/42 ":                       42,
+	}
+	for location, expected := range valid {
+		id, err := collectionIDFromLocation(location)
+		if err != nil || id != expected {
+			t.Errorf("%q: expected %d, got: %d, %v", location, expected, id, err)
+		}
+	}
+
+	for _, location := range []string{"/library/collections/", "/library/collections/abc", "/library/collections/12/children", "/library/metadata/12", "/library/collections/-1", "%zz"} {
+		if _, err := collectionIDFromLocation(location); err == nil {
+			t.Errorf("%q: expected an error", location)
+		}
+	}
+}
+
+func FuzzCollectionIDFromLocation(f *testing.F) {
+	f.Add("/library/collections/12345")
+	f.Add("http://10.0.0.5:32400/library/collections/7?x=1")
+	f.Add("/library/collections/12/children")
+
+	f.Fuzz(func(t *testing.T, location string) {
+		id, err := collectionIDFromLocation(location)
+		if err != nil {
+			return
+		}
+		if id <= 0 {
+			t.Errorf("%q: expected a positive ID, got %d", location, id)
+		}
+		if again, err := collectionIDFromLocation(fmt.Sprintf("/library/collections/%d", id)); err != nil || again != id {
+			t.Errorf("%q: ID %d does not round-trip, got %d, %v", location, id, again, err)
+		}
+	})
+}
+
+func FuzzCollectionUnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`{"ratingKey":"1","title":"Horror","smart":"1","collectionMode":"2","collectionSort":1}`))
+	f.Add([]byte(`{"smart":true,"collectionMode":-1,"collectionSort":"alpha"}`))
+	f.Add([]byte(`{"smart":1.5,"collectionMode":1e300,"collectionSort":null}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var collection Collection
+		if err := json.Unmarshal(data, &collection); err != nil {
+			return
+		}
+
+		// Flexible fields must always be usable once decoded
+		_ = collection.IsSmartCollection()
+		_ = collection.ModeEnum()
+		_ = collection.SortEnum()
+
+		var preference CollectionPreference
+		if err := json.Unmarshal(data, &preference); err == nil {
+			_ = normalizeCollectionSetting(preference.Value, CollectionModeKeys)
+		}
+	})
+}
+
+func TestSmartCollectionReadOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Recent Horror","smart":"1","type":"collection"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	collection, err := client.Collections.GetCollection(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if collection.CanMutateMembers() {
+		t.Errorf("Expected the members of a smart collection not to be mutable")
+	}
+
+	errs := []error{
+		client.Collections.AddToCollection(context.Background(), 7, []string{"1"}),
+		client.Collections.RemoveFromCollection(context.Background(), 7, []string{"1"}),
+		client.Collections.MoveCollectionItem(context.Background(), 7, "1", ""),
+	}
+	for i, err := range errs {
+		var smartErr *SmartCollectionError
+		if !errors.Is(err, ErrSmartCollectionReadOnly) || !errors.As(err, &smartErr) || smartErr.CollectionID != 7 {
+			t.Errorf("Expected ErrSmartCollectionReadOnly for collection 7 from operation %d, got: %v", i, err)
+		}
+	}
+}
+
+func TestGetCollectionByKey(t *testing.T) {
+	deleted := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/42":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"42","title":"Marvel","type":"collection"}]}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/library/collections/42":
+			deleted = true
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	collection, err := client.Collections.GetCollectionByKey(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if id, err := collection.ID(); err != nil || id != 42 {
+		t.Errorf("Expected ID 42, got: %d, %v", id, err)
+	}
+
+	if err := client.Collections.DeleteCollectionByKey(context.Background(), collection.RatingKey); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+	if !deleted {
+		t.Errorf("Expected the collection to be deleted")
+	}
+
+	for _, ratingKey := range []string{"", "abc", "-1"} {
+		if _, err := client.Collections.GetCollectionByKey(context.Background(), ratingKey); err == nil {
+			t.Errorf("Expected an error for rating key %q", ratingKey)
+		}
+	}
+}
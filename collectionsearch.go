@@ -0,0 +1,67 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// CollectionItemQuery selects the items of a collection returned by SearchItems. Empty fields
+// match any item.
+type CollectionItemQuery struct {
+	Title   string // Part of the title, matched case-insensitively
+	Year    int    // Release year
+	MinYear int    // Earliest release year
+	MaxYear int    // Latest release year
+	Limit   int    // Maximum number of items; all if 0
+}
+
+// filter returns the smart filter conditions of the query
+func (q CollectionItemQuery) filter() FilterGroup {
+	filter := AllOf()
+	if title := strings.TrimSpace(q.Title); title != "" {
+		filter.Nodes = append(filter.Nodes, Cond("title", FilterOpContains, title))
+	}
+	if q.Year > 0 {
+		filter.Nodes = append(filter.Nodes, Cond("year", FilterOpContains, strconv.Itoa(q.Year)))
+	}
+	// The server's comparisons are exclusive, while the query's years are inclusive
+	if q.MinYear > 0 {
+		filter.Nodes = append(filter.Nodes, Cond("year", FilterOpGreaterThan, strconv.Itoa(q.MinYear-1)))
+	}
+	if q.MaxYear > 0 {
+		filter.Nodes = append(filter.Nodes, Cond("year", FilterOpLessThan, strconv.Itoa(q.MaxYear+1)))
+	}
+	return filter
+}
+
+// SearchItems lists the items of a collection matching query, in the collection's order. The
+// server does the filtering, so pickers over large franchise collections only receive the
+// matching items.
+func (s *Collections) SearchItems(ctx context.Context, collectionID int, query CollectionItemQuery, opts ...operations.Option) ([]Metadata, error) {
+	if query.MinYear > 0 && query.MaxYear > 0 && query.MinYear > query.MaxYear {
+		return nil, fmt.Errorf("invalid year range %d-%d", query.MinYear, query.MaxYear)
+	}
+
+	path := fmt.Sprintf("/library/collections/%d/children", collectionID)
+	if filter := query.filter(); len(filter.Nodes) > 0 {
+		path += "?" + strings.Join(filter.encode(), "&")
+	}
+
+	queryParams := url.Values{}
+	if query.Limit > 0 {
+		queryParams.Add("X-Plex-Container-Start", "0")
+		queryParams.Add("X-Plex-Container-Size", strconv.Itoa(query.Limit))
+	}
+
+	items, err := newLibrary(s.sdkConfiguration).listMetadata(ctx, path, queryParams, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error searching items of collection %d: %w", collectionID, err)
+	}
+
+	return items, nil
+}
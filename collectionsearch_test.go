@@ -0,0 +1,39 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchCollectionItems(t *testing.T) {
+	var rawQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/collections/42/children" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		rawQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","title":"Iron Man 2","year":2010,"type":"movie"}]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	items, err := client.Collections.SearchItems(ctx, 42, CollectionItemQuery{Title: "iron man", MinYear: 2010, MaxYear: 2013, Limit: 20})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(items) != 1 || items[0].RatingKey != "101" {
+		t.Errorf("Unexpected items: %+v", items)
+	}
+	if expected := "title=iron+man&year>>=2009&year<<=2014&X-Plex-Container-Size=20&X-Plex-Container-Start=0"; rawQuery != expected {
+		t.Errorf("Expected the filters to be sent to the server, got: %s", rawQuery)
+	}
+
+	if _, err := client.Collections.SearchItems(ctx, 42, CollectionItemQuery{MinYear: 2015, MaxYear: 2010}); err == nil {
+		t.Error("Expected an error for an inverted year range")
+	}
+}
@@ -0,0 +1,689 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// CollectionState is the desired state of the collections of a library section, loaded from a
+// state file with LoadCollectionState. Plan compares it against the live collections and Apply
+// makes the changes.
+type CollectionState struct {
+	SectionID int `json:"sectionId"`
+	// Label marks the collections managed by the state. Collections created by Apply are labeled
+	// with it, and labeled collections that are no longer in the state are deleted. Without a label
+	// no collection is ever deleted.
+	Label string `json:"label,omitempty"`
+	// MinItems, if set, is the number of items below which the collections of the state are hidden
+	// or deleted, as set by BelowMinItems, as with CollectionPolicy.MinItems. Plan plans hidden
+	// collections with CollectionModeHide and no visibility, and doesn't create deleted ones.
+	MinItems      int              `json:"minItems,omitempty"`
+	BelowMinItems MinItemsAction   `json:"belowMinItems,omitempty"` // MinItemsHide if not set
+	Collections   []CollectionSpec `json:"collections"`
+}
+
+// CollectionSpec is the desired state of a collection, matched to the live collections by title.
+// Smart collections are defined by a Filter, other collections by the GUIDs of their members. A GUID
+// matches the default edition of a movie with several editions; a specific edition is named after
+// the GUID as in file names, e.g. "imdb://tt0133093{edition-Director's Cut}".
+type CollectionSpec struct {
+	Title           string                `json:"title"`
+	Filter          string                `json:"filter,omitempty"` // Smart filter query including the type, e.g. "type=1&decade=1990"
+	GUIDs           []string              `json:"guids,omitempty"`  // Member GUIDs, e.g. "imdb://tt0133093" or "plex://movie/5d7768..."
+	Summary         string                `json:"summary,omitempty"`
+	SummaryTemplate string                `json:"summaryTemplate,omitempty"` // Rendered from the collection's stats instead of a fixed summary, see CollectionTemplate
+	Mode            string                `json:"mode,omitempty"`            // One of the CollectionMode constants
+	Sort            string                `json:"sort,omitempty"`            // One of the CollectionSort constants
+	PosterURL       string                `json:"posterUrl,omitempty"`       // Only set when the collection is created
+	Visibility      *CollectionVisibility `json:"visibility,omitempty"`
+}
+
+// PlanAction is the action of a planned collection change
+type PlanAction string
+
+const (
+	PlanCreate  PlanAction = "create"
+	PlanUpdate  PlanAction = "update"
+	PlanReplace PlanAction = "replace" // Delete and create, when a collection changes between smart and regular
+	PlanDelete  PlanAction = "delete"
+)
+
+// PlannedChange is a change to a collection planned by Plan
+type PlannedChange struct {
+	Action       PlanAction
+	Title        string
+	CollectionID int             // Live collection, 0 for creates
+	Spec         *CollectionSpec // Desired collection, nil for deletes
+	Fields       []string        // Settings that differ, for updates: filter, members, summary, mode, sort, visibility or label
+	Add          []string        // Rating keys of members to add
+	Remove       []string        // Rating keys of members to remove
+	Unmatched    []string        // GUIDs of the spec that match no item in the section, which are skipped
+
+	current *Collection
+}
+
+// CollectionPlan is the set of changes that makes the collections of a section match a state
+type CollectionPlan struct {
+	SectionID int
+	Label     string
+	Changes   []PlannedChange
+}
+
+// Empty returns true if the collections already match the state
+func (p *CollectionPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns the plan in a readable form to confirm before applying it: one line per change,
+// prefixed with + for creates, ~ for updates, -/+ for replacements and - for deletes, followed by
+// the number of changes of each kind
+func (p *CollectionPlan) String() string {
+	if p.Empty() {
+		return "No changes. The collections match the state.\n"
+	}
+
+	var b strings.Builder
+	counts := map[PlanAction]int{}
+	for _, change := range p.Changes {
+		counts[change.Action]++
+
+		switch change.Action {
+		case PlanCreate:
+			fmt.Fprintf(&b, "+ create %q", change.Title)
+			if change.Spec.Filter == "" {
+				fmt.Fprintf(&b, " (%d items)", len(change.Add))
+			}
+		case PlanUpdate:
+			fields := make([]string, 0, len(change.Fields))
+			for _, field := range change.Fields {
+				if field == "members" {
+					field = fmt.Sprintf("members +%d -%d", len(change.Add), len(change.Remove))
+				}
+				fields = append(fields, field)
+			}
+			fmt.Fprintf(&b, "~ update %q: %s", change.Title, strings.Join(fields, ", "))
+		case PlanReplace:
+			fmt.Fprintf(&b, "-/+ replace %q", change.Title)
+		case PlanDelete:
+			fmt.Fprintf(&b, "- delete %q", change.Title)
+		}
+
+		if len(change.Unmatched) > 0 {
+			fmt.Fprintf(&b, " [%d unmatched GUIDs: %s]", len(change.Unmatched), strings.Join(change.Unmatched, ", "))
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d to replace, %d to delete.\n",
+		counts[PlanCreate], counts[PlanUpdate], counts[PlanReplace], counts[PlanDelete])
+
+	return b.String()
+}
+
+// LoadCollectionState reads a JSON state file, e.g.
+//
+//	{
+//	  "sectionId": 1,
+//	  "label": "managed",
+//	  "collections": [
+//	    {"title": "1990s", "filter": "type=1&decade=1990", "mode": "hideItems"},
+//	    {"title": "The Matrix", "guids": ["imdb://tt0133093", "imdb://tt0234215"], "summary": "..."}
+//	  ]
+//	}
+//
+// Unknown fields are rejected so that typos are not silently ignored.
+func LoadCollectionState(r io.Reader) (*CollectionState, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	var state CollectionState
+	if err := decoder.Decode(&state); err != nil {
+		return nil, fmt.Errorf("error reading collection state: %w", err)
+	}
+
+	if err := state.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// Validate checks that the state has a section and that every collection has a unique title and
+// either a valid filter or member GUIDs
+func (s *CollectionState) Validate() error {
+	if s.SectionID <= 0 {
+		return fmt.Errorf("collection state has no section")
+	}
+	if err := (CollectionPolicy{MinItems: s.MinItems, BelowMinItems: s.BelowMinItems}).validate(); err != nil {
+		return err
+	}
+
+	titles := make(map[string]bool, len(s.Collections))
+	for _, spec := range s.Collections {
+		if spec.Title == "" {
+			return fmt.Errorf("collection state has a collection without a title")
+		}
+		if titles[spec.Title] {
+			return fmt.Errorf("collection %q is defined more than once", spec.Title)
+		}
+		titles[spec.Title] = true
+
+		if (spec.Filter == "") == (len(spec.GUIDs) == 0) {
+			return fmt.Errorf("collection %q needs either a filter or guids", spec.Title)
+		}
+		if spec.Filter != "" {
+			if _, err := spec.smartFilter(); err != nil {
+				return fmt.Errorf("collection %q: %w", spec.Title, err)
+			}
+		}
+		if spec.SummaryTemplate != "" {
+			if spec.Summary != "" {
+				return fmt.Errorf("collection %q has both a summary and a summary template", spec.Title)
+			}
+			if _, err := spec.template(); err != nil {
+				return fmt.Errorf("collection %q: %w", spec.Title, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// smartFilter parses the filter of a smart collection spec
+func (c CollectionSpec) smartFilter() (*SmartFilter, error) {
+	filter, err := ParseSmartFilter(c.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	if filter.Type == 0 {
+		return nil, fmt.Errorf("filter has no type")
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// template parses the summary template of a spec
+func (c CollectionSpec) template() (*CollectionTemplate, error) {
+	return ParseCollectionTemplate("", c.SummaryTemplate)
+}
+
+// Plan compares the collections of the state's section against the state and returns the changes
+// that make them match. Nothing is changed on the server; print the plan with String and pass it
+// to Apply once confirmed. Poster URLs are only set on creation, as the live poster cannot be
+// compared to a URL.
+func (s *Collections) Plan(ctx context.Context, state *CollectionState, opts ...operations.Option) (*CollectionPlan, error) {
+	if err := state.Validate(); err != nil {
+		return nil, err
+	}
+
+	live, err := s.GetAllCollections(ctx, state.SectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collections: %w", err)
+	}
+
+	liveByTitle := make(map[string]Collection, len(live))
+	for _, collection := range live {
+		liveByTitle[collection.Title] = collection
+	}
+
+	// Member GUIDs are resolved against the items of the section, read once for all collections
+	var guidKeys map[string]string
+	for _, spec := range state.Collections {
+		if len(spec.GUIDs) > 0 {
+			if guidKeys, err = s.sectionGUIDKeys(ctx, state.SectionID, opts...); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	plan := &CollectionPlan{SectionID: state.SectionID, Label: state.Label, Changes: []PlannedChange{}}
+	for i := range state.Collections {
+		spec := &state.Collections[i]
+
+		var memberKeys []string
+		var unmatched []string
+		for _, guid := range spec.GUIDs {
+			base, edition := splitEdition(guid)
+			if key, ok := guidKeys[editionKey(normalizeGUID(base), edition)]; ok {
+				memberKeys = append(memberKeys, key)
+			} else {
+				unmatched = append(unmatched, guid)
+			}
+		}
+
+		existing, ok := liveByTitle[spec.Title]
+
+		if state.MinItems > 0 {
+			count, err := s.specItemCount(ctx, state.SectionID, spec, memberKeys, opts...)
+			if err != nil {
+				return nil, fmt.Errorf("error counting the items of %q: %w", spec.Title, err)
+			}
+			if count < state.MinItems {
+				if state.BelowMinItems == MinItemsDelete {
+					if ok {
+						delete(liveByTitle, spec.Title)
+						collectionID, err := existing.ID()
+						if err != nil {
+							return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+						}
+						plan.Changes = append(plan.Changes, PlannedChange{Action: PlanDelete, Title: spec.Title, CollectionID: collectionID})
+					}
+					continue
+				}
+
+				hidden := *spec
+				hidden.Mode = CollectionModeHide
+				hidden.Visibility = &CollectionVisibility{}
+				spec = &hidden
+			}
+		}
+
+		if !ok {
+			plan.Changes = append(plan.Changes, PlannedChange{Action: PlanCreate, Title: spec.Title, Spec: spec, Add: memberKeys, Unmatched: unmatched})
+			continue
+		}
+		delete(liveByTitle, spec.Title)
+
+		change, err := s.planUpdate(ctx, state, spec, &existing, memberKeys, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error planning %q: %w", spec.Title, err)
+		}
+		change.Unmatched = unmatched
+		if change.Action != PlanUpdate || len(change.Fields) > 0 {
+			plan.Changes = append(plan.Changes, *change)
+		}
+	}
+
+	if state.Label != "" {
+		// Section listings don't include labels, so the collections left over are read in full
+		remaining := make([]Collection, 0, len(liveByTitle))
+		for _, collection := range liveByTitle {
+			remaining = append(remaining, collection)
+		}
+		sort.Slice(remaining, func(i, j int) bool { return remaining[i].Title < remaining[j].Title })
+
+		for _, candidate := range remaining {
+			collectionID, err := candidate.ID()
+			if err != nil {
+				return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+			}
+
+			collection, err := s.GetCollection(ctx, collectionID, opts...)
+			if err != nil {
+				return nil, fmt.Errorf("error getting collection %q: %w", candidate.Title, err)
+			}
+
+			if collectionHasLabel(collection, state.Label) {
+				plan.Changes = append(plan.Changes, PlannedChange{Action: PlanDelete, Title: collection.Title, CollectionID: collectionID})
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// specItemCount returns the number of items a collection of a spec has: its matched members, or
+// the items matching its smart filter
+func (s *Collections) specItemCount(ctx context.Context, sectionID int, spec *CollectionSpec, memberKeys []string, opts ...operations.Option) (int, error) {
+	if spec.Filter == "" {
+		return len(memberKeys), nil
+	}
+
+	filter, err := spec.smartFilter()
+	if err != nil {
+		return 0, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("X-Plex-Container-Start", "0")
+	queryParams.Add("X-Plex-Container-Size", "0")
+
+	var out struct {
+		MediaContainer struct {
+			Size      int `json:"size"`
+			TotalSize int `json:"totalSize"`
+		} `json:"MediaContainer"`
+	}
+	path := fmt.Sprintf("/library/sections/%d/all%s", sectionID, filter.String())
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, path, queryParams, "getLibraryItems", &out, opts...); err != nil {
+		return 0, err
+	}
+
+	// Servers that don't report the total ignore the page size and return all items
+	count := out.MediaContainer.TotalSize
+	if count == 0 {
+		count = out.MediaContainer.Size
+	}
+	if filter.Limit > 0 && count > filter.Limit {
+		return filter.Limit, nil
+	}
+	return count, nil
+}
+
+// planUpdate compares a live collection against its spec
+func (s *Collections) planUpdate(ctx context.Context, state *CollectionState, spec *CollectionSpec, existing *Collection, memberKeys []string, opts ...operations.Option) (*PlannedChange, error) {
+	collectionID, err := existing.ID()
+	if err != nil {
+		return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+	}
+
+	if existing.IsSmartCollection() != (spec.Filter != "") {
+		return &PlannedChange{Action: PlanReplace, Title: spec.Title, CollectionID: collectionID, Spec: spec, Add: memberKeys}, nil
+	}
+
+	// Section listings don't include labels
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &PlannedChange{Action: PlanUpdate, Title: spec.Title, CollectionID: collectionID, Spec: spec, Fields: []string{}, current: collection}
+
+	if spec.Filter != "" {
+		config, err := s.GetSmartFilterConfig(ctx, collection, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		desired, _ := spec.smartFilter()
+		current := config.Filter
+		if config.Parsed != nil {
+			current = config.Parsed.String()
+		}
+		if desired.String() != current {
+			change.Fields = append(change.Fields, "filter")
+		}
+	} else {
+		members, err := s.GetCollectionItems(ctx, collectionID, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		change.Add, change.Remove = diffKeys(members, memberKeys)
+		if len(change.Add) > 0 || len(change.Remove) > 0 {
+			change.Fields = append(change.Fields, "members")
+		}
+	}
+
+	if spec.Summary != "" && spec.Summary != collection.Summary {
+		change.Fields = append(change.Fields, "summary")
+	}
+	// Templated summaries are re-rendered by Apply whenever the members change, otherwise the
+	// current stats tell if the rendered summary is out of date
+	if spec.SummaryTemplate != "" && !change.hasField("filter") && !change.hasField("members") {
+		summary, err := s.renderSpecSummary(ctx, spec, collectionID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if summary != collection.Summary {
+			change.Fields = append(change.Fields, "summary")
+		}
+	}
+	if spec.Mode != "" && spec.Mode != collection.CollectionMode {
+		change.Fields = append(change.Fields, "mode")
+	}
+	if spec.Sort != "" && spec.Sort != collection.CollectionSort {
+		change.Fields = append(change.Fields, "sort")
+	}
+
+	if spec.Visibility != nil {
+		visibility, err := s.GetCollectionVisibility(ctx, state.SectionID, collectionID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if *visibility != *spec.Visibility {
+			change.Fields = append(change.Fields, "visibility")
+		}
+	}
+
+	if state.Label != "" && !collectionHasLabel(collection, state.Label) {
+		change.Fields = append(change.Fields, "label")
+	}
+
+	return change, nil
+}
+
+// sectionGUIDKeys maps the normalized GUIDs of the items of a section to their rating keys. A GUID
+// maps to the default edition of a movie, preferring the edition without a title, and each edition
+// is also mapped by its editionKey.
+func (s *Collections) sectionGUIDKeys(ctx context.Context, sectionID int, opts ...operations.Option) (map[string]string, error) {
+	queryParams := url.Values{}
+	queryParams.Add("includeGuids", "1")
+
+	items, err := newLibrary(s.sdkConfiguration).listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), queryParams, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting library items: %w", err)
+	}
+
+	keys := make(map[string]string, len(items))
+	for _, item := range items {
+		for _, guid := range metadataGUIDs(item) {
+			if _, ok := keys[guid]; !ok || item.EditionTitle == "" {
+				keys[guid] = item.RatingKey
+			}
+			if item.EditionTitle != "" {
+				keys[editionKey(guid, item.EditionTitle)] = item.RatingKey
+			}
+		}
+	}
+	return keys, nil
+}
+
+// diffKeys returns the keys of desired missing from current, and the keys of current not in desired
+func diffKeys(current []string, desired []string) ([]string, []string) {
+	inCurrent := make(map[string]bool, len(current))
+	for _, key := range current {
+		inCurrent[key] = true
+	}
+	inDesired := make(map[string]bool, len(desired))
+	for _, key := range desired {
+		inDesired[key] = true
+	}
+
+	add := []string{}
+	for _, key := range desired {
+		if !inCurrent[key] {
+			add = append(add, key)
+			inCurrent[key] = true
+		}
+	}
+	remove := []string{}
+	for _, key := range current {
+		if !inDesired[key] {
+			remove = append(remove, key)
+		}
+	}
+	return add, remove
+}
+
+// Apply makes the changes of a plan returned by Plan. Changes are applied in order; if one fails,
+// the changes applied before it are returned with the error, and planning again picks up from
+// where it stopped.
+func (s *Collections) Apply(ctx context.Context, plan *CollectionPlan, opts ...operations.Option) ([]PlannedChange, error) {
+	ctx = withOperationCorrelationID(ctx)
+
+	ctx, finish, err := s.sdkConfiguration.track(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
+	options := processOptions(opts)
+
+	reportProgress(options, 0, len(plan.Changes), "applying collection plan")
+	for i, change := range plan.Changes {
+		if err := s.applyChange(ctx, plan, change, opts...); err != nil {
+			return plan.Changes[:i], fmt.Errorf("error applying %s of %q: %w", change.Action, change.Title, err)
+		}
+		reportProgress(options, i+1, len(plan.Changes), "applying collection plan")
+	}
+
+	return plan.Changes, nil
+}
+
+// applyChange makes a single planned change
+func (s *Collections) applyChange(ctx context.Context, plan *CollectionPlan, change PlannedChange, opts ...operations.Option) error {
+	switch change.Action {
+	case PlanDelete:
+		return s.DeleteCollection(ctx, change.CollectionID, opts...)
+	case PlanReplace:
+		if err := s.DeleteCollection(ctx, change.CollectionID, opts...); err != nil {
+			return err
+		}
+		return s.createFromSpec(ctx, plan, change, opts...)
+	case PlanCreate:
+		return s.createFromSpec(ctx, plan, change, opts...)
+	}
+
+	spec := change.Spec
+	if change.hasField("filter") {
+		filter, err := spec.smartFilter()
+		if err != nil {
+			return err
+		}
+		filterURI, err := s.buildSmartFilterURI(ctx, plan.SectionID, filter.String(), opts...)
+		if err != nil {
+			return err
+		}
+		if err := s.UpdateSmartCollection(ctx, change.CollectionID, filterURI, opts...); err != nil {
+			return fmt.Errorf("error updating smart filter: %w", err)
+		}
+	}
+
+	if len(change.Add) > 0 {
+		if err := s.AddToCollection(ctx, change.CollectionID, change.Add, opts...); err != nil {
+			return fmt.Errorf("error adding members: %w", err)
+		}
+	}
+	if len(change.Remove) > 0 {
+		if err := s.RemoveFromCollection(ctx, change.CollectionID, change.Remove, opts...); err != nil {
+			return fmt.Errorf("error removing members: %w", err)
+		}
+	}
+
+	// Only the settings that differ are applied
+	updateOptions := CreateCollectionOptions{}
+	if change.hasField("summary") && spec.SummaryTemplate == "" {
+		updateOptions.Summary = spec.Summary
+	}
+	if change.hasField("mode") {
+		updateOptions.Mode = spec.Mode
+	}
+	if change.hasField("sort") {
+		updateOptions.Sort = spec.Sort
+	}
+	if change.hasField("visibility") {
+		updateOptions.Visibility = spec.Visibility
+	}
+	if change.hasField("label") {
+		updateOptions.IdempotencyKey = plan.Label
+	}
+	if updateOptions != (CreateCollectionOptions{}) {
+		current := change.current
+		if current == nil {
+			current = &Collection{RatingKey: strconv.Itoa(change.CollectionID)}
+		}
+		if _, err := s.applyCreateOptions(ctx, plan.SectionID, current, updateOptions, opts...); err != nil {
+			return err
+		}
+	}
+
+	if spec.SummaryTemplate != "" && (change.hasField("summary") || change.hasField("filter") || change.hasField("members")) {
+		return s.applySpecTemplate(ctx, spec, change.CollectionID, opts...)
+	}
+	return nil
+}
+
+// createFromSpec creates the collection of a planned create or replacement
+func (s *Collections) createFromSpec(ctx context.Context, plan *CollectionPlan, change PlannedChange, opts ...operations.Option) error {
+	spec := change.Spec
+	createOptions := CreateCollectionOptions{
+		Mode:           spec.Mode,
+		Sort:           spec.Sort,
+		Visibility:     spec.Visibility,
+		PosterURL:      spec.PosterURL,
+		Summary:        spec.Summary,
+		Idempotent:     true,
+		IdempotencyKey: plan.Label,
+	}
+
+	var collection *Collection
+	var err error
+	if spec.Filter != "" {
+		filter, err := spec.smartFilter()
+		if err != nil {
+			return err
+		}
+		collection, err = s.CreateSmartCollectionWithOptions(ctx, plan.SectionID, spec.Title, filter.Type, filter.String(), createOptions, opts...)
+		if err != nil {
+			return err
+		}
+	} else {
+		if len(change.Add) == 0 {
+			return fmt.Errorf("none of the GUIDs match an item in the section")
+		}
+		collection, err = s.CreateCollectionWithOptions(ctx, plan.SectionID, spec.Title, change.Add, createOptions, opts...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if spec.SummaryTemplate == "" {
+		return nil
+	}
+	collectionID, err := collection.ID()
+	if err != nil {
+		return fmt.Errorf("error converting collection ID to int: %w", err)
+	}
+	return s.applySpecTemplate(ctx, spec, collectionID, opts...)
+}
+
+// renderSpecSummary renders the summary template of a spec with the current stats of a collection
+func (s *Collections) renderSpecSummary(ctx context.Context, spec *CollectionSpec, collectionID int, opts ...operations.Option) (string, error) {
+	tmpl, err := spec.template()
+	if err != nil {
+		return "", err
+	}
+
+	stats, err := s.GetCollectionStats(ctx, collectionID, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	_, summary, err := tmpl.Render(stats)
+	return summary, err
+}
+
+// applySpecTemplate renders the summary template of a spec once the members of its collection are set
+func (s *Collections) applySpecTemplate(ctx context.Context, spec *CollectionSpec, collectionID int, opts ...operations.Option) error {
+	tmpl, err := spec.template()
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.RenderCollectionTemplate(ctx, collectionID, tmpl, opts...); err != nil {
+		return fmt.Errorf("error rendering summary: %w", err)
+	}
+	return nil
+}
+
+// hasField returns true if a planned update changes a setting
+func (c PlannedChange) hasField(field string) bool {
+	for _, f := range c.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
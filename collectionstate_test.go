@@ -0,0 +1,239 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLoadCollectionState(t *testing.T) {
+	state, err := LoadCollectionState(strings.NewReader(`{
+		"sectionId": 1,
+		"label": "managed",
+		"collections": [
+			{"title": "1990s", "filter": "type=1&decade=1990", "mode": "hideItems", "visibility": {"library": true}},
+			{"title": "The Matrix", "guids": ["imdb://tt0133093"]}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if state.SectionID != 1 || len(state.Collections) != 2 || state.Collections[0].Visibility == nil || !state.Collections[0].Visibility.Library {
+		t.Errorf("Unexpected state: %+v", state)
+	}
+
+	invalid := map[string]string{
+		"unknown field":        `{"sectionId": 1, "collections": [{"title": "A", "filters": "type=1"}]}`,
+		"no section":           `{"collections": [{"title": "A", "filter": "type=1"}]}`,
+		"filter and guids":     `{"sectionId": 1, "collections": [{"title": "A", "filter": "type=1", "guids": ["imdb://tt1"]}]}`,
+		"no members":           `{"sectionId": 1, "collections": [{"title": "A"}]}`,
+		"duplicate title":      `{"sectionId": 1, "collections": [{"title": "A", "filter": "type=1"}, {"title": "A", "filter": "type=2"}]}`,
+		"filter without type":  `{"sectionId": 1, "collections": [{"title": "A", "filter": "decade=1990"}]}`,
+		"summary and template": `{"sectionId": 1, "collections": [{"title": "A", "filter": "type=1", "summary": "B", "summaryTemplate": "{{.ItemCount}}"}]}`,
+		"invalid template":     `{"sectionId": 1, "collections": [{"title": "A", "filter": "type=1", "summaryTemplate": "{{.ItemCount"}]}`,
+	}
+	for name, file := range invalid {
+		if _, err := LoadCollectionState(strings.NewReader(file)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+// collectionStateServer serves a section with a smart collection, a regular collection and two
+// collections that are not in the test state, one of them labeled as managed
+func collectionStateServer(t *testing.T, requests *[]string) *httptest.Server {
+	var mu sync.Mutex
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":4,"Metadata":[
+				{"ratingKey":"7","title":"1990s","smart":"1","librarySectionID":1},
+				{"ratingKey":"8","title":"The Matrix","librarySectionID":1},
+				{"ratingKey":"9","title":"Old Favorites","librarySectionID":1},
+				{"ratingKey":"10","title":"Hand Picked","librarySectionID":1}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all" && r.URL.Query().Get("includeGuids") != "1":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"104","title":"Unforgiven","type":"movie"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"ratingKey":"101","title":"The Matrix","type":"movie","Guid":[{"id":"imdb://tt0133093"}]},
+				{"ratingKey":"102","title":"The Matrix Reloaded","type":"movie","Guid":[{"id":"imdb://tt0234215"}]},
+				{"ratingKey":"103","title":"Speed","type":"movie","Guid":[{"id":"imdb://tt0111257"}]}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"content":"/library/sections/1/all?type=1&decade=1980","Metadata":[
+				{"ratingKey":"7","title":"1990s","smart":"1","librarySectionID":1,"Label":[{"tag":"managed"}]}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[
+				{"ratingKey":"8","title":"The Matrix","summary":"Neo","librarySectionID":1,"Label":[{"tag":"managed"}]}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8/children":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[{"ratingKey":"101","type":"movie"},{"ratingKey":"103","type":"movie"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/9":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"9","title":"Old Favorites","librarySectionID":1,"Label":[{"tag":"managed"}]}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/10":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","title":"Hand Picked","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/11":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"11","title":"Westerns","smart":"1","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/102":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"102","type":"movie"}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"11","title":"Westerns","smart":"1","librarySectionID":1}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/7/items",
+			r.Method == "PUT" && r.URL.Path == "/library/collections/8/items",
+			r.Method == "DELETE" && r.URL.Path == "/library/collections/8/items/103",
+			r.Method == "DELETE" && r.URL.Path == "/library/collections/9",
+			r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestCollectionPlanAndApply(t *testing.T) {
+	var requests []string
+	server := collectionStateServer(t, &requests)
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	state := &CollectionState{
+		SectionID: 1,
+		Label:     "managed",
+		Collections: []CollectionSpec{
+			{Title: "1990s", Filter: "type=1&decade=1990"},
+			{Title: "The Matrix", GUIDs: []string{"imdb://tt0133093", "com.plexapp.agents.imdb://tt0234215?lang=en", "imdb://tt9999999"}, Summary: "Neo"},
+			{Title: "Westerns", Filter: "type=1&genre=5"},
+		},
+	}
+
+	plan, err := client.Collections.Plan(context.Background(), state)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "~ update \"1990s\": filter\n" +
+		"~ update \"The Matrix\": members +1 -1 [1 unmatched GUIDs: imdb://tt9999999]\n" +
+		"+ create \"Westerns\"\n" +
+		"- delete \"Old Favorites\"\n" +
+		"\nPlan: 1 to create, 2 to update, 0 to replace, 1 to delete.\n"
+	if plan.String() != expected {
+		t.Fatalf("Unexpected plan:\n%s", plan)
+	}
+
+	for _, request := range requests {
+		if !strings.HasPrefix(request, "GET ") {
+			t.Errorf("Expected planning to only read, got: %s", request)
+		}
+	}
+
+	requests = nil
+	applied, err := client.Collections.Apply(context.Background(), plan, WithWaitForActivity(time.Second))
+	if err != nil {
+		t.Fatalf("Expected no error applying the plan, got: %v", err)
+	}
+	if len(applied) != len(plan.Changes) {
+		t.Errorf("Expected all changes to be applied, got: %d", len(applied))
+	}
+
+	for _, want := range []string{
+		"PUT /library/collections/7/items",
+		"PUT /library/collections/8/items",
+		"DELETE /library/collections/8/items/103",
+		"POST /library/collections",
+		"DELETE /library/collections/9",
+	} {
+		found := false
+		for _, request := range requests {
+			found = found || request == want
+		}
+		if !found {
+			t.Errorf("Expected request %s, got: %v", want, requests)
+		}
+	}
+}
+
+func TestCollectionPlanEmpty(t *testing.T) {
+	var requests []string
+	server := collectionStateServer(t, &requests)
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	// Without a label, collections missing from the state are left alone
+	plan, err := client.Collections.Plan(context.Background(), &CollectionState{
+		SectionID:   1,
+		Collections: []CollectionSpec{{Title: "1990s", Filter: "?type=1&decade=1980"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !plan.Empty() || plan.String() != "No changes. The collections match the state.\n" {
+		t.Errorf("Expected an empty plan, got:\n%s", plan)
+	}
+}
+
+func TestCollectionPlanMinItems(t *testing.T) {
+	var requests []string
+	server := collectionStateServer(t, &requests)
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	// The smart collections match a single item, The Matrix two
+	state := &CollectionState{
+		SectionID:     1,
+		Label:         "managed",
+		MinItems:      2,
+		BelowMinItems: MinItemsDelete,
+		Collections: []CollectionSpec{
+			{Title: "1990s", Filter: "type=1&decade=1990"},
+			{Title: "The Matrix", GUIDs: []string{"imdb://tt0133093", "imdb://tt0234215"}, Summary: "Neo"},
+			{Title: "Westerns", Filter: "type=1&genre=5"},
+		},
+	}
+
+	plan, err := client.Collections.Plan(context.Background(), state)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "- delete \"1990s\"\n" +
+		"~ update \"The Matrix\": members +1 -1\n" +
+		"- delete \"Old Favorites\"\n" +
+		"\nPlan: 0 to create, 1 to update, 0 to replace, 2 to delete.\n"
+	if plan.String() != expected {
+		t.Errorf("Unexpected plan:\n%s", plan)
+	}
+
+	// Hidden collections are planned with a hidden mode and no visibility
+	plan, err = client.Collections.Plan(context.Background(), &CollectionState{
+		SectionID:   1,
+		MinItems:    2,
+		Collections: []CollectionSpec{{Title: "Westerns", Filter: "type=1&genre=5", Mode: CollectionModeShowItems}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(plan.Changes) != 1 || plan.Changes[0].Action != PlanCreate {
+		t.Fatalf("Expected Westerns to be created, got:\n%s", plan)
+	}
+	if spec := plan.Changes[0].Spec; spec.Mode != CollectionModeHide || spec.Visibility == nil || *spec.Visibility != (CollectionVisibility{}) {
+		t.Errorf("Expected a hidden collection, got: %+v", spec)
+	}
+}
@@ -0,0 +1,89 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Kinds of the tasks the collection features enqueue on a TaskQueue
+const (
+	TaskKindCollectionWebhook  = "collections.webhook"            // A change of a CollectionWatcher to POST to its webhook
+	TaskKindFeaturedVisibility = "collections.featuredVisibility" // A collection a FeaturedRotation features or takes off the home screen
+	TaskKindPlannedChange      = "collections.plannedChange"      // A change of a collection plan, enqueued with EnqueuePlan
+)
+
+// collectionWebhookTask is the payload of a TaskKindCollectionWebhook task
+type collectionWebhookTask struct {
+	URL    string          `json:"url"`
+	Change json.RawMessage `json:"change"`
+}
+
+// featuredVisibilityTask is the payload of a TaskKindFeaturedVisibility task
+type featuredVisibilityTask struct {
+	SectionID    int  `json:"sectionId"`
+	CollectionID int  `json:"collectionId"`
+	Featured     bool `json:"featured"`
+	Shared       bool `json:"shared,omitempty"`
+}
+
+// plannedChangeTask is the payload of a TaskKindPlannedChange task
+type plannedChangeTask struct {
+	SectionID int           `json:"sectionId"`
+	Label     string        `json:"label,omitempty"`
+	Change    PlannedChange `json:"change"`
+}
+
+// HandleTasks registers the handlers of the collection tasks on a queue, which perform them with
+// opts. Watchers, rotations and EnqueuePlan register them when they enqueue tasks; call it when
+// starting a process whose queue may hold tasks saved before a restart. PlexAPI.Close of the client
+// then also stops the queue's Run.
+func (s *Collections) HandleTasks(q *TaskQueue, opts ...operations.Option) {
+	q.mu.Lock()
+	q.track = s.sdkConfiguration.track
+	q.mu.Unlock()
+
+	q.Handle(TaskKindCollectionWebhook, func(ctx context.Context, task Task) error {
+		var payload collectionWebhookTask
+		if err := task.Decode(&payload); err != nil {
+			return err
+		}
+		return postJSONWebhook(ctx, s.sdkConfiguration, payload.URL, payload.Change)
+	})
+
+	q.Handle(TaskKindFeaturedVisibility, func(ctx context.Context, task Task) error {
+		var payload featuredVisibilityTask
+		if err := task.Decode(&payload); err != nil {
+			return err
+		}
+		rotation := &FeaturedRotation{Shared: payload.Shared, collections: s, sectionID: payload.SectionID}
+		return rotation.setFeatured(ctx, payload.CollectionID, payload.Featured, opts...)
+	})
+
+	q.Handle(TaskKindPlannedChange, func(ctx context.Context, task Task) error {
+		var payload plannedChangeTask
+		if err := task.Decode(&payload); err != nil {
+			return err
+		}
+		plan := &CollectionPlan{SectionID: payload.SectionID, Label: payload.Label}
+		return s.applyChange(ctx, plan, payload.Change, opts...)
+	})
+}
+
+// EnqueuePlan enqueues the changes of a plan returned by Plan on a queue rather than applying them
+// as Apply does, one task per change in order. The queue applies them when it is processed,
+// retrying failed changes, and with a Store changes not yet applied survive restarts.
+func (s *Collections) EnqueuePlan(ctx context.Context, q *TaskQueue, plan *CollectionPlan, opts ...operations.Option) error {
+	s.HandleTasks(q, opts...)
+
+	for _, change := range plan.Changes {
+		payload := plannedChangeTask{SectionID: plan.SectionID, Label: plan.Label, Change: change}
+		if _, err := q.Enqueue(ctx, TaskKindPlannedChange, payload); err != nil {
+			return fmt.Errorf("error enqueuing %s of %q: %w", change.Action, change.Title, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package plexgo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// CollectionStats are computed from the items of a collection and passed to collection templates
+type CollectionStats struct {
+	ItemCount   int
+	Newest      *Metadata // Most recently added item, nil if the collection is empty or no item has an added date
+	FirstAdded  time.Time // When the first item still in the collection was added to the library
+	LastAdded   time.Time // When the newest item was added to the library
+	FirstYear   int       // Release year of the oldest item, 0 if no item has a year
+	LastYear    int       // Release year of the most recent item
+	Duration    time.Duration
+	SectionName string
+	Items       []Metadata
+}
+
+// YearRange returns the release years of the items, e.g. "1999–2003", or a single year if they
+// were all released the same year
+func (s *CollectionStats) YearRange() string {
+	if s.FirstYear == 0 {
+		return ""
+	}
+	if s.FirstYear == s.LastYear {
+		return strconv.Itoa(s.FirstYear)
+	}
+	return fmt.Sprintf("%d–%d", s.FirstYear, s.LastYear)
+}
+
+// CollectionTemplate renders the title and summary of a collection from its CollectionStats with
+// text/template. Besides the stats fields, templates can use plural, e.g.
+// {{.ItemCount}} {{plural .ItemCount "movie" "movies"}}, and the methods of time.Time, e.g.
+// {{.LastAdded.Format "January 2006"}}.
+type CollectionTemplate struct {
+	Title   *template.Template // Leaves the title unchanged if nil
+	Summary *template.Template // Leaves the summary unchanged if nil
+}
+
+// collectionTemplateFuncs are the functions available to collection templates
+var collectionTemplateFuncs = template.FuncMap{
+	"plural": func(count int, singular string, plural string) string {
+		if count == 1 {
+			return singular
+		}
+		return plural
+	},
+}
+
+// ParseCollectionTemplate parses the title and summary templates of a collection. Either may be
+// empty to leave that field unchanged.
+func ParseCollectionTemplate(title string, summary string) (*CollectionTemplate, error) {
+	tmpl := &CollectionTemplate{}
+
+	if title != "" {
+		parsed, err := template.New("title").Funcs(collectionTemplateFuncs).Parse(title)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing title template: %w", err)
+		}
+		tmpl.Title = parsed
+	}
+
+	if summary != "" {
+		parsed, err := template.New("summary").Funcs(collectionTemplateFuncs).Parse(summary)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing summary template: %w", err)
+		}
+		tmpl.Summary = parsed
+	}
+
+	return tmpl, nil
+}
+
+// Render executes the templates with stats. Fields without a template are returned empty.
+func (t *CollectionTemplate) Render(stats *CollectionStats) (string, string, error) {
+	var title string
+	if t.Title != nil {
+		var b bytes.Buffer
+		if err := t.Title.Execute(&b, stats); err != nil {
+			return "", "", fmt.Errorf("error rendering title: %w", err)
+		}
+		// Titles are single line
+		title = strings.Join(strings.Fields(b.String()), " ")
+	}
+
+	var summary string
+	if t.Summary != nil {
+		var b bytes.Buffer
+		if err := t.Summary.Execute(&b, stats); err != nil {
+			return "", "", fmt.Errorf("error rendering summary: %w", err)
+		}
+		summary = strings.TrimSpace(b.String())
+	}
+
+	return title, summary, nil
+}
+
+// GetCollectionStats computes the stats of the items of a collection
+func (s *Collections) GetCollectionStats(ctx context.Context, collectionID int, opts ...operations.Option) (*CollectionStats, error) {
+	items, err := newLibrary(s.sdkConfiguration).listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), nil, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection items: %w", err)
+	}
+
+	return newCollectionStats(items), nil
+}
+
+// newCollectionStats computes the stats of a list of collection items
+func newCollectionStats(items []Metadata) *CollectionStats {
+	stats := &CollectionStats{ItemCount: len(items), Items: items}
+
+	for i := range items {
+		item := &items[i]
+
+		if item.AddedAt > 0 {
+			added := time.Unix(item.AddedAt, 0)
+			if stats.Newest == nil || added.After(stats.LastAdded) {
+				stats.Newest = item
+				stats.LastAdded = added
+			}
+			if stats.FirstAdded.IsZero() || added.Before(stats.FirstAdded) {
+				stats.FirstAdded = added
+			}
+		}
+
+		if item.Year > 0 {
+			if stats.FirstYear == 0 || item.Year < stats.FirstYear {
+				stats.FirstYear = item.Year
+			}
+			if item.Year > stats.LastYear {
+				stats.LastYear = item.Year
+			}
+		}
+
+		if stats.SectionName == "" {
+			stats.SectionName = item.SectionTitle
+		}
+		stats.Duration += time.Duration(item.Duration) * time.Millisecond
+	}
+
+	return stats
+}
+
+// RenderCollectionTemplate renders a template with the current stats of a collection and updates
+// its title and summary if they differ. Rendered fields are locked so agent refreshes don't
+// overwrite them. It returns true if the collection was changed.
+func (s *Collections) RenderCollectionTemplate(ctx context.Context, collectionID int, tmpl *CollectionTemplate, opts ...operations.Option) (bool, error) {
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return false, fmt.Errorf("error getting collection: %w", err)
+	}
+
+	stats, err := s.GetCollectionStats(ctx, collectionID, opts...)
+	if err != nil {
+		return false, err
+	}
+	if stats.SectionName == "" {
+		stats.SectionName = collection.SectionTitle
+	}
+
+	title, summary, err := tmpl.Render(stats)
+	if err != nil {
+		return false, err
+	}
+
+	fields := map[string]string{}
+	if tmpl.Title != nil && title != "" && title != collection.Title {
+		fields["title.value"] = title
+		fields["title.locked"] = "1"
+	}
+	if tmpl.Summary != nil && summary != collection.Summary {
+		fields["summary.value"] = summary
+		fields["summary.locked"] = "1"
+	}
+	if len(fields) == 0 {
+		return false, nil
+	}
+
+	if err := s.editCollection(ctx, collectionID, "renderCollectionTemplate", fields, opts...); err != nil {
+		return false, fmt.Errorf("error updating collection: %w", err)
+	}
+
+	return true, nil
+}
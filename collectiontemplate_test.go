@@ -0,0 +1,117 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCollectionTemplateRender(t *testing.T) {
+	tmpl, err := ParseCollectionTemplate(
+		`{{.SectionName}} Picks
+		({{.ItemCount}})`,
+		`{{.ItemCount}} {{plural .ItemCount "film" "films"}} from {{.YearRange}}.{{with .Newest}} Newest: {{.Title}}, added {{$.LastAdded.UTC.Format "January 2006"}}.{{end}}`,
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	stats := newCollectionStats([]Metadata{
+		{RatingKey: "1", Title: "Heat", Year: 1995, AddedAt: 1600000000, SectionTitle: "Movies"},
+		{RatingKey: "2", Title: "Ronin", Year: 1998, AddedAt: 1700000000},
+		{RatingKey: "3", Title: "Thief", Year: 1981, AddedAt: 1650000000},
+	})
+
+	title, summary, err := tmpl.Render(stats)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if title != "Movies Picks (3)" {
+		t.Errorf("Unexpected title: %q", title)
+	}
+	if summary != "3 films from 1981–1998. Newest: Ronin, added November 2023." {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+
+	_, summary, _ = tmpl.Render(newCollectionStats([]Metadata{{RatingKey: "1", Year: 2001}}))
+	if summary != "1 film from 2001." {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+
+	if _, err := ParseCollectionTemplate("{{.ItemCount", ""); err == nil {
+		t.Errorf("Expected an error for an invalid template")
+	}
+	if _, _, err := tmpl.Render(&CollectionStats{}); err != nil {
+		t.Errorf("Expected an empty collection to render, got: %v", err)
+	}
+}
+
+func TestCollectionWatcherTemplates(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	summary := ""
+	var edits []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			polls++
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"5","title":"Heist"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/5":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"5","title":"Heist","librarySectionID":1,"summary":"` + summary + `"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/5/children":
+			if polls == 1 {
+				w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"101","title":"Heat","addedAt":1600000000}]}}`))
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"101","title":"Heat","addedAt":1600000000},{"ratingKey":"102","title":"Ronin","addedAt":1700000000}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			query := r.URL.Query()
+			if query.Get("id") != "5" || query.Get("summary.locked") != "1" || query.Get("title.value") != "" {
+				t.Errorf("Unexpected edit: %s", r.URL.RawQuery)
+			}
+			summary = query.Get("summary.value")
+			edits = append(edits, summary)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	tmpl, err := ParseCollectionTemplate("", `{{.ItemCount}} heists, newest: {{.Newest.Title}}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	watcher := client.Collections.NewWatcher(1)
+	watcher.Templates = map[int]*CollectionTemplate{5: tmpl}
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := watcher.Poll(ctx, WithWaitForActivity(time.Second)); err != nil {
+			t.Fatalf("Expected no error on poll %d, got: %v", i+1, err)
+		}
+	}
+
+	if len(edits) != 1 || edits[0] != "2 heists, newest: Ronin" {
+		t.Fatalf("Expected the summary to be rendered once, got: %v", edits)
+	}
+
+	// Rendering again with the same items changes nothing
+	changed, err := client.Collections.RenderCollectionTemplate(ctx, 5, tmpl)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if changed || len(edits) != 1 {
+		t.Errorf("Expected no change, got: %v", edits)
+	}
+}
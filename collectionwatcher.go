@@ -0,0 +1,317 @@
+package plexgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// defaultWatchInterval is the time between collection snapshots when CollectionWatcher.Interval is not set
+const defaultWatchInterval = 5 * time.Minute
+
+// CollectionChange describes how a collection changed between two snapshots
+type CollectionChange struct {
+	CollectionID   int         `json:"collectionId"`
+	Title          string      `json:"title"`
+	Created        bool        `json:"created,omitempty"`
+	Deleted        bool        `json:"deleted,omitempty"`
+	Added          []string    `json:"added,omitempty"`   // Rating keys of items added to the collection
+	Removed        []string    `json:"removed,omitempty"` // Rating keys of items removed from the collection
+	ArtworkChanged bool        `json:"artworkChanged,omitempty"`
+	Collection     *Collection `json:"collection,omitempty"` // Current state, nil if the collection was deleted
+	DetectedAt     time.Time   `json:"detectedAt"`
+}
+
+// collectionSnapshot is the state of a collection the watcher compares
+type collectionSnapshot struct {
+	collection Collection
+	items      map[string]bool
+}
+
+// CollectionWatcher periodically snapshots the collections of a library section and reports how
+// they changed, e.g. for a Discord bot announcing collection updates. Create one with
+// Collections.NewWatcher and set its fields before running it.
+type CollectionWatcher struct {
+	Interval   time.Duration // Time between snapshots, 5 minutes if not set
+	WebhookURL string        // If set, each change is POSTed to it as JSON
+	OnError    func(error)   // Receives snapshot, template, policy and webhook errors, which don't stop Run
+	// Templates are re-rendered with RenderCollectionTemplate whenever the items of the collection
+	// with the given ID change, keeping generated titles and summaries up to date
+	Templates map[int]*CollectionTemplate
+	// Policy, if set, is applied to collections created since the previous snapshot, so new
+	// collections get the section's mode, sort and visibility. With MinItems it is also applied to
+	// collections whose items changed, hiding or deleting those that fall below the minimum.
+	Policy *CollectionPolicy
+	// Queue, if set, receives the webhook deliveries as tasks rather than Run sending them, so
+	// failed deliveries are retried and, with a TaskStore, survive restarts. Run the queue with
+	// TaskQueue.Run.
+	Queue *TaskQueue
+
+	collections *Collections
+	sectionID   int
+	snapshot    map[int]collectionSnapshot
+}
+
+// NewWatcher returns a watcher for the collections of a library section
+func (s *Collections) NewWatcher(sectionID int) *CollectionWatcher {
+	return &CollectionWatcher{
+		collections: s,
+		sectionID:   sectionID,
+	}
+}
+
+// Run snapshots the collections every Interval until ctx is done, sending each change to changes
+// (if not nil) and to WebhookURL (if set). It returns ctx.Err() when stopped, including by
+// PlexAPI.Close.
+func (w *CollectionWatcher) Run(ctx context.Context, changes chan<- CollectionChange, opts ...operations.Option) error {
+	ctx, finish, err := w.collections.sdkConfiguration.track(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+
+	interval := w.Interval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+
+	if w.Queue != nil {
+		w.collections.HandleTasks(w.Queue, opts...)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		detected, err := w.Poll(ctx, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			w.reportError(err)
+		}
+
+		for _, change := range detected {
+			if changes != nil {
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			if w.WebhookURL != "" {
+				if err := w.postWebhook(ctx, change); err != nil {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					w.reportError(err)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll takes a snapshot of the collections and returns how they changed since the previous one.
+// The first poll only records the initial state and returns no changes. Collections with a
+// template in Templates are re-rendered when their items changed, and new collections get Policy,
+// as do collections whose items changed if the policy has MinItems.
+func (w *CollectionWatcher) Poll(ctx context.Context, opts ...operations.Option) ([]CollectionChange, error) {
+	collections, err := w.collections.GetAllCollections(ctx, w.sectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collections: %w", err)
+	}
+
+	library := newLibrary(w.collections.sdkConfiguration)
+
+	snapshot := make(map[int]collectionSnapshot, len(collections))
+	for _, collection := range collections {
+		collectionID, err := collection.ID()
+		if err != nil {
+			return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+		}
+
+		items, err := library.listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), nil, "getCollectionChildren", opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting items of %s: %w", collection.Title, err)
+		}
+
+		current := collectionSnapshot{collection: collection, items: make(map[string]bool, len(items))}
+		for _, item := range items {
+			current.items[item.RatingKey] = true
+		}
+		snapshot[collectionID] = current
+	}
+
+	previous := w.snapshot
+	w.snapshot = snapshot
+	if previous == nil {
+		return []CollectionChange{}, nil
+	}
+
+	changes := diffCollectionSnapshots(previous, snapshot, time.Now())
+
+	// A failed render or policy update doesn't hide the changes, which are returned with the error
+	var errs []error
+	for _, change := range changes {
+		itemsChanged := len(change.Added) > 0 || len(change.Removed) > 0
+		if w.Policy != nil && (change.Created || (w.Policy.MinItems > 0 && !change.Deleted && itemsChanged)) {
+			// The snapshot counts the items as they are now. New collections may still be filling
+			// up, so the minimum only applies once their items change.
+			collection := *change.Collection
+			collection.ChildCount = len(snapshot[change.CollectionID].items)
+			policy := *w.Policy
+			if change.Created {
+				policy.MinItems = 0
+			}
+
+			applied, err := w.collections.applyPolicy(ctx, w.sectionID, collection, policy, opts...)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error applying policy to %s: %w", change.Title, err))
+			}
+			if len(applied) > 0 && applied[len(applied)-1].Setting == "deleted" {
+				delete(w.snapshot, change.CollectionID)
+				continue
+			}
+		}
+
+		tmpl, ok := w.Templates[change.CollectionID]
+		if !ok || change.Deleted || (len(change.Added) == 0 && len(change.Removed) == 0) {
+			continue
+		}
+		if _, err := w.collections.RenderCollectionTemplate(ctx, change.CollectionID, tmpl, opts...); err != nil {
+			errs = append(errs, fmt.Errorf("error rendering template of %s: %w", change.Title, err))
+		}
+	}
+
+	return changes, errors.Join(errs...)
+}
+
+// diffCollectionSnapshots returns the changes between two snapshots, ordered by collection ID
+func diffCollectionSnapshots(previous, current map[int]collectionSnapshot, now time.Time) []CollectionChange {
+	changes := []CollectionChange{}
+
+	for collectionID, after := range current {
+		collection := after.collection
+		change := CollectionChange{
+			CollectionID: collectionID,
+			Title:        collection.Title,
+			Collection:   &collection,
+			DetectedAt:   now,
+		}
+
+		before, existed := previous[collectionID]
+		if !existed {
+			change.Created = true
+			change.Added = sortedKeys(after.items)
+			changes = append(changes, change)
+			continue
+		}
+
+		for ratingKey := range after.items {
+			if !before.items[ratingKey] {
+				change.Added = append(change.Added, ratingKey)
+			}
+		}
+		for ratingKey := range before.items {
+			if !after.items[ratingKey] {
+				change.Removed = append(change.Removed, ratingKey)
+			}
+		}
+		sort.Strings(change.Added)
+		sort.Strings(change.Removed)
+
+		change.ArtworkChanged = before.collection.Thumb != collection.Thumb || before.collection.Art != collection.Art
+
+		if len(change.Added) > 0 || len(change.Removed) > 0 || change.ArtworkChanged {
+			changes = append(changes, change)
+		}
+	}
+
+	for collectionID, before := range previous {
+		if _, ok := current[collectionID]; !ok {
+			changes = append(changes, CollectionChange{
+				CollectionID: collectionID,
+				Title:        before.collection.Title,
+				Deleted:      true,
+				Removed:      sortedKeys(before.items),
+				DetectedAt:   now,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].CollectionID < changes[j].CollectionID })
+
+	return changes
+}
+
+// postWebhook POSTs a change to the webhook URL as JSON, or enqueues it on Queue
+func (w *CollectionWatcher) postWebhook(ctx context.Context, change CollectionChange) error {
+	if w.Queue == nil {
+		return postJSONWebhook(ctx, w.collections.sdkConfiguration, w.WebhookURL, change)
+	}
+
+	body, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("error serializing webhook payload: %w", err)
+	}
+	_, err = w.Queue.Enqueue(ctx, TaskKindCollectionWebhook, collectionWebhookTask{URL: w.WebhookURL, Change: body})
+	return err
+}
+
+// postJSONWebhook POSTs a payload to a webhook URL as JSON
+func postJSONWebhook(ctx context.Context, sdkConfig sdkConfiguration, webhookURL string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error serializing webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", sdkConfig.UserAgent)
+
+	res, err := sdkConfig.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending webhook: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// reportError passes an error to OnError, if set
+func (w *CollectionWatcher) reportError(err error) {
+	if w.OnError != nil {
+		w.OnError(err)
+	}
+}
+
+// sortedKeys returns the keys of a set in order
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
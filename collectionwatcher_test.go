@@ -0,0 +1,99 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCollectionWatcher(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	var webhooks []CollectionChange
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			polls++
+			if polls == 1 {
+				w.Write([]byte(`{"MediaContainer":{"Metadata":[
+					{"ratingKey":"5","title":"Heist","thumb":"/library/collections/5/thumb/1"},
+					{"ratingKey":"6","title":"Noir"}
+				]}}`))
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"5","title":"Heist","thumb":"/library/collections/5/thumb/2"},
+				{"ratingKey":"7","title":"Space"}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/5/children":
+			if polls == 1 {
+				w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"101"},{"ratingKey":"102"}]}}`))
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"102"},{"ratingKey":"103"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/6/children":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"201"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7/children":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"301"}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/webhook":
+			var change CollectionChange
+			if err := json.NewDecoder(r.Body).Decode(&change); err != nil {
+				t.Errorf("Error decoding webhook body: %v", err)
+			}
+			webhooks = append(webhooks, change)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	watcher := client.Collections.NewWatcher(1)
+	watcher.Interval = 10 * time.Millisecond
+	watcher.WebhookURL = server.URL + "/webhook"
+	watcher.OnError = func(err error) { t.Errorf("Unexpected watcher error: %v", err) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan CollectionChange)
+	done := make(chan error)
+	go func() { done <- watcher.Run(ctx, changes) }()
+
+	var received []CollectionChange
+	for len(received) < 3 {
+		received = append(received, <-changes)
+	}
+	cancel()
+
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+
+	heist, noir, space := received[0], received[1], received[2]
+
+	if heist.CollectionID != 5 || !heist.ArtworkChanged || len(heist.Added) != 1 || heist.Added[0] != "103" || len(heist.Removed) != 1 || heist.Removed[0] != "101" {
+		t.Errorf("Unexpected change of Heist: %+v", heist)
+	}
+	if noir.CollectionID != 6 || !noir.Deleted || noir.Collection != nil || len(noir.Removed) != 1 {
+		t.Errorf("Unexpected change of Noir: %+v", noir)
+	}
+	if space.CollectionID != 7 || !space.Created || len(space.Added) != 1 {
+		t.Errorf("Unexpected change of Space: %+v", space)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(webhooks) < 2 || webhooks[0].Title != "Heist" {
+		t.Errorf("Expected the changes to be posted to the webhook, got: %+v", webhooks)
+	}
+}
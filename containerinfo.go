@@ -0,0 +1,102 @@
+package plexgo
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// ContainerInfo is the container-level metadata of a list response: its pagination and the
+// library section and artwork the items belong to
+type ContainerInfo struct {
+	Size                int    `json:"size"`                // Items in this response
+	TotalSize           int    `json:"totalSize,omitempty"` // Items in all pages; 0 if the server doesn't paginate the list
+	Offset              int    `json:"offset,omitempty"`    // Index of the first item of this response
+	Identifier          string `json:"identifier,omitempty"`
+	LibrarySectionID    int    `json:"librarySectionID,omitempty"`
+	LibrarySectionTitle string `json:"librarySectionTitle,omitempty"`
+	MediaTagPrefix      string `json:"mediaTagPrefix,omitempty"`  // Path of media tag artwork, e.g. "/system/bundle/media/flags/"
+	MediaTagVersion     int64  `json:"mediaTagVersion,omitempty"` // Cache buster of media tag artwork
+	Thumb               string `json:"thumb,omitempty"`
+	Art                 string `json:"art,omitempty"`
+}
+
+// HasMore returns true if there are items after this response
+func (c ContainerInfo) HasMore() bool {
+	return c.TotalSize > 0 && c.Offset+c.Size < c.TotalSize
+}
+
+// NextOffset returns the X-Plex-Container-Start of the page after this response
+func (c ContainerInfo) NextOffset() int {
+	return c.Offset + c.Size
+}
+
+// GetContainerInfo returns the container-level metadata of a generated list response, e.g. a
+// *operations.GetAllMediaLibraryResponse, its Object or its MediaContainer. Fields the response
+// doesn't have are left zero; ok is false if v has no media container.
+func GetContainerInfo(v interface{}) (info ContainerInfo, ok bool) {
+	container := reflect.ValueOf(v)
+	for _, name := range []string{"Object", "MediaContainer"} {
+		if field := structField(container, name); field.IsValid() {
+			container = field
+		}
+	}
+
+	container = indirect(container)
+	if !container.IsValid() || container.Kind() != reflect.Struct || !structField(container, "Size").IsValid() {
+		return ContainerInfo{}, false
+	}
+
+	target := reflect.ValueOf(&info).Elem()
+	for i := 0; i < target.NumField(); i++ {
+		setContainerField(target.Field(i), structField(container, target.Type().Field(i).Name))
+	}
+
+	return info, true
+}
+
+// structField returns the named field of a struct or pointer to a struct, or the zero Value
+func structField(v reflect.Value, name string) reflect.Value {
+	v = indirect(v)
+	if !v.IsValid() || v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v.FieldByName(name)
+}
+
+// indirect dereferences pointers and interfaces, returning the zero Value for nil
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// setContainerField sets a ContainerInfo field from a field of a generated container, converting
+// between the integer, float and string types the generated models use
+func setContainerField(target reflect.Value, source reflect.Value) {
+	source = indirect(source)
+	if !source.IsValid() {
+		return
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		if source.Kind() == reflect.String {
+			target.SetString(source.String())
+		}
+	case reflect.Int, reflect.Int64:
+		switch source.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			target.SetInt(source.Int())
+		case reflect.Float32, reflect.Float64:
+			target.SetInt(int64(source.Float()))
+		case reflect.String:
+			if n, err := strconv.ParseInt(source.String(), 10, 64); err == nil {
+				target.SetInt(n)
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestGetContainerInfo(t *testing.T) {
+	res := &operations.GetAllMediaLibraryResponse{
+		Object: &operations.GetAllMediaLibraryResponseBody{
+			MediaContainer: &operations.GetAllMediaLibraryMediaContainer{
+				Size:                50,
+				TotalSize:           120,
+				Offset:              50,
+				LibrarySectionID:    1,
+				LibrarySectionTitle: "Movies",
+				MediaTagPrefix:      "/system/bundle/media/flags/",
+				MediaTagVersion:     1700000000,
+				Art:                 "/:/resources/movie-fanart.jpg",
+			},
+		},
+	}
+
+	info, ok := GetContainerInfo(res)
+	if !ok {
+		t.Fatalf("Expected the response to have a media container")
+	}
+	if info.Size != 50 || info.TotalSize != 120 || info.LibrarySectionID != 1 || info.MediaTagVersion != 1700000000 || info.Art != "/:/resources/movie-fanart.jpg" {
+		t.Errorf("Unexpected container info: %+v", info)
+	}
+	if !info.HasMore() || info.NextOffset() != 100 {
+		t.Errorf("Expected another page at 100, got: %+v", info)
+	}
+
+	if _, ok := GetContainerInfo(&operations.GetAllMediaLibraryResponse{}); ok {
+		t.Errorf("Expected no container info for a response without a body")
+	}
+}
+
+func TestListItemsPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		if query.Get("X-Plex-Container-Start") != "2" || query.Get("X-Plex-Container-Size") != "2" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.Write([]byte(`{"MediaContainer":{"size":2,"totalSize":3,"offset":2,"librarySectionID":1,"librarySectionTitle":"Movies","mediaTagPrefix":"/system/bundle/media/flags/","mediaTagVersion":1700000000,"Metadata":[{"ratingKey":"3"}]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	page, err := client.Library.ListItemsPage(context.Background(), 1, ItemListOptions{PageSize: 2}, 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if page.TotalSize != 3 || page.LibrarySectionTitle != "Movies" || page.MediaTagPrefix != "/system/bundle/media/flags/" || len(page.Metadata) != 1 {
+		t.Errorf("Unexpected page: %+v", page)
+	}
+}
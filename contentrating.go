@@ -0,0 +1,188 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// MPAARatings are the US film ratings, by the minimum age they are suitable for
+var MPAARatings = map[string]int{
+	"G":     0,
+	"PG":    8,
+	"PG-13": 13,
+	"R":     17,
+	"NC-17": 18,
+}
+
+// TVRatings are the US TV parental guidelines, by the minimum age they are suitable for
+var TVRatings = map[string]int{
+	"TV-Y":  0,
+	"TV-G":  0,
+	"TV-Y7": 7,
+	"TV-PG": 10,
+	"TV-14": 14,
+	"TV-MA": 17,
+}
+
+// InternationalRatings are common country ratings in the "country/rating" form Plex agents use,
+// by the minimum age they are suitable for
+var InternationalRatings = map[string]int{
+	"gb/U":   0,
+	"gb/PG":  8,
+	"gb/12":  12,
+	"gb/12A": 12,
+	"gb/15":  15,
+	"gb/18":  18,
+	"gb/R18": 18,
+	"de/0":   0,
+	"de/6":   6,
+	"de/12":  12,
+	"de/16":  16,
+	"de/18":  18,
+	"fr/U":   0,
+	"fr/10":  10,
+	"fr/12":  12,
+	"fr/16":  16,
+	"fr/18":  18,
+	"au/G":   0,
+	"au/PG":  8,
+	"au/M":   15,
+	"au/MA":  15,
+	"au/R":   18,
+	"ca/G":   0,
+	"ca/PG":  8,
+	"ca/14A": 14,
+	"ca/18A": 18,
+	"ca/R":   18,
+}
+
+// DefaultRatingTable combines MPAARatings, TVRatings and InternationalRatings. Ratings are compared
+// by minimum age, so a maximum rating in one system applies to the others too.
+var DefaultRatingTable = mergeRatingTables(MPAARatings, TVRatings, InternationalRatings)
+
+// ContentRatingCount is the number of items with a content rating in a library section
+type ContentRatingCount struct {
+	Rating string // "" for unrated items
+	Age    int    // Minimum age of the rating, or -1 if it's not in the rating table
+	Count  int
+}
+
+// WithRatingTable replaces the table content ratings are ordered by, mapping each rating to the
+// minimum age it is suitable for. Ratings are matched case-insensitively.
+func WithRatingTable(table map[string]int) operations.Option {
+	return helperOption(func(opts *helperOptions) {
+		opts.RatingTable = table
+	})
+}
+
+// GetContentRatingBreakdown counts the top-level items of a library section by content rating,
+// from the youngest to the oldest audience. Ratings missing from the rating table come last.
+func (s *Library) GetContentRatingBreakdown(ctx context.Context, sectionID int, opts ...operations.Option) ([]ContentRatingCount, error) {
+	items, err := s.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), nil, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting library items: %w", err)
+	}
+
+	table := ratingTable(opts)
+
+	counts := map[string]*ContentRatingCount{}
+	for _, item := range items {
+		count, ok := counts[item.ContentRating]
+		if !ok {
+			count = &ContentRatingCount{Rating: item.ContentRating, Age: ratingAge(table, item.ContentRating)}
+			counts[item.ContentRating] = count
+		}
+		count.Count++
+	}
+
+	breakdown := make([]ContentRatingCount, 0, len(counts))
+	for _, count := range counts {
+		breakdown = append(breakdown, *count)
+	}
+
+	sort.Slice(breakdown, func(i, j int) bool {
+		a, b := breakdown[i], breakdown[j]
+		if (a.Age < 0) != (b.Age < 0) {
+			return b.Age < 0
+		}
+		if a.Age != b.Age {
+			return a.Age < b.Age
+		}
+		return a.Rating < b.Rating
+	})
+
+	return breakdown, nil
+}
+
+// FindExceedingRating finds the top-level items of a library section rated above maxRating, e.g.
+// everything above "PG-13" in a section shared with children. Items whose rating is missing from
+// the rating table, including unrated items, are returned too since they can't be verified.
+func (s *Library) FindExceedingRating(ctx context.Context, sectionID int, maxRating string, opts ...operations.Option) ([]Metadata, error) {
+	table := ratingTable(opts)
+
+	maxAge := ratingAge(table, maxRating)
+	if maxAge < 0 {
+		return nil, fmt.Errorf("unknown content rating %q", maxRating)
+	}
+
+	items, err := s.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), nil, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting library items: %w", err)
+	}
+
+	exceeding := []Metadata{}
+	for _, item := range items {
+		if age := ratingAge(table, item.ContentRating); age < 0 || age > maxAge {
+			exceeding = append(exceeding, item)
+		}
+	}
+
+	return exceeding, nil
+}
+
+// ratingTable returns the rating table set with WithRatingTable, or DefaultRatingTable
+func ratingTable(opts []operations.Option) map[string]int {
+	if table := processOptions(opts).RatingTable; table != nil {
+		return table
+	}
+	return DefaultRatingTable
+}
+
+// ratingAge returns the minimum age of a content rating, or -1 if it's not in the table
+func ratingAge(table map[string]int, rating string) int {
+	rating = strings.TrimSpace(rating)
+	if rating == "" {
+		return -1
+	}
+
+	if age, ok := table[rating]; ok {
+		return age
+	}
+	for key, age := range table {
+		if strings.EqualFold(key, rating) {
+			return age
+		}
+	}
+
+	// US ratings are sometimes reported with a country prefix, e.g. "us/PG-13"
+	if strings.HasPrefix(strings.ToLower(rating), "us/") {
+		return ratingAge(table, rating[3:])
+	}
+
+	return -1
+}
+
+// mergeRatingTables returns a table with the ratings of all tables
+func mergeRatingTables(tables ...map[string]int) map[string]int {
+	merged := map[string]int{}
+	for _, table := range tables {
+		for rating, age := range table {
+			merged[rating] = age
+		}
+	}
+	return merged
+}
@@ -0,0 +1,88 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newContentRatingServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/library/sections/1/all" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"Metadata":[
+			{"ratingKey":"101","type":"movie","title":"Toy Story","contentRating":"G"},
+			{"ratingKey":"102","type":"movie","title":"Heat","contentRating":"R"},
+			{"ratingKey":"103","type":"movie","title":"Ronin","contentRating":"r"},
+			{"ratingKey":"104","type":"movie","title":"Paddington","contentRating":"gb/PG"},
+			{"ratingKey":"105","type":"movie","title":"Home Movie"},
+			{"ratingKey":"106","type":"movie","title":"Up","contentRating":"us/PG"}
+		]}}`))
+	}))
+}
+
+func TestGetContentRatingBreakdown(t *testing.T) {
+	server := newContentRatingServer(t)
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	breakdown, err := client.Library.GetContentRatingBreakdown(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := []ContentRatingCount{
+		{Rating: "G", Age: 0, Count: 1},
+		{Rating: "gb/PG", Age: 8, Count: 1},
+		{Rating: "us/PG", Age: 8, Count: 1},
+		{Rating: "R", Age: 17, Count: 1},
+		{Rating: "r", Age: 17, Count: 1},
+		{Rating: "", Age: -1, Count: 1},
+	}
+	if len(breakdown) != len(expected) {
+		t.Fatalf("Expected %d ratings, got: %+v", len(expected), breakdown)
+	}
+	for i := range expected {
+		if breakdown[i] != expected[i] {
+			t.Errorf("Expected %+v at %d, got: %+v", expected[i], i, breakdown[i])
+		}
+	}
+}
+
+func TestFindExceedingRating(t *testing.T) {
+	server := newContentRatingServer(t)
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	exceeding, err := client.Library.FindExceedingRating(context.Background(), 1, "PG-13")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var ratingKeys []string
+	for _, item := range exceeding {
+		ratingKeys = append(ratingKeys, item.RatingKey)
+	}
+	if len(ratingKeys) != 3 || ratingKeys[0] != "102" || ratingKeys[1] != "103" || ratingKeys[2] != "105" {
+		t.Errorf("Expected the R rated and unrated items, got: %v", ratingKeys)
+	}
+
+	// A custom table where PG is the strictest known rating
+	exceeding, err = client.Library.FindExceedingRating(context.Background(), 1, "PG", WithRatingTable(map[string]int{"G": 0, "PG": 1}))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(exceeding) != 4 {
+		t.Errorf("Expected 4 items outside the custom table, got: %d", len(exceeding))
+	}
+
+	if _, err := client.Library.FindExceedingRating(context.Background(), 1, "XYZ"); err == nil {
+		t.Error("Expected an error for an unknown maximum rating")
+	}
+}
@@ -0,0 +1,50 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// CorrelationIDHeader is the request header carrying the correlation ID of an operation
+const CorrelationIDHeader = sdkerrors.CorrelationIDHeader
+
+// WithCorrelationID returns a copy of ctx whose requests all carry the correlation ID id, so the
+// requests of several calls, e.g. of a job, can be traced together in logs. Without it, each
+// operation gets a new ID, which the operations of several steps, such as
+// CreateCollectionWithOptions, share across their requests.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return hooks.WithCorrelationID(ctx, id)
+}
+
+// CorrelationID returns the correlation ID of ctx: the ID set with WithCorrelationID or, in the
+// context of a request as seen by middlewares, the ID the request was sent with. It returns ""
+// if there is none.
+func CorrelationID(ctx context.Context) string {
+	return hooks.CorrelationID(ctx)
+}
+
+// ErrorCorrelationID returns the correlation ID of the request that failed with an
+// *sdkerrors.SDKError or *sdkerrors.PlexError, or "" for other errors
+func ErrorCorrelationID(err error) string {
+	var plexErr *sdkerrors.PlexError
+	if errors.As(err, &plexErr) {
+		return plexErr.CorrelationID
+	}
+	var sdkErr *sdkerrors.SDKError
+	if errors.As(err, &sdkErr) {
+		return sdkErr.CorrelationID
+	}
+	return ""
+}
+
+// withOperationCorrelationID returns ctx with a new correlation ID unless it already has one, so
+// the requests of an operation of several steps share an ID
+func withOperationCorrelationID(ctx context.Context) context.Context {
+	if hooks.CorrelationID(ctx) != "" {
+		return ctx
+	}
+	return hooks.WithCorrelationID(ctx, hooks.NewCorrelationID())
+}
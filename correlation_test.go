@@ -0,0 +1,85 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCorrelationID(t *testing.T) {
+	var received []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(CorrelationIDHeader))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,
+				"content":"/library/sections/1/all?type=1&viewedAt>>=-30d",
+				"Metadata":[{"ratingKey":"7","title":"Recently Watched","smart":"1","subtype":"movie","type":"collection","librarySectionID":1}]}}`))
+		case r.URL.Path == "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"Meta":{"Type":[{"type":"movie","Field":[{"key":"lastViewedAt"}]}]}}}`))
+		case r.URL.Path == "/library/collections/7/items":
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":1002,"message":"Collection not found","status":404}]}`))
+		}
+	}))
+	defer server.Close()
+
+	var seen string
+	client := New(WithServerURL(server.URL), WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			seen = CorrelationID(req.Context())
+			return next.RoundTrip(req)
+		})
+	}))
+	ctx := context.Background()
+
+	// Each operation gets its own ID, which middlewares and errors report
+	_, err := client.Collections.GetCollection(ctx, 404)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if len(received) != 1 || len(received[0]) != 16 || seen != received[0] {
+		t.Fatalf("Expected a correlation ID seen by the middleware, got: %v, %s", received, seen)
+	}
+	if ErrorCorrelationID(err) != received[0] || !strings.Contains(err.Error(), "(correlation ID "+received[0]+")") {
+		t.Errorf("Expected the error to carry %s, got: %v", received[0], err)
+	}
+
+	received = nil
+	client.Collections.GetCollection(ctx, 404)
+	client.Collections.GetCollection(ctx, 404)
+	if len(received) != 2 || received[0] == received[1] {
+		t.Errorf("Expected a new ID per operation, got: %v", received)
+	}
+
+	// IDs set by the caller are used for every request
+	received = nil
+	_, err = client.Collections.GetCollection(WithCorrelationID(ctx, "nightly-sync-42"), 404)
+	if len(received) != 1 || received[0] != "nightly-sync-42" || ErrorCorrelationID(err) != "nightly-sync-42" {
+		t.Errorf("Expected the caller's ID, got: %v, %v", received, err)
+	}
+
+	// The steps of an operation share an ID
+	received = nil
+	if _, err := client.Collections.RepairSmart(ctx, 7); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(received) < 3 {
+		t.Fatalf("Expected several requests, got: %v", received)
+	}
+	for _, id := range received {
+		if id != received[0] || id == "" {
+			t.Errorf("Expected the requests to share an ID, got: %v", received)
+			break
+		}
+	}
+
+	if ErrorCorrelationID(context.Canceled) != "" {
+		t.Error("Expected no ID for other errors")
+	}
+}
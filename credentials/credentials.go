@@ -0,0 +1,390 @@
+// Package credentials persists Plex tokens between runs of a CLI, in the OS keyring where one is
+// available and otherwise in a passphrase-encrypted file, and obtains them through the plex.tv/link
+// PIN flow so users never have to paste tokens into env files.
+package credentials
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// keyIterations is the number of PBKDF2 iterations deriving the file encryption key
+const keyIterations = 100000
+
+var (
+	// ErrNotFound is returned when no token is stored for an account
+	ErrNotFound = errors.New("credentials: no token stored")
+	// ErrKeyringUnavailable is returned when the OS keyring can't be used, e.g. on a headless server
+	ErrKeyringUnavailable = errors.New("credentials: keyring unavailable")
+	// ErrWrongPassphrase is returned when a credentials file can't be decrypted
+	ErrWrongPassphrase = errors.New("credentials: wrong passphrase or corrupted file")
+)
+
+// Store stores tokens by account, e.g. by the client identifier of the app
+type Store interface {
+	Get(account string) (string, error)
+	Set(account, token string) error
+	Delete(account string) error
+}
+
+// runFunc runs a command with stdin, returning its output and exit code
+type runFunc func(stdin string, name string, args ...string) (stdout, stderr string, code int, err error)
+
+func run(stdin string, name string, args ...string) (string, string, int, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return stdout.String(), stderr.String(), exitErr.ExitCode(), nil
+	}
+	return stdout.String(), stderr.String(), 0, err
+}
+
+// keyringTools are the commands used to access the keyring of each OS
+var keyringTools = map[string]string{
+	"darwin": "security",    // macOS Keychain
+	"linux":  "secret-tool", // Secret Service, e.g. GNOME Keyring or KWallet
+}
+
+// KeyringAvailable returns true if the OS keyring tool is installed
+func KeyringAvailable() bool {
+	tool, ok := keyringTools[runtime.GOOS]
+	if !ok {
+		return false
+	}
+	_, err := exec.LookPath(tool)
+	return err == nil
+}
+
+// keyring stores tokens in the OS keyring through its command line tool
+type keyring struct {
+	service string
+	goos    string
+	run     runFunc
+}
+
+// Keyring returns a store backed by the macOS Keychain or the Secret Service on Linux, keeping
+// tokens under the given service name, e.g. the name of the CLI
+func Keyring(service string) Store {
+	return &keyring{service: service, goos: runtime.GOOS, run: run}
+}
+
+func (k *keyring) Get(account string) (string, error) {
+	switch k.goos {
+	case "darwin":
+		out, stderr, code, err := k.run("", "security", "find-generic-password", "-s", k.service, "-a", account, "-w")
+		if err := k.check(code, 44, stderr, err); err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(out, "\n"), nil
+	case "linux":
+		out, stderr, code, err := k.run("", "secret-tool", "lookup", "service", k.service, "account", account)
+		if code == 1 && out == "" && stderr == "" {
+			return "", ErrNotFound
+		}
+		if err := k.check(code, -1, stderr, err); err != nil {
+			return "", err
+		}
+		return out, nil
+	default:
+		return "", fmt.Errorf("%w: no keyring support on %s", ErrKeyringUnavailable, k.goos)
+	}
+}
+
+func (k *keyring) Set(account, token string) error {
+	switch k.goos {
+	case "darwin":
+		// security only takes the password as an argument, which other users see in the process
+		// list, so the command is passed to its interactive mode on stdin instead
+		command := securityCommand("add-generic-password", "-U", "-s", k.service, "-a", account, "-w", token)
+		_, stderr, code, err := k.run(command, "security", "-i")
+		// The interactive mode reports a failed command on stderr, not with its exit code
+		if code == 0 && strings.TrimSpace(stderr) != "" {
+			code = 1
+		}
+		return k.check(code, -1, stderr, err)
+	case "linux":
+		_, stderr, code, err := k.run(token, "secret-tool", "store", "--label", fmt.Sprintf("%s (%s)", k.service, account),
+			"service", k.service, "account", account)
+		return k.check(code, -1, stderr, err)
+	default:
+		return fmt.Errorf("%w: no keyring support on %s", ErrKeyringUnavailable, k.goos)
+	}
+}
+
+func (k *keyring) Delete(account string) error {
+	switch k.goos {
+	case "darwin":
+		_, stderr, code, err := k.run("", "security", "delete-generic-password", "-s", k.service, "-a", account)
+		return k.check(code, 44, stderr, err)
+	case "linux":
+		_, stderr, code, err := k.run("", "secret-tool", "clear", "service", k.service, "account", account)
+		return k.check(code, -1, stderr, err)
+	default:
+		return fmt.Errorf("%w: no keyring support on %s", ErrKeyringUnavailable, k.goos)
+	}
+}
+
+// securityCommand quotes the arguments of a command line for security's interactive mode
+func securityCommand(args ...string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		arg = strings.ReplaceAll(arg, `\`, `\\`)
+		quoted[i] = `"` + strings.ReplaceAll(arg, `"`, `\"`) + `"`
+	}
+	return strings.Join(quoted, " ") + "\n"
+}
+
+// check turns the result of a keyring command into an error. notFound is the exit code the tool
+// uses for missing items, or -1 if it has none.
+func (k *keyring) check(code, notFound int, stderr string, err error) error {
+	switch {
+	case err != nil:
+		return fmt.Errorf("%w: %v", ErrKeyringUnavailable, err)
+	case code == 0:
+		return nil
+	case code == notFound:
+		return ErrNotFound
+	default:
+		return fmt.Errorf("%w: %s", ErrKeyringUnavailable, strings.TrimSpace(stderr))
+	}
+}
+
+// file stores tokens in a JSON file encrypted with AES-GCM
+type file struct {
+	path       string
+	passphrase string
+	mu         sync.Mutex
+}
+
+// fileEnvelope is the on-disk format of a credentials file
+type fileEnvelope struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// File returns a store that keeps tokens in a file only readable by the current user, encrypted
+// with a key derived from the passphrase. The file is created on the first Set.
+func File(path, passphrase string) Store {
+	return &file{path: path, passphrase: passphrase}
+}
+
+// DefaultPath returns the default credentials file path, e.g. ~/.config/plexgo/credentials.json
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "plexgo", "credentials.json"), nil
+}
+
+func (f *file) Get(account string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tokens, err := f.load()
+	if err != nil {
+		return "", err
+	}
+	token, ok := tokens[account]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return token, nil
+}
+
+func (f *file) Set(account, token string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tokens, err := f.load()
+	if err != nil {
+		return err
+	}
+	tokens[account] = token
+	return f.save(tokens)
+}
+
+func (f *file) Delete(account string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tokens, err := f.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[account]; !ok {
+		return ErrNotFound
+	}
+	delete(tokens, account)
+	return f.save(tokens)
+}
+
+// load decrypts the tokens in the file, returning no tokens if it doesn't exist yet
+func (f *file) load() (map[string]string, error) {
+	tokens := map[string]string{}
+
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading credentials: %w", err)
+	}
+
+	var envelope fileEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil || envelope.Version != 1 {
+		return nil, ErrWrongPassphrase
+	}
+	gcm, err := newGCM(f.passphrase, envelope.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(envelope.Nonce) != gcm.NonceSize() {
+		return nil, ErrWrongPassphrase
+	}
+	plaintext, err := gcm.Open(nil, envelope.Nonce, envelope.Data, nil)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	if err := json.Unmarshal(plaintext, &tokens); err != nil {
+		return nil, fmt.Errorf("error decoding credentials: %w", err)
+	}
+	return tokens, nil
+}
+
+// save encrypts the tokens with a fresh salt and nonce and replaces the file
+func (f *file) save(tokens map[string]string) error {
+	plaintext, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	envelope := fileEnvelope{Version: 1, Salt: make([]byte, 16), Nonce: make([]byte, 12)}
+	if _, err := rand.Read(envelope.Salt); err != nil {
+		return err
+	}
+	if _, err := rand.Read(envelope.Nonce); err != nil {
+		return err
+	}
+	gcm, err := newGCM(f.passphrase, envelope.Salt)
+	if err != nil {
+		return err
+	}
+	envelope.Data = gcm.Seal(nil, envelope.Nonce, plaintext, nil)
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(f.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("error creating credentials directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".credentials-*")
+	if err != nil {
+		return fmt.Errorf("error writing credentials: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing credentials: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing credentials: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return fmt.Errorf("error writing credentials: %w", err)
+	}
+	return nil
+}
+
+// newGCM returns an AES-256-GCM cipher keyed by the passphrase
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(deriveKey(passphrase, salt))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// deriveKey derives a 32 byte key from a passphrase with PBKDF2-HMAC-SHA256
+func deriveKey(passphrase string, salt []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(passphrase))
+	mac.Write(salt)
+	mac.Write([]byte{0, 0, 0, 1})
+	u := mac.Sum(nil)
+
+	key := append([]byte(nil), u...)
+	for i := 1; i < keyIterations; i++ {
+		mac.Reset()
+		mac.Write(u)
+		u = mac.Sum(u[:0])
+		for j := range key {
+			key[j] ^= u[j]
+		}
+	}
+	return key
+}
+
+// fallback uses the keyring, switching to the file when the keyring turns out to be unavailable
+type fallback struct {
+	keyring Store
+	file    Store
+}
+
+// Default returns the keyring store of the service if the OS has one and a file store at path,
+// encrypted with the passphrase, otherwise. Keyrings that fail at runtime, e.g. without a D-Bus
+// session, also fall back to the file.
+func Default(service, path, passphrase string) Store {
+	if !KeyringAvailable() {
+		return File(path, passphrase)
+	}
+	return &fallback{keyring: Keyring(service), file: File(path, passphrase)}
+}
+
+func (s *fallback) Get(account string) (string, error) {
+	token, err := s.keyring.Get(account)
+	if errors.Is(err, ErrKeyringUnavailable) {
+		return s.file.Get(account)
+	}
+	return token, err
+}
+
+func (s *fallback) Set(account, token string) error {
+	err := s.keyring.Set(account, token)
+	if errors.Is(err, ErrKeyringUnavailable) {
+		return s.file.Set(account, token)
+	}
+	return err
+}
+
+func (s *fallback) Delete(account string) error {
+	err := s.keyring.Delete(account)
+	if errors.Is(err, ErrKeyringUnavailable) {
+		return s.file.Delete(account)
+	}
+	return err
+}
@@ -0,0 +1,182 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plexgo", "credentials.json")
+	store := File(path, "hunter2")
+
+	if _, err := store.Get("client"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, got: %v", err)
+	}
+	if err := store.Set("client", "secret-token"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected the file to exist, got: %v", err)
+	}
+	if strings.Contains(string(data), "secret-token") {
+		t.Errorf("Expected the token to be encrypted, got: %s", data)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected the file to be private, got: %v", info.Mode())
+	}
+
+	if token, err := File(path, "hunter2").Get("client"); err != nil || token != "secret-token" {
+		t.Errorf("Expected the stored token, got: %q, %v", token, err)
+	}
+	if _, err := File(path, "wrong").Get("client"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("Expected ErrWrongPassphrase, got: %v", err)
+	}
+
+	if err := store.Delete("client"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := store.Get("client"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after Delete, got: %v", err)
+	}
+}
+
+func TestKeyringStore(t *testing.T) {
+	items := map[string]string{}
+	var argv []string
+	fake := func(stdin string, name string, args ...string) (string, string, int, error) {
+		argv = append(argv, args...)
+		switch args[0] {
+		case "-i":
+			if stdin != `"add-generic-password" "-U" "-s" "plexctl" "-a" "client" "-w" "secret \"token\""`+"\n" {
+				t.Errorf("Unexpected security command: %q", stdin)
+			}
+		case "store":
+			items[args[len(args)-1]] = stdin
+		case "lookup":
+			token, ok := items[args[len(args)-1]]
+			if !ok {
+				return "", "", 1, nil
+			}
+			return token, "", 0, nil
+		case "clear":
+			delete(items, args[len(args)-1])
+		case "find-generic-password":
+			return "", "security: The specified item could not be found in the keychain.", 44, nil
+		}
+		return "", "", 0, nil
+	}
+
+	store := &keyring{service: "plexctl", goos: "linux", run: fake}
+	if _, err := store.Get("client"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, got: %v", err)
+	}
+	if err := store.Set("client", "secret-token"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if token, err := store.Get("client"); err != nil || token != "secret-token" {
+		t.Errorf("Expected the stored token, got: %q, %v", token, err)
+	}
+	if err := store.Delete("client"); err != nil || len(items) != 0 {
+		t.Errorf("Expected the token to be deleted, got: %v, %v", items, err)
+	}
+
+	mac := &keyring{service: "plexctl", goos: "darwin", run: fake}
+	if _, err := mac.Get("client"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing keychain item, got: %v", err)
+	}
+	if err := mac.Set("client", `secret "token"`); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+
+	// Tokens are passed on stdin, never in the process list
+	for _, arg := range argv {
+		if strings.Contains(arg, "token") {
+			t.Errorf("Expected no token in the arguments, got: %v", argv)
+		}
+	}
+
+	broken := &keyring{service: "plexctl", goos: "linux", run: func(string, string, ...string) (string, string, int, error) {
+		return "", "Cannot autolaunch D-Bus without X11 $DISPLAY", 1, nil
+	}}
+	file := File(filepath.Join(t.TempDir(), "credentials.json"), "hunter2")
+	fallbackStore := &fallback{keyring: broken, file: file}
+	if err := fallbackStore.Set("client", "secret-token"); err != nil {
+		t.Fatalf("Expected the file fallback, got: %v", err)
+	}
+	if token, err := file.Get("client"); err != nil || token != "secret-token" {
+		t.Errorf("Expected the token in the file, got: %q, %v", token, err)
+	}
+
+	if _, err := (&keyring{goos: "windows"}).Get("client"); !errors.Is(err, ErrKeyringUnavailable) {
+		t.Errorf("Expected ErrKeyringUnavailable, got: %v", err)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Plex-Client-Identifier") != "plexctl-1" || r.Header.Get("X-Plex-Product") != "plexctl" {
+			t.Errorf("Unexpected client headers: %v", r.Header)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		expiresAt := time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/pins":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":308667304,"code":"7ZJA","product":"plexctl","qr":"","clientIdentifier":"plexctl-1","location":{"code":"US","continent_code":"NA","country":"United States","city":"Austin","time_zone":"America/Chicago","postal_code":"78701","subdivisions":"Texas","coordinates":"0, 0"},"createdAt":"2024-05-01T12:00:00Z","expiresAt":"` + expiresAt + `"}`))
+		case r.Method == "GET" && r.URL.Path == "/pins/308667304":
+			polls++
+			token := ""
+			if polls == 2 {
+				token = `,"authToken":"linked-token"`
+			}
+			w.Write([]byte(`{"id":308667304,"code":"7ZJA","product":"plexctl","qr":"","clientIdentifier":"plexctl-1","location":{"code":"US","continent_code":"NA","country":"United States","city":"Austin","time_zone":"America/Chicago","postal_code":"78701","subdivisions":"Texas","coordinates":"0, 0"},"createdAt":"2024-05-01T12:00:00Z","expiresAt":"` + expiresAt + `"` + token + `}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := plexgo.New()
+	store := File(filepath.Join(t.TempDir(), "credentials.json"), "hunter2")
+
+	var shown string
+	options := LoginOptions{
+		ClientID:     "plexctl-1",
+		Product:      "plexctl",
+		Prompt:       func(code string) error { shown = code; return nil },
+		PollInterval: time.Millisecond,
+	}
+	token, err := Login(context.Background(), client, store, options, operations.WithServerURL(server.URL))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if token != "linked-token" || shown != "7ZJA" || polls != 2 {
+		t.Errorf("Unexpected login: token %q, code %q, %d polls", token, shown, polls)
+	}
+
+	// The stored token is reused without asking plex.tv
+	token, err = Login(context.Background(), client, store, options, operations.WithServerURL(server.URL))
+	if err != nil || token != "linked-token" || polls != 2 {
+		t.Errorf("Expected the stored token, got: %q, %v", token, err)
+	}
+
+	if err := Logout(store, "plexctl-1"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := store.Get("plexctl-1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected the token to be removed, got: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// LinkURL is where users enter the code shown by Login
+const LinkURL = "https://plex.tv/link"
+
+// ErrPinExpired is returned when the user didn't enter the code before the PIN expired
+var ErrPinExpired = errors.New("credentials: PIN expired before it was linked")
+
+// LoginOptions configures Login
+type LoginOptions struct {
+	ClientID     string                  // X-Plex-Client-Identifier of the app; tokens are stored under it
+	Product      string                  // X-Plex-Product shown on plex.tv, "plexgo" if empty
+	Prompt       func(code string) error // Shows the code to the user, who enters it at LinkURL
+	PollInterval time.Duration           // How often to check whether the code was entered, 2s if 0
+}
+
+// Login returns the token stored for the client, or runs the PIN flow when there is none: it
+// requests a PIN from plex.tv, shows its code through Prompt, waits until the user links it at
+// LinkURL, and stores the resulting token for the next run. The opts are passed to the plex.tv
+// calls.
+func Login(ctx context.Context, client *plexgo.PlexAPI, store Store, options LoginOptions, opts ...operations.Option) (string, error) {
+	if options.ClientID == "" {
+		return "", fmt.Errorf("a client ID is required")
+	}
+	if options.Prompt == nil {
+		return "", fmt.Errorf("a prompt is required")
+	}
+
+	token, err := store.Get(options.ClientID)
+	if err == nil {
+		return token, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return "", err
+	}
+
+	token, err = linkPin(ctx, client, options, opts)
+	if err != nil {
+		return "", err
+	}
+	if err := store.Set(options.ClientID, token); err != nil {
+		return "", fmt.Errorf("error storing token: %w", err)
+	}
+	return token, nil
+}
+
+// Logout removes the token stored for the client, so the next Login runs the PIN flow again
+func Logout(store Store, clientID string) error {
+	err := store.Delete(clientID)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// linkPin runs the PIN flow and returns the token once the user linked the PIN
+func linkPin(ctx context.Context, client *plexgo.PlexAPI, options LoginOptions, opts []operations.Option) (string, error) {
+	product := options.Product
+	if product == "" {
+		product = "plexgo"
+	}
+	interval := options.PollInterval
+	if interval == 0 {
+		interval = 2 * time.Second
+	}
+
+	res, err := client.Plex.GetPin(ctx, operations.GetPinRequest{
+		ClientID:   options.ClientID,
+		ClientName: plexgo.String(product),
+	}, opts...)
+	if err != nil {
+		return "", fmt.Errorf("error requesting PIN: %w", err)
+	}
+	pin := res.AuthPinContainer
+	if pin == nil {
+		return "", fmt.Errorf("plex.tv returned no PIN")
+	}
+
+	expiresAt := pin.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresIn := int64(900)
+		if pin.ExpiresIn != nil {
+			expiresIn = *pin.ExpiresIn
+		}
+		expiresAt = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+
+	if err := options.Prompt(pin.Code); err != nil {
+		return "", err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+
+		res, err := client.Plex.GetTokenByPinID(ctx, operations.GetTokenByPinIDRequest{
+			PinID:      pin.ID,
+			ClientID:   options.ClientID,
+			ClientName: plexgo.String(product),
+		}, opts...)
+		if err != nil {
+			return "", fmt.Errorf("error checking PIN: %w", err)
+		}
+		if res.AuthPinContainer != nil && res.AuthPinContainer.AuthToken != nil && *res.AuthPinContainer.AuthToken != "" {
+			return *res.AuthPinContainer.AuthToken, nil
+		}
+		if time.Now().After(expiresAt) {
+			return "", ErrPinExpired
+		}
+	}
+}
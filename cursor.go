@@ -0,0 +1,178 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Cursor is a position in a paginated list. Pass one to a ...Page method to list a page; the
+// cursor it returns describes that page, so HasNext tells whether to go on and Next is the cursor
+// of the following page. Cursors are plain values, so they can be kept between requests, e.g. to
+// serve pages of an app's own API.
+type Cursor struct {
+	Offset    int // Index of the first item of the page
+	PageSize  int // Items requested per page, 100 if not set
+	Size      int // Items in the page; set on cursors returned by ...Page methods
+	TotalSize int // Items in all pages; -1 if the server didn't report it
+}
+
+// HasNext returns true if there are items after the page. If the server didn't report the total
+// size, a full page is assumed to be followed by another.
+func (c Cursor) HasNext() bool {
+	if c.TotalSize < 0 {
+		return c.Size > 0 && c.Size >= c.pageSize()
+	}
+	return c.Offset+c.Size < c.TotalSize
+}
+
+// Next returns the cursor of the page after this one
+func (c Cursor) Next() Cursor {
+	return Cursor{Offset: c.Offset + c.Size, PageSize: c.pageSize(), TotalSize: c.TotalSize}
+}
+
+// pageSize returns PageSize, or the default page size if it is not set
+func (c Cursor) pageSize() int {
+	if c.PageSize <= 0 {
+		return defaultItemPageSize
+	}
+	return c.PageSize
+}
+
+// addTo returns a copy of queryParams with the cursor's page requested
+func (c Cursor) addTo(queryParams url.Values) url.Values {
+	params := url.Values{}
+	for key, values := range queryParams {
+		params[key] = values
+	}
+	params.Set("X-Plex-Container-Start", strconv.Itoa(c.Offset))
+	params.Set("X-Plex-Container-Size", strconv.Itoa(c.pageSize()))
+	return params
+}
+
+// page returns the cursor describing a listed page of size items
+func (c Cursor) page(info ContainerInfo, size int) Cursor {
+	page := Cursor{Offset: c.Offset, PageSize: c.pageSize(), Size: size, TotalSize: info.TotalSize}
+	if info.TotalSize == 0 && size > 0 {
+		page.TotalSize = -1
+	}
+	return page
+}
+
+// ItemsPage lists a page of the items of a library section. Unlike ListItemsPage, it takes and
+// returns a Cursor; listOptions.PageSize and Limit are ignored.
+func (s *Library) ItemsPage(ctx context.Context, sectionID int, listOptions ItemListOptions, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error) {
+	container, err := s.listItemsPage(ctx, sectionID, listOptions, cursor.Offset, cursor.pageSize(), opts...)
+	if err != nil {
+		return nil, cursor, err
+	}
+
+	return container.Metadata, cursor.page(container.ContainerInfo, len(container.Metadata)), nil
+}
+
+// metadataPage lists a page of a metadata listing such as the items of a collection
+func (s *Library) metadataPage(ctx context.Context, path string, queryParams url.Values, operationID string, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error) {
+	container, err := s.listMetadataContainer(ctx, path, cursor.addTo(queryParams), operationID, opts...)
+	if err != nil {
+		return nil, cursor, err
+	}
+
+	return container.Metadata, cursor.page(container.ContainerInfo, len(container.Metadata)), nil
+}
+
+// GetCollectionsPage lists a page of the collections of a library section
+func (s *Collections) GetCollectionsPage(ctx context.Context, sectionID int, cursor Cursor, opts ...operations.Option) ([]Collection, Cursor, error) {
+	var out struct {
+		MediaContainer struct {
+			ContainerInfo
+			Metadata []Collection `json:"Metadata,omitempty"`
+		} `json:"MediaContainer"`
+	}
+
+	path := fmt.Sprintf("/library/sections/%d/collections", sectionID)
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, path, cursor.addTo(nil), "getAllCollections", &out, opts...); err != nil {
+		return nil, cursor, fmt.Errorf("error listing collections of section %d: %w", sectionID, err)
+	}
+
+	container := out.MediaContainer
+	return container.Metadata, cursor.page(container.ContainerInfo, len(container.Metadata)), nil
+}
+
+// GetCollectionChildrenPage lists a page of the items of a collection
+func (s *Collections) GetCollectionChildrenPage(ctx context.Context, collectionID int, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error) {
+	items, page, err := newLibrary(s.sdkConfiguration).metadataPage(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), nil, "getCollectionChildren", cursor, opts...)
+	if err != nil {
+		return nil, cursor, fmt.Errorf("error listing items of collection %d: %w", collectionID, err)
+	}
+	return items, page, nil
+}
+
+// GetPlaylistsPage lists a page of the playlists of the server
+func (s *Playlists) GetPlaylistsPage(ctx context.Context, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error) {
+	playlists, page, err := newLibrary(s.sdkConfiguration).metadataPage(ctx, "/playlists", nil, "getPlaylists", cursor, opts...)
+	if err != nil {
+		return nil, cursor, fmt.Errorf("error listing playlists: %w", err)
+	}
+	return playlists, page, nil
+}
+
+// GetPlaylistItemsPage lists a page of the items of a playlist
+func (s *Playlists) GetPlaylistItemsPage(ctx context.Context, playlistID int, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error) {
+	items, page, err := newLibrary(s.sdkConfiguration).metadataPage(ctx, fmt.Sprintf("/playlists/%d/items", playlistID), nil, "getPlaylistContents", cursor, opts...)
+	if err != nil {
+		return nil, cursor, fmt.Errorf("error listing items of playlist %d: %w", playlistID, err)
+	}
+	return items, page, nil
+}
+
+// GetHistoryPage lists a page of the watch history of the server, most recent first. With an
+// accountID of 0 the history of all accounts is listed.
+func (s *Sessions) GetHistoryPage(ctx context.Context, accountID int, cursor Cursor, opts ...operations.Option) ([]operations.GetSessionHistoryMetadata, Cursor, error) {
+	var out struct {
+		MediaContainer struct {
+			ContainerInfo
+			Metadata []operations.GetSessionHistoryMetadata `json:"Metadata,omitempty"`
+		} `json:"MediaContainer"`
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("sort", "viewedAt:desc")
+	if accountID > 0 {
+		queryParams.Add("accountID", strconv.Itoa(accountID))
+	}
+
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, "/status/sessions/history/all", cursor.addTo(queryParams), "getSessionHistory", &out, opts...); err != nil {
+		return nil, cursor, fmt.Errorf("error listing watch history: %w", err)
+	}
+
+	container := out.MediaContainer
+	return container.Metadata, cursor.page(container.ContainerInfo, len(container.Metadata)), nil
+}
+
+// GetWatchlistPage lists a page of the plex.tv watchlist of the account. The filter is "all",
+// "available" or "released"; all titles are listed if it is "".
+func (s *Watchlist) GetWatchlistPage(ctx context.Context, filter string, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error) {
+	if filter == "" {
+		filter = string(operations.FilterAll)
+	}
+
+	options := processOptions(opts)
+
+	baseURL := utils.ReplaceParameters(operations.GetWatchListServerList[0], map[string]string{})
+	if options.ServerURL != nil {
+		baseURL = *options.ServerURL
+	}
+
+	watchlistConfig := s.sdkConfiguration
+	watchlistConfig.ServerURL = baseURL
+
+	items, page, err := newLibrary(watchlistConfig).metadataPage(ctx, "/library/sections/watchlist/"+filter, nil, "getWatchList", cursor, withoutServerURL(opts)...)
+	if err != nil {
+		return nil, cursor, fmt.Errorf("error listing watchlist: %w", err)
+	}
+	return items, page, nil
+}
@@ -0,0 +1,107 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		start, _ := strconv.Atoi(query.Get("X-Plex-Container-Start"))
+		size, _ := strconv.Atoi(query.Get("X-Plex-Container-Size"))
+
+		switch r.URL.Path {
+		case "/library/sections/1/all":
+			// 5 items, with totalSize
+			items := ""
+			for i := start; i < start+size && i < 5; i++ {
+				if items != "" {
+					items += ","
+				}
+				items += fmt.Sprintf(`{"ratingKey":"%d"}`, i+1)
+			}
+			fmt.Fprintf(w, `{"MediaContainer":{"size":%d,"totalSize":5,"offset":%d,"Metadata":[%s]}}`, size, start, items)
+		case "/playlists/9/items":
+			// 3 items, without totalSize
+			if start == 0 {
+				w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[{"ratingKey":"1"},{"ratingKey":"2"}]}}`))
+			} else {
+				w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"3"}]}}`))
+			}
+		case "/status/sessions/history/all":
+			if query.Get("accountID") != "7" || query.Get("sort") != "viewedAt:desc" {
+				t.Errorf("Expected the history of account 7, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"totalSize":1,"Metadata":[{"ratingKey":"4","title":"Alien","viewedAt":1700000000}]}}`))
+		case "/library/sections/watchlist/all":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"totalSize":1,"Metadata":[{"ratingKey":"5d776b59ad5437001f79c6f8","title":"Dune"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	var keys []string
+	cursor := Cursor{PageSize: 2}
+	for pages := 0; ; pages++ {
+		items, page, err := client.Library.ItemsPage(ctx, 1, ItemListOptions{}, cursor)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if page.TotalSize != 5 || page.Size != len(items) {
+			t.Errorf("Unexpected page: %+v", page)
+		}
+		for _, item := range items {
+			keys = append(keys, item.RatingKey)
+		}
+		if !page.HasNext() || pages > 5 {
+			break
+		}
+		cursor = page.Next()
+	}
+	if fmt.Sprint(keys) != "[1 2 3 4 5]" {
+		t.Errorf("Expected items 1 to 5, got: %v", keys)
+	}
+
+	items, page, err := client.Playlists.GetPlaylistItemsPage(ctx, 9, Cursor{PageSize: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(items) != 2 || page.TotalSize != -1 || !page.HasNext() {
+		t.Errorf("Expected a full page without a total size to have a next page, got: %+v", page)
+	}
+	items, page, err = client.Playlists.GetPlaylistItemsPage(ctx, 9, page.Next())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(items) != 1 || page.Offset != 2 || page.HasNext() {
+		t.Errorf("Expected a short last page, got: %+v", page)
+	}
+
+	history, page, err := client.Sessions.GetHistoryPage(ctx, 7, Cursor{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(history) != 1 || history[0].ViewedAt == nil || *history[0].ViewedAt != 1700000000 || page.HasNext() {
+		t.Errorf("Unexpected history: %+v %+v", history, page)
+	}
+
+	watchlist, _, err := client.Watchlist.GetWatchlistPage(ctx, "", Cursor{}, operations.WithServerURL(server.URL))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(watchlist) != 1 || watchlist[0].Title != "Dune" {
+		t.Errorf("Unexpected watchlist: %+v", watchlist)
+	}
+}
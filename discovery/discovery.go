@@ -0,0 +1,127 @@
+// Package discovery finds the Plex Media Servers of a plex.tv account and a working connection to
+// each, so tools can be pointed at a server by name instead of an address that may change.
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// probeTimeout is how long Connect waits for each connection to respond
+const probeTimeout = 5 * time.Second
+
+// ErrNoServer is returned by Find when the account has no matching server
+var ErrNoServer = errors.New("discovery: no matching server")
+
+// ErrUnreachable is returned by Connect when none of the connections of a server respond
+var ErrUnreachable = errors.New("discovery: server is unreachable")
+
+// Server is a Plex Media Server of the account, with its connections sorted local first, then
+// remote and relay
+type Server struct {
+	plexgo.ServerConnections
+	Owned       bool   // Whether the account owns the server, rather than it being shared with it
+	AccessToken string // Token for requests to the server, which differs from the account's for shared servers
+}
+
+// Servers lists the servers the account of the client's token owns or has access to. clientID
+// identifies the calling app to plex.tv, as for Plex.GetServerResources. The opts are passed to
+// the plex.tv call.
+func Servers(ctx context.Context, client *plexgo.PlexAPI, clientID string, opts ...operations.Option) ([]Server, error) {
+	https := operations.IncludeHTTPSEnable
+	relay := operations.IncludeRelayEnable
+	ipv6 := operations.IncludeIPv6Enable
+	res, err := client.Plex.GetServerResources(ctx, clientID, &https, &relay, &ipv6, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server resources: %w", err)
+	}
+
+	servers := []Server{}
+	for _, device := range res.GetPlexDevices() {
+		if !provides(device, "server") {
+			continue
+		}
+		servers = append(servers, Server{
+			ServerConnections: *plexgo.NewServerConnections(device),
+			Owned:             device.Owned,
+			AccessToken:       device.AccessToken,
+		})
+	}
+
+	return servers, nil
+}
+
+// Find returns the server of the account named name, or its first owned server if name is "",
+// and the URI of the first of its connections that responds, as with Connect. It returns
+// ErrNoServer if there is no such server.
+func Find(ctx context.Context, client *plexgo.PlexAPI, clientID string, name string, opts ...operations.Option) (*Server, string, error) {
+	servers, err := Servers(ctx, client, clientID, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i := range servers {
+		server := &servers[i]
+		if (name == "" && !server.Owned) || (name != "" && !strings.EqualFold(server.Name, name)) {
+			continue
+		}
+
+		uri, err := Connect(ctx, client, *server, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return server, uri, nil
+	}
+
+	if name == "" {
+		return nil, "", ErrNoServer
+	}
+	return nil, "", fmt.Errorf("%w named %q", ErrNoServer, name)
+}
+
+// Connect returns the URI of the first connection of a server that responds as that server,
+// trying local connections first, then remote and relayed ones, so a tool on the server's network
+// avoids the relay. Each connection is given 5 seconds, unless the opts set an operation timeout.
+// It returns ErrUnreachable, joined with the error of each connection, if none responds.
+func Connect(ctx context.Context, client *plexgo.PlexAPI, server Server, opts ...operations.Option) (string, error) {
+	errs := []error{ErrUnreachable}
+	for _, connection := range server.Connections {
+		probeOpts := append([]operations.Option{operations.WithOperationTimeout(probeTimeout)}, opts...)
+		probeOpts = append(probeOpts, operations.WithServerURL(connection.URI))
+
+		identity, err := client.Server.GetIdentity(ctx, probeOpts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", connection.URI, err))
+			continue
+		}
+
+		// Addresses on other networks may belong to another server
+		if identity.MachineIdentifier != server.MachineIdentifier {
+			errs = append(errs, fmt.Errorf("%s: reached server %s instead", connection.URI, identity.MachineIdentifier))
+			continue
+		}
+
+		return connection.URI, nil
+	}
+
+	return "", errors.Join(errs...)
+}
+
+// provides returns true if a device provides a capability, e.g. "server"
+func provides(device operations.PlexDevice, capability string) bool {
+	for _, provided := range strings.Split(device.Provides, ",") {
+		if strings.TrimSpace(provided) == capability {
+			return true
+		}
+	}
+	return false
+}
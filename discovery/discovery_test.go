@@ -0,0 +1,74 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// identityServer returns a server responding as the server with the machine identifier id
+func identityServer(id string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"` + id + `","version":"1.40.0"}}`))
+	}))
+}
+
+func TestFind(t *testing.T) {
+	home := identityServer("abc123")
+	defer home.Close()
+	// A server on another network that happens to use the same private address
+	other := identityServer("xyz789")
+	defer other.Close()
+
+	plexTV := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/resources" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[
+			{"name":"iPhone","clientIdentifier":"phone","provides":"client,player","connections":[]},
+			{"name":"Friend","clientIdentifier":"def456","provides":"server","owned":false,"accessToken":"shared-token","connections":[]},
+			{"name":"Home","clientIdentifier":"abc123","provides":"server","owned":true,"accessToken":"home-token","presence":true,"connections":[
+				{"protocol":"http","address":"127.0.0.1","port":1,"uri":"%s","local":false,"relay":true,"IPv6":false},
+				{"protocol":"http","address":"127.0.0.1","port":1,"uri":"%s","local":true,"relay":false,"IPv6":false},
+				{"protocol":"http","address":"10.0.0.2","port":32400,"uri":"%s","local":false,"relay":false,"IPv6":false}
+			]}
+		]`, home.URL, other.URL, "http://127.0.0.1:1")
+	}))
+	defer plexTV.Close()
+
+	client := plexgo.New(plexgo.WithSecurity("account-token"))
+	ctx := context.Background()
+	plexTVURL := operations.WithServerURL(plexTV.URL)
+
+	servers, err := Servers(ctx, client, "collectionmanagerd", plexTVURL)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(servers) != 2 || servers[0].Name != "Friend" || servers[0].AccessToken != "shared-token" || servers[1].Connections[0].Type != plexgo.ConnectionLocal {
+		t.Fatalf("Unexpected servers: %+v", servers)
+	}
+
+	// The local connection reaches another server and the remote one is down, so the relay is used
+	server, uri, err := Find(ctx, client, "collectionmanagerd", "", plexTVURL)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if server.Name != "Home" || uri != home.URL {
+		t.Errorf("Expected the relay of Home, got: %s %s", server.Name, uri)
+	}
+
+	if _, _, err := Find(ctx, client, "collectionmanagerd", "friend", plexTVURL); !errors.Is(err, ErrUnreachable) {
+		t.Errorf("Expected a server without connections to be unreachable, got: %v", err)
+	}
+	if _, _, err := Find(ctx, client, "collectionmanagerd", "Office", plexTVURL); !errors.Is(err, ErrNoServer) {
+		t.Errorf("Expected ErrNoServer, got: %v", err)
+	}
+}
@@ -0,0 +1,277 @@
+package plexgo
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// Thresholds above which Doctor warns
+const (
+	doctorMaxClockSkew       = time.Minute
+	doctorCertificateWarning = 14 * 24 * time.Hour
+)
+
+// DoctorStatus is the outcome of a Doctor check
+type DoctorStatus string
+
+const (
+	DoctorOK      DoctorStatus = "ok"
+	DoctorWarning DoctorStatus = "warning"
+	DoctorFailed  DoctorStatus = "failed"
+	DoctorSkipped DoctorStatus = "skipped" // A check it depends on failed
+)
+
+// Names of the Doctor checks, in the order they run
+const (
+	DoctorCheckIdentity    = "identity"
+	DoctorCheckToken       = "token"
+	DoctorCheckSections    = "sections"
+	DoctorCheckCollections = "collections"
+	DoctorCheckClock       = "clock"
+	DoctorCheckTLS         = "tls"
+)
+
+// DoctorCheck is the result of a single Doctor check
+type DoctorCheck struct {
+	Name     string
+	Status   DoctorStatus
+	Message  string
+	Duration time.Duration
+}
+
+// DoctorReport is the result of Doctor
+type DoctorReport struct {
+	ServerURL string
+	Checks    []DoctorCheck
+}
+
+// OK returns true if no check failed; warnings are allowed
+func (r *DoctorReport) OK() bool {
+	for _, check := range r.Checks {
+		if check.Status == DoctorFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// Check returns the result of the named check, nil if it wasn't run
+func (r *DoctorReport) Check(name string) *DoctorCheck {
+	for i := range r.Checks {
+		if r.Checks[i].Name == name {
+			return &r.Checks[i]
+		}
+	}
+	return nil
+}
+
+// String renders the report with one line per check, e.g. for a "doctor" command
+func (r *DoctorReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", r.ServerURL)
+	for _, check := range r.Checks {
+		fmt.Fprintf(&b, "  %-9s %-12s %s\n", "["+check.Status+"]", check.Name, check.Message)
+	}
+	return b.String()
+}
+
+// doctorState carries what earlier Doctor checks learned to the later ones
+type doctorState struct {
+	baseURL     string
+	identityRes *http.Response
+	identityErr error
+	tokenOK     bool
+	sectionID   int
+	section     string
+}
+
+// Doctor checks that the SDK is configured to work with the server: that its identity can be
+// reached, the token is accepted, library sections can be listed, the collections endpoint
+// returns JSON, the clocks agree and the certificate is valid. Checks that depend on one that
+// failed are skipped. The report is returned whatever the outcome; use OK to tell whether
+// anything failed.
+func (s *PlexAPI) Doctor(ctx context.Context, opts ...operations.Option) *DoctorReport {
+	state := &doctorState{baseURL: newLibrary(s.sdkConfiguration).baseURL(opts)}
+	report := &DoctorReport{ServerURL: state.baseURL}
+
+	checks := []struct {
+		name string
+		run  func() (DoctorStatus, string)
+	}{
+		{DoctorCheckIdentity, func() (DoctorStatus, string) { return s.doctorIdentity(ctx, state, opts) }},
+		{DoctorCheckToken, func() (DoctorStatus, string) { return s.doctorToken(ctx, state, opts) }},
+		{DoctorCheckSections, func() (DoctorStatus, string) { return s.doctorSections(ctx, state, opts) }},
+		{DoctorCheckCollections, func() (DoctorStatus, string) { return s.doctorCollections(ctx, state, opts) }},
+		{DoctorCheckClock, func() (DoctorStatus, string) { return doctorClock(state) }},
+		{DoctorCheckTLS, func() (DoctorStatus, string) { return doctorTLS(state) }},
+	}
+
+	for _, check := range checks {
+		start := time.Now()
+		status, message := check.run()
+		report.Checks = append(report.Checks, DoctorCheck{
+			Name:     check.name,
+			Status:   status,
+			Message:  message,
+			Duration: time.Since(start),
+		})
+	}
+
+	return report
+}
+
+// doctorIdentity checks that the server's identity can be requested, which needs no token
+func (s *PlexAPI) doctorIdentity(ctx context.Context, state *doctorState, opts []operations.Option) (DoctorStatus, string) {
+	if err := s.sdkConfiguration.serverURLErr; err != nil {
+		state.identityErr = err
+		return DoctorFailed, err.Error()
+	}
+
+	res, err := s.Server.GetServerIdentity(ctx, opts...)
+	if err != nil {
+		state.identityErr = err
+		return DoctorFailed, fmt.Sprintf("server not reachable: %v", err)
+	}
+	state.identityRes = res.RawResponse
+
+	container := res.Object.GetMediaContainer()
+	if container.GetMachineIdentifier() == nil || *container.GetMachineIdentifier() == "" {
+		return DoctorFailed, "identity response has no machine identifier; is this a Plex Media Server?"
+	}
+
+	message := fmt.Sprintf("machine %s", *container.GetMachineIdentifier())
+	if container.GetVersion() != nil {
+		message += fmt.Sprintf(", version %s", *container.GetVersion())
+	}
+	return DoctorOK, message
+}
+
+// doctorToken checks that the server accepts the token
+func (s *PlexAPI) doctorToken(ctx context.Context, state *doctorState, opts []operations.Option) (DoctorStatus, string) {
+	if state.identityRes == nil {
+		return DoctorSkipped, "server not reachable"
+	}
+	if s.sdkConfiguration.Security == nil {
+		return DoctorFailed, "no token configured; set one with WithSecurity"
+	}
+
+	if _, err := s.Server.GetServerCapabilities(ctx, opts...); err != nil {
+		var sdkErr *sdkerrors.SDKError
+		if errors.As(err, &sdkErr) && (sdkErr.StatusCode == http.StatusUnauthorized || sdkErr.StatusCode == http.StatusForbidden) {
+			return DoctorFailed, fmt.Sprintf("token rejected by the server (status %d)", sdkErr.StatusCode)
+		}
+		return DoctorFailed, fmt.Sprintf("error checking the token: %v", err)
+	}
+
+	state.tokenOK = true
+	return DoctorOK, "token accepted"
+}
+
+// doctorSections checks that the library sections can be listed
+func (s *PlexAPI) doctorSections(ctx context.Context, state *doctorState, opts []operations.Option) (DoctorStatus, string) {
+	if !state.tokenOK {
+		return DoctorSkipped, "no valid token"
+	}
+
+	res, err := s.Library.GetAllLibraries(ctx, opts...)
+	if err != nil {
+		return DoctorFailed, fmt.Sprintf("error listing library sections: %v", err)
+	}
+
+	sections := res.Object.GetMediaContainer().GetDirectory()
+	if len(sections) == 0 {
+		return DoctorWarning, "no library sections; the token may not have access to any"
+	}
+
+	for _, section := range sections {
+		if id, err := strconv.Atoi(section.Key); err == nil {
+			state.sectionID, state.section = id, section.Title
+			break
+		}
+	}
+	return DoctorOK, fmt.Sprintf("%d library sections", len(sections))
+}
+
+// doctorCollections checks that the collections of a section can be listed as JSON, which the
+// hand-written services rely on
+func (s *PlexAPI) doctorCollections(ctx context.Context, state *doctorState, opts []operations.Option) (DoctorStatus, string) {
+	if state.sectionID == 0 {
+		return DoctorSkipped, "no library section to list"
+	}
+
+	collections, err := s.Collections.GetAllCollections(ctx, state.sectionID, opts...)
+	if errors.Is(err, sdkerrors.ErrUnexpectedContent) {
+		return DoctorFailed, fmt.Sprintf("collections endpoint didn't return JSON: %v", err)
+	} else if err != nil {
+		return DoctorFailed, fmt.Sprintf("error listing collections of %s: %v", state.section, err)
+	}
+
+	return DoctorOK, fmt.Sprintf("%d collections in %s", len(collections), state.section)
+}
+
+// doctorClock compares the server's clock, from the Date header of the identity response, with
+// the local one. Large differences break plex.tv sign-ins and time-based filters.
+func doctorClock(state *doctorState) (DoctorStatus, string) {
+	if state.identityRes == nil {
+		return DoctorSkipped, "server not reachable"
+	}
+
+	serverTime, err := http.ParseTime(state.identityRes.Header.Get("Date"))
+	if err != nil {
+		return DoctorSkipped, "server didn't send its time"
+	}
+
+	skew := time.Since(serverTime).Round(time.Second)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > doctorMaxClockSkew {
+		return DoctorWarning, fmt.Sprintf("server clock differs from the local one by %s", skew)
+	}
+	return DoctorOK, fmt.Sprintf("clocks agree within %s", doctorMaxClockSkew)
+}
+
+// doctorTLS checks the server's certificate, or warns if the token is sent unencrypted
+func doctorTLS(state *doctorState) (DoctorStatus, string) {
+	if u, err := url.Parse(state.baseURL); err == nil && u.Scheme == "http" {
+		return DoctorWarning, "server URL uses plain HTTP; the token is sent unencrypted"
+	}
+
+	if state.identityRes == nil {
+		if isCertificateError(state.identityErr) {
+			return DoctorFailed, fmt.Sprintf("certificate not trusted: %v", state.identityErr)
+		}
+		return DoctorSkipped, "server not reachable"
+	}
+
+	if state.identityRes.TLS == nil || len(state.identityRes.TLS.PeerCertificates) == 0 {
+		return DoctorSkipped, "no certificate received"
+	}
+
+	certificate := state.identityRes.TLS.PeerCertificates[0]
+	if remaining := time.Until(certificate.NotAfter); remaining < doctorCertificateWarning {
+		return DoctorWarning, fmt.Sprintf("certificate expires on %s", certificate.NotAfter.Format(time.DateOnly))
+	}
+	return DoctorOK, fmt.Sprintf("certificate valid until %s", certificate.NotAfter.Format(time.DateOnly))
+}
+
+// isCertificateError returns true if a request failed because the server's certificate couldn't
+// be verified
+func isCertificateError(err error) bool {
+	var verification *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalid x509.CertificateInvalidError
+	return errors.As(err, &verification) || errors.As(err, &unknownAuthority) || errors.As(err, &hostname) || errors.As(err, &invalid)
+}
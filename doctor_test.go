@@ -0,0 +1,72 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDoctor(t *testing.T) {
+	token := "good"
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/identity" && r.Header.Get("X-Plex-Token") != token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/identity":
+			w.Header().Set("Date", time.Now().Add(-5*time.Minute).UTC().Format(http.TimeFormat))
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.2.8395"}}`))
+		case "/":
+			w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+		case "/library/sections":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"key":"1","title":"Movies","type":"movie"}]}}`))
+		case "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Marvel","type":"collection"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithClient(server.Client()), WithSecurity("good"))
+
+	report := client.Doctor(context.Background())
+	if !report.OK() {
+		t.Errorf("Expected no failed checks, got:\n%s", report)
+	}
+	for name, status := range map[string]DoctorStatus{
+		DoctorCheckIdentity:    DoctorOK,
+		DoctorCheckToken:       DoctorOK,
+		DoctorCheckSections:    DoctorOK,
+		DoctorCheckCollections: DoctorOK,
+		DoctorCheckClock:       DoctorWarning,
+		DoctorCheckTLS:         DoctorOK,
+	} {
+		if check := report.Check(name); check == nil || check.Status != status {
+			t.Errorf("Expected %s to be %s, got: %+v", name, status, check)
+		}
+	}
+	if !strings.Contains(report.String(), "[warning] clock") {
+		t.Errorf("Expected the clock warning in the printed report, got:\n%s", report)
+	}
+
+	client = New(WithServerURL(server.URL), WithClient(server.Client()), WithSecurity("expired"))
+
+	report = client.Doctor(context.Background())
+	if report.OK() || report.Check(DoctorCheckToken).Status != DoctorFailed || report.Check(DoctorCheckCollections).Status != DoctorSkipped {
+		t.Errorf("Expected the token to fail and the listings to be skipped, got:\n%s", report)
+	}
+
+	client = New(WithServerURL(server.URL), WithSecurity("good"))
+
+	report = client.Doctor(context.Background())
+	if check := report.Check(DoctorCheckTLS); check.Status != DoctorFailed || report.Check(DoctorCheckIdentity).Status != DoctorFailed {
+		t.Errorf("Expected the self-signed certificate to fail, got:\n%s", report)
+	}
+}
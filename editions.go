@@ -0,0 +1,89 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// editionPrefix starts the edition of a movie in file names, e.g. "{edition-Director's Cut}", and in
+// the member GUIDs of a CollectionSpec
+const editionPrefix = "{edition-"
+
+// GetEditions lists the editions of a movie, e.g. the theatrical cut and a director's cut, which the
+// server keeps as separate items of the section sharing a GUID. The edition without an
+// EditionTitle comes first, then the others by title. Each edition has its own rating key, so
+// collections can hold and order a specific edition. Items that aren't movies have one edition,
+// themselves.
+func (s *Media) GetEditions(ctx context.Context, ratingKey string, opts ...operations.Option) ([]Metadata, error) {
+	library := newLibrary(s.sdkConfiguration)
+
+	items, err := library.listMetadata(ctx, "/library/metadata/"+ratingKey, nil, "getMediaMetaData", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting item %s: %w", ratingKey, err)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("item %s not found", ratingKey)
+	}
+	item := items[0]
+	if item.Type != "movie" || item.GUID == "" || item.SectionID == 0 {
+		return items[:1], nil
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("type", "1")
+	queryParams.Add("guid", item.GUID)
+
+	editions, err := library.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", item.SectionID), queryParams, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting editions of item %s: %w", ratingKey, err)
+	}
+	if len(editions) == 0 {
+		return items[:1], nil
+	}
+
+	sort.SliceStable(editions, func(i, j int) bool {
+		return strings.ToLower(editions[i].EditionTitle) < strings.ToLower(editions[j].EditionTitle)
+	})
+	return editions, nil
+}
+
+// GetEdition gets the edition of a movie with a title, e.g. "Director's Cut", matched regardless of
+// case, or the edition without a title if editionTitle is ""
+func (s *Media) GetEdition(ctx context.Context, ratingKey string, editionTitle string, opts ...operations.Option) (*Metadata, error) {
+	editions, err := s.GetEditions(ctx, ratingKey, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range editions {
+		if strings.EqualFold(editions[i].EditionTitle, editionTitle) {
+			return &editions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("edition %q of item %s not found", editionTitle, ratingKey)
+}
+
+// splitEdition splits a GUID with an edition suffix, e.g. "imdb://tt0133093{edition-Director's Cut}",
+// into the GUID and the edition title
+func splitEdition(guid string) (string, string) {
+	guid = strings.TrimSpace(guid)
+	i := strings.LastIndex(guid, editionPrefix)
+	if i < 0 || !strings.HasSuffix(guid, "}") {
+		return guid, ""
+	}
+	return guid[:i], guid[i+len(editionPrefix) : len(guid)-1]
+}
+
+// editionKey returns the key of an edition of a normalized GUID in the map of sectionGUIDKeys
+func editionKey(guid string, editionTitle string) string {
+	if editionTitle == "" {
+		return guid
+	}
+	return guid + editionPrefix + strings.ToLower(editionTitle) + "}"
+}
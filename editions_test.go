@@ -0,0 +1,76 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEditions(t *testing.T) {
+	editions := `{"MediaContainer":{"size":3,"Metadata":[
+		{"ratingKey":"11","type":"movie","title":"Blade Runner","editionTitle":"The Final Cut","guid":"plex://movie/5d7768","librarySectionID":1,"Guid":[{"id":"imdb://tt0083658"}]},
+		{"ratingKey":"10","type":"movie","title":"Blade Runner","guid":"plex://movie/5d7768","librarySectionID":1,"Guid":[{"id":"imdb://tt0083658"}],"Media":[{"id":1,"videoResolution":"1080"},{"id":2,"videoResolution":"4k","title":"Remux"}]},
+		{"ratingKey":"12","type":"movie","title":"Blade Runner","editionTitle":"Director's Cut","guid":"plex://movie/5d7768","librarySectionID":1,"Guid":[{"id":"imdb://tt0083658"}]}
+	]}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/library/metadata/11":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"11","type":"movie","title":"Blade Runner","editionTitle":"The Final Cut","guid":"plex://movie/5d7768","librarySectionID":1}]}}`))
+		case r.URL.Path == "/library/metadata/20":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"20","type":"show","title":"Firefly","guid":"plex://show/5d9c08","librarySectionID":2}]}}`))
+		case r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("guid") != "" && r.URL.Query().Get("guid") != "plex://movie/5d7768" {
+				t.Errorf("Unexpected GUID: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(editions))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	items, err := client.Media.GetEditions(ctx, "11")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(items) != 3 || items[0].RatingKey != "10" || items[1].EditionTitle != "Director's Cut" || items[2].EditionTitle != "The Final Cut" {
+		t.Errorf("Expected the default edition first, then by title, got: %+v", items)
+	}
+	if len(items[0].Media) != 2 || items[0].Media[1].Title != "Remux" {
+		t.Errorf("Expected the versions of the default edition, got: %+v", items[0].Media)
+	}
+
+	edition, err := client.Media.GetEdition(ctx, "11", "director's cut")
+	if err != nil || edition.RatingKey != "12" {
+		t.Errorf("Expected the director's cut, got: %+v %v", edition, err)
+	}
+	if _, err := client.Media.GetEdition(ctx, "11", "Extended"); err == nil {
+		t.Error("Expected an error for a missing edition")
+	}
+
+	items, err = client.Media.GetEditions(ctx, "20")
+	if err != nil || len(items) != 1 || items[0].RatingKey != "20" {
+		t.Errorf("Expected a show to be its only edition, got: %+v %v", items, err)
+	}
+
+	keys, err := client.Collections.sectionGUIDKeys(ctx, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	for guid, want := range map[string]string{
+		"imdb://tt0083658":                           "10",
+		"IMDB://tt0083658{edition-Director's Cut}":   "12",
+		"plex://movie/5d7768{edition-THE FINAL CUT}": "11",
+	} {
+		base, title := splitEdition(guid)
+		if got := keys[editionKey(normalizeGUID(base), title)]; got != want {
+			t.Errorf("Expected %s to match %s, got: %q", guid, want, got)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// Command collectionmanagerd is a reference daemon built from the SDK's packages: it signs in
+// through plex.tv/link, finds the server of the account, keeps its collections in sync with state
+// files on a schedule, announces the changes on Discord or Slack, and serves the collections and
+// the scheduled jobs over a REST API.
+//
+// Usage:
+//
+//	COLLECTIONMANAGERD_API_TOKEN=secret collectionmanagerd -state '/etc/collectionmanagerd/*.json'
+//
+// See README.md for the flags and the API.
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/credentials"
+	"github.com/unfaiyted/plexgo/discovery"
+	"github.com/unfaiyted/plexgo/httpapi"
+	"github.com/unfaiyted/plexgo/listsync"
+	"github.com/unfaiyted/plexgo/notify"
+	"github.com/unfaiyted/plexgo/scheduler"
+)
+
+// product is the name the daemon is shown with on plex.tv
+const product = "collectionmanagerd"
+
+// config holds the settings of the daemon, from flags and environment variables
+type config struct {
+	clientID       string
+	serverName     string
+	statePatterns  []string
+	interval       time.Duration
+	listen         string
+	dryRun         bool
+	checkpoint     string
+	credentials    string
+	passphrase     string
+	apiToken       string
+	discordWebhook string
+	slackWebhook   string
+}
+
+func main() {
+	cfg, err := parseConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, cfg); err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatal(err)
+	}
+}
+
+// parseConfig reads the flags, and the secrets from the environment so they don't show up in
+// process listings
+func parseConfig(args []string) (*config, error) {
+	cfg := &config{
+		apiToken:       os.Getenv("COLLECTIONMANAGERD_API_TOKEN"),
+		passphrase:     os.Getenv("COLLECTIONMANAGERD_PASSPHRASE"),
+		discordWebhook: os.Getenv("DISCORD_WEBHOOK_URL"),
+		slackWebhook:   os.Getenv("SLACK_WEBHOOK_URL"),
+	}
+
+	defaultCredentials, err := credentials.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+
+	var state string
+	flags := flag.NewFlagSet(product, flag.ContinueOnError)
+	flags.StringVar(&cfg.clientID, "client-id", product, "client identifier registered with plex.tv")
+	flags.StringVar(&cfg.serverName, "server", "", "name of the server to manage; the account's first owned server if empty")
+	flags.StringVar(&state, "state", "", "comma-separated state files or glob patterns (required)")
+	flags.DurationVar(&cfg.interval, "interval", time.Hour, "time between syncs")
+	flags.StringVar(&cfg.listen, "listen", ":8080", "address of the REST API")
+	flags.BoolVar(&cfg.dryRun, "dry-run", false, "report the planned changes without applying them")
+	flags.StringVar(&cfg.checkpoint, "checkpoint", "", "file saving the progress of a sync, so one interrupted by a restart resumes; none if empty")
+	flags.StringVar(&cfg.credentials, "credentials", defaultCredentials, "file storing the plex.tv token when no keyring is available")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range strings.Split(state, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			cfg.statePatterns = append(cfg.statePatterns, pattern)
+		}
+	}
+	if len(cfg.statePatterns) == 0 {
+		return nil, errors.New("-state is required")
+	}
+	if cfg.apiToken == "" {
+		return nil, errors.New("COLLECTIONMANAGERD_API_TOKEN must be set")
+	}
+
+	return cfg, nil
+}
+
+// run connects to the server and runs the scheduler and the API until ctx is done
+func run(ctx context.Context, cfg *config) error {
+	client, err := connect(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	sink := newSink(cfg)
+
+	syncer := listsync.New(client, cfg.statePatterns...)
+	syncer.Sink = sink
+	syncer.DryRun = cfg.dryRun
+	syncer.CheckpointPath = cfg.checkpoint
+
+	jobs := scheduler.New()
+	jobs.OnError = func(err error) { log.Print(err) }
+	err = jobs.Every("list-sync", cfg.interval, func(ctx context.Context) error {
+		_, err := syncer.Sync(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/", http.StripPrefix("/api", httpapi.New(client, cfg.apiToken)))
+	mux.Handle("/jobs", requireToken(cfg.apiToken, jobsHandler(jobs)))
+	mux.Handle("/jobs/", requireToken(cfg.apiToken, jobsHandler(jobs)))
+	srv := &http.Server{Addr: cfg.listen, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	errs := make(chan error, 2)
+	go func() { errs <- jobs.Run(ctx) }()
+	go func() {
+		log.Printf("serving the API on %s", cfg.listen)
+		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			errs <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+	}
+
+	// Let running syncs stop at a safe point, leaving a PartialError rather than a half-done request
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	srv.Shutdown(shutdownCtx)
+	if closeErr := client.Close(shutdownCtx); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// connect signs in to plex.tv, running the PIN flow on the first start, and returns a client for
+// the server found with discovery
+func connect(ctx context.Context, cfg *config) (*plexgo.PlexAPI, error) {
+	store := credentials.Default(product, cfg.credentials, cfg.passphrase)
+	token, err := credentials.Login(ctx, plexgo.New(), store, credentials.LoginOptions{
+		ClientID: cfg.clientID,
+		Product:  product,
+		Prompt: func(code string) error {
+			log.Printf("To link collectionmanagerd to your Plex account, enter %s at %s", code, credentials.LinkURL)
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error signing in: %w", err)
+	}
+
+	account := plexgo.New(plexgo.WithSecurity(token))
+	server, uri, err := discovery.Find(ctx, account, cfg.clientID, cfg.serverName)
+	if err != nil {
+		return nil, fmt.Errorf("error finding the server: %w", err)
+	}
+	log.Printf("managing %s at %s", server.Name, uri)
+
+	serverToken := server.AccessToken
+	if serverToken == "" {
+		serverToken = token
+	}
+	return plexgo.New(plexgo.WithSecurity(serverToken), plexgo.WithServerURL(uri)), nil
+}
+
+// newSink returns the configured notification sinks, or a sink logging events if there are none
+func newSink(cfg *config) notify.Sink {
+	var sinks []notify.Sink
+	if cfg.discordWebhook != "" {
+		sinks = append(sinks, notify.NewDiscord(cfg.discordWebhook))
+	}
+	if cfg.slackWebhook != "" {
+		sinks = append(sinks, notify.NewSlack(cfg.slackWebhook))
+	}
+	if len(sinks) == 0 {
+		return logSink{}
+	}
+	return notify.Multi(sinks...)
+}
+
+// logSink writes events to the log
+type logSink struct{}
+
+func (logSink) Send(ctx context.Context, event notify.Event) error {
+	log.Printf("[%s] %s: %s %v", event.Level, event.Title, event.Message, event.Fields)
+	return nil
+}
+
+// jobsHandler serves the status of the scheduled jobs at GET /jobs, and runs a job now at
+// POST /jobs/{name}/run
+func jobsHandler(jobs *scheduler.Scheduler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		switch {
+		case r.Method == "GET" && len(segments) == 1:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(jobs.Status())
+		case r.Method == "POST" && len(segments) == 3 && segments[2] == "run":
+			if err := jobs.Trigger(segments[1]); err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	})
+}
+
+// requireToken rejects requests without the bearer token, like the httpapi handler
+func requireToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="collectionmanagerd"`)
+			http.Error(w, "missing or invalid token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
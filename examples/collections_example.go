@@ -36,8 +36,8 @@ func main() {
 	fmt.Println("\nCreating a new collection...")
 	newCollection, err := client.Collections.CreateCollection(
 		ctx,
-		1,                 // Library section ID
-		"My New Collection", // Collection title
+		1,                        // Library section ID
+		"My New Collection",      // Collection title
 		[]string{"1234", "5678"}, // Item IDs to add to the collection
 	)
 	if err != nil {
@@ -68,7 +68,7 @@ func main() {
 
 	// Example 5: Update collection mode
 	fmt.Println("\nUpdating collection mode...")
-	err = client.Collections.UpdateCollectionMode(
+	_, err = client.Collections.UpdateCollectionMode(
 		ctx,
 		collectionID,
 		plexgo.CollectionModeShowItems, // Use the predefined constant
@@ -83,10 +83,10 @@ func main() {
 	smartFilter := "?type=1&genre=action" // Example filter: action movies
 	smartCollection, err := client.Collections.CreateSmartCollection(
 		ctx,
-		1,                   // Library section ID
-		"Action Movies",     // Collection title
-		1,                   // Type 1 for movies
-		smartFilter,         // Filter args
+		1,               // Library section ID
+		"Action Movies", // Collection title
+		1,               // Type 1 for movies
+		smartFilter,     // Filter args
 	)
 	if err != nil {
 		log.Fatalf("Error creating smart collection: %v", err)
@@ -101,4 +101,4 @@ func main() {
 		log.Fatalf("Error deleting collection: %v", err)
 	}
 	fmt.Println("Collection deleted successfully")
-}
\ No newline at end of file
+}
@@ -0,0 +1,301 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// defaultRotationInterval is the time between rotations when FeaturedRotation.Interval is not set
+const defaultRotationInterval = 7 * 24 * time.Hour
+
+// RotationState is the persisted state of a FeaturedRotation
+type RotationState struct {
+	Next      int       `json:"next"`     // Index in the pool of the first collection to feature next
+	Featured  []int     `json:"featured"` // IDs of the collections currently featured
+	RotatedAt time.Time `json:"rotatedAt"`
+}
+
+// RotationStore persists the state of a FeaturedRotation, so restarts neither rotate early nor
+// repeat collections. Load returns nil and no error if no state was saved yet.
+type RotationStore interface {
+	Load(ctx context.Context) (*RotationState, error)
+	Save(ctx context.Context, state RotationState) error
+}
+
+// fileRotationStore keeps the state of a rotation in a JSON file
+type fileRotationStore struct {
+	path string
+}
+
+// FileRotationStore returns a store keeping the state of a rotation in a JSON file
+func FileRotationStore(path string) RotationStore {
+	return fileRotationStore{path: path}
+}
+
+// Load reads the state from the file
+func (f fileRotationStore) Load(ctx context.Context) (*RotationState, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading rotation state: %w", err)
+	}
+
+	var state RotationState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("error decoding rotation state: %w", err)
+	}
+	return &state, nil
+}
+
+// Save writes the state to the file, replacing it atomically
+func (f fileRotationStore) Save(ctx context.Context, state RotationState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := writeFileAtomic(f.path, data); err != nil {
+		return fmt.Errorf("error writing rotation state: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it to path, so readers
+// never see a partly written file
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// memoryRotationStore keeps the state of a rotation in memory, for rotations without a Store
+type memoryRotationStore struct {
+	state *RotationState
+}
+
+func (m *memoryRotationStore) Load(ctx context.Context) (*RotationState, error) {
+	return m.state, nil
+}
+
+func (m *memoryRotationStore) Save(ctx context.Context, state RotationState) error {
+	m.state = &state
+	return nil
+}
+
+// FeaturedRotation promotes a few collections of a pool to the home screen at a time and rotates
+// them on a schedule, e.g. a weekly spotlight. Create one with Collections.NewFeaturedRotation and
+// set its fields before running it.
+type FeaturedRotation struct {
+	Interval time.Duration // Time between rotations, a week if not set
+	Count    int           // Collections featured at a time, 1 if not set
+	Shared   bool          // Also feature the collections on the home screens of shared users
+	Store    RotationStore // Persists the rotation; kept in memory if nil
+	Queue    *TaskQueue    // If set, receives the visibility updates of each rotation as tasks, see Rotate
+	OnError  func(error)   // Receives rotation errors, which don't stop Run
+
+	collections *Collections
+	sectionID   int
+	pool        []int
+}
+
+// NewFeaturedRotation returns a rotation featuring the collections of a library section in the
+// order of collectionIDs
+func (s *Collections) NewFeaturedRotation(sectionID int, collectionIDs ...int) *FeaturedRotation {
+	return &FeaturedRotation{
+		collections: s,
+		sectionID:   sectionID,
+		pool:        collectionIDs,
+	}
+}
+
+// Rotate features the next collections of the pool, taking the previously featured ones off the
+// home screen, and saves the new state. It returns the IDs of the featured collections. With a
+// Queue, the visibility updates are enqueued rather than made, so one that fails is retried by the
+// queue instead of failing the rotation.
+func (r *FeaturedRotation) Rotate(ctx context.Context, opts ...operations.Option) ([]int, error) {
+	if len(r.pool) == 0 {
+		return nil, fmt.Errorf("no collections to rotate")
+	}
+
+	store := r.store()
+	state, err := store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = &RotationState{}
+	}
+
+	count := r.Count
+	if count <= 0 {
+		count = 1
+	}
+	if count > len(r.pool) {
+		count = len(r.pool)
+	}
+
+	next := state.Next % len(r.pool)
+	featured := make([]int, 0, count)
+	for i := 0; i < count; i++ {
+		featured = append(featured, r.pool[(next+i)%len(r.pool)])
+	}
+
+	keep := make(map[int]bool, len(featured))
+	for _, collectionID := range featured {
+		keep[collectionID] = true
+	}
+	if r.Queue != nil {
+		r.collections.HandleTasks(r.Queue, opts...)
+	}
+	for _, collectionID := range state.Featured {
+		if keep[collectionID] {
+			continue
+		}
+		if err := r.updateFeatured(ctx, collectionID, false, opts...); err != nil {
+			return nil, err
+		}
+	}
+	for _, collectionID := range featured {
+		if err := r.updateFeatured(ctx, collectionID, true, opts...); err != nil {
+			return nil, err
+		}
+	}
+
+	newState := RotationState{Next: (next + count) % len(r.pool), Featured: featured, RotatedAt: time.Now()}
+	if err := store.Save(ctx, newState); err != nil {
+		return nil, fmt.Errorf("error saving rotation state: %w", err)
+	}
+
+	return featured, nil
+}
+
+// Run rotates the featured collections every Interval until ctx is done, sending the IDs of the
+// featured collections to rotations (if not nil). The first rotation happens once Interval has
+// passed since the last one recorded in Store, or immediately if there is none. It returns
+// ctx.Err() when stopped, including by PlexAPI.Close.
+func (r *FeaturedRotation) Run(ctx context.Context, rotations chan<- []int, opts ...operations.Option) error {
+	ctx, finish, err := r.collections.sdkConfiguration.track(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+
+	interval := r.Interval
+	if interval <= 0 {
+		interval = defaultRotationInterval
+	}
+
+	for {
+		wait := time.Duration(0)
+		state, err := r.store().Load(ctx)
+		if err != nil {
+			r.reportError(err)
+		} else if state != nil && !state.RotatedAt.IsZero() {
+			wait = time.Until(state.RotatedAt.Add(interval))
+		}
+
+		if wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		featured, err := r.Rotate(ctx, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			r.reportError(err)
+
+			// Retry failed rotations at a fraction of the interval rather than immediately
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval / 24):
+			}
+			continue
+		}
+
+		if rotations != nil {
+			select {
+			case rotations <- featured:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// updateFeatured features a collection or takes it off the home screens, or enqueues the update
+// on Queue
+func (r *FeaturedRotation) updateFeatured(ctx context.Context, collectionID int, featured bool, opts ...operations.Option) error {
+	if r.Queue == nil {
+		return r.setFeatured(ctx, collectionID, featured, opts...)
+	}
+
+	payload := featuredVisibilityTask{SectionID: r.sectionID, CollectionID: collectionID, Featured: featured, Shared: r.Shared}
+	if _, err := r.Queue.Enqueue(ctx, TaskKindFeaturedVisibility, payload); err != nil {
+		return fmt.Errorf("error enqueuing visibility update of collection %d: %w", collectionID, err)
+	}
+	return nil
+}
+
+// setFeatured promotes a collection to or removes it from the home screens, keeping its
+// library recommendation setting
+func (r *FeaturedRotation) setFeatured(ctx context.Context, collectionID int, featured bool, opts ...operations.Option) error {
+	visibility, err := r.collections.GetCollectionVisibility(ctx, r.sectionID, collectionID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting visibility of collection %d: %w", collectionID, err)
+	}
+
+	updated := *visibility
+	updated.Home = featured
+	if r.Shared {
+		updated.Shared = featured
+	}
+	if updated == *visibility {
+		return nil
+	}
+
+	if err := r.collections.UpdateCollectionVisibility(ctx, r.sectionID, collectionID, &updated, opts...); err != nil {
+		return fmt.Errorf("error updating visibility of collection %d: %w", collectionID, err)
+	}
+	return nil
+}
+
+// store returns Store, or an in-memory store if it is not set
+func (r *FeaturedRotation) store() RotationStore {
+	if r.Store == nil {
+		r.Store = &memoryRotationStore{}
+	}
+	return r.Store
+}
+
+// reportError passes an error to OnError, if set
+func (r *FeaturedRotation) reportError(err error) {
+	if r.OnError != nil {
+		r.OnError(err)
+	}
+}
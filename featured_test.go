@@ -0,0 +1,70 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFeaturedRotation(t *testing.T) {
+	home := map[string]bool{"1": false, "2": false, "3": true}
+	shared := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		id := query.Get("metadataItemId")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/hubs/sections/1/manage":
+			fmt.Fprintf(w, `{"MediaContainer":{"Directory":[{"promotedToRecommended":"1","promotedToOwnHome":"%s","promotedToSharedHome":"%s"}]}}`,
+				boolToString(home[id]), boolToString(shared[id]))
+		case r.Method == "POST" && r.URL.Path == "/hubs/sections/1/manage":
+			home[id] = query.Get("promotedToOwnHome") == "1"
+			shared[id] = query.Get("promotedToSharedHome") == "1"
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	path := filepath.Join(t.TempDir(), "rotation.json")
+
+	rotation := client.Collections.NewFeaturedRotation(1, 1, 2, 3)
+	rotation.Count = 2
+	rotation.Shared = true
+	rotation.Store = FileRotationStore(path)
+
+	featured, err := rotation.Rotate(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(featured, []int{1, 2}) || !home["1"] || !home["2"] || !shared["1"] {
+		t.Errorf("Expected collections 1 and 2 to be featured, got: %v, home %v", featured, home)
+	}
+
+	// A new rotation with the same store continues where the previous one stopped
+	rotation = client.Collections.NewFeaturedRotation(1, 1, 2, 3)
+	rotation.Count = 2
+	rotation.Store = FileRotationStore(path)
+
+	featured, err = rotation.Rotate(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(featured, []int{3, 1}) || !home["3"] || !home["1"] || home["2"] {
+		t.Errorf("Expected collections 3 and 1 to be featured, got: %v, home %v", featured, home)
+	}
+	if !shared["1"] {
+		t.Errorf("Expected the shared home setting to be kept without Shared")
+	}
+
+	state, err := FileRotationStore(path).Load(context.Background())
+	if err != nil || state == nil || state.Next != 1 || !reflect.DeepEqual(state.Featured, []int{3, 1}) || state.RotatedAt.IsZero() {
+		t.Errorf("Unexpected saved state: %+v, %v", state, err)
+	}
+}
@@ -0,0 +1,163 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// FilterTemplate is a named, reusable smart filter fragment of a library section, e.g. "family
+// friendly" for contentRating<<=PG13 and genre!=Horror, registered with RegisterFilterTemplate
+type FilterTemplate struct {
+	Name      string
+	SectionID int
+	Type      MetadataType // Item type the template filters, e.g. MetadataTypeMovie
+	Filter    FilterGroup
+}
+
+// FilterTemplateRef refers to a registered filter template by name inside a smart filter. It is
+// replaced by the template of the collection's section when the collection is created.
+type FilterTemplateRef struct {
+	Name string
+}
+
+// Template returns a reference to the filter template with the given name
+func Template(name string) FilterTemplateRef {
+	return FilterTemplateRef{Name: name}
+}
+
+// encode returns nothing, as references are replaced before filters are encoded
+func (r FilterTemplateRef) encode() []string {
+	return []string{}
+}
+
+// filterTemplateRegistry holds the filter templates of an SDK instance by section and name
+type filterTemplateRegistry struct {
+	mu        sync.RWMutex
+	templates map[int]map[string]FilterTemplate
+}
+
+// newFilterTemplateRegistry returns an empty filter template registry
+func newFilterTemplateRegistry() *filterTemplateRegistry {
+	return &filterTemplateRegistry{templates: map[int]map[string]FilterTemplate{}}
+}
+
+// filterTemplateKey normalizes a template name, so that names are matched case-insensitively
+func filterTemplateKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// RegisterFilterTemplate registers a reusable filter fragment of a library section under a name,
+// which smart filters can then refer to with Template(name). The fragment is checked locally and
+// then sent to the server, so templates the server rejects are never registered. Registering a
+// name again replaces the template. Templates live as long as the SDK instance.
+func (s *Collections) RegisterFilterTemplate(ctx context.Context, sectionID int, name string, itemType MetadataType, filter FilterGroup, opts ...operations.Option) (*FilterTemplate, error) {
+	key := filterTemplateKey(name)
+	if key == "" {
+		return nil, fmt.Errorf("filter template name is empty")
+	}
+	if len(filter.Nodes) == 0 {
+		return nil, fmt.Errorf("filter template %q has no conditions", name)
+	}
+
+	resolved, err := s.ResolveFilterTemplates(sectionID, &SmartFilter{Type: itemType, Root: filter})
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter template %q: %w", name, err)
+	}
+	if err := resolved.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter template %q: %w", name, err)
+	}
+	if _, err := s.TestSmartFilter(ctx, sectionID, resolved.String(), opts...); err != nil {
+		return nil, fmt.Errorf("filter template %q rejected by the server: %w", name, err)
+	}
+
+	template := FilterTemplate{Name: strings.TrimSpace(name), SectionID: sectionID, Type: itemType, Filter: resolved.Root}
+
+	registry := s.sdkConfiguration.filterTemplates
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	if registry.templates[sectionID] == nil {
+		registry.templates[sectionID] = map[string]FilterTemplate{}
+	}
+	registry.templates[sectionID][key] = template
+
+	return &template, nil
+}
+
+// UnregisterFilterTemplate removes a filter template of a library section
+func (s *Collections) UnregisterFilterTemplate(sectionID int, name string) {
+	registry := s.sdkConfiguration.filterTemplates
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	delete(registry.templates[sectionID], filterTemplateKey(name))
+}
+
+// GetFilterTemplate returns the filter template of a library section with the given name, false
+// if there is none
+func (s *Collections) GetFilterTemplate(sectionID int, name string) (FilterTemplate, bool) {
+	registry := s.sdkConfiguration.filterTemplates
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	template, ok := registry.templates[sectionID][filterTemplateKey(name)]
+	return template, ok
+}
+
+// GetFilterTemplates lists the filter templates of a library section, sorted by name
+func (s *Collections) GetFilterTemplates(sectionID int) []FilterTemplate {
+	registry := s.sdkConfiguration.filterTemplates
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	templates := make([]FilterTemplate, 0, len(registry.templates[sectionID]))
+	for _, template := range registry.templates[sectionID] {
+		templates = append(templates, template)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates
+}
+
+// ResolveFilterTemplates returns a copy of a smart filter with its template references replaced
+// by the registered templates of a library section, each as a group of its own. It fails if a
+// template isn't registered for the section or filters another item type.
+func (s *Collections) ResolveFilterTemplates(sectionID int, filter *SmartFilter) (*SmartFilter, error) {
+	root, err := s.resolveFilterGroup(sectionID, filter.Type, filter.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := *filter
+	resolved.Root = root
+	resolved.Sort = append([]FilterSort{}, filter.Sort...)
+	return &resolved, nil
+}
+
+// resolveFilterGroup replaces the template references of a group and its nested groups
+func (s *Collections) resolveFilterGroup(sectionID int, itemType MetadataType, group FilterGroup) (FilterGroup, error) {
+	resolved := FilterGroup{Or: group.Or, Nodes: make([]FilterNode, 0, len(group.Nodes))}
+	for _, node := range group.Nodes {
+		switch n := node.(type) {
+		case FilterTemplateRef:
+			template, ok := s.GetFilterTemplate(sectionID, n.Name)
+			if !ok {
+				return FilterGroup{}, fmt.Errorf("unknown filter template %q for section %d", n.Name, sectionID)
+			}
+			if template.Type != itemType {
+				return FilterGroup{}, fmt.Errorf("filter template %q filters items of type %d, not %d", n.Name, template.Type, itemType)
+			}
+			resolved.Nodes = append(resolved.Nodes, template.Filter)
+		case FilterGroup:
+			nested, err := s.resolveFilterGroup(sectionID, itemType, n)
+			if err != nil {
+				return FilterGroup{}, err
+			}
+			resolved.Nodes = append(resolved.Nodes, nested)
+		default:
+			resolved.Nodes = append(resolved.Nodes, node)
+		}
+	}
+	return resolved, nil
+}
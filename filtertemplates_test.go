@@ -0,0 +1,67 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilterTemplates(t *testing.T) {
+	created := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			if strings.Contains(r.URL.RawQuery, "bogus") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","type":"movie"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			created = r.URL.Query().Get("uri")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Family Night","type":"collection","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Family Night","type":"collection","librarySectionID":1}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	familyFriendly := AllOf(Cond("contentRating", FilterOpIs, "PG"), Cond("genre", FilterOpNotContains, "Horror"))
+	if _, err := client.Collections.RegisterFilterTemplate(ctx, 1, "Family Friendly", 1, familyFriendly); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := client.Collections.RegisterFilterTemplate(ctx, 1, "broken", 1, AllOf(Cond("bogus", FilterOpIs, "1"))); err == nil {
+		t.Error("Expected the server to reject the template")
+	}
+	if _, ok := client.Collections.GetFilterTemplate(1, "broken"); ok {
+		t.Error("Expected the rejected template not to be registered")
+	}
+	if templates := client.Collections.GetFilterTemplates(1); len(templates) != 1 || templates[0].Name != "Family Friendly" {
+		t.Errorf("Unexpected templates: %+v", templates)
+	}
+
+	filter := NewSmartFilter(1).Add(Template("family friendly")).Where("year", FilterOpGreaterThan, "2000")
+	if _, err := client.Collections.CreateSmartCollectionFromFilter(ctx, 1, "Family Night", filter, WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.HasSuffix(created, "/library/sections/1/all?type=1&push=1&contentRating==PG&genre!=Horror&pop=1&year>>=2000") {
+		t.Errorf("Expected the template to be expanded, got: %s", created)
+	}
+
+	if _, err := client.Collections.CreateSmartCollectionFromFilter(ctx, 2, "Family Night", filter); err == nil {
+		t.Error("Expected an error for a template of another section")
+	}
+	if _, err := client.Collections.CreateSmartCollectionFromFilter(ctx, 1, "Family Shows", NewSmartFilter(2).Add(Template("family friendly"))); err == nil {
+		t.Error("Expected an error for a template of another item type")
+	}
+}
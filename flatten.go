@@ -0,0 +1,64 @@
+package plexgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// GetOrZero returns the value of an optional field of a generated model, or the zero value if it
+// is nil, e.g. GetOrZero(item.Year)
+func GetOrZero[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// Flatten converts the metadata of a generated response model, such as the Metadata of
+// Library.GetAllMediaLibrary or Library.GetLibraryItems, into the SDK's Metadata struct, which has
+// no pointer fields. It accepts a single item, a pointer to one or a slice of them. Fields of the
+// generated model that Metadata doesn't have are dropped, and fields whose types differ are left
+// at their zero value.
+func Flatten(metadata interface{}) ([]Metadata, error) {
+	value := reflect.ValueOf(metadata)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return []Metadata{}, nil
+		}
+		value = value.Elem()
+	}
+
+	// Encode items one at a time so a slice of items is always decoded as a list
+	var items []interface{}
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, value.Index(i).Interface())
+		}
+	case reflect.Struct:
+		items = append(items, value.Interface())
+	default:
+		return nil, fmt.Errorf("cannot flatten %T into metadata", metadata)
+	}
+
+	flattened := make([]Metadata, 0, len(items))
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("error serializing %T: %w", item, err)
+		}
+
+		var m Metadata
+		var typeErr *json.UnmarshalTypeError
+		if err := json.Unmarshal(data, &m); err != nil && !errors.As(err, &typeErr) {
+			return nil, fmt.Errorf("error converting %T to metadata: %w", item, err)
+		}
+
+		flattened = append(flattened, m)
+	}
+
+	return flattened, nil
+}
@@ -0,0 +1,74 @@
+package plexgo
+
+import (
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/types"
+)
+
+func TestFlatten(t *testing.T) {
+	if GetOrZero[int](nil) != 0 || GetOrZero(String("x")) != "x" {
+		t.Error("Expected GetOrZero to dereference or return the zero value")
+	}
+
+	released, _ := types.DateFromString("1999-03-31")
+	items := []operations.GetAllMediaLibraryMetadata{
+		{
+			RatingKey:             "42",
+			Key:                   "/library/metadata/42",
+			GUID:                  "plex://movie/5d7768",
+			Type:                  operations.GetAllMediaLibraryLibraryTypeMovie,
+			Title:                 "The Matrix",
+			Rating:                8.5,
+			Year:                  Int(1999),
+			Studio:                String("Warner Bros."),
+			ViewCount:             Int(3),
+			Duration:              8160000,
+			OriginallyAvailableAt: released,
+			Genre:                 []operations.GetAllMediaLibraryGenre{{Tag: "Action"}, {Tag: "Science Fiction"}},
+			Guids:                 []operations.GetAllMediaLibraryGuids{{ID: String("tmdb://603")}},
+			Media:                 []operations.GetAllMediaLibraryMedia{{ID: 7, Width: Int(1920)}},
+		},
+		{
+			RatingKey: "43",
+			Title:     "Unrated",
+			Type:      operations.GetAllMediaLibraryLibraryTypeMovie,
+		},
+	}
+
+	flattened, err := Flatten(items)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(flattened) != 2 {
+		t.Fatalf("Expected 2 items, got: %d", len(flattened))
+	}
+
+	m := flattened[0]
+	if m.RatingKey != "42" || m.Type != "movie" || m.Title != "The Matrix" || m.Year != 1999 || m.Studio != "Warner Bros." {
+		t.Errorf("Unexpected metadata: %+v", m)
+	}
+	if m.ViewCount != 3 || m.Duration != 8160000 || m.Rating != 8.5 || m.OriginallyAvailableAt != "1999-03-31" {
+		t.Errorf("Unexpected metadata: %+v", m)
+	}
+	if len(m.Genre) != 2 || m.Genre[1].Tag != "Science Fiction" || m.ExternalID("tmdb") != "603" {
+		t.Errorf("Unexpected tags or GUIDs: %+v %+v", m.Genre, m.ExternalGUIDs)
+	}
+	if len(m.Media) != 1 || m.Media[0].ID != 7 || m.Media[0].Width != 1920 {
+		t.Errorf("Unexpected media: %+v", m.Media)
+	}
+
+	if flattened[1].Year != 0 || flattened[1].Studio != "" {
+		t.Errorf("Expected nil fields to be zero, got: %+v", flattened[1])
+	}
+
+	single, err := Flatten(&items[1])
+	if err != nil || len(single) != 1 || single[0].RatingKey != "43" {
+		t.Errorf("Expected a single item to be flattened, got: %+v %v", single, err)
+	}
+
+	if _, err := Flatten("not metadata"); err == nil {
+		t.Error("Expected an error for a non-struct value")
+	}
+}
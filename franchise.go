@@ -0,0 +1,181 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// tmdbCollectionSource is the external GUID source of the TMDb collection (franchise) a movie belongs to,
+// e.g. "tmdbcollection://10"
+const tmdbCollectionSource = "tmdbcollection"
+
+// franchiseLabelPrefix prefixes the label identifying the collections created by AutoGroupFranchises
+const franchiseLabelPrefix = "tmdb-collection:"
+
+// franchiseTitleStopWords are trailing words dropped from the common title of a franchise's movies
+var franchiseTitleStopWords = map[string]bool{"and": true, "the": true, "of": true, "a": true, "in": true}
+
+// Franchise is a TMDb collection with at least two movies in a library section
+type Franchise struct {
+	TMDbID     string
+	Title      string
+	Items      []Metadata // Members in the section, by release
+	Collection *Collection
+	Created    bool     // The collection did not exist before
+	Added      []string // Rating keys added to an existing collection
+}
+
+// AutoGroupFranchises groups the movies of a library section into one collection per franchise,
+// using the TMDb collection GUIDs of the items. Franchises with fewer than two movies in the
+// section are skipped. Collections are labeled with the TMDb collection ID so running it again
+// adds newly matched movies to the existing collections instead of creating duplicates. Plex
+// doesn't report franchise names, so collections are titled after the common part of their
+// movies' titles (e.g. "Toy Story Collection"), or after the first movie.
+func (s *Collections) AutoGroupFranchises(ctx context.Context, sectionID int, opts ...operations.Option) ([]Franchise, error) {
+	library := newLibrary(s.sdkConfiguration)
+
+	queryParams := url.Values{}
+	queryParams.Add("includeGuids", "1")
+
+	items, err := library.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), queryParams, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting library items: %w", err)
+	}
+
+	members := map[string][]Metadata{}
+	for _, item := range items {
+		if tmdbID := item.ExternalID(tmdbCollectionSource); tmdbID != "" {
+			members[tmdbID] = append(members[tmdbID], item)
+		}
+	}
+
+	franchises := []Franchise{}
+	for tmdbID, franchiseItems := range members {
+		if len(franchiseItems) < 2 {
+			continue
+		}
+
+		sort.SliceStable(franchiseItems, func(i, j int) bool {
+			if franchiseItems[i].OriginallyAvailableAt != franchiseItems[j].OriginallyAvailableAt {
+				return franchiseItems[i].OriginallyAvailableAt < franchiseItems[j].OriginallyAvailableAt
+			}
+			return franchiseItems[i].Year < franchiseItems[j].Year
+		})
+
+		franchises = append(franchises, Franchise{
+			TMDbID: tmdbID,
+			Title:  franchiseTitle(franchiseItems),
+			Items:  franchiseItems,
+		})
+	}
+	sort.Slice(franchises, func(i, j int) bool { return franchises[i].Title < franchises[j].Title })
+
+	options := processOptions(opts)
+
+	reportProgress(options, 0, len(franchises), "grouping franchises")
+	for i := range franchises {
+		if err := s.groupFranchise(ctx, sectionID, &franchises[i], opts...); err != nil {
+			return franchises[:i], fmt.Errorf("error grouping %s: %w", franchises[i].Title, err)
+		}
+		reportProgress(options, i+1, len(franchises), "grouping franchises")
+	}
+
+	return franchises, nil
+}
+
+// groupFranchise creates the collection of a franchise, or adds its missing members to the existing one
+func (s *Collections) groupFranchise(ctx context.Context, sectionID int, franchise *Franchise, opts ...operations.Option) error {
+	label := franchiseLabelPrefix + franchise.TMDbID
+
+	ratingKeys := make([]string, 0, len(franchise.Items))
+	for _, item := range franchise.Items {
+		ratingKeys = append(ratingKeys, item.RatingKey)
+	}
+
+	existing, err := s.findIdempotentCollection(ctx, sectionID, franchise.Title, label, opts...)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		collection, err := s.CreateCollectionWithOptions(ctx, sectionID, franchise.Title, ratingKeys, CreateCollectionOptions{
+			Sort:           CollectionSortRelease,
+			IdempotencyKey: label,
+		}, opts...)
+		if err != nil {
+			return err
+		}
+
+		franchise.Collection = collection
+		franchise.Created = true
+		franchise.Added = ratingKeys
+		return nil
+	}
+
+	franchise.Collection = existing
+	franchise.Added = []string{}
+
+	collectionID, err := existing.ID()
+	if err != nil {
+		return fmt.Errorf("error converting collection ID to int: %w", err)
+	}
+
+	current, err := s.GetCollectionItems(ctx, collectionID, opts...)
+	if err != nil {
+		return err
+	}
+
+	inCollection := make(map[string]bool, len(current))
+	for _, ratingKey := range current {
+		inCollection[ratingKey] = true
+	}
+
+	for _, ratingKey := range ratingKeys {
+		if !inCollection[ratingKey] {
+			franchise.Added = append(franchise.Added, ratingKey)
+		}
+	}
+
+	if len(franchise.Added) == 0 {
+		return nil
+	}
+
+	return s.AddToCollection(ctx, collectionID, franchise.Added, opts...)
+}
+
+// franchiseTitle returns the collection title of a franchise: the words its movies' titles start
+// with, up to any subtitle, or the title of the first movie if they have none in common
+func franchiseTitle(items []Metadata) string {
+	common := strings.Fields(items[0].Title)
+	for _, item := range items[1:] {
+		words := strings.Fields(item.Title)
+		n := 0
+		for n < len(common) && n < len(words) && strings.EqualFold(common[n], words[n]) {
+			n++
+		}
+		common = common[:n]
+	}
+
+	// Stop at a subtitle, e.g. "Star Wars: Episode ..."
+	for i, word := range common {
+		if strings.HasSuffix(word, ":") {
+			common = append(common[:i:i], strings.TrimSuffix(word, ":"))
+			break
+		}
+	}
+
+	for len(common) > 0 && (franchiseTitleStopWords[strings.ToLower(common[len(common)-1])] || common[len(common)-1] == "-") {
+		common = common[:len(common)-1]
+	}
+
+	if len(common) == 0 {
+		return items[0].Title + " Collection"
+	}
+
+	return strings.Join(common, " ") + " Collection"
+}
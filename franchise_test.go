@@ -0,0 +1,107 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAutoGroupFranchises(t *testing.T) {
+	var created, added string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("includeGuids") != "1" {
+				t.Errorf("Expected includeGuids=1, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"102","type":"movie","title":"Toy Story 2","originallyAvailableAt":"1999-11-24","Guid":[{"id":"tmdb://863"},{"id":"tmdbcollection://10194"}]},
+				{"ratingKey":"101","type":"movie","title":"Toy Story","originallyAvailableAt":"1995-11-22","Guid":[{"id":"tmdb://862"},{"id":"tmdbcollection://10194"}]},
+				{"ratingKey":"201","type":"movie","title":"The Matrix","originallyAvailableAt":"1999-03-31","Guid":[{"id":"tmdbcollection://2344"}]},
+				{"ratingKey":"202","type":"movie","title":"The Matrix Reloaded","originallyAvailableAt":"2003-05-15","Guid":[{"id":"tmdbcollection://2344"}]},
+				{"ratingKey":"301","type":"movie","title":"Heat","Guid":[{"id":"tmdbcollection://999"}]},
+				{"ratingKey":"401","type":"movie","title":"Ronin"}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"8","title":"The Matrix Collection","type":"collection"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"8","title":"The Matrix Collection","type":"collection","librarySectionID":1,"Label":[{"tag":"tmdb-collection:2344"}]}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8/children":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"201","type":"movie"}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/8/items":
+			added = r.URL.Query().Get("uri")
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/101,102":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"101","type":"movie"},{"ratingKey":"102","type":"movie"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"machineIdentifier":"abc"}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			created = r.URL.Query().Get("title")
+			w.Header().Set("Location", "/library/collections/9")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == "GET" && r.URL.Path == "/library/collections/9":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"9","title":"Toy Story Collection","type":"collection","librarySectionID":1}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/9/prefs":
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("label[0].tag.tag") != "tmdb-collection:10194" {
+				t.Errorf("Expected the franchise label, got: %s", r.URL.RawQuery)
+			}
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	franchises, err := client.Collections.AutoGroupFranchises(context.Background(), 1, WithWaitForActivity(time.Second))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(franchises) != 2 {
+		t.Fatalf("Expected 2 franchises, got: %d", len(franchises))
+	}
+
+	matrix, toyStory := franchises[0], franchises[1]
+
+	if matrix.Title != "The Matrix Collection" || matrix.Created {
+		t.Errorf("Expected the existing Matrix collection to be reused, got: %+v", matrix)
+	}
+	if len(matrix.Added) != 1 || matrix.Added[0] != "202" || added == "" {
+		t.Errorf("Expected only The Matrix Reloaded to be added, got: %v (uri %q)", matrix.Added, added)
+	}
+
+	if !toyStory.Created || created != "Toy Story Collection" || toyStory.Collection.RatingKey != "9" {
+		t.Errorf("Expected Toy Story Collection to be created, got: %+v (title %q)", toyStory, created)
+	}
+	if toyStory.Items[0].RatingKey != "101" {
+		t.Errorf("Expected members in release order, got: %s first", toyStory.Items[0].Title)
+	}
+}
+
+func TestFranchiseTitle(t *testing.T) {
+	tests := []struct {
+		titles   []string
+		expected string
+	}{
+		{[]string{"Star Wars: A New Hope", "Star Wars: The Empire Strikes Back"}, "Star Wars Collection"},
+		{[]string{"Harry Potter and the Philosopher's Stone", "Harry Potter and the Chamber of Secrets"}, "Harry Potter Collection"},
+		{[]string{"Alien", "Aliens"}, "Alien Collection"},
+	}
+
+	for _, tt := range tests {
+		items := make([]Metadata, 0, len(tt.titles))
+		for _, title := range tt.titles {
+			items = append(items, Metadata{Title: title})
+		}
+
+		if title := franchiseTitle(items); title != tt.expected {
+			t.Errorf("Expected %q for %v, got: %q", tt.expected, tt.titles, title)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/grpcapi/managementpb"
+)
+
+// CollectionServer implements CollectionService with the Collections service of a client
+type CollectionServer struct {
+	managementpb.UnimplementedCollectionServiceServer
+	config
+}
+
+// NewCollectionServer returns a CollectionService server for client
+func NewCollectionServer(client *plexgo.PlexAPI, opts ...Option) *CollectionServer {
+	return &CollectionServer{config: newConfig(client, opts)}
+}
+
+func (s *CollectionServer) ListCollections(ctx context.Context, req *managementpb.ListCollectionsRequest) (*managementpb.ListCollectionsResponse, error) {
+	if req.GetSectionId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "section_id is required")
+	}
+
+	collections, err := s.client.Collections.GetAllCollections(ctx, int(req.GetSectionId()), s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	res := &managementpb.ListCollectionsResponse{Collections: make([]*managementpb.Collection, 0, len(collections))}
+	for i := range collections {
+		res.Collections = append(res.Collections, newCollection(&collections[i]))
+	}
+	return res, nil
+}
+
+func (s *CollectionServer) GetCollection(ctx context.Context, req *managementpb.GetCollectionRequest) (*managementpb.Collection, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	collection, err := s.client.Collections.GetCollection(ctx, int(req.GetId()), s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	return newCollection(collection), nil
+}
+
+func (s *CollectionServer) CreateCollection(ctx context.Context, req *managementpb.CreateCollectionRequest) (*managementpb.Collection, error) {
+	if req.GetSectionId() <= 0 || req.GetTitle() == "" || len(req.GetItemIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "section_id, title and item_ids are required")
+	}
+
+	collection, err := s.client.Collections.CreateCollection(ctx, int(req.GetSectionId()), req.GetTitle(), req.GetItemIds(), s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	return newCollection(collection), nil
+}
+
+func (s *CollectionServer) DeleteCollection(ctx context.Context, req *managementpb.DeleteCollectionRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.client.Collections.DeleteCollection(ctx, int(req.GetId()), s.requestOpts...); err != nil {
+		return nil, Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CollectionServer) ListCollectionItems(ctx context.Context, req *managementpb.ListCollectionItemsRequest) (*managementpb.ListCollectionItemsResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	itemIDs, err := s.client.Collections.GetCollectionItems(ctx, int(req.GetId()), s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	return &managementpb.ListCollectionItemsResponse{ItemIds: itemIDs}, nil
+}
+
+func (s *CollectionServer) AddCollectionItems(ctx context.Context, req *managementpb.AddCollectionItemsRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 || len(req.GetItemIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id and item_ids are required")
+	}
+
+	if err := s.client.Collections.AddToCollection(ctx, int(req.GetId()), req.GetItemIds(), s.requestOpts...); err != nil {
+		return nil, Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CollectionServer) RemoveCollectionItems(ctx context.Context, req *managementpb.RemoveCollectionItemsRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 || len(req.GetItemIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id and item_ids are required")
+	}
+
+	if err := s.client.Collections.RemoveFromCollection(ctx, int(req.GetId()), req.GetItemIds(), s.requestOpts...); err != nil {
+		return nil, Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// newCollection returns the message of a collection
+func newCollection(c *plexgo.Collection) *managementpb.Collection {
+	return &managementpb.Collection{
+		Id:        parseRatingKey(c.RatingKey),
+		Title:     c.Title,
+		Summary:   c.Summary,
+		SectionId: int64(c.SectionID),
+		Smart:     c.IsSmartCollection(),
+		ItemCount: int32(c.ChildCount),
+		Mode:      c.CollectionMode,
+		Sort:      c.CollectionSort,
+		AddedAt:   c.AddedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+// Package grpcapi serves the collection, playlist and library subset of a PlexAPI client over gRPC,
+// for embedding plexgo in a larger service stack. The services are defined in
+// managementpb/management.proto, and errors of the SDK are returned with the gRPC status code
+// matching the server's response. It is a separate module so the SDK itself does not depend on gRPC.
+package grpcapi
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative managementpb/management.proto
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/grpcapi/managementpb"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Option configures the servers
+type Option func(*config)
+
+// WithRequestOptions sets options passed to every call to the SDK, e.g. operations.WithServerURL
+// or operations.WithRetries
+func WithRequestOptions(opts ...operations.Option) Option {
+	return func(c *config) {
+		c.requestOpts = append(c.requestOpts, opts...)
+	}
+}
+
+// config holds the settings shared by the servers
+type config struct {
+	client      *plexgo.PlexAPI
+	requestOpts []operations.Option
+}
+
+// newConfig returns the settings of a server
+func newConfig(client *plexgo.PlexAPI, opts []Option) config {
+	c := config{client: client}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// Register registers the collection, playlist and library services of client on a gRPC server.
+// Authentication of callers is left to the server, e.g. with an interceptor.
+func Register(registrar grpc.ServiceRegistrar, client *plexgo.PlexAPI, opts ...Option) {
+	managementpb.RegisterCollectionServiceServer(registrar, NewCollectionServer(client, opts...))
+	managementpb.RegisterPlaylistServiceServer(registrar, NewPlaylistServer(client, opts...))
+	managementpb.RegisterLibraryServiceServer(registrar, NewLibraryServer(client, opts...))
+}
+
+// parseRatingKey returns a rating key as an ID, or 0 if it is not numeric
+func parseRatingKey(ratingKey string) int64 {
+	id, _ := strconv.ParseInt(ratingKey, 10, 64)
+	return id
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
@@ -0,0 +1,194 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/grpcapi/managementpb"
+)
+
+// newTestConn returns a connection to a gRPC server registered in front of a Plex server serving handler
+func newTestConn(t *testing.T, handler http.HandlerFunc) *grpc.ClientConn {
+	plex := httptest.NewServer(handler)
+	t.Cleanup(plex.Close)
+
+	client := plexgo.New(plexgo.WithServerURL(plex.URL), plexgo.WithSecurity("plex-token"))
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	// Waiting for activities skips the delay after writes
+	Register(server, client, WithRequestOptions(plexgo.WithWaitForActivity(time.Second)))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Error dialing server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func TestCollectionService(t *testing.T) {
+	var added string
+
+	conn := newTestConn(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"10","title":"Marvel","librarySectionID":1,"childCount":3,"addedAt":1700000000},
+				{"ratingKey":"11","title":"Unwatched","librarySectionID":1,"smart":"1"}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/10":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"10","title":"Marvel","librarySectionID":1,"childCount":3}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/99":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/101":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"101","type":"movie","title":"Iron Man"}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/10/items":
+			added = r.URL.Query().Get("uri")
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	collections := managementpb.NewCollectionServiceClient(conn)
+	ctx := context.Background()
+
+	list, err := collections.ListCollections(ctx, &managementpb.ListCollectionsRequest{SectionId: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := []*managementpb.Collection{
+		{Id: 10, Title: "Marvel", SectionId: 1, ItemCount: 3, AddedAt: 1700000000},
+		{Id: 11, Title: "Unwatched", SectionId: 1, Smart: true},
+	}
+	if len(list.Collections) != len(expected) {
+		t.Fatalf("Expected %d collections, got: %v", len(expected), list.Collections)
+	}
+	for i := range expected {
+		if !proto.Equal(list.Collections[i], expected[i]) {
+			t.Errorf("Expected collection %v, got: %v", expected[i], list.Collections[i])
+		}
+	}
+
+	if _, err := collections.AddCollectionItems(ctx, &managementpb.AddCollectionItemsRequest{Id: 10, ItemIds: []string{"101"}}); err != nil {
+		t.Fatalf("Expected no error adding items, got: %v", err)
+	}
+	if added != "server://abc123/com.plexapp.plugins.library/library/metadata/101" {
+		t.Errorf("Unexpected items URI: %s", added)
+	}
+
+	_, err = collections.GetCollection(ctx, &managementpb.GetCollectionRequest{Id: 99})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got: %v", err)
+	}
+
+	_, err = collections.CreateCollection(ctx, &managementpb.CreateCollectionRequest{SectionId: 1, Title: "Empty"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got: %v", err)
+	}
+}
+
+func TestPlaylistService(t *testing.T) {
+	var createdURI string
+
+	conn := newTestConn(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "GET" && r.URL.Path == "/playlists":
+			if r.URL.Query().Get("smart") != "0" {
+				t.Errorf("Expected the smart filter, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"20","title":"Favorites","playlistType":"video","leafCount":5,"duration":7200000}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/playlists":
+			createdURI = r.URL.Query().Get("uri")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"21","title":"Road Trip","playlistType":"audio","leafCount":2}]}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/playlists/30":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	playlists := managementpb.NewPlaylistServiceClient(conn)
+	ctx := context.Background()
+
+	list, err := playlists.ListPlaylists(ctx, &managementpb.ListPlaylistsRequest{Smart: proto.Bool(false)})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(list.Playlists) != 1 || list.Playlists[0].Id != 20 || list.Playlists[0].ItemCount != 5 || list.Playlists[0].DurationMs != 7200000 {
+		t.Errorf("Unexpected playlists: %v", list.Playlists)
+	}
+
+	playlist, err := playlists.CreatePlaylist(ctx, &managementpb.CreatePlaylistRequest{Title: "Road Trip", Type: "audio", ItemIds: []string{"1", "2"}})
+	if err != nil {
+		t.Fatalf("Expected no error creating the playlist, got: %v", err)
+	}
+	if playlist.Id != 21 || createdURI != "server://abc123/com.plexapp.plugins.library/library/metadata/1,2" {
+		t.Errorf("Unexpected playlist %v created from URI: %s", playlist, createdURI)
+	}
+
+	_, err = playlists.DeletePlaylist(ctx, &managementpb.DeletePlaylistRequest{Id: 30})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected Unavailable, got: %v", err)
+	}
+}
+
+func TestLibraryService(t *testing.T) {
+	conn := newTestConn(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"key":"1","type":"movie","title":"Movies","agent":"tv.plex.agents.movie","language":"en-US"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/101":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"101","guid":"plex://movie/1","type":"movie","title":"Iron Man","year":2008,"librarySectionID":1}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	library := managementpb.NewLibraryServiceClient(conn)
+	ctx := context.Background()
+
+	sections, err := library.ListSections(ctx, &managementpb.ListSectionsRequest{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(sections.Sections) != 1 || sections.Sections[0].Id != 1 || sections.Sections[0].Agent != "tv.plex.agents.movie" {
+		t.Errorf("Unexpected sections: %v", sections.Sections)
+	}
+
+	item, err := library.GetItem(ctx, &managementpb.GetItemRequest{RatingKey: 101})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if item.Title != "Iron Man" || item.Year != 2008 || item.SectionId != 1 {
+		t.Errorf("Unexpected item: %v", item)
+	}
+}
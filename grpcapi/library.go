@@ -0,0 +1,89 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/grpcapi/managementpb"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// LibraryServer implements LibraryService with the Library service of a client
+type LibraryServer struct {
+	managementpb.UnimplementedLibraryServiceServer
+	config
+}
+
+// NewLibraryServer returns a LibraryService server for client
+func NewLibraryServer(client *plexgo.PlexAPI, opts ...Option) *LibraryServer {
+	return &LibraryServer{config: newConfig(client, opts)}
+}
+
+func (s *LibraryServer) ListSections(ctx context.Context, req *managementpb.ListSectionsRequest) (*managementpb.ListSectionsResponse, error) {
+	res, err := s.client.Library.GetAllLibraries(ctx, s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	out := &managementpb.ListSectionsResponse{Sections: []*managementpb.Section{}}
+	if res.Object != nil && res.Object.MediaContainer != nil {
+		for _, d := range res.Object.MediaContainer.Directory {
+			out.Sections = append(out.Sections, &managementpb.Section{
+				Id:         parseRatingKey(d.Key),
+				Title:      d.Title,
+				Type:       string(d.Type),
+				Agent:      d.Agent,
+				Language:   d.Language,
+				Refreshing: d.Refreshing,
+			})
+		}
+	}
+	return out, nil
+}
+
+func (s *LibraryServer) GetItem(ctx context.Context, req *managementpb.GetItemRequest) (*managementpb.Item, error) {
+	if req.GetRatingKey() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "rating_key is required")
+	}
+
+	item, err := s.client.Library.GetItem(ctx, int(req.GetRatingKey()), s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	return &managementpb.Item{
+		RatingKey:        item.RatingKey,
+		Guid:             item.GUID,
+		Type:             item.Type,
+		Title:            item.Title,
+		Summary:          item.Summary,
+		Year:             int32(item.Year),
+		Index:            int32(item.Index),
+		ParentIndex:      int32(item.ParentIndex),
+		ParentRatingKey:  item.ParentRatingKey,
+		GrandparentTitle: item.GrandparentTitle,
+		SectionId:        int64(item.SectionID),
+		AddedAt:          item.AddedAt,
+	}, nil
+}
+
+func (s *LibraryServer) RefreshSection(ctx context.Context, req *managementpb.RefreshSectionRequest) (*emptypb.Empty, error) {
+	if req.GetSectionId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "section_id is required")
+	}
+
+	force := operations.ForceZero
+	if req.GetForce() {
+		force = operations.ForceOne
+	}
+
+	if _, err := s.client.Library.GetRefreshLibraryMetadata(ctx, int(req.GetSectionId()), force.ToPointer(), s.requestOpts...); err != nil {
+		return nil, Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
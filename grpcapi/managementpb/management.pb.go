@@ -0,0 +1,1742 @@
+// Management services of a Plex Media Server: the collection, playlist and library subset of the
+// plexgo SDK, served by the grpcapi package.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: managementpb/management.proto
+
+package managementpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Summary       string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	SectionId     int64                  `protobuf:"varint,4,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Smart         bool                   `protobuf:"varint,5,opt,name=smart,proto3" json:"smart,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,6,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	Mode          string                 `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	Sort          string                 `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	AddedAt       int64                  `protobuf:"varint,9,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`        // Unix time in seconds
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix time in seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_managementpb_management_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Collection) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Collection) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Collection) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Collection) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+func (x *Collection) GetSmart() bool {
+	if x != nil {
+		return x.Smart
+	}
+	return false
+}
+
+func (x *Collection) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Collection) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Collection) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *Collection) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+func (x *Collection) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     int64                  `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCollectionsRequest) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_managementpb_management_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type GetCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCollectionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     int64                  `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,3,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"` // Rating keys of the items, which must all be of the same type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCollectionRequest) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+func (x *CreateCollectionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCollectionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListCollectionItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionItemsRequest) Reset() {
+	*x = ListCollectionItemsRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionItemsRequest) ProtoMessage() {}
+
+func (x *ListCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCollectionItemsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListCollectionItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemIds       []string               `protobuf:"bytes,1,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionItemsResponse) Reset() {
+	*x = ListCollectionItemsResponse{}
+	mi := &file_managementpb_management_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionItemsResponse) ProtoMessage() {}
+
+func (x *ListCollectionItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsResponse) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCollectionItemsResponse) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type AddCollectionItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollectionItemsRequest) Reset() {
+	*x = AddCollectionItemsRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollectionItemsRequest) ProtoMessage() {}
+
+func (x *AddCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*AddCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddCollectionItemsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddCollectionItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type RemoveCollectionItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollectionItemsRequest) Reset() {
+	*x = RemoveCollectionItemsRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollectionItemsRequest) ProtoMessage() {}
+
+func (x *RemoveCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RemoveCollectionItemsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveCollectionItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type Playlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Summary       string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // audio, video or photo
+	Smart         bool                   `protobuf:"varint,5,opt,name=smart,proto3" json:"smart,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,6,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	AddedAt       int64                  `protobuf:"varint,8,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`       // Unix time in seconds
+	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix time in seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Playlist) Reset() {
+	*x = Playlist{}
+	mi := &file_managementpb_management_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Playlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playlist) ProtoMessage() {}
+
+func (x *Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Playlist) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Playlist) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Playlist) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Playlist) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Playlist) GetSmart() bool {
+	if x != nil {
+		return x.Smart
+	}
+	return false
+}
+
+func (x *Playlist) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Playlist) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *Playlist) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+func (x *Playlist) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListPlaylistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`          // audio, video or photo; all types if empty
+	Smart         *bool                  `protobuf:"varint,2,opt,name=smart,proto3,oneof" json:"smart,omitempty"` // Only smart or regular playlists if set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistsRequest) Reset() {
+	*x = ListPlaylistsRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistsRequest) ProtoMessage() {}
+
+func (x *ListPlaylistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaylistsRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPlaylistsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListPlaylistsRequest) GetSmart() bool {
+	if x != nil && x.Smart != nil {
+		return *x.Smart
+	}
+	return false
+}
+
+type ListPlaylistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlists     []*Playlist            `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistsResponse) Reset() {
+	*x = ListPlaylistsResponse{}
+	mi := &file_managementpb_management_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistsResponse) ProtoMessage() {}
+
+func (x *ListPlaylistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaylistsResponse) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPlaylistsResponse) GetPlaylists() []*Playlist {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+type GetPlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlaylistRequest) Reset() {
+	*x = GetPlaylistRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistRequest) ProtoMessage() {}
+
+func (x *GetPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPlaylistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// A smart playlist is created from a URI; other playlists from a URI or the rating keys of their items
+type CreatePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // audio, video or photo
+	Smart         bool                   `protobuf:"varint,3,opt,name=smart,proto3" json:"smart,omitempty"`
+	Uri           string                 `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,5,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlaylistRequest) Reset() {
+	*x = CreatePlaylistRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistRequest) ProtoMessage() {}
+
+func (x *CreatePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreatePlaylistRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetSmart() bool {
+	if x != nil {
+		return x.Smart
+	}
+	return false
+}
+
+func (x *CreatePlaylistRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+// Fields that are not set are left unchanged
+type UpdatePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Summary       *string                `protobuf:"bytes,3,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlaylistRequest) Reset() {
+	*x = UpdatePlaylistRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistRequest) ProtoMessage() {}
+
+func (x *UpdatePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdatePlaylistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePlaylistRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+type DeletePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaylistRequest) Reset() {
+	*x = DeletePlaylistRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistRequest) ProtoMessage() {}
+
+func (x *DeletePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeletePlaylistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AddPlaylistItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaylistItemsRequest) Reset() {
+	*x = AddPlaylistItemsRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaylistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaylistItemsRequest) ProtoMessage() {}
+
+func (x *AddPlaylistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaylistItemsRequest.ProtoReflect.Descriptor instead.
+func (*AddPlaylistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddPlaylistItemsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddPlaylistItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type ClearPlaylistItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearPlaylistItemsRequest) Reset() {
+	*x = ClearPlaylistItemsRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearPlaylistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearPlaylistItemsRequest) ProtoMessage() {}
+
+func (x *ClearPlaylistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearPlaylistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ClearPlaylistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ClearPlaylistItemsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Section struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // movie, show, artist or photo
+	Agent         string                 `protobuf:"bytes,4,opt,name=agent,proto3" json:"agent,omitempty"`
+	Language      string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Refreshing    bool                   `protobuf:"varint,6,opt,name=refreshing,proto3" json:"refreshing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_managementpb_management_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Section) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Section) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Section) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Section) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *Section) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Section) GetRefreshing() bool {
+	if x != nil {
+		return x.Refreshing
+	}
+	return false
+}
+
+type ListSectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSectionsRequest) Reset() {
+	*x = ListSectionsRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSectionsRequest) ProtoMessage() {}
+
+func (x *ListSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{20}
+}
+
+type ListSectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*Section             `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSectionsResponse) Reset() {
+	*x = ListSectionsResponse{}
+	mi := &file_managementpb_management_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSectionsResponse) ProtoMessage() {}
+
+func (x *ListSectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSectionsResponse) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSectionsResponse) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type Item struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RatingKey        string                 `protobuf:"bytes,1,opt,name=rating_key,json=ratingKey,proto3" json:"rating_key,omitempty"`
+	Guid             string                 `protobuf:"bytes,2,opt,name=guid,proto3" json:"guid,omitempty"`
+	Type             string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Title            string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Summary          string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	Year             int32                  `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	Index            int32                  `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+	ParentIndex      int32                  `protobuf:"varint,8,opt,name=parent_index,json=parentIndex,proto3" json:"parent_index,omitempty"`
+	ParentRatingKey  string                 `protobuf:"bytes,9,opt,name=parent_rating_key,json=parentRatingKey,proto3" json:"parent_rating_key,omitempty"`
+	GrandparentTitle string                 `protobuf:"bytes,10,opt,name=grandparent_title,json=grandparentTitle,proto3" json:"grandparent_title,omitempty"`
+	SectionId        int64                  `protobuf:"varint,11,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	AddedAt          int64                  `protobuf:"varint,12,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"` // Unix time in seconds
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_managementpb_management_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Item) GetRatingKey() string {
+	if x != nil {
+		return x.RatingKey
+	}
+	return ""
+}
+
+func (x *Item) GetGuid() string {
+	if x != nil {
+		return x.Guid
+	}
+	return ""
+}
+
+func (x *Item) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Item) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Item) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Item) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Item) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Item) GetParentIndex() int32 {
+	if x != nil {
+		return x.ParentIndex
+	}
+	return 0
+}
+
+func (x *Item) GetParentRatingKey() string {
+	if x != nil {
+		return x.ParentRatingKey
+	}
+	return ""
+}
+
+func (x *Item) GetGrandparentTitle() string {
+	if x != nil {
+		return x.GrandparentTitle
+	}
+	return ""
+}
+
+func (x *Item) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+func (x *Item) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+type GetItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatingKey     int64                  `protobuf:"varint,1,opt,name=rating_key,json=ratingKey,proto3" json:"rating_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemRequest) Reset() {
+	*x = GetItemRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemRequest) ProtoMessage() {}
+
+func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
+func (*GetItemRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetItemRequest) GetRatingKey() int64 {
+	if x != nil {
+		return x.RatingKey
+	}
+	return 0
+}
+
+type RefreshSectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     int64                  `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // Refresh all metadata, not only items that changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSectionRequest) Reset() {
+	*x = RefreshSectionRequest{}
+	mi := &file_managementpb_management_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSectionRequest) ProtoMessage() {}
+
+func (x *RefreshSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_managementpb_management_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSectionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSectionRequest) Descriptor() ([]byte, []int) {
+	return file_managementpb_management_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RefreshSectionRequest) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+func (x *RefreshSectionRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+var File_managementpb_management_proto protoreflect.FileDescriptor
+
+const file_managementpb_management_proto_rawDesc = "" +
+	"\n" +
+	"\x1dmanagementpb/management.proto\x12\x14plexgo.management.v1\x1a\x1bgoogle/protobuf/empty.proto\"\x82\x02\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x04 \x01(\x03R\tsectionId\x12\x14\n" +
+	"\x05smart\x18\x05 \x01(\bR\x05smart\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x06 \x01(\x05R\titemCount\x12\x12\n" +
+	"\x04mode\x18\a \x01(\tR\x04mode\x12\x12\n" +
+	"\x04sort\x18\b \x01(\tR\x04sort\x12\x19\n" +
+	"\badded_at\x18\t \x01(\x03R\aaddedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\"7\n" +
+	"\x16ListCollectionsRequest\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\x03R\tsectionId\"]\n" +
+	"\x17ListCollectionsResponse\x12B\n" +
+	"\vcollections\x18\x01 \x03(\v2 .plexgo.management.v1.CollectionR\vcollections\"&\n" +
+	"\x14GetCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"i\n" +
+	"\x17CreateCollectionRequest\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\x03R\tsectionId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x19\n" +
+	"\bitem_ids\x18\x03 \x03(\tR\aitemIds\")\n" +
+	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\",\n" +
+	"\x1aListCollectionItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"8\n" +
+	"\x1bListCollectionItemsResponse\x12\x19\n" +
+	"\bitem_ids\x18\x01 \x03(\tR\aitemIds\"F\n" +
+	"\x19AddCollectionItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\"I\n" +
+	"\x1cRemoveCollectionItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\"\xee\x01\n" +
+	"\bPlaylist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x14\n" +
+	"\x05smart\x18\x05 \x01(\bR\x05smart\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x06 \x01(\x05R\titemCount\x12\x1f\n" +
+	"\vduration_ms\x18\a \x01(\x03R\n" +
+	"durationMs\x12\x19\n" +
+	"\badded_at\x18\b \x01(\x03R\aaddedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\"O\n" +
+	"\x14ListPlaylistsRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x19\n" +
+	"\x05smart\x18\x02 \x01(\bH\x00R\x05smart\x88\x01\x01B\b\n" +
+	"\x06_smart\"U\n" +
+	"\x15ListPlaylistsResponse\x12<\n" +
+	"\tplaylists\x18\x01 \x03(\v2\x1e.plexgo.management.v1.PlaylistR\tplaylists\"$\n" +
+	"\x12GetPlaylistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x84\x01\n" +
+	"\x15CreatePlaylistRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05smart\x18\x03 \x01(\bR\x05smart\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12\x19\n" +
+	"\bitem_ids\x18\x05 \x03(\tR\aitemIds\"w\n" +
+	"\x15UpdatePlaylistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\asummary\x18\x03 \x01(\tH\x01R\asummary\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_summary\"'\n" +
+	"\x15DeletePlaylistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x17AddPlaylistItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\"+\n" +
+	"\x19ClearPlaylistItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x95\x01\n" +
+	"\aSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05agent\x18\x04 \x01(\tR\x05agent\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x1e\n" +
+	"\n" +
+	"refreshing\x18\x06 \x01(\bR\n" +
+	"refreshing\"\x15\n" +
+	"\x13ListSectionsRequest\"Q\n" +
+	"\x14ListSectionsResponse\x129\n" +
+	"\bsections\x18\x01 \x03(\v2\x1d.plexgo.management.v1.SectionR\bsections\"\xdd\x02\n" +
+	"\x04Item\x12\x1d\n" +
+	"\n" +
+	"rating_key\x18\x01 \x01(\tR\tratingKey\x12\x12\n" +
+	"\x04guid\x18\x02 \x01(\tR\x04guid\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x05 \x01(\tR\asummary\x12\x12\n" +
+	"\x04year\x18\x06 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05index\x18\a \x01(\x05R\x05index\x12!\n" +
+	"\fparent_index\x18\b \x01(\x05R\vparentIndex\x12*\n" +
+	"\x11parent_rating_key\x18\t \x01(\tR\x0fparentRatingKey\x12+\n" +
+	"\x11grandparent_title\x18\n" +
+	" \x01(\tR\x10grandparentTitle\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\v \x01(\x03R\tsectionId\x12\x19\n" +
+	"\badded_at\x18\f \x01(\x03R\aaddedAt\"/\n" +
+	"\x0eGetItemRequest\x12\x1d\n" +
+	"\n" +
+	"rating_key\x18\x01 \x01(\x03R\tratingKey\"L\n" +
+	"\x15RefreshSectionRequest\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\x03R\tsectionId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force2\xe2\x05\n" +
+	"\x11CollectionService\x12n\n" +
+	"\x0fListCollections\x12,.plexgo.management.v1.ListCollectionsRequest\x1a-.plexgo.management.v1.ListCollectionsResponse\x12]\n" +
+	"\rGetCollection\x12*.plexgo.management.v1.GetCollectionRequest\x1a .plexgo.management.v1.Collection\x12c\n" +
+	"\x10CreateCollection\x12-.plexgo.management.v1.CreateCollectionRequest\x1a .plexgo.management.v1.Collection\x12Y\n" +
+	"\x10DeleteCollection\x12-.plexgo.management.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\x12z\n" +
+	"\x13ListCollectionItems\x120.plexgo.management.v1.ListCollectionItemsRequest\x1a1.plexgo.management.v1.ListCollectionItemsResponse\x12]\n" +
+	"\x12AddCollectionItems\x12/.plexgo.management.v1.AddCollectionItemsRequest\x1a\x16.google.protobuf.Empty\x12c\n" +
+	"\x15RemoveCollectionItems\x122.plexgo.management.v1.RemoveCollectionItemsRequest\x1a\x16.google.protobuf.Empty2\x9b\x05\n" +
+	"\x0fPlaylistService\x12h\n" +
+	"\rListPlaylists\x12*.plexgo.management.v1.ListPlaylistsRequest\x1a+.plexgo.management.v1.ListPlaylistsResponse\x12W\n" +
+	"\vGetPlaylist\x12(.plexgo.management.v1.GetPlaylistRequest\x1a\x1e.plexgo.management.v1.Playlist\x12]\n" +
+	"\x0eCreatePlaylist\x12+.plexgo.management.v1.CreatePlaylistRequest\x1a\x1e.plexgo.management.v1.Playlist\x12U\n" +
+	"\x0eUpdatePlaylist\x12+.plexgo.management.v1.UpdatePlaylistRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x0eDeletePlaylist\x12+.plexgo.management.v1.DeletePlaylistRequest\x1a\x16.google.protobuf.Empty\x12Y\n" +
+	"\x10AddPlaylistItems\x12-.plexgo.management.v1.AddPlaylistItemsRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
+	"\x12ClearPlaylistItems\x12/.plexgo.management.v1.ClearPlaylistItemsRequest\x1a\x16.google.protobuf.Empty2\x9b\x02\n" +
+	"\x0eLibraryService\x12e\n" +
+	"\fListSections\x12).plexgo.management.v1.ListSectionsRequest\x1a*.plexgo.management.v1.ListSectionsResponse\x12K\n" +
+	"\aGetItem\x12$.plexgo.management.v1.GetItemRequest\x1a\x1a.plexgo.management.v1.Item\x12U\n" +
+	"\x0eRefreshSection\x12+.plexgo.management.v1.RefreshSectionRequest\x1a\x16.google.protobuf.EmptyB2Z0github.com/unfaiyted/plexgo/grpcapi/managementpbb\x06proto3"
+
+var (
+	file_managementpb_management_proto_rawDescOnce sync.Once
+	file_managementpb_management_proto_rawDescData []byte
+)
+
+func file_managementpb_management_proto_rawDescGZIP() []byte {
+	file_managementpb_management_proto_rawDescOnce.Do(func() {
+		file_managementpb_management_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_managementpb_management_proto_rawDesc), len(file_managementpb_management_proto_rawDesc)))
+	})
+	return file_managementpb_management_proto_rawDescData
+}
+
+var file_managementpb_management_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_managementpb_management_proto_goTypes = []any{
+	(*Collection)(nil),                   // 0: plexgo.management.v1.Collection
+	(*ListCollectionsRequest)(nil),       // 1: plexgo.management.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),      // 2: plexgo.management.v1.ListCollectionsResponse
+	(*GetCollectionRequest)(nil),         // 3: plexgo.management.v1.GetCollectionRequest
+	(*CreateCollectionRequest)(nil),      // 4: plexgo.management.v1.CreateCollectionRequest
+	(*DeleteCollectionRequest)(nil),      // 5: plexgo.management.v1.DeleteCollectionRequest
+	(*ListCollectionItemsRequest)(nil),   // 6: plexgo.management.v1.ListCollectionItemsRequest
+	(*ListCollectionItemsResponse)(nil),  // 7: plexgo.management.v1.ListCollectionItemsResponse
+	(*AddCollectionItemsRequest)(nil),    // 8: plexgo.management.v1.AddCollectionItemsRequest
+	(*RemoveCollectionItemsRequest)(nil), // 9: plexgo.management.v1.RemoveCollectionItemsRequest
+	(*Playlist)(nil),                     // 10: plexgo.management.v1.Playlist
+	(*ListPlaylistsRequest)(nil),         // 11: plexgo.management.v1.ListPlaylistsRequest
+	(*ListPlaylistsResponse)(nil),        // 12: plexgo.management.v1.ListPlaylistsResponse
+	(*GetPlaylistRequest)(nil),           // 13: plexgo.management.v1.GetPlaylistRequest
+	(*CreatePlaylistRequest)(nil),        // 14: plexgo.management.v1.CreatePlaylistRequest
+	(*UpdatePlaylistRequest)(nil),        // 15: plexgo.management.v1.UpdatePlaylistRequest
+	(*DeletePlaylistRequest)(nil),        // 16: plexgo.management.v1.DeletePlaylistRequest
+	(*AddPlaylistItemsRequest)(nil),      // 17: plexgo.management.v1.AddPlaylistItemsRequest
+	(*ClearPlaylistItemsRequest)(nil),    // 18: plexgo.management.v1.ClearPlaylistItemsRequest
+	(*Section)(nil),                      // 19: plexgo.management.v1.Section
+	(*ListSectionsRequest)(nil),          // 20: plexgo.management.v1.ListSectionsRequest
+	(*ListSectionsResponse)(nil),         // 21: plexgo.management.v1.ListSectionsResponse
+	(*Item)(nil),                         // 22: plexgo.management.v1.Item
+	(*GetItemRequest)(nil),               // 23: plexgo.management.v1.GetItemRequest
+	(*RefreshSectionRequest)(nil),        // 24: plexgo.management.v1.RefreshSectionRequest
+	(*emptypb.Empty)(nil),                // 25: google.protobuf.Empty
+}
+var file_managementpb_management_proto_depIdxs = []int32{
+	0,  // 0: plexgo.management.v1.ListCollectionsResponse.collections:type_name -> plexgo.management.v1.Collection
+	10, // 1: plexgo.management.v1.ListPlaylistsResponse.playlists:type_name -> plexgo.management.v1.Playlist
+	19, // 2: plexgo.management.v1.ListSectionsResponse.sections:type_name -> plexgo.management.v1.Section
+	1,  // 3: plexgo.management.v1.CollectionService.ListCollections:input_type -> plexgo.management.v1.ListCollectionsRequest
+	3,  // 4: plexgo.management.v1.CollectionService.GetCollection:input_type -> plexgo.management.v1.GetCollectionRequest
+	4,  // 5: plexgo.management.v1.CollectionService.CreateCollection:input_type -> plexgo.management.v1.CreateCollectionRequest
+	5,  // 6: plexgo.management.v1.CollectionService.DeleteCollection:input_type -> plexgo.management.v1.DeleteCollectionRequest
+	6,  // 7: plexgo.management.v1.CollectionService.ListCollectionItems:input_type -> plexgo.management.v1.ListCollectionItemsRequest
+	8,  // 8: plexgo.management.v1.CollectionService.AddCollectionItems:input_type -> plexgo.management.v1.AddCollectionItemsRequest
+	9,  // 9: plexgo.management.v1.CollectionService.RemoveCollectionItems:input_type -> plexgo.management.v1.RemoveCollectionItemsRequest
+	11, // 10: plexgo.management.v1.PlaylistService.ListPlaylists:input_type -> plexgo.management.v1.ListPlaylistsRequest
+	13, // 11: plexgo.management.v1.PlaylistService.GetPlaylist:input_type -> plexgo.management.v1.GetPlaylistRequest
+	14, // 12: plexgo.management.v1.PlaylistService.CreatePlaylist:input_type -> plexgo.management.v1.CreatePlaylistRequest
+	15, // 13: plexgo.management.v1.PlaylistService.UpdatePlaylist:input_type -> plexgo.management.v1.UpdatePlaylistRequest
+	16, // 14: plexgo.management.v1.PlaylistService.DeletePlaylist:input_type -> plexgo.management.v1.DeletePlaylistRequest
+	17, // 15: plexgo.management.v1.PlaylistService.AddPlaylistItems:input_type -> plexgo.management.v1.AddPlaylistItemsRequest
+	18, // 16: plexgo.management.v1.PlaylistService.ClearPlaylistItems:input_type -> plexgo.management.v1.ClearPlaylistItemsRequest
+	20, // 17: plexgo.management.v1.LibraryService.ListSections:input_type -> plexgo.management.v1.ListSectionsRequest
+	23, // 18: plexgo.management.v1.LibraryService.GetItem:input_type -> plexgo.management.v1.GetItemRequest
+	24, // 19: plexgo.management.v1.LibraryService.RefreshSection:input_type -> plexgo.management.v1.RefreshSectionRequest
+	2,  // 20: plexgo.management.v1.CollectionService.ListCollections:output_type -> plexgo.management.v1.ListCollectionsResponse
+	0,  // 21: plexgo.management.v1.CollectionService.GetCollection:output_type -> plexgo.management.v1.Collection
+	0,  // 22: plexgo.management.v1.CollectionService.CreateCollection:output_type -> plexgo.management.v1.Collection
+	25, // 23: plexgo.management.v1.CollectionService.DeleteCollection:output_type -> google.protobuf.Empty
+	7,  // 24: plexgo.management.v1.CollectionService.ListCollectionItems:output_type -> plexgo.management.v1.ListCollectionItemsResponse
+	25, // 25: plexgo.management.v1.CollectionService.AddCollectionItems:output_type -> google.protobuf.Empty
+	25, // 26: plexgo.management.v1.CollectionService.RemoveCollectionItems:output_type -> google.protobuf.Empty
+	12, // 27: plexgo.management.v1.PlaylistService.ListPlaylists:output_type -> plexgo.management.v1.ListPlaylistsResponse
+	10, // 28: plexgo.management.v1.PlaylistService.GetPlaylist:output_type -> plexgo.management.v1.Playlist
+	10, // 29: plexgo.management.v1.PlaylistService.CreatePlaylist:output_type -> plexgo.management.v1.Playlist
+	25, // 30: plexgo.management.v1.PlaylistService.UpdatePlaylist:output_type -> google.protobuf.Empty
+	25, // 31: plexgo.management.v1.PlaylistService.DeletePlaylist:output_type -> google.protobuf.Empty
+	25, // 32: plexgo.management.v1.PlaylistService.AddPlaylistItems:output_type -> google.protobuf.Empty
+	25, // 33: plexgo.management.v1.PlaylistService.ClearPlaylistItems:output_type -> google.protobuf.Empty
+	21, // 34: plexgo.management.v1.LibraryService.ListSections:output_type -> plexgo.management.v1.ListSectionsResponse
+	22, // 35: plexgo.management.v1.LibraryService.GetItem:output_type -> plexgo.management.v1.Item
+	25, // 36: plexgo.management.v1.LibraryService.RefreshSection:output_type -> google.protobuf.Empty
+	20, // [20:37] is the sub-list for method output_type
+	3,  // [3:20] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_managementpb_management_proto_init() }
+func file_managementpb_management_proto_init() {
+	if File_managementpb_management_proto != nil {
+		return
+	}
+	file_managementpb_management_proto_msgTypes[11].OneofWrappers = []any{}
+	file_managementpb_management_proto_msgTypes[15].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_managementpb_management_proto_rawDesc), len(file_managementpb_management_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_managementpb_management_proto_goTypes,
+		DependencyIndexes: file_managementpb_management_proto_depIdxs,
+		MessageInfos:      file_managementpb_management_proto_msgTypes,
+	}.Build()
+	File_managementpb_management_proto = out.File
+	file_managementpb_management_proto_goTypes = nil
+	file_managementpb_management_proto_depIdxs = nil
+}
@@ -0,0 +1,189 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/grpcapi/managementpb"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// PlaylistServer implements PlaylistService with the Playlists service of a client
+type PlaylistServer struct {
+	managementpb.UnimplementedPlaylistServiceServer
+	config
+}
+
+// NewPlaylistServer returns a PlaylistService server for client
+func NewPlaylistServer(client *plexgo.PlexAPI, opts ...Option) *PlaylistServer {
+	return &PlaylistServer{config: newConfig(client, opts)}
+}
+
+func (s *PlaylistServer) ListPlaylists(ctx context.Context, req *managementpb.ListPlaylistsRequest) (*managementpb.ListPlaylistsResponse, error) {
+	var playlistType *operations.PlaylistType
+	if req.GetType() != "" {
+		playlistType = operations.PlaylistType(req.GetType()).ToPointer()
+	}
+
+	var smart *operations.QueryParamSmart
+	if req.Smart != nil {
+		smart = operations.QueryParamSmartZero.ToPointer()
+		if req.GetSmart() {
+			smart = operations.QueryParamSmartOne.ToPointer()
+		}
+	}
+
+	res, err := s.client.Playlists.GetPlaylists(ctx, playlistType, smart, s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	out := &managementpb.ListPlaylistsResponse{Playlists: []*managementpb.Playlist{}}
+	if res.Object != nil && res.Object.MediaContainer != nil {
+		for _, m := range res.Object.MediaContainer.Metadata {
+			out.Playlists = append(out.Playlists, newPlaylist(m.RatingKey, m.Title, m.Summary, m.PlaylistType, m.Smart, m.LeafCount, m.Duration, m.AddedAt, m.UpdatedAt))
+		}
+	}
+	return out, nil
+}
+
+func (s *PlaylistServer) GetPlaylist(ctx context.Context, req *managementpb.GetPlaylistRequest) (*managementpb.Playlist, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	res, err := s.client.Playlists.GetPlaylist(ctx, float64(req.GetId()), s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	if res.Object == nil || res.Object.MediaContainer == nil || len(res.Object.MediaContainer.Metadata) == 0 {
+		return nil, status.Errorf(codes.NotFound, "playlist %d not found", req.GetId())
+	}
+
+	m := res.Object.MediaContainer.Metadata[0]
+	return newPlaylist(m.RatingKey, m.Title, m.Summary, m.PlaylistType, m.Smart, m.LeafCount, m.Duration, m.AddedAt, m.UpdatedAt), nil
+}
+
+func (s *PlaylistServer) CreatePlaylist(ctx context.Context, req *managementpb.CreatePlaylistRequest) (*managementpb.Playlist, error) {
+	if req.GetTitle() == "" || req.GetType() == "" {
+		return nil, status.Error(codes.InvalidArgument, "title and type are required")
+	}
+	if (req.GetUri() == "") == (len(req.GetItemIds()) == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of uri and item_ids is required")
+	}
+
+	uri := req.GetUri()
+	if uri == "" {
+		var err error
+		if uri, err = s.itemsURI(ctx, req.GetItemIds()); err != nil {
+			return nil, Error(err)
+		}
+	}
+
+	request := operations.CreatePlaylistRequest{
+		Title: req.GetTitle(),
+		Type:  operations.CreatePlaylistQueryParamType(req.GetType()),
+		Smart: operations.SmartZero,
+		URI:   uri,
+	}
+	if req.GetSmart() {
+		request.Smart = operations.SmartOne
+	}
+
+	res, err := s.client.Playlists.CreatePlaylist(ctx, request, s.requestOpts...)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	if res.Object == nil || res.Object.MediaContainer == nil || len(res.Object.MediaContainer.Metadata) == 0 {
+		return nil, status.Error(codes.Internal, "server did not return the created playlist")
+	}
+
+	m := res.Object.MediaContainer.Metadata[0]
+	return newPlaylist(m.RatingKey, m.Title, m.Summary, m.PlaylistType, m.Smart, m.LeafCount, m.Duration, m.AddedAt, m.UpdatedAt), nil
+}
+
+func (s *PlaylistServer) UpdatePlaylist(ctx context.Context, req *managementpb.UpdatePlaylistRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.client.Playlists.UpdatePlaylist(ctx, float64(req.GetId()), req.Title, req.Summary, s.requestOpts...); err != nil {
+		return nil, Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PlaylistServer) DeletePlaylist(ctx context.Context, req *managementpb.DeletePlaylistRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.client.Playlists.DeletePlaylist(ctx, float64(req.GetId()), s.requestOpts...); err != nil {
+		return nil, Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PlaylistServer) AddPlaylistItems(ctx context.Context, req *managementpb.AddPlaylistItemsRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 || len(req.GetItemIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id and item_ids are required")
+	}
+
+	uri, err := s.itemsURI(ctx, req.GetItemIds())
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	if _, err := s.client.Playlists.AddPlaylistContents(ctx, float64(req.GetId()), uri, nil, s.requestOpts...); err != nil {
+		return nil, Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PlaylistServer) ClearPlaylistItems(ctx context.Context, req *managementpb.ClearPlaylistItemsRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.client.Playlists.ClearPlaylistContents(ctx, float64(req.GetId()), s.requestOpts...); err != nil {
+		return nil, Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// itemsURI returns the server:// URI of library items, used to create or add to playlists
+func (s *PlaylistServer) itemsURI(ctx context.Context, itemIDs []string) (string, error) {
+	identity, err := s.client.Server.GetIdentity(ctx, s.requestOpts...)
+	if err != nil {
+		return "", fmt.Errorf("error getting server identity: %w", err)
+	}
+
+	return fmt.Sprintf("server://%s/com.plexapp.plugins.library/library/metadata/%s", identity.MachineIdentifier, strings.Join(itemIDs, ",")), nil
+}
+
+// newPlaylist returns the message of a playlist from the fields shared by the playlist responses
+func newPlaylist(ratingKey, title, summary, playlistType *string, smart *bool, leafCount, duration, addedAt, updatedAt *int) *managementpb.Playlist {
+	return &managementpb.Playlist{
+		Id:         parseRatingKey(stringValue(ratingKey)),
+		Title:      stringValue(title),
+		Summary:    stringValue(summary),
+		Type:       stringValue(playlistType),
+		Smart:      smart != nil && *smart,
+		ItemCount:  int32(intValue(leafCount)),
+		DurationMs: int64(intValue(duration)),
+		AddedAt:    int64(intValue(addedAt)),
+		UpdatedAt:  int64(intValue(updatedAt)),
+	}
+}
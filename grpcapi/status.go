@@ -0,0 +1,118 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"reflect"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// Code returns the gRPC status code of an error returned by the SDK. Errors of a response from the
+// server are mapped by HTTP status, e.g. 404 to NotFound and 503 to Unavailable, and errors
+// sending the request to Unavailable. The server rejecting the SDK's token is PermissionDenied,
+// since it is not the caller's credentials that failed. Arguments the SDK rejects before sending a
+// request are InvalidArgument, and changes to the members of a smart collection FailedPrecondition.
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, plexgo.ErrInvalidArgument):
+		return codes.InvalidArgument
+	case errors.Is(err, plexgo.ErrSmartCollectionReadOnly):
+		return codes.FailedPrecondition
+	}
+
+	if statusCode, ok := responseStatus(err); ok {
+		return httpStatusCode(statusCode)
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return codes.Unavailable
+	}
+
+	return codes.Unknown
+}
+
+// Error returns an error of the SDK as a gRPC status error with the code returned by Code.
+// Errors that already have a status are returned unchanged.
+func Error(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return status.Error(Code(err), err.Error())
+}
+
+// responseStatus returns the HTTP status of the response an error was created from
+func responseStatus(err error) (int, bool) {
+	var sdkErr *sdkerrors.SDKError
+	if errors.As(err, &sdkErr) {
+		return sdkErr.StatusCode, true
+	}
+
+	// The typed errors of generated operations, e.g. *sdkerrors.GetPlaylistBadRequest, only share
+	// their RawResponse field
+	for ; err != nil; err = errors.Unwrap(err) {
+		v := reflect.ValueOf(err)
+		if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		field := v.Elem().FieldByName("RawResponse")
+		if !field.IsValid() {
+			continue
+		}
+		if res, ok := field.Interface().(*http.Response); ok && res != nil {
+			return res.StatusCode, true
+		}
+	}
+
+	return 0, false
+}
+
+// httpStatusCode returns the gRPC status code of an HTTP error status
+func httpStatusCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	}
+
+	if statusCode >= 500 {
+		return codes.Internal
+	}
+	return codes.FailedPrecondition
+}
@@ -0,0 +1,62 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"nil", nil, codes.OK},
+		{"not found", sdkerrors.NewSDKError("API error occurred", 404, "", nil), codes.NotFound},
+		{"wrapped bad request", fmt.Errorf("error adding items: %w", sdkerrors.NewSDKError("API error occurred", 400, "", nil)), codes.InvalidArgument},
+		{"plex error", sdkerrors.NewAPIError(401, `{"errors":[{"code":1001,"message":"User could not be authenticated"}]}`, nil), codes.PermissionDenied},
+		{"typed error", &sdkerrors.GetPlaylistBadRequest{RawResponse: &http.Response{StatusCode: 400}}, codes.InvalidArgument},
+		{"rate limited", sdkerrors.NewSDKError("API error occurred", 429, "", nil), codes.ResourceExhausted},
+		{"unavailable", sdkerrors.NewSDKError("API error occurred", 503, "", nil), codes.Unavailable},
+		{"server error", sdkerrors.NewSDKError("API error occurred", 500, "", nil), codes.Internal},
+		{"canceled", fmt.Errorf("error sending request: %w", context.Canceled), codes.Canceled},
+		{"deadline", context.DeadlineExceeded, codes.DeadlineExceeded},
+		{"connection refused", fmt.Errorf("error sending request: %w", &url.Error{Op: "Get", URL: "http://localhost:32400", Err: syscall.ECONNREFUSED}), codes.Unavailable},
+		{"status", status.Error(codes.AlreadyExists, "exists"), codes.AlreadyExists},
+		{"smart collection", &plexgo.SmartCollectionError{CollectionID: 11, Operation: "add"}, codes.FailedPrecondition},
+		{"wrapped smart collection", fmt.Errorf("error syncing: %w", &plexgo.SmartCollectionError{CollectionID: 11, Operation: "remove"}), codes.FailedPrecondition},
+		{"invalid filter", plexgo.NewSmartFilter(plexgo.CollectionItemTypeArtist).SortBy("mediaBitrate", true).Validate(), codes.InvalidArgument},
+		{"invalid argument", fmt.Errorf("error creating collection: %w", plexgo.ErrInvalidArgument), codes.InvalidArgument},
+		{"other", errors.New("error decoding response"), codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		if code := Code(tt.err); code != tt.code {
+			t.Errorf("%s: expected %s, got: %s", tt.name, tt.code, code)
+		}
+	}
+}
+
+func TestError(t *testing.T) {
+	err := Error(sdkerrors.NewSDKError("API error occurred", 404, "", nil))
+
+	s, ok := status.FromError(err)
+	if !ok || s.Code() != codes.NotFound || s.Message() != "API error occurred: Status 404" {
+		t.Errorf("Expected a NotFound status with the error message, got: %v", err)
+	}
+
+	if Error(nil) != nil {
+		t.Errorf("Expected no error for nil")
+	}
+}
@@ -0,0 +1,207 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// defaultHistoryTopN is the number of items of CreateFromHistory if HistoryCriteria.TopN is 0
+const defaultHistoryTopN = 10
+
+// HistoryCriteria selects the items of a collection built from watch history by CreateFromHistory
+type HistoryCriteria struct {
+	TopN   int           // Number of most played items, 10 if 0
+	Window time.Duration // How far back plays are counted, e.g. 30 days for "Most watched this month"; all history if 0
+	Users  []int         // Account IDs whose plays are counted, as in the history's accountID; all accounts if empty
+}
+
+// historyRank is an item ranked by its plays in the watch history
+type historyRank struct {
+	RatingKey    string
+	Plays        int
+	LastViewedAt time.Time
+}
+
+// CreateFromHistory creates a collection of the most played items of a library section, e.g.
+// "Most watched this month", or syncs it if a collection with the title exists: missing items
+// are added, items that dropped out are removed and the rest are put in order, most played first.
+// Plays of episodes count towards their show and plays of tracks towards their album, so the
+// collection holds the section's top-level items. Run it on a schedule to keep the collection current.
+func (s *Collections) CreateFromHistory(ctx context.Context, sectionID int, title string, criteria HistoryCriteria, opts ...operations.Option) (*Collection, error) {
+	if title == "" {
+		return nil, fmt.Errorf("collection title is empty")
+	}
+
+	ranked, err := s.rankHistory(ctx, sectionID, criteria, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(ranked) == 0 {
+		return nil, fmt.Errorf("no plays in section %d match the criteria", sectionID)
+	}
+
+	desired := make([]string, 0, len(ranked))
+	for _, item := range ranked {
+		desired = append(desired, item.RatingKey)
+	}
+
+	existing, err := s.findIdempotentCollection(ctx, sectionID, title, "", opts...)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return s.CreateCollectionWithOptions(ctx, sectionID, title, desired, CreateCollectionOptions{Sort: CollectionSortCustom}, opts...)
+	}
+
+	if err := s.syncItems(ctx, existing, desired, opts...); err != nil {
+		return nil, fmt.Errorf("error syncing %s: %w", title, err)
+	}
+	return existing, nil
+}
+
+// syncItems makes a regular collection hold exactly the desired items, in their order
+func (s *Collections) syncItems(ctx context.Context, collection *Collection, desired []string, opts ...operations.Option) error {
+	collectionID, err := collection.ID()
+	if err != nil {
+		return fmt.Errorf("error converting collection ID to int: %w", err)
+	}
+	if !collection.CanMutateMembers() {
+		return &SmartCollectionError{CollectionID: collectionID, Operation: "add"}
+	}
+
+	current, err := s.GetCollectionItems(ctx, collectionID, opts...)
+	if err != nil {
+		return err
+	}
+
+	add, remove := diffKeys(current, desired)
+	if len(add) > 0 {
+		if err := s.AddToCollection(ctx, collectionID, add, opts...); err != nil {
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		if err := s.RemoveFromCollection(ctx, collectionID, remove, opts...); err != nil {
+			return err
+		}
+	}
+
+	if collection.SortEnum().String() != CollectionSortCustom {
+		if _, err := s.UpdateCollectionSort(ctx, collectionID, CollectionSortCustom, opts...); err != nil {
+			return err
+		}
+	}
+
+	// Added items are appended, so the order is read back before moving items into place
+	if current, err = s.GetCollectionItems(ctx, collectionID, opts...); err != nil {
+		return err
+	}
+	_, err = s.moveIntoOrder(ctx, collectionID, current, desired, "ordering collection", opts...)
+	return err
+}
+
+// rankHistory counts the plays of the top-level items of a section in the watch history and
+// returns the most played, most played first
+func (s *Collections) rankHistory(ctx context.Context, sectionID int, criteria HistoryCriteria, opts ...operations.Option) ([]historyRank, error) {
+	topN := criteria.TopN
+	if topN <= 0 {
+		topN = defaultHistoryTopN
+	}
+
+	var history struct {
+		MediaContainer struct {
+			Metadata []struct {
+				RatingKey      string `json:"ratingKey"`
+				Type           string `json:"type"`
+				ParentKey      string `json:"parentKey"`
+				GrandparentKey string `json:"grandparentKey"`
+				AccountID      int    `json:"accountID"`
+				ViewedAt       int64  `json:"viewedAt"`
+			} `json:"Metadata"`
+		} `json:"MediaContainer"`
+	}
+	historyParams := url.Values{}
+	historyParams.Add("librarySectionID", strconv.Itoa(sectionID))
+	if criteria.Window > 0 {
+		historyParams.Add("viewedAt>", strconv.FormatInt(time.Now().Add(-criteria.Window).Unix(), 10))
+	}
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, "/status/sessions/history/all", historyParams, "getSessionHistory", &history, opts...); err != nil {
+		return nil, fmt.Errorf("error getting watch history: %w", err)
+	}
+
+	users := make(map[int]bool, len(criteria.Users))
+	for _, accountID := range criteria.Users {
+		users[accountID] = true
+	}
+
+	ranks := map[string]*historyRank{}
+	for _, entry := range history.MediaContainer.Metadata {
+		if len(users) > 0 && !users[entry.AccountID] {
+			continue
+		}
+
+		ratingKey := entry.RatingKey
+		switch entry.Type {
+		case "episode":
+			ratingKey = ratingKeyFromKey(entry.GrandparentKey)
+		case "track":
+			ratingKey = ratingKeyFromKey(entry.ParentKey)
+		}
+		if ratingKey == "" {
+			continue
+		}
+
+		rank := ranks[ratingKey]
+		if rank == nil {
+			rank = &historyRank{RatingKey: ratingKey}
+			ranks[ratingKey] = rank
+		}
+		rank.Plays++
+		if viewedAt := time.Unix(entry.ViewedAt, 0); viewedAt.After(rank.LastViewedAt) {
+			rank.LastViewedAt = viewedAt
+		}
+	}
+
+	ranked := make([]historyRank, 0, len(ranks))
+	for _, rank := range ranks {
+		ranked = append(ranked, *rank)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Plays != ranked[j].Plays {
+			return ranked[i].Plays > ranked[j].Plays
+		}
+		if !ranked[i].LastViewedAt.Equal(ranked[j].LastViewedAt) {
+			return ranked[i].LastViewedAt.After(ranked[j].LastViewedAt)
+		}
+		return ranked[i].RatingKey < ranked[j].RatingKey
+	})
+
+	// Items removed from the library since they were played would fail the sync, so they make
+	// way for the next most played
+	ratingKeys := make([]string, 0, len(ranked))
+	for _, rank := range ranked {
+		ratingKeys = append(ratingKeys, rank.RatingKey)
+	}
+	availability, err := newLibrary(s.sdkConfiguration).VerifyRatingKeys(ctx, ratingKeys, opts...)
+	if err != nil {
+		return nil, err
+	}
+	available := make(map[string]bool, len(availability.Available))
+	for _, ratingKey := range availability.Available {
+		available[ratingKey] = true
+	}
+
+	kept := make([]historyRank, 0, topN)
+	for _, rank := range ranked {
+		if available[rank.RatingKey] && len(kept) < topN {
+			kept = append(kept, rank)
+		}
+	}
+	return kept, nil
+}
@@ -0,0 +1,118 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateFromHistory(t *testing.T) {
+	// Show 100 was played three times, show 200 twice and show 300 once, by account 1 except
+	// for the plays of account 2
+	history := `{"MediaContainer":{"size":7,"Metadata":[
+		{"ratingKey":"101","type":"episode","grandparentKey":"/library/metadata/100","accountID":1,"viewedAt":1700000100},
+		{"ratingKey":"102","type":"episode","grandparentKey":"/library/metadata/100","accountID":1,"viewedAt":1700000200},
+		{"ratingKey":"103","type":"episode","grandparentKey":"/library/metadata/100","accountID":1,"viewedAt":1700000300},
+		{"ratingKey":"201","type":"episode","grandparentKey":"/library/metadata/200","accountID":1,"viewedAt":1700000400},
+		{"ratingKey":"202","type":"episode","grandparentKey":"/library/metadata/200","accountID":1,"viewedAt":1700000500},
+		{"ratingKey":"301","type":"episode","grandparentKey":"/library/metadata/300","accountID":1,"viewedAt":1700000600},
+		{"ratingKey":"401","type":"episode","grandparentKey":"/library/metadata/400","accountID":2,"viewedAt":1700000700}
+	]}}`
+
+	exists := false
+	created := ""
+	members := []string{"300", "500"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		path := r.URL.Path
+		switch {
+		case r.Method == "GET" && path == "/status/sessions/history/all":
+			if r.URL.Query().Get("librarySectionID") != "2" || r.URL.Query().Get("viewedAt>") == "" {
+				t.Errorf("Expected the history of section 2 in the window, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(history))
+		case r.Method == "GET" && path == "/library/sections/2/collections":
+			if exists {
+				w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Most Watched","type":"collection"}]}}`))
+			} else {
+				w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+			}
+		case r.Method == "GET" && path == "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Most Watched","type":"collection","subtype":"show","librarySectionID":2,"collectionSort":"0"}]}}`))
+		case r.Method == "GET" && path == "/library/collections/8/children":
+			items := []string{}
+			for _, key := range members {
+				items = append(items, fmt.Sprintf(`{"ratingKey":"%s","type":"show"}`, key))
+			}
+			fmt.Fprintf(w, `{"MediaContainer":{"size":%d,"Metadata":[%s]}}`, len(items), strings.Join(items, ","))
+		case r.Method == "GET" && strings.HasPrefix(path, "/library/metadata/"):
+			items := []string{}
+			for _, key := range strings.Split(strings.TrimPrefix(path, "/library/metadata/"), ",") {
+				items = append(items, fmt.Sprintf(`{"ratingKey":"%s","type":"show"}`, key))
+			}
+			fmt.Fprintf(w, `{"MediaContainer":{"size":%d,"Metadata":[%s]}}`, len(items), strings.Join(items, ","))
+		case r.Method == "GET" && path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "POST" && path == "/library/collections":
+			created = r.URL.Query().Get("uri")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Most Watched","type":"collection","librarySectionID":2}]}}`))
+		case r.Method == "PUT" && path == "/library/collections/8/items":
+			uri := r.URL.Query().Get("uri")
+			members = append(members, strings.Split(uri[strings.LastIndex(uri, "/")+1:], ",")...)
+		case r.Method == "DELETE" && strings.HasPrefix(path, "/library/collections/8/items/"):
+			key := strings.TrimPrefix(path, "/library/collections/8/items/")
+			for i, member := range members {
+				if member == key {
+					members = append(members[:i], members[i+1:]...)
+					break
+				}
+			}
+		case r.Method == "PUT" && strings.HasSuffix(path, "/move"):
+			key := strings.Split(path, "/")[5]
+			after := r.URL.Query().Get("after")
+			moved := []string{}
+			for _, member := range members {
+				if member != key {
+					moved = append(moved, member)
+				}
+			}
+			position := 0
+			for i, member := range moved {
+				if member == after {
+					position = i + 1
+				}
+			}
+			members = append(moved[:position], append([]string{key}, moved[position:]...)...)
+		case r.Method == "PUT" || r.Method == "POST":
+			// Settings of the collection
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+	criteria := HistoryCriteria{TopN: 2, Window: 30 * 24 * time.Hour, Users: []int{1}}
+
+	if _, err := client.Collections.CreateFromHistory(ctx, 2, "Most Watched", criteria, WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.HasSuffix(created, "/library/metadata/100,200") {
+		t.Errorf("Expected the two most watched shows, got: %s", created)
+	}
+
+	// The collection exists now, so it is synced: 300 and 500 make way for 100 and 200
+	exists = true
+	if _, err := client.Collections.CreateFromHistory(ctx, 2, "Most Watched", criteria, WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if fmt.Sprint(members) != "[100 200]" {
+		t.Errorf("Expected the collection to hold the top shows in order, got: %v", members)
+	}
+}
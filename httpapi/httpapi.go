@@ -0,0 +1,663 @@
+// Package httpapi serves the Collections and Playlists services of a PlexAPI client as a small JSON
+// REST API, authenticated with a bearer token, so a management API can be put in front of a server
+// without writing handlers. The Handler is a plain http.Handler to mount in any router.
+package httpapi
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// maxBodySize is the largest request body accepted, in bytes
+const maxBodySize = 1 << 20
+
+// Collection is a collection as returned by the API
+type Collection struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	Summary   string `json:"summary,omitempty"`
+	SectionID int    `json:"sectionId"`
+	Smart     bool   `json:"smart"`
+	ItemCount int    `json:"itemCount"`
+	Mode      string `json:"mode,omitempty"`
+	Sort      string `json:"sort,omitempty"`
+	AddedAt   int64  `json:"addedAt"`
+	UpdatedAt int64  `json:"updatedAt,omitempty"`
+}
+
+// CreateCollectionRequest is the body of a request creating a collection
+type CreateCollectionRequest struct {
+	Title   string   `json:"title"`
+	ItemIDs []string `json:"itemIds"` // Rating keys of the items, which must all be of the same type
+}
+
+// Playlist is a playlist as returned by the API
+type Playlist struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	Summary   string `json:"summary,omitempty"`
+	Type      string `json:"type"` // audio, video or photo
+	Smart     bool   `json:"smart"`
+	ItemCount int    `json:"itemCount"`
+	Duration  int    `json:"duration,omitempty"` // In milliseconds
+	AddedAt   int64  `json:"addedAt"`
+	UpdatedAt int64  `json:"updatedAt,omitempty"`
+}
+
+// CreatePlaylistRequest is the body of a request creating a playlist. A smart playlist is created
+// from a URI; other playlists from a URI or the rating keys of their items.
+type CreatePlaylistRequest struct {
+	Title   string   `json:"title"`
+	Type    string   `json:"type"` // audio, video or photo
+	Smart   bool     `json:"smart,omitempty"`
+	URI     string   `json:"uri,omitempty"`
+	ItemIDs []string `json:"itemIds,omitempty"`
+}
+
+// UpdatePlaylistRequest is the body of a request updating a playlist. Fields that are not given
+// are left unchanged.
+type UpdatePlaylistRequest struct {
+	Title   *string `json:"title,omitempty"`
+	Summary *string `json:"summary,omitempty"`
+}
+
+// PlaylistItem is an item of a playlist
+type PlaylistItem struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+}
+
+// Items is a list of item rating keys, used both to list the items of a collection and to add
+// items to a collection or playlist
+type Items struct {
+	ItemIDs []string `json:"itemIds"`
+}
+
+// Error is the body of an error response
+type Error struct {
+	Error string `json:"error"`
+}
+
+// Option configures a Handler
+type Option func(*Handler)
+
+// WithRequestOptions sets options passed to every call to the SDK, e.g. operations.WithServerURL
+// or operations.WithRetries
+func WithRequestOptions(opts ...operations.Option) Option {
+	return func(h *Handler) {
+		h.requestOpts = append(h.requestOpts, opts...)
+	}
+}
+
+// Handler serves the API. Routes are relative to where the handler is mounted; use
+// http.StripPrefix to mount it under a path:
+//
+//	GET    /sections/{sectionID}/collections     List the collections of a library section
+//	POST   /sections/{sectionID}/collections     Create a collection (CreateCollectionRequest)
+//	GET    /collections/{id}                     Get a collection
+//	DELETE /collections/{id}                     Delete a collection
+//	GET    /collections/{id}/items               List the items of a collection (Items)
+//	POST   /collections/{id}/items               Add items to a collection (Items)
+//	DELETE /collections/{id}/items/{itemID}      Remove an item from a collection
+//	GET    /playlists?type=video&smart=true      List playlists, optionally filtered
+//	POST   /playlists                            Create a playlist (CreatePlaylistRequest)
+//	GET    /playlists/{id}                       Get a playlist
+//	PATCH  /playlists/{id}                       Update a playlist (UpdatePlaylistRequest)
+//	DELETE /playlists/{id}                       Delete a playlist
+//	GET    /playlists/{id}/items?type=4          List the items of a playlist of a metadata type
+//	POST   /playlists/{id}/items                 Add items to a playlist (Items)
+//	DELETE /playlists/{id}/items                 Remove all items from a playlist
+//
+// Every request must have an "Authorization: Bearer {token}" header. Errors are returned as an
+// Error with a 4XX status for invalid requests, missing items and changes to the members of smart
+// collections, or 502 if the server failed.
+type Handler struct {
+	client      *plexgo.PlexAPI
+	token       string
+	requestOpts []operations.Option
+}
+
+// New returns a handler serving the collections and playlists of client to requests authenticated
+// with token. An empty token rejects every request.
+func New(client *plexgo.PlexAPI, token string, opts ...Option) *Handler {
+	h := &Handler{
+		client: client,
+		token:  token,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// ServeHTTP authenticates and routes a request
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="plexgo"`)
+		writeError(w, http.StatusUnauthorized, errors.New("missing or invalid token"))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case len(segments) == 3 && segments[0] == "sections" && segments[2] == "collections":
+		h.route(w, r, map[string]func(){
+			"GET":  func() { h.listCollections(w, r, segments[1]) },
+			"POST": func() { h.createCollection(w, r, segments[1]) },
+		})
+	case len(segments) == 2 && segments[0] == "collections":
+		h.route(w, r, map[string]func(){
+			"GET":    func() { h.getCollection(w, r, segments[1]) },
+			"DELETE": func() { h.deleteCollection(w, r, segments[1]) },
+		})
+	case len(segments) == 3 && segments[0] == "collections" && segments[2] == "items":
+		h.route(w, r, map[string]func(){
+			"GET":  func() { h.getCollectionItems(w, r, segments[1]) },
+			"POST": func() { h.addCollectionItems(w, r, segments[1]) },
+		})
+	case len(segments) == 4 && segments[0] == "collections" && segments[2] == "items":
+		h.route(w, r, map[string]func(){
+			"DELETE": func() { h.removeCollectionItem(w, r, segments[1], segments[3]) },
+		})
+	case len(segments) == 1 && segments[0] == "playlists":
+		h.route(w, r, map[string]func(){
+			"GET":  func() { h.listPlaylists(w, r) },
+			"POST": func() { h.createPlaylist(w, r) },
+		})
+	case len(segments) == 2 && segments[0] == "playlists":
+		h.route(w, r, map[string]func(){
+			"GET":    func() { h.getPlaylist(w, r, segments[1]) },
+			"PATCH":  func() { h.updatePlaylist(w, r, segments[1]) },
+			"DELETE": func() { h.deletePlaylist(w, r, segments[1]) },
+		})
+	case len(segments) == 3 && segments[0] == "playlists" && segments[2] == "items":
+		h.route(w, r, map[string]func(){
+			"GET":    func() { h.getPlaylistItems(w, r, segments[1]) },
+			"POST":   func() { h.addPlaylistItems(w, r, segments[1]) },
+			"DELETE": func() { h.clearPlaylistItems(w, r, segments[1]) },
+		})
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("no route for %s", r.URL.Path))
+	}
+}
+
+// authorized returns true if a request has the bearer token
+func (h *Handler) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || h.token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) == 1
+}
+
+// route calls the handler of the request method, or responds 405 listing the allowed methods
+func (h *Handler) route(w http.ResponseWriter, r *http.Request, methods map[string]func()) {
+	if handle, ok := methods[r.Method]; ok {
+		handle()
+		return
+	}
+
+	allowed := make([]string, 0, len(methods))
+	for _, method := range []string{"GET", "POST", "PATCH", "DELETE"} {
+		if _, ok := methods[method]; ok {
+			allowed = append(allowed, method)
+		}
+	}
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+}
+
+func (h *Handler) listCollections(w http.ResponseWriter, r *http.Request, sectionParam string) {
+	sectionID, ok := parseID(w, "section", sectionParam)
+	if !ok {
+		return
+	}
+
+	collections, err := h.client.Collections.GetAllCollections(r.Context(), sectionID, h.requestOpts...)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	out := make([]Collection, 0, len(collections))
+	for i := range collections {
+		out = append(out, newCollection(&collections[i]))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (h *Handler) createCollection(w http.ResponseWriter, r *http.Request, sectionParam string) {
+	sectionID, ok := parseID(w, "section", sectionParam)
+	if !ok {
+		return
+	}
+
+	var body CreateCollectionRequest
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Title == "" || len(body.ItemIDs) == 0 {
+		writeError(w, http.StatusBadRequest, errors.New("title and itemIds are required"))
+		return
+	}
+
+	collection, err := h.client.Collections.CreateCollection(r.Context(), sectionID, body.Title, body.ItemIDs, h.requestOpts...)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, newCollection(collection))
+}
+
+func (h *Handler) getCollection(w http.ResponseWriter, r *http.Request, idParam string) {
+	collectionID, ok := parseID(w, "collection", idParam)
+	if !ok {
+		return
+	}
+
+	collection, err := h.client.Collections.GetCollection(r.Context(), collectionID, h.requestOpts...)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newCollection(collection))
+}
+
+func (h *Handler) deleteCollection(w http.ResponseWriter, r *http.Request, idParam string) {
+	collectionID, ok := parseID(w, "collection", idParam)
+	if !ok {
+		return
+	}
+
+	if err := h.client.Collections.DeleteCollection(r.Context(), collectionID, h.requestOpts...); err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getCollectionItems(w http.ResponseWriter, r *http.Request, idParam string) {
+	collectionID, ok := parseID(w, "collection", idParam)
+	if !ok {
+		return
+	}
+
+	itemIDs, err := h.client.Collections.GetCollectionItems(r.Context(), collectionID, h.requestOpts...)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	if itemIDs == nil {
+		itemIDs = []string{}
+	}
+	writeJSON(w, http.StatusOK, Items{ItemIDs: itemIDs})
+}
+
+func (h *Handler) addCollectionItems(w http.ResponseWriter, r *http.Request, idParam string) {
+	collectionID, ok := parseID(w, "collection", idParam)
+	if !ok {
+		return
+	}
+
+	var body Items
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if len(body.ItemIDs) == 0 {
+		writeError(w, http.StatusBadRequest, errors.New("itemIds is required"))
+		return
+	}
+
+	if err := h.client.Collections.AddToCollection(r.Context(), collectionID, body.ItemIDs, h.requestOpts...); err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) removeCollectionItem(w http.ResponseWriter, r *http.Request, idParam string, itemID string) {
+	collectionID, ok := parseID(w, "collection", idParam)
+	if !ok {
+		return
+	}
+
+	if err := h.client.Collections.RemoveFromCollection(r.Context(), collectionID, []string{itemID}, h.requestOpts...); err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) listPlaylists(w http.ResponseWriter, r *http.Request) {
+	var playlistType *operations.PlaylistType
+	if value := r.URL.Query().Get("type"); value != "" {
+		playlistType = operations.PlaylistType(value).ToPointer()
+	}
+
+	var smart *operations.QueryParamSmart
+	if value := r.URL.Query().Get("smart"); value != "" {
+		isSmart, err := strconv.ParseBool(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid smart filter %q", value))
+			return
+		}
+		smart = operations.QueryParamSmartZero.ToPointer()
+		if isSmart {
+			smart = operations.QueryParamSmartOne.ToPointer()
+		}
+	}
+
+	res, err := h.client.Playlists.GetPlaylists(r.Context(), playlistType, smart, h.requestOpts...)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	out := []Playlist{}
+	if res.Object != nil && res.Object.MediaContainer != nil {
+		for _, m := range res.Object.MediaContainer.Metadata {
+			out = append(out, newPlaylist(m.RatingKey, m.Title, m.Summary, m.PlaylistType, m.Smart, m.LeafCount, m.Duration, m.AddedAt, m.UpdatedAt))
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (h *Handler) createPlaylist(w http.ResponseWriter, r *http.Request) {
+	var body CreatePlaylistRequest
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Title == "" || body.Type == "" {
+		writeError(w, http.StatusBadRequest, errors.New("title and type are required"))
+		return
+	}
+	if (body.URI == "") == (len(body.ItemIDs) == 0) {
+		writeError(w, http.StatusBadRequest, errors.New("exactly one of uri and itemIds is required"))
+		return
+	}
+
+	uri := body.URI
+	if uri == "" {
+		var err error
+		if uri, err = h.itemsURI(r, body.ItemIDs); err != nil {
+			writeSDKError(w, err)
+			return
+		}
+	}
+
+	request := operations.CreatePlaylistRequest{
+		Title: body.Title,
+		Type:  operations.CreatePlaylistQueryParamType(body.Type),
+		Smart: operations.SmartZero,
+		URI:   uri,
+	}
+	if body.Smart {
+		request.Smart = operations.SmartOne
+	}
+
+	res, err := h.client.Playlists.CreatePlaylist(r.Context(), request, h.requestOpts...)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	if res.Object == nil || res.Object.MediaContainer == nil || len(res.Object.MediaContainer.Metadata) == 0 {
+		writeError(w, http.StatusBadGateway, errors.New("server did not return the created playlist"))
+		return
+	}
+
+	m := res.Object.MediaContainer.Metadata[0]
+	writeJSON(w, http.StatusCreated, newPlaylist(m.RatingKey, m.Title, m.Summary, m.PlaylistType, m.Smart, m.LeafCount, m.Duration, m.AddedAt, m.UpdatedAt))
+}
+
+func (h *Handler) getPlaylist(w http.ResponseWriter, r *http.Request, idParam string) {
+	playlistID, ok := parseID(w, "playlist", idParam)
+	if !ok {
+		return
+	}
+
+	res, err := h.client.Playlists.GetPlaylist(r.Context(), float64(playlistID), h.requestOpts...)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	if res.Object == nil || res.Object.MediaContainer == nil || len(res.Object.MediaContainer.Metadata) == 0 {
+		writeError(w, http.StatusNotFound, fmt.Errorf("playlist %d not found", playlistID))
+		return
+	}
+
+	m := res.Object.MediaContainer.Metadata[0]
+	writeJSON(w, http.StatusOK, newPlaylist(m.RatingKey, m.Title, m.Summary, m.PlaylistType, m.Smart, m.LeafCount, m.Duration, m.AddedAt, m.UpdatedAt))
+}
+
+func (h *Handler) updatePlaylist(w http.ResponseWriter, r *http.Request, idParam string) {
+	playlistID, ok := parseID(w, "playlist", idParam)
+	if !ok {
+		return
+	}
+
+	var body UpdatePlaylistRequest
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	if _, err := h.client.Playlists.UpdatePlaylist(r.Context(), float64(playlistID), body.Title, body.Summary, h.requestOpts...); err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) deletePlaylist(w http.ResponseWriter, r *http.Request, idParam string) {
+	playlistID, ok := parseID(w, "playlist", idParam)
+	if !ok {
+		return
+	}
+
+	if _, err := h.client.Playlists.DeletePlaylist(r.Context(), float64(playlistID), h.requestOpts...); err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getPlaylistItems(w http.ResponseWriter, r *http.Request, idParam string) {
+	playlistID, ok := parseID(w, "playlist", idParam)
+	if !ok {
+		return
+	}
+
+	// Plex filters the contents of a playlist by metadata type, e.g. 1 for movies or 4 for episodes
+	itemType, err := strconv.Atoi(r.URL.Query().Get("type"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("type must be a metadata type, e.g. 1 for movies or 4 for episodes"))
+		return
+	}
+
+	res, err := h.client.Playlists.GetPlaylistContents(r.Context(), float64(playlistID), operations.GetPlaylistContentsQueryParamType(itemType), h.requestOpts...)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	out := []PlaylistItem{}
+	if res.Object != nil && res.Object.MediaContainer != nil {
+		for _, m := range res.Object.MediaContainer.Metadata {
+			out = append(out, PlaylistItem{ID: stringValue(m.RatingKey), Title: stringValue(m.Title), Type: stringValue(m.Type)})
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (h *Handler) addPlaylistItems(w http.ResponseWriter, r *http.Request, idParam string) {
+	playlistID, ok := parseID(w, "playlist", idParam)
+	if !ok {
+		return
+	}
+
+	var body Items
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if len(body.ItemIDs) == 0 {
+		writeError(w, http.StatusBadRequest, errors.New("itemIds is required"))
+		return
+	}
+
+	uri, err := h.itemsURI(r, body.ItemIDs)
+	if err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	if _, err := h.client.Playlists.AddPlaylistContents(r.Context(), float64(playlistID), uri, nil, h.requestOpts...); err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) clearPlaylistItems(w http.ResponseWriter, r *http.Request, idParam string) {
+	playlistID, ok := parseID(w, "playlist", idParam)
+	if !ok {
+		return
+	}
+
+	if _, err := h.client.Playlists.ClearPlaylistContents(r.Context(), float64(playlistID), h.requestOpts...); err != nil {
+		writeSDKError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// itemsURI returns the server:// URI of library items, used to create or add to playlists
+func (h *Handler) itemsURI(r *http.Request, itemIDs []string) (string, error) {
+	identity, err := h.client.Server.GetIdentity(r.Context(), h.requestOpts...)
+	if err != nil {
+		return "", fmt.Errorf("error getting server identity: %w", err)
+	}
+
+	return fmt.Sprintf("server://%s/com.plexapp.plugins.library/library/metadata/%s", identity.MachineIdentifier, strings.Join(itemIDs, ",")), nil
+}
+
+// newCollection returns the API model of a collection
+func newCollection(c *plexgo.Collection) Collection {
+	id, _ := c.ID()
+	return Collection{
+		ID:        id,
+		Title:     c.Title,
+		Summary:   c.Summary,
+		SectionID: c.SectionID,
+		Smart:     c.IsSmartCollection(),
+		ItemCount: c.ChildCount,
+		Mode:      c.CollectionMode,
+		Sort:      c.CollectionSort,
+		AddedAt:   c.AddedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// newPlaylist returns the API model of a playlist from the fields shared by the playlist responses
+func newPlaylist(ratingKey, title, summary, playlistType *string, smart *bool, leafCount, duration, addedAt, updatedAt *int) Playlist {
+	id, _ := strconv.Atoi(stringValue(ratingKey))
+	return Playlist{
+		ID:        id,
+		Title:     stringValue(title),
+		Summary:   stringValue(summary),
+		Type:      stringValue(playlistType),
+		Smart:     smart != nil && *smart,
+		ItemCount: intValue(leafCount),
+		Duration:  intValue(duration),
+		AddedAt:   int64(intValue(addedAt)),
+		UpdatedAt: int64(intValue(updatedAt)),
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// parseID parses an ID from the path, responding 400 if it is not a positive integer
+func parseID(w http.ResponseWriter, name string, value string) (int, bool) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid %s ID %q", name, value))
+		return 0, false
+	}
+	return id, true
+}
+
+// readJSON decodes a request body, responding 400 if it is not valid JSON for v
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return false
+	}
+	return true
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an Error response
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, Error{Error: err.Error()})
+}
+
+// writeSDKError writes the error of an SDK call: 400 for arguments the SDK rejected, 404 if the
+// item was not found on the server, 409 for changes to the members of a smart collection, or 502
+// for any other failure. The details of failures are left out of 502 responses, as they may
+// describe the server.
+func writeSDKError(w http.ResponseWriter, err error) {
+	var sdkErr *sdkerrors.SDKError
+	switch {
+	case errors.Is(err, plexgo.ErrInvalidArgument):
+		writeError(w, http.StatusBadRequest, err)
+	case errors.As(err, &sdkErr) && sdkErr.StatusCode == http.StatusNotFound:
+		writeError(w, http.StatusNotFound, err)
+	case errors.Is(err, plexgo.ErrSmartCollectionReadOnly):
+		writeError(w, http.StatusConflict, err)
+	default:
+		writeError(w, http.StatusBadGateway, errors.New("the Plex server request failed"))
+	}
+}
@@ -0,0 +1,229 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+)
+
+// newTestAPI returns an API server in front of a Plex server serving handler
+func newTestAPI(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	plex := httptest.NewServer(handler)
+	t.Cleanup(plex.Close)
+
+	client := plexgo.New(plexgo.WithServerURL(plex.URL), plexgo.WithSecurity("plex-token"))
+	// Waiting for activities skips the delay after writes
+	api := httptest.NewServer(New(client, "secret", WithRequestOptions(plexgo.WithWaitForActivity(time.Second))))
+	t.Cleanup(api.Close)
+
+	return api
+}
+
+// do sends an authenticated request to the API and decodes the response into out, if given
+func do(t *testing.T, api *httptest.Server, method string, path string, body string, out interface{}) *http.Response {
+	req, err := http.NewRequest(method, api.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Error creating request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			t.Fatalf("Error decoding response: %v", err)
+		}
+	}
+
+	return res
+}
+
+func TestHandlerAuthentication(t *testing.T) {
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to Plex: %s %s", r.Method, r.URL.Path)
+	})
+
+	for _, header := range []string{"", "Bearer wrong", "secret"} {
+		req, _ := http.NewRequest("GET", api.URL+"/playlists", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Error sending request: %v", err)
+		}
+		res.Body.Close()
+
+		if res.StatusCode != http.StatusUnauthorized {
+			t.Errorf("Expected status 401 with Authorization %q, got: %d", header, res.StatusCode)
+		}
+	}
+}
+
+func TestHandlerCollections(t *testing.T) {
+	var deleted bool
+
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Plex-Token") != "plex-token" {
+			t.Errorf("Expected the Plex token, got: %q", r.Header.Get("X-Plex-Token"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"10","title":"Marvel","librarySectionID":1,"childCount":3,"addedAt":1700000000},
+				{"ratingKey":"11","title":"Unwatched","librarySectionID":1,"smart":"1"}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/10":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"10","title":"Marvel","librarySectionID":1,"childCount":3}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/99":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "DELETE" && r.URL.Path == "/library/collections/10":
+			deleted = true
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	var collections []Collection
+	res := do(t, api, "GET", "/sections/1/collections", "", &collections)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got: %d", res.StatusCode)
+	}
+	if len(collections) != 2 || collections[0].ID != 10 || collections[0].ItemCount != 3 || collections[0].Smart || !collections[1].Smart {
+		t.Errorf("Unexpected collections: %+v", collections)
+	}
+
+	var collection Collection
+	if res := do(t, api, "GET", "/collections/10", "", &collection); res.StatusCode != http.StatusOK || collection.Title != "Marvel" {
+		t.Errorf("Expected the collection, got status %d: %+v", res.StatusCode, collection)
+	}
+
+	var apiErr Error
+	if res := do(t, api, "GET", "/collections/99", "", &apiErr); res.StatusCode != http.StatusNotFound || apiErr.Error == "" {
+		t.Errorf("Expected status 404 with an error, got status %d: %+v", res.StatusCode, apiErr)
+	}
+
+	if res := do(t, api, "DELETE", "/collections/10", "", nil); res.StatusCode != http.StatusNoContent || !deleted {
+		t.Errorf("Expected the collection to be deleted, got status: %d", res.StatusCode)
+	}
+}
+
+func TestHandlerPlaylists(t *testing.T) {
+	var createdURI string
+
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "GET" && r.URL.Path == "/playlists":
+			if r.URL.Query().Get("playlistType") != "video" || r.URL.Query().Get("smart") != "1" {
+				t.Errorf("Expected the type and smart filters, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"20","title":"Favorites","playlistType":"video","smart":true,"leafCount":5}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/playlists":
+			createdURI = r.URL.Query().Get("uri")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"21","title":"Road Trip","playlistType":"audio","leafCount":2}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	var playlists []Playlist
+	if res := do(t, api, "GET", "/playlists?type=video&smart=true", "", &playlists); res.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got: %d", res.StatusCode)
+	}
+	if len(playlists) != 1 || playlists[0].ID != 20 || !playlists[0].Smart || playlists[0].ItemCount != 5 {
+		t.Errorf("Unexpected playlists: %+v", playlists)
+	}
+
+	var playlist Playlist
+	res := do(t, api, "POST", "/playlists", `{"title":"Road Trip","type":"audio","itemIds":["1","2"]}`, &playlist)
+	if res.StatusCode != http.StatusCreated || playlist.ID != 21 {
+		t.Errorf("Expected the created playlist, got status %d: %+v", res.StatusCode, playlist)
+	}
+	if createdURI != "server://abc123/com.plexapp.plugins.library/library/metadata/1,2" {
+		t.Errorf("Unexpected playlist URI: %s", createdURI)
+	}
+}
+
+func TestHandlerInvalidRequests(t *testing.T) {
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to Plex: %s %s", r.Method, r.URL.Path)
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{"GET", "/collections/abc", "", http.StatusBadRequest},
+		{"POST", "/sections/1/collections", `{"title":"Empty"}`, http.StatusBadRequest},
+		{"POST", "/sections/1/collections", `{"name":"Marvel"}`, http.StatusBadRequest},
+		{"POST", "/playlists", `{"title":"Both","type":"video","uri":"server://x","itemIds":["1"]}`, http.StatusBadRequest},
+		{"GET", "/playlists/20/items", "", http.StatusBadRequest},
+		{"PUT", "/collections/10", "", http.StatusMethodNotAllowed},
+		{"GET", "/libraries", "", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		var apiErr Error
+		res := do(t, api, tt.method, tt.path, tt.body, &apiErr)
+		if res.StatusCode != tt.status || apiErr.Error == "" {
+			t.Errorf("%s %s: expected status %d with an error, got status %d: %+v", tt.method, tt.path, tt.status, res.StatusCode, apiErr)
+		}
+	}
+}
+
+func TestHandlerSDKErrors(t *testing.T) {
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/1,2":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[{"ratingKey":"1","type":"movie"},{"ratingKey":"2","type":"episode"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/11":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"11","title":"Unwatched","librarySectionID":1,"smart":"1"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/12":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":"database is locked: /var/lib/plexmediaserver/com.plexapp.plugins.library.db"}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{"mixed item types", "POST", "/sections/1/collections", `{"title":"Mixed","itemIds":["1","2"]}`, http.StatusBadRequest},
+		{"smart collection members", "POST", "/collections/11/items", `{"itemIds":["1"]}`, http.StatusConflict},
+		{"server failure", "GET", "/collections/12", "", http.StatusBadGateway},
+	}
+
+	for _, tt := range tests {
+		var apiErr Error
+		res := do(t, api, tt.method, tt.path, tt.body, &apiErr)
+		if res.StatusCode != tt.status || apiErr.Error == "" {
+			t.Errorf("%s: expected status %d with an error, got status %d: %+v", tt.name, tt.status, res.StatusCode, apiErr)
+		}
+		if res.StatusCode >= 500 && (strings.Contains(apiErr.Error, "database") || strings.Contains(apiErr.Error, "127.0.0.1")) {
+			t.Errorf("%s: expected the details of the failure to be left out, got: %s", tt.name, apiErr.Error)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// HubIdentifier is the identifier Plex assigns to a hub (e.g. "home.continue")
+type HubIdentifier string
+
+// Well-known hub identifiers for the home screen and library recommended rows
+const (
+	HubIdentifierContinueWatching      HubIdentifier = "home.continue"
+	HubIdentifierOnDeck                HubIdentifier = "home.ondeck"
+	HubIdentifierRecentlyAddedMovies   HubIdentifier = "home.movies.recent"
+	HubIdentifierRecentlyAddedShows    HubIdentifier = "home.television.recent"
+	HubIdentifierRecentlyAddedMusic    HubIdentifier = "home.music.recent"
+	HubIdentifierRecentlyAddedPhotos   HubIdentifier = "home.photos.recent"
+	HubIdentifierRecentPlaylists       HubIdentifier = "home.playlists"
+	HubIdentifierRecentlyAddedVideos   HubIdentifier = "home.videos.recent"
+	HubIdentifierMovieRecentlyAdded    HubIdentifier = "movie.recentlyadded"
+	HubIdentifierMovieInProgress       HubIdentifier = "movie.inprogress"
+	HubIdentifierMovieRecentlyReleased HubIdentifier = "movie.recentlyreleased"
+	HubIdentifierMovieRecentlyViewed   HubIdentifier = "movie.recentlyviewed"
+	HubIdentifierMovieTopUnwatched     HubIdentifier = "movie.topunwatched"
+	HubIdentifierMovieByActorDirector  HubIdentifier = "movie.by.actor.or.director"
+	HubIdentifierTVOnDeck              HubIdentifier = "tv.ondeck"
+	HubIdentifierTVRecentlyAdded       HubIdentifier = "tv.recentlyadded"
+	HubIdentifierTVInProgress          HubIdentifier = "tv.inprogress"
+	HubIdentifierTVRecentlyAired       HubIdentifier = "tv.recentlyaired"
+	HubIdentifierTVStartWatching       HubIdentifier = "tv.startwatching"
+	HubIdentifierTVRediscover          HubIdentifier = "tv.rediscover"
+	HubIdentifierMusicRecentlyAdded    HubIdentifier = "music.recent.added"
+	HubIdentifierMusicRecentlyPlayed   HubIdentifier = "music.recent.played"
+)
+
+// collectionHubPrefix is the identifier prefix of hubs created by promoting a collection
+const collectionHubPrefix = "custom.collection."
+
+// CollectionHubIdentifier returns the identifier of the hub Plex creates when a collection is
+// promoted to the library recommended or home screen rows
+func CollectionHubIdentifier(sectionID int, collectionID int) HubIdentifier {
+	return HubIdentifier(fmt.Sprintf("%s%d.%d", collectionHubPrefix, sectionID, collectionID))
+}
+
+// IsCollectionHub returns true if the identifier belongs to a promoted collection hub
+func (h HubIdentifier) IsCollectionHub() bool {
+	return strings.HasPrefix(string(h), collectionHubPrefix)
+}
+
+// String returns the identifier as a string
+func (h HubIdentifier) String() string {
+	return string(h)
+}
+
+// HubContext is the context Plex assigns to a hub, describing where it is displayed
+type HubContext string
+
+// Well-known hub contexts
+const (
+	HubContextHomeContinue       HubContext = "hub.home.continue"
+	HubContextHomeOnDeck         HubContext = "hub.home.ondeck"
+	HubContextHomeRecent         HubContext = "hub.home.recent"
+	HubContextMovieRecentlyAdded HubContext = "hub.movie.recentlyadded"
+	HubContextTVRecentlyAdded    HubContext = "hub.tv.recentlyadded"
+	HubContextCustomCollection   HubContext = "hub.custom.collection"
+	HubContextMusicRecentlyAdded HubContext = "hub.music.recent.added"
+	HubContextLibraryRecommends  HubContext = "hub.library.recommended"
+)
+
+// String returns the context as a string
+func (h HubContext) String() string {
+	return string(h)
+}
+
+// GetGlobalHub gets a single global hub by its identifier
+func (s *Hubs) GetGlobalHub(ctx context.Context, identifier HubIdentifier, opts ...operations.Option) (*operations.Hub, error) {
+	res, err := s.GetGlobalHubs(ctx, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Object == nil || res.Object.MediaContainer == nil {
+		return nil, fmt.Errorf("hub %s not found", identifier)
+	}
+
+	for i, hub := range res.Object.MediaContainer.Hub {
+		if hub.HubIdentifier != nil && *hub.HubIdentifier == string(identifier) {
+			return &res.Object.MediaContainer.Hub[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("hub %s not found", identifier)
+}
+
+// GetLibraryHub gets a single hub of a library section by its identifier
+func (s *Hubs) GetLibraryHub(ctx context.Context, sectionID int, identifier HubIdentifier, opts ...operations.Option) (*operations.GetLibraryHubsHub, error) {
+	res, err := s.GetLibraryHubs(ctx, float64(sectionID), nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Object == nil || res.Object.MediaContainer == nil {
+		return nil, fmt.Errorf("hub %s not found in section %d", identifier, sectionID)
+	}
+
+	for i, hub := range res.Object.MediaContainer.Hub {
+		if hub.HubIdentifier != nil && *hub.HubIdentifier == string(identifier) {
+			return &res.Object.MediaContainer.Hub[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("hub %s not found in section %d", identifier, sectionID)
+}
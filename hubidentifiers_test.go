@@ -0,0 +1,42 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetLibraryHub(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/hubs/sections/1" || r.Method != "GET" {
+			t.Errorf("Expected GET /hubs/sections/1, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":2,"Hub":[
+			{"title":"Recently Added Movies","hubIdentifier":"movie.recentlyadded","context":"hub.movie.recentlyadded"},
+			{"title":"Marvel","hubIdentifier":"custom.collection.1.42","context":"hub.custom.collection"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	hub, err := client.Hubs.GetLibraryHub(context.Background(), 1, CollectionHubIdentifier(1, 42))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if hub.Title == nil || *hub.Title != "Marvel" {
+		t.Errorf("Expected hub title 'Marvel', got: %v", hub.Title)
+	}
+
+	if hub.Context == nil || HubContext(*hub.Context) != HubContextCustomCollection {
+		t.Errorf("Expected hub context %s, got: %v", HubContextCustomCollection, hub.Context)
+	}
+
+	if _, err := client.Hubs.GetLibraryHub(context.Background(), 1, HubIdentifierTVOnDeck); err == nil {
+		t.Error("Expected an error for a missing hub, got nil")
+	}
+}
@@ -0,0 +1,159 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// hubLayoutVersion is the version of the hub layout format
+const hubLayoutVersion = 1
+
+// HubLayout is the home screen layout of the library sections as exported by ExportLayout: the
+// order and visibility of each section's recommendation rows, including promoted collections.
+// RestoreLayout puts it back.
+type HubLayout struct {
+	Version    int                `json:"version"`
+	ExportedAt time.Time          `json:"exportedAt"`
+	Sections   []SectionHubLayout `json:"sections"`
+}
+
+// SectionHubLayout is the layout of the recommendation rows of a library section
+type SectionHubLayout struct {
+	SectionID int              `json:"sectionId"`
+	Title     string           `json:"title,omitempty"`
+	Hubs      []HubLayoutEntry `json:"hubs"` // In display order
+}
+
+// HubLayoutEntry is a recommendation row of a HubLayout
+type HubLayoutEntry struct {
+	Identifier HubIdentifier        `json:"identifier"`
+	Title      string               `json:"title,omitempty"`
+	Visibility CollectionVisibility `json:"visibility"`
+}
+
+// ExportLayout writes the home screen layout of every library section as JSON to w: the order of
+// the recommendation rows of each section and where each is shown, including the collections
+// promoted to them. RestoreLayout puts the layout back, so experiments with the home screen, e.g.
+// a FeaturedRotation, can be undone.
+func (s *Hubs) ExportLayout(ctx context.Context, w io.Writer, opts ...operations.Option) (*HubLayout, error) {
+	var out struct {
+		MediaContainer struct {
+			Directory []librarySection `json:"Directory"`
+		} `json:"MediaContainer"`
+	}
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, "/library/sections", nil, "getAllLibraries", &out, opts...); err != nil {
+		return nil, fmt.Errorf("error getting library sections: %w", err)
+	}
+
+	layout := &HubLayout{Version: hubLayoutVersion, ExportedAt: time.Now().UTC(), Sections: []SectionHubLayout{}}
+	for _, section := range out.MediaContainer.Directory {
+		sectionID, err := strconv.Atoi(section.Key)
+		if err != nil {
+			continue
+		}
+
+		hubs, err := s.GetManagedHubs(ctx, sectionID, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		sectionLayout := SectionHubLayout{SectionID: sectionID, Title: section.Title, Hubs: []HubLayoutEntry{}}
+		for _, hub := range hubs {
+			sectionLayout.Hubs = append(sectionLayout.Hubs, HubLayoutEntry{Identifier: hub.Identifier, Title: hub.Title, Visibility: hub.Visibility})
+		}
+		layout.Sections = append(layout.Sections, sectionLayout)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(layout); err != nil {
+		return nil, fmt.Errorf("error writing hub layout: %w", err)
+	}
+	return layout, nil
+}
+
+// RestoreLayout puts back a home screen layout written by ExportLayout. In each section of the
+// layout, the rows get the visibility they had, collections promoted since the export are hidden
+// again, and the rows are moved into the exported order. Rows that no longer exist, e.g. of
+// deleted collections, are skipped, and sections missing from the layout are left alone. Only
+// rows whose visibility or position changed are updated, so restoring an unchanged layout makes
+// no changes.
+func (s *Hubs) RestoreLayout(ctx context.Context, r io.Reader, opts ...operations.Option) error {
+	ctx = withOperationCorrelationID(ctx)
+
+	var layout HubLayout
+	if err := json.NewDecoder(r).Decode(&layout); err != nil {
+		return fmt.Errorf("error reading hub layout: %w", err)
+	}
+	if layout.Version != hubLayoutVersion {
+		return fmt.Errorf("unsupported hub layout version %d", layout.Version)
+	}
+
+	for _, section := range layout.Sections {
+		if err := s.restoreSectionLayout(ctx, section, opts...); err != nil {
+			return fmt.Errorf("error restoring the hubs of section %d: %w", section.SectionID, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreSectionLayout restores the visibility and order of the rows of a section
+func (s *Hubs) restoreSectionLayout(ctx context.Context, layout SectionHubLayout, opts ...operations.Option) error {
+	hubs, err := s.GetManagedHubs(ctx, layout.SectionID, opts...)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[HubIdentifier]ManagedHub, len(hubs))
+	for _, hub := range hubs {
+		current[hub.Identifier] = hub
+	}
+
+	order := []HubIdentifier{}
+	exported := map[HubIdentifier]bool{}
+	for _, entry := range layout.Hubs {
+		exported[entry.Identifier] = true
+
+		hub, ok := current[entry.Identifier]
+		if !ok {
+			// Collections that are no longer promoted are promoted again; other rows are gone
+			if _, isCollection := (ManagedHub{Identifier: entry.Identifier}).CollectionID(); !isCollection {
+				continue
+			}
+		} else if hub.Visibility == entry.Visibility {
+			order = append(order, entry.Identifier)
+			continue
+		}
+
+		if err := s.UpdateHubVisibility(ctx, layout.SectionID, entry.Identifier, entry.Visibility, opts...); err != nil {
+			// A collection that was deleted since the export can't be promoted
+			var sdkErr *sdkerrors.SDKError
+			if !ok && errors.As(err, &sdkErr) && sdkErr.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("error updating hub %s: %w", entry.Identifier, err)
+		}
+		order = append(order, entry.Identifier)
+	}
+
+	for _, hub := range hubs {
+		if _, isCollection := hub.CollectionID(); !isCollection || exported[hub.Identifier] || hub.Visibility == (CollectionVisibility{}) {
+			continue
+		}
+		if err := s.UpdateHubVisibility(ctx, layout.SectionID, hub.Identifier, CollectionVisibility{}, opts...); err != nil {
+			return fmt.Errorf("error hiding hub %s: %w", hub.Identifier, err)
+		}
+	}
+
+	return s.ReorderHubs(ctx, layout.SectionID, order, opts...)
+}
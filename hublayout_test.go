@@ -0,0 +1,84 @@
+package plexgo
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHubLayout(t *testing.T) {
+	exported := `{"MediaContainer":{"size":2,"Hub":[
+		{"identifier":"custom.collection.1.42","title":"Marvel","promotedToRecommended":true,"promotedToOwnHome":false,"promotedToSharedHome":false},
+		{"identifier":"movie.recentlyadded","title":"Recently Added Movies","promotedToRecommended":true,"promotedToOwnHome":true,"promotedToSharedHome":true}
+	]}}`
+	// Since the export, Marvel was featured on the home screen, moved down and Pixar was promoted
+	changed := `{"MediaContainer":{"size":3,"Hub":[
+		{"identifier":"movie.recentlyadded","title":"Recently Added Movies","promotedToRecommended":true,"promotedToOwnHome":true,"promotedToSharedHome":true},
+		{"identifier":"custom.collection.1.42","title":"Marvel","promotedToRecommended":true,"promotedToOwnHome":true,"promotedToSharedHome":false},
+		{"identifier":"custom.collection.1.43","title":"Pixar","promotedToRecommended":false,"promotedToOwnHome":true,"promotedToSharedHome":false}
+	]}}`
+
+	hubs := exported
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"key":"1","type":"movie","title":"Movies"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/hubs/sections/1/manage":
+			w.Write([]byte(hubs))
+		case r.Method == "POST" && r.URL.Path == "/hubs/sections/1/manage":
+			requests = append(requests, "visibility "+r.URL.Query().Get("metadataItemId")+" "+r.URL.Query().Get("promotedToOwnHome"))
+		case r.Method == "PUT" && r.URL.Path == "/hubs/sections/1/manage/move":
+			requests = append(requests, "move "+r.URL.Query().Get("identifier")+" after "+r.URL.Query().Get("after"))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	layout, err := client.Hubs.ExportLayout(ctx, &buf)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(layout.Sections) != 1 || layout.Sections[0].Title != "Movies" || len(layout.Sections[0].Hubs) != 2 {
+		t.Fatalf("Unexpected layout: %+v", layout)
+	}
+	if layout.Sections[0].Hubs[0].Identifier != CollectionHubIdentifier(1, 42) || !layout.Sections[0].Hubs[0].Visibility.Library {
+		t.Errorf("Unexpected first hub: %+v", layout.Sections[0].Hubs[0])
+	}
+
+	// Restoring an unchanged layout changes nothing
+	if err := client.Hubs.RestoreLayout(ctx, bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(requests) != 0 {
+		t.Errorf("Expected no changes, got: %v", requests)
+	}
+
+	hubs = changed
+	if err := client.Hubs.RestoreLayout(ctx, bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := []string{
+		"visibility 42 0",
+		"visibility 43 0",
+		"move custom.collection.1.42 after ",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected requests:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(requests, "\n"))
+	}
+
+	if err := client.Hubs.RestoreLayout(ctx, strings.NewReader(`{"version":2}`)); err == nil {
+		t.Error("Expected an error for an unsupported version")
+	}
+}
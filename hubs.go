@@ -65,10 +65,7 @@ func (s *Hubs) GetGlobalHubs(ctx context.Context, count *float64, onlyTransient
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -95,13 +92,7 @@ func (s *Hubs) GetGlobalHubs(ctx context.Context, count *float64, onlyTransient
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -200,7 +191,7 @@ func (s *Hubs) GetGlobalHubs(ctx context.Context, count *float64, onlyTransient
 			}
 
 			var out operations.GetGlobalHubsResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -261,13 +252,13 @@ func (s *Hubs) GetGlobalHubs(ctx context.Context, count *float64, onlyTransient
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -314,10 +305,7 @@ func (s *Hubs) GetRecentlyAdded(ctx context.Context, request operations.GetRecen
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -344,13 +332,7 @@ func (s *Hubs) GetRecentlyAdded(ctx context.Context, request operations.GetRecen
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -449,7 +431,7 @@ func (s *Hubs) GetRecentlyAdded(ctx context.Context, request operations.GetRecen
 			}
 
 			var out operations.GetRecentlyAddedResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -470,13 +452,13 @@ func (s *Hubs) GetRecentlyAdded(ctx context.Context, request operations.GetRecen
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -529,10 +511,7 @@ func (s *Hubs) GetLibraryHubs(ctx context.Context, sectionID float64, count *flo
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -559,13 +538,7 @@ func (s *Hubs) GetLibraryHubs(ctx context.Context, sectionID float64, count *flo
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -664,7 +637,7 @@ func (s *Hubs) GetLibraryHubs(ctx context.Context, sectionID float64, count *flo
 			}
 
 			var out operations.GetLibraryHubsResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -725,13 +698,13 @@ func (s *Hubs) GetLibraryHubs(ctx context.Context, sectionID float64, count *flo
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
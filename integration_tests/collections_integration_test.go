@@ -19,19 +19,19 @@ import (
 func getClient() *plexgo.PlexAPI {
 	// Load environment variables from .env file
 	_ = internal.LoadEnv() // Ignore error, we'll check for env vars next
-	
+
 	client, err := internal.GetPlexClient()
 	if err != nil {
 		return nil
 	}
-	
+
 	return client
 }
 
 func getSectionID() int {
 	// Load environment variables from .env file
 	_ = internal.LoadEnv() // Ignore error, we'll check for env vars next
-	
+
 	sectionID, err := internal.GetSectionID()
 	if err != nil {
 		return 1 // Default to section ID 1
@@ -134,7 +134,7 @@ func TestIntegration_CollectionMode(t *testing.T) {
 	fmt.Sscanf(collection.RatingKey, "%d", &collectionID)
 
 	// Test updating the collection mode
-	err = client.Collections.UpdateCollectionMode(ctx, collectionID, plexgo.CollectionModeShowItems)
+	_, err = client.Collections.UpdateCollectionMode(ctx, collectionID, plexgo.CollectionModeShowItems)
 	if err != nil {
 		t.Fatalf("Error updating collection mode: %v", err)
 	}
@@ -148,4 +148,4 @@ func TestIntegration_CollectionMode(t *testing.T) {
 	}
 }
 
-// Add more integration tests as needed...
\ No newline at end of file
+// Add more integration tests as needed...
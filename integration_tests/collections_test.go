@@ -57,7 +57,7 @@ func TestCollections_Integration(t *testing.T) {
 			t.Fatalf("Failed to parse collection ID: %v", err)
 		}
 
-		t.Logf("Created collection: %s (ID: %s) with %d items", 
+		t.Logf("Created collection: %s (ID: %s) with %d items",
 			collection.Title, collection.RatingKey, collection.ChildCount)
 
 		// Ensure cleanup happens
@@ -117,7 +117,7 @@ func TestCollections_Integration(t *testing.T) {
 			// or the items might not be properly added through the API
 			// This is a limitation of the Plex API itself
 			t.Logf("Collection has %d items: %v", len(items), items)
-			
+
 			// First try to add items explicitly to make sure they're in the collection
 			err = client.Collections.AddToCollection(ctx, collectionID, mediaIDs)
 			if err != nil {
@@ -127,7 +127,7 @@ func TestCollections_Integration(t *testing.T) {
 
 		// Test case: Update collection mode
 		t.Run("UpdateCollectionMode", func(t *testing.T) {
-			err := client.Collections.UpdateCollectionMode(ctx, collectionID, plexgo.CollectionModeShowItems)
+			_, err := client.Collections.UpdateCollectionMode(ctx, collectionID, plexgo.CollectionModeShowItems)
 			if err != nil {
 				t.Fatalf("Failed to update collection mode: %v", err)
 			}
@@ -144,7 +144,7 @@ func TestCollections_Integration(t *testing.T) {
 
 		// Test case: Update collection sort
 		t.Run("UpdateCollectionSort", func(t *testing.T) {
-			err := client.Collections.UpdateCollectionSort(ctx, collectionID, plexgo.CollectionSortAlpha)
+			_, err := client.Collections.UpdateCollectionSort(ctx, collectionID, plexgo.CollectionSortAlpha)
 			if err != nil {
 				t.Fatalf("Failed to update collection sort: %v", err)
 			}
@@ -268,7 +268,7 @@ func TestCollections_Integration(t *testing.T) {
 
 			// Use the first media ID for testing
 			itemToRemove := []string{mediaIDs[0]}
-			
+
 			err := client.Collections.RemoveFromCollection(ctx, collectionID, itemToRemove)
 			if err != nil {
 				t.Fatalf("Failed to remove item from collection: %v", err)
@@ -281,7 +281,7 @@ func TestCollections_Integration(t *testing.T) {
 			}
 
 			t.Logf("Collection now has %d items", len(items))
-			
+
 			// Check if the item was actually removed
 			found := false
 			for _, item := range items {
@@ -290,42 +290,42 @@ func TestCollections_Integration(t *testing.T) {
 					break
 				}
 			}
-			
+
 			if found {
 				t.Logf("Warning: Removed item still found in collection, removal might be delayed")
 			}
 		})
-		
+
 		// Test case: Move item in collection (only run if we have at least 2 items)
 		t.Run("MoveCollectionItem", func(t *testing.T) {
 			// Skip if we don't have enough test media items
 			if len(mediaIDs) < 2 {
 				t.Skip("Not enough test media IDs to test moving items in collection")
 			}
-			
+
 			// First ensure we have at least 2 items in the collection
 			err := client.Collections.AddToCollection(ctx, collectionID, mediaIDs[:2])
 			if err != nil {
 				t.Fatalf("Failed to add items to collection for move test: %v", err)
 			}
-			
+
 			// Get items to verify we have at least 2
 			items, err := client.Collections.GetCollectionItems(ctx, collectionID)
 			if err != nil {
 				t.Fatalf("Failed to get collection items: %v", err)
 			}
-			
+
 			if len(items) < 2 {
 				t.Skip("Not enough items in collection to test moving")
 			}
-			
+
 			// Move the second item to be after the first item
 			// This may not visibly change anything, but it tests the API call
 			err = client.Collections.MoveCollectionItem(ctx, collectionID, items[1], items[0])
 			if err != nil {
 				t.Fatalf("Failed to move item in collection: %v", err)
 			}
-			
+
 			t.Logf("Successfully tested moving item in collection")
 		})
 	})
@@ -335,15 +335,15 @@ func TestCollections_Integration(t *testing.T) {
 		// Create test smart collection
 		timestamp := time.Now().Unix()
 		smartCollectionName := fmt.Sprintf("Smart Test Collection %d", timestamp)
-		
+
 		// Create a simple filter for action movies
 		filterArgs := "?type=1"
 
 		t.Logf("Creating smart collection: %s", smartCollectionName)
 		collection, err := client.Collections.CreateSmartCollection(
-			ctx, 
-			sectionID, 
-			smartCollectionName, 
+			ctx,
+			sectionID,
+			smartCollectionName,
 			1, // Type 1 is for movies
 			filterArgs,
 		)
@@ -357,7 +357,7 @@ func TestCollections_Integration(t *testing.T) {
 			t.Fatalf("Failed to parse collection ID: %v", err)
 		}
 
-		t.Logf("Created smart collection: %s (ID: %s)", 
+		t.Logf("Created smart collection: %s (ID: %s)",
 			collection.Title, collection.RatingKey)
 
 		// Ensure cleanup happens
@@ -392,7 +392,7 @@ func TestCollections_Integration(t *testing.T) {
 			}
 			ip := os.Getenv("PLEX_SERVER_IP")
 			port := os.Getenv("PLEX_SERVER_PORT")
-			
+
 			newFilterURI := fmt.Sprintf("%s://%s:%s/library/sections/%d/all?genre=action&year>=2020",
 				protocol,
 				ip,
@@ -414,4 +414,4 @@ func TestCollections_Integration(t *testing.T) {
 			t.Logf("Updated smart collection now has %d items", updatedCollection.ChildCount)
 		})
 	})
-}
\ No newline at end of file
+}
@@ -91,4 +91,29 @@ func GetTestMediaIDs() ([]string, error) {
 	}
 
 	return strings.Split(mediaIDs, ","), nil
-}
\ No newline at end of file
+}
+
+// GetMusicSectionID returns the music library section ID from environment variables
+func GetMusicSectionID() (int, error) {
+	sectionID := os.Getenv("PLEX_MUSIC_SECTION_ID")
+	if sectionID == "" {
+		return 0, fmt.Errorf("PLEX_MUSIC_SECTION_ID environment variable is not set")
+	}
+
+	id, err := strconv.Atoi(sectionID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid PLEX_MUSIC_SECTION_ID: %s - %w", sectionID, err)
+	}
+
+	return id, nil
+}
+
+// GetTestAlbumIDs returns a slice of album IDs in the music section from environment variables
+func GetTestAlbumIDs() ([]string, error) {
+	albumIDs := os.Getenv("PLEX_TEST_ALBUM_IDS")
+	if albumIDs == "" {
+		return nil, fmt.Errorf("PLEX_TEST_ALBUM_IDS environment variable is not set")
+	}
+
+	return strings.Split(albumIDs, ","), nil
+}
@@ -0,0 +1,106 @@
+package integration_tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/integration_tests/internal"
+)
+
+// getMusicSection returns the client, music section and album IDs for the music collection
+// tests, skipping the test when they are not configured
+func getMusicSection(t *testing.T) (*plexgo.PlexAPI, int, []string) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	client := getClient()
+	if client == nil {
+		t.Skip("Skipping test; PLEX_TOKEN, PLEX_SERVER_IP, or PLEX_SERVER_PORT not set")
+	}
+
+	sectionID, err := internal.GetMusicSectionID()
+	if err != nil {
+		t.Skipf("Skipping test; %v", err)
+	}
+	albumIDs, err := internal.GetTestAlbumIDs()
+	if err != nil {
+		t.Skipf("Skipping test; %v", err)
+	}
+
+	return client, sectionID, albumIDs
+}
+
+func TestIntegration_AlbumCollection(t *testing.T) {
+	client, sectionID, albumIDs := getMusicSection(t)
+	ctx := context.Background()
+
+	collection, err := client.Collections.CreateCollection(ctx, sectionID, "Test Album Collection", albumIDs)
+	if err != nil {
+		t.Fatalf("Error creating album collection: %v", err)
+	}
+
+	collectionID, _ := strconv.Atoi(collection.RatingKey)
+	defer func() {
+		if err := client.Collections.DeleteCollection(ctx, collectionID); err != nil {
+			t.Errorf("Error deleting collection: %v", err)
+		}
+	}()
+
+	if collection.SubType != "album" {
+		t.Errorf("Expected an album collection, got subtype %q", collection.SubType)
+	}
+
+	items, err := client.Collections.GetCollectionItems(ctx, collectionID)
+	if err != nil {
+		t.Fatalf("Error getting collection items: %v", err)
+	}
+	if len(items) != len(albumIDs) {
+		t.Errorf("Expected %d albums in the collection, got %d", len(albumIDs), len(items))
+	}
+}
+
+func TestIntegration_MusicSmartCollection(t *testing.T) {
+	client, sectionID, albumIDs := getMusicSection(t)
+	ctx := context.Background()
+
+	albumID, err := strconv.Atoi(albumIDs[0])
+	if err != nil {
+		t.Fatalf("Invalid album ID %q: %v", albumIDs[0], err)
+	}
+	album, err := client.Library.GetItem(ctx, albumID)
+	if err != nil {
+		t.Fatalf("Error getting album: %v", err)
+	}
+
+	// Filter on the album's own tags, so the filter is known to match it
+	filter := plexgo.NewSmartFilter(plexgo.CollectionItemTypeAlbum)
+	if len(album.Genre) > 0 {
+		filter.Add(plexgo.InGenre(plexgo.CollectionItemTypeAlbum, album.Genre[0].ID))
+	}
+	if album.Year > 0 {
+		filter.Add(plexgo.InDecade(plexgo.CollectionItemTypeAlbum, album.Year))
+	}
+	if len(album.Mood) > 0 {
+		filter.Add(plexgo.HasMood(album.Mood[0].ID))
+	}
+
+	collection, err := client.Collections.CreateSmartCollectionFromFilter(ctx, sectionID, "Test Music Smart Collection", filter)
+	if err != nil {
+		t.Fatalf("Error creating smart collection %s: %v", filter, err)
+	}
+
+	collectionID, _ := strconv.Atoi(collection.RatingKey)
+	defer func() {
+		if err := client.Collections.DeleteCollection(ctx, collectionID); err != nil {
+			t.Errorf("Error deleting collection: %v", err)
+		}
+	}()
+
+	t.Logf("Created smart collection %s (ID: %s) with filter %s", collection.Title, collection.RatingKey, filter)
+	if !collection.IsSmartCollection() || collection.SubType != "album" {
+		t.Errorf("Expected a smart album collection, got: %+v", collection)
+	}
+}
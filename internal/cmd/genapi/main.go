@@ -0,0 +1,551 @@
+// Command genapi generates the interfaces of the SDK's services, such as CollectionsAPI for
+// Collections, and moq-style mocks of them in the plexmock package. It is run by go generate in
+// the root of the module:
+//
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	apiFile  = "serviceapi_gen.go"
+	mockFile = "plexmock/mocks_gen.go"
+	header   = "// Code generated by genapi. DO NOT EDIT.\n\n"
+)
+
+// interfaceNames overrides the interface name of services whose name plus "API" is taken
+var interfaceNames = map[string]string{
+	"Plex": "PlexTVAPI", // PlexAPI is the SDK itself
+}
+
+// reservedParams are names the mock methods use themselves, so parameters are renamed
+var reservedParams = map[string]bool{
+	"mock":     true,
+	"callInfo": true,
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the plexgo package")
+	flag.Parse()
+
+	api, mocks, err := generate(*dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "genapi: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(filepath.Join(*dir, apiFile), api, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "genapi: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, mockFile), mocks, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "genapi: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// service is a service of the SDK, a field of PlexAPI, with its exported methods
+type service struct {
+	Name      string
+	Interface string
+	Methods   []method
+}
+
+// method is an exported method of a service
+type method struct {
+	Name    string
+	Params  []param
+	Results []ast.Expr
+}
+
+// param is a parameter of a method
+type param struct {
+	Name     string
+	Type     ast.Expr // The element type for a variadic parameter
+	Variadic bool
+}
+
+// generator turns the parsed plexgo package into source code
+type generator struct {
+	module  string              // Import path of the plexgo package
+	imports map[string]string   // Import paths by package name, from the package's files
+	types   map[string]bool     // Types declared by the package
+	used    map[string]struct{} // Package names used by the signatures written so far
+}
+
+// generate returns the interfaces file of the plexgo package in dir and the mocks file of plexmock
+func generate(dir string) ([]byte, []byte, error) {
+	module, err := modulePath(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != apiFile
+	}, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkg, ok := pkgs["plexgo"]
+	if !ok {
+		return nil, nil, fmt.Errorf("no plexgo package in %s", dir)
+	}
+
+	g := &generator{module: module, imports: map[string]string{}, types: map[string]bool{}}
+	services, err := g.collect(pkg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g.used = map[string]struct{}{}
+	api, err := g.writeInterfaces(services)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g.used = map[string]struct{}{}
+	mocks, err := g.writeMocks(services)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return api, mocks, nil
+}
+
+// modulePath reads the module path from the go.mod file in dir
+func modulePath(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+			return fields[1], nil
+		}
+	}
+	return "", fmt.Errorf("no module path in %s", filepath.Join(dir, "go.mod"))
+}
+
+// collect finds the services of PlexAPI and their exported methods, sorted by name
+func (g *generator) collect(pkg *ast.Package) ([]service, error) {
+	var services []service
+	byName := map[string]*service{}
+
+	files := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	for _, name := range files {
+		file := pkg.Files[name]
+		for _, spec := range file.Imports {
+			path := strings.Trim(spec.Path.Value, `"`)
+			pkgName := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				pkgName = spec.Name.Name
+			}
+			g.imports[pkgName] = path
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				g.types[typeSpec.Name.Name] = true
+
+				if typeSpec.Name.Name != "PlexAPI" {
+					continue
+				}
+				for _, field := range typeSpec.Type.(*ast.StructType).Fields.List {
+					star, ok := field.Type.(*ast.StarExpr)
+					if !ok || len(field.Names) != 1 {
+						continue
+					}
+					ident, ok := star.X.(*ast.Ident)
+					if !ok || ident.Name != field.Names[0].Name {
+						continue
+					}
+
+					interfaceName := ident.Name + "API"
+					if override, ok := interfaceNames[ident.Name]; ok {
+						interfaceName = override
+					}
+					services = append(services, service{Name: ident.Name, Interface: interfaceName})
+				}
+			}
+		}
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no services found in PlexAPI")
+	}
+	for i := range services {
+		byName[services[i].Name] = &services[i]
+	}
+
+	for _, name := range files {
+		for _, decl := range pkg.Files[name].Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			ident, ok := recv.(*ast.Ident)
+			if !ok || byName[ident.Name] == nil {
+				continue
+			}
+
+			m := method{Name: fn.Name.Name}
+			for _, field := range fn.Type.Params.List {
+				typ, variadic := field.Type, false
+				if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+					typ, variadic = ellipsis.Elt, true
+				}
+				names := field.Names
+				if len(names) == 0 {
+					names = []*ast.Ident{{Name: "_"}}
+				}
+				for _, n := range names {
+					m.Params = append(m.Params, param{Name: n.Name, Type: typ, Variadic: variadic})
+				}
+			}
+			if fn.Type.Results != nil {
+				for _, field := range fn.Type.Results.List {
+					for i := 0; i < len(field.Names) || i == 0; i++ {
+						m.Results = append(m.Results, field.Type)
+					}
+				}
+			}
+			for i := range m.Params {
+				if m.Params[i].Name == "_" || reservedParams[m.Params[i].Name] {
+					m.Params[i].Name = fmt.Sprintf("arg%d", i+1)
+				}
+			}
+
+			byName[ident.Name].Methods = append(byName[ident.Name].Methods, m)
+		}
+	}
+
+	for i := range services {
+		sort.Slice(services[i].Methods, func(a, b int) bool { return services[i].Methods[a].Name < services[i].Methods[b].Name })
+	}
+	return services, nil
+}
+
+// writeInterfaces returns the interfaces of the services, in the plexgo package
+func (g *generator) writeInterfaces(services []service) ([]byte, error) {
+	var body bytes.Buffer
+	for _, svc := range services {
+		fmt.Fprintf(&body, "// %s is the interface of %s, implemented by PlexAPI.%s and by plexmock.%sMock\n", svc.Interface, svc.Name, svc.Name, svc.Interface)
+		fmt.Fprintf(&body, "type %s interface {\n", svc.Interface)
+		for _, m := range svc.Methods {
+			signature, err := g.signature(m, false)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", svc.Name, m.Name, err)
+			}
+			fmt.Fprintf(&body, "\t%s%s\n", m.Name, signature)
+		}
+		fmt.Fprintf(&body, "}\n\nvar _ %s = (*%s)(nil)\n\n", svc.Interface, svc.Name)
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header + "package plexgo\n\n")
+	g.writeImports(&out, nil)
+	out.Write(body.Bytes())
+	return format.Source(out.Bytes())
+}
+
+// writeMocks returns the mocks of the services, in the plexmock package
+func (g *generator) writeMocks(services []service) ([]byte, error) {
+	var body bytes.Buffer
+	for _, svc := range services {
+		mock := svc.Interface + "Mock"
+
+		fmt.Fprintf(&body, "// Ensure, that %s does implement plexgo.%s.\n", mock, svc.Interface)
+		fmt.Fprintf(&body, "var _ plexgo.%s = &%s{}\n\n", svc.Interface, mock)
+		fmt.Fprintf(&body, "// %s is a mock implementation of plexgo.%s. Set the Func field of each\n", mock, svc.Interface)
+		fmt.Fprintf(&body, "// method a test calls; the calls are recorded and returned by the Calls methods.\n")
+		fmt.Fprintf(&body, "type %s struct {\n", mock)
+		for _, m := range svc.Methods {
+			signature, err := g.signature(m, true)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", svc.Name, m.Name, err)
+			}
+			fmt.Fprintf(&body, "\t// %sFunc mocks the %s method.\n\t%sFunc func%s\n\n", m.Name, m.Name, m.Name, signature)
+		}
+		fmt.Fprintf(&body, "\t// calls tracks calls to the methods.\n\tcalls struct {\n")
+		for _, m := range svc.Methods {
+			callInfo, err := g.callInfo(m)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&body, "\t\t// %s holds details about calls to the %s method.\n\t\t%s []%s\n", m.Name, m.Name, m.Name, callInfo)
+		}
+		fmt.Fprintf(&body, "\t}\n")
+		for _, m := range svc.Methods {
+			fmt.Fprintf(&body, "\tlock%s sync.RWMutex\n", m.Name)
+		}
+		fmt.Fprintf(&body, "}\n\n")
+
+		for _, m := range svc.Methods {
+			if err := g.writeMockMethod(&body, svc, m); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header + "package plexmock\n\n")
+	g.writeImports(&out, []string{"sync"})
+	out.Write(body.Bytes())
+	return format.Source(out.Bytes())
+}
+
+// writeMockMethod writes the method of a mock and the method returning its calls
+func (g *generator) writeMockMethod(w *bytes.Buffer, svc service, m method) error {
+	mock := svc.Interface + "Mock"
+
+	signature, err := g.signature(m, true)
+	if err != nil {
+		return err
+	}
+	callInfo, err := g.callInfo(m)
+	if err != nil {
+		return err
+	}
+
+	args := make([]string, 0, len(m.Params))
+	fields := make([]string, 0, len(m.Params))
+	for _, p := range m.Params {
+		arg := p.Name
+		if p.Variadic {
+			arg += "..."
+		}
+		args = append(args, arg)
+		fields = append(fields, fmt.Sprintf("%s: %s,\n", exportedName(p.Name), p.Name))
+	}
+
+	fmt.Fprintf(w, "// %s calls %sFunc.\n", m.Name, m.Name)
+	fmt.Fprintf(w, "func (mock *%s) %s%s {\n", mock, m.Name, signature)
+	fmt.Fprintf(w, "\tif mock.%sFunc == nil {\n", m.Name)
+	fmt.Fprintf(w, "\t\tpanic(\"%s.%sFunc: method is nil but %s.%s was just called\")\n\t}\n", mock, m.Name, svc.Interface, m.Name)
+	fmt.Fprintf(w, "\tcallInfo := %s{\n%s}\n", callInfo, strings.Join(fields, ""))
+	fmt.Fprintf(w, "\tmock.lock%s.Lock()\n\tmock.calls.%s = append(mock.calls.%s, callInfo)\n\tmock.lock%s.Unlock()\n", m.Name, m.Name, m.Name, m.Name)
+	call := fmt.Sprintf("mock.%sFunc(%s)", m.Name, strings.Join(args, ", "))
+	if len(m.Results) > 0 {
+		fmt.Fprintf(w, "\treturn %s\n}\n\n", call)
+	} else {
+		fmt.Fprintf(w, "\t%s\n}\n\n", call)
+	}
+
+	fmt.Fprintf(w, "// %sCalls gets all the calls that were made to %s.\n", m.Name, m.Name)
+	fmt.Fprintf(w, "// Check the length with:\n//\n//\tlen(mocked%s.%sCalls())\n", svc.Name, m.Name)
+	fmt.Fprintf(w, "func (mock *%s) %sCalls() []%s {\n", mock, m.Name, callInfo)
+	fmt.Fprintf(w, "\tvar calls []%s\n", callInfo)
+	fmt.Fprintf(w, "\tmock.lock%s.RLock()\n\tcalls = mock.calls.%s\n\tmock.lock%s.RUnlock()\n\treturn calls\n}\n\n", m.Name, m.Name, m.Name)
+	return nil
+}
+
+// writeImports writes the import block of the standard library packages extra and the packages
+// used by the signatures written so far
+func (g *generator) writeImports(w *bytes.Buffer, extra []string) {
+	var std, module []string
+	std = append(std, extra...)
+	for name := range g.used {
+		path := g.imports[name]
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			module = append(module, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	if len(std)+len(module) == 0 {
+		return
+	}
+	sort.Strings(std)
+	sort.Strings(module)
+
+	w.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(w, "\t%q\n", path)
+	}
+	if len(std) > 0 && len(module) > 0 {
+		w.WriteString("\n")
+	}
+	for _, path := range module {
+		fmt.Fprintf(w, "\t%q\n", path)
+	}
+	w.WriteString(")\n\n")
+}
+
+// signature returns the parameters and results of a method, qualifying the types of the plexgo
+// package if qualify is set
+func (g *generator) signature(m method, qualify bool) (string, error) {
+	params := make([]string, 0, len(m.Params))
+	for _, p := range m.Params {
+		typ, err := g.typeString(p.Type, qualify)
+		if err != nil {
+			return "", err
+		}
+		if p.Variadic {
+			typ = "..." + typ
+		}
+		params = append(params, p.Name+" "+typ)
+	}
+
+	results := make([]string, 0, len(m.Results))
+	for _, r := range m.Results {
+		typ, err := g.typeString(r, qualify)
+		if err != nil {
+			return "", err
+		}
+		results = append(results, typ)
+	}
+
+	signature := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		signature += " " + results[0]
+	default:
+		signature += " (" + strings.Join(results, ", ") + ")"
+	}
+	return signature, nil
+}
+
+// callInfo returns the struct type recording a call of a method
+func (g *generator) callInfo(m method) (string, error) {
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	for _, p := range m.Params {
+		typ, err := g.typeString(p.Type, true)
+		if err != nil {
+			return "", err
+		}
+		if p.Variadic {
+			typ = "[]" + typ
+		}
+		fmt.Fprintf(&b, "%s %s\n", exportedName(p.Name), typ)
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// typeString returns the source of a type, noting the packages it uses
+func (g *generator) typeString(expr ast.Expr, qualify bool) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if !g.types[t.Name] {
+			return t.Name, nil // Predeclared, like string or error
+		}
+		if !t.IsExported() {
+			return "", fmt.Errorf("unexported type %s in an exported method", t.Name)
+		}
+		if qualify {
+			g.used["plexgo"] = struct{}{}
+			g.imports["plexgo"] = g.module
+			return "plexgo." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok || g.imports[pkg.Name] == "" {
+			return "", fmt.Errorf("unknown package of %s", t.Sel.Name)
+		}
+		g.used[pkg.Name] = struct{}{}
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		elem, err := g.typeString(t.X, qualify)
+		return "*" + elem, err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", fmt.Errorf("arrays aren't supported")
+		}
+		elem, err := g.typeString(t.Elt, qualify)
+		return "[]" + elem, err
+	case *ast.MapType:
+		key, err := g.typeString(t.Key, qualify)
+		if err != nil {
+			return "", err
+		}
+		value, err := g.typeString(t.Value, qualify)
+		return "map[" + key + "]" + value, err
+	case *ast.ChanType:
+		elem, err := g.typeString(t.Value, qualify)
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + elem, err
+		case ast.RECV:
+			return "<-chan " + elem, err
+		default:
+			return "chan " + elem, err
+		}
+	case *ast.Ellipsis:
+		elem, err := g.typeString(t.Elt, qualify)
+		return "..." + elem, err
+	case *ast.FuncType:
+		m := method{}
+		for _, field := range t.Params.List {
+			typ, variadic := field.Type, false
+			if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+				typ, variadic = ellipsis.Elt, true
+			}
+			for i := 0; i < len(field.Names) || i == 0; i++ {
+				name := "_"
+				if i < len(field.Names) {
+					name = field.Names[i].Name
+				}
+				m.Params = append(m.Params, param{Name: name, Type: typ, Variadic: variadic})
+			}
+		}
+		if t.Results != nil {
+			for _, field := range t.Results.List {
+				for i := 0; i < len(field.Names) || i == 0; i++ {
+					m.Results = append(m.Results, field.Type)
+				}
+			}
+		}
+		signature, err := g.signature(m, qualify)
+		return "func" + signature, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) > 0 {
+			return "", fmt.Errorf("interface literals aren't supported")
+		}
+		return "interface{}", nil
+	case *ast.StructType:
+		if len(t.Fields.List) > 0 {
+			return "", fmt.Errorf("struct literals aren't supported")
+		}
+		return "struct{}", nil
+	default:
+		return "", fmt.Errorf("unsupported type %T", expr)
+	}
+}
+
+// exportedName returns a parameter name with its first letter in upper case, for the fields of
+// recorded calls
+func exportedName(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
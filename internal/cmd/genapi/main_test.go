@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedUpToDate fails if a service gained, lost or changed a method since the interfaces
+// and mocks were last generated
+func TestGeneratedUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..", "..")
+
+	api, mocks, err := generate(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	for file, want := range map[string][]byte{apiFile: api, mockFile: mocks} {
+		got, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate in the root of the module", file)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package hooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// correlationIDKey is the context key of the correlation ID of an operation
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a copy of ctx whose requests carry the correlation ID id
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationID returns the correlation ID attached to ctx, or "" if there is none
+func CorrelationID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// NewCorrelationID returns a random correlation ID
+func NewCorrelationID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// CorrelationID returns the correlation ID of the operation, or "" before its request is sent
+func (c HookContext) CorrelationID() string {
+	return CorrelationID(c.Context)
+}
+
+// correlate gives a request the correlation ID of its context, a retried request the ID of its
+// first attempt, and other requests a new one. The ID is sent in the CorrelationIDHeader and
+// attached to the request's and the hook context's contexts.
+func (c HookContext) correlate(req *http.Request) (HookContext, *http.Request) {
+	id := CorrelationID(req.Context())
+	if id == "" {
+		id = req.Header.Get(sdkerrors.CorrelationIDHeader)
+	}
+	if id == "" {
+		id = NewCorrelationID()
+	}
+
+	req.Header.Set(sdkerrors.CorrelationIDHeader, id)
+	if CorrelationID(req.Context()) != id {
+		req = req.WithContext(WithCorrelationID(req.Context(), id))
+	}
+	if c.Context != nil && c.CorrelationID() != id {
+		c.Context = WithCorrelationID(c.Context, id)
+	}
+	return c, req
+}
+
+// correlateResponse attaches the correlation ID of the request of a response to the hook context
+func (c HookContext) correlateResponse(res *http.Response) HookContext {
+	if c.Context == nil || c.CorrelationID() != "" || res == nil || res.Request == nil {
+		return c
+	}
+	if id := res.Request.Header.Get(sdkerrors.CorrelationIDHeader); id != "" {
+		c.Context = WithCorrelationID(c.Context, id)
+	}
+	return c
+}
@@ -69,6 +69,7 @@ type Hooks struct {
 	beforeRequestHook []beforeRequestHook
 	afterSuccessHook  []afterSuccessHook
 	afterErrorHook    []afterErrorHook
+	stats             *statsHook
 }
 
 func New() *Hooks {
@@ -112,6 +113,7 @@ func (h *Hooks) SDKInit(baseURL string, client HTTPClient) (string, HTTPClient)
 }
 
 func (h *Hooks) BeforeRequest(hookCtx BeforeRequestContext, req *http.Request) (*http.Request, error) {
+	hookCtx.HookContext, req = hookCtx.HookContext.correlate(req)
 	for _, hook := range h.beforeRequestHook {
 		var err error
 		req, err = hook.BeforeRequest(hookCtx, req)
@@ -123,6 +125,7 @@ func (h *Hooks) BeforeRequest(hookCtx BeforeRequestContext, req *http.Request) (
 }
 
 func (h *Hooks) AfterSuccess(hookCtx AfterSuccessContext, res *http.Response) (*http.Response, error) {
+	hookCtx.HookContext = hookCtx.HookContext.correlateResponse(res)
 	for _, hook := range h.afterSuccessHook {
 		var err error
 		res, err = hook.AfterSuccess(hookCtx, res)
@@ -134,6 +137,7 @@ func (h *Hooks) AfterSuccess(hookCtx AfterSuccessContext, res *http.Response) (*
 }
 
 func (h *Hooks) AfterError(hookCtx AfterErrorContext, res *http.Response, err error) (*http.Response, error) {
+	hookCtx.HookContext = hookCtx.HookContext.correlateResponse(res)
 	for _, hook := range h.afterErrorHook {
 		res, err = hook.AfterError(hookCtx, res, err)
 		var fe *FailEarly
@@ -10,4 +10,14 @@ func initHooks(h *Hooks) {
 	// Add hooks by calling h.register{ClientInit/BeforeRequest/AfterRequest/AfterError}Hook
 	// with an instance of a hook that implements that specific Hook interface
 	// Hooks are registered per SDK instance, and are valid for the lifetime of the SDK instance
+
+	// Count usage first, so bodies are counted as they come off the wire
+	h.stats = newStatsHook()
+	h.registerBeforeRequestHook(h.stats)
+	h.registerAfterSuccessHook(h.stats)
+	h.registerAfterErrorHook(h.stats)
+
+	contentHook := &unexpectedContentHook{}
+	h.registerAfterSuccessHook(contentHook)
+	h.registerAfterErrorHook(contentHook)
 }
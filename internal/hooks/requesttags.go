@@ -0,0 +1,33 @@
+package hooks
+
+import "context"
+
+// requestTagsKey is the context key of the tags attached to a request
+type requestTagsKey struct{}
+
+// WithTag returns a copy of ctx carrying the tag key=value in addition to the tags of ctx. The
+// tags of ctx itself are left untouched, so contexts derived from it don't share changes.
+func WithTag(ctx context.Context, key, value string) context.Context {
+	parent := Tags(ctx)
+	tags := make(map[string]string, len(parent)+1)
+	for k, v := range parent {
+		tags[k] = v
+	}
+	tags[key] = value
+
+	return context.WithValue(ctx, requestTagsKey{}, tags)
+}
+
+// Tags returns the tags attached to ctx, or nil if there are none. The map must not be modified.
+func Tags(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+	tags, _ := ctx.Value(requestTagsKey{}).(map[string]string)
+	return tags
+}
+
+// Tags returns the tags attached to the context of the operation
+func (c HookContext) Tags() map[string]string {
+	return Tags(c.Context)
+}
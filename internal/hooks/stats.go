@@ -0,0 +1,135 @@
+package hooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OperationStats counts the usage of a single operation
+type OperationStats struct {
+	Requests        int64 // Requests sent, including retries
+	Retries         int64 // Requests sent to retry an earlier attempt
+	Errors          int64 // Requests that failed to get a response, and error responses that weren't retried
+	BytesDownloaded int64 // Response body bytes read
+}
+
+// operationCounters are the live counters of an operation
+type operationCounters struct {
+	requests, retries, errors, bytesDownloaded atomic.Int64
+}
+
+// attemptKey marks the context of a request that was sent once, so later attempts count as retries
+type attemptKey struct{}
+
+// statsHook counts the requests, retries, errors and downloaded bytes of each operation
+type statsHook struct {
+	mu         sync.Mutex
+	operations map[string]*operationCounters
+	since      time.Time
+}
+
+var (
+	_ beforeRequestHook = (*statsHook)(nil)
+	_ afterSuccessHook  = (*statsHook)(nil)
+	_ afterErrorHook    = (*statsHook)(nil)
+)
+
+func newStatsHook() *statsHook {
+	return &statsHook{operations: map[string]*operationCounters{}, since: time.Now()}
+}
+
+// counters returns the counters of an operation, creating them on first use
+func (h *statsHook) counters(operationID string) *operationCounters {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	counters, ok := h.operations[operationID]
+	if !ok {
+		counters = &operationCounters{}
+		h.operations[operationID] = counters
+	}
+	return counters
+}
+
+func (h *statsHook) BeforeRequest(hookCtx BeforeRequestContext, req *http.Request) (*http.Request, error) {
+	counters := h.counters(hookCtx.OperationID)
+	counters.requests.Add(1)
+
+	// Retry loops send the request returned by the hooks of the previous attempt again
+	if req.Context().Value(attemptKey{}) != nil {
+		counters.retries.Add(1)
+		return req, nil
+	}
+	return req.WithContext(context.WithValue(req.Context(), attemptKey{}, true)), nil
+}
+
+func (h *statsHook) AfterSuccess(hookCtx AfterSuccessContext, res *http.Response) (*http.Response, error) {
+	h.countBody(hookCtx.OperationID, res)
+	return res, nil
+}
+
+func (h *statsHook) AfterError(hookCtx AfterErrorContext, res *http.Response, err error) (*http.Response, error) {
+	h.counters(hookCtx.OperationID).errors.Add(1)
+	h.countBody(hookCtx.OperationID, res)
+	return res, err
+}
+
+// countBody counts the bytes of the response body as they are read
+func (h *statsHook) countBody(operationID string, res *http.Response) {
+	if res == nil || res.Body == nil || res.Body == http.NoBody {
+		return
+	}
+	res.Body = &countingBody{ReadCloser: res.Body, counter: &h.counters(operationID).bytesDownloaded}
+}
+
+// snapshot returns the counts of every operation and the time counting started
+func (h *statsHook) snapshot() (map[string]OperationStats, time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	operations := make(map[string]OperationStats, len(h.operations))
+	for operationID, counters := range h.operations {
+		operations[operationID] = OperationStats{
+			Requests:        counters.requests.Load(),
+			Retries:         counters.retries.Load(),
+			Errors:          counters.errors.Load(),
+			BytesDownloaded: counters.bytesDownloaded.Load(),
+		}
+	}
+	return operations, h.since
+}
+
+// reset clears the counts. Bodies still being read count towards the cleared counters.
+func (h *statsHook) reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.operations = map[string]*operationCounters{}
+	h.since = time.Now()
+}
+
+// countingBody adds the number of bytes read from a response body to a counter
+type countingBody struct {
+	io.ReadCloser
+	counter *atomic.Int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.counter.Add(int64(n))
+	return n, err
+}
+
+// Stats returns the usage counts of every operation by operation ID and the time counting started
+func (h *Hooks) Stats() (map[string]OperationStats, time.Time) {
+	return h.stats.snapshot()
+}
+
+// ResetStats clears the usage counts
+func (h *Hooks) ResetStats() {
+	h.stats.reset()
+}
@@ -0,0 +1,98 @@
+package hooks
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// contentSniffLength is the number of bytes of a response body inspected for unexpected content
+const contentSniffLength = 512
+
+// snippetLength is the maximum length of the body snippet included in an UnexpectedContentError
+const snippetLength = 200
+
+// unexpectedContentHook turns HTML responses to requests for JSON or XML into an
+// *sdkerrors.UnexpectedContentError, so a misconfigured proxy or authentication portal in front of
+// the server is reported as such instead of as an opaque decoding error
+type unexpectedContentHook struct{}
+
+var (
+	_ afterSuccessHook = (*unexpectedContentHook)(nil)
+	_ afterErrorHook   = (*unexpectedContentHook)(nil)
+)
+
+func (h *unexpectedContentHook) AfterSuccess(hookCtx AfterSuccessContext, res *http.Response) (*http.Response, error) {
+	if err := checkContent(res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (h *unexpectedContentHook) AfterError(hookCtx AfterErrorContext, res *http.Response, err error) (*http.Response, error) {
+	if err != nil || res == nil {
+		return res, err
+	}
+	if contentErr := checkContent(res); contentErr != nil {
+		return res, contentErr
+	}
+	return res, nil
+}
+
+// checkContent returns an *sdkerrors.UnexpectedContentError if res is HTML while the request
+// accepted only JSON or XML. The body is left unconsumed.
+func checkContent(res *http.Response) error {
+	if res.Request == nil || res.Body == nil {
+		return nil
+	}
+
+	accept := res.Request.Header.Get("Accept")
+	if !strings.Contains(accept, "json") && !strings.Contains(accept, "xml") {
+		return nil
+	}
+
+	prefix := make([]byte, contentSniffLength)
+	n, _ := io.ReadFull(res.Body, prefix)
+	prefix = prefix[:n]
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), res.Body), res.Body}
+
+	contentType := res.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != "text/html" && !looksLikeHTML(prefix) {
+		return nil
+	}
+
+	if contentType == "" {
+		contentType = "text/html"
+	}
+
+	return &sdkerrors.UnexpectedContentError{
+		ContentType: contentType,
+		StatusCode:  res.StatusCode,
+		URL:         res.Request.URL.String(),
+		Snippet:     bodySnippet(prefix),
+		RawResponse: res,
+	}
+}
+
+// looksLikeHTML returns true if a body starts like an HTML document
+func looksLikeHTML(body []byte) bool {
+	start := strings.ToLower(strings.TrimSpace(string(body)))
+	return strings.HasPrefix(start, "<!doctype html") || strings.HasPrefix(start, "<html")
+}
+
+// bodySnippet returns the start of a body with its whitespace collapsed
+func bodySnippet(body []byte) string {
+	snippet := strings.Join(strings.Fields(string(body)), " ")
+	if len(snippet) > snippetLength {
+		snippet = snippet[:snippetLength] + "..."
+	}
+	return snippet
+}
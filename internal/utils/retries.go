@@ -7,12 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"github.com/unfaiyted/plexgo/retry"
+	"io"
 	"math"
 	"math/rand"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 )
 
@@ -31,12 +33,16 @@ func Retry(ctx context.Context, r Retries, operation func() (*http.Response, err
 	switch r.Config.Strategy {
 	case "backoff":
 		if r.Config.Backoff == nil {
+			// Nothing would limit how long an interval strategy retries
+			if r.Config.IntervalStrategy != nil {
+				return nil, retry.ErrIntervalWithoutBackoff
+			}
 			return operation()
 		}
 
 		var resp *http.Response
 
-		err := retryWithBackoff(ctx, r.Config.Backoff, func() error {
+		err := retryWithBackoff(ctx, r.Config, func() error {
 			if resp != nil {
 				resp.Body.Close()
 			}
@@ -51,7 +57,7 @@ func Retry(ctx context.Context, r Retries, operation func() (*http.Response, err
 			if err != nil {
 				urlError := new(url.Error)
 				if errors.As(err, &urlError) {
-					if (urlError.Temporary() || urlError.Timeout()) && r.Config.RetryConnectionErrors {
+					if (urlError.Temporary() || urlError.Timeout() || isDroppedConnection(urlError)) && r.Config.RetryConnectionErrors {
 						return err
 					}
 				}
@@ -103,11 +109,20 @@ func Retry(ctx context.Context, r Retries, operation func() (*http.Response, err
 	}
 }
 
-func retryWithBackoff(ctx context.Context, s *retry.BackoffStrategy, operation func() error) error {
+// isDroppedConnection returns true if a request failed because the server closed or reset the
+// connection, which is neither temporary nor a timeout but is as worth retrying
+func isDroppedConnection(err *url.Error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+}
+
+func retryWithBackoff(ctx context.Context, config *retry.Config, operation func() error) error {
 	var (
 		err            error
 		next           time.Duration
+		previous       time.Duration
 		attempt        int
+		s              = config.Backoff
+		budget         = config.Budget
 		start          = time.Now()
 		maxElapsedTime = time.Duration(s.MaxElapsedTime) * time.Millisecond
 	)
@@ -120,6 +135,9 @@ func retryWithBackoff(ctx context.Context, s *retry.BackoffStrategy, operation f
 	for {
 		err = operation()
 		if err == nil {
+			if budget != nil {
+				budget.Deposit()
+			}
 			return nil
 		}
 
@@ -132,14 +150,25 @@ func retryWithBackoff(ctx context.Context, s *retry.BackoffStrategy, operation f
 			return err
 		}
 
+		// A shared budget stops retries when too many operations are failing at once
+		if budget != nil && !budget.Withdraw() {
+			return err
+		}
+
+		next = 0
 		var temporary *retry.TemporaryError
 		if errors.As(err, &temporary) {
 			next = temporary.RetryAfter()
 		}
 
 		if next <= 0 {
-			next = nextInterval(s, attempt)
+			if config.IntervalStrategy != nil {
+				next = config.IntervalStrategy.NextInterval(attempt, previous)
+			} else {
+				next = nextInterval(s, attempt)
+			}
 		}
+		previous = next
 
 		timer.Start(next)
 
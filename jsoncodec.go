@@ -0,0 +1,46 @@
+package plexgo
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/unfaiyted/plexgo/internal/utils"
+)
+
+// JSONCodec decodes the JSON bodies of responses. Implementations must behave like
+// encoding/json.Unmarshal, including calling the UnmarshalJSON methods of the SDK's models.
+type JSONCodec interface {
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// JSONCodecFunc adapts an Unmarshal function to a JSONCodec, e.g.
+// plexgo.JSONCodecFunc(jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal) or
+// plexgo.JSONCodecFunc(gojson.Unmarshal)
+type JSONCodecFunc func(data []byte, v interface{}) error
+
+// Unmarshal calls f(data, v)
+func (f JSONCodecFunc) Unmarshal(data []byte, v interface{}) error {
+	return f(data, v)
+}
+
+// WithJSONCodec decodes responses with codec instead of encoding/json, e.g. a faster drop-in
+// replacement for large library responses. It applies to the top level of each response; models
+// with custom decoding still decode their fields with encoding/json.
+func WithJSONCodec(codec JSONCodec) SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.JSONCodec = codec
+	}
+}
+
+// decodeJSON decodes a JSON response body into out with the configured codec
+func (c *sdkConfiguration) decodeJSON(rawBody []byte, out interface{}) error {
+	if c.JSONCodec == nil {
+		return utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), out, "")
+	}
+
+	if err := c.JSONCodec.Unmarshal(rawBody, out); err != nil {
+		return fmt.Errorf("error unmarshalling json response body: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithJSONCodec(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"machineIdentifier":"abc123"}}`))
+		case "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"7","title":"Horror","type":"collection","subtype":"movie"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	calls := 0
+	codec := JSONCodecFunc(func(data []byte, v interface{}) error {
+		calls++
+		return json.Unmarshal(data, v)
+	})
+
+	client := New(WithServerURL(server.URL), WithJSONCodec(codec))
+
+	// Generated operations
+	res, err := client.Server.GetServerCapabilities(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if res.Object.MediaContainer.MachineIdentifier == nil || *res.Object.MediaContainer.MachineIdentifier != "abc123" {
+		t.Errorf("Unexpected response: %+v", res.Object.MediaContainer)
+	}
+
+	// Hand-written helpers
+	collection, err := client.Collections.GetCollection(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if collection.Title != "Horror" {
+		t.Errorf("Unexpected collection: %+v", collection)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected the codec to decode both responses, got %d calls", calls)
+	}
+
+	failing := JSONCodecFunc(func(data []byte, v interface{}) error {
+		return fmt.Errorf("codec failure")
+	})
+	client = New(WithServerURL(server.URL), WithJSONCodec(failing))
+	if _, err := client.Server.GetServerCapabilities(context.Background()); err == nil || !strings.Contains(err.Error(), "codec failure") {
+		t.Errorf("Expected the codec's error, got: %v", err)
+	}
+}
+
+// libraryResponse returns a library items response with n movies, each with media and tags
+func libraryResponse(n int) []byte {
+	var b strings.Builder
+	b.WriteString(`{"MediaContainer":{"size":` + fmt.Sprint(n) + `,"Metadata":[`)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"ratingKey":"%d","key":"/library/metadata/%d","guid":"plex://movie/%08x","type":"movie","title":"Movie %d","summary":"A movie about the number %d, told over two hours.","year":%d,"contentRating":"PG-13","addedAt":1700000000,"Media":[{"id":%d,"duration":7200000,"bitrate":8000,"width":1920,"height":1080,"videoCodec":"h264","audioCodec":"aac","Part":[{"id":%d,"file":"/movies/Movie %d.mkv","size":7200000000}]}],"Genre":[{"tag":"Drama"},{"tag":"Thriller"}],"Label":[{"tag":"watched"}]}`, i, i, i, i, i, 1950+i%70, i, i, i)
+	}
+	b.WriteString(`]}}`)
+	return []byte(b.String())
+}
+
+// BenchmarkJSONCodec compares the default decoding of a large library response with a codec. Add
+// sub-benchmarks for third party codecs locally to compare them before opting in.
+func BenchmarkJSONCodec(b *testing.B) {
+	data := libraryResponse(5000)
+
+	codecs := []struct {
+		name  string
+		codec JSONCodec
+	}{
+		{"default", nil},
+		{"encoding/json", JSONCodecFunc(json.Unmarshal)},
+	}
+
+	for _, c := range codecs {
+		b.Run(c.name, func(b *testing.B) {
+			config := sdkConfiguration{JSONCodec: c.codec}
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				var out struct {
+					MediaContainer struct {
+						Metadata []Metadata `json:"Metadata"`
+					} `json:"MediaContainer"`
+				}
+				if err := config.decodeJSON(data, &out); err != nil {
+					b.Fatal(err)
+				}
+				if len(out.MediaContainer.Metadata) != 5000 {
+					b.Fatalf("Expected 5000 items, got %d", len(out.MediaContainer.Metadata))
+				}
+			}
+		})
+	}
+}
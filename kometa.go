@@ -0,0 +1,560 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// KometaCollection is a smart collection definition loaded from a Kometa (formerly Plex Meta
+// Manager) collection file by LoadKometaCollections
+type KometaCollection struct {
+	Title     string
+	Summary   string         // summary
+	PosterURL string         // url_poster
+	Mode      string         // collection_mode, as a CollectionMode constant
+	Sort      string         // collection_order, as a CollectionSort constant
+	Schedule  KometaSchedule // schedule
+	Search    KometaSearch   // plex_search
+}
+
+// KometaSearch is a plex_search builder
+type KometaSearch struct {
+	Type  MetadataType // Item type from "type", or 0 to use the type of the section's items
+	Any   bool         // Match any rule ("any") instead of all rules ("all")
+	Rules []KometaRule // Search rules, e.g. genre: Action
+	Sort  []FilterSort // sort_by
+	Limit int          // limit
+}
+
+// KometaRule is a plex_search rule such as "year.gte: 2000" or "genre: [Action, Comedy]".
+// Multiple values match any of the values.
+type KometaRule struct {
+	Attribute string // e.g. "genre"
+	Modifier  string // e.g. "not" or "gte", empty for none
+	Values    []string
+}
+
+// KometaSchedule is a Kometa run schedule
+type KometaSchedule struct {
+	Frequency string       // "daily", "weekly", "monthly" or "never"
+	Weekday   time.Weekday // Day of the week for weekly schedules
+	Day       int          // Day of the month for monthly schedules
+}
+
+// kometaSearchField describes how a plex_search attribute maps onto a Plex filter field
+type kometaSearchField struct {
+	field string
+	kind  string  // "tag" (resolved by name), "string", "number" or "date"
+	step  float64 // Precision of numbers, used to make gte and lte bounds inclusive
+}
+
+// kometaSearchFields are the supported plex_search attributes
+var kometaSearchFields = map[string]kometaSearchField{
+	"title":           {"title", "string", 0},
+	"studio":          {"studio", "string", 0},
+	"content_rating":  {"contentRating", "string", 0},
+	"resolution":      {"resolution", "string", 0},
+	"year":            {"year", "number", 1},
+	"decade":          {"decade", "number", 10},
+	"critic_rating":   {"rating", "number", 0.1},
+	"audience_rating": {"audienceRating", "number", 0.1},
+	"plays":           {"viewCount", "number", 1},
+	"added":           {"addedAt", "date", 0},
+	"release":         {"originallyAvailableAt", "date", 0},
+	"last_played":     {"lastViewedAt", "date", 0},
+	"genre":           {"genre", "tag", 0},
+	"actor":           {"actor", "tag", 0},
+	"director":        {"director", "tag", 0},
+	"writer":          {"writer", "tag", 0},
+	"producer":        {"producer", "tag", 0},
+	"country":         {"country", "tag", 0},
+	"label":           {"label", "tag", 0},
+	"collection":      {"collection", "tag", 0},
+}
+
+// kometaModifiers maps plex_search modifiers onto smart filter operators
+var kometaModifiers = map[string]FilterOperator{
+	"":       FilterOpContains,
+	"not":    FilterOpNotContains,
+	"is":     FilterOpIs,
+	"isnot":  FilterOpIsNot,
+	"begins": FilterOpBeginsWith,
+	"ends":   FilterOpEndsWith,
+	"gt":     FilterOpGreaterThan,
+	"gte":    FilterOpGreaterThan,
+	"after":  FilterOpGreaterThan,
+	"lt":     FilterOpLessThan,
+	"lte":    FilterOpLessThan,
+	"before": FilterOpLessThan,
+}
+
+// kometaSortFields maps sort_by attributes onto Plex sort fields
+var kometaSortFields = map[string]string{
+	"title":           "titleSort",
+	"year":            "year",
+	"release":         "originallyAvailableAt",
+	"added":           "addedAt",
+	"critic_rating":   "rating",
+	"audience_rating": "audienceRating",
+	"user_rating":     "userRating",
+	"content_rating":  "contentRating",
+	"duration":        "duration",
+	"plays":           "viewCount",
+	"last_played":     "lastViewedAt",
+	"random":          "random",
+}
+
+// kometaCollectionModes maps collection_mode values onto CollectionMode constants
+var kometaCollectionModes = map[string]string{
+	"default":    CollectionModeDefault,
+	"hide":       CollectionModeHide,
+	"hide_items": CollectionModeHideItems,
+	"show_items": CollectionModeShowItems,
+}
+
+// LoadKometaCollections reads the collections of a Kometa collection file. Only smart collections
+// built with plex_search are supported, along with the summary, url_poster, schedule,
+// collection_mode and collection_order attributes; any other builder or attribute is reported as
+// an error rather than ignored, so a migrated collection never silently differs from the original.
+func LoadKometaCollections(r io.Reader) ([]KometaCollection, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Kometa config: %w", err)
+	}
+
+	doc, err := parseYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Kometa config: %w", err)
+	}
+
+	for _, key := range doc.keys {
+		if key != "collections" {
+			return nil, fmt.Errorf("unsupported Kometa config section %q", key)
+		}
+	}
+
+	value, _ := doc.get("collections")
+	definitions, ok := value.(*yamlMap)
+	if !ok {
+		return nil, fmt.Errorf("Kometa config has no collections")
+	}
+
+	collections := make([]KometaCollection, 0, len(definitions.keys))
+	for _, title := range definitions.keys {
+		definition, ok := definitions.values[title].(*yamlMap)
+		if !ok {
+			return nil, fmt.Errorf("collection %q: expected a mapping", title)
+		}
+
+		collection, err := parseKometaCollection(title, definition)
+		if err != nil {
+			return nil, fmt.Errorf("collection %q: %w", title, err)
+		}
+		collections = append(collections, collection)
+	}
+
+	return collections, nil
+}
+
+// parseKometaCollection parses a single collection definition
+func parseKometaCollection(title string, definition *yamlMap) (KometaCollection, error) {
+	collection := KometaCollection{
+		Title:    title,
+		Schedule: KometaSchedule{Frequency: "daily"},
+	}
+
+	hasSearch := false
+	for _, key := range definition.keys {
+		value := definition.values[key]
+
+		var err error
+		switch key {
+		case "plex_search":
+			hasSearch = true
+			collection.Search, err = parseKometaSearch(value)
+		case "summary":
+			collection.Summary, err = kometaString(value)
+		case "url_poster":
+			collection.PosterURL, err = kometaString(value)
+		case "schedule":
+			var schedule string
+			if schedule, err = kometaString(value); err == nil {
+				collection.Schedule, err = ParseKometaSchedule(schedule)
+			}
+		case "collection_mode":
+			var mode string
+			if mode, err = kometaString(value); err == nil {
+				var ok bool
+				if collection.Mode, ok = kometaCollectionModes[mode]; !ok {
+					err = fmt.Errorf("invalid collection_mode %q", mode)
+				}
+			}
+		case "collection_order":
+			var order string
+			if order, err = kometaString(value); err == nil {
+				switch order {
+				case CollectionSortRelease, CollectionSortAlpha, CollectionSortCustom:
+					collection.Sort = order
+				default:
+					err = fmt.Errorf("invalid collection_order %q", order)
+				}
+			}
+		default:
+			err = fmt.Errorf("unsupported attribute %q", key)
+		}
+		if err != nil {
+			return collection, err
+		}
+	}
+
+	if !hasSearch {
+		return collection, fmt.Errorf("missing plex_search builder")
+	}
+
+	return collection, nil
+}
+
+// parseKometaSearch parses a plex_search builder
+func parseKometaSearch(value interface{}) (KometaSearch, error) {
+	search := KometaSearch{}
+
+	builder, ok := value.(*yamlMap)
+	if !ok {
+		return search, fmt.Errorf("plex_search must be a mapping")
+	}
+
+	var rules *yamlMap
+	for _, key := range builder.keys {
+		value := builder.values[key]
+
+		switch key {
+		case "all", "any":
+			if rules != nil {
+				return search, fmt.Errorf("plex_search can only have one of all or any")
+			}
+			if rules, ok = value.(*yamlMap); !ok {
+				return search, fmt.Errorf("plex_search %s must be a mapping", key)
+			}
+			search.Any = key == "any"
+		case "type":
+			typeName, err := kometaString(value)
+			if err != nil {
+				return search, err
+			}
+			if search.Type = collectionItemTypeFromName(strings.TrimSuffix(typeName, "s")); search.Type == 0 {
+				return search, fmt.Errorf("invalid plex_search type %q", typeName)
+			}
+		case "sort_by":
+			sortBy, err := kometaString(value)
+			if err != nil {
+				return search, err
+			}
+			attribute, direction, _ := strings.Cut(sortBy, ".")
+			field, ok := kometaSortFields[attribute]
+			if !ok || (direction != "" && direction != "asc" && direction != "desc") {
+				return search, fmt.Errorf("unsupported sort_by %q", sortBy)
+			}
+			search.Sort = append(search.Sort, FilterSort{Field: field, Descending: direction == "desc"})
+		case "limit":
+			limit, err := kometaString(value)
+			if err != nil {
+				return search, err
+			}
+			if search.Limit, err = strconv.Atoi(limit); err != nil || search.Limit <= 0 {
+				return search, fmt.Errorf("invalid plex_search limit %q", limit)
+			}
+		default:
+			return search, fmt.Errorf("unsupported plex_search attribute %q", key)
+		}
+	}
+
+	if rules == nil || len(rules.keys) == 0 {
+		return search, fmt.Errorf("plex_search needs all or any rules")
+	}
+
+	for _, key := range rules.keys {
+		attribute, modifier, _ := strings.Cut(key, ".")
+		field, ok := kometaSearchFields[attribute]
+		if !ok {
+			return search, fmt.Errorf("unsupported plex_search attribute %q", attribute)
+		}
+		if _, ok := kometaModifiers[modifier]; !ok {
+			return search, fmt.Errorf("unsupported modifier %q for %s", modifier, attribute)
+		}
+		if field.kind == "date" && modifier != "" && modifier != "not" && modifier != "before" && modifier != "after" {
+			return search, fmt.Errorf("unsupported modifier %q for %s", modifier, attribute)
+		}
+
+		values, err := kometaStrings(rules.values[key])
+		if err != nil {
+			return search, fmt.Errorf("%s: %w", key, err)
+		}
+
+		search.Rules = append(search.Rules, KometaRule{Attribute: attribute, Modifier: modifier, Values: values})
+	}
+
+	return search, nil
+}
+
+// ParseKometaSchedule parses a Kometa schedule: daily, weekly(<day>), monthly(<day of month>) or never
+func ParseKometaSchedule(schedule string) (KometaSchedule, error) {
+	frequency, arg, hasArg := strings.Cut(strings.TrimSuffix(strings.ToLower(strings.TrimSpace(schedule)), ")"), "(")
+
+	switch frequency {
+	case "daily", "never":
+		if hasArg {
+			break
+		}
+		return KometaSchedule{Frequency: frequency}, nil
+	case "weekly":
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if arg == strings.ToLower(day.String()) {
+				return KometaSchedule{Frequency: frequency, Weekday: day}, nil
+			}
+		}
+	case "monthly":
+		day, err := strconv.Atoi(arg)
+		if err == nil && day >= 1 && day <= 31 {
+			return KometaSchedule{Frequency: frequency, Day: day}, nil
+		}
+	}
+
+	return KometaSchedule{}, fmt.Errorf("unsupported schedule %q", schedule)
+}
+
+// Due returns true if the schedule runs on the day of t. Monthly schedules for days beyond the
+// end of a month run on its last day.
+func (s KometaSchedule) Due(t time.Time) bool {
+	switch s.Frequency {
+	case "daily":
+		return true
+	case "weekly":
+		return t.Weekday() == s.Weekday
+	case "monthly":
+		lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+		if s.Day > lastDay {
+			return t.Day() == lastDay
+		}
+		return t.Day() == s.Day
+	default:
+		return false
+	}
+}
+
+// RunKometaCollections creates the smart collections of Kometa definitions in a library section,
+// skipping definitions whose schedule is not due today. Collections are matched by title, so runs
+// can be repeated: an existing collection has its filter, summary, poster, mode and sort updated.
+// Tag values such as genres and actors are resolved to tag IDs by name. A definition that fails
+// does not stop the others; the collections that were created or updated are returned together
+// with an error describing each failed definition.
+func (s *Collections) RunKometaCollections(ctx context.Context, sectionID int, collections []KometaCollection, opts ...operations.Option) ([]*Collection, error) {
+	library := newLibrary(s.sdkConfiguration)
+	options := processOptions(opts)
+	now := time.Now()
+
+	results := []*Collection{}
+	var failed []string
+
+	reportProgress(options, 0, len(collections), "running collections")
+	for i, definition := range collections {
+		if definition.Schedule.Due(now) {
+			collection, err := s.runKometaCollection(ctx, library, sectionID, definition, opts...)
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("collection %s: %v", definition.Title, err))
+			} else {
+				results = append(results, collection)
+			}
+		}
+		reportProgress(options, i+1, len(collections), "running collections")
+	}
+
+	if len(failed) > 0 {
+		return results, fmt.Errorf("error running Kometa collections: %s", strings.Join(failed, "; "))
+	}
+
+	return results, nil
+}
+
+// runKometaCollection creates or updates the smart collection of a single definition
+func (s *Collections) runKometaCollection(ctx context.Context, library *Library, sectionID int, definition KometaCollection, opts ...operations.Option) (*Collection, error) {
+	filter, err := definition.Search.smartFilter(ctx, library, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	createOptions := CreateCollectionOptions{
+		Mode:       definition.Mode,
+		Sort:       definition.Sort,
+		PosterURL:  definition.PosterURL,
+		Summary:    definition.Summary,
+		Idempotent: true,
+	}
+
+	existing, err := s.findIdempotentCollection(ctx, sectionID, definition.Title, "", opts...)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return s.CreateSmartCollectionWithOptions(ctx, sectionID, definition.Title, filter.Type, filter.String(), createOptions, opts...)
+	}
+
+	collectionID, err := existing.ID()
+	if err != nil {
+		return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+	}
+
+	filterURI, err := s.buildSmartFilterURI(ctx, sectionID, filter.String(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.UpdateSmartCollection(ctx, collectionID, filterURI, opts...); err != nil {
+		return nil, fmt.Errorf("error updating smart filter: %w", err)
+	}
+
+	return s.applyCreateOptions(ctx, sectionID, existing, createOptions, opts...)
+}
+
+// smartFilter builds the smart filter of a plex_search builder, resolving tag names to IDs
+func (k KometaSearch) smartFilter(ctx context.Context, library *Library, sectionID int, opts ...operations.Option) (*SmartFilter, error) {
+	itemType := k.Type
+	if itemType == 0 {
+		// Default to the type of the section's items, as Kometa does
+		queryParams := url.Values{}
+		queryParams.Add("X-Plex-Container-Start", "0")
+		queryParams.Add("X-Plex-Container-Size", "1")
+
+		items, err := library.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), queryParams, "getLibraryItems", opts...)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return nil, fmt.Errorf("cannot determine the item type of empty section %d", sectionID)
+		}
+		itemType = collectionItemTypeFromName(items[0].Type)
+	}
+
+	filter := NewSmartFilter(itemType).WithLimit(k.Limit)
+	filter.Sort = k.Sort
+
+	conditions := make([]FilterNode, 0, len(k.Rules))
+	for _, rule := range k.Rules {
+		condition, err := rule.condition(ctx, library, sectionID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if k.Any && len(conditions) > 1 {
+		return filter.AnyOf(conditions...), nil
+	}
+	return filter.Add(conditions...), nil
+}
+
+// condition builds the smart filter condition of a rule
+func (r KometaRule) condition(ctx context.Context, library *Library, sectionID int, opts ...operations.Option) (FilterCondition, error) {
+	field := kometaSearchFields[r.Attribute]
+	op := kometaModifiers[r.Modifier]
+
+	values := make([]string, 0, len(r.Values))
+	for _, value := range r.Values {
+		switch field.kind {
+		case "tag":
+			tag, err := library.findTag(ctx, sectionID, field.field, value, opts...)
+			if err != nil {
+				return FilterCondition{}, err
+			}
+			value = strconv.FormatInt(tag.ID, 10)
+		case "number":
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return FilterCondition{}, fmt.Errorf("invalid %s value %q", r.Attribute, value)
+			}
+			// Plex only supports strict comparisons, so inclusive bounds are widened by one step
+			switch r.Modifier {
+			case "gte":
+				n -= field.step
+			case "lte":
+				n += field.step
+			}
+			value = strconv.FormatFloat(math.Round(n*100)/100, 'f', -1, 64)
+		case "date":
+			if r.Modifier == "before" || r.Modifier == "after" {
+				if _, err := time.Parse("2006-01-02", value); err != nil {
+					return FilterCondition{}, fmt.Errorf("invalid %s date %q", r.Attribute, value)
+				}
+				break
+			}
+
+			// Without a modifier a date attribute matches the last number of days
+			days, err := strconv.Atoi(value)
+			if err != nil || days <= 0 {
+				return FilterCondition{}, fmt.Errorf("invalid %s days %q", r.Attribute, value)
+			}
+			if len(r.Values) > 1 {
+				return FilterCondition{}, fmt.Errorf("%s only accepts one number of days", r.Attribute)
+			}
+			if r.Modifier == "not" {
+				return NotInTheLast(field.field, time.Duration(days)*24*time.Hour), nil
+			}
+			return InTheLast(field.field, time.Duration(days)*24*time.Hour), nil
+		}
+		values = append(values, value)
+	}
+
+	// Plex matches any of several comma-separated values
+	return Cond(field.field, op, strings.Join(values, ",")), nil
+}
+
+// kometaString returns a scalar config value
+func kometaString(value interface{}) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a single value, got %v", value)
+	}
+	return s, nil
+}
+
+// kometaStrings returns a config value that may be a single value, a list or a comma-separated list
+func kometaStrings(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		values := []string{}
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("missing value")
+		}
+		return values, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected a list of values")
+			}
+			values = append(values, s)
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("missing value")
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("expected a value or a list of values")
+	}
+}
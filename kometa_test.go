@@ -0,0 +1,235 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseYAML(t *testing.T) {
+	doc, err := parseYAML([]byte(`---
+# Collections
+collections:
+  "Sci-Fi: Classics":   # quoted key
+    summary: >
+      Classic science
+      fiction films
+    genres: [Sci-Fi, 'Rock ''n'' Roll', "A, B"]
+    list:
+    - one
+    - two # comment
+    maps:
+      - title: x
+        year: 1999
+      - title: y
+    url: https://example.com/a#b
+    script: |
+      line 1
+        line 2
+`))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	collections, _ := doc.get("collections")
+	definition, ok := collections.(*yamlMap).get("Sci-Fi: Classics")
+	if !ok {
+		t.Fatalf("Expected the quoted key to be parsed, got: %v", collections.(*yamlMap).keys)
+	}
+	m := definition.(*yamlMap)
+
+	expected := map[string]interface{}{
+		"summary": "Classic science fiction films",
+		"genres":  []interface{}{"Sci-Fi", "Rock 'n' Roll", "A, B"},
+		"list":    []interface{}{"one", "two"},
+		"url":     "https://example.com/a#b",
+		"script":  "line 1\n  line 2",
+	}
+	for key, value := range expected {
+		if got, _ := m.get(key); !reflect.DeepEqual(got, value) {
+			t.Errorf("Expected %s to be %#v, got: %#v", key, value, got)
+		}
+	}
+
+	maps, _ := m.get("maps")
+	if list, ok := maps.([]interface{}); !ok || len(list) != 2 || list[0].(*yamlMap).values["year"] != "1999" || list[1].(*yamlMap).values["title"] != "y" {
+		t.Errorf("Unexpected list of mappings: %#v", maps)
+	}
+
+	invalid := []string{
+		"a: 1\n  b: 2",
+		"a: 1\na: 2",
+		"a:\n\t- b",
+		"a: {b: 1}",
+		"- a",
+	}
+	for _, doc := range invalid {
+		if _, err := parseYAML([]byte(doc)); err == nil {
+			t.Errorf("Expected an error parsing %q, got nil", doc)
+		}
+	}
+}
+
+func TestLoadKometaCollections(t *testing.T) {
+	collections, err := LoadKometaCollections(strings.NewReader(`
+collections:
+  Modern Action:
+    plex_search:
+      all:
+        genre: Action
+        year.gte: 2000
+        added: 90
+      sort_by: critic_rating.desc
+      limit: 50
+    summary: Action movies since 2000
+    url_poster: https://example.com/action.jpg
+    collection_mode: hide_items
+    collection_order: alpha
+    schedule: weekly(sunday)
+  Comedies or Dramas:
+    plex_search:
+      type: shows
+      any:
+        genre: [Comedy, Drama]
+`))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := []KometaCollection{
+		{
+			Title:     "Modern Action",
+			Summary:   "Action movies since 2000",
+			PosterURL: "https://example.com/action.jpg",
+			Mode:      CollectionModeHideItems,
+			Sort:      CollectionSortAlpha,
+			Schedule:  KometaSchedule{Frequency: "weekly", Weekday: time.Sunday},
+			Search: KometaSearch{
+				Rules: []KometaRule{
+					{Attribute: "genre", Values: []string{"Action"}},
+					{Attribute: "year", Modifier: "gte", Values: []string{"2000"}},
+					{Attribute: "added", Values: []string{"90"}},
+				},
+				Sort:  []FilterSort{{Field: "rating", Descending: true}},
+				Limit: 50,
+			},
+		},
+		{
+			Title:    "Comedies or Dramas",
+			Schedule: KometaSchedule{Frequency: "daily"},
+			Search: KometaSearch{
+				Type:  CollectionItemTypeShow,
+				Any:   true,
+				Rules: []KometaRule{{Attribute: "genre", Values: []string{"Comedy", "Drama"}}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(collections, expected) {
+		t.Errorf("Unexpected collections:\n%+v\nexpected:\n%+v", collections, expected)
+	}
+
+	unsupported := []string{
+		"collections:\n  A:\n    tmdb_collection: 1241\n",
+		"collections:\n  A:\n    plex_search:\n      all:\n        imdb_rating: 7\n",
+		"collections:\n  A:\n    summary: no builder\n",
+		"templates:\n  A:\n    summary: x\n",
+	}
+	for _, config := range unsupported {
+		if _, err := LoadKometaCollections(strings.NewReader(config)); err == nil {
+			t.Errorf("Expected an error loading %q, got nil", config)
+		}
+	}
+}
+
+func TestKometaScheduleDue(t *testing.T) {
+	sunday := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		schedule string
+		due      bool
+	}{
+		{"daily", true},
+		{"never", false},
+		{"weekly(sunday)", true},
+		{"weekly(monday)", false},
+		{"monthly(30)", true},
+		{"monthly(31)", true}, // June has no 31st, so it runs on the 30th
+		{"monthly(1)", false},
+	}
+
+	for _, tt := range tests {
+		schedule, err := ParseKometaSchedule(tt.schedule)
+		if err != nil {
+			t.Errorf("Expected no error parsing %s, got: %v", tt.schedule, err)
+			continue
+		}
+		if schedule.Due(sunday) != tt.due {
+			t.Errorf("Expected %s due=%v on %v", tt.schedule, tt.due, sunday)
+		}
+	}
+
+	if _, err := ParseKometaSchedule("hourly(5)"); err == nil {
+		t.Error("Expected an error for an unsupported schedule, got nil")
+	}
+}
+
+func TestRunKometaCollections(t *testing.T) {
+	var uri string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/genre":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"key":"55","title":"Action"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","title":"Die Hard","type":"movie"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Modern Action","smart":"1","librarySectionID":1,"type":"collection"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Modern Action","smart":"1","librarySectionID":1,"type":"collection"}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/7/items":
+			uri = r.URL.Query().Get("uri")
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("summary.value") != "Action movies since 2000" {
+				t.Errorf("Unexpected summary update: %s", r.URL.RawQuery)
+			}
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	collections, err := client.Collections.RunKometaCollections(context.Background(), 1, []KometaCollection{
+		{
+			Title:    "Modern Action",
+			Summary:  "Action movies since 2000",
+			Schedule: KometaSchedule{Frequency: "daily"},
+			Search: KometaSearch{Rules: []KometaRule{
+				{Attribute: "genre", Values: []string{"Action"}},
+				{Attribute: "year", Modifier: "gte", Values: []string{"2000"}},
+			}},
+		},
+		{
+			Title:    "Never Run",
+			Schedule: KometaSchedule{Frequency: "never"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(collections) != 1 || collections[0].RatingKey != "7" {
+		t.Errorf("Expected the existing collection to be updated, got: %+v", collections)
+	}
+
+	if !strings.HasSuffix(uri, "/library/sections/1/all?type=1&genre=55&year>>=1999") {
+		t.Errorf("Unexpected smart filter URI: %s", uri)
+	}
+}
@@ -0,0 +1,394 @@
+package plexgo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// yamlMap is a YAML mapping that keeps the order of its keys
+type yamlMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// yamlLine is a line of a YAML document with its comment stripped
+type yamlLine struct {
+	raw    string
+	text   string // trimmed content without the comment
+	indent int
+	blank  bool // empty or comment-only
+}
+
+// yamlParser parses the block-style subset of YAML used by Kometa configs: mappings, lists,
+// quoted and plain scalars, flow lists of scalars and literal (|) or folded (>) block scalars.
+// Scalars are returned as strings, lists as []interface{} and mappings as *yamlMap. Anchors,
+// aliases, tags, flow mappings and multiple documents are not supported.
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML parses a YAML document whose top level is a mapping
+func parseYAML(data []byte) (*yamlMap, error) {
+	p := &yamlParser{}
+	for i, raw := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		if i == 0 && strings.TrimSpace(raw) == "---" {
+			raw = ""
+		}
+
+		text := strings.TrimRight(stripYAMLComment(raw), " \t")
+		trimmed := strings.TrimLeft(text, " ")
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("line %d: tabs cannot be used for indentation", i+1)
+		}
+
+		p.lines = append(p.lines, yamlLine{
+			raw:    raw,
+			text:   trimmed,
+			indent: len(text) - len(trimmed),
+			blank:  trimmed == "",
+		})
+	}
+
+	line, ok := p.peek()
+	if !ok {
+		return &yamlMap{values: map[string]interface{}{}}, nil
+	}
+	if line.indent != 0 || isYAMLListItem(line.text) {
+		return nil, fmt.Errorf("line %d: the document must be a mapping", p.pos+1)
+	}
+
+	root, err := p.parseMap(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := p.peek(); ok {
+		return nil, fmt.Errorf("line %d: unexpected indentation", p.pos+1)
+	}
+
+	return root, nil
+}
+
+// peek returns the next non-blank line without consuming it
+func (p *yamlParser) peek() (yamlLine, bool) {
+	for p.pos < len(p.lines) && p.lines[p.pos].blank {
+		p.pos++
+	}
+	if p.pos >= len(p.lines) {
+		return yamlLine{}, false
+	}
+	return p.lines[p.pos], true
+}
+
+// parseBlock parses the mapping or list starting at the next line
+func (p *yamlParser) parseBlock(indent int) (interface{}, error) {
+	line, _ := p.peek()
+	if isYAMLListItem(line.text) {
+		return p.parseList(indent)
+	}
+	return p.parseMap(indent)
+}
+
+// parseMap parses the keys of a mapping at the given indentation
+func (p *yamlParser) parseMap(indent int) (*yamlMap, error) {
+	m := &yamlMap{values: map[string]interface{}{}}
+
+	for {
+		line, ok := p.peek()
+		if !ok || line.indent < indent {
+			return m, nil
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", p.pos+1)
+		}
+		if isYAMLListItem(line.text) {
+			return nil, fmt.Errorf("line %d: expected a key, got a list item", p.pos+1)
+		}
+
+		key, rest, err := splitYAMLKey(line.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", p.pos+1, err)
+		}
+		if _, ok := m.values[key]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key %q", p.pos+1, key)
+		}
+		p.pos++
+
+		value, err := p.parseValue(rest, indent)
+		if err != nil {
+			return nil, err
+		}
+
+		m.keys = append(m.keys, key)
+		m.values[key] = value
+	}
+}
+
+// parseList parses the items of a list at the given indentation
+func (p *yamlParser) parseList(indent int) ([]interface{}, error) {
+	list := []interface{}{}
+
+	for {
+		line, ok := p.peek()
+		if !ok || line.indent < indent || (line.indent == indent && !isYAMLListItem(line.text)) {
+			return list, nil
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", p.pos+1)
+		}
+
+		rest := strings.TrimLeft(strings.TrimPrefix(line.text, "-"), " ")
+
+		// A mapping that starts on the same line as its dash, e.g. "- title: x", continues at the
+		// column of its first key
+		if rest != "" && rest[0] != '"' && rest[0] != '\'' && rest[0] != '[' && (strings.Contains(rest, ": ") || strings.HasSuffix(rest, ":")) {
+			itemIndent := indent + len(line.text) - len(rest)
+			p.lines[p.pos].text = rest
+			p.lines[p.pos].indent = itemIndent
+
+			item, err := p.parseMap(itemIndent)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+			continue
+		}
+
+		p.pos++
+		item, err := p.parseValue(rest, indent)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, item)
+	}
+}
+
+// parseValue parses the value after a key or a list dash, which may be a nested block
+func (p *yamlParser) parseValue(rest string, indent int) (interface{}, error) {
+	switch {
+	case rest == "":
+		next, ok := p.peek()
+		if ok && (next.indent > indent || (next.indent == indent && isYAMLListItem(next.text))) {
+			return p.parseBlock(next.indent)
+		}
+		return "", nil
+	case rest == "|" || rest == "|-" || rest == ">" || rest == ">-":
+		return p.parseBlockScalar(indent, rest[0] == '>'), nil
+	case strings.HasPrefix(rest, "["):
+		value, err := parseYAMLFlowList(rest)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", p.pos, err)
+		}
+		return value, nil
+	case strings.HasPrefix(rest, "{") || strings.HasPrefix(rest, "&") || strings.HasPrefix(rest, "*") || strings.HasPrefix(rest, "!"):
+		return nil, fmt.Errorf("line %d: unsupported YAML syntax %q", p.pos, rest)
+	default:
+		value, err := parseYAMLScalar(rest)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", p.pos, err)
+		}
+		return value, nil
+	}
+}
+
+// parseBlockScalar parses the lines of a literal or folded block scalar indented beyond indent.
+// Trailing newlines are always stripped.
+func (p *yamlParser) parseBlockScalar(indent int, folded bool) string {
+	var lines []string
+	blockIndent := -1
+
+	for ; p.pos < len(p.lines); p.pos++ {
+		raw := strings.TrimRight(p.lines[p.pos].raw, " \t")
+		trimmed := strings.TrimLeft(raw, " ")
+		if trimmed == "" {
+			lines = append(lines, "")
+			continue
+		}
+
+		lineIndent := len(raw) - len(trimmed)
+		if lineIndent <= indent {
+			break
+		}
+		if blockIndent < 0 {
+			blockIndent = lineIndent
+		}
+		if lineIndent < blockIndent {
+			blockIndent = lineIndent
+		}
+		lines = append(lines, raw[blockIndent:])
+	}
+
+	// Blank lines consumed after the block belong to the following content
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if !folded {
+		return strings.Join(lines, "\n")
+	}
+
+	var b strings.Builder
+	for i, line := range lines {
+		switch {
+		case line == "":
+			b.WriteString("\n")
+		case i > 0 && lines[i-1] != "":
+			b.WriteString(" " + line)
+		default:
+			b.WriteString(line)
+		}
+	}
+	return b.String()
+}
+
+// get returns the value of a key
+func (m *yamlMap) get(key string) (interface{}, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// isYAMLListItem returns true if the line is a list item
+func isYAMLListItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitYAMLKey splits a "key: value" line into its key and the remaining value text
+func splitYAMLKey(text string) (string, string, error) {
+	if text[0] == '"' || text[0] == '\'' {
+		end := closingYAMLQuote(text)
+		if end < 0 {
+			return "", "", fmt.Errorf("unterminated quoted key")
+		}
+
+		key, err := parseYAMLScalar(text[:end+1])
+		if err != nil {
+			return "", "", err
+		}
+
+		rest := strings.TrimLeft(text[end+1:], " ")
+		if !strings.HasPrefix(rest, ":") {
+			return "", "", fmt.Errorf("expected ':' after key %q", key)
+		}
+		return key, strings.TrimSpace(rest[1:]), nil
+	}
+
+	idx := strings.Index(text, ": ")
+	if idx < 0 {
+		if !strings.HasSuffix(text, ":") {
+			return "", "", fmt.Errorf("expected \"key: value\", got %q", text)
+		}
+		idx = len(text) - 1
+	}
+
+	return strings.TrimSpace(text[:idx]), strings.TrimSpace(text[idx+1:]), nil
+}
+
+// parseYAMLScalar parses a plain or quoted scalar
+func parseYAMLScalar(text string) (string, error) {
+	switch {
+	case strings.HasPrefix(text, "\""):
+		value, err := strconv.Unquote(text)
+		if err != nil {
+			return "", fmt.Errorf("invalid double-quoted string %s", text)
+		}
+		return value, nil
+	case strings.HasPrefix(text, "'"):
+		if len(text) < 2 || !strings.HasSuffix(text, "'") {
+			return "", fmt.Errorf("invalid single-quoted string %s", text)
+		}
+		return strings.ReplaceAll(text[1:len(text)-1], "''", "'"), nil
+	case text == "~" || text == "null":
+		return "", nil
+	default:
+		return text, nil
+	}
+}
+
+// parseYAMLFlowList parses a flow list of scalars, e.g. [Action, "Sci-Fi & Fantasy"]
+func parseYAMLFlowList(text string) ([]interface{}, error) {
+	if !strings.HasSuffix(text, "]") {
+		return nil, fmt.Errorf("unterminated flow list %s", text)
+	}
+
+	list := []interface{}{}
+	inner := strings.TrimSpace(text[1 : len(text)-1])
+	if inner == "" {
+		return list, nil
+	}
+
+	var quote byte
+	start := 0
+	for i := 0; i <= len(inner); i++ {
+		if i < len(inner) {
+			c := inner[i]
+			if quote != 0 {
+				if c == quote && (quote == '\'' || inner[i-1] != '\\') {
+					quote = 0
+				}
+				continue
+			}
+			if c == '"' || c == '\'' {
+				quote = c
+				continue
+			}
+			if c == '[' || c == '{' {
+				return nil, fmt.Errorf("nested flow collections are not supported: %s", text)
+			}
+			if c != ',' {
+				continue
+			}
+		}
+
+		value, err := parseYAMLScalar(strings.TrimSpace(inner[start:i]))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, value)
+		start = i + 1
+	}
+
+	return list, nil
+}
+
+// closingYAMLQuote returns the index of the quote closing the quoted string at the start of text, or -1
+func closingYAMLQuote(text string) int {
+	quote := text[0]
+	for i := 1; i < len(text); i++ {
+		switch {
+		case quote == '"' && text[i] == '\\':
+			i++
+		case quote == '\'' && text[i] == '\'' && i+1 < len(text) && text[i+1] == '\'':
+			i++
+		case text[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// stripYAMLComment removes a trailing comment, ignoring '#' inside quoted strings and plain
+// scalars such as URLs with fragments
+func stripYAMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if quote == '"' && c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			// Quotes only start a string at the start of a value
+			if i == 0 || strings.ContainsRune(" [,:-", rune(line[i-1])) {
+				quote = c
+			}
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
@@ -0,0 +1,102 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// LanguageHeader selects the language of localized metadata in responses
+const LanguageHeader = "X-Plex-Language"
+
+// WithLanguage sends X-Plex-Language with every request, so the server returns titles, summaries
+// and other localized metadata in the language with the given tag, e.g. "de" or "pt-BR". Calls
+// can use a different language with WithOperationLanguage.
+func WithLanguage(tag string) SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.PlexLanguage = strings.TrimSpace(tag)
+	}
+}
+
+// WithOperationLanguage requests localized metadata in the language with the given tag, e.g. "de"
+// or "pt-BR", overriding the language set on the client. The headers are copied first, so a map
+// passed to operations.WithSetHeaders is left unchanged.
+func WithOperationLanguage(tag string) operations.Option {
+	return func(opts *operations.Options, supportedOptions ...string) error {
+		headers := make(map[string]string, len(opts.SetHeaders)+1)
+		for name, value := range opts.SetHeaders {
+			headers[name] = value
+		}
+		headers[LanguageHeader] = tag
+		opts.SetHeaders = headers
+		return nil
+	}
+}
+
+// languageMiddleware sets X-Plex-Language on requests that don't have a per-call language
+func languageMiddleware(tag string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(LanguageHeader) == "" {
+				req = req.Clone(req.Context())
+				req.Header.Set(LanguageHeader, tag)
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// setLanguage sets the per-call language of WithOperationLanguage on a request
+func setLanguage(req *http.Request, options *helperOptions) {
+	if tag := options.SetHeaders[LanguageHeader]; tag != "" {
+		req.Header.Set(LanguageHeader, tag)
+	}
+}
+
+// requestLanguage returns the language metadata is requested in, "" for the server's default
+func (c *sdkConfiguration) requestLanguage(options *helperOptions) string {
+	if tag := options.SetHeaders[LanguageHeader]; tag != "" {
+		return tag
+	}
+	return c.PlexLanguage
+}
+
+// LocalizedMetadata is the localized text of an item in one language
+type LocalizedMetadata struct {
+	Language      string `json:"language"`
+	Title         string `json:"title"`
+	TitleSort     string `json:"titleSort,omitempty"`
+	OriginalTitle string `json:"originalTitle,omitempty"`
+	Summary       string `json:"summary,omitempty"`
+}
+
+// GetLocalizedMetadata fetches the title and summary of an item in each of the given languages, in
+// order, e.g. to update per-language collections or notify each member of a household in their
+// own language
+func (s *Library) GetLocalizedMetadata(ctx context.Context, ratingKey int, languages []string, opts ...operations.Option) ([]LocalizedMetadata, error) {
+	if len(languages) == 0 {
+		return nil, fmt.Errorf("no languages given")
+	}
+
+	localized := make([]LocalizedMetadata, 0, len(languages))
+	for _, language := range languages {
+		languageOpts := append(append([]operations.Option{}, opts...), WithOperationLanguage(language))
+		item, err := s.GetItem(ctx, ratingKey, languageOpts...)
+		if err != nil {
+			return localized, fmt.Errorf("error getting %s metadata: %w", language, err)
+		}
+
+		localized = append(localized, LocalizedMetadata{
+			Language:      language,
+			Title:         item.Title,
+			TitleSort:     item.TitleSort,
+			OriginalTitle: item.OriginalTitle,
+			Summary:       item.Summary,
+		})
+	}
+
+	return localized, nil
+}
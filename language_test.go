@@ -0,0 +1,141 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestWithLanguage(t *testing.T) {
+	titles := map[string]string{"": "Spirited Away", "de": "Chihiros Reise ins Zauberland", "ja": "千と千尋の神隠し"}
+	var languages []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		language := r.Header.Get("X-Plex-Language")
+		languages = append(languages, language)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/metadata/101":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","type":"movie","title":"` + titles[language] + `","originalTitle":"千と千尋の神隠し"}]}}`))
+		case "/library/sections":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"Directory":[]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	client := New(WithServerURL(server.URL), WithLanguage("de"))
+
+	item, err := client.Library.GetItem(ctx, 101)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if item.Title != titles["de"] || item.Language != "de" {
+		t.Errorf("Expected the German title, got: %q (%q)", item.Title, item.Language)
+	}
+
+	item, err = client.Library.GetItem(ctx, 101, WithOperationLanguage("ja"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if item.Title != titles["ja"] || item.Language != "ja" {
+		t.Errorf("Expected the per-call language to override the client's, got: %q (%q)", item.Title, item.Language)
+	}
+
+	// Generated operations send the languages too, without changing the caller's headers
+	shared := map[string]string{"X-Tool": "sync"}
+	if _, err := client.Library.GetAllLibraries(ctx, operations.WithSetHeaders(shared), WithOperationLanguage("ja")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(shared) != 1 {
+		t.Errorf("Expected the caller's headers to be unchanged, got: %v", shared)
+	}
+	if _, err := client.Library.GetAllLibraries(ctx); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := []string{"de", "ja", "ja", "de"}
+	if len(languages) != len(expected) {
+		t.Fatalf("Expected languages %v, got: %v", expected, languages)
+	}
+	for i := range expected {
+		if languages[i] != expected[i] {
+			t.Errorf("Expected languages %v, got: %v", expected, languages)
+			break
+		}
+	}
+
+	// Without a language the server's default is used
+	languages = nil
+	item, err = New(WithServerURL(server.URL)).Library.GetItem(ctx, 101)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if item.Title != titles[""] || item.Language != "" || languages[0] != "" {
+		t.Errorf("Expected the default title, got: %q (%q)", item.Title, item.Language)
+	}
+}
+
+func TestGetLocalizedMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Header.Get("X-Plex-Language") {
+		case "fr":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","title":"Le Voyage de Chihiro","summary":"Chihiro, dix ans..."}]}}`))
+		case "es":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","title":"El viaje de Chihiro","summary":"Chihiro, de diez años..."}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithLanguage("en"))
+
+	localized, err := client.Library.GetLocalizedMetadata(context.Background(), 101, []string{"fr", "es"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(localized) != 2 || localized[0].Language != "fr" || localized[0].Title != "Le Voyage de Chihiro" ||
+		localized[1].Language != "es" || localized[1].Summary != "Chihiro, de diez años..." {
+		t.Errorf("Unexpected localized metadata: %+v", localized)
+	}
+
+	localized, err = client.Library.GetLocalizedMetadata(context.Background(), 101, []string{"fr", "it"})
+	if err == nil || len(localized) != 1 {
+		t.Errorf("Expected the languages fetched before the error, got: %+v, %v", localized, err)
+	}
+}
+
+func TestOperationLanguageRemoveFromCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if language := r.Header.Get("X-Plex-Language"); language != "ja" {
+			t.Errorf("Expected the per-call language on %s %s, got: %q", r.Method, r.URL.Path, language)
+		}
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/14":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"14","title":"Test Collection","type":"collection"}]}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/library/collections/14/items/101":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithLanguage("de"))
+
+	if err := client.Collections.RemoveFromCollection(context.Background(), 14, []string{"101"}, WithOperationLanguage("ja"), WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+}
@@ -65,10 +65,7 @@ func (s *Library) GetFileHash(ctx context.Context, url_ string, type_ *float64,
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -95,13 +92,7 @@ func (s *Library) GetFileHash(ctx context.Context, url_ string, type_ *float64,
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -241,13 +232,13 @@ func (s *Library) GetFileHash(ctx context.Context, url_ string, type_ *float64,
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -294,10 +285,7 @@ func (s *Library) GetRecentlyAddedLibrary(ctx context.Context, request operation
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -324,13 +312,7 @@ func (s *Library) GetRecentlyAddedLibrary(ctx context.Context, request operation
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -429,7 +411,7 @@ func (s *Library) GetRecentlyAddedLibrary(ctx context.Context, request operation
 			}
 
 			var out operations.GetRecentlyAddedLibraryResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -490,13 +472,13 @@ func (s *Library) GetRecentlyAddedLibrary(ctx context.Context, request operation
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -548,10 +530,7 @@ func (s *Library) GetAllLibraries(ctx context.Context, opts ...operations.Option
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -574,13 +553,7 @@ func (s *Library) GetAllLibraries(ctx context.Context, opts ...operations.Option
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -679,7 +652,7 @@ func (s *Library) GetAllLibraries(ctx context.Context, opts ...operations.Option
 			}
 
 			var out operations.GetAllLibrariesResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -740,13 +713,13 @@ func (s *Library) GetAllLibraries(ctx context.Context, opts ...operations.Option
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -836,10 +809,7 @@ func (s *Library) GetLibraryDetails(ctx context.Context, sectionKey int, include
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -866,13 +836,7 @@ func (s *Library) GetLibraryDetails(ctx context.Context, sectionKey int, include
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -971,7 +935,7 @@ func (s *Library) GetLibraryDetails(ctx context.Context, sectionKey int, include
 			}
 
 			var out operations.GetLibraryDetailsResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -1032,13 +996,13 @@ func (s *Library) GetLibraryDetails(ctx context.Context, sectionKey int, include
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -1089,10 +1053,7 @@ func (s *Library) DeleteLibrary(ctx context.Context, sectionKey int, opts ...ope
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "DELETE", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -1115,13 +1076,7 @@ func (s *Library) DeleteLibrary(ctx context.Context, sectionKey int, opts ...ope
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -1261,13 +1216,13 @@ func (s *Library) DeleteLibrary(ctx context.Context, sectionKey int, opts ...ope
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -1334,10 +1289,7 @@ func (s *Library) GetLibraryItems(ctx context.Context, request operations.GetLib
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -1364,13 +1316,7 @@ func (s *Library) GetLibraryItems(ctx context.Context, request operations.GetLib
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -1469,7 +1415,7 @@ func (s *Library) GetLibraryItems(ctx context.Context, request operations.GetLib
 			}
 
 			var out operations.GetLibraryItemsResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -1530,13 +1476,13 @@ func (s *Library) GetLibraryItems(ctx context.Context, request operations.GetLib
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -1583,10 +1529,7 @@ func (s *Library) GetAllMediaLibrary(ctx context.Context, request operations.Get
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -1613,13 +1556,7 @@ func (s *Library) GetAllMediaLibrary(ctx context.Context, request operations.Get
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -1718,7 +1655,7 @@ func (s *Library) GetAllMediaLibrary(ctx context.Context, request operations.Get
 			}
 
 			var out operations.GetAllMediaLibraryResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -1781,13 +1718,13 @@ func (s *Library) GetAllMediaLibrary(ctx context.Context, request operations.Get
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -1839,10 +1776,7 @@ func (s *Library) GetRefreshLibraryMetadata(ctx context.Context, sectionKey int,
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -1869,13 +1803,7 @@ func (s *Library) GetRefreshLibraryMetadata(ctx context.Context, sectionKey int,
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -2015,13 +1943,13 @@ func (s *Library) GetRefreshLibraryMetadata(ctx context.Context, sectionKey int,
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -2090,10 +2018,7 @@ func (s *Library) GetSearchLibrary(ctx context.Context, sectionKey int, type_ op
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -2120,13 +2045,7 @@ func (s *Library) GetSearchLibrary(ctx context.Context, sectionKey int, type_ op
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -2225,7 +2144,7 @@ func (s *Library) GetSearchLibrary(ctx context.Context, sectionKey int, type_ op
 			}
 
 			var out operations.GetSearchLibraryResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -2286,13 +2205,13 @@ func (s *Library) GetSearchLibrary(ctx context.Context, sectionKey int, type_ op
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -2344,10 +2263,7 @@ func (s *Library) GetGenresLibrary(ctx context.Context, sectionKey int, type_ op
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -2374,13 +2290,7 @@ func (s *Library) GetGenresLibrary(ctx context.Context, sectionKey int, type_ op
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -2479,7 +2389,7 @@ func (s *Library) GetGenresLibrary(ctx context.Context, sectionKey int, type_ op
 			}
 
 			var out operations.GetGenresLibraryResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -2542,13 +2452,13 @@ func (s *Library) GetGenresLibrary(ctx context.Context, sectionKey int, type_ op
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -2600,10 +2510,7 @@ func (s *Library) GetCountriesLibrary(ctx context.Context, sectionKey int, type_
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -2630,13 +2537,7 @@ func (s *Library) GetCountriesLibrary(ctx context.Context, sectionKey int, type_
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -2735,7 +2636,7 @@ func (s *Library) GetCountriesLibrary(ctx context.Context, sectionKey int, type_
 			}
 
 			var out operations.GetCountriesLibraryResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -2798,13 +2699,13 @@ func (s *Library) GetCountriesLibrary(ctx context.Context, sectionKey int, type_
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -2856,10 +2757,7 @@ func (s *Library) GetActorsLibrary(ctx context.Context, sectionKey int, type_ op
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -2886,13 +2784,7 @@ func (s *Library) GetActorsLibrary(ctx context.Context, sectionKey int, type_ op
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -2991,7 +2883,7 @@ func (s *Library) GetActorsLibrary(ctx context.Context, sectionKey int, type_ op
 			}
 
 			var out operations.GetActorsLibraryResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -3054,13 +2946,13 @@ func (s *Library) GetActorsLibrary(ctx context.Context, sectionKey int, type_ op
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -3107,10 +2999,7 @@ func (s *Library) GetSearchAllLibraries(ctx context.Context, request operations.
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -3139,13 +3028,7 @@ func (s *Library) GetSearchAllLibraries(ctx context.Context, request operations.
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -3244,7 +3127,7 @@ func (s *Library) GetSearchAllLibraries(ctx context.Context, request operations.
 			}
 
 			var out operations.GetSearchAllLibrariesResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -3305,13 +3188,13 @@ func (s *Library) GetSearchAllLibraries(ctx context.Context, request operations.
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -3358,10 +3241,7 @@ func (s *Library) GetMediaMetaData(ctx context.Context, request operations.GetMe
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -3388,13 +3268,7 @@ func (s *Library) GetMediaMetaData(ctx context.Context, request operations.GetMe
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -3493,7 +3367,7 @@ func (s *Library) GetMediaMetaData(ctx context.Context, request operations.GetMe
 			}
 
 			var out operations.GetMediaMetaDataResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -3556,13 +3430,13 @@ func (s *Library) GetMediaMetaData(ctx context.Context, request operations.GetMe
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -3613,10 +3487,7 @@ func (s *Library) GetMediaArts(ctx context.Context, ratingKey int64, opts ...ope
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -3639,13 +3510,7 @@ func (s *Library) GetMediaArts(ctx context.Context, ratingKey int64, opts ...ope
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -3744,7 +3609,7 @@ func (s *Library) GetMediaArts(ctx context.Context, ratingKey int64, opts ...ope
 			}
 
 			var out operations.GetMediaArtsResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -3763,13 +3628,13 @@ func (s *Library) GetMediaArts(ctx context.Context, ratingKey int64, opts ...ope
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -3826,10 +3691,7 @@ func (s *Library) PostMediaArts(ctx context.Context, ratingKey int64, url_ *stri
 		return nil, err
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -3859,13 +3721,7 @@ func (s *Library) PostMediaArts(ctx context.Context, ratingKey int64, url_ *stri
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -3963,13 +3819,13 @@ func (s *Library) PostMediaArts(ctx context.Context, ratingKey int64, url_ *stri
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -4020,10 +3876,7 @@ func (s *Library) GetMediaPosters(ctx context.Context, ratingKey int64, opts ...
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -4046,13 +3899,7 @@ func (s *Library) GetMediaPosters(ctx context.Context, ratingKey int64, opts ...
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -4151,7 +3998,7 @@ func (s *Library) GetMediaPosters(ctx context.Context, ratingKey int64, opts ...
 			}
 
 			var out operations.GetMediaPostersResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -4170,13 +4017,13 @@ func (s *Library) GetMediaPosters(ctx context.Context, ratingKey int64, opts ...
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -4233,10 +4080,7 @@ func (s *Library) PostMediaPoster(ctx context.Context, ratingKey int64, url_ *st
 		return nil, err
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -4266,13 +4110,7 @@ func (s *Library) PostMediaPoster(ctx context.Context, ratingKey int64, url_ *st
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -4370,13 +4208,13 @@ func (s *Library) PostMediaPoster(ctx context.Context, ratingKey int64, url_ *st
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -4428,10 +4266,7 @@ func (s *Library) GetMetadataChildren(ctx context.Context, ratingKey float64, in
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -4458,13 +4293,7 @@ func (s *Library) GetMetadataChildren(ctx context.Context, ratingKey float64, in
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -4563,7 +4392,7 @@ func (s *Library) GetMetadataChildren(ctx context.Context, ratingKey float64, in
 			}
 
 			var out operations.GetMetadataChildrenResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -4624,13 +4453,13 @@ func (s *Library) GetMetadataChildren(ctx context.Context, ratingKey float64, in
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -4682,10 +4511,7 @@ func (s *Library) GetTopWatchedContent(ctx context.Context, type_ operations.Get
 		SecuritySource: s.sdkConfiguration.Security,
 	}
 
-	timeout := o.Timeout
-	if timeout == nil {
-		timeout = s.sdkConfiguration.Timeout
-	}
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", o.Timeout)
 
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -4712,13 +4538,7 @@ func (s *Library) GetTopWatchedContent(ctx context.Context, type_ operations.Get
 		req.Header.Set(k, v)
 	}
 
-	globalRetryConfig := s.sdkConfiguration.RetryConfig
-	retryConfig := o.Retries
-	if retryConfig == nil {
-		if globalRetryConfig != nil {
-			retryConfig = globalRetryConfig
-		}
-	}
+	retryConfig := s.sdkConfiguration.retryConfigFor(hookCtx.OperationID, req.Method, o.Retries)
 
 	var httpRes *http.Response
 	if retryConfig != nil {
@@ -4817,7 +4637,7 @@ func (s *Library) GetTopWatchedContent(ctx context.Context, type_ operations.Get
 			}
 
 			var out operations.GetTopWatchedContentResponseBody
-			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+			if err := s.sdkConfiguration.unmarshalResponse(hookCtx.OperationID, rawBody, &out); err != nil {
 				return nil, err
 			}
 
@@ -4878,13 +4698,13 @@ func (s *Library) GetTopWatchedContent(ctx context.Context, type_ operations.Get
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	case httpRes.StatusCode >= 500 && httpRes.StatusCode < 600:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
 			return nil, err
 		}
-		return nil, sdkerrors.NewSDKError("API error occurred", httpRes.StatusCode, string(rawBody), httpRes)
+		return nil, sdkerrors.NewAPIError(httpRes.StatusCode, string(rawBody), httpRes)
 	default:
 		rawBody, err := utils.ConsumeRawBody(httpRes)
 		if err != nil {
@@ -0,0 +1,138 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// LibraryDiffEntry is an item found in only one of two compared sections, or in both with
+// different versions
+type LibraryDiffEntry struct {
+	GUID       string
+	Title      string
+	Year       int
+	RatingKeyA string   // "" if the item is missing from section A
+	RatingKeyB string   // "" if the item is missing from section B
+	VersionsA  []string // Resolution and codec of each version in section A, e.g. "1080 h264"
+	VersionsB  []string
+}
+
+// LibraryDiff is the difference between the items of two library sections
+type LibraryDiff struct {
+	Missing    []LibraryDiffEntry // In section A but not in section B
+	Extra      []LibraryDiffEntry // In section B but not in section A
+	Mismatched []LibraryDiffEntry // In both, with different versions
+	Matched    int                // Items in both with the same versions
+}
+
+// Diff compares the items of a library section with a section of another server, e.g. to verify a
+// migration or a backup. Items are matched by GUID, or by an external ID such as "tmdb://603" if
+// the servers use different agents. Items in both sections are compared by the resolution and
+// codec of their versions; shows and artists, which have no versions, are only matched.
+func (s *Library) Diff(ctx context.Context, other *PlexAPI, sectionA int, sectionB int, opts ...operations.Option) (*LibraryDiff, error) {
+	itemsA, err := s.ListItems(ctx, sectionA, ItemListOptions{IncludeGUIDs: true}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing items of section %d: %w", sectionA, err)
+	}
+
+	// Options such as WithServerURL target this server, not the other one
+	itemsB, err := other.Library.ListItems(ctx, sectionB, ItemListOptions{IncludeGUIDs: true}, withoutServerURL(opts)...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing items of section %d of the other server: %w", sectionB, err)
+	}
+
+	// Items of section B by GUID and by external ID
+	indexB := make(map[string]int, len(itemsB))
+	for i, item := range itemsB {
+		for _, id := range itemIDs(item) {
+			if _, ok := indexB[id]; !ok {
+				indexB[id] = i
+			}
+		}
+	}
+
+	diff := &LibraryDiff{
+		Missing:    []LibraryDiffEntry{},
+		Extra:      []LibraryDiffEntry{},
+		Mismatched: []LibraryDiffEntry{},
+	}
+	matchedB := make([]bool, len(itemsB))
+
+	for _, item := range itemsA {
+		entry := LibraryDiffEntry{
+			GUID:       item.GUID,
+			Title:      item.Title,
+			Year:       item.Year,
+			RatingKeyA: item.RatingKey,
+			VersionsA:  itemVersions(item),
+		}
+
+		match := -1
+		for _, id := range itemIDs(item) {
+			if i, ok := indexB[id]; ok && !matchedB[i] {
+				match = i
+				break
+			}
+		}
+		if match < 0 {
+			diff.Missing = append(diff.Missing, entry)
+			continue
+		}
+
+		matchedB[match] = true
+		entry.RatingKeyB = itemsB[match].RatingKey
+		entry.VersionsB = itemVersions(itemsB[match])
+
+		if strings.Join(entry.VersionsA, ",") != strings.Join(entry.VersionsB, ",") {
+			diff.Mismatched = append(diff.Mismatched, entry)
+		} else {
+			diff.Matched++
+		}
+	}
+
+	for i, item := range itemsB {
+		if matchedB[i] {
+			continue
+		}
+		diff.Extra = append(diff.Extra, LibraryDiffEntry{
+			GUID:       item.GUID,
+			Title:      item.Title,
+			Year:       item.Year,
+			RatingKeyB: item.RatingKey,
+			VersionsB:  itemVersions(item),
+		})
+	}
+
+	return diff, nil
+}
+
+// itemIDs returns the IDs an item can be matched by across servers: its GUID, then its external IDs
+func itemIDs(item Metadata) []string {
+	ids := make([]string, 0, len(item.ExternalGUIDs)+1)
+	if item.GUID != "" {
+		ids = append(ids, item.GUID)
+	}
+	for _, guid := range item.ExternalGUIDs {
+		ids = append(ids, guid.ID)
+	}
+	return ids
+}
+
+// itemVersions returns the resolution and codec of each version of an item, or the audio codec
+// of tracks, sorted so the versions of two servers can be compared regardless of their order
+func itemVersions(item Metadata) []string {
+	versions := make([]string, 0, len(item.Media))
+	for _, media := range item.Media {
+		version := strings.TrimSpace(media.VideoResolution + " " + media.VideoCodec)
+		if version == "" {
+			version = media.AudioCodec // Tracks
+		}
+		versions = append(versions, strings.ToLower(version))
+	}
+	sort.Strings(versions)
+	return versions
+}
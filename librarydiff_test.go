@@ -0,0 +1,59 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLibraryDiff(t *testing.T) {
+	serverA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/library/sections/1/all" || r.URL.Query().Get("includeGuids") != "1" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.Write([]byte(`{"MediaContainer":{"totalSize":4,"Metadata":[
+			{"ratingKey":"1","guid":"plex://movie/1","title":"Heat","year":1995,"Media":[{"videoResolution":"1080","videoCodec":"h264"}]},
+			{"ratingKey":"2","guid":"plex://movie/2","title":"Ronin","year":1998,"Media":[{"videoResolution":"4k","videoCodec":"hevc"},{"videoResolution":"1080","videoCodec":"h264"}]},
+			{"ratingKey":"3","guid":"plex://movie/3","title":"The Matrix","year":1999,"Guid":[{"id":"tmdb://603"}],"Media":[{"videoResolution":"1080","videoCodec":"h264"}]},
+			{"ratingKey":"4","guid":"plex://movie/4","title":"Collateral","year":2004,"Media":[{"videoResolution":"1080","videoCodec":"h264"}]}
+		]}}`))
+	}))
+	defer serverA.Close()
+
+	serverB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/library/sections/5/all" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.Write([]byte(`{"MediaContainer":{"totalSize":4,"Metadata":[
+			{"ratingKey":"11","guid":"plex://movie/1","title":"Heat","year":1995,"Media":[{"videoResolution":"1080","videoCodec":"h264"}]},
+			{"ratingKey":"12","guid":"plex://movie/2","title":"Ronin","year":1998,"Media":[{"videoResolution":"1080","videoCodec":"h264"}]},
+			{"ratingKey":"13","guid":"com.plexapp.agents.imdb://tt0133093","title":"The Matrix","year":1999,"Guid":[{"id":"tmdb://603"}],"Media":[{"videoResolution":"1080","videoCodec":"h264"}]},
+			{"ratingKey":"15","guid":"plex://movie/5","title":"Thief","year":1981,"Media":[{"videoResolution":"720","videoCodec":"h264"}]}
+		]}}`))
+	}))
+	defer serverB.Close()
+
+	client := New(WithServerURL(serverA.URL))
+	other := New(WithServerURL(serverB.URL))
+
+	diff, err := client.Library.Diff(context.Background(), other, 1, 5)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if diff.Matched != 2 {
+		t.Errorf("Expected Heat and The Matrix to match, got: %d", diff.Matched)
+	}
+	if len(diff.Missing) != 1 || diff.Missing[0].Title != "Collateral" || diff.Missing[0].RatingKeyB != "" {
+		t.Errorf("Expected Collateral to be missing, got: %+v", diff.Missing)
+	}
+	if len(diff.Extra) != 1 || diff.Extra[0].RatingKeyB != "15" {
+		t.Errorf("Expected Thief to be extra, got: %+v", diff.Extra)
+	}
+	if len(diff.Mismatched) != 1 || diff.Mismatched[0].RatingKeyB != "12" || len(diff.Mismatched[0].VersionsA) != 2 || diff.Mismatched[0].VersionsA[1] != "4k hevc" {
+		t.Errorf("Expected the versions of Ronin to differ, got: %+v", diff.Mismatched)
+	}
+}
@@ -0,0 +1,336 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// libraryFilterValueSeparator separates the values of a sharing restriction field. plex.tv stores
+// and reports it URL encoded.
+const libraryFilterValueSeparator = "%2C"
+
+// LibraryFilter restricts the items of one kind of library (movies, TV or music) a shared or
+// managed user can see. Items must match all of the set fields. Build filters with the With and
+// Without methods, e.g. LibraryFilter{}.WithContentRating("G", "PG").WithoutLabel("adults").
+type LibraryFilter struct {
+	Labels                []string // Only show items with one of these labels
+	ExcludeLabels         []string // Hide items (and collections) with any of these labels
+	ContentRatings        []string // Only show items with one of these content ratings
+	ExcludeContentRatings []string // Hide items with any of these content ratings
+}
+
+// LibraryFilters are the sharing restrictions of a user. Nil filters are left unchanged, and
+// empty filters remove the restrictions.
+type LibraryFilters struct {
+	Movies     *LibraryFilter
+	Television *LibraryFilter
+	Music      *LibraryFilter
+}
+
+// WithLabel returns a copy of the filter that only shows items with one of the labels
+func (f LibraryFilter) WithLabel(labels ...string) LibraryFilter {
+	f.Labels = appendFilterValues(f.Labels, labels)
+	return f
+}
+
+// WithoutLabel returns a copy of the filter that hides items with any of the labels
+func (f LibraryFilter) WithoutLabel(labels ...string) LibraryFilter {
+	f.ExcludeLabels = appendFilterValues(f.ExcludeLabels, labels)
+	return f
+}
+
+// WithContentRating returns a copy of the filter that only shows items with one of the content ratings
+func (f LibraryFilter) WithContentRating(ratings ...string) LibraryFilter {
+	f.ContentRatings = appendFilterValues(f.ContentRatings, ratings)
+	return f
+}
+
+// WithoutContentRating returns a copy of the filter that hides items with any of the content ratings
+func (f LibraryFilter) WithoutContentRating(ratings ...string) LibraryFilter {
+	f.ExcludeContentRatings = appendFilterValues(f.ExcludeContentRatings, ratings)
+	return f
+}
+
+// IsEmpty returns true if the filter doesn't restrict anything
+func (f LibraryFilter) IsEmpty() bool {
+	return len(f.Labels) == 0 && len(f.ExcludeLabels) == 0 && len(f.ContentRatings) == 0 && len(f.ExcludeContentRatings) == 0
+}
+
+// Validate checks that the values of the filter can be represented in a sharing restriction.
+// Values cannot be empty or contain the '|', '=' or ',' separators.
+func (f LibraryFilter) Validate() error {
+	for _, field := range f.fields() {
+		for _, value := range field.values {
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("invalid %s filter: empty value", field.key)
+			}
+			if strings.ContainsAny(value, "|=,") || strings.Contains(value, libraryFilterValueSeparator) {
+				return fmt.Errorf("invalid %s filter value %q: values cannot contain '|', '=' or ','", field.key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseLibraryFilter parses a sharing restriction as reported by plex.tv in the FilterMovies,
+// FilterTelevision and FilterMusic fields of a user, e.g. "contentRating=G%2CPG|label!=adults".
+// Values may also be separated by plain commas.
+func ParseLibraryFilter(filter string) (LibraryFilter, error) {
+	var f LibraryFilter
+	if strings.TrimSpace(filter) == "" {
+		return f, nil
+	}
+
+	for _, part := range strings.Split(filter, "|") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return LibraryFilter{}, fmt.Errorf("invalid sharing restriction %q: expected key=values", part)
+		}
+
+		var values []string
+		for _, v := range strings.Split(strings.ReplaceAll(value, libraryFilterValueSeparator, ","), ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+
+		switch key {
+		case "label":
+			f.Labels = appendFilterValues(f.Labels, values)
+		case "label!":
+			f.ExcludeLabels = appendFilterValues(f.ExcludeLabels, values)
+		case "contentRating":
+			f.ContentRatings = appendFilterValues(f.ContentRatings, values)
+		case "contentRating!":
+			f.ExcludeContentRatings = appendFilterValues(f.ExcludeContentRatings, values)
+		default:
+			return LibraryFilter{}, fmt.Errorf("unsupported sharing restriction %q", key)
+		}
+	}
+
+	return f, nil
+}
+
+// String returns the filter in the form stored by plex.tv
+func (f LibraryFilter) String() string {
+	var parts []string
+	for _, field := range f.fields() {
+		if len(field.values) > 0 {
+			parts = append(parts, field.key+"="+strings.Join(field.values, libraryFilterValueSeparator))
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// libraryFilterField is a field of a sharing restriction with its values
+type libraryFilterField struct {
+	key    string
+	values []string
+}
+
+// fields returns the fields of the filter in the order plex.tv uses
+func (f LibraryFilter) fields() []libraryFilterField {
+	return []libraryFilterField{
+		{"contentRating", f.ContentRatings},
+		{"contentRating!", f.ExcludeContentRatings},
+		{"label", f.Labels},
+		{"label!", f.ExcludeLabels},
+	}
+}
+
+// appendFilterValues returns a new slice with the values appended, skipping duplicates
+func appendFilterValues(existing []string, values []string) []string {
+	result := make([]string, 0, len(existing)+len(values))
+	seen := make(map[string]bool, len(existing)+len(values))
+	for _, value := range append(append([]string{}, existing...), values...) {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// SetLibraryFilters sets the sharing restrictions of a shared or managed user, e.g. to hide the
+// items and collections carrying a label. The userID is the plex.tv ID from Users.GetUsers.
+func (s *Users) SetLibraryFilters(ctx context.Context, userID int64, filters LibraryFilters, opts ...operations.Option) error {
+	options := processOptions(opts)
+
+	baseURL := utils.ReplaceParameters(operations.GetUsersServerList[0], map[string]string{})
+	if options.ServerURL != nil {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, "/friends", strconv.FormatInt(userID, 10))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	for param, filter := range map[string]*LibraryFilter{
+		"filterMovies":     filters.Movies,
+		"filterTelevision": filters.Television,
+		"filterMusic":      filters.Music,
+	} {
+		if filter == nil {
+			continue
+		}
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+		queryParams.Set(param, filter.String())
+	}
+	if len(queryParams) == 0 {
+		return nil
+	}
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "setLibraryFilters",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		_, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HideFromUser hides a collection from a shared or managed user by labeling it and excluding the
+// label in the user's sharing restrictions. The user is as returned by Users.GetUsers, whose
+// existing restrictions are kept.
+func (s *Collections) HideFromUser(ctx context.Context, collectionID int, label string, user operations.User, opts ...operations.Option) error {
+	return s.setUserVisibility(ctx, collectionID, label, user, false, opts...)
+}
+
+// ShowToUser reverts HideFromUser, removing the label from the user's excluded labels. The
+// collection keeps the label, so it stays hidden from other users excluding it.
+func (s *Collections) ShowToUser(ctx context.Context, collectionID int, label string, user operations.User, opts ...operations.Option) error {
+	return s.setUserVisibility(ctx, collectionID, label, user, true, opts...)
+}
+
+// setUserVisibility adds or removes a collection label from the excluded labels of a user, for the
+// kind of library the collection is in
+func (s *Collections) setUserVisibility(ctx context.Context, collectionID int, label string, user operations.User, visible bool, opts ...operations.Option) error {
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting collection: %w", err)
+	}
+
+	if !visible {
+		if err := s.addCollectionLabel(ctx, collection, label, opts...); err != nil {
+			return fmt.Errorf("error labeling collection: %w", err)
+		}
+	}
+
+	var current *string
+	var filters LibraryFilters
+	filter := &LibraryFilter{}
+
+	switch collectionItemFamily(collectionItemTypeFromName(collection.SubType)) {
+	case CollectionItemTypeMovie:
+		current, filters.Movies = user.FilterMovies, filter
+	case CollectionItemTypeShow:
+		current, filters.Television = user.FilterTelevision, filter
+	case CollectionItemTypeArtist:
+		current, filters.Music = user.FilterMusic, filter
+	default:
+		return fmt.Errorf("sharing restrictions do not support collections of type %s", collection.SubType)
+	}
+
+	if current != nil {
+		if *filter, err = ParseLibraryFilter(*current); err != nil {
+			return fmt.Errorf("error reading the sharing restrictions of %s: %w", user.Username, err)
+		}
+	}
+
+	excluded := make([]string, 0, len(filter.ExcludeLabels)+1)
+	for _, existing := range filter.ExcludeLabels {
+		if existing != label {
+			excluded = append(excluded, existing)
+		}
+	}
+	if !visible {
+		excluded = append(excluded, label)
+	}
+	filter.ExcludeLabels = excluded
+
+	return newUsers(s.sdkConfiguration).SetLibraryFilters(ctx, user.ID, filters, withoutServerURL(opts)...)
+}
+
+// addCollectionLabel adds a label to a collection, keeping its existing labels
+func (s *Collections) addCollectionLabel(ctx context.Context, collection *Collection, label string, opts ...operations.Option) error {
+	if collectionHasLabel(collection, label) {
+		return nil
+	}
+
+	collectionID, err := collection.ID()
+	if err != nil {
+		return fmt.Errorf("error converting collection ID to int: %w", err)
+	}
+
+	args := map[string]string{"label.locked": "1"}
+	for i, tag := range collection.Label {
+		args[fmt.Sprintf("label[%d].tag.tag", i)] = tag.Tag
+	}
+	args[fmt.Sprintf("label[%d].tag.tag", len(collection.Label))] = label
+
+	return s.editCollection(ctx, collectionID, "labelCollection", args, opts...)
+}
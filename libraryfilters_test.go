@@ -0,0 +1,96 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestParseLibraryFilter(t *testing.T) {
+	filter, err := ParseLibraryFilter("label=kids%2Cfamily|contentRating!=R,NC-17")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(filter.Labels) != 2 || filter.Labels[1] != "family" {
+		t.Errorf("Expected labels kids and family, got: %v", filter.Labels)
+	}
+	if len(filter.ExcludeContentRatings) != 2 || filter.ExcludeContentRatings[1] != "NC-17" {
+		t.Errorf("Expected content ratings R and NC-17 to be excluded, got: %v", filter.ExcludeContentRatings)
+	}
+
+	if s := filter.String(); s != "contentRating!=R%2CNC-17|label=kids%2Cfamily" {
+		t.Errorf("Unexpected filter string: %s", s)
+	}
+
+	if _, err := ParseLibraryFilter("genre=horror"); err == nil {
+		t.Error("Expected an error for an unsupported restriction")
+	}
+}
+
+func TestLibraryFilterBuilder(t *testing.T) {
+	base := LibraryFilter{}.WithContentRating("G", "PG")
+	filter := base.WithContentRating("PG").WithoutLabel("adults")
+
+	if len(base.ExcludeLabels) != 0 {
+		t.Errorf("Expected the builder not to modify the original filter, got: %+v", base)
+	}
+	if s := filter.String(); s != "contentRating=G%2CPG|label!=adults" {
+		t.Errorf("Unexpected filter string: %s", s)
+	}
+	if err := filter.Validate(); err != nil {
+		t.Errorf("Expected a valid filter, got: %v", err)
+	}
+
+	if err := (LibraryFilter{}).WithLabel("kids|adults").Validate(); err == nil {
+		t.Error("Expected an error for a value containing a separator")
+	}
+	if !(LibraryFilter{}).IsEmpty() {
+		t.Error("Expected an empty filter")
+	}
+}
+
+func TestHideFromUser(t *testing.T) {
+	var labeled, filterMovies string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"7","title":"Horror","type":"collection","subtype":"movie","librarySectionID":1,"Label":[{"tag":"spooky"}]}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("label[0].tag.tag") != "spooky" {
+				t.Errorf("Expected the existing label to be kept, got: %s", r.URL.RawQuery)
+			}
+			labeled = r.URL.Query().Get("label[1].tag.tag")
+		case r.Method == "PUT" && r.URL.Path == "/api/friends/42":
+			if _, ok := r.URL.Query()["filterTelevision"]; ok {
+				t.Errorf("Expected only the movie filter to be set, got: %s", r.URL.RawQuery)
+			}
+			filterMovies = r.URL.Query().Get("filterMovies")
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithMiddleware(routePlexTV(server.URL)))
+
+	existing := "contentRating!=R"
+	user := operations.User{ID: 42, FilterMovies: &existing}
+
+	if err := client.Collections.HideFromUser(context.Background(), 7, "hidden-kids", user, operations.WithServerURL(server.URL)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if labeled != "hidden-kids" {
+		t.Errorf("Expected the collection to be labeled hidden-kids, got: %q", labeled)
+	}
+	if filterMovies != "contentRating!=R|label!=hidden-kids" {
+		t.Errorf("Expected the label to be excluded alongside existing restrictions, got: %q", filterMovies)
+	}
+}
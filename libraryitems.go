@@ -0,0 +1,162 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// defaultItemPageSize is the number of items requested per page when ItemListOptions.PageSize is not set
+const defaultItemPageSize = 100
+
+// ItemListOptions selects and orders the items listed by ListItems and EachItem
+type ItemListOptions struct {
+	Type         MetadataType // Item type, e.g. CollectionItemTypeEpisode; the section's top-level items if 0
+	Filter       FilterNode   // Smart filter conditions the items must match; nil matches all
+	Sort         string       // e.g. "titleSort" or "addedAt:desc"; the server's default order if ""
+	Limit        int          // Maximum number of items; all if 0
+	PageSize     int          // Items requested per page, 100 if not set
+	IncludeGUIDs bool         // Fill in ExternalGUIDs, e.g. for Metadata.ExternalID
+}
+
+// ListItems lists the items of a library section, requesting them a page at a time. It is the
+// hand-written counterpart of GetAllMediaLibrary, returning Metadata instead of the generated
+// response types.
+func (s *Library) ListItems(ctx context.Context, sectionID int, listOptions ItemListOptions, opts ...operations.Option) ([]Metadata, error) {
+	items := []Metadata{}
+	err := s.EachItem(ctx, sectionID, listOptions, func(item Metadata) error {
+		items = append(items, item)
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// EachItem calls fn for each item of a library section, requesting the next page only once fn
+// has been called for the items of the previous one, so large sections don't have to be held in
+// memory. If fn returns ErrStopWalk EachItem stops and returns nil; any other error stops it and
+// is returned.
+func (s *Library) EachItem(ctx context.Context, sectionID int, listOptions ItemListOptions, fn func(item Metadata) error, opts ...operations.Option) error {
+	pageSize := listOptions.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultItemPageSize
+	}
+
+	options := processOptions(opts)
+
+	listed := 0
+	for start := 0; ; start += pageSize {
+		size := pageSize
+		if listOptions.Limit > 0 && listOptions.Limit-listed < size {
+			size = listOptions.Limit - listed
+		}
+
+		page, err := s.listItemsPage(ctx, sectionID, listOptions, start, size, opts...)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Metadata {
+			if err := fn(item); err != nil {
+				if errors.Is(err, ErrStopWalk) {
+					return nil
+				}
+				return err
+			}
+			listed++
+		}
+
+		total := page.TotalSize
+		if listOptions.Limit > 0 && (total == 0 || listOptions.Limit < total) {
+			total = listOptions.Limit
+		}
+		reportProgress(options, listed, total, "listing items")
+
+		// Servers that leave out totalSize end the listing with a short page
+		if len(page.Metadata) < size || (total > 0 && listed >= total) {
+			return nil
+		}
+	}
+}
+
+// ListItemsPage lists a single page of the items of a library section, starting at the item
+// with index start; listOptions.PageSize is the size of the page. The page's ContainerInfo tells
+// the total number of items and whether there are more pages, for callers that paginate
+// themselves, e.g. to serve pages of their own. Limit is ignored.
+func (s *Library) ListItemsPage(ctx context.Context, sectionID int, listOptions ItemListOptions, start int, opts ...operations.Option) (*MetadataMediaContainer, error) {
+	pageSize := listOptions.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultItemPageSize
+	}
+
+	return s.listItemsPage(ctx, sectionID, listOptions, start, pageSize, opts...)
+}
+
+// listItemsPage requests size items of a library section starting at index start
+func (s *Library) listItemsPage(ctx context.Context, sectionID int, listOptions ItemListOptions, start int, size int, opts ...operations.Option) (*MetadataMediaContainer, error) {
+	path := fmt.Sprintf("/library/sections/%d/all", sectionID)
+	if listOptions.Filter != nil {
+		// The conditions are kept in order, as push/pop groups depend on it
+		path += "?" + strings.Join(listOptions.Filter.encode(), "&")
+	}
+
+	queryParams := url.Values{}
+	if listOptions.Type > 0 {
+		queryParams.Add("type", strconv.Itoa(int(listOptions.Type)))
+	}
+	if listOptions.Sort != "" {
+		queryParams.Add("sort", listOptions.Sort)
+	}
+	if listOptions.IncludeGUIDs {
+		queryParams.Add("includeGuids", "1")
+	}
+	queryParams.Add("X-Plex-Container-Start", strconv.Itoa(start))
+	queryParams.Add("X-Plex-Container-Size", strconv.Itoa(size))
+
+	page, err := s.listMetadataContainer(ctx, path, queryParams, "getAllMediaLibrary", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing items of section %d: %w", sectionID, err)
+	}
+
+	return page, nil
+}
+
+// GetItems gets the full metadata of library items, requesting them in batches. It is the
+// hand-written counterpart of GetMediaMetaData for several items; the items are returned in the
+// order of ratingKeys, and a key that doesn't exist is an error.
+func (s *Library) GetItems(ctx context.Context, ratingKeys []string, opts ...operations.Option) ([]Metadata, error) {
+	found := make(map[string]Metadata, len(ratingKeys))
+
+	for start := 0; start < len(ratingKeys); start += metadataBatchSize {
+		end := start + metadataBatchSize
+		if end > len(ratingKeys) {
+			end = len(ratingKeys)
+		}
+
+		items, err := s.listMetadata(ctx, "/library/metadata/"+strings.Join(ratingKeys[start:end], ","), nil, "getMediaMetaData", opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting items: %w", err)
+		}
+		for _, item := range items {
+			found[item.RatingKey] = item
+		}
+	}
+
+	items := make([]Metadata, 0, len(ratingKeys))
+	for _, ratingKey := range ratingKeys {
+		item, ok := found[ratingKey]
+		if !ok {
+			return nil, fmt.Errorf("item %s not found", ratingKey)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,151 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestListItems(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		if r.URL.Path != "/library/sections/1/all" || query.Get("type") != "1" || query.Get("sort") != "titleSort" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		if !strings.HasPrefix(r.URL.RawQuery, "year%3E%3E=2000&") && !strings.HasPrefix(r.URL.RawQuery, "year>>=2000&") {
+			t.Errorf("Expected the filter first, got: %s", r.URL.RawQuery)
+		}
+
+		start, _ := strconv.Atoi(query.Get("X-Plex-Container-Start"))
+		size, _ := strconv.Atoi(query.Get("X-Plex-Container-Size"))
+		starts = append(starts, fmt.Sprintf("%d+%d", start, size))
+
+		var items []string
+		for i := start; i < start+size && i < 5; i++ {
+			items = append(items, fmt.Sprintf(`{"ratingKey":"%d","type":"movie","title":"Movie %d"}`, i+1, i+1))
+		}
+		fmt.Fprintf(w, `{"MediaContainer":{"size":%d,"totalSize":5,"offset":%d,"Metadata":[%s]}}`, len(items), start, strings.Join(items, ","))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	listOptions := ItemListOptions{
+		Type:     CollectionItemTypeMovie,
+		Filter:   Cond("year", FilterOpGreaterThan, "2000"),
+		Sort:     "titleSort",
+		PageSize: 2,
+	}
+
+	items, err := client.Library.ListItems(context.Background(), 1, listOptions)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(items) != 5 || items[4].RatingKey != "5" {
+		t.Errorf("Expected 5 items, got: %+v", items)
+	}
+	if strings.Join(starts, " ") != "0+2 2+2 4+2" {
+		t.Errorf("Unexpected pages: %v", starts)
+	}
+
+	starts = nil
+	listOptions.Limit = 3
+	items, err = client.Library.ListItems(context.Background(), 1, listOptions)
+	if err != nil || len(items) != 3 {
+		t.Errorf("Expected 3 items, got: %d, %v", len(items), err)
+	}
+	if strings.Join(starts, " ") != "0+2 2+1" {
+		t.Errorf("Unexpected pages with a limit: %v", starts)
+	}
+
+	starts = nil
+	listOptions.Limit = 0
+	seen := 0
+	err = client.Library.EachItem(context.Background(), 1, listOptions, func(item Metadata) error {
+		seen++
+		if item.RatingKey == "2" {
+			return ErrStopWalk
+		}
+		return nil
+	})
+	if err != nil || seen != 2 || len(starts) != 1 {
+		t.Errorf("Expected to stop after the first page, got: %d items, %v pages, %v", seen, starts, err)
+	}
+}
+
+func TestGetItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/metadata/3,1":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"1","title":"Heat"},{"ratingKey":"3","title":"Ronin"}]}}`))
+		case "/library/metadata/4":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	items, err := client.Library.GetItems(context.Background(), []string{"3", "1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(items) != 2 || items[0].Title != "Ronin" || items[1].Title != "Heat" {
+		t.Errorf("Expected the items in the requested order, got: %+v", items)
+	}
+
+	if _, err := client.Library.GetItems(context.Background(), []string{"4"}); err == nil {
+		t.Errorf("Expected an error for a missing item")
+	}
+}
+
+func TestWithInclude(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		if query.Get("includeCollections") != "1" || query.Get("includePreferences") != "1" {
+			t.Errorf("Expected the include flags, got: %s", r.URL.RawQuery)
+		}
+
+		switch r.URL.Path {
+		case "/library/metadata/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","type":"show","title":"Severance",
+				"Collection":[{"id":12,"tag":"Apple Originals"}],
+				"Preferences":{"Setting":[{"id":"showOrdering","type":"text","default":"","value":"aired"}]}}]}}`))
+		case "/library/sections/2/all":
+			if query.Get("sort") != "titleSort" {
+				t.Errorf("Expected the listing parameters to be kept, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"totalSize":1,"Metadata":[{"ratingKey":"7","type":"show","title":"Severance"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	include := WithInclude(IncludeCollections, IncludePreferences)
+
+	item, err := client.Library.GetItem(context.Background(), 7, include)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(item.Collection) != 1 || item.Collection[0].Tag != "Apple Originals" {
+		t.Errorf("Expected the collection to be decoded, got: %+v", item.Collection)
+	}
+	if ordering, ok := item.Preferences.Get("showOrdering"); !ok || ordering != "aired" {
+		t.Errorf("Expected the preferences to be decoded, got: %+v", item.Preferences)
+	}
+
+	if _, err := client.Library.ListItems(context.Background(), 2, ItemListOptions{Sort: "titleSort"}, include); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+}
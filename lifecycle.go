@@ -0,0 +1,92 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClientClosed is returned by long-running operations started after PlexAPI.Close
+var ErrClientClosed = errors.New("client is closed")
+
+// lifecycle tracks the long-running work of a client, such as log tails, watchers and bulk
+// operations, so Close can stop it and wait for it to return
+type lifecycle struct {
+	mu      sync.Mutex
+	closed  bool
+	done    chan struct{} // Closed by Close
+	running sync.WaitGroup
+}
+
+// newLifecycle returns the lifecycle of an open client
+func newLifecycle() *lifecycle {
+	return &lifecycle{done: make(chan struct{})}
+}
+
+// track starts tracking work, returning a context that is canceled when ctx is done or the client
+// is closed, and a func to call when the work returns. ErrClientClosed is returned once the client
+// is closed. Work of configurations without a lifecycle isn't tracked.
+func (c *sdkConfiguration) track(ctx context.Context) (context.Context, func(), error) {
+	l := c.lifecycle
+	if l == nil {
+		return ctx, func() {}, nil
+	}
+
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return ctx, func() {}, ErrClientClosed
+	}
+	l.running.Add(1)
+	l.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-l.done:
+			cancel()
+		case <-stop:
+		}
+	}()
+
+	return ctx, func() {
+		close(stop)
+		cancel()
+		l.running.Done()
+	}, nil
+}
+
+// Close shuts the client down for long-running daemons: it cancels the log tails, activity
+// waits, collection watchers, featured rotations, task queues and bulk operations of the client,
+// and waits until they have returned or ctx is done. Bulk operations stopped part way return a
+// *PartialError to their callers, and task queues keep the unfinished task queued. Operations
+// started afterwards return ErrClientClosed, while single requests are unaffected. Idle
+// connections of the HTTP client are closed once all work has returned.
+func (s *PlexAPI) Close(ctx context.Context) error {
+	l := s.sdkConfiguration.lifecycle
+
+	l.mu.Lock()
+	if !l.closed {
+		l.closed = true
+		close(l.done)
+	}
+	l.mu.Unlock()
+
+	returned := make(chan struct{})
+	go func() {
+		l.running.Wait()
+		close(returned)
+	}()
+
+	select {
+	case <-returned:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if client, ok := s.sdkConfiguration.Client.(interface{ CloseIdleConnections() }); ok {
+		client.CloseIdleConnections()
+	}
+	return nil
+}
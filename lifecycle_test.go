@@ -0,0 +1,112 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/:/eventsource/notifications":
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		case strings.HasSuffix(r.URL.Path, "/collections"):
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	entries, err := client.Server.TailLogs(ctx, operations.LevelTwo)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	waited := make(chan error, 1)
+	go func() {
+		_, err := client.Activities.WaitFor(ctx, SectionActivity(1, ""), time.Hour)
+		waited <- err
+	}()
+
+	watched := make(chan error, 1)
+	go func() {
+		watched <- client.Collections.NewWatcher(1).Run(ctx, nil)
+	}()
+
+	queue := NewTaskQueue(nil)
+	client.Collections.HandleTasks(queue)
+	queued := make(chan error, 1)
+	go func() {
+		queued <- queue.Run(ctx)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+
+	closeCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	if err := client.Close(closeCtx); err != nil {
+		t.Fatalf("Expected everything to stop, got: %v", err)
+	}
+
+	// Everything has returned by the time Close does
+	for name, done := range map[string]chan error{"WaitFor": waited, "watcher": watched, "queue": queued} {
+		select {
+		case err := <-done:
+			if err == nil {
+				t.Errorf("Expected %s to return an error", name)
+			}
+		default:
+			t.Errorf("Expected %s to have returned", name)
+		}
+	}
+	for range entries {
+	}
+
+	if _, err := client.Server.TailLogs(ctx, operations.LevelTwo); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("Expected ErrClientClosed, got: %v", err)
+	}
+	if err := client.Close(closeCtx); err != nil {
+		t.Errorf("Expected closing again to succeed, got: %v", err)
+	}
+}
+
+// idleClient records whether its idle connections were closed
+type idleClient struct {
+	closed bool
+}
+
+func (c *idleClient) Do(req *http.Request) (*http.Response, error) {
+	return http.DefaultClient.Do(req)
+}
+
+func (c *idleClient) CloseIdleConnections() {
+	c.closed = true
+}
+
+func TestCloseWithMiddleware(t *testing.T) {
+	httpClient := &idleClient{}
+	client := New(WithClient(httpClient), WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return next
+	}))
+
+	if err := client.Close(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !httpClient.closed {
+		t.Error("Expected the idle connections of the client behind the middlewares to be closed")
+	}
+}
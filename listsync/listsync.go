@@ -0,0 +1,210 @@
+// Package listsync keeps the collections of library sections in sync with state files listing
+// the collections each should have, as with Collections.Plan and Apply, and reports the changes
+// of each run to a notify.Sink. A Syncer's Sync method can be run on a schedule, and checkpoints
+// its progress so a run interrupted by a restart resumes where it stopped.
+package listsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/notify"
+)
+
+// Result is the outcome of syncing one state file
+type Result struct {
+	Path    string
+	Plan    *plexgo.CollectionPlan // nil if the file could not be loaded or planned
+	Applied []plexgo.PlannedChange // Changes applied, nil for a dry run
+	Err     error
+}
+
+// Syncer syncs collections with state files. Create one with New and set its fields before
+// calling Sync.
+type Syncer struct {
+	Sink    notify.Sink         // Receives a PlanEvent for each file with changes, and errors; nil sends nothing
+	DryRun  bool                // Plan the changes and report them without applying them
+	Options []operations.Option // Passed to the SDK calls
+
+	// CheckpointPath is a file the progress of a run is saved to as a plexgo.Checkpoint, so that a
+	// run interrupted, e.g. by a restart, resumes where it stopped. It is removed once a run
+	// completes. Empty keeps no checkpoint; dry runs never do.
+	CheckpointPath string
+
+	client *plexgo.PlexAPI
+	paths  []string
+}
+
+// New returns a syncer for state files, see plexgo.LoadCollectionState. Each path may be a glob
+// pattern, e.g. "/etc/collectionmanagerd/*.json", which is expanded on each Sync so files can be
+// added without a restart.
+func New(client *plexgo.PlexAPI, paths ...string) *Syncer {
+	return &Syncer{client: client, paths: paths}
+}
+
+// Sync plans the changes of each state file and applies them, unless DryRun is set. A file that
+// fails doesn't stop the others; their errors are returned joined, and are reported to Sink. With
+// a CheckpointPath, a run that was interrupted is resumed after the files it synced; they have no
+// Result. The checkpoint never passes a file that failed, so a resumed run retries it.
+func (s *Syncer) Sync(ctx context.Context) ([]Result, error) {
+	paths, err := s.expand()
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := s.loadCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	for i, path := range paths {
+		if path == checkpoint.Last {
+			paths = paths[i+1:]
+			break
+		}
+	}
+
+	results := make([]Result, 0, len(paths))
+	var errs []error
+	failed := false
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+
+		result := s.syncFile(ctx, path)
+		if ctx.Err() != nil {
+			// The file is synced again when the run is resumed
+			return results, ctx.Err()
+		}
+		results = append(results, result)
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, result.Err))
+			failed = true
+		}
+		s.report(ctx, result)
+
+		if !failed {
+			checkpoint.Offset++
+			checkpoint.Last = path
+			if err := s.saveCheckpoint(checkpoint); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if err := s.removeCheckpoint(); err != nil {
+		errs = append(errs, err)
+	}
+	return results, errors.Join(errs...)
+}
+
+// checkpointScope identifies the paths of the syncer in its checkpoint, so a checkpoint is not
+// resumed by a syncer of other state files
+func (s *Syncer) checkpointScope() string {
+	return strings.Join(s.paths, string(filepath.ListSeparator))
+}
+
+// loadCheckpoint returns the checkpoint of an interrupted run, or an empty one
+func (s *Syncer) loadCheckpoint() (*plexgo.Checkpoint, error) {
+	checkpoint := &plexgo.Checkpoint{Operation: "listSync", Scope: s.checkpointScope()}
+	if s.CheckpointPath == "" || s.DryRun {
+		return checkpoint, nil
+	}
+
+	saved, err := plexgo.LoadCheckpoint(s.CheckpointPath)
+	if err != nil || saved == nil || !saved.Matches(checkpoint.Operation, checkpoint.Scope) {
+		return checkpoint, err
+	}
+	return saved, nil
+}
+
+// saveCheckpoint saves the progress of the run
+func (s *Syncer) saveCheckpoint(checkpoint *plexgo.Checkpoint) error {
+	if s.CheckpointPath == "" || s.DryRun {
+		return nil
+	}
+	checkpoint.Updated = time.Now().UTC()
+	return plexgo.SaveCheckpoint(s.CheckpointPath, checkpoint)
+}
+
+// removeCheckpoint removes the checkpoint of a completed run
+func (s *Syncer) removeCheckpoint() error {
+	if s.CheckpointPath == "" || s.DryRun {
+		return nil
+	}
+	return plexgo.RemoveCheckpoint(s.CheckpointPath)
+}
+
+// expand returns the state files matched by the paths, in order and without duplicates
+func (s *Syncer) expand() ([]string, error) {
+	paths := []string{}
+	seen := map[string]bool{}
+	for _, pattern := range s.paths {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state file pattern %q: %w", pattern, err)
+		}
+		// A path that matches nothing is reported as a missing file
+		if len(matches) == 0 {
+			matches = []string{pattern}
+		}
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				paths = append(paths, match)
+			}
+		}
+	}
+	return paths, nil
+}
+
+// syncFile plans and applies the changes of one state file
+func (s *Syncer) syncFile(ctx context.Context, path string) Result {
+	result := Result{Path: path}
+
+	f, err := os.Open(path)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer f.Close()
+
+	state, err := plexgo.LoadCollectionState(f)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Plan, result.Err = s.client.Collections.Plan(ctx, state, s.Options...)
+	if result.Err != nil || s.DryRun || result.Plan.Empty() {
+		return result
+	}
+
+	result.Applied, result.Err = s.client.Collections.Apply(ctx, result.Plan, s.Options...)
+	if result.Applied == nil {
+		result.Applied = []plexgo.PlannedChange{}
+	}
+	return result
+}
+
+// report sends the result of a file to the sink, unless nothing changed
+func (s *Syncer) report(ctx context.Context, result Result) {
+	if s.Sink == nil {
+		return
+	}
+
+	name := filepath.Base(result.Path)
+	switch {
+	case result.Plan == nil:
+		_ = s.Sink.Send(ctx, notify.ErrorEvent(name+" failed", result.Err))
+	case !result.Plan.Empty():
+		_ = s.Sink.Send(ctx, notify.PlanEvent(name, result.Plan, result.Applied, result.Err))
+	}
+}
@@ -0,0 +1,173 @@
+package listsync
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/notify"
+)
+
+type recordingSink []notify.Event
+
+func (s *recordingSink) Send(ctx context.Context, event notify.Event) error {
+	*s = append(*s, event)
+	return nil
+}
+
+func TestSync(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"9","title":"Old Favorites","librarySectionID":1},
+				{"ratingKey":"10","title":"Hand Picked","librarySectionID":1}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/9":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"9","title":"Old Favorites","librarySectionID":1,"Label":[{"tag":"managed"}]}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/10":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","title":"Hand Picked","librarySectionID":1,"Label":[{"tag":"managed"}]}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/10/children":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","type":"movie"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","title":"The Matrix","type":"movie","Guid":[{"id":"imdb://tt0133093"}]}]}}`))
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/library/collections/"):
+			mu.Lock()
+			deleted = append(deleted, r.URL.Path)
+			mu.Unlock()
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	state := `{"sectionId":1,"label":"managed","collections":[{"title":"Hand Picked","guids":["imdb://tt0133093"]}]}`
+	if err := os.WriteFile(filepath.Join(dir, "movies.json"), []byte(state), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := plexgo.New(plexgo.WithServerURL(server.URL))
+	sink := &recordingSink{}
+	syncer := New(client, filepath.Join(dir, "*.json"), filepath.Join(dir, "missing.json"))
+	syncer.Sink = sink
+	syncer.DryRun = true
+
+	// Changes are only reported in a dry run, and a missing file doesn't stop the others
+	results, err := syncer.Sync(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "missing.json") {
+		t.Errorf("Expected an error for the missing file, got: %v", err)
+	}
+	if len(results) != 2 || results[0].Plan == nil || len(results[0].Plan.Changes) != 1 || results[0].Applied != nil || results[1].Err == nil {
+		t.Fatalf("Unexpected results: %+v", results)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("Expected no changes in a dry run, got: %v", deleted)
+	}
+	if len(*sink) != 2 || (*sink)[0].Message != "Planned 1 changes" || (*sink)[0].Fields["Deleted"] != "Old Favorites" || (*sink)[1].Title != "missing.json failed" {
+		t.Errorf("Unexpected events: %+v", *sink)
+	}
+
+	*sink = nil
+	syncer.DryRun = false
+	results, _ = syncer.Sync(context.Background())
+	if len(results[0].Applied) != 1 || results[0].Err != nil {
+		t.Errorf("Expected the delete to be applied, got: %+v", results[0])
+	}
+	if len(deleted) != 1 || deleted[0] != "/library/collections/9" {
+		t.Errorf("Unexpected deletes: %v", deleted)
+	}
+	if len(*sink) != 2 || (*sink)[0].Message != "Applied 1 changes" {
+		t.Errorf("Unexpected events: %+v", *sink)
+	}
+}
+
+// cancelingSink cancels a run once it received a number of events, as a shutdown would
+type cancelingSink struct {
+	events int
+	after  int
+	cancel context.CancelFunc
+}
+
+func (s *cancelingSink) Send(ctx context.Context, event notify.Event) error {
+	if s.events++; s.events == s.after {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestSyncCheckpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != "GET" || r.URL.Path != "/library/sections/1/collections" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+	}))
+	defer server.Close()
+
+	// b.json and d.json fail to load; the others are in sync
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.json": `{"sectionId":1,"label":"managed","collections":[]}`,
+		"b.json": "not a state",
+		"c.json": `{"sectionId":1,"label":"managed","collections":[]}`,
+		"d.json": "not a state",
+		"e.json": `{"sectionId":1,"label":"managed","collections":[]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client := plexgo.New(plexgo.WithServerURL(server.URL))
+	checkpointPath := filepath.Join(t.TempDir(), "list-sync.checkpoint")
+
+	// The run is interrupted after d.json
+	ctx, cancel := context.WithCancel(context.Background())
+	syncer := New(client, filepath.Join(dir, "*.json"))
+	syncer.CheckpointPath = checkpointPath
+	syncer.Sink = &cancelingSink{after: 2, cancel: cancel}
+
+	results, err := syncer.Sync(ctx)
+	if !errors.Is(err, context.Canceled) || len(results) != 4 {
+		t.Fatalf("Expected the run to stop after d.json, got: %+v, %v", results, err)
+	}
+
+	// The checkpoint stops before the first file that failed
+	checkpoint, err := plexgo.LoadCheckpoint(checkpointPath)
+	if err != nil || checkpoint == nil || filepath.Base(checkpoint.Last) != "a.json" || checkpoint.Offset != 1 {
+		t.Fatalf("Unexpected checkpoint: %+v, %v", checkpoint, err)
+	}
+
+	// A new syncer, as after a restart, resumes with b.json
+	resumed := New(client, filepath.Join(dir, "*.json"))
+	resumed.CheckpointPath = checkpointPath
+	results, err = resumed.Sync(context.Background())
+	synced := []string{}
+	for _, result := range results {
+		synced = append(synced, filepath.Base(result.Path))
+	}
+	if err == nil || strings.Join(synced, ",") != "b.json,c.json,d.json,e.json" {
+		t.Errorf("Expected the files from b.json on to be synced, got: %v, %v", synced, err)
+	}
+	if _, err := os.Stat(checkpointPath); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected the checkpoint to be removed after a complete run, got: %v", err)
+	}
+
+	results, _ = resumed.Sync(context.Background())
+	if len(results) != 5 {
+		t.Errorf("Expected the next run to sync every file, got: %+v", results)
+	}
+}
@@ -0,0 +1,131 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Metadata fields that can be locked, so agent refreshes keep their current values
+const (
+	FieldTitle         = "title"
+	FieldTitleSort     = "titleSort"
+	FieldOriginalTitle = "originalTitle"
+	FieldSummary       = "summary"
+	FieldTagline       = "tagline"
+	FieldStudio        = "studio"
+	FieldContentRating = "contentRating"
+	FieldReleaseDate   = "originallyAvailableAt"
+	FieldYear          = "year"
+	FieldPoster        = "thumb"
+	FieldBackground    = "art"
+	FieldTheme         = "theme"
+	FieldGenre         = "genre"
+	FieldLabel         = "label"
+	FieldCollection    = "collection"
+	FieldDirector      = "director"
+	FieldWriter        = "writer"
+	FieldProducer      = "producer"
+	FieldCountry       = "country"
+	FieldMood          = "mood"
+	FieldStyle         = "style"
+)
+
+var lockableFields = map[string]bool{
+	FieldTitle: true, FieldTitleSort: true, FieldOriginalTitle: true, FieldSummary: true, FieldTagline: true,
+	FieldStudio: true, FieldContentRating: true, FieldReleaseDate: true, FieldYear: true,
+	FieldPoster: true, FieldBackground: true, FieldTheme: true,
+	FieldGenre: true, FieldLabel: true, FieldCollection: true, FieldDirector: true, FieldWriter: true,
+	FieldProducer: true, FieldCountry: true, FieldMood: true, FieldStyle: true,
+}
+
+// LockFields locks metadata fields of items, e.g. FieldPoster and FieldSummary after applying
+// custom values, so agent refreshes don't overwrite them. Items are edited with one request per
+// library section and item type.
+func (s *Library) LockFields(ctx context.Context, ratingKeys []string, fields ...string) error {
+	return s.setFieldLocks(ctx, ratingKeys, fields, true)
+}
+
+// UnlockFields unlocks metadata fields of items, so the next agent refresh updates them again
+func (s *Library) UnlockFields(ctx context.Context, ratingKeys []string, fields ...string) error {
+	return s.setFieldLocks(ctx, ratingKeys, fields, false)
+}
+
+// setFieldLocks locks or unlocks fields of items, grouping them by section and type
+func (s *Library) setFieldLocks(ctx context.Context, ratingKeys []string, fields []string, locked bool) error {
+	if len(ratingKeys) == 0 {
+		return nil
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("no fields to lock or unlock")
+	}
+
+	edits := map[string]string{}
+	for _, field := range fields {
+		if !lockableFields[field] {
+			return fmt.Errorf("field %q cannot be locked", field)
+		}
+		edits[field+".locked"] = boolToString(locked)
+	}
+
+	// Items can only be edited through their library section, one type at a time
+	type itemGroup struct {
+		sectionID int
+		itemType  MetadataType
+	}
+	groups := map[itemGroup][]string{}
+	found := map[string]bool{}
+
+	for start := 0; start < len(ratingKeys); start += metadataBatchSize {
+		end := start + metadataBatchSize
+		if end > len(ratingKeys) {
+			end = len(ratingKeys)
+		}
+
+		items, err := s.listMetadata(ctx, "/library/metadata/"+strings.Join(ratingKeys[start:end], ","), nil, "getMediaMetaData")
+		if err != nil {
+			return fmt.Errorf("error getting items: %w", err)
+		}
+
+		for _, item := range items {
+			itemType := collectionItemTypeFromName(item.Type)
+			if itemType == 0 {
+				return fmt.Errorf("items of type %s cannot be edited", item.Type)
+			}
+			group := itemGroup{sectionID: item.SectionID, itemType: itemType}
+			groups[group] = append(groups[group], item.RatingKey)
+			found[item.RatingKey] = true
+		}
+	}
+
+	for _, ratingKey := range ratingKeys {
+		if !found[ratingKey] {
+			return fmt.Errorf("item %s not found", ratingKey)
+		}
+	}
+
+	ordered := make([]itemGroup, 0, len(groups))
+	for group := range groups {
+		ordered = append(ordered, group)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].sectionID != ordered[j].sectionID {
+			return ordered[i].sectionID < ordered[j].sectionID
+		}
+		return ordered[i].itemType < ordered[j].itemType
+	})
+
+	operationID := "lockFields"
+	if !locked {
+		operationID = "unlockFields"
+	}
+
+	for _, group := range ordered {
+		if err := s.editMetadataBulk(ctx, group.sectionID, group.itemType, groups[group], edits, operationID); err != nil {
+			return fmt.Errorf("error updating field locks of section %d: %w", group.sectionID, err)
+		}
+	}
+
+	return nil
+}
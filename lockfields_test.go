@@ -0,0 +1,68 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"testing"
+)
+
+func TestLockFields(t *testing.T) {
+	var edits []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/1,2,3":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"1","type":"movie","title":"Heat","librarySectionID":1},
+				{"ratingKey":"2","type":"movie","title":"Ronin","librarySectionID":1},
+				{"ratingKey":"3","type":"show","title":"Severance","librarySectionID":2}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/4":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[]}}`))
+		case r.Method == "PUT" && (r.URL.Path == "/library/sections/1/all" || r.URL.Path == "/library/sections/2/all"):
+			query := r.URL.Query()
+			query.Set("section", r.URL.Path)
+			edits = append(edits, query)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	if err := client.Library.LockFields(context.Background(), []string{"1", "2", "3"}, FieldPoster, FieldSummary); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(edits) != 2 {
+		t.Fatalf("Expected one edit per section and type, got: %v", edits)
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].Get("section") < edits[j].Get("section") })
+	if edits[0].Get("id") != "1,2" || edits[0].Get("type") != "1" || edits[0].Get("thumb.locked") != "1" || edits[0].Get("summary.locked") != "1" {
+		t.Errorf("Unexpected movie edit: %v", edits[0])
+	}
+	if edits[1].Get("id") != "3" || edits[1].Get("type") != "2" {
+		t.Errorf("Unexpected show edit: %v", edits[1])
+	}
+
+	edits = nil
+	if err := client.Library.UnlockFields(context.Background(), []string{"1", "2", "3"}, FieldTitle); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(edits) != 2 || edits[0].Get("title.locked") != "0" {
+		t.Errorf("Unexpected unlock edits: %v", edits)
+	}
+
+	if err := client.Library.LockFields(context.Background(), []string{"4"}, FieldTitle); err == nil {
+		t.Errorf("Expected an error for a missing item")
+	}
+	if err := client.Library.LockFields(context.Background(), []string{"1"}, "poster"); err == nil {
+		t.Errorf("Expected an error for an unknown field")
+	}
+	if err := client.Library.LockFields(context.Background(), []string{"1"}); err == nil {
+		t.Errorf("Expected an error without fields")
+	}
+}
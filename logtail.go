@@ -0,0 +1,181 @@
+package plexgo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// Intervals between reconnections of TailLogs, doubling while the server can't be reached
+const (
+	logTailMinRetryInterval = time.Second
+	logTailMaxRetryInterval = 30 * time.Second
+)
+
+// LogEntry is a line of the server's log
+type LogEntry struct {
+	Level   operations.Level // From 0 for errors to 4 for verbose messages, as with Log.LogLine
+	Message string
+	Time    time.Time // When the line was received; the server doesn't send the time it was logged
+}
+
+// TailLogs streams the lines the server logs from now on, up to level (e.g. 2 for errors,
+// warnings and info), on the returned channel, for live debugging dashboards. The lines come
+// from the server's event stream; when it ends, e.g. at the client's timeout or a server restart,
+// TailLogs reconnects, so lines logged in between are missed. The channel is closed once ctx is
+// done or the client is closed. An error is returned if the first connection fails.
+func (s *Server) TailLogs(ctx context.Context, level operations.Level, opts ...operations.Option) (<-chan LogEntry, error) {
+	ctx, finish, err := s.sdkConfiguration.track(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := openNotificationStream(ctx, s.sdkConfiguration, "log", "tailLogs", opts...)
+	if err != nil {
+		finish()
+		return nil, err
+	}
+
+	entries := make(chan LogEntry, 100)
+	go func() {
+		defer finish()
+		defer close(entries)
+
+		wait := logTailMinRetryInterval
+		for {
+			if stream != nil {
+				readLogStream(ctx, stream, level, entries)
+				stream.Close()
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+
+			stream, err = openNotificationStream(ctx, s.sdkConfiguration, "log", "tailLogs", opts...)
+			if err != nil {
+				stream = nil
+				if wait *= 2; wait > logTailMaxRetryInterval {
+					wait = logTailMaxRetryInterval
+				}
+			} else {
+				wait = logTailMinRetryInterval
+			}
+		}
+	}()
+
+	return entries, nil
+}
+
+// readLogStream sends the log lines of an event stream with a level up to level to entries, until
+// the stream ends or ctx is done
+func readLogStream(ctx context.Context, stream io.Reader, level operations.Level, entries chan<- LogEntry) {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+
+		var notification struct {
+			NotificationContainer struct {
+				Type string `json:"type"`
+				Log  []struct {
+					Level   operations.Level `json:"level"`
+					Message string           `json:"message"`
+				} `json:"Log"`
+			} `json:"NotificationContainer"`
+		}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &notification); err != nil || notification.NotificationContainer.Type != "log" {
+			continue
+		}
+
+		for _, line := range notification.NotificationContainer.Log {
+			if line.Level > level {
+				continue
+			}
+			select {
+			case entries <- LogEntry{Level: line.Level, Message: line.Message, Time: time.Now()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// openNotificationStream opens the server's event stream of notifications of the types in filters,
+// e.g. "log" or "activity"
+func openNotificationStream(ctx context.Context, sdkConfig sdkConfiguration, filters string, operationID string, opts ...operations.Option) (io.ReadCloser, error) {
+	baseURL := newLibrary(sdkConfig).baseURL(opts)
+
+	opURL, err := url.JoinPath(baseURL, "/:/eventsource/notifications")
+	if err != nil {
+		return nil, fmt.Errorf("error generating URL: %w", err)
+	}
+	opURL += "?filters=" + url.QueryEscape(filters)
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    operationID,
+		OAuth2Scopes:   []string{},
+		SecuritySource: sdkConfig.Security,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("User-Agent", sdkConfig.UserAgent)
+
+	if err := utils.PopulateSecurity(ctx, req, sdkConfig.Security); err != nil {
+		return nil, err
+	}
+
+	req, err = sdkConfig.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRes, err := sdkConfig.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = sdkConfig.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return nil, err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = sdkConfig.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = sdkConfig.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return httpRes.Body, nil
+}
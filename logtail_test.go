@@ -0,0 +1,57 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestTailLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/:/eventsource/notifications" || r.URL.Query().Get("filters") != "log" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: log\n")
+		fmt.Fprint(w, `data: {"NotificationContainer":{"type":"log","size":3,"Log":[{"level":0,"message":"Transcoder crashed"},{"level":4,"message":"Verbose detail"},{"level":2,"message":"Scanning section 1"}]}}`+"\n\n")
+		fmt.Fprint(w, `data: {"NotificationContainer":{"type":"activity","size":1}}`+"\n\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	entries, err := client.Server.TailLogs(ctx, operations.LevelTwo)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	for _, expected := range []string{"Transcoder crashed", "Scanning section 1"} {
+		select {
+		case entry := <-entries:
+			if entry.Message != expected || entry.Time.IsZero() {
+				t.Errorf("Expected %q, got: %+v", expected, entry)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected %q", expected)
+		}
+	}
+
+	cancel()
+	select {
+	case _, open := <-entries:
+		if open {
+			t.Error("Expected no more entries")
+		}
+	case <-time.After(3 * time.Second):
+		t.Error("Expected the channel to be closed")
+	}
+}
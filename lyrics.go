@@ -0,0 +1,280 @@
+package plexgo
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// ErrNoLyrics is returned by GetLyrics for tracks without a lyrics stream
+var ErrNoLyrics = errors.New("track has no lyrics")
+
+// LyricsKind tells timed lyrics, synced to the track, from static lyrics
+type LyricsKind string
+
+// Kinds of lyrics
+const (
+	LyricsTimed  LyricsKind = "timed"
+	LyricsStatic LyricsKind = "static"
+)
+
+// LyricLine is a line of lyrics. Start is zero for static lyrics.
+type LyricLine struct {
+	Start time.Duration `json:"start"`
+	Text  string        `json:"text"`
+}
+
+// Lyrics are the lyrics of a track
+type Lyrics struct {
+	Kind     LyricsKind  `json:"kind"`
+	Format   string      `json:"format,omitempty"`   // Format of the lyrics stream, e.g. "lrc" or "txt"
+	Provider string      `json:"provider,omitempty"` // Agent that supplied the lyrics
+	Lines    []LyricLine `json:"lines"`              // Timed lines are in order of their start
+}
+
+// Timed returns true if the lines carry start times
+func (l *Lyrics) Timed() bool {
+	return l.Kind == LyricsTimed
+}
+
+// LineAt returns the line being sung at a playback position, e.g. the viewOffset of a session,
+// for overlays that follow playback. It returns false for static lyrics and before the first line.
+func (l *Lyrics) LineAt(position time.Duration) (LyricLine, bool) {
+	if !l.Timed() {
+		return LyricLine{}, false
+	}
+
+	i := sort.Search(len(l.Lines), func(i int) bool { return l.Lines[i].Start > position })
+	if i == 0 {
+		return LyricLine{}, false
+	}
+	return l.Lines[i-1], true
+}
+
+// Text returns the lyrics without timing, one line per line
+func (l *Lyrics) Text() string {
+	lines := make([]string, 0, len(l.Lines))
+	for _, line := range l.Lines {
+		lines = append(lines, line.Text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// GetLyrics streams the lyrics of a track from its lyrics stream. Lyrics in LRC format are
+// returned as timed lines; plain text lyrics as static lines. Timed lyrics are preferred when a
+// track has both. ErrNoLyrics is returned for tracks without lyrics.
+func (s *Media) GetLyrics(ctx context.Context, trackRatingKey int, opts ...operations.Option) (*Lyrics, error) {
+	track, err := newLibrary(s.sdkConfiguration).GetItem(ctx, trackRatingKey, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting track: %w", err)
+	}
+	if track.Type != "track" {
+		return nil, fmt.Errorf("item %d is a %s, not a track", trackRatingKey, track.Type)
+	}
+
+	stream, ok := lyricsStream(track)
+	if !ok {
+		return nil, ErrNoLyrics
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, stream.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error generating URL: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "getLyrics",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	// The stream is the lyrics file itself, not a MediaContainer
+	req.Header.Set("Accept", "*/*")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return nil, err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return nil, err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer httpRes.Body.Close()
+
+	lyrics, err := ParseLyrics(httpRes.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading lyrics: %w", err)
+	}
+	lyrics.Format = stream.Format
+	lyrics.Provider = stream.Provider
+
+	return lyrics, nil
+}
+
+// lyricsStream returns the lyrics stream of a track, preferring timed lyrics
+func lyricsStream(track *Metadata) (MediaStream, bool) {
+	var found *MediaStream
+	for _, media := range track.Media {
+		for _, part := range media.Part {
+			for i, stream := range part.Stream {
+				if stream.StreamType != StreamTypeLyrics || stream.Key == "" {
+					continue
+				}
+				if found == nil || (found.Format != "lrc" && stream.Format == "lrc") {
+					found = &part.Stream[i]
+				}
+			}
+		}
+	}
+	if found == nil {
+		return MediaStream{}, false
+	}
+	return *found, true
+}
+
+var (
+	// lrcTimestamp matches an LRC time tag, e.g. [01:23.45]
+	lrcTimestamp = regexp.MustCompile(`^\[(\d+):(\d{1,2}(?:[.:]\d{1,3})?)\]`)
+	// lrcTag matches an LRC ID tag, e.g. [ar:Massive Attack]
+	lrcTag = regexp.MustCompile(`^\[([a-z]+):(.*)\]$`)
+)
+
+// ParseLyrics reads lyrics from r line by line. Lyrics with LRC time tags are timed; any other
+// text is static. LRC ID tags such as [ar:...] are dropped, and [offset:...] is applied.
+func ParseLyrics(r io.Reader) (*Lyrics, error) {
+	var timed, static []LyricLine
+	var offset time.Duration
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(timed) == 0 && len(static) == 0 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+
+		var starts []time.Duration
+		for {
+			match := lrcTimestamp.FindStringSubmatch(line)
+			if match == nil {
+				break
+			}
+			starts = append(starts, lrcTime(match[1], match[2]))
+			line = line[len(match[0]):]
+		}
+
+		if len(starts) > 0 {
+			text := strings.TrimSpace(line)
+			for _, start := range starts {
+				timed = append(timed, LyricLine{Start: start, Text: text})
+			}
+			continue
+		}
+
+		if match := lrcTag.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			if match[1] == "offset" {
+				if ms, err := strconv.Atoi(strings.TrimSpace(match[2])); err == nil {
+					offset = time.Duration(ms) * time.Millisecond
+				}
+			}
+			continue
+		}
+
+		static = append(static, LyricLine{Text: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(timed) == 0 {
+		// Trim blank lines around static lyrics, keeping the blank lines between verses
+		for len(static) > 0 && strings.TrimSpace(static[0].Text) == "" {
+			static = static[1:]
+		}
+		for len(static) > 0 && strings.TrimSpace(static[len(static)-1].Text) == "" {
+			static = static[:len(static)-1]
+		}
+		return &Lyrics{Kind: LyricsStatic, Lines: static}, nil
+	}
+
+	// A positive offset shows the lyrics earlier
+	for i := range timed {
+		timed[i].Start -= offset
+		if timed[i].Start < 0 {
+			timed[i].Start = 0
+		}
+	}
+	sort.SliceStable(timed, func(i, j int) bool { return timed[i].Start < timed[j].Start })
+
+	return &Lyrics{Kind: LyricsTimed, Lines: timed}, nil
+}
+
+// lrcTime converts the minutes and seconds of an LRC time tag into a duration
+func lrcTime(minutes string, seconds string) time.Duration {
+	m, _ := strconv.Atoi(minutes)
+	// Some files separate hundredths with a colon, e.g. [01:23:45]
+	sec, _ := strconv.ParseFloat(strings.Replace(seconds, ":", ".", 1), 64)
+	return time.Duration(m)*time.Minute + time.Duration(sec*float64(time.Second)).Round(time.Millisecond)
+}
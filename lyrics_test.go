@@ -0,0 +1,89 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLyrics(t *testing.T) {
+	timed, err := ParseLyrics(strings.NewReader("\ufeff[ar:Massive Attack]\r\n[ti:Teardrop]\r\n[offset:+500]\r\n" +
+		"[00:12.50]Love, love is a verb\r\n[00:16.00][01:20.00]Love is a doing word\r\n[00:20:25]\r\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	want := []LyricLine{
+		{12 * time.Second, "Love, love is a verb"},
+		{15500 * time.Millisecond, "Love is a doing word"},
+		{19750 * time.Millisecond, ""},
+		{79500 * time.Millisecond, "Love is a doing word"},
+	}
+	if !timed.Timed() || len(timed.Lines) != len(want) {
+		t.Fatalf("Unexpected timed lyrics: %+v", timed)
+	}
+	for i, line := range want {
+		if timed.Lines[i] != line {
+			t.Errorf("Expected line %d to be %+v, got: %+v", i, line, timed.Lines[i])
+		}
+	}
+
+	if _, ok := timed.LineAt(5 * time.Second); ok {
+		t.Errorf("Expected no line before the first one")
+	}
+	if line, ok := timed.LineAt(17 * time.Second); !ok || line.Text != "Love is a doing word" {
+		t.Errorf("Unexpected line at 0:17: %+v", line)
+	}
+
+	static, err := ParseLyrics(strings.NewReader("\nLove, love is a verb\n\nLove is a doing word\n\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if static.Timed() || static.Text() != "Love, love is a verb\n\nLove is a doing word" {
+		t.Errorf("Unexpected static lyrics: %+v", static)
+	}
+	if _, ok := static.LineAt(time.Minute); ok {
+		t.Errorf("Expected no current line for static lyrics")
+	}
+}
+
+func TestGetLyrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/library/metadata/100":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"100","type":"track","Media":[{"id":1,"Part":[{"id":2,"Stream":[
+				{"id":3,"streamType":2,"codec":"flac"},
+				{"id":4,"streamType":4,"key":"/library/streams/4","format":"txt","provider":"com.plexapp.agents.lyricfind"},
+				{"id":5,"streamType":4,"key":"/library/streams/5","format":"lrc","provider":"com.plexapp.agents.lyricfind"}
+			]}]}]}]}}`))
+		case "/library/metadata/101":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"101","type":"track","Media":[{"id":1,"Part":[{"id":2,"Stream":[{"id":3,"streamType":2}]}]}]}]}}`))
+		case "/library/streams/5":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte("[00:12.50]Love, love is a verb\n"))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	lyrics, err := client.Media.GetLyrics(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !lyrics.Timed() || lyrics.Format != "lrc" || lyrics.Provider != "com.plexapp.agents.lyricfind" || len(lyrics.Lines) != 1 {
+		t.Errorf("Unexpected lyrics: %+v", lyrics)
+	}
+
+	if _, err := client.Media.GetLyrics(context.Background(), 101); !errors.Is(err, ErrNoLyrics) {
+		t.Errorf("Expected ErrNoLyrics, got: %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Visibilities of a recommendation row, the values of ManagedHub.HomeVisibility and
+// ManagedHub.RecommendationsVisibility
+const (
+	HubVisibilityAll    = "all"    // Shown to everyone
+	HubVisibilityAdmin  = "admin"  // Shown to the server owner only
+	HubVisibilityShared = "shared" // Shown to users the server is shared with only
+	HubVisibilityNone   = "none"   // Not shown
+)
+
+// ManagedHub is a recommendation row of a library section, such as "Recently Added" or a promoted
+// collection
+type ManagedHub struct {
+	Identifier                HubIdentifier
+	Title                     string
+	SectionID                 int
+	Index                     int                  // Position of the row in the section, from 0; -1 if unknown
+	Visibility                CollectionVisibility // Where the row is shown
+	HomeVisibility            string               // Who sees the row on the home screen, e.g. HubVisibilityAll
+	RecommendationsVisibility string               // Who sees the row in the library, e.g. HubVisibilityAll
+	Deletable                 bool                 // Whether the row can be removed, true for collection rows
+	Promoted                  bool                 // Whether the row is listed in the section, false for collections that aren't shown
+}
+
+// CollectionID returns the collection of a promoted collection row, false for other rows
+func (h ManagedHub) CollectionID() (int, bool) {
+	// Collection rows are identified by the section and the collection, e.g. custom.collection.1.42
+	keys, ok := strings.CutPrefix(h.Identifier.String(), collectionHubPrefix)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.Atoi(keys[strings.LastIndex(keys, ".")+1:])
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// managedHub is a row of /hubs/sections/{id}/manage, whose promotion flags are booleans or "0"/"1"
+// depending on the server version and endpoint
+type managedHub struct {
+	Identifier                string      `json:"identifier"`
+	Title                     string      `json:"title"`
+	HomeVisibility            string      `json:"homeVisibility"`
+	RecommendationsVisibility string      `json:"recommendationsVisibility"`
+	PromotedToRecommended     interface{} `json:"promotedToRecommended"`
+	PromotedToOwnHome         interface{} `json:"promotedToOwnHome"`
+	PromotedToSharedHome      interface{} `json:"promotedToSharedHome"`
+	Deletable                 interface{} `json:"deletable"`
+}
+
+// managedHubsResponse is the response of /hubs/sections/{id}/manage. Rows of the section are Hub
+// elements; a collection asked for with metadataItemId is a Directory element.
+type managedHubsResponse struct {
+	MediaContainer struct {
+		Hub       []managedHub `json:"Hub"`
+		Directory []managedHub `json:"Directory"`
+	} `json:"MediaContainer"`
+}
+
+// toManagedHub converts a row of a section to a ManagedHub
+func (h managedHub) toManagedHub(sectionID int, index int) ManagedHub {
+	return ManagedHub{
+		Identifier: HubIdentifier(h.Identifier),
+		Title:      h.Title,
+		SectionID:  sectionID,
+		Index:      index,
+		Visibility: CollectionVisibility{
+			Library: flexBool(h.PromotedToRecommended),
+			Home:    flexBool(h.PromotedToOwnHome),
+			Shared:  flexBool(h.PromotedToSharedHome),
+		},
+		HomeVisibility:            h.HomeVisibility,
+		RecommendationsVisibility: h.RecommendationsVisibility,
+		Deletable:                 flexBool(h.Deletable),
+	}
+}
+
+// flexBool converts a JSON boolean, number or numeric string into a bool, returning false for
+// anything else
+func flexBool(v interface{}) bool {
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	return flexFloat(v) != 0
+}
+
+// GetManagedHubs lists the recommendation rows of a library section in display order; the rows
+// promoted to the home screen appear there in the same order
+func (s *Hubs) GetManagedHubs(ctx context.Context, sectionID int, opts ...operations.Option) ([]ManagedHub, error) {
+	var out managedHubsResponse
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, fmt.Sprintf("/hubs/sections/%d/manage", sectionID), nil, "getManagedHubs", &out, opts...); err != nil {
+		return nil, fmt.Errorf("error getting hubs of section %d: %w", sectionID, err)
+	}
+
+	hubs := make([]ManagedHub, 0, len(out.MediaContainer.Hub))
+	for i, hub := range out.MediaContainer.Hub {
+		managed := hub.toManagedHub(sectionID, i)
+		managed.Promoted = true
+		hubs = append(hubs, managed)
+	}
+
+	return hubs, nil
+}
+
+// GetManagedHub gets a recommendation row of a library section by its identifier
+func (s *Hubs) GetManagedHub(ctx context.Context, sectionID int, identifier HubIdentifier, opts ...operations.Option) (*ManagedHub, error) {
+	hubs, err := s.GetManagedHubs(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range hubs {
+		if hubs[i].Identifier == identifier {
+			return &hubs[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("hub %s not found in section %d", identifier, sectionID)
+}
+
+// UpdateHubVisibility sets where a recommendation row of a library section is shown: in the
+// library's recommended rows, on the owner's home screen and on the home screens of shared users.
+// Collection rows are promoted if they aren't yet, as with Collections.UpdateCollectionVisibility.
+func (s *Hubs) UpdateHubVisibility(ctx context.Context, sectionID int, identifier HubIdentifier, visibility CollectionVisibility, opts ...operations.Option) error {
+	if collectionID, ok := (ManagedHub{Identifier: identifier}).CollectionID(); ok {
+		return newCollections(s.sdkConfiguration).UpdateCollectionVisibility(ctx, sectionID, collectionID, &visibility, opts...)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("promotedToRecommended", boolToString(visibility.Library))
+	queryParams.Add("promotedToOwnHome", boolToString(visibility.Home))
+	queryParams.Add("promotedToSharedHome", boolToString(visibility.Shared))
+
+	library := newLibrary(s.sdkConfiguration)
+	return library.put(ctx, library.baseURL(opts), fmt.Sprintf("/hubs/sections/%d/manage/%s", sectionID, url.PathEscape(identifier.String())), queryParams, nil, "updateManagedHub", opts...)
+}
+
+// MoveHub moves a recommendation row of a library section after another, or to the top if after
+// is "". The identifiers are those of GetManagedHubs.
+func (s *Hubs) MoveHub(ctx context.Context, sectionID int, identifier HubIdentifier, after HubIdentifier, opts ...operations.Option) error {
+	queryParams := url.Values{}
+	queryParams.Add("identifier", identifier.String())
+	if after != "" {
+		queryParams.Add("after", after.String())
+	}
+
+	library := newLibrary(s.sdkConfiguration)
+	return library.put(ctx, library.baseURL(opts), fmt.Sprintf("/hubs/sections/%d/manage/move", sectionID), queryParams, nil, "moveManagedHub", opts...)
+}
+
+// ReorderHubs moves the recommendation rows of a library section into the order of identifiers,
+// with one MoveHub per row that is out of place. Rows that aren't listed keep their order after
+// the listed ones.
+func (s *Hubs) ReorderHubs(ctx context.Context, sectionID int, identifiers []HubIdentifier, opts ...operations.Option) error {
+	hubs, err := s.GetManagedHubs(ctx, sectionID, opts...)
+	if err != nil {
+		return err
+	}
+
+	current := make([]HubIdentifier, 0, len(hubs))
+	for _, hub := range hubs {
+		current = append(current, hub.Identifier)
+	}
+
+	for i, identifier := range identifiers {
+		if i < len(current) && current[i] == identifier {
+			continue
+		}
+
+		var after HubIdentifier
+		if i > 0 {
+			after = identifiers[i-1]
+		}
+		if err := s.MoveHub(ctx, sectionID, identifier, after, opts...); err != nil {
+			return fmt.Errorf("error moving hub %s: %w", identifier, err)
+		}
+
+		// Mirror the move, so later rows are compared with the server's new order
+		for j, id := range current {
+			if id == identifier {
+				current = append(current[:j], current[j+1:]...)
+				break
+			}
+		}
+		if i > len(current) {
+			i = len(current)
+		}
+		current = append(current[:i], append([]HubIdentifier{identifier}, current[i:]...)...)
+	}
+
+	return nil
+}
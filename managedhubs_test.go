@@ -0,0 +1,83 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestManagedHubs(t *testing.T) {
+	updated := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/hubs/sections/1/manage" && r.URL.Query().Get("metadataItemId") == "43":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"promotedToRecommended":"0","promotedToOwnHome":"0","promotedToSharedHome":"0"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/hubs/sections/1/manage":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Hub":[
+				{"identifier":"movie.recentlyadded","title":"Recently Added Movies","homeVisibility":"all","recommendationsVisibility":"all","promotedToRecommended":true,"promotedToOwnHome":true,"deletable":false},
+				{"identifier":"custom.collection.1.42","title":"Marvel","homeVisibility":"admin","recommendationsVisibility":"all","promotedToRecommended":"1","promotedToOwnHome":"1","promotedToSharedHome":"0","deletable":"1"}
+			]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/hubs/sections/1/manage/movie.recentlyadded":
+			updated[HubIdentifierMovieRecentlyAdded.String()] = r.URL.RawQuery
+		case r.Method == "POST" && r.URL.Path == "/hubs/sections/1/manage":
+			updated[r.URL.Query().Get("metadataItemId")] = r.URL.RawQuery
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	hubs, err := client.Hubs.GetManagedHubs(ctx, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(hubs) != 2 || hubs[1].Index != 1 || !hubs[1].Deletable || hubs[0].Deletable || hubs[1].HomeVisibility != HubVisibilityAdmin {
+		t.Errorf("Unexpected hubs: %+v", hubs)
+	}
+	if !hubs[1].Visibility.Home || hubs[1].Visibility.Shared || !hubs[1].Promoted {
+		t.Errorf("Expected the string flags to be decoded, got: %+v", hubs[1].Visibility)
+	}
+	if id, ok := hubs[1].CollectionID(); !ok || id != 42 {
+		t.Errorf("Expected collection 42, got: %d %v", id, ok)
+	}
+	if _, ok := hubs[0].CollectionID(); ok {
+		t.Error("Expected no collection for a built-in row")
+	}
+
+	hub, err := client.Hubs.GetManagedHub(ctx, 1, HubIdentifierMovieRecentlyAdded)
+	if err != nil || hub.Title != "Recently Added Movies" {
+		t.Errorf("Unexpected hub: %+v %v", hub, err)
+	}
+	if _, err := client.Hubs.GetManagedHub(ctx, 1, HubIdentifierMovieInProgress); err == nil {
+		t.Error("Expected an error for a row that isn't listed")
+	}
+
+	hub, err = client.Collections.GetCollectionHub(ctx, 1, 43)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if hub.Promoted || hub.Identifier != CollectionHubIdentifier(1, 43) {
+		t.Errorf("Expected a collection that isn't promoted, got: %+v", hub)
+	}
+
+	visibility := CollectionVisibility{Library: true, Home: false, Shared: true}
+	if err := client.Hubs.UpdateHubVisibility(ctx, 1, HubIdentifierMovieRecentlyAdded, visibility); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := client.Hubs.UpdateHubVisibility(ctx, 1, CollectionHubIdentifier(1, 43), visibility); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	want := "promotedToOwnHome=0&promotedToRecommended=1&promotedToSharedHome=1"
+	if updated[HubIdentifierMovieRecentlyAdded.String()] != want {
+		t.Errorf("Unexpected update of the built-in row: %q", updated[HubIdentifierMovieRecentlyAdded.String()])
+	}
+	if updated["43"] != "metadataItemId=43&"+want {
+		t.Errorf("Expected the collection to be promoted, got: %q", updated["43"])
+	}
+}
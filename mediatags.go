@@ -0,0 +1,76 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Kinds of media tag artwork served under a container's MediaTagPrefix
+const (
+	MediaTagStudio          = "studio"          // Studio and network logos, by Metadata.Studio
+	MediaTagContentRating   = "contentRating"   // Content rating badges, e.g. "PG-13"
+	MediaTagVideoResolution = "videoResolution" // e.g. "1080" or "4k"
+	MediaTagVideoCodec      = "videoCodec"      // e.g. "h264" or "hevc"
+	MediaTagAudioCodec      = "audioCodec"      // e.g. "aac" or "truehd"
+	MediaTagAudioChannels   = "audioChannels"   // e.g. "6" for 5.1
+)
+
+// MediaTagPath returns the server path of the artwork of a media tag, e.g. the logo of a studio
+// with MediaTagStudio, built from the container's MediaTagPrefix and MediaTagVersion. It returns
+// "" if the container has no MediaTagPrefix. Not every value has artwork; the server answers 404
+// for those.
+func (c ContainerInfo) MediaTagPath(kind string, value string) string {
+	if c.MediaTagPrefix == "" || value == "" {
+		return ""
+	}
+
+	path := strings.TrimSuffix(c.MediaTagPrefix, "/") + "/" + kind + "/" + url.PathEscape(value)
+	if c.MediaTagVersion > 0 {
+		// The version changes when the server updates its artwork, so clients can cache by URL
+		path += "?t=" + strconv.FormatInt(c.MediaTagVersion, 10)
+	}
+	return path
+}
+
+// GetMediaTagURL returns the full URL of the artwork of a media tag, e.g. for an <img> tag in a
+// web UI. Like GetStreamURL, the URL carries the SDK's token so it can be requested without
+// headers; treat it as a secret. It returns an error if the container has no MediaTagPrefix.
+func (s *Library) GetMediaTagURL(ctx context.Context, info ContainerInfo, kind string, value string, opts ...operations.Option) (string, error) {
+	path := info.MediaTagPath(kind, value)
+	if path == "" {
+		return "", fmt.Errorf("no media tag artwork for %s %q", kind, value)
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	tagURL, err := url.Parse(strings.TrimSuffix(baseURL, "/") + path)
+	if err != nil {
+		return "", fmt.Errorf("error generating URL: %w", err)
+	}
+
+	token, err := newVideo(s.sdkConfiguration).streamToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	if token != "" {
+		query := tagURL.Query()
+		query.Set("X-Plex-Token", token)
+		tagURL.RawQuery = query.Encode()
+	}
+
+	return tagURL.String(), nil
+}
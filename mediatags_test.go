@@ -0,0 +1,37 @@
+package plexgo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMediaTagPath(t *testing.T) {
+	info := ContainerInfo{MediaTagPrefix: "/system/bundle/media/flags/", MediaTagVersion: 1700000000}
+
+	if path := info.MediaTagPath(MediaTagStudio, "Warner Bros."); path != "/system/bundle/media/flags/studio/Warner%20Bros.?t=1700000000" {
+		t.Errorf("Unexpected studio path: %s", path)
+	}
+	if path := info.MediaTagPath(MediaTagContentRating, "PG-13"); path != "/system/bundle/media/flags/contentRating/PG-13?t=1700000000" {
+		t.Errorf("Unexpected content rating path: %s", path)
+	}
+	if path := (ContainerInfo{}).MediaTagPath(MediaTagStudio, "A24"); path != "" {
+		t.Errorf("Expected no path without a prefix, got: %s", path)
+	}
+}
+
+func TestGetMediaTagURL(t *testing.T) {
+	client := New(WithServerURL("http://plex.local:32400"), WithSecurity("secret"))
+	info := ContainerInfo{MediaTagPrefix: "/system/bundle/media/flags/", MediaTagVersion: 1700000000}
+
+	tagURL, err := client.Library.GetMediaTagURL(context.Background(), info, MediaTagStudio, "Warner Bros.")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if tagURL != "http://plex.local:32400/system/bundle/media/flags/studio/Warner%20Bros.?X-Plex-Token=secret&t=1700000000" {
+		t.Errorf("Unexpected URL: %s", tagURL)
+	}
+
+	if _, err := client.Library.GetMediaTagURL(context.Background(), ContainerInfo{}, MediaTagStudio, "A24"); err == nil {
+		t.Errorf("Expected an error without a media tag prefix")
+	}
+}
@@ -0,0 +1,492 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// Tag represents a metadata tag such as a genre, label, actor or director.
+// The ID is the tag ID used by library filters (e.g. actor=<ID>).
+type Tag struct {
+	ID     int64  `json:"id,omitempty"`
+	Tag    string `json:"tag"`
+	TagKey string `json:"tagKey,omitempty"`
+	Role   string `json:"role,omitempty"` // Character name for actors
+	Thumb  string `json:"thumb,omitempty"`
+	Filter string `json:"filter,omitempty"`
+}
+
+// Metadata represents a library item (movie, show, season, episode, artist, album or track)
+type Metadata struct {
+	RatingKey             string         `json:"ratingKey"`
+	Key                   string         `json:"key"`
+	GUID                  string         `json:"guid,omitempty"`
+	ExternalGUIDs         []ExternalGUID `json:"Guid,omitempty"` // Only included by newer servers and plex.tv Discover
+	Type                  string         `json:"type"`
+	ExtraType             int            `json:"extraType,omitempty"` // Kind of extra, e.g. ExtraTypeTrailer; 0 for library items
+	Title                 string         `json:"title"`
+	TitleSort             string         `json:"titleSort,omitempty"`
+	OriginalTitle         string         `json:"originalTitle,omitempty"`
+	EditionTitle          string         `json:"editionTitle,omitempty"` // Edition of a movie, e.g. "Director's Cut"; "" for the default edition
+	Summary               string         `json:"summary,omitempty"`
+	Studio                string         `json:"studio,omitempty"`
+	ContentRating         string         `json:"contentRating,omitempty"`
+	Rating                float64        `json:"rating,omitempty"`
+	AudienceRating        float64        `json:"audienceRating,omitempty"`
+	Year                  int            `json:"year,omitempty"`
+	Index                 int            `json:"index,omitempty"`
+	ParentIndex           int            `json:"parentIndex,omitempty"`
+	Thumb                 string         `json:"thumb,omitempty"`
+	Art                   string         `json:"art,omitempty"`
+	Duration              int64          `json:"duration,omitempty"`
+	OriginallyAvailableAt string         `json:"originallyAvailableAt,omitempty"`
+	AddedAt               int64          `json:"addedAt,omitempty"`
+	UpdatedAt             int64          `json:"updatedAt,omitempty"`
+	LastViewedAt          int64          `json:"lastViewedAt,omitempty"`
+	ViewCount             int            `json:"viewCount,omitempty"`
+	ViewOffset            int64          `json:"viewOffset,omitempty"`
+	LeafCount             int            `json:"leafCount,omitempty"`
+	ViewedLeafCount       int            `json:"viewedLeafCount,omitempty"`
+	ChildCount            int            `json:"childCount,omitempty"`
+	ParentRatingKey       string         `json:"parentRatingKey,omitempty"`
+	ParentTitle           string         `json:"parentTitle,omitempty"`
+	GrandparentRatingKey  string         `json:"grandparentRatingKey,omitempty"`
+	GrandparentTitle      string         `json:"grandparentTitle,omitempty"`
+	SectionID             int            `json:"librarySectionID,omitempty"`
+	SectionTitle          string         `json:"librarySectionTitle,omitempty"`
+	Genre                 []Tag          `json:"Genre,omitempty"`
+	Role                  []Tag          `json:"Role,omitempty"`
+	Director              []Tag          `json:"Director,omitempty"`
+	Writer                []Tag          `json:"Writer,omitempty"`
+	Producer              []Tag          `json:"Producer,omitempty"`
+	Country               []Tag          `json:"Country,omitempty"`
+	Collection            []Tag          `json:"Collection,omitempty"`
+	Label                 []Tag          `json:"Label,omitempty"`
+	Mood                  []Tag          `json:"Mood,omitempty"`  // Music moods, e.g. "Melancholy"
+	Style                 []Tag          `json:"Style,omitempty"` // Music styles of artists and albums, e.g. "Trip Hop"
+	Media                 []MediaVersion `json:"Media,omitempty"`
+	Language              string         `json:"-"` // Language the text was requested in with WithLanguage, "" for the server's default
+
+	// Only included when requested with the IncludePreferences or IncludeAdvanced flags of WithInclude
+	Preferences *ItemPreferences `json:"Preferences,omitempty"`
+}
+
+// Flags of WithInclude, requesting details the server leaves out of item metadata by
+// default
+const (
+	IncludeCollections   = "includeCollections"   // The Collection tags of each item
+	IncludeExternalMedia = "includeExternalMedia" // Media available from external sources
+	IncludeAdvanced      = "includeAdvanced"      // Advanced settings, in Preferences
+	IncludePreferences   = "includePreferences"   // Preferences, such as the episode ordering of shows
+)
+
+// ItemPreferences are the preferences of a library item, included in its metadata when requested
+// with WithInclude. Settings use the same format as the preferences of a collection.
+type ItemPreferences struct {
+	Settings []CollectionPreference `json:"Setting,omitempty"`
+}
+
+// Get returns the value of a preference by ID
+func (p *ItemPreferences) Get(id string) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+	for _, setting := range p.Settings {
+		if setting.ID == id {
+			return setting.Value, true
+		}
+	}
+	return "", false
+}
+
+// ExternalGUID is an ID of a library item in an external database, e.g. "tmdb://603" or "imdb://tt0133093"
+type ExternalGUID struct {
+	ID string `json:"id"`
+}
+
+// ExternalID returns the item's ID in an external database (e.g. "tmdb", "tvdb" or "imdb"), or "" if unknown
+func (m Metadata) ExternalID(source string) string {
+	prefix := source + "://"
+	for _, guid := range m.ExternalGUIDs {
+		if strings.HasPrefix(guid.ID, prefix) {
+			return strings.TrimPrefix(guid.ID, prefix)
+		}
+	}
+	return ""
+}
+
+// OriginallyAvailable returns the release date of the item, or the date a photo was taken, and
+// false if it has none
+func (m Metadata) OriginallyAvailable() (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, m.OriginallyAvailableAt); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// MediaVersion represents a version of a library item, e.g. a 1080p and a 4K copy of the same movie
+type MediaVersion struct {
+	ID              int64       `json:"id"`
+	Title           string      `json:"title,omitempty"` // Name of the version if it has one, e.g. "Optimized for Mobile"
+	Duration        int64       `json:"duration,omitempty"`
+	Bitrate         int         `json:"bitrate,omitempty"` // Overall bitrate in kbps
+	Width           int         `json:"width,omitempty"`
+	Height          int         `json:"height,omitempty"`
+	AspectRatio     float64     `json:"aspectRatio,omitempty"`
+	AudioChannels   int         `json:"audioChannels,omitempty"`
+	AudioCodec      string      `json:"audioCodec,omitempty"`
+	VideoCodec      string      `json:"videoCodec,omitempty"`
+	VideoResolution string      `json:"videoResolution,omitempty"` // e.g. "sd", "720", "1080" or "4k"
+	VideoFrameRate  string      `json:"videoFrameRate,omitempty"`
+	VideoProfile    string      `json:"videoProfile,omitempty"`
+	Container       string      `json:"container,omitempty"`
+	Part            []MediaPart `json:"Part,omitempty"`
+}
+
+// MediaPart represents a file of a media version
+type MediaPart struct {
+	ID           int64         `json:"id"`
+	Key          string        `json:"key,omitempty"`
+	Duration     int64         `json:"duration,omitempty"`
+	File         string        `json:"file,omitempty"`
+	Size         int64         `json:"size,omitempty"` // File size in bytes
+	Container    string        `json:"container,omitempty"`
+	VideoProfile string        `json:"videoProfile,omitempty"`
+	AudioProfile string        `json:"audioProfile,omitempty"`
+	Stream       []MediaStream `json:"Stream,omitempty"` // Only included in full item metadata
+}
+
+// Stream types of a MediaStream
+const (
+	StreamTypeVideo    = 1
+	StreamTypeAudio    = 2
+	StreamTypeSubtitle = 3
+	StreamTypeLyrics   = 4
+)
+
+// MediaStream represents a video, audio, subtitle or lyrics stream of a media part
+type MediaStream struct {
+	ID           int64  `json:"id"`
+	StreamType   int    `json:"streamType"`
+	Key          string `json:"key,omitempty"` // Path of external subtitle and lyrics files, e.g. /library/streams/123
+	Default      bool   `json:"default,omitempty"`
+	Selected     bool   `json:"selected,omitempty"`
+	Index        int    `json:"index,omitempty"`
+	Codec        string `json:"codec,omitempty"`
+	Bitrate      int    `json:"bitrate,omitempty"`
+	Language     string `json:"language,omitempty"`
+	LanguageCode string `json:"languageCode,omitempty"`
+	Title        string `json:"title,omitempty"`
+	DisplayTitle string `json:"displayTitle,omitempty"`
+
+	// Video attributes
+	Profile           string  `json:"profile,omitempty"`
+	BitDepth          int     `json:"bitDepth,omitempty"`
+	Width             int     `json:"width,omitempty"`
+	Height            int     `json:"height,omitempty"`
+	FrameRate         float64 `json:"frameRate,omitempty"`
+	ChromaSubsampling string  `json:"chromaSubsampling,omitempty"`
+	ColorPrimaries    string  `json:"colorPrimaries,omitempty"`
+	ColorRange        string  `json:"colorRange,omitempty"`
+	ColorSpace        string  `json:"colorSpace,omitempty"`
+	ColorTrc          string  `json:"colorTrc,omitempty"`
+	DOVIPresent       bool    `json:"DOVIPresent,omitempty"`
+	DOVIProfile       int     `json:"DOVIProfile,omitempty"`
+	DOVILevel         int     `json:"DOVILevel,omitempty"`
+	DOVIBLPresent     bool    `json:"DOVIBLPresent,omitempty"`
+	DOVIELPresent     bool    `json:"DOVIELPresent,omitempty"`
+	DOVIRPUPresent    bool    `json:"DOVIRPUPresent,omitempty"`
+
+	// Audio attributes
+	Channels           int    `json:"channels,omitempty"`
+	AudioChannelLayout string `json:"audioChannelLayout,omitempty"` // e.g. "5.1(side)" or "7.1"
+	SamplingRate       int    `json:"samplingRate,omitempty"`
+
+	// Subtitle and lyrics attributes
+	Format   string `json:"format,omitempty"`   // e.g. "srt", "lrc" or "txt"
+	Provider string `json:"provider,omitempty"` // Agent that supplied the file, e.g. com.plexapp.agents.lyricfind
+}
+
+// IsDolbyVision returns true if the stream carries Dolby Vision metadata
+func (m MediaStream) IsDolbyVision() bool {
+	return m.DOVIPresent
+}
+
+// IsHDR10 returns true if the stream uses the PQ transfer function used by HDR10 and HDR10+
+func (m MediaStream) IsHDR10() bool {
+	return m.ColorTrc == "smpte2084"
+}
+
+// IsHLG returns true if the stream uses the hybrid log-gamma transfer function
+func (m MediaStream) IsHLG() bool {
+	return m.ColorTrc == "arib-std-b67"
+}
+
+// IsHDR returns true if the stream is HDR10, HLG or Dolby Vision
+func (m MediaStream) IsHDR() bool {
+	return m.IsHDR10() || m.IsHLG() || m.IsDolbyVision()
+}
+
+// Streams returns the streams of the given type across all parts of the media version
+func (m MediaVersion) Streams(streamType int) []MediaStream {
+	streams := []MediaStream{}
+	for _, part := range m.Part {
+		for _, stream := range part.Stream {
+			if stream.StreamType == streamType {
+				streams = append(streams, stream)
+			}
+		}
+	}
+	return streams
+}
+
+// MetadataMediaContainer represents a media container holding library items
+type MetadataMediaContainer struct {
+	ContainerInfo
+	Metadata []Metadata `json:"Metadata,omitempty"`
+}
+
+// MetadataResponse represents a response containing library items
+type MetadataResponse struct {
+	MediaContainer MetadataMediaContainer `json:"MediaContainer"`
+}
+
+// listMetadata performs a GET request against a library endpoint and returns the items it lists
+func (s *Library) listMetadata(ctx context.Context, path string, queryParams url.Values, operationID string, opts ...operations.Option) ([]Metadata, error) {
+	container, err := s.listMetadataContainer(ctx, path, queryParams, operationID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return container.Metadata, nil
+}
+
+// listMetadataContainer is listMetadata returning the whole media container, whose TotalSize is
+// needed to page through a listing
+func (s *Library) listMetadataContainer(ctx context.Context, path string, queryParams url.Values, operationID string, opts ...operations.Option) (*MetadataMediaContainer, error) {
+	options := processOptions(opts)
+	if len(options.Include) > 0 {
+		params := url.Values{}
+		for key, values := range queryParams {
+			params[key] = values
+		}
+		for _, flag := range options.Include {
+			params.Set(flag, "1")
+		}
+		queryParams = params
+	}
+
+	var out MetadataResponse
+	if err := s.getJSON(ctx, path, queryParams, operationID, &out, opts...); err != nil {
+		return nil, err
+	}
+
+	if language := s.sdkConfiguration.requestLanguage(options); language != "" {
+		for i := range out.MediaContainer.Metadata {
+			out.MediaContainer.Metadata[i].Language = language
+		}
+	}
+
+	return &out.MediaContainer, nil
+}
+
+// getJSON performs a GET request against a library endpoint and decodes the JSON response into out
+func (s *Library) getJSON(ctx context.Context, path string, queryParams url.Values, operationID string, out interface{}, opts ...operations.Option) error {
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	// The path may carry a raw query, such as an encoded smart filter whose parameter order matters
+	path, rawQuery, _ := strings.Cut(path, "?")
+
+	opURL, err := url.JoinPath(baseURL, path)
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	if len(queryParams) > 0 {
+		if rawQuery != "" {
+			rawQuery += "&"
+		}
+		rawQuery += queryParams.Encode()
+	}
+	if rawQuery != "" {
+		opURL = fmt.Sprintf("%s?%s", opURL, rawQuery)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    operationID,
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(operationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	rawBody, err := utils.ConsumeRawBody(httpRes)
+	if err != nil {
+		return err
+	}
+
+	return s.sdkConfiguration.decodeResponse(operationID, rawBody, out)
+}
+
+// editMetadata edits the fields of a library item with the metadata edit API, e.g.
+// {"titleSort.value": "Matrix", "titleSort.locked": "1"}
+func (s *Library) editMetadata(ctx context.Context, sectionID int, item Metadata, fields map[string]string, operationID string, opts ...operations.Option) error {
+	itemType := collectionItemTypeFromName(item.Type)
+	if itemType == 0 {
+		return fmt.Errorf("items of type %s cannot be edited", item.Type)
+	}
+
+	return s.editMetadataBulk(ctx, sectionID, itemType, []string{item.RatingKey}, fields, operationID, opts...)
+}
+
+// editMetadataBulk edits the same fields of several items of one type in a library section
+func (s *Library) editMetadataBulk(ctx context.Context, sectionID int, itemType MetadataType, ratingKeys []string, fields map[string]string, operationID string, opts ...operations.Option) error {
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/sections/%d/all", sectionID))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	for k, v := range fields {
+		queryParams.Set(k, v)
+	}
+	queryParams.Set("type", strconv.Itoa(int(itemType)))
+	queryParams.Set("id", strings.Join(ratingKeys, ","))
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    operationID,
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		_, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// ErrSkipChildren can be returned by a WalkFunc to skip the children of the item it was called with
+var ErrSkipChildren = errors.New("skip children")
+
+// ErrStopWalk can be returned by a WalkFunc to stop walking without WalkTree returning an error
+var ErrStopWalk = errors.New("stop walk")
+
+// WalkFunc is called by WalkTree for each item of a tree, with the depth of the item below the
+// root, which is at depth 0
+type WalkFunc func(item Metadata, depth int) error
+
+// leafTypes are the item types without children
+var leafTypes = map[string]bool{
+	"movie":   true,
+	"episode": true,
+	"track":   true,
+	"clip":    true,
+	"photo":   true,
+}
+
+// GetChildren gets the direct children of a library item: the seasons of a show, the episodes of
+// a season, the albums of an artist or the tracks of an album
+func (s *Library) GetChildren(ctx context.Context, ratingKey int, opts ...operations.Option) ([]Metadata, error) {
+	return s.listMetadata(ctx, fmt.Sprintf("/library/metadata/%d/children", ratingKey), nil, "getChildren", opts...)
+}
+
+// WalkTree calls fn for a library item and then, depth first, for each of its descendants, e.g. a
+// show, its first season, the season's episodes, the second season and so on. Children are
+// fetched one parent at a time as the walk reaches them, and never for movies, episodes, tracks,
+// clips or photos. If fn returns ErrSkipChildren the item's children are skipped, ErrStopWalk
+// stops the walk, and any other error stops the walk and is returned.
+func (s *Library) WalkTree(ctx context.Context, ratingKey int, fn WalkFunc, opts ...operations.Option) error {
+	root, err := s.GetItem(ctx, ratingKey, opts...)
+	if err != nil {
+		return err
+	}
+
+	err = s.walk(ctx, *root, 0, fn, opts)
+	if errors.Is(err, ErrStopWalk) {
+		return nil
+	}
+	return err
+}
+
+// walk calls fn for item and walks its children
+func (s *Library) walk(ctx context.Context, item Metadata, depth int, fn WalkFunc, opts []operations.Option) error {
+	if err := fn(item, depth); err != nil {
+		if errors.Is(err, ErrSkipChildren) {
+			return nil
+		}
+		return err
+	}
+
+	if leafTypes[item.Type] {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	ratingKey, err := strconv.Atoi(item.RatingKey)
+	if err != nil {
+		return fmt.Errorf("error converting rating key of %s to int: %w", item.Title, err)
+	}
+
+	children, err := s.GetChildren(ctx, ratingKey, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting children of %s: %w", item.Title, err)
+	}
+
+	for _, child := range children {
+		if err := s.walk(ctx, child, depth+1, fn, opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
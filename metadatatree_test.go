@@ -0,0 +1,126 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// showTreeServer serves a show with two seasons of two episodes each
+func showTreeServer(t *testing.T, requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/library/metadata/10":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","type":"show","title":"Severance","leafCount":4,"childCount":2}]}}`))
+		case "/library/metadata/10/children":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"11","type":"season","title":"Season 1","index":1,"parentRatingKey":"10"},
+				{"ratingKey":"12","type":"season","title":"Season 2","index":2,"parentRatingKey":"10"}
+			]}}`))
+		case "/library/metadata/11/children", "/library/metadata/12/children":
+			season := strings.Split(r.URL.Path, "/")[3]
+			fmt.Fprintf(w, `{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"%[1]s1","type":"episode","title":"Episode 1","index":1,"parentRatingKey":"%[1]s"},
+				{"ratingKey":"%[1]s2","type":"episode","title":"Episode 2","index":2,"parentRatingKey":"%[1]s"}
+			]}}`, season)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestGetChildren(t *testing.T) {
+	var requests []string
+	server := showTreeServer(t, &requests)
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	seasons, err := client.Library.GetChildren(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(seasons) != 2 || seasons[0].Type != "season" || seasons[1].Index != 2 || seasons[1].ParentRatingKey != "10" {
+		t.Errorf("Unexpected seasons: %+v", seasons)
+	}
+}
+
+func TestWalkTree(t *testing.T) {
+	var requests []string
+	server := showTreeServer(t, &requests)
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	walk := func(fn WalkFunc) ([]string, error) {
+		requests = nil
+		var visited []string
+		err := client.Library.WalkTree(context.Background(), 10, func(item Metadata, depth int) error {
+			visited = append(visited, fmt.Sprintf("%d:%s", depth, item.RatingKey))
+			return fn(item, depth)
+		})
+		return visited, err
+	}
+
+	visited, err := walk(func(item Metadata, depth int) error { return nil })
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if got := strings.Join(visited, ","); got != "0:10,1:11,2:111,2:112,1:12,2:121,2:122" {
+		t.Errorf("Expected a depth first walk, got: %s", got)
+	}
+	if len(requests) != 4 {
+		t.Errorf("Expected episodes not to be asked for children, got requests: %v", requests)
+	}
+
+	t.Run("skip children", func(t *testing.T) {
+		visited, err := walk(func(item Metadata, depth int) error {
+			if item.RatingKey == "11" {
+				return ErrSkipChildren
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if got := strings.Join(visited, ","); got != "0:10,1:11,1:12,2:121,2:122" {
+			t.Errorf("Expected the first season's episodes to be skipped, got: %s", got)
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		visited, err := walk(func(item Metadata, depth int) error {
+			if item.RatingKey == "112" {
+				return ErrStopWalk
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if got := strings.Join(visited, ","); got != "0:10,1:11,2:111,2:112" {
+			t.Errorf("Expected the walk to stop, got: %s", got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		failure := errors.New("failure")
+		_, err := walk(func(item Metadata, depth int) error {
+			if item.RatingKey == "12" {
+				return failure
+			}
+			return nil
+		})
+		if !errors.Is(err, failure) {
+			t.Errorf("Expected the callback's error, got: %v", err)
+		}
+	})
+}
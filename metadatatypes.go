@@ -0,0 +1,119 @@
+package plexgo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MetadataType is a Plex metadata type, the numeric type of library filters, smart collections
+// and presets. Metadata.Type holds the name of the type, see Metadata.MetadataType.
+type MetadataType int
+
+// MetadataType values
+const (
+	MetadataTypeMovie      MetadataType = 1
+	MetadataTypeShow       MetadataType = 2
+	MetadataTypeSeason     MetadataType = 3
+	MetadataTypeEpisode    MetadataType = 4
+	MetadataTypeArtist     MetadataType = 8
+	MetadataTypeAlbum      MetadataType = 9
+	MetadataTypeTrack      MetadataType = 10
+	MetadataTypePlaylist   MetadataType = 15
+	MetadataTypeCollection MetadataType = 18
+)
+
+// metadataTypeNames maps the metadata types to the names the server uses for them
+var metadataTypeNames = map[MetadataType]string{
+	MetadataTypeMovie:      "movie",
+	MetadataTypeShow:       "show",
+	MetadataTypeSeason:     "season",
+	MetadataTypeEpisode:    "episode",
+	MetadataTypeArtist:     "artist",
+	MetadataTypeAlbum:      "album",
+	MetadataTypeTrack:      "track",
+	MetadataTypePlaylist:   "playlist",
+	MetadataTypeCollection: "collection",
+}
+
+// String returns the name of the type, e.g. "movie", or "unknown"
+func (t MetadataType) String() string {
+	if name, ok := metadataTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseMetadataType parses the name of a metadata type, e.g. "movie" or "Episode", or its number,
+// e.g. "1"
+func ParseMetadataType(s string) (MetadataType, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		if _, ok := metadataTypeNames[MetadataType(n)]; ok {
+			return MetadataType(n), nil
+		}
+		return 0, fmt.Errorf("unknown metadata type %d", n)
+	}
+
+	for t, name := range metadataTypeNames {
+		if strings.EqualFold(name, s) {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown metadata type %q", s)
+}
+
+// metadataTypeFromName returns the metadata type of a type name, or 0 if unknown
+func metadataTypeFromName(name string) MetadataType {
+	for t, typeName := range metadataTypeNames {
+		if typeName == name {
+			return t
+		}
+	}
+	return 0
+}
+
+// MetadataType returns the metadata type of the item, or 0 if unknown, e.g. for photos and clips
+func (m Metadata) MetadataType() MetadataType {
+	return metadataTypeFromName(m.Type)
+}
+
+// SectionType is the type of a library section
+type SectionType string
+
+// SectionType values
+const (
+	SectionTypeMovie  SectionType = "movie"
+	SectionTypeShow   SectionType = "show"
+	SectionTypeArtist SectionType = "artist"
+	SectionTypePhoto  SectionType = "photo"
+)
+
+// ParseSectionType parses the type of a library section, e.g. "movie" or "Show"
+func ParseSectionType(s string) (SectionType, error) {
+	for _, t := range []SectionType{SectionTypeMovie, SectionTypeShow, SectionTypeArtist, SectionTypePhoto} {
+		if strings.EqualFold(string(t), strings.TrimSpace(s)) {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unknown section type %q", s)
+}
+
+// String returns the type, e.g. "movie"
+func (t SectionType) String() string {
+	return string(t)
+}
+
+// MetadataType returns the type of the top-level items of a section of the type, e.g.
+// MetadataTypeShow for show sections, or 0 for photo sections
+func (t SectionType) MetadataType() MetadataType {
+	switch t {
+	case SectionTypeMovie:
+		return MetadataTypeMovie
+	case SectionTypeShow:
+		return MetadataTypeShow
+	case SectionTypeArtist:
+		return MetadataTypeArtist
+	}
+	return 0
+}
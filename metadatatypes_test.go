@@ -0,0 +1,40 @@
+package plexgo
+
+import "testing"
+
+func TestMetadataType(t *testing.T) {
+	if MetadataTypeEpisode.String() != "episode" || MetadataTypeCollection.String() != "collection" {
+		t.Errorf("Unexpected names: %s, %s", MetadataTypeEpisode, MetadataTypeCollection)
+	}
+	if MetadataType(7).String() != "unknown" {
+		t.Errorf("Expected unknown, got %s", MetadataType(7))
+	}
+
+	for input, expected := range map[string]MetadataType{"movie": MetadataTypeMovie, " Track ": MetadataTypeTrack, "15": MetadataTypePlaylist} {
+		if parsed, err := ParseMetadataType(input); err != nil || parsed != expected {
+			t.Errorf("ParseMetadataType(%q) = %v, %v; expected %v", input, parsed, err, expected)
+		}
+	}
+	for _, input := range []string{"photo", "7", ""} {
+		if _, err := ParseMetadataType(input); err == nil {
+			t.Errorf("Expected an error parsing %q", input)
+		}
+	}
+
+	if (Metadata{Type: "season"}).MetadataType() != MetadataTypeSeason || (Metadata{Type: "clip"}).MetadataType() != 0 {
+		t.Error("Unexpected metadata types of items")
+	}
+}
+
+func TestSectionType(t *testing.T) {
+	sectionType, err := ParseSectionType("Show")
+	if err != nil || sectionType != SectionTypeShow {
+		t.Fatalf("Expected show, got %v, %v", sectionType, err)
+	}
+	if sectionType.MetadataType() != MetadataTypeShow || SectionTypePhoto.MetadataType() != 0 {
+		t.Error("Unexpected metadata types of sections")
+	}
+	if _, err := ParseSectionType("podcast"); err == nil {
+		t.Error("Expected an error parsing podcast")
+	}
+}
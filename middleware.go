@@ -0,0 +1,55 @@
+package plexgo
+
+import "net/http"
+
+// Middleware wraps the round tripper that sends the SDK's requests, e.g. to record metrics, cache
+// responses or sign requests. It returns a round tripper that calls next to continue the chain.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to an http.RoundTripper, for writing middlewares inline
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req)
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithMiddleware adds middlewares to the chain every request of the SDK passes through. Middlewares
+// run in the order they are added, the first being the outermost, and wrap the HTTP client set
+// with WithClient. Requests pass through the chain after the BeforeRequest hooks.
+func WithMiddleware(middlewares ...Middleware) SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.Middlewares = append(sdk.sdkConfiguration.Middlewares, middlewares...)
+	}
+}
+
+// middlewareClient sends requests through a middleware chain
+type middlewareClient struct {
+	client    HTTPClient // Client at the end of the chain
+	transport http.RoundTripper
+}
+
+func (c *middlewareClient) Do(req *http.Request) (*http.Response, error) {
+	return c.transport.RoundTrip(req)
+}
+
+// CloseIdleConnections closes the idle connections of the wrapped client, if it keeps any
+func (c *middlewareClient) CloseIdleConnections() {
+	if client, ok := c.client.(interface{ CloseIdleConnections() }); ok {
+		client.CloseIdleConnections()
+	}
+}
+
+// applyMiddlewares wraps a client in a chain of middlewares
+func applyMiddlewares(client HTTPClient, middlewares []Middleware) HTTPClient {
+	if len(middlewares) == 0 {
+		return client
+	}
+
+	var transport http.RoundTripper = RoundTripperFunc(client.Do)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		transport = middlewares[i](transport)
+	}
+
+	return &middlewareClient{client: client, transport: transport}
+}
@@ -0,0 +1,71 @@
+package plexgo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Signature") != "signed" {
+			t.Errorf("Expected signed request, got headers: %v", r.Header)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+	}))
+	defer server.Close()
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				res, err := next.RoundTrip(req)
+				calls = append(calls, name+" after")
+				return res, err
+			})
+		}
+	}
+	sign := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Signature", "signed")
+			return next.RoundTrip(req)
+		})
+	}
+
+	client := New(WithServerURL(server.URL), WithMiddleware(record("metrics")), WithMiddleware(record("cache"), sign))
+
+	if _, err := client.Activities.GetServerActivities(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "metrics before,cache before,cache after,metrics after"
+	if got := strings.Join(calls, ","); got != expected {
+		t.Errorf("Expected calls %q, got: %q", expected, got)
+	}
+
+	// Middlewares can answer requests themselves
+	cached := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"MediaContainer":{"size":1}}`)),
+				Request:    req,
+			}, nil
+		})
+	}
+
+	client = New(WithServerURL("http://unreachable.invalid"), WithMiddleware(cached))
+	res, err := client.Activities.GetServerActivities(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if res.Object.MediaContainer.Size == nil || *res.Object.MediaContainer.Size != 1 {
+		t.Errorf("Expected the cached response, got: %+v", res.Object.MediaContainer)
+	}
+}
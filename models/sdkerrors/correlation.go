@@ -0,0 +1,25 @@
+package sdkerrors
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CorrelationIDHeader is the request header carrying the correlation ID of an SDK operation
+const CorrelationIDHeader = "X-Correlation-ID"
+
+// correlationID returns the correlation ID of the request of a response, or "" if it has none
+func correlationID(httpRes *http.Response) string {
+	if httpRes == nil || httpRes.Request == nil {
+		return ""
+	}
+	return httpRes.Request.Header.Get(CorrelationIDHeader)
+}
+
+// withCorrelationID appends the correlation ID to an error message, if there is one
+func withCorrelationID(message string, correlationID string) string {
+	if correlationID == "" {
+		return message
+	}
+	return fmt.Sprintf("%s (correlation ID %s)", message, correlationID)
+}
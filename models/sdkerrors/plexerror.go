@@ -0,0 +1,81 @@
+package sdkerrors
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// PlexErrorDetail is a single entry of the errors list in a Plex error response
+type PlexErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  int    `json:"status"`
+}
+
+// PlexError is an error response from Plex with a body of the form
+// {"errors":[{"code":1001,"message":"..."}]}. Code and Message are those of the first error, and
+// Status is the HTTP status code. It unwraps to an *SDKError for callers matching on that type.
+type PlexError struct {
+	Code          int
+	Message       string
+	Status        int
+	Errors        []PlexErrorDetail
+	Body          string
+	RawResponse   *http.Response
+	CorrelationID string // Correlation ID of the operation's request, see CorrelationIDHeader
+}
+
+var _ error = &PlexError{}
+
+func (e *PlexError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, detail := range e.Errors {
+		messages = append(messages, detail.Message)
+	}
+
+	return withCorrelationID(fmt.Sprintf("plex error %d: %s: Status %d", e.Code, strings.Join(messages, "; "), e.Status), e.CorrelationID)
+}
+
+func (e *PlexError) Unwrap() error {
+	return NewSDKError("API error occurred", e.Status, e.Body, e.RawResponse)
+}
+
+// NewAPIError returns a *PlexError if body is a Plex error response, or an *SDKError otherwise
+func NewAPIError(statusCode int, body string, httpRes *http.Response) error {
+	var out struct {
+		Errors []PlexErrorDetail `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(body), &out); err != nil || len(out.Errors) == 0 || out.Errors[0].Message == "" {
+		return NewSDKError("API error occurred", statusCode, body, httpRes)
+	}
+
+	return &PlexError{
+		Code:          out.Errors[0].Code,
+		Message:       out.Errors[0].Message,
+		Status:        statusCode,
+		Errors:        out.Errors,
+		Body:          body,
+		RawResponse:   httpRes,
+		CorrelationID: correlationID(httpRes),
+	}
+}
+
+// NewAPIErrorFromResponse reads the body of an error response and returns it as NewAPIError does.
+// The body is restored so the response can still be read by the caller.
+func NewAPIErrorFromResponse(httpRes *http.Response) error {
+	body := ""
+	if httpRes.Body != nil {
+		data, err := io.ReadAll(httpRes.Body)
+		httpRes.Body.Close()
+		if err == nil {
+			body = string(data)
+		}
+		httpRes.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	return NewAPIError(httpRes.StatusCode, body, httpRes)
+}
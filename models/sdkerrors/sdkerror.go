@@ -8,20 +8,22 @@ import (
 )
 
 type SDKError struct {
-	Message     string
-	StatusCode  int
-	Body        string
-	RawResponse *http.Response
+	Message       string
+	StatusCode    int
+	Body          string
+	RawResponse   *http.Response
+	CorrelationID string // Correlation ID of the operation's request, see CorrelationIDHeader
 }
 
 var _ error = &SDKError{}
 
 func NewSDKError(message string, statusCode int, body string, httpRes *http.Response) *SDKError {
 	return &SDKError{
-		Message:     message,
-		StatusCode:  statusCode,
-		Body:        body,
-		RawResponse: httpRes,
+		Message:       message,
+		StatusCode:    statusCode,
+		Body:          body,
+		RawResponse:   httpRes,
+		CorrelationID: correlationID(httpRes),
 	}
 }
 
@@ -31,5 +33,5 @@ func (e *SDKError) Error() string {
 		body = fmt.Sprintf("\n%s", e.Body)
 	}
 
-	return fmt.Sprintf("%s: Status %d%s", e.Message, e.StatusCode, body)
+	return withCorrelationID(fmt.Sprintf("%s: Status %d", e.Message, e.StatusCode), e.CorrelationID) + body
 }
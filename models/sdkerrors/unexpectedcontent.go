@@ -0,0 +1,35 @@
+package sdkerrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrUnexpectedContent matches an *UnexpectedContentError with errors.Is
+var ErrUnexpectedContent = errors.New("unexpected response content")
+
+// UnexpectedContentError is returned when a response that should be JSON or XML is something else,
+// typically the HTML of a reverse proxy error or an authentication portal's login page. URL is the
+// URL of the final request, after redirects, and Snippet the start of the body.
+type UnexpectedContentError struct {
+	ContentType string
+	StatusCode  int
+	URL         string
+	Snippet     string
+	RawResponse *http.Response
+}
+
+var _ error = &UnexpectedContentError{}
+
+func (e *UnexpectedContentError) Error() string {
+	return fmt.Sprintf("unexpected %s response from %s, check for a proxy or login page in front of the server: Status %d\n%s", e.ContentType, e.URL, e.StatusCode, e.Snippet)
+}
+
+func (e *UnexpectedContentError) Is(target error) bool {
+	return target == ErrUnexpectedContent
+}
+
+func (e *UnexpectedContentError) Unwrap() error {
+	return NewSDKError("API error occurred", e.StatusCode, e.Snippet, e.RawResponse)
+}
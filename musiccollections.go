@@ -0,0 +1,65 @@
+package plexgo
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// musicFilterFields maps the genre and decade fields of music item types that don't have them to
+// the album field Plex filters them by
+var musicFilterFields = map[MetadataType]map[string]string{
+	CollectionItemTypeArtist: {"decade": "album.decade"},
+	CollectionItemTypeTrack:  {"decade": "album.decade", "genre": "album.genre"},
+}
+
+// filterField returns the field that filters items of itemType by field, e.g. album.decade for
+// the decade of tracks
+func filterField(itemType MetadataType, field string) string {
+	if mapped, ok := musicFilterFields[itemType][field]; ok {
+		return mapped
+	}
+	return field
+}
+
+// InGenre returns a condition matching items with the genre of the given tag ID, as found in an
+// item's Genre tags. Tracks have no genres of their own and are matched by their album's genres.
+func InGenre(itemType MetadataType, genreID int64) FilterCondition {
+	return Cond(filterField(itemType, "genre"), FilterOpContains, strconv.FormatInt(genreID, 10))
+}
+
+// InDecade returns a condition matching items released in the decade containing year, e.g.
+// InDecade(CollectionItemTypeAlbum, 1994) encodes as decade=1990. Artists match when any of their
+// albums was released in the decade and tracks by the decade of their album.
+func InDecade(itemType MetadataType, year int) FilterCondition {
+	return Cond(filterField(itemType, "decade"), FilterOpContains, strconv.Itoa(year-year%10))
+}
+
+// HasMood returns a condition matching artists, albums or tracks with the mood of the given tag ID
+func HasMood(moodID int64) FilterCondition {
+	return Cond("mood", FilterOpContains, strconv.FormatInt(moodID, 10))
+}
+
+// validateMusicCondition checks that mood, style, genre and decade conditions are supported by
+// the item type they filter, and that decades are given by their first year. Plex silently
+// returns no items otherwise.
+func validateMusicCondition(itemType MetadataType, c FilterCondition) error {
+	fieldType, field := filterFieldType(itemType, c.Field)
+
+	switch field {
+	case "mood", "style":
+		if collectionItemFamily(fieldType) != CollectionItemTypeArtist {
+			return fmt.Errorf("%s cannot filter %s items", c.Field, CollectionItemTypeKeys[fieldType])
+		}
+	case "genre", "decade":
+		if mapped := filterField(fieldType, field); mapped != field {
+			return fmt.Errorf("%s cannot filter %s items; use %s", c.Field, CollectionItemTypeKeys[fieldType], mapped)
+		}
+	}
+
+	if field == "decade" && (c.Operator == FilterOpContains || c.Operator == FilterOpNotContains) {
+		if year, err := strconv.Atoi(c.Value); err != nil || year%10 != 0 {
+			return invalidArgument("invalid decade %q; use the first year of the decade, e.g. 1990", c.Value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMusicFilters(t *testing.T) {
+	tests := []struct {
+		filter *SmartFilter
+		want   string
+	}{
+		{NewSmartFilter(CollectionItemTypeAlbum).Add(InGenre(CollectionItemTypeAlbum, 12), InDecade(CollectionItemTypeAlbum, 1994)), "?type=9&genre=12&decade=1990"},
+		{NewSmartFilter(CollectionItemTypeArtist).Add(InDecade(CollectionItemTypeArtist, 1970), HasMood(7)), "?type=8&album.decade=1970&mood=7"},
+		{NewSmartFilter(CollectionItemTypeTrack).Add(InGenre(CollectionItemTypeTrack, 12), HasMood(7)), "?type=10&album.genre=12&mood=7"},
+	}
+	for _, tt := range tests {
+		if err := tt.filter.Validate(); err != nil {
+			t.Errorf("Expected %s to be valid, got: %v", tt.want, err)
+		}
+		if got := tt.filter.String(); got != tt.want {
+			t.Errorf("Expected %s, got: %s", tt.want, got)
+		}
+	}
+
+	invalid := []*SmartFilter{
+		NewSmartFilter(CollectionItemTypeMovie).Add(HasMood(7)),
+		NewSmartFilter(CollectionItemTypeArtist).Where("decade", FilterOpContains, "1990"),
+		NewSmartFilter(CollectionItemTypeTrack).Where("genre", FilterOpContains, "12"),
+		NewSmartFilter(CollectionItemTypeAlbum).Where("decade", FilterOpContains, "1994"),
+		NewSmartFilter(CollectionItemTypeShow).Where("episode.style", FilterOpContains, "3"),
+	}
+	for _, f := range invalid {
+		if err := f.Validate(); err == nil {
+			t.Errorf("Expected an error for %s", f)
+		}
+	}
+
+	// Decades can still be compared as numbers
+	if err := NewSmartFilter(CollectionItemTypeAlbum).Where("decade", FilterOpGreaterThan, "1985").Validate(); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+
+	if preset := DecadePreset(CollectionItemTypeTrack, 1994); preset.Filter != "album.decade=1990" {
+		t.Errorf("Unexpected track decade preset: %s", preset.Filter)
+	}
+}
+
+// musicSectionServer serves a music section with two artists, an album of each and a track
+func musicSectionServer(t *testing.T, created *[]string) *httptest.Server {
+	items := map[string]string{
+		"1":   `{"ratingKey":"1","type":"artist","title":"Massive Attack"}`,
+		"2":   `{"ratingKey":"2","type":"artist","title":"Portishead"}`,
+		"50":  `{"ratingKey":"50","type":"album","title":"Mezzanine","parentRatingKey":"1"}`,
+		"60":  `{"ratingKey":"60","type":"album","title":"Dummy","parentRatingKey":"2"}`,
+		"100": `{"ratingKey":"100","type":"track","title":"Teardrop","parentRatingKey":"50"}`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/library/metadata/"):
+			metadata := []string{}
+			for _, key := range strings.Split(strings.TrimPrefix(r.URL.Path, "/library/metadata/"), ",") {
+				metadata = append(metadata, items[key])
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[` + strings.Join(metadata, ",") + `]}}`))
+		case r.URL.Path == "/library/sections/3/mood":
+			w.Write([]byte(`{"MediaContainer":{"Directory":[{"key":"7","title":"Melancholy"}]}}`))
+		case r.URL.Path == "/library/sections/3/all":
+			if query.Get("type") != "9" || query.Get("mood") != "7" {
+				w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[` + items["50"] + `,` + items["60"] + `]}}`))
+		case r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"machineIdentifier":"abc123"}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			*created = append(*created, r.URL.RawQuery)
+			w.Header().Set("Location", "/library/collections/40")
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/library/collections/40":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"40","title":"Trip Hop","type":"collection","subtype":"album","librarySectionID":3}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+}
+
+func TestCreateMusicCollections(t *testing.T) {
+	var created []string
+	server := musicSectionServer(t, &created)
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	if _, err := client.Collections.CreateCollection(ctx, 3, "Trip Hop", []string{"50", "60"}, WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(created) != 1 || !strings.Contains(created[0], "type=9") {
+		t.Errorf("Expected an album collection, got: %v", created)
+	}
+
+	// Music collections hold a single type
+	if _, err := client.Collections.CreateCollection(ctx, 3, "Bristol", []string{"1", "60"}); err == nil {
+		t.Errorf("Expected an error when mixing artists and albums")
+	}
+	if err := client.Collections.AddToCollection(ctx, 40, []string{"100"}); err == nil || !strings.Contains(err.Error(), "cannot add track item 100 to a album collection") {
+		t.Errorf("Expected an error adding a track to an album collection, got: %v", err)
+	}
+
+	created = nil
+	collections, err := client.Collections.CreatePresets(ctx, 3, []Preset{MoodPreset(CollectionItemTypeAlbum, "melancholy")}, WithWaitForActivity(time.Second))
+	if err != nil || len(collections) != 1 {
+		t.Fatalf("Expected the mood preset to be created, got: %v", err)
+	}
+	if len(created) != 1 || !strings.Contains(created[0], "type=9") || !strings.Contains(created[0], "smart=1") || !strings.Contains(created[0], "mood%3D7") {
+		t.Errorf("Unexpected smart collection: %v", created)
+	}
+}
@@ -0,0 +1,368 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// defaultNewItemInterval is the time between checks for new items when NewItemPipeline.Interval is not set
+const defaultNewItemInterval = 5 * time.Minute
+
+// NewItemEvent is a batch of new items of a library section matched by a rule
+type NewItemEvent struct {
+	SectionID  int        `json:"sectionId"`
+	Rule       string     `json:"rule"`
+	Items      []Metadata `json:"items"`
+	DetectedAt time.Time  `json:"detectedAt"`
+}
+
+// RatingKeys returns the rating keys of the event's items
+func (e NewItemEvent) RatingKeys() []string {
+	keys := make([]string, len(e.Items))
+	for i, item := range e.Items {
+		keys[i] = item.RatingKey
+	}
+	return keys
+}
+
+// NewItemAction is run with the new items matched by a NewItemRule. Built-in actions are
+// Collections.AddToCollectionAction and Library.AddLabelsAction; notify.NewItemAction sends a
+// notification, and NewItemActionFunc turns a function into an action.
+type NewItemAction interface {
+	Name() string
+	Run(ctx context.Context, event NewItemEvent, opts ...operations.Option) error
+}
+
+// newItemActionFunc is an action implemented by a function
+type newItemActionFunc struct {
+	name string
+	fn   func(ctx context.Context, event NewItemEvent, opts ...operations.Option) error
+}
+
+// NewItemActionFunc returns an action running fn
+func NewItemActionFunc(name string, fn func(ctx context.Context, event NewItemEvent, opts ...operations.Option) error) NewItemAction {
+	return newItemActionFunc{name: name, fn: fn}
+}
+
+// Name returns the name of the action
+func (a newItemActionFunc) Name() string {
+	return a.name
+}
+
+// Run runs the function
+func (a newItemActionFunc) Run(ctx context.Context, event NewItemEvent, opts ...operations.Option) error {
+	return a.fn(ctx, event, opts...)
+}
+
+// AddToCollectionAction returns an action adding new items to a collection
+func (s *Collections) AddToCollectionAction(collectionID int) NewItemAction {
+	return NewItemActionFunc(fmt.Sprintf("add-to-collection-%d", collectionID), func(ctx context.Context, event NewItemEvent, opts ...operations.Option) error {
+		return s.AddToCollection(ctx, collectionID, event.RatingKeys(), opts...)
+	})
+}
+
+// AddLabelsAction returns an action adding labels to new items, keeping their existing labels
+func (s *Library) AddLabelsAction(labels ...string) NewItemAction {
+	return NewItemActionFunc("add-labels", func(ctx context.Context, event NewItemEvent, opts ...operations.Option) error {
+		for _, item := range event.Items {
+			if err := s.addLabels(ctx, event.SectionID, item, labels, opts...); err != nil {
+				return fmt.Errorf("error labeling %s: %w", item.Title, err)
+			}
+		}
+		return nil
+	})
+}
+
+// addLabels adds labels to an item, keeping its existing labels
+func (s *Library) addLabels(ctx context.Context, sectionID int, item Metadata, labels []string, opts ...operations.Option) error {
+	ratingKey, err := strconv.Atoi(item.RatingKey)
+	if err != nil {
+		return fmt.Errorf("error converting rating key to int: %w", err)
+	}
+
+	// Listings may leave out tags, so the labels are read from the full item
+	full, err := s.GetItem(ctx, ratingKey, opts...)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]string{"label.locked": "1"}
+	existing := map[string]bool{}
+	for i, tag := range full.Label {
+		fields[fmt.Sprintf("label[%d].tag.tag", i)] = tag.Tag
+		existing[strings.ToLower(tag.Tag)] = true
+	}
+
+	added := 0
+	for _, label := range labels {
+		if existing[strings.ToLower(label)] {
+			continue
+		}
+		existing[strings.ToLower(label)] = true
+		fields[fmt.Sprintf("label[%d].tag.tag", len(full.Label)+added)] = label
+		added++
+	}
+	if added == 0 {
+		return nil
+	}
+
+	return s.editMetadata(ctx, sectionID, *full, fields, "addLabels", opts...)
+}
+
+// NewItemRule runs actions on the new items that match it
+type NewItemRule struct {
+	Name    string
+	Filter  FilterNode          // Smart filter conditions the items must match, checked by the server; nil matches all
+	Match   func(Metadata) bool // Checked after Filter; nil matches all
+	Actions []NewItemAction     // Run in order with the matched items
+}
+
+// NewItemPipeline periodically checks a library section for new items and runs the actions of
+// the rules they match, e.g. adding new 4K movies to a collection and announcing them on Discord.
+// Create one with Library.NewItemPipeline and set its fields before running it.
+type NewItemPipeline struct {
+	Interval   time.Duration // Time between checks, 5 minutes if not set
+	Type       MetadataType  // Item type to watch, e.g. CollectionItemTypeEpisode; the section's top-level items if 0
+	WebhookURL string        // If set, each event is POSTed to it as JSON
+	OnError    func(error)   // Receives check and action errors, which don't stop Run
+
+	library   *Library
+	sectionID int
+	rules     []NewItemRule
+	started   bool
+	since     int64           // addedAt of the newest item seen
+	seen      map[string]bool // Rating keys of the items added at since
+}
+
+// NewItemPipeline returns a pipeline running rules on the new items of a library section
+func (s *Library) NewItemPipeline(sectionID int, rules ...NewItemRule) *NewItemPipeline {
+	return &NewItemPipeline{
+		library:   s,
+		sectionID: sectionID,
+		rules:     rules,
+	}
+}
+
+// Poll checks for items added since the previous poll, runs the actions of the rules they match
+// and returns an event for each rule that matched. The first poll only records the newest item
+// and returns no events. Action errors don't stop the other actions; they are returned with the
+// events.
+func (p *NewItemPipeline) Poll(ctx context.Context, opts ...operations.Option) ([]NewItemEvent, error) {
+	if !p.started {
+		if err := p.start(ctx, opts...); err != nil {
+			return nil, err
+		}
+		return []NewItemEvent{}, nil
+	}
+
+	items, err := p.itemsSince(ctx, p.since, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting new items: %w", err)
+	}
+
+	var added []Metadata
+	for _, item := range items {
+		if !p.seen[item.RatingKey] {
+			added = append(added, item)
+		}
+	}
+	p.advance(items)
+
+	events := []NewItemEvent{}
+	if len(added) == 0 {
+		return events, nil
+	}
+
+	now := time.Now()
+	var errs []error
+	for _, rule := range p.rules {
+		matched, err := p.match(ctx, rule, added, opts...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error matching rule %s: %w", rule.Name, err))
+			continue
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		event := NewItemEvent{SectionID: p.sectionID, Rule: rule.Name, Items: matched, DetectedAt: now}
+		for _, action := range rule.Actions {
+			if err := action.Run(ctx, event, opts...); err != nil {
+				errs = append(errs, fmt.Errorf("error running %s of rule %s: %w", action.Name(), rule.Name, err))
+			}
+		}
+		events = append(events, event)
+	}
+
+	return events, errors.Join(errs...)
+}
+
+// Run checks for new items every Interval until ctx is done, sending each event to events (if
+// not nil) and to WebhookURL (if set). It returns ctx.Err() when stopped.
+func (p *NewItemPipeline) Run(ctx context.Context, events chan<- NewItemEvent, opts ...operations.Option) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = defaultNewItemInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		detected, err := p.Poll(ctx, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			p.reportError(err)
+		}
+
+		for _, event := range detected {
+			if events != nil {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			if p.WebhookURL != "" {
+				if err := postJSONWebhook(ctx, p.library.sdkConfiguration, p.WebhookURL, event); err != nil {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					p.reportError(err)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// start records the newest item of the section, so only items added later are reported
+func (p *NewItemPipeline) start(ctx context.Context, opts ...operations.Option) error {
+	queryParams := p.queryParams()
+	queryParams.Add("X-Plex-Container-Start", "0")
+	queryParams.Add("X-Plex-Container-Size", "1")
+
+	newest, err := p.library.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", p.sectionID), queryParams, "getLibraryItems", opts...)
+	if err != nil {
+		return fmt.Errorf("error getting the newest item: %w", err)
+	}
+	if len(newest) == 0 {
+		p.started = true
+		p.seen = map[string]bool{}
+		return nil
+	}
+
+	// Other items may share the newest item's addedAt
+	items, err := p.itemsSince(ctx, newest[0].AddedAt, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting the newest items: %w", err)
+	}
+	p.since = newest[0].AddedAt
+	p.seen = map[string]bool{}
+	p.advance(items)
+	p.started = true
+	return nil
+}
+
+// itemsSince returns the items added at or after a time
+func (p *NewItemPipeline) itemsSince(ctx context.Context, since int64, opts ...operations.Option) ([]Metadata, error) {
+	path := fmt.Sprintf("/library/sections/%d/all", p.sectionID)
+	if since > 0 {
+		path += "?" + Cond("addedAt", FilterOpGreaterThan, strconv.FormatInt(since-1, 10)).String()
+	}
+	return p.library.listMetadata(ctx, path, p.queryParams(), "getLibraryItems", opts...)
+}
+
+// advance moves the high-water mark to the newest of the items
+func (p *NewItemPipeline) advance(items []Metadata) {
+	for _, item := range items {
+		if item.AddedAt > p.since {
+			p.since = item.AddedAt
+			p.seen = map[string]bool{}
+		}
+	}
+	for _, item := range items {
+		if item.AddedAt == p.since {
+			p.seen[item.RatingKey] = true
+		}
+	}
+}
+
+// match returns the items that match a rule
+func (p *NewItemPipeline) match(ctx context.Context, rule NewItemRule, items []Metadata, opts ...operations.Option) ([]Metadata, error) {
+	if rule.Filter != nil {
+		filtered, err := p.filter(ctx, rule.Filter, items, opts...)
+		if err != nil {
+			return nil, err
+		}
+		items = filtered
+	}
+
+	if rule.Match == nil {
+		return items, nil
+	}
+	var matched []Metadata
+	for _, item := range items {
+		if rule.Match(item) {
+			matched = append(matched, item)
+		}
+	}
+	return matched, nil
+}
+
+// filter asks the server which of the items match smart filter conditions
+func (p *NewItemPipeline) filter(ctx context.Context, node FilterNode, items []Metadata, opts ...operations.Option) ([]Metadata, error) {
+	queryParams := p.queryParams()
+	queryParams.Add("id", strings.Join(NewItemEvent{Items: items}.RatingKeys(), ","))
+
+	// The conditions are kept in order, as push/pop groups depend on it
+	path := fmt.Sprintf("/library/sections/%d/all?%s", p.sectionID, strings.Join(node.encode(), "&"))
+	matching, err := p.library.listMetadata(ctx, path, queryParams, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]bool, len(matching))
+	for _, item := range matching {
+		keys[item.RatingKey] = true
+	}
+	var matched []Metadata
+	for _, item := range items {
+		if keys[item.RatingKey] {
+			matched = append(matched, item)
+		}
+	}
+	return matched, nil
+}
+
+// queryParams returns the parameters listing the watched items, newest first
+func (p *NewItemPipeline) queryParams() url.Values {
+	queryParams := url.Values{}
+	if p.Type > 0 {
+		queryParams.Add("type", strconv.Itoa(int(p.Type)))
+	}
+	queryParams.Add("sort", "addedAt:desc")
+	return queryParams
+}
+
+// reportError passes an error to OnError, if set
+func (p *NewItemPipeline) reportError(err error) {
+	if p.OnError != nil {
+		p.OnError(err)
+	}
+}
@@ -0,0 +1,121 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestNewItemPipeline(t *testing.T) {
+	added := false
+	var labelEdit string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all" && query.Get("X-Plex-Container-Size") == "1":
+			if query.Get("sort") != "addedAt:desc" || query.Get("type") != "1" {
+				t.Errorf("Unexpected newest item query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"10","type":"movie","title":"Heat","addedAt":1000}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all" && query.Get("id") != "":
+			if query.Get("id") != "12,13" || query.Get("year>>") != "2020" {
+				t.Errorf("Unexpected filter query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"12","type":"movie","title":"Dune: Part Two","year":2024,"addedAt":1100}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			since := query.Get("addedAt>>")
+			switch {
+			case since == "999" && added:
+				w.Write([]byte(`{"MediaContainer":{"Metadata":[
+					{"ratingKey":"12","type":"movie","title":"Dune: Part Two","year":2024,"addedAt":1100},
+					{"ratingKey":"13","type":"movie","title":"The Matrix","year":1999,"addedAt":1100},
+					{"ratingKey":"10","type":"movie","title":"Heat","addedAt":1000},
+					{"ratingKey":"9","type":"movie","title":"Ronin","addedAt":1000}]}}`))
+			case since == "999":
+				w.Write([]byte(`{"MediaContainer":{"Metadata":[
+					{"ratingKey":"10","type":"movie","title":"Heat","addedAt":1000},
+					{"ratingKey":"9","type":"movie","title":"Ronin","addedAt":1000}]}}`))
+			case since == "1099":
+				w.Write([]byte(`{"MediaContainer":{"Metadata":[
+					{"ratingKey":"12","type":"movie","title":"Dune: Part Two","year":2024,"addedAt":1100},
+					{"ratingKey":"13","type":"movie","title":"The Matrix","year":1999,"addedAt":1100}]}}`))
+			default:
+				t.Errorf("Unexpected items query: %s", r.URL.RawQuery)
+			}
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/12":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"12","type":"movie","title":"Dune: Part Two","Label":[{"tag":"Sci-Fi"}]}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			labelEdit = r.URL.RawQuery
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	var ran []string
+	record := NewItemActionFunc("record", func(ctx context.Context, event NewItemEvent, opts ...operations.Option) error {
+		ran = append(ran, event.Rule+":"+strings.Join(event.RatingKeys(), ","))
+		return nil
+	})
+	failing := NewItemActionFunc("fail", func(ctx context.Context, event NewItemEvent, opts ...operations.Option) error {
+		return errors.New("boom")
+	})
+
+	pipeline := client.Library.NewItemPipeline(1,
+		NewItemRule{
+			Name:    "Recent releases",
+			Filter:  Cond("year", FilterOpGreaterThan, "2020"),
+			Actions: []NewItemAction{client.Library.AddLabelsAction("New Release", "sci-fi"), record},
+		},
+		NewItemRule{
+			Name:    "Everything",
+			Actions: []NewItemAction{record},
+		},
+		NewItemRule{
+			Name:    "Classics",
+			Match:   func(item Metadata) bool { return item.Year < 2000 },
+			Actions: []NewItemAction{failing, record},
+		},
+	)
+	pipeline.Type = CollectionItemTypeMovie
+
+	// The first poll records the newest items
+	events, err := pipeline.Poll(context.Background())
+	if err != nil || len(events) != 0 {
+		t.Fatalf("Expected no events, got: %v, %v", events, err)
+	}
+
+	added = true
+	events, err = pipeline.Poll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "fail of rule Classics") {
+		t.Errorf("Expected the failing action's error, got: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got: %+v", events)
+	}
+	want := []string{"Recent releases:12", "Everything:12,13", "Classics:13"}
+	if strings.Join(ran, " ") != strings.Join(want, " ") {
+		t.Errorf("Expected actions %v, got: %v", want, ran)
+	}
+	if !strings.Contains(labelEdit, "label%5B0%5D.tag.tag=Sci-Fi") || !strings.Contains(labelEdit, "label%5B1%5D.tag.tag=New+Release") ||
+		strings.Contains(labelEdit, "label%5B2%5D") || !strings.Contains(labelEdit, "id=12") {
+		t.Errorf("Unexpected label edit: %s", labelEdit)
+	}
+
+	// Items are only reported once
+	ran = nil
+	events, err = pipeline.Poll(context.Background())
+	if err != nil || len(events) != 0 || len(ran) != 0 {
+		t.Errorf("Expected no new events, got: %v, %v", events, err)
+	}
+}
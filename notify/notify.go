@@ -0,0 +1,383 @@
+// Package notify publishes SDK events, such as collection changes from a CollectionWatcher or the
+// summary of a scheduled run, to Discord and Slack through their incoming webhooks.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// defaultTemplate renders an event when no template is set
+var defaultTemplate = template.Must(template.New("event").Parse(
+	`{{if .Title}}**{{.Title}}**{{"\n"}}{{end}}{{.Message}}{{range $k, $v := .Fields}}{{"\n"}}{{$k}}: {{$v}}{{end}}`,
+))
+
+// Level is the severity of an event
+type Level string
+
+const (
+	LevelInfo    Level = "info"
+	LevelWarning Level = "warning"
+	LevelError   Level = "error"
+)
+
+// Event is a notification, e.g. a run summary or an error
+type Event struct {
+	Level   Level
+	Title   string
+	Message string
+	Fields  map[string]string // Extra details, rendered in key order by the default template
+	Time    time.Time
+}
+
+// Sink sends events somewhere
+type Sink interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// Option configures a Discord or Slack sink
+type Option func(*webhook)
+
+// WithHTTPClient overrides the HTTP client used to call the webhook
+func WithHTTPClient(client *http.Client) Option {
+	return func(w *webhook) {
+		w.httpClient = client
+	}
+}
+
+// WithTemplate renders the message text of events with a text/template, executed with the Event.
+// For example: template.Must(template.New("").Parse("[{{.Level}}] {{.Title}}: {{.Message}}"))
+func WithTemplate(tmpl *template.Template) Option {
+	return func(w *webhook) {
+		w.template = tmpl
+	}
+}
+
+// webhook holds the settings shared by the sinks
+type webhook struct {
+	url        string
+	template   *template.Template
+	httpClient *http.Client
+}
+
+// newWebhook returns the webhook settings of a sink
+func newWebhook(url string, opts []Option) webhook {
+	w := webhook{
+		url:        url,
+		template:   defaultTemplate,
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(&w)
+	}
+
+	return w
+}
+
+// render returns the message text of an event
+func (w webhook) render(event Event) (string, error) {
+	var b strings.Builder
+	if err := w.template.Execute(&b, event); err != nil {
+		return "", fmt.Errorf("error rendering notification: %w", err)
+	}
+	return b.String(), nil
+}
+
+// post sends a JSON payload to the webhook
+func (w webhook) post(ctx context.Context, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error serializing notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := w.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending notification: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// Discord sends events to a Discord channel webhook
+type Discord struct {
+	webhook
+}
+
+// NewDiscord returns a sink for a Discord webhook URL, from the channel's integration settings
+func NewDiscord(webhookURL string, opts ...Option) *Discord {
+	return &Discord{webhook: newWebhook(webhookURL, opts)}
+}
+
+// discordColors are the embed colors of each level
+var discordColors = map[Level]int{
+	LevelInfo:    0x2ecc71,
+	LevelWarning: 0xf1c40f,
+	LevelError:   0xe74c3c,
+}
+
+// Send posts an event to Discord as an embed colored by its level
+func (d *Discord) Send(ctx context.Context, event Event) error {
+	text, err := d.render(event)
+	if err != nil {
+		return err
+	}
+
+	embed := map[string]interface{}{
+		"description": text,
+		"color":       discordColors[event.Level],
+	}
+	if !event.Time.IsZero() {
+		embed["timestamp"] = event.Time.Format(time.RFC3339)
+	}
+
+	return d.post(ctx, map[string]interface{}{
+		"embeds": []interface{}{embed},
+	})
+}
+
+// Slack sends events to a Slack incoming webhook
+type Slack struct {
+	webhook
+}
+
+// NewSlack returns a sink for a Slack incoming webhook URL
+func NewSlack(webhookURL string, opts ...Option) *Slack {
+	return &Slack{webhook: newWebhook(webhookURL, opts)}
+}
+
+// Send posts an event to Slack. Slack uses single asterisks for bold text, so the default
+// template's bold title is converted.
+func (s *Slack) Send(ctx context.Context, event Event) error {
+	text, err := s.render(event)
+	if err != nil {
+		return err
+	}
+
+	if s.template == defaultTemplate {
+		text = strings.Replace(text, "**", "*", 2)
+	}
+
+	return s.post(ctx, map[string]interface{}{
+		"text": text,
+	})
+}
+
+// Multi sends each event to all sinks, returning the errors of the sinks that failed
+func Multi(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+// multiSink fans events out to several sinks
+type multiSink []Sink
+
+// Send sends the event to every sink, even if some fail
+func (m multiSink) Send(ctx context.Context, event Event) error {
+	var errs []error
+	for _, sink := range m {
+		if err := sink.Send(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ErrorHandler returns a function that sends errors to a sink, e.g. for CollectionWatcher.OnError.
+// Errors sending the notification itself are dropped.
+func ErrorHandler(ctx context.Context, sink Sink, title string) func(error) {
+	return func(err error) {
+		_ = sink.Send(ctx, ErrorEvent(title, err))
+	}
+}
+
+// ErrorEvent returns an error event
+func ErrorEvent(title string, err error) Event {
+	return Event{
+		Level:   LevelError,
+		Title:   title,
+		Message: err.Error(),
+		Time:    time.Now(),
+	}
+}
+
+// CollectionChangeEvent returns an event announcing a collection change from a CollectionWatcher
+func CollectionChangeEvent(change plexgo.CollectionChange) Event {
+	event := Event{
+		Level:  LevelInfo,
+		Title:  change.Title,
+		Fields: map[string]string{},
+		Time:   change.DetectedAt,
+	}
+
+	switch {
+	case change.Created:
+		event.Message = fmt.Sprintf("New collection with %d items", len(change.Added))
+	case change.Deleted:
+		event.Message = "Collection deleted"
+	default:
+		var parts []string
+		if len(change.Added) > 0 {
+			parts = append(parts, fmt.Sprintf("%d items added", len(change.Added)))
+		}
+		if len(change.Removed) > 0 {
+			parts = append(parts, fmt.Sprintf("%d items removed", len(change.Removed)))
+		}
+		if change.ArtworkChanged {
+			parts = append(parts, "artwork changed")
+		}
+		event.Message = strings.Join(parts, ", ")
+	}
+
+	if len(change.Added) > 0 && !change.Created {
+		event.Fields["Added"] = strings.Join(change.Added, ", ")
+	}
+	if len(change.Removed) > 0 && !change.Deleted {
+		event.Fields["Removed"] = strings.Join(change.Removed, ", ")
+	}
+
+	return event
+}
+
+// RunSummaryEvent returns an event summarizing a scheduled run, e.g. of RunKometaCollections:
+// an info event listing the collections, or an error event if the run failed
+func RunSummaryEvent(name string, collections []*plexgo.Collection, err error) Event {
+	if err != nil {
+		return ErrorEvent(name+" failed", err)
+	}
+
+	titles := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		if collection != nil {
+			titles = append(titles, collection.Title)
+		}
+	}
+
+	event := Event{
+		Level:   LevelInfo,
+		Title:   name,
+		Message: fmt.Sprintf("Updated %d collections", len(titles)),
+		Fields:  map[string]string{},
+		Time:    time.Now(),
+	}
+	if len(titles) > 0 {
+		event.Fields["Collections"] = strings.Join(titles, ", ")
+	}
+
+	return event
+}
+
+// PlanEvent returns an event summarizing the changes of a collection plan from Collections.Plan,
+// listing the titles by action: those applied, or all planned changes if applied is nil, e.g.
+// for a dry run. If err is set, it returns a warning event listing the changes applied before the
+// error instead.
+func PlanEvent(name string, plan *plexgo.CollectionPlan, applied []plexgo.PlannedChange, err error) Event {
+	changes := applied
+	message := fmt.Sprintf("Applied %d changes", len(applied))
+	if applied == nil && err == nil {
+		changes = plan.Changes
+		message = fmt.Sprintf("Planned %d changes", len(plan.Changes))
+	}
+
+	event := Event{
+		Level:   LevelInfo,
+		Title:   name,
+		Message: message,
+		Fields:  map[string]string{},
+		Time:    time.Now(),
+	}
+	if err != nil {
+		event.Level = LevelWarning
+		event.Message = fmt.Sprintf("Stopped after %d of %d changes: %v", len(applied), len(plan.Changes), err)
+	}
+
+	titles := map[plexgo.PlanAction][]string{}
+	for _, change := range changes {
+		titles[change.Action] = append(titles[change.Action], change.Title)
+	}
+	for action, field := range map[plexgo.PlanAction]string{
+		plexgo.PlanCreate:  "Created",
+		plexgo.PlanUpdate:  "Updated",
+		plexgo.PlanReplace: "Replaced",
+		plexgo.PlanDelete:  "Deleted",
+	} {
+		if len(titles[action]) > 0 {
+			event.Fields[field] = strings.Join(titles[action], ", ")
+		}
+	}
+
+	return event
+}
+
+// ViolationEvent returns a warning event reporting a session policy violation from a SessionMonitor
+func ViolationEvent(violation plexgo.PolicyViolation) Event {
+	event := Event{
+		Level:   LevelWarning,
+		Title:   fmt.Sprintf("%s: %s", violation.Session.User, violation.Policy),
+		Message: violation.Message,
+		Fields: map[string]string{
+			"Title":  violation.Session.Title,
+			"Player": violation.Session.Player,
+		},
+		Time: violation.DetectedAt,
+	}
+	if violation.Terminated {
+		event.Fields["Action"] = "Session terminated"
+	}
+	return event
+}
+
+// NewItemsEvent returns an event announcing the new items matched by a rule of a NewItemPipeline
+func NewItemsEvent(event plexgo.NewItemEvent) Event {
+	titles := make([]string, len(event.Items))
+	for i, item := range event.Items {
+		titles[i] = item.Title
+		if item.GrandparentTitle != "" {
+			titles[i] = item.GrandparentTitle + " - " + item.Title
+		}
+	}
+
+	message := fmt.Sprintf("%d new items", len(titles))
+	if len(titles) == 1 {
+		message = "New: " + titles[0]
+	}
+
+	result := Event{
+		Level:   LevelInfo,
+		Title:   event.Rule,
+		Message: message,
+		Fields:  map[string]string{},
+		Time:    event.DetectedAt,
+	}
+	if len(titles) > 1 {
+		result.Fields["Items"] = strings.Join(titles, ", ")
+	}
+	return result
+}
+
+// NewItemAction returns a NewItemPipeline action sending NewItemsEvent to a sink
+func NewItemAction(sink Sink) plexgo.NewItemAction {
+	return plexgo.NewItemActionFunc("notify", func(ctx context.Context, event plexgo.NewItemEvent, opts ...operations.Option) error {
+		return sink.Send(ctx, NewItemsEvent(event))
+	})
+}
@@ -0,0 +1,130 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+)
+
+func TestDiscordAndSlack(t *testing.T) {
+	payloads := map[string]map[string]interface{}{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected a JSON POST, got: %s %s", r.Method, r.Header.Get("Content-Type"))
+		}
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %v", err)
+		}
+		payloads[r.URL.Path] = payload
+
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	event := CollectionChangeEvent(plexgo.CollectionChange{
+		Title:      "Heist",
+		Added:      []string{"101", "102"},
+		DetectedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	})
+
+	sink := Multi(
+		NewDiscord(server.URL+"/discord"),
+		NewSlack(server.URL+"/slack", WithTemplate(template.Must(template.New("").Parse("[{{.Level}}] {{.Title}}: {{.Message}}")))),
+	)
+	if err := sink.Send(context.Background(), event); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	embed := payloads["/discord"]["embeds"].([]interface{})[0].(map[string]interface{})
+	if embed["description"] != "**Heist**\n2 items added\nAdded: 101, 102" {
+		t.Errorf("Unexpected Discord description: %q", embed["description"])
+	}
+	if embed["timestamp"] != "2024-05-01T12:00:00Z" || embed["color"] != float64(discordColors[LevelInfo]) {
+		t.Errorf("Unexpected Discord embed: %v", embed)
+	}
+
+	if payloads["/slack"]["text"] != "[info] Heist: 2 items added" {
+		t.Errorf("Unexpected Slack text: %q", payloads["/slack"]["text"])
+	}
+
+	err := Multi(NewSlack(server.URL+"/broken"), NewSlack(server.URL+"/slack")).Send(context.Background(), ErrorEvent("Sync", errors.New("boom")))
+	if err == nil || !strings.Contains(err.Error(), "status 404") {
+		t.Errorf("Expected the failing sink's error, got: %v", err)
+	}
+	if payloads["/slack"]["text"] != "*Sync*\nboom" {
+		t.Errorf("Expected the other sinks to still be notified, got: %q", payloads["/slack"]["text"])
+	}
+}
+
+func TestRunSummaryEvent(t *testing.T) {
+	event := RunSummaryEvent("Kometa", []*plexgo.Collection{{Title: "Heist"}, {Title: "Noir"}}, nil)
+	if event.Level != LevelInfo || event.Message != "Updated 2 collections" || event.Fields["Collections"] != "Heist, Noir" {
+		t.Errorf("Unexpected summary: %+v", event)
+	}
+
+	event = RunSummaryEvent("Kometa", nil, errors.New("server unreachable"))
+	if event.Level != LevelError || event.Title != "Kometa failed" {
+		t.Errorf("Unexpected error summary: %+v", event)
+	}
+}
+
+func TestPlanEvent(t *testing.T) {
+	plan := &plexgo.CollectionPlan{Changes: []plexgo.PlannedChange{
+		{Action: plexgo.PlanCreate, Title: "Westerns"},
+		{Action: plexgo.PlanUpdate, Title: "1990s"},
+		{Action: plexgo.PlanUpdate, Title: "The Matrix"},
+		{Action: plexgo.PlanDelete, Title: "Old Favorites"},
+	}}
+
+	event := PlanEvent("collections.json", plan, nil, nil)
+	if event.Level != LevelInfo || event.Message != "Planned 4 changes" || event.Fields["Updated"] != "1990s, The Matrix" || event.Fields["Deleted"] != "Old Favorites" {
+		t.Errorf("Unexpected plan: %+v", event)
+	}
+
+	event = PlanEvent("collections.json", plan, plan.Changes[:1], errors.New("server unreachable"))
+	if event.Level != LevelWarning || event.Message != "Stopped after 1 of 4 changes: server unreachable" || len(event.Fields) != 1 || event.Fields["Created"] != "Westerns" {
+		t.Errorf("Unexpected partial apply: %+v", event)
+	}
+}
+
+type recordingSink []Event
+
+func (s *recordingSink) Send(ctx context.Context, event Event) error {
+	*s = append(*s, event)
+	return nil
+}
+
+func TestNewItemAction(t *testing.T) {
+	sink := &recordingSink{}
+	event := plexgo.NewItemEvent{
+		Rule: "New episodes",
+		Items: []plexgo.Metadata{
+			{Title: "Good News About Hell", GrandparentTitle: "Severance"},
+			{Title: "Heat"},
+		},
+	}
+	if err := NewItemAction(sink).Run(context.Background(), event); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(*sink) != 1 || (*sink)[0].Message != "2 new items" || (*sink)[0].Fields["Items"] != "Severance - Good News About Hell, Heat" {
+		t.Errorf("Unexpected events: %+v", *sink)
+	}
+
+	single := NewItemsEvent(plexgo.NewItemEvent{Rule: "Movies", Items: []plexgo.Metadata{{Title: "Heat"}}})
+	if single.Title != "Movies" || single.Message != "New: Heat" || len(single.Fields) != 0 {
+		t.Errorf("Unexpected event: %+v", single)
+	}
+}
@@ -0,0 +1,125 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// NextEpisode is the next episode to watch of a show in a collection
+type NextEpisode struct {
+	Show    Metadata
+	Episode *Metadata // nil if every episode of the show has been watched
+}
+
+// GetNextUnwatched resolves the next episode to watch of each show in a collection, for "continue
+// the franchise" UIs. The next episode is the first unwatched episode after the most recently
+// watched one, or the first unwatched episode if there is none after it. With an accountID of 0
+// the watch state of the authenticated user is used; otherwise the watch history of that account
+// on the server is used, so managed users' progress can be resolved with the owner's token.
+// Items of the collection that are not shows are skipped.
+func (s *Collections) GetNextUnwatched(ctx context.Context, collectionID int, accountID int, opts ...operations.Option) ([]NextEpisode, error) {
+	library := newLibrary(s.sdkConfiguration)
+
+	items, err := library.listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), nil, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection items: %w", err)
+	}
+
+	var shows []Metadata
+	for _, item := range items {
+		if item.Type == "show" {
+			shows = append(shows, item)
+		}
+	}
+
+	options := processOptions(opts)
+	next := make([]NextEpisode, 0, len(shows))
+
+	reportProgress(options, 0, len(shows), "resolving shows")
+	for i, show := range shows {
+		episodes, err := library.listMetadata(ctx, fmt.Sprintf("/library/metadata/%s/allLeaves", show.RatingKey), nil, "getAllLeaves", opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting episodes of %s: %w", show.Title, err)
+		}
+
+		viewedAt := make(map[string]int64, len(episodes))
+		if accountID == 0 {
+			for _, episode := range episodes {
+				if episode.ViewCount > 0 {
+					viewedAt[episode.RatingKey] = episode.LastViewedAt
+				}
+			}
+		} else {
+			viewedAt, err = library.getAccountViews(ctx, show.RatingKey, accountID, opts...)
+			if err != nil {
+				return nil, fmt.Errorf("error getting watch history of %s: %w", show.Title, err)
+			}
+		}
+
+		next = append(next, NextEpisode{
+			Show:    show,
+			Episode: nextUnwatchedEpisode(episodes, viewedAt),
+		})
+		reportProgress(options, i+1, len(shows), "resolving shows")
+	}
+
+	return next, nil
+}
+
+// getAccountViews returns when an account last watched each item under a metadata item (e.g. the
+// episodes of a show), by rating key, from the server's watch history
+func (s *Library) getAccountViews(ctx context.Context, ratingKey string, accountID int, opts ...operations.Option) (map[string]int64, error) {
+	var out struct {
+		MediaContainer struct {
+			Metadata []struct {
+				RatingKey string `json:"ratingKey"`
+				ViewedAt  int64  `json:"viewedAt"`
+			} `json:"Metadata"`
+		} `json:"MediaContainer"`
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("accountID", strconv.Itoa(accountID))
+	queryParams.Add("metadataItemID", ratingKey)
+
+	if err := s.getJSON(ctx, "/status/sessions/history/all", queryParams, "getSessionHistory", &out, opts...); err != nil {
+		return nil, err
+	}
+
+	viewedAt := make(map[string]int64, len(out.MediaContainer.Metadata))
+	for _, view := range out.MediaContainer.Metadata {
+		if view.ViewedAt >= viewedAt[view.RatingKey] {
+			viewedAt[view.RatingKey] = view.ViewedAt
+		}
+	}
+
+	return viewedAt, nil
+}
+
+// nextUnwatchedEpisode returns the first unwatched episode after the most recently watched one,
+// falling back to the first unwatched episode. Episodes must be in viewing order.
+func nextUnwatchedEpisode(episodes []Metadata, viewedAt map[string]int64) *Metadata {
+	start := 0
+	var latest int64 = -1
+	for i, episode := range episodes {
+		if at, ok := viewedAt[episode.RatingKey]; ok && at > latest {
+			latest = at
+			start = i + 1
+		}
+	}
+
+	for _, from := range []int{start, 0} {
+		for i := from; i < len(episodes); i++ {
+			if _, watched := viewedAt[episodes[i].RatingKey]; !watched {
+				episode := episodes[i]
+				return &episode
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestGetNextUnwatched(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/collections/7/children":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"10","type":"show","title":"Star Trek"},
+				{"ratingKey":"20","type":"show","title":"Star Trek: The Next Generation"},
+				{"ratingKey":"30","type":"movie","title":"Star Trek: First Contact"}
+			]}}`))
+		case "/library/metadata/10/allLeaves":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"11","type":"episode","title":"The Cage","viewCount":1,"lastViewedAt":100},
+				{"ratingKey":"12","type":"episode","title":"The Man Trap"},
+				{"ratingKey":"13","type":"episode","title":"Charlie X","viewCount":1,"lastViewedAt":200},
+				{"ratingKey":"14","type":"episode","title":"Where No Man Has Gone Before"}
+			]}}`))
+		case "/library/metadata/20/allLeaves":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"21","type":"episode","title":"Encounter at Farpoint","viewCount":1,"lastViewedAt":100},
+				{"ratingKey":"22","type":"episode","title":"The Naked Now","viewCount":2,"lastViewedAt":300}
+			]}}`))
+		case "/status/sessions/history/all":
+			if r.URL.Query().Get("accountID") != "5" {
+				t.Errorf("Expected accountID=5, got: %s", r.URL.Query().Get("accountID"))
+			}
+			if r.URL.Query().Get("metadataItemID") == "10" {
+				w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"11","viewedAt":100}]}}`))
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{}}`))
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := New()
+
+	next, err := client.Collections.GetNextUnwatched(context.Background(), 7, 0, operations.WithServerURL(server.URL))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(next) != 2 {
+		t.Fatalf("Expected 2 shows, got: %d", len(next))
+	}
+	if next[0].Episode == nil || next[0].Episode.RatingKey != "14" {
+		t.Errorf("Expected episode 14 after the most recently watched one, got: %+v", next[0].Episode)
+	}
+	if next[1].Episode != nil {
+		t.Errorf("Expected no next episode of a fully watched show, got: %+v", next[1].Episode)
+	}
+
+	next, err = client.Collections.GetNextUnwatched(context.Background(), 7, 5, operations.WithServerURL(server.URL))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if next[0].Episode == nil || next[0].Episode.RatingKey != "12" {
+		t.Errorf("Expected episode 12 from the account's history, got: %+v", next[0].Episode)
+	}
+	if next[1].Episode == nil || next[1].Episode.RatingKey != "21" {
+		t.Errorf("Expected the first episode of an unwatched show, got: %+v", next[1].Episode)
+	}
+}
+
+func TestNextUnwatchedEpisodeWrapsAround(t *testing.T) {
+	episodes := []Metadata{{RatingKey: "1"}, {RatingKey: "2"}, {RatingKey: "3"}}
+
+	episode := nextUnwatchedEpisode(episodes, map[string]int64{"2": 10, "3": 20})
+	if episode == nil || episode.RatingKey != "1" {
+		t.Errorf("Expected episode 1, got: %+v", episode)
+	}
+}
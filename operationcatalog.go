@@ -0,0 +1,415 @@
+package plexgo
+
+import (
+	"sort"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Locations of operation parameters
+const (
+	ParameterInPath  = "path"
+	ParameterInQuery = "query"
+	ParameterInBody  = "body"
+)
+
+// Types of operation parameters. Booleans are sent as 1 or 0.
+const (
+	ParameterTypeString  = "string"
+	ParameterTypeInteger = "integer"
+	ParameterTypeNumber  = "number"
+	ParameterTypeBoolean = "boolean"
+	ParameterTypeObject  = "object"
+	ParameterTypeBinary  = "binary"
+)
+
+// OperationParameter describes a parameter of an operation
+type OperationParameter struct {
+	Name     string `json:"name"`
+	In       string `json:"in"`   // ParameterInPath, ParameterInQuery or ParameterInBody
+	Type     string `json:"type"` // One of the ParameterType constants
+	Required bool   `json:"required"`
+}
+
+// OperationInfo describes an operation of the hand-written services. ID is the OperationID the
+// hooks see for its requests, and Path is a template whose {name} placeholders are the path
+// parameters, e.g. "/library/collections/{collectionId}".
+type OperationInfo struct {
+	ID         string               `json:"operationId"`
+	Method     string               `json:"method"`
+	Path       string               `json:"path"`
+	ServerURL  string               `json:"serverUrl,omitempty"` // Server of the operation; "" for the Plex Media Server
+	Parameters []OperationParameter `json:"parameters,omitempty"`
+	Idempotent bool                 `json:"idempotent"` // Whether it is retried by default, see WithIdempotentOperation
+}
+
+// PathParameters returns the parameters of the operation that are part of its path
+func (o OperationInfo) PathParameters() []OperationParameter {
+	return o.parametersIn(ParameterInPath)
+}
+
+// QueryParameters returns the parameters of the operation sent in its query string
+func (o OperationInfo) QueryParameters() []OperationParameter {
+	return o.parametersIn(ParameterInQuery)
+}
+
+func (o OperationInfo) parametersIn(in string) []OperationParameter {
+	parameters := []OperationParameter{}
+	for _, parameter := range o.Parameters {
+		if parameter.In == in {
+			parameters = append(parameters, parameter)
+		}
+	}
+	return parameters
+}
+
+func pathParam(name string, typ string) OperationParameter {
+	return OperationParameter{Name: name, In: ParameterInPath, Type: typ, Required: true}
+}
+
+func queryParam(name string, typ string, required bool) OperationParameter {
+	return OperationParameter{Name: name, In: ParameterInQuery, Type: typ, Required: required}
+}
+
+func bodyParam(name string, typ string, required bool) OperationParameter {
+	return OperationParameter{Name: name, In: ParameterInBody, Type: typ, Required: required}
+}
+
+// pageParams are the query parameters of listings that can be paged with a Cursor
+var pageParams = []OperationParameter{
+	queryParam("X-Plex-Container-Start", ParameterTypeInteger, false),
+	queryParam("X-Plex-Container-Size", ParameterTypeInteger, false),
+}
+
+func withPageParams(parameters ...OperationParameter) []OperationParameter {
+	return append(parameters, pageParams...)
+}
+
+// editParams are the parameters of the metadata edit API, which edits the items given by id. The
+// edited fields, e.g. "titleSort.value", vary with the operation and are not listed.
+func editParams() []OperationParameter {
+	return []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("type", ParameterTypeInteger, true),
+		queryParam("id", ParameterTypeString, true),
+	}
+}
+
+// operationCatalog lists the operations sent by the hand-written services. Operations of the
+// generated services are described by the OpenAPI document the SDK is generated from, and Raw
+// requests by their caller, so neither is listed.
+var operationCatalog = []OperationInfo{
+	{ID: "addLabels", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "addToCollection", Method: "PUT", Path: "/library/collections/{collectionId}/items", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+		queryParam("uri", ParameterTypeString, true),
+	}},
+	{ID: "autocomplete", Method: "GET", Path: "/hubs/search/voice", Parameters: []OperationParameter{
+		queryParam("query", ParameterTypeString, true),
+		queryParam("limit", ParameterTypeInteger, false),
+	}},
+	{ID: "bulkEdit", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "createCollection", Method: "POST", Path: "/library/collections", Parameters: []OperationParameter{
+		queryParam("type", ParameterTypeInteger, true),
+		queryParam("title", ParameterTypeString, true),
+		queryParam("smart", ParameterTypeBoolean, true),
+		queryParam("sectionId", ParameterTypeInteger, true),
+		queryParam("uri", ParameterTypeString, true),
+	}},
+	{ID: "createPlayQueue", Method: "POST", Path: "/playQueues", Parameters: []OperationParameter{
+		queryParam("type", ParameterTypeString, true),
+		queryParam("uri", ParameterTypeString, true),
+		queryParam("shuffle", ParameterTypeBoolean, false),
+		queryParam("repeat", ParameterTypeBoolean, false),
+		queryParam("continuous", ParameterTypeBoolean, false),
+	}},
+	{ID: "createSmartCollection", Method: "POST", Path: "/library/collections", Parameters: []OperationParameter{
+		queryParam("type", ParameterTypeInteger, true),
+		queryParam("title", ParameterTypeString, true),
+		queryParam("smart", ParameterTypeBoolean, true),
+		queryParam("sectionId", ParameterTypeInteger, true),
+		queryParam("uri", ParameterTypeString, true),
+	}},
+	{ID: "deleteCollection", Method: "DELETE", Path: "/library/collections/{collectionId}", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+	}},
+	{ID: "deleteMediaVersion", Method: "DELETE", Path: "/library/metadata/{ratingKey}/media/{mediaId}", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+		pathParam("mediaId", ParameterTypeInteger),
+	}},
+	{ID: "getAllCollections", Method: "GET", Path: "/library/sections/{sectionId}/collections", Parameters: withPageParams(
+		pathParam("sectionId", ParameterTypeInteger),
+	)},
+	{ID: "getAllLeaves", Method: "GET", Path: "/library/metadata/{ratingKey}/allLeaves", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+	}},
+	{ID: "getAllLibraries", Method: "GET", Path: "/library/sections"},
+	{ID: "getAllMediaLibrary", Method: "GET", Path: "/library/sections/{sectionId}/all", Parameters: withPageParams(
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("type", ParameterTypeInteger, false),
+		queryParam("sort", ParameterTypeString, false),
+		queryParam("includeGuids", ParameterTypeBoolean, false),
+	)},
+	{ID: "getArtwork", Method: "GET", Path: "/library/metadata/{ratingKey}/{element}/{version}", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+		pathParam("element", ParameterTypeString),
+		pathParam("version", ParameterTypeInteger),
+	}},
+	{ID: "getAvailabilities", Method: "GET", Path: "/library/metadata/{metadataId}/availabilities", ServerURL: discoverServerList[0], Parameters: []OperationParameter{
+		pathParam("metadataId", ParameterTypeString),
+	}},
+	{ID: "getBandwidthStatistics", Method: "GET", Path: "/statistics/bandwidth", Parameters: []OperationParameter{
+		queryParam("timespan", ParameterTypeInteger, false),
+	}},
+	{ID: "getChildren", Method: "GET", Path: "/library/metadata/{ratingKey}/children", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+	}},
+	{ID: "getCollection", Method: "GET", Path: "/library/collections/{collectionId}", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+	}},
+	{ID: "getCollectionChildren", Method: "GET", Path: "/library/collections/{collectionId}/children", Parameters: withPageParams(
+		pathParam("collectionId", ParameterTypeInteger),
+		queryParam("includeGuids", ParameterTypeBoolean, false),
+	)},
+	{ID: "getCollectionItems", Method: "GET", Path: "/library/collections/{collectionId}/children", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+	}},
+	{ID: "getCollectionPreferences", Method: "GET", Path: "/library/collections/{collectionId}", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+		queryParam("includePreferences", ParameterTypeBoolean, true),
+	}},
+	{ID: "getCollectionVisibility", Method: "GET", Path: "/hubs/sections/{sectionId}/manage", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("metadataItemId", ParameterTypeInteger, true),
+	}},
+	{ID: "getDiscoverMetadata", Method: "GET", Path: "/library/metadata/{metadataId}", ServerURL: discoverServerList[0], Parameters: []OperationParameter{
+		pathParam("metadataId", ParameterTypeString),
+	}},
+	{ID: "getItem", Method: "GET", Path: "/library/metadata/{ratingKey}", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+	}},
+	{ID: "getItemTypes", Method: "GET", Path: "/library/metadata/{ratingKeys}", Parameters: []OperationParameter{
+		pathParam("ratingKeys", ParameterTypeString),
+	}},
+	{ID: "getItemsByPerson", Method: "GET", Path: "/library/sections/{sectionId}/all", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam(string(PersonRoleActor), ParameterTypeInteger, false),
+		queryParam(string(PersonRoleDirector), ParameterTypeInteger, false),
+		queryParam(string(PersonRoleWriter), ParameterTypeInteger, false),
+		queryParam(string(PersonRoleProducer), ParameterTypeInteger, false),
+	}},
+	{ID: "getLibraryItems", Method: "GET", Path: "/library/sections/{sectionId}/all", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("type", ParameterTypeInteger, false),
+		queryParam("includeGuids", ParameterTypeBoolean, false),
+	}},
+	{ID: "getLibraryItemsByGUID", Method: "GET", Path: "/library/all", Parameters: []OperationParameter{
+		queryParam("guid", ParameterTypeString, true),
+	}},
+	{ID: "getLyrics", Method: "GET", Path: "/library/streams/{streamId}", Parameters: []OperationParameter{
+		pathParam("streamId", ParameterTypeInteger),
+	}},
+	{ID: "getManagedHubs", Method: "GET", Path: "/hubs/sections/{sectionId}/manage", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+	}},
+	{ID: "getMediaExtras", Method: "GET", Path: "/library/metadata/{ratingKey}/extras", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+	}},
+	{ID: "getMediaMetaData", Method: "GET", Path: "/library/metadata/{ratingKeys}", Parameters: []OperationParameter{
+		pathParam("ratingKeys", ParameterTypeString),
+	}},
+	{ID: "getPhotoTimeline", Method: "GET", Path: "/library/sections/{sectionId}/all", Parameters: withPageParams(
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("type", ParameterTypeInteger, true),
+		queryParam("sort", ParameterTypeString, false),
+		queryParam("originallyAvailableAt>>", ParameterTypeInteger, false),
+		queryParam("originallyAvailableAt<<", ParameterTypeInteger, false),
+	)},
+	{ID: "getPlaylistContents", Method: "GET", Path: "/playlists/{playlistId}/items", Parameters: withPageParams(
+		pathParam("playlistId", ParameterTypeInteger),
+	)},
+	{ID: "getPlaylists", Method: "GET", Path: "/playlists", Parameters: withPageParams()},
+	{ID: "getSessionHistory", Method: "GET", Path: "/status/sessions/history/all", Parameters: withPageParams(
+		queryParam("librarySectionID", ParameterTypeInteger, false),
+		queryParam("viewedAt>", ParameterTypeInteger, false),
+	)},
+	{ID: "getSessions", Method: "GET", Path: "/status/sessions"},
+	{ID: "getSharedServers", Method: "GET", Path: "/servers/{machineIdentifier}/shared_servers", ServerURL: operations.GetUsersServerList[0], Parameters: []OperationParameter{
+		pathParam("machineIdentifier", ParameterTypeString),
+	}},
+	{ID: "getShowPreferences", Method: "GET", Path: "/library/metadata/{ratingKey}", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+		queryParam("includePreferences", ParameterTypeBoolean, true),
+	}},
+	{ID: "getSmartFilter", Method: "GET", Path: "/library/collections/{collectionId}", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+	}},
+	{ID: "getSonicallySimilar", Method: "GET", Path: "/library/metadata/{ratingKey}/nearest", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+		queryParam("maxDistance", ParameterTypeNumber, false),
+		queryParam("limit", ParameterTypeInteger, false),
+	}},
+	{ID: "getStatistics", Method: "GET", Path: "/statistics/media", Parameters: []OperationParameter{
+		queryParam("timespan", ParameterTypeInteger, false),
+	}},
+	{ID: "getTags", Method: "GET", Path: "/library/sections/{sectionId}/{field}", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		pathParam("field", ParameterTypeString),
+	}},
+	{ID: "getWatchHistory", Method: "POST", Path: "/api", ServerURL: communityServerList[0], Parameters: []OperationParameter{
+		bodyParam("query", ParameterTypeString, true),
+		bodyParam("variables", ParameterTypeObject, false),
+	}},
+	{ID: "getWatchList", Method: "GET", Path: "/library/sections/watchlist/{filter}", ServerURL: operations.GetWatchListServerList[0], Parameters: withPageParams(
+		pathParam("filter", ParameterTypeString),
+	)},
+	{ID: "labelCollection", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "lockFields", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "moveCollectionItem", Method: "PUT", Path: "/library/collections/{collectionId}/items/{itemId}/move", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+		pathParam("itemId", ParameterTypeString),
+		queryParam("after", ParameterTypeString, false),
+	}},
+	{ID: "moveManagedHub", Method: "PUT", Path: "/hubs/sections/{sectionId}/manage/move", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("identifier", ParameterTypeString, true),
+		queryParam("after", ParameterTypeString, false),
+	}},
+	{ID: "renderCollectionTemplate", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "removeCollectionTheme", Method: "DELETE", Path: "/library/metadata/{collectionId}/theme", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+	}},
+	{ID: "removeFromCollection", Method: "DELETE", Path: "/library/collections/{collectionId}/items/{itemId}", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+		pathParam("itemId", ParameterTypeString),
+	}},
+	{ID: "setContentRatingLocked", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "setLibraryFilters", Method: "PUT", Path: "/friends/{userId}", ServerURL: operations.GetUsersServerList[0], Parameters: []OperationParameter{
+		pathParam("userId", ParameterTypeInteger),
+		queryParam("filterMovies", ParameterTypeString, false),
+		queryParam("filterTelevision", ParameterTypeString, false),
+		queryParam("filterMusic", ParameterTypeString, false),
+	}},
+	{ID: "setRemoteAccess", Method: "PUT", Path: "/:/prefs", Parameters: []OperationParameter{
+		queryParam("PublishServerOnPlexOnlineKey", ParameterTypeBoolean, true),
+		queryParam("ManualPortMappingMode", ParameterTypeBoolean, true),
+		queryParam("ManualPortMappingPort", ParameterTypeInteger, false),
+	}},
+	{ID: "setSectionAgent", Method: "PUT", Path: "/library/sections/{sectionId}", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("agent", ParameterTypeString, true),
+		queryParam("language", ParameterTypeString, false),
+	}},
+	{ID: "setSectionVisibility", Method: "PUT", Path: "/library/sections/{sectionId}/prefs", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("hidden", ParameterTypeString, true),
+	}},
+	{ID: "tailLogs", Method: "GET", Path: "/:/eventsource/notifications", Parameters: []OperationParameter{
+		queryParam("filters", ParameterTypeString, true),
+	}},
+	{ID: "terminateSession", Method: "GET", Path: "/status/sessions/terminate", Parameters: []OperationParameter{
+		queryParam("sessionId", ParameterTypeString, true),
+		queryParam("reason", ParameterTypeString, true),
+	}},
+	{ID: "testSmartFilter", Method: "GET", Path: "/library/sections/{sectionId}/all", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+	}},
+	{ID: "transcodeImage", Method: "GET", Path: "/photo/:/transcode", Parameters: []OperationParameter{
+		queryParam("url", ParameterTypeString, true),
+		queryParam("width", ParameterTypeInteger, true),
+		queryParam("height", ParameterTypeInteger, true),
+		queryParam("minSize", ParameterTypeBoolean, false),
+		queryParam("upscale", ParameterTypeBoolean, false),
+	}},
+	{ID: "unlockFields", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "updateCollectionSummary", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "updateCollectionMode", Method: "PUT", Path: "/library/collections/{collectionId}/prefs", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+		queryParam("collectionMode", ParameterTypeInteger, true),
+	}},
+	{ID: "updateCollectionSort", Method: "PUT", Path: "/library/collections/{collectionId}/prefs", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+		queryParam("collectionSort", ParameterTypeInteger, true),
+	}},
+	{ID: "updateCollectionVisibility", Method: "POST", Path: "/hubs/sections/{sectionId}/manage", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("metadataItemId", ParameterTypeInteger, true),
+		queryParam("promotedToRecommended", ParameterTypeBoolean, true),
+		queryParam("promotedToOwnHome", ParameterTypeBoolean, true),
+		queryParam("promotedToSharedHome", ParameterTypeBoolean, true),
+	}},
+	{ID: "updateContentRating", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "updateManagedHub", Method: "PUT", Path: "/hubs/sections/{sectionId}/manage/{identifier}", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		pathParam("identifier", ParameterTypeString),
+		queryParam("promotedToRecommended", ParameterTypeBoolean, true),
+		queryParam("promotedToOwnHome", ParameterTypeBoolean, true),
+		queryParam("promotedToSharedHome", ParameterTypeBoolean, true),
+	}},
+	{ID: "updateSharedServer", Method: "PUT", Path: "/servers/{machineIdentifier}/shared_servers/{sharedServerId}", ServerURL: operations.GetUsersServerList[0], Parameters: []OperationParameter{
+		pathParam("machineIdentifier", ParameterTypeString),
+		pathParam("sharedServerId", ParameterTypeInteger),
+		bodyParam("shared_server", ParameterTypeObject, true),
+	}},
+	{ID: "updateShowPreferences", Method: "PUT", Path: "/library/metadata/{ratingKey}/prefs", Parameters: []OperationParameter{
+		pathParam("ratingKey", ParameterTypeInteger),
+	}},
+	{ID: "updateSortTitle", Method: "PUT", Path: "/library/sections/{sectionId}/all", Parameters: editParams()},
+	{ID: "updateSmartCollection", Method: "PUT", Path: "/library/collections/{collectionId}/items", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+		queryParam("uri", ParameterTypeString, true),
+	}},
+	{ID: "uploadCollectionTheme", Method: "POST", Path: "/library/metadata/{collectionId}/themes", Parameters: []OperationParameter{
+		pathParam("collectionId", ParameterTypeInteger),
+		queryParam("url", ParameterTypeString, false),
+		bodyParam("theme", ParameterTypeBinary, false),
+	}},
+	{ID: "uploadMedia", Method: "POST", Path: "/library/sections/{sectionId}/upload", Parameters: []OperationParameter{
+		pathParam("sectionId", ParameterTypeInteger),
+		queryParam("path", ParameterTypeString, true),
+		bodyParam("file", ParameterTypeBinary, true),
+	}},
+	{ID: "waitForActivity", Method: "GET", Path: "/:/eventsource/notifications", Parameters: []OperationParameter{
+		queryParam("filters", ParameterTypeString, true),
+	}},
+}
+
+// operationsByID indexes the catalog and fills in whether each operation is idempotent
+var operationsByID = func() map[string]OperationInfo {
+	defaults := &sdkConfiguration{}
+	byID := make(map[string]OperationInfo, len(operationCatalog))
+	for _, operation := range operationCatalog {
+		operation.Idempotent = defaults.isIdempotent(operation.ID, operation.Method)
+		byID[operation.ID] = operation
+	}
+	return byID
+}()
+
+// Operations lists the operations of the hand-written services, sorted by ID, e.g. to generate
+// mocks or gateway routes for what the SDK calls. The IDs are the OperationID values hooks see,
+// and Idempotent reflects the defaults, not options of a particular client.
+func Operations() []OperationInfo {
+	list := make([]OperationInfo, 0, len(operationsByID))
+	for _, operation := range operationsByID {
+		list = append(list, copyOperation(operation))
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// LookupOperation returns the operation with the given ID, false if it isn't in the catalog
+func LookupOperation(id string) (OperationInfo, bool) {
+	operation, ok := operationsByID[id]
+	if !ok {
+		return OperationInfo{}, false
+	}
+	return copyOperation(operation), true
+}
+
+// copyOperation copies the parameters of an operation, so callers can't change the catalog
+func copyOperation(operation OperationInfo) OperationInfo {
+	if operation.Parameters != nil {
+		operation.Parameters = append([]OperationParameter{}, operation.Parameters...)
+	}
+	return operation
+}
@@ -0,0 +1,84 @@
+package plexgo
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestOperationCatalog(t *testing.T) {
+	operations := Operations()
+	if len(operations) != len(operationCatalog) {
+		t.Errorf("Expected %d operations, got %d; is an ID listed twice?", len(operationCatalog), len(operations))
+	}
+
+	for _, operation := range operations {
+		for _, parameter := range operation.PathParameters() {
+			if !strings.Contains(operation.Path, "{"+parameter.Name+"}") {
+				t.Errorf("Path of %s has no placeholder for %s", operation.ID, parameter.Name)
+			}
+		}
+		if strings.Count(operation.Path, "{") != len(operation.PathParameters()) {
+			t.Errorf("Path of %s has placeholders that aren't parameters", operation.ID)
+		}
+	}
+
+	create, ok := LookupOperation("createCollection")
+	if !ok || create.Method != "POST" || create.Idempotent {
+		t.Errorf("Unexpected createCollection: %+v", create)
+	}
+	if visibility, _ := LookupOperation("updateCollectionVisibility"); !visibility.Idempotent {
+		t.Error("Expected updateCollectionVisibility to be idempotent")
+	}
+	if _, ok := LookupOperation("raw"); ok {
+		t.Error("Expected raw requests not to be listed")
+	}
+
+	create.Parameters[0].Name = "changed"
+	if again, _ := LookupOperation("createCollection"); again.Parameters[0].Name == "changed" {
+		t.Error("Expected the catalog not to change with the returned operation")
+	}
+}
+
+// TestOperationCatalogComplete checks that every operation ID used by the hand-written services
+// is in the catalog
+func TestOperationCatalogComplete(t *testing.T) {
+	hookID := regexp.MustCompile(`OperationID:\s+"(\w+)"`)
+	helperID := regexp.MustCompile(`\.(getJSON|listMetadata|listMetadataContainer|metadataPage|put|editCollection|editMetadata|editMetadataBulk|communityQuery)\(ctx,.*"(\w+)"`)
+	variableID := regexp.MustCompile(`operationID :?= "(\w+)"`)
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		source, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(string(source), "// Code generated") {
+			continue
+		}
+
+		ids := []string{}
+		for _, match := range hookID.FindAllStringSubmatch(string(source), -1) {
+			ids = append(ids, match[1])
+		}
+		for _, match := range helperID.FindAllStringSubmatch(string(source), -1) {
+			ids = append(ids, match[2])
+		}
+		for _, match := range variableID.FindAllStringSubmatch(string(source), -1) {
+			ids = append(ids, match[1])
+		}
+		for _, id := range ids {
+			if _, ok := LookupOperation(id); !ok && id != "raw" {
+				t.Errorf("Operation %s of %s is not in the catalog", id, file)
+			}
+		}
+	}
+}
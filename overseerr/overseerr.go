@@ -0,0 +1,273 @@
+// Package overseerr turns Plex watchlist additions and missing collection members into media
+// requests on an Overseerr (or Jellyseerr) instance, skipping titles that were already requested.
+package overseerr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// requestPageSize is the number of requests fetched per page when listing existing requests
+const requestPageSize = 100
+
+// MediaType is the type of media Overseerr can request
+type MediaType string
+
+const (
+	MediaTypeMovie MediaType = "movie"
+	MediaTypeTV    MediaType = "tv"
+)
+
+// Item is a title to request, identified by its TMDB ID
+type Item struct {
+	Title     string
+	MediaType MediaType
+	TMDBID    int
+}
+
+// Request is a media request on Overseerr
+type Request struct {
+	ID     int `json:"id"`
+	Status int `json:"status"` // 1 pending approval, 2 approved, 3 declined
+	Media  struct {
+		TMDBID    int       `json:"tmdbId"`
+		MediaType MediaType `json:"mediaType"`
+		Status    int       `json:"status"` // 5 available
+	} `json:"media"`
+}
+
+// RequestResult reports the outcome of RequestItems
+type RequestResult struct {
+	Requested []Request // Requests that were created
+	Skipped   []Item    // Items that were already requested
+}
+
+// Client is an Overseerr API client
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient overrides the HTTP client used to call Overseerr
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// New returns a client for the Overseerr instance at baseURL (e.g. http://localhost:5055),
+// authenticated with an API key from Overseerr's general settings
+func New(baseURL string, apiKey string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// ItemFromMetadata returns the request item of a Plex movie or show, using its TMDB GUID.
+// Plex only includes external GUIDs in full metadata, e.g. from Library.GetItem or Watchlist.GetMetadata.
+func ItemFromMetadata(item plexgo.Metadata) (Item, error) {
+	var mediaType MediaType
+	switch item.Type {
+	case "movie":
+		mediaType = MediaTypeMovie
+	case "show":
+		mediaType = MediaTypeTV
+	default:
+		return Item{}, fmt.Errorf("cannot request %s %s", item.Type, item.Title)
+	}
+
+	tmdbID, err := strconv.Atoi(item.ExternalID("tmdb"))
+	if err != nil {
+		return Item{}, fmt.Errorf("%s has no TMDB ID", item.Title)
+	}
+
+	return Item{Title: item.Title, MediaType: mediaType, TMDBID: tmdbID}, nil
+}
+
+// WatchlistItems resolves watchlist entries from Watchlist.GetWatchList into request items. Entries
+// that cannot be requested (e.g. because they have no TMDB ID) are returned in the error, and do
+// not stop the other entries from being resolved.
+func WatchlistItems(ctx context.Context, watchlist *plexgo.Watchlist, entries []operations.Metadata, opts ...operations.Option) ([]Item, error) {
+	items := []Item{}
+	var failed []string
+
+	for _, entry := range entries {
+		if entry.GUID == nil {
+			continue
+		}
+
+		metadata, err := watchlist.GetMetadata(ctx, *entry.GUID, opts...)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", *entry.GUID, err))
+			continue
+		}
+
+		item, err := ItemFromMetadata(*metadata)
+		if err != nil {
+			failed = append(failed, err.Error())
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	if len(failed) > 0 {
+		return items, fmt.Errorf("error resolving watchlist entries: %s", strings.Join(failed, "; "))
+	}
+
+	return items, nil
+}
+
+// RequestItems requests each item on Overseerr, skipping items that already have a request (or
+// appear twice in items)
+func (c *Client) RequestItems(ctx context.Context, items []Item) (*RequestResult, error) {
+	existing, err := c.GetRequests(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting existing requests: %w", err)
+	}
+
+	requested := make(map[string]bool, len(existing))
+	for _, request := range existing {
+		requested[itemKey(request.Media.MediaType, request.Media.TMDBID)] = true
+	}
+
+	result := &RequestResult{
+		Requested: []Request{},
+		Skipped:   []Item{},
+	}
+
+	for _, item := range items {
+		key := itemKey(item.MediaType, item.TMDBID)
+		if requested[key] {
+			result.Skipped = append(result.Skipped, item)
+			continue
+		}
+
+		request, err := c.CreateRequest(ctx, item)
+		if err != nil {
+			return result, fmt.Errorf("error requesting %s: %w", item.Title, err)
+		}
+
+		requested[key] = true
+		result.Requested = append(result.Requested, *request)
+	}
+
+	return result, nil
+}
+
+// GetRequests gets all media requests on Overseerr
+func (c *Client) GetRequests(ctx context.Context) ([]Request, error) {
+	requests := []Request{}
+
+	for skip := 0; ; skip += requestPageSize {
+		query := url.Values{}
+		query.Add("take", strconv.Itoa(requestPageSize))
+		query.Add("skip", strconv.Itoa(skip))
+		query.Add("filter", "all")
+
+		var out struct {
+			PageInfo struct {
+				Pages   int `json:"pages"`
+				Page    int `json:"page"`
+				Results int `json:"results"`
+			} `json:"pageInfo"`
+			Results []Request `json:"results"`
+		}
+		if err := c.do(ctx, "GET", "/api/v1/request?"+query.Encode(), nil, &out); err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, out.Results...)
+		if len(out.Results) == 0 || len(requests) >= out.PageInfo.Results {
+			return requests, nil
+		}
+	}
+}
+
+// CreateRequest requests an item on Overseerr. Shows are requested with all seasons.
+func (c *Client) CreateRequest(ctx context.Context, item Item) (*Request, error) {
+	body := map[string]interface{}{
+		"mediaType": item.MediaType,
+		"mediaId":   item.TMDBID,
+	}
+	if item.MediaType == MediaTypeTV {
+		body["seasons"] = "all"
+	}
+
+	var request Request
+	if err := c.do(ctx, "POST", "/api/v1/request", body, &request); err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// do sends a request to the Overseerr API and decodes the JSON response into out
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error serializing request body: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Api-Key", c.apiKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(res.Body).Decode(&apiErr)
+		return fmt.Errorf("overseerr API error %d: %s", res.StatusCode, apiErr.Message)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return nil
+}
+
+// itemKey identifies a title across requests and items
+func itemKey(mediaType MediaType, tmdbID int) string {
+	return fmt.Sprintf("%s:%d", mediaType, tmdbID)
+}
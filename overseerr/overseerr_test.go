@@ -0,0 +1,89 @@
+package overseerr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestRequestItems(t *testing.T) {
+	var created []map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" {
+			t.Errorf("Expected the API key header, got: %s", r.Header.Get("X-Api-Key"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/request":
+			w.Write([]byte(`{"pageInfo":{"pages":1,"page":1,"results":1},"results":[
+				{"id":1,"status":2,"media":{"tmdbId":603,"mediaType":"movie","status":3}}
+			]}`))
+		case r.Method == "POST" && r.URL.Path == "/api/v1/request":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Error decoding request body: %v", err)
+			}
+			created = append(created, body)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":2,"status":1,"media":{"tmdbId":1396,"mediaType":"tv","status":2}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(server.URL+"/", "secret")
+
+	result, err := client.RequestItems(context.Background(), []Item{
+		{Title: "The Matrix", MediaType: MediaTypeMovie, TMDBID: 603},
+		{Title: "Breaking Bad", MediaType: MediaTypeTV, TMDBID: 1396},
+		{Title: "Breaking Bad", MediaType: MediaTypeTV, TMDBID: 1396},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(result.Requested) != 1 || len(result.Skipped) != 2 {
+		t.Fatalf("Expected 1 request and 2 skipped items, got: %+v", result)
+	}
+
+	if len(created) != 1 || created[0]["mediaType"] != "tv" || created[0]["mediaId"] != float64(1396) || created[0]["seasons"] != "all" {
+		t.Errorf("Unexpected created request: %v", created)
+	}
+}
+
+func TestWatchlistItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/metadata/5d7768":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"5d7768","guid":"plex://movie/5d7768","type":"movie","title":"Heat",
+				"Guid":[{"id":"imdb://tt0113277"},{"id":"tmdb://949"}]}]}}`))
+		case "/library/metadata/5d9999":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"5d9999","guid":"plex://movie/5d9999","type":"movie","title":"Obscure"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	plex := plexgo.New()
+
+	heat, obscure := "plex://movie/5d7768", "plex://movie/5d9999"
+	items, err := WatchlistItems(context.Background(), plex.Watchlist, []operations.Metadata{{GUID: &heat}, {GUID: &obscure}}, operations.WithServerURL(server.URL))
+
+	if err == nil {
+		t.Error("Expected an error for the item without a TMDB ID, got nil")
+	}
+
+	if len(items) != 1 || items[0] != (Item{Title: "Heat", MediaType: MediaTypeMovie, TMDBID: 949}) {
+		t.Errorf("Unexpected items: %+v", items)
+	}
+}
@@ -0,0 +1,121 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// PersonRole is the role a person has in a library item, matching the Plex tag filter name
+type PersonRole string
+
+// Person roles supported by the library tag endpoints
+const (
+	PersonRoleActor    PersonRole = "actor"
+	PersonRoleDirector PersonRole = "director"
+	PersonRoleWriter   PersonRole = "writer"
+	PersonRoleProducer PersonRole = "producer"
+)
+
+// GetPeople gets the actors that appear in a library section, including the tag ID of each actor
+// so it can be used to filter items with GetItemsByActor
+func (s *Library) GetPeople(ctx context.Context, sectionID int, opts ...operations.Option) ([]Tag, error) {
+	return s.GetPeopleByRole(ctx, sectionID, PersonRoleActor, opts...)
+}
+
+// GetPeopleByRole gets the people with the given role (actor, director, writer or producer) in a
+// library section, including the tag ID of each person
+func (s *Library) GetPeopleByRole(ctx context.Context, sectionID int, role PersonRole, opts ...operations.Option) ([]Tag, error) {
+	return s.getTags(ctx, sectionID, string(role), opts...)
+}
+
+// getTags gets the tags of a field (e.g. actor or genre) used by the items of a library section
+func (s *Library) getTags(ctx context.Context, sectionID int, field string, opts ...operations.Option) ([]Tag, error) {
+	path := fmt.Sprintf("/library/sections/%d/%s", sectionID, field)
+
+	var out struct {
+		MediaContainer struct {
+			Directory []struct {
+				Key   string `json:"key"`
+				Title string `json:"title"`
+				Thumb string `json:"thumb,omitempty"`
+			} `json:"Directory,omitempty"`
+		} `json:"MediaContainer"`
+	}
+	if err := s.getJSON(ctx, path, nil, "getTags", &out, opts...); err != nil {
+		return nil, err
+	}
+
+	tags := make([]Tag, 0, len(out.MediaContainer.Directory))
+	for _, dir := range out.MediaContainer.Directory {
+		id, err := strconv.ParseInt(dir.Key, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag ID %q for %s %s: %w", dir.Key, field, dir.Title, err)
+		}
+		tags = append(tags, Tag{
+			ID:     id,
+			Tag:    dir.Title,
+			Thumb:  dir.Thumb,
+			Filter: fmt.Sprintf("%s=%d", field, id),
+		})
+	}
+
+	return tags, nil
+}
+
+// findTag finds a tag of a field in a library section by name (case-insensitive)
+func (s *Library) findTag(ctx context.Context, sectionID int, field string, name string, opts ...operations.Option) (*Tag, error) {
+	tags, err := s.getTags(ctx, sectionID, field, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, tag := range tags {
+		if strings.EqualFold(tag.Tag, name) {
+			return &tags[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s %q not found in section %d", field, name, sectionID)
+}
+
+// FindPerson finds a person with the given role in a library section by name (case-insensitive)
+func (s *Library) FindPerson(ctx context.Context, sectionID int, name string, role PersonRole, opts ...operations.Option) (*Tag, error) {
+	return s.findTag(ctx, sectionID, string(role), name, opts...)
+}
+
+// GetItemsByActor gets the items in a library section featuring the actor with the given tag ID
+func (s *Library) GetItemsByActor(ctx context.Context, sectionID int, actorTagID int64, opts ...operations.Option) ([]Metadata, error) {
+	return s.GetItemsByPerson(ctx, sectionID, PersonRoleActor, actorTagID, opts...)
+}
+
+// GetItemsByPerson gets the items in a library section where the person with the given tag ID has the given role
+func (s *Library) GetItemsByPerson(ctx context.Context, sectionID int, role PersonRole, tagID int64, opts ...operations.Option) ([]Metadata, error) {
+	path := fmt.Sprintf("/library/sections/%d/all", sectionID)
+
+	queryParams := url.Values{}
+	queryParams.Add(string(role), strconv.FormatInt(tagID, 10))
+
+	return s.listMetadata(ctx, path, queryParams, "getItemsByPerson", opts...)
+}
+
+// GetItem gets the full metadata of a single library item, including its genre, role and
+// director tags with their tag IDs
+func (s *Library) GetItem(ctx context.Context, ratingKey int, opts ...operations.Option) (*Metadata, error) {
+	path := fmt.Sprintf("/library/metadata/%d", ratingKey)
+
+	items, err := s.listMetadata(ctx, path, nil, "getItem", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("item %d not found", ratingKey)
+	}
+
+	return &items[0], nil
+}
@@ -0,0 +1,77 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPeople(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/sections/1/actor" || r.Method != "GET" {
+			t.Errorf("Expected GET /library/sections/1/actor, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":2,"Directory":[
+			{"key":"101","title":"Keanu Reeves","thumb":"/t/keanu.jpg"},
+			{"key":"102","title":"Carrie-Anne Moss"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	people, err := client.Library.GetPeople(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(people) != 2 {
+		t.Fatalf("Expected 2 people, got: %d", len(people))
+	}
+
+	if people[0].ID != 101 || people[0].Tag != "Keanu Reeves" || people[0].Thumb != "/t/keanu.jpg" {
+		t.Errorf("Unexpected first person: %+v", people[0])
+	}
+}
+
+func TestGetItemsByActor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/sections/1/all" || r.Method != "GET" {
+			t.Errorf("Expected GET /library/sections/1/all, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		if r.URL.Query().Get("actor") != "101" {
+			t.Errorf("Expected actor=101, got: %s", r.URL.Query().Get("actor"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[
+			{"ratingKey":"1234","key":"/library/metadata/1234","type":"movie","title":"The Matrix","year":1999,
+			 "Role":[{"id":101,"tag":"Keanu Reeves","role":"Neo","tagKey":"abc"}],
+			 "Director":[{"id":201,"tag":"Lana Wachowski"}]}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	items, err := client.Library.GetItemsByActor(context.Background(), 1, 101)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(items) != 1 || items[0].Title != "The Matrix" || items[0].Year != 1999 {
+		t.Fatalf("Unexpected items: %+v", items)
+	}
+
+	if len(items[0].Role) != 1 || items[0].Role[0].ID != 101 || items[0].Role[0].Role != "Neo" {
+		t.Errorf("Expected role tag for Keanu Reeves with ID 101, got: %+v", items[0].Role)
+	}
+
+	if len(items[0].Director) != 1 || items[0].Director[0].ID != 201 {
+		t.Errorf("Expected director tag with ID 201, got: %+v", items[0].Director)
+	}
+}
@@ -0,0 +1,149 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// photoItemType is the library type of photos, as opposed to photo albums (14)
+const photoItemType = 13
+
+// Photos provides date based queries of photo libraries, e.g. for digital frames and memories apps
+type Photos struct {
+	sdkConfiguration sdkConfiguration
+}
+
+func newPhotos(sdkConfig sdkConfiguration) *Photos {
+	return &Photos{
+		sdkConfiguration: sdkConfig,
+	}
+}
+
+// Memory is the photos taken on one day
+type Memory struct {
+	Date     time.Time  `json:"date"`
+	YearsAgo int        `json:"yearsAgo,omitempty"` // Set by GetOnThisDay
+	Photos   []Metadata `json:"photos"`
+}
+
+// GetTimeline returns the photos of a section taken between from and to, inclusive, oldest first.
+// A zero from or to leaves that end of the range open. Photos are matched by the date they were
+// taken (their originallyAvailableAt), read by the server from their EXIF data.
+func (s *Photos) GetTimeline(ctx context.Context, sectionID int, from time.Time, to time.Time, opts ...operations.Option) ([]Metadata, error) {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, fmt.Errorf("timeline ends before it starts")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("type", strconv.Itoa(photoItemType))
+	queryParams.Add("sort", "originallyAvailableAt")
+	if !from.IsZero() {
+		queryParams.Add("originallyAvailableAt>>", strconv.FormatInt(from.Unix(), 10))
+	}
+	if !to.IsZero() {
+		queryParams.Add("originallyAvailableAt<<", strconv.FormatInt(to.Unix(), 10))
+	}
+
+	photos, err := newLibrary(s.sdkConfiguration).listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), queryParams, "getPhotoTimeline", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting photos: %w", err)
+	}
+
+	return photos, nil
+}
+
+// GetOnThisDay returns the photos of a section taken on the same month and day as day in earlier
+// years, most recent year first. Years without photos are skipped, as are non-leap years for
+// February 29. The day is matched in the location of day.
+func (s *Photos) GetOnThisDay(ctx context.Context, sectionID int, day time.Time, opts ...operations.Option) ([]Memory, error) {
+	options := processOptions(opts)
+
+	oldest, err := s.getOldestPhoto(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	memories := []Memory{}
+	if oldest == nil {
+		return memories, nil
+	}
+
+	taken, ok := oldest.OriginallyAvailable()
+	if !ok {
+		return memories, nil
+	}
+
+	years := day.Year() - taken.Year()
+	reportProgress(options, 0, years, "getting photos")
+	for yearsAgo := 1; yearsAgo <= years; yearsAgo++ {
+		start := time.Date(day.Year()-yearsAgo, day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+		if start.Day() != day.Day() {
+			reportProgress(options, yearsAgo, years, "getting photos")
+			continue
+		}
+
+		photos, err := s.GetTimeline(ctx, sectionID, start, start.AddDate(0, 0, 1).Add(-time.Second), opts...)
+		if err != nil {
+			return memories, err
+		}
+		if len(photos) > 0 {
+			memories = append(memories, Memory{Date: start, YearsAgo: yearsAgo, Photos: photos})
+		}
+		reportProgress(options, yearsAgo, years, "getting photos")
+	}
+
+	return memories, nil
+}
+
+// getOldestPhoto returns the photo of a section taken first, or nil if the section has no dated photos
+func (s *Photos) getOldestPhoto(ctx context.Context, sectionID int, opts ...operations.Option) (*Metadata, error) {
+	queryParams := url.Values{}
+	queryParams.Add("type", strconv.Itoa(photoItemType))
+	queryParams.Add("sort", "originallyAvailableAt")
+	queryParams.Add("originallyAvailableAt>>", "0")
+	queryParams.Add("X-Plex-Container-Start", "0")
+	queryParams.Add("X-Plex-Container-Size", "1")
+
+	photos, err := newLibrary(s.sdkConfiguration).listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), queryParams, "getPhotoTimeline", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the oldest photo: %w", err)
+	}
+	if len(photos) == 0 {
+		return nil, nil
+	}
+
+	return &photos[0], nil
+}
+
+// GroupPhotosByDay groups photos, e.g. of a timeline, by the day they were taken, oldest day first.
+// Photos without a date are left out.
+func GroupPhotosByDay(photos []Metadata) []Memory {
+	groups := []Memory{}
+	index := map[string]int{}
+
+	for _, photo := range photos {
+		taken, ok := photo.OriginallyAvailable()
+		if !ok {
+			continue
+		}
+
+		key := taken.Format("2006-01-02")
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, Memory{Date: time.Date(taken.Year(), taken.Month(), taken.Day(), 0, 0, 0, 0, taken.Location())})
+		}
+		groups[i].Photos = append(groups[i].Photos, photo)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Date.Before(groups[j].Date) })
+
+	return groups
+}
@@ -0,0 +1,100 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// photoTimelineServer serves a photo section, answering date range queries from photos
+func photoTimelineServer(t *testing.T, photos map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/library/sections/3/all" || query.Get("type") != "13" || query.Get("sort") != "originallyAvailableAt" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+
+		from, _ := strconv.ParseInt(query.Get("originallyAvailableAt>>"), 10, 64)
+		to := int64(1 << 62)
+		if query.Get("originallyAvailableAt<<") != "" {
+			to, _ = strconv.ParseInt(query.Get("originallyAvailableAt<<"), 10, 64)
+		}
+
+		metadata := ""
+		for _, key := range []string{"1", "2", "3", "4"} {
+			taken, ok := photos[key]
+			if !ok {
+				continue
+			}
+			date, _ := time.Parse("2006-01-02 15:04:05", taken)
+			if date.Unix() < from || date.Unix() > to {
+				continue
+			}
+			if metadata != "" {
+				if query.Get("X-Plex-Container-Size") == "1" {
+					break
+				}
+				metadata += ","
+			}
+			metadata += `{"ratingKey":"` + key + `","type":"photo","originallyAvailableAt":"` + taken + `"}`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"Metadata":[` + metadata + `]}}`))
+	}))
+}
+
+func TestPhotoTimeline(t *testing.T) {
+	server := photoTimelineServer(t, map[string]string{
+		"1": "2021-07-04 09:15:00",
+		"2": "2022-07-04 18:30:00",
+		"3": "2022-07-04 19:00:00",
+		"4": "2023-07-05 10:00:00",
+	})
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	photos, err := client.Photos.GetTimeline(ctx, 3, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(photos) != 3 || photos[0].RatingKey != "2" {
+		t.Fatalf("Unexpected timeline: %+v", photos)
+	}
+
+	days := GroupPhotosByDay(photos)
+	if len(days) != 2 || len(days[0].Photos) != 2 || !days[0].Date.Equal(time.Date(2022, 7, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected days: %+v", days)
+	}
+
+	if _, err := client.Photos.GetTimeline(ctx, 3, time.Now(), time.Now().Add(-time.Hour)); err == nil {
+		t.Errorf("Expected an error for a reversed range")
+	}
+
+	memories, err := client.Photos.GetOnThisDay(ctx, 3, time.Date(2024, 7, 4, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(memories) != 2 || memories[0].YearsAgo != 2 || len(memories[0].Photos) != 2 ||
+		memories[1].YearsAgo != 3 || memories[1].Photos[0].RatingKey != "1" {
+		t.Errorf("Unexpected memories: %+v", memories)
+	}
+}
+
+func TestPhotoOnThisDayEmpty(t *testing.T) {
+	server := photoTimelineServer(t, map[string]string{})
+	defer server.Close()
+
+	memories, err := New(WithServerURL(server.URL)).Photos.GetOnThisDay(context.Background(), 3, time.Now())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(memories) != 0 {
+		t.Errorf("Expected no memories, got: %+v", memories)
+	}
+}
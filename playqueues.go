@@ -0,0 +1,231 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// Play queue types, by the kind of media they play
+const (
+	PlayQueueTypeVideo = "video"
+	PlayQueueTypeAudio = "audio"
+	PlayQueueTypePhoto = "photo"
+)
+
+// ExtraTypeTrailer is the extra type of trailers
+const ExtraTypeTrailer = 1
+
+// cinemaCandidatesPerTrailer is the number of unwatched movies checked for each trailer a cinema
+// queue needs, as not every movie has a trailer
+const cinemaCandidatesPerTrailer = 5
+
+// PlayQueues builds play queues, the ordered lists of items a player works through
+type PlayQueues struct {
+	sdkConfiguration sdkConfiguration
+}
+
+func newPlayQueues(sdkConfig sdkConfiguration) *PlayQueues {
+	return &PlayQueues{
+		sdkConfiguration: sdkConfig,
+	}
+}
+
+// PlayQueue is a play queue created on the server. Players open it by ID, starting at the
+// selected item.
+type PlayQueue struct {
+	ID             int
+	SelectedItemID int        // Play queue item ID of the item to start with
+	Items          []Metadata // In play order
+}
+
+// CreatePlayQueue creates a play queue of library items in the order of ratingKeys. queueType is
+// a PlayQueueType constant matching the items, e.g. PlayQueueTypeVideo for movies and episodes.
+func (s *PlayQueues) CreatePlayQueue(ctx context.Context, queueType string, ratingKeys []string, opts ...operations.Option) (*PlayQueue, error) {
+	if len(ratingKeys) == 0 {
+		return nil, fmt.Errorf("no items to queue")
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, "/playQueues")
+	if err != nil {
+		return nil, fmt.Errorf("error generating URL: %w", err)
+	}
+
+	identity, err := newServer(s.sdkConfiguration).GetIdentity(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server identity: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "createPlayQueue",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("type", queueType)
+	queryParams.Add("uri", fmt.Sprintf("%s/library/metadata/%s", s.sdkConfiguration.GetURIRoot(identity.MachineIdentifier), strings.Join(ratingKeys, ",")))
+	queryParams.Add("shuffle", "0")
+	queryParams.Add("repeat", "0")
+	queryParams.Add("continuous", "0")
+
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", opURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return nil, err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return nil, err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rawBody, err := utils.ConsumeRawBody(httpRes)
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		MediaContainer struct {
+			PlayQueueID             int        `json:"playQueueID"`
+			PlayQueueSelectedItemID int        `json:"playQueueSelectedItemID"`
+			Metadata                []Metadata `json:"Metadata"`
+		} `json:"MediaContainer"`
+	}
+	if err := s.sdkConfiguration.decodeResponse(hookCtx.OperationID, rawBody, &out); err != nil {
+		return nil, err
+	}
+	if out.MediaContainer.PlayQueueID == 0 {
+		return nil, fmt.Errorf("no play queue created or returned in response")
+	}
+
+	return &PlayQueue{
+		ID:             out.MediaContainer.PlayQueueID,
+		SelectedItemID: out.MediaContainer.PlayQueueSelectedItemID,
+		Items:          out.MediaContainer.Metadata,
+	}, nil
+}
+
+// CreateCinemaQueue creates a "cinema mode" play queue: up to trailers trailers of unwatched
+// movies of the same library section, picked at random, followed by the movie. Movies without a
+// trailer are skipped, so the queue may hold fewer trailers than requested, or none.
+func (s *PlayQueues) CreateCinemaQueue(ctx context.Context, ratingKey int, trailers int, opts ...operations.Option) (*PlayQueue, error) {
+	library := newLibrary(s.sdkConfiguration)
+
+	movie, err := library.GetItem(ctx, ratingKey, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting movie: %w", err)
+	}
+	if movie.Type != "movie" {
+		return nil, fmt.Errorf("%s is a %s, not a movie", movie.Title, movie.Type)
+	}
+
+	ratingKeys := []string{}
+	if trailers > 0 {
+		candidates := ItemListOptions{
+			Type:     CollectionItemTypeMovie,
+			Filter:   Cond("unwatched", FilterOpContains, "1"),
+			Sort:     "random",
+			Limit:    trailers * cinemaCandidatesPerTrailer,
+			PageSize: trailers * cinemaCandidatesPerTrailer,
+		}
+		err := library.EachItem(ctx, movie.SectionID, candidates, func(candidate Metadata) error {
+			if candidate.RatingKey == movie.RatingKey {
+				return nil
+			}
+
+			trailer, err := library.getTrailer(ctx, candidate.RatingKey, opts...)
+			if err != nil {
+				return fmt.Errorf("error getting trailer of %s: %w", candidate.Title, err)
+			}
+			if trailer == nil {
+				return nil
+			}
+
+			ratingKeys = append(ratingKeys, trailer.RatingKey)
+			if len(ratingKeys) == trailers {
+				return ErrStopWalk
+			}
+			return nil
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.CreatePlayQueue(ctx, PlayQueueTypeVideo, append(ratingKeys, movie.RatingKey), opts...)
+}
+
+// getTrailer returns the first trailer among the extras of an item, or nil if it has none
+func (s *Library) getTrailer(ctx context.Context, ratingKey string, opts ...operations.Option) (*Metadata, error) {
+	extras, err := s.listMetadata(ctx, fmt.Sprintf("/library/metadata/%s/extras", ratingKey), nil, "getMediaExtras", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, extra := range extras {
+		if extra.ExtraType == ExtraTypeTrailer {
+			return &extra, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,57 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateCinemaQueue(t *testing.T) {
+	var queueURI string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+
+		switch {
+		case r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"machineIdentifier":"abc123"}}`))
+		case r.URL.Path == "/library/metadata/10":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"10","type":"movie","title":"Heat","librarySectionID":1}]}}`))
+		case r.URL.Path == "/library/sections/1/all":
+			if query.Get("unwatched") != "1" || query.Get("sort") != "random" || query.Get("type") != "1" {
+				t.Errorf("Expected random unwatched movies, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"10","type":"movie"},{"ratingKey":"11","type":"movie"},{"ratingKey":"12","type":"movie"},{"ratingKey":"13","type":"movie"}]}}`))
+		case r.URL.Path == "/library/metadata/11/extras":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"111","type":"clip","extraType":5},{"ratingKey":"112","type":"clip","extraType":1}]}}`))
+		case r.URL.Path == "/library/metadata/12/extras":
+			w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+		case r.URL.Path == "/library/metadata/13/extras":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"131","type":"clip","extraType":1}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/playQueues":
+			queueURI = query.Get("uri")
+			if query.Get("type") != "video" {
+				t.Errorf("Expected a video play queue, got: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"MediaContainer":{"playQueueID":5,"playQueueSelectedItemID":50,"Metadata":[{"ratingKey":"112"},{"ratingKey":"131"},{"ratingKey":"10"}]}}`)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	queue, err := client.PlayQueues.CreateCinemaQueue(context.Background(), 10, 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if queueURI != "server://abc123/com.plexapp.plugins.library/library/metadata/112,131,10" {
+		t.Errorf("Expected two trailers followed by the movie, got: %s", queueURI)
+	}
+	if queue.ID != 5 || queue.SelectedItemID != 50 || len(queue.Items) != 3 {
+		t.Errorf("Unexpected play queue: %+v", queue)
+	}
+}
@@ -47,19 +47,43 @@ func Float64(f float64) *float64 { return &f }
 func Pointer[T any](v T) *T { return &v }
 
 type sdkConfiguration struct {
-	Client            HTTPClient
-	Security          func(context.Context) (interface{}, error)
-	ServerURL         string
-	ServerIndex       int
-	ServerDefaults    []map[string]string
-	Language          string
-	OpenAPIDocVersion string
-	SDKVersion        string
-	GenVersion        string
-	UserAgent         string
-	RetryConfig       *retry.Config
-	Hooks             *hooks.Hooks
-	Timeout           *time.Duration
+	Client                HTTPClient
+	Security              func(context.Context) (interface{}, error)
+	ServerURL             string
+	ServerIndex           int
+	ServerDefaults        []map[string]string
+	Language              string
+	OpenAPIDocVersion     string
+	SDKVersion            string
+	GenVersion            string
+	UserAgent             string
+	RetryConfig           *retry.Config
+	Hooks                 *hooks.Hooks
+	Timeout               *time.Duration
+	SmartFilterURIStyle   SmartFilterURIStyle
+	OperationRetryConfigs map[string]*retry.Config
+	MethodRetryConfigs    map[string]*retry.Config
+	RetryBudget           *retry.Budget
+	Middlewares           []Middleware
+	StrictDecoding        bool
+	UnknownFieldsHandler  UnknownFieldsFunc
+	ValidateResponses     bool
+	JSONCodec             JSONCodec
+	PlexLanguage          string
+	identities            *identityCache
+
+	IdempotentOperations map[string]bool
+	AppInfo              []string
+	CollectionRecycleBin string
+	filterTemplates      *filterTemplateRegistry
+	TokenInQuery         bool
+	ReadTimeout          time.Duration
+	WriteTimeout         time.Duration
+	ListTimeout          time.Duration
+	OperationTimeouts    map[string]time.Duration
+	fallbackTimeout      time.Duration
+	serverURLErr         error
+	lifecycle            *lifecycle
 }
 
 func (c *sdkConfiguration) GetServerDetails() (string, map[string]string) {
@@ -70,7 +94,7 @@ func (c *sdkConfiguration) GetServerDetails() (string, map[string]string) {
 	return ServerList[c.ServerIndex], c.ServerDefaults[c.ServerIndex]
 }
 
-func (c *sdkConfiguration) GetURIRoot(machineIdentifer string) (string) {
+func (c *sdkConfiguration) GetURIRoot(machineIdentifer string) string {
 	return fmt.Sprintf("server://%s/com.plexapp.plugins.library", machineIdentifer)
 }
 
@@ -165,16 +189,34 @@ type PlexAPI struct {
 	// Collections support different view modes and sort orders, and can have visibility settings for library, home, and shared users.
 	//
 	Collections *Collections
+	// Photos provides date based queries of photo libraries, such as timelines and "on this day" memories.
+	//
+	Photos *Photos
+	// PlayQueues builds play queues, the ordered lists of items a player works through, such as cinema mode queues with trailers before a movie.
+	//
+	PlayQueues *PlayQueues
 
 	sdkConfiguration sdkConfiguration
 }
 
 type SDKOption func(*PlexAPI)
 
-// WithServerURL allows the overriding of the default server URL
+// WithServerURL allows the overriding of the default server URL. The URL is normalized with
+// ParseServerURL, so forms such as "10.0.0.5:32400" are accepted. If the URL is invalid, every
+// operation of the client returns ParseServerURL's error, and Doctor reports it.
 func WithServerURL(serverURL string) SDKOption {
 	return func(sdk *PlexAPI) {
+		if serverURL != "" {
+			normalized, err := ParseServerURL(serverURL)
+			if err != nil {
+				sdk.sdkConfiguration.serverURLErr = err
+				return
+			}
+			serverURL = normalized
+		}
+
 		sdk.sdkConfiguration.ServerURL = serverURL
+		sdk.sdkConfiguration.serverURLErr = nil
 	}
 }
 
@@ -319,16 +361,26 @@ func New(opts ...SDKOption) *PlexAPI {
 					"port":     "32400",
 				},
 			},
-			Hooks: hooks.New(),
+			Hooks:           hooks.New(),
+			identities:      newIdentityCache(),
+			filterTemplates: newFilterTemplateRegistry(),
+			lifecycle:       newLifecycle(),
 		},
 	}
 	for _, opt := range opts {
 		opt(sdk)
 	}
+	sdk.sdkConfiguration.UserAgent = sdk.sdkConfiguration.userAgent()
 
 	// Use WithClient to override the default client if you would like to customize the timeout
 	if sdk.sdkConfiguration.Client == nil {
 		sdk.sdkConfiguration.Client = &http.Client{Timeout: 60 * time.Second}
+		// Operations set their own deadlines with WithDefaultTimeouts, which may be longer; those
+		// of a kind without one keep the client's 60 seconds
+		if sdk.sdkConfiguration.ReadTimeout > 0 || sdk.sdkConfiguration.WriteTimeout > 0 || sdk.sdkConfiguration.ListTimeout > 0 {
+			sdk.sdkConfiguration.Client = &http.Client{}
+			sdk.sdkConfiguration.fallbackTimeout = 60 * time.Second
+		}
 	}
 
 	currentServerURL, _ := sdk.sdkConfiguration.GetServerDetails()
@@ -338,6 +390,21 @@ func New(opts ...SDKOption) *PlexAPI {
 		sdk.sdkConfiguration.ServerURL = serverURL
 	}
 
+	// The client's language is set outermost so middlewares see it
+	if sdk.sdkConfiguration.PlexLanguage != "" {
+		sdk.sdkConfiguration.Middlewares = append([]Middleware{languageMiddleware(sdk.sdkConfiguration.PlexLanguage)}, sdk.sdkConfiguration.Middlewares...)
+	}
+	// The token is moved innermost so it also covers tokens set by middlewares
+	if sdk.sdkConfiguration.TokenInQuery {
+		sdk.sdkConfiguration.Middlewares = append(sdk.sdkConfiguration.Middlewares, tokenInQueryMiddleware())
+		sdk.sdkConfiguration.Client = withoutCrossHostRedirects(sdk.sdkConfiguration.Client)
+	}
+	// An invalid WithServerURL fails every request before anything else sees it
+	if sdk.sdkConfiguration.serverURLErr != nil {
+		sdk.sdkConfiguration.Middlewares = append([]Middleware{errorMiddleware(sdk.sdkConfiguration.serverURLErr)}, sdk.sdkConfiguration.Middlewares...)
+	}
+	sdk.sdkConfiguration.Client = applyMiddlewares(sdk.sdkConfiguration.Client, sdk.sdkConfiguration.Middlewares)
+
 	sdk.Server = newServer(sdk.sdkConfiguration)
 
 	sdk.Media = newMedia(sdk.sdkConfiguration)
@@ -374,5 +441,9 @@ func New(opts ...SDKOption) *PlexAPI {
 
 	sdk.Collections = newCollections(sdk.sdkConfiguration)
 
+	sdk.Photos = newPhotos(sdk.sdkConfiguration)
+
+	sdk.PlayQueues = newPlayQueues(sdk.sdkConfiguration)
+
 	return sdk
 }
@@ -0,0 +1,67 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+func TestPlexErrorResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/collections/404":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":1002,"message":"Collection not found","status":404}]}`))
+		case "/activities":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"errors":[{"code":1000,"message":"Database is locked","status":500},{"code":1001,"message":"Try again later","status":500}]}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<html>Forbidden</html>`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	// Hand-written methods
+	_, err := client.Collections.GetCollection(context.Background(), 404)
+	var plexErr *sdkerrors.PlexError
+	if !errors.As(err, &plexErr) {
+		t.Fatalf("Expected *sdkerrors.PlexError, got: %T %v", err, err)
+	}
+	if plexErr.Code != 1002 || plexErr.Message != "Collection not found" || plexErr.Status != http.StatusNotFound {
+		t.Errorf("Unexpected error fields: %+v", plexErr)
+	}
+
+	var sdkErr *sdkerrors.SDKError
+	if !errors.As(err, &sdkErr) || sdkErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected error to unwrap to *sdkerrors.SDKError with status 404, got: %v", sdkErr)
+	}
+
+	// Generated methods
+	_, err = client.Activities.GetServerActivities(context.Background())
+	if !errors.As(err, &plexErr) {
+		t.Fatalf("Expected *sdkerrors.PlexError, got: %T %v", err, err)
+	}
+	if len(plexErr.Errors) != 2 || plexErr.Code != 1000 || plexErr.Status != http.StatusInternalServerError {
+		t.Errorf("Unexpected error fields: %+v", plexErr)
+	}
+	if plexErr.Error() != "plex error 1000: Database is locked; Try again later: Status 500 (correlation ID "+plexErr.CorrelationID+")" || plexErr.CorrelationID == "" {
+		t.Errorf("Unexpected error message: %s", plexErr.Error())
+	}
+
+	// Bodies that aren't Plex errors are still reported as SDK errors
+	_, err = client.Collections.GetCollection(context.Background(), 1)
+	if errors.As(err, &plexErr) {
+		t.Errorf("Expected no *sdkerrors.PlexError, got: %v", err)
+	}
+	if !errors.As(err, &sdkErr) || sdkErr.Body != "<html>Forbidden</html>" {
+		t.Errorf("Expected *sdkerrors.SDKError with the response body, got: %v", err)
+	}
+}
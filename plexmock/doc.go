@@ -0,0 +1,6 @@
+// Package plexmock has mocks of the interfaces of the SDK's services, such as CollectionsAPIMock
+// for plexgo.CollectionsAPI, for unit tests of applications that don't talk to a Plex server.
+// The mocks are generated by go generate in the root of the module and follow the conventions
+// of moq: each method calls the Func field of the same name and records its arguments, which the
+// Calls method of the same name returns.
+package plexmock
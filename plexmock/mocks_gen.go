@@ -0,0 +1,12977 @@
+// Code generated by genapi. DO NOT EDIT.
+
+package plexmock
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"sync"
+	"time"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Ensure, that ServerAPIMock does implement plexgo.ServerAPI.
+var _ plexgo.ServerAPI = &ServerAPIMock{}
+
+// ServerAPIMock is a mock implementation of plexgo.ServerAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type ServerAPIMock struct {
+	// ClearIdentityCacheFunc mocks the ClearIdentityCache method.
+	ClearIdentityCacheFunc func()
+
+	// GetAvailableClientsFunc mocks the GetAvailableClients method.
+	GetAvailableClientsFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetAvailableClientsResponse, error)
+
+	// GetConnectionsFunc mocks the GetConnections method.
+	GetConnectionsFunc func(ctx context.Context, clientID string, opts ...operations.Option) (*plexgo.ServerConnections, error)
+
+	// GetDevicesFunc mocks the GetDevices method.
+	GetDevicesFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetDevicesResponse, error)
+
+	// GetIdentityFunc mocks the GetIdentity method.
+	GetIdentityFunc func(ctx context.Context, opts ...operations.Option) (*plexgo.ServerIdentity, error)
+
+	// GetMediaProvidersFunc mocks the GetMediaProviders method.
+	GetMediaProvidersFunc func(ctx context.Context, xPlexToken string, opts ...operations.Option) (*operations.GetMediaProvidersResponse, error)
+
+	// GetMyPlexAccountFunc mocks the GetMyPlexAccount method.
+	GetMyPlexAccountFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetMyPlexAccountResponse, error)
+
+	// GetRemoteAccessStatusFunc mocks the GetRemoteAccessStatus method.
+	GetRemoteAccessStatusFunc func(ctx context.Context, opts ...operations.Option) (*plexgo.RemoteAccessStatus, error)
+
+	// GetResizedPhotoFunc mocks the GetResizedPhoto method.
+	GetResizedPhotoFunc func(ctx context.Context, request operations.GetResizedPhotoRequest, opts ...operations.Option) (*operations.GetResizedPhotoResponse, error)
+
+	// GetServerCapabilitiesFunc mocks the GetServerCapabilities method.
+	GetServerCapabilitiesFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetServerCapabilitiesResponse, error)
+
+	// GetServerIdentityFunc mocks the GetServerIdentity method.
+	GetServerIdentityFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetServerIdentityResponse, error)
+
+	// GetServerListFunc mocks the GetServerList method.
+	GetServerListFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetServerListResponse, error)
+
+	// GetServerPreferencesFunc mocks the GetServerPreferences method.
+	GetServerPreferencesFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetServerPreferencesResponse, error)
+
+	// SetRemoteAccessFunc mocks the SetRemoteAccess method.
+	SetRemoteAccessFunc func(ctx context.Context, enabled bool, publicPort int, manualMapping bool, opts ...operations.Option) error
+
+	// TailLogsFunc mocks the TailLogs method.
+	TailLogsFunc func(ctx context.Context, level operations.Level, opts ...operations.Option) (<-chan plexgo.LogEntry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ClearIdentityCache holds details about calls to the ClearIdentityCache method.
+		ClearIdentityCache []struct {
+		}
+		// GetAvailableClients holds details about calls to the GetAvailableClients method.
+		GetAvailableClients []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetConnections holds details about calls to the GetConnections method.
+		GetConnections []struct {
+			Ctx      context.Context
+			ClientID string
+			Opts     []operations.Option
+		}
+		// GetDevices holds details about calls to the GetDevices method.
+		GetDevices []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetIdentity holds details about calls to the GetIdentity method.
+		GetIdentity []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetMediaProviders holds details about calls to the GetMediaProviders method.
+		GetMediaProviders []struct {
+			Ctx        context.Context
+			XPlexToken string
+			Opts       []operations.Option
+		}
+		// GetMyPlexAccount holds details about calls to the GetMyPlexAccount method.
+		GetMyPlexAccount []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetRemoteAccessStatus holds details about calls to the GetRemoteAccessStatus method.
+		GetRemoteAccessStatus []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetResizedPhoto holds details about calls to the GetResizedPhoto method.
+		GetResizedPhoto []struct {
+			Ctx     context.Context
+			Request operations.GetResizedPhotoRequest
+			Opts    []operations.Option
+		}
+		// GetServerCapabilities holds details about calls to the GetServerCapabilities method.
+		GetServerCapabilities []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetServerIdentity holds details about calls to the GetServerIdentity method.
+		GetServerIdentity []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetServerList holds details about calls to the GetServerList method.
+		GetServerList []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetServerPreferences holds details about calls to the GetServerPreferences method.
+		GetServerPreferences []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// SetRemoteAccess holds details about calls to the SetRemoteAccess method.
+		SetRemoteAccess []struct {
+			Ctx           context.Context
+			Enabled       bool
+			PublicPort    int
+			ManualMapping bool
+			Opts          []operations.Option
+		}
+		// TailLogs holds details about calls to the TailLogs method.
+		TailLogs []struct {
+			Ctx   context.Context
+			Level operations.Level
+			Opts  []operations.Option
+		}
+	}
+	lockClearIdentityCache    sync.RWMutex
+	lockGetAvailableClients   sync.RWMutex
+	lockGetConnections        sync.RWMutex
+	lockGetDevices            sync.RWMutex
+	lockGetIdentity           sync.RWMutex
+	lockGetMediaProviders     sync.RWMutex
+	lockGetMyPlexAccount      sync.RWMutex
+	lockGetRemoteAccessStatus sync.RWMutex
+	lockGetResizedPhoto       sync.RWMutex
+	lockGetServerCapabilities sync.RWMutex
+	lockGetServerIdentity     sync.RWMutex
+	lockGetServerList         sync.RWMutex
+	lockGetServerPreferences  sync.RWMutex
+	lockSetRemoteAccess       sync.RWMutex
+	lockTailLogs              sync.RWMutex
+}
+
+// ClearIdentityCache calls ClearIdentityCacheFunc.
+func (mock *ServerAPIMock) ClearIdentityCache() {
+	if mock.ClearIdentityCacheFunc == nil {
+		panic("ServerAPIMock.ClearIdentityCacheFunc: method is nil but ServerAPI.ClearIdentityCache was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockClearIdentityCache.Lock()
+	mock.calls.ClearIdentityCache = append(mock.calls.ClearIdentityCache, callInfo)
+	mock.lockClearIdentityCache.Unlock()
+	mock.ClearIdentityCacheFunc()
+}
+
+// ClearIdentityCacheCalls gets all the calls that were made to ClearIdentityCache.
+// Check the length with:
+//
+//	len(mockedServer.ClearIdentityCacheCalls())
+func (mock *ServerAPIMock) ClearIdentityCacheCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockClearIdentityCache.RLock()
+	calls = mock.calls.ClearIdentityCache
+	mock.lockClearIdentityCache.RUnlock()
+	return calls
+}
+
+// GetAvailableClients calls GetAvailableClientsFunc.
+func (mock *ServerAPIMock) GetAvailableClients(ctx context.Context, opts ...operations.Option) (*operations.GetAvailableClientsResponse, error) {
+	if mock.GetAvailableClientsFunc == nil {
+		panic("ServerAPIMock.GetAvailableClientsFunc: method is nil but ServerAPI.GetAvailableClients was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetAvailableClients.Lock()
+	mock.calls.GetAvailableClients = append(mock.calls.GetAvailableClients, callInfo)
+	mock.lockGetAvailableClients.Unlock()
+	return mock.GetAvailableClientsFunc(ctx, opts...)
+}
+
+// GetAvailableClientsCalls gets all the calls that were made to GetAvailableClients.
+// Check the length with:
+//
+//	len(mockedServer.GetAvailableClientsCalls())
+func (mock *ServerAPIMock) GetAvailableClientsCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetAvailableClients.RLock()
+	calls = mock.calls.GetAvailableClients
+	mock.lockGetAvailableClients.RUnlock()
+	return calls
+}
+
+// GetConnections calls GetConnectionsFunc.
+func (mock *ServerAPIMock) GetConnections(ctx context.Context, clientID string, opts ...operations.Option) (*plexgo.ServerConnections, error) {
+	if mock.GetConnectionsFunc == nil {
+		panic("ServerAPIMock.GetConnectionsFunc: method is nil but ServerAPI.GetConnections was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ClientID string
+		Opts     []operations.Option
+	}{
+		Ctx:      ctx,
+		ClientID: clientID,
+		Opts:     opts,
+	}
+	mock.lockGetConnections.Lock()
+	mock.calls.GetConnections = append(mock.calls.GetConnections, callInfo)
+	mock.lockGetConnections.Unlock()
+	return mock.GetConnectionsFunc(ctx, clientID, opts...)
+}
+
+// GetConnectionsCalls gets all the calls that were made to GetConnections.
+// Check the length with:
+//
+//	len(mockedServer.GetConnectionsCalls())
+func (mock *ServerAPIMock) GetConnectionsCalls() []struct {
+	Ctx      context.Context
+	ClientID string
+	Opts     []operations.Option
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ClientID string
+		Opts     []operations.Option
+	}
+	mock.lockGetConnections.RLock()
+	calls = mock.calls.GetConnections
+	mock.lockGetConnections.RUnlock()
+	return calls
+}
+
+// GetDevices calls GetDevicesFunc.
+func (mock *ServerAPIMock) GetDevices(ctx context.Context, opts ...operations.Option) (*operations.GetDevicesResponse, error) {
+	if mock.GetDevicesFunc == nil {
+		panic("ServerAPIMock.GetDevicesFunc: method is nil but ServerAPI.GetDevices was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetDevices.Lock()
+	mock.calls.GetDevices = append(mock.calls.GetDevices, callInfo)
+	mock.lockGetDevices.Unlock()
+	return mock.GetDevicesFunc(ctx, opts...)
+}
+
+// GetDevicesCalls gets all the calls that were made to GetDevices.
+// Check the length with:
+//
+//	len(mockedServer.GetDevicesCalls())
+func (mock *ServerAPIMock) GetDevicesCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetDevices.RLock()
+	calls = mock.calls.GetDevices
+	mock.lockGetDevices.RUnlock()
+	return calls
+}
+
+// GetIdentity calls GetIdentityFunc.
+func (mock *ServerAPIMock) GetIdentity(ctx context.Context, opts ...operations.Option) (*plexgo.ServerIdentity, error) {
+	if mock.GetIdentityFunc == nil {
+		panic("ServerAPIMock.GetIdentityFunc: method is nil but ServerAPI.GetIdentity was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetIdentity.Lock()
+	mock.calls.GetIdentity = append(mock.calls.GetIdentity, callInfo)
+	mock.lockGetIdentity.Unlock()
+	return mock.GetIdentityFunc(ctx, opts...)
+}
+
+// GetIdentityCalls gets all the calls that were made to GetIdentity.
+// Check the length with:
+//
+//	len(mockedServer.GetIdentityCalls())
+func (mock *ServerAPIMock) GetIdentityCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetIdentity.RLock()
+	calls = mock.calls.GetIdentity
+	mock.lockGetIdentity.RUnlock()
+	return calls
+}
+
+// GetMediaProviders calls GetMediaProvidersFunc.
+func (mock *ServerAPIMock) GetMediaProviders(ctx context.Context, xPlexToken string, opts ...operations.Option) (*operations.GetMediaProvidersResponse, error) {
+	if mock.GetMediaProvidersFunc == nil {
+		panic("ServerAPIMock.GetMediaProvidersFunc: method is nil but ServerAPI.GetMediaProviders was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		XPlexToken string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		XPlexToken: xPlexToken,
+		Opts:       opts,
+	}
+	mock.lockGetMediaProviders.Lock()
+	mock.calls.GetMediaProviders = append(mock.calls.GetMediaProviders, callInfo)
+	mock.lockGetMediaProviders.Unlock()
+	return mock.GetMediaProvidersFunc(ctx, xPlexToken, opts...)
+}
+
+// GetMediaProvidersCalls gets all the calls that were made to GetMediaProviders.
+// Check the length with:
+//
+//	len(mockedServer.GetMediaProvidersCalls())
+func (mock *ServerAPIMock) GetMediaProvidersCalls() []struct {
+	Ctx        context.Context
+	XPlexToken string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		XPlexToken string
+		Opts       []operations.Option
+	}
+	mock.lockGetMediaProviders.RLock()
+	calls = mock.calls.GetMediaProviders
+	mock.lockGetMediaProviders.RUnlock()
+	return calls
+}
+
+// GetMyPlexAccount calls GetMyPlexAccountFunc.
+func (mock *ServerAPIMock) GetMyPlexAccount(ctx context.Context, opts ...operations.Option) (*operations.GetMyPlexAccountResponse, error) {
+	if mock.GetMyPlexAccountFunc == nil {
+		panic("ServerAPIMock.GetMyPlexAccountFunc: method is nil but ServerAPI.GetMyPlexAccount was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetMyPlexAccount.Lock()
+	mock.calls.GetMyPlexAccount = append(mock.calls.GetMyPlexAccount, callInfo)
+	mock.lockGetMyPlexAccount.Unlock()
+	return mock.GetMyPlexAccountFunc(ctx, opts...)
+}
+
+// GetMyPlexAccountCalls gets all the calls that were made to GetMyPlexAccount.
+// Check the length with:
+//
+//	len(mockedServer.GetMyPlexAccountCalls())
+func (mock *ServerAPIMock) GetMyPlexAccountCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetMyPlexAccount.RLock()
+	calls = mock.calls.GetMyPlexAccount
+	mock.lockGetMyPlexAccount.RUnlock()
+	return calls
+}
+
+// GetRemoteAccessStatus calls GetRemoteAccessStatusFunc.
+func (mock *ServerAPIMock) GetRemoteAccessStatus(ctx context.Context, opts ...operations.Option) (*plexgo.RemoteAccessStatus, error) {
+	if mock.GetRemoteAccessStatusFunc == nil {
+		panic("ServerAPIMock.GetRemoteAccessStatusFunc: method is nil but ServerAPI.GetRemoteAccessStatus was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetRemoteAccessStatus.Lock()
+	mock.calls.GetRemoteAccessStatus = append(mock.calls.GetRemoteAccessStatus, callInfo)
+	mock.lockGetRemoteAccessStatus.Unlock()
+	return mock.GetRemoteAccessStatusFunc(ctx, opts...)
+}
+
+// GetRemoteAccessStatusCalls gets all the calls that were made to GetRemoteAccessStatus.
+// Check the length with:
+//
+//	len(mockedServer.GetRemoteAccessStatusCalls())
+func (mock *ServerAPIMock) GetRemoteAccessStatusCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetRemoteAccessStatus.RLock()
+	calls = mock.calls.GetRemoteAccessStatus
+	mock.lockGetRemoteAccessStatus.RUnlock()
+	return calls
+}
+
+// GetResizedPhoto calls GetResizedPhotoFunc.
+func (mock *ServerAPIMock) GetResizedPhoto(ctx context.Context, request operations.GetResizedPhotoRequest, opts ...operations.Option) (*operations.GetResizedPhotoResponse, error) {
+	if mock.GetResizedPhotoFunc == nil {
+		panic("ServerAPIMock.GetResizedPhotoFunc: method is nil but ServerAPI.GetResizedPhoto was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetResizedPhotoRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetResizedPhoto.Lock()
+	mock.calls.GetResizedPhoto = append(mock.calls.GetResizedPhoto, callInfo)
+	mock.lockGetResizedPhoto.Unlock()
+	return mock.GetResizedPhotoFunc(ctx, request, opts...)
+}
+
+// GetResizedPhotoCalls gets all the calls that were made to GetResizedPhoto.
+// Check the length with:
+//
+//	len(mockedServer.GetResizedPhotoCalls())
+func (mock *ServerAPIMock) GetResizedPhotoCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetResizedPhotoRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetResizedPhotoRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetResizedPhoto.RLock()
+	calls = mock.calls.GetResizedPhoto
+	mock.lockGetResizedPhoto.RUnlock()
+	return calls
+}
+
+// GetServerCapabilities calls GetServerCapabilitiesFunc.
+func (mock *ServerAPIMock) GetServerCapabilities(ctx context.Context, opts ...operations.Option) (*operations.GetServerCapabilitiesResponse, error) {
+	if mock.GetServerCapabilitiesFunc == nil {
+		panic("ServerAPIMock.GetServerCapabilitiesFunc: method is nil but ServerAPI.GetServerCapabilities was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetServerCapabilities.Lock()
+	mock.calls.GetServerCapabilities = append(mock.calls.GetServerCapabilities, callInfo)
+	mock.lockGetServerCapabilities.Unlock()
+	return mock.GetServerCapabilitiesFunc(ctx, opts...)
+}
+
+// GetServerCapabilitiesCalls gets all the calls that were made to GetServerCapabilities.
+// Check the length with:
+//
+//	len(mockedServer.GetServerCapabilitiesCalls())
+func (mock *ServerAPIMock) GetServerCapabilitiesCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetServerCapabilities.RLock()
+	calls = mock.calls.GetServerCapabilities
+	mock.lockGetServerCapabilities.RUnlock()
+	return calls
+}
+
+// GetServerIdentity calls GetServerIdentityFunc.
+func (mock *ServerAPIMock) GetServerIdentity(ctx context.Context, opts ...operations.Option) (*operations.GetServerIdentityResponse, error) {
+	if mock.GetServerIdentityFunc == nil {
+		panic("ServerAPIMock.GetServerIdentityFunc: method is nil but ServerAPI.GetServerIdentity was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetServerIdentity.Lock()
+	mock.calls.GetServerIdentity = append(mock.calls.GetServerIdentity, callInfo)
+	mock.lockGetServerIdentity.Unlock()
+	return mock.GetServerIdentityFunc(ctx, opts...)
+}
+
+// GetServerIdentityCalls gets all the calls that were made to GetServerIdentity.
+// Check the length with:
+//
+//	len(mockedServer.GetServerIdentityCalls())
+func (mock *ServerAPIMock) GetServerIdentityCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetServerIdentity.RLock()
+	calls = mock.calls.GetServerIdentity
+	mock.lockGetServerIdentity.RUnlock()
+	return calls
+}
+
+// GetServerList calls GetServerListFunc.
+func (mock *ServerAPIMock) GetServerList(ctx context.Context, opts ...operations.Option) (*operations.GetServerListResponse, error) {
+	if mock.GetServerListFunc == nil {
+		panic("ServerAPIMock.GetServerListFunc: method is nil but ServerAPI.GetServerList was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetServerList.Lock()
+	mock.calls.GetServerList = append(mock.calls.GetServerList, callInfo)
+	mock.lockGetServerList.Unlock()
+	return mock.GetServerListFunc(ctx, opts...)
+}
+
+// GetServerListCalls gets all the calls that were made to GetServerList.
+// Check the length with:
+//
+//	len(mockedServer.GetServerListCalls())
+func (mock *ServerAPIMock) GetServerListCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetServerList.RLock()
+	calls = mock.calls.GetServerList
+	mock.lockGetServerList.RUnlock()
+	return calls
+}
+
+// GetServerPreferences calls GetServerPreferencesFunc.
+func (mock *ServerAPIMock) GetServerPreferences(ctx context.Context, opts ...operations.Option) (*operations.GetServerPreferencesResponse, error) {
+	if mock.GetServerPreferencesFunc == nil {
+		panic("ServerAPIMock.GetServerPreferencesFunc: method is nil but ServerAPI.GetServerPreferences was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetServerPreferences.Lock()
+	mock.calls.GetServerPreferences = append(mock.calls.GetServerPreferences, callInfo)
+	mock.lockGetServerPreferences.Unlock()
+	return mock.GetServerPreferencesFunc(ctx, opts...)
+}
+
+// GetServerPreferencesCalls gets all the calls that were made to GetServerPreferences.
+// Check the length with:
+//
+//	len(mockedServer.GetServerPreferencesCalls())
+func (mock *ServerAPIMock) GetServerPreferencesCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetServerPreferences.RLock()
+	calls = mock.calls.GetServerPreferences
+	mock.lockGetServerPreferences.RUnlock()
+	return calls
+}
+
+// SetRemoteAccess calls SetRemoteAccessFunc.
+func (mock *ServerAPIMock) SetRemoteAccess(ctx context.Context, enabled bool, publicPort int, manualMapping bool, opts ...operations.Option) error {
+	if mock.SetRemoteAccessFunc == nil {
+		panic("ServerAPIMock.SetRemoteAccessFunc: method is nil but ServerAPI.SetRemoteAccess was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Enabled       bool
+		PublicPort    int
+		ManualMapping bool
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		Enabled:       enabled,
+		PublicPort:    publicPort,
+		ManualMapping: manualMapping,
+		Opts:          opts,
+	}
+	mock.lockSetRemoteAccess.Lock()
+	mock.calls.SetRemoteAccess = append(mock.calls.SetRemoteAccess, callInfo)
+	mock.lockSetRemoteAccess.Unlock()
+	return mock.SetRemoteAccessFunc(ctx, enabled, publicPort, manualMapping, opts...)
+}
+
+// SetRemoteAccessCalls gets all the calls that were made to SetRemoteAccess.
+// Check the length with:
+//
+//	len(mockedServer.SetRemoteAccessCalls())
+func (mock *ServerAPIMock) SetRemoteAccessCalls() []struct {
+	Ctx           context.Context
+	Enabled       bool
+	PublicPort    int
+	ManualMapping bool
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Enabled       bool
+		PublicPort    int
+		ManualMapping bool
+		Opts          []operations.Option
+	}
+	mock.lockSetRemoteAccess.RLock()
+	calls = mock.calls.SetRemoteAccess
+	mock.lockSetRemoteAccess.RUnlock()
+	return calls
+}
+
+// TailLogs calls TailLogsFunc.
+func (mock *ServerAPIMock) TailLogs(ctx context.Context, level operations.Level, opts ...operations.Option) (<-chan plexgo.LogEntry, error) {
+	if mock.TailLogsFunc == nil {
+		panic("ServerAPIMock.TailLogsFunc: method is nil but ServerAPI.TailLogs was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Level operations.Level
+		Opts  []operations.Option
+	}{
+		Ctx:   ctx,
+		Level: level,
+		Opts:  opts,
+	}
+	mock.lockTailLogs.Lock()
+	mock.calls.TailLogs = append(mock.calls.TailLogs, callInfo)
+	mock.lockTailLogs.Unlock()
+	return mock.TailLogsFunc(ctx, level, opts...)
+}
+
+// TailLogsCalls gets all the calls that were made to TailLogs.
+// Check the length with:
+//
+//	len(mockedServer.TailLogsCalls())
+func (mock *ServerAPIMock) TailLogsCalls() []struct {
+	Ctx   context.Context
+	Level operations.Level
+	Opts  []operations.Option
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Level operations.Level
+		Opts  []operations.Option
+	}
+	mock.lockTailLogs.RLock()
+	calls = mock.calls.TailLogs
+	mock.lockTailLogs.RUnlock()
+	return calls
+}
+
+// Ensure, that MediaAPIMock does implement plexgo.MediaAPI.
+var _ plexgo.MediaAPI = &MediaAPIMock{}
+
+// MediaAPIMock is a mock implementation of plexgo.MediaAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type MediaAPIMock struct {
+	// DeleteVersionFunc mocks the DeleteVersion method.
+	DeleteVersionFunc func(ctx context.Context, ratingKey string, mediaID int64, opts ...operations.Option) error
+
+	// GetBannerImageFunc mocks the GetBannerImage method.
+	GetBannerImageFunc func(ctx context.Context, request operations.GetBannerImageRequest, opts ...operations.Option) (*operations.GetBannerImageResponse, error)
+
+	// GetEditionFunc mocks the GetEdition method.
+	GetEditionFunc func(ctx context.Context, ratingKey string, editionTitle string, opts ...operations.Option) (*plexgo.Metadata, error)
+
+	// GetEditionsFunc mocks the GetEditions method.
+	GetEditionsFunc func(ctx context.Context, ratingKey string, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// GetLyricsFunc mocks the GetLyrics method.
+	GetLyricsFunc func(ctx context.Context, trackRatingKey int, opts ...operations.Option) (*plexgo.Lyrics, error)
+
+	// GetThumbImageFunc mocks the GetThumbImage method.
+	GetThumbImageFunc func(ctx context.Context, request operations.GetThumbImageRequest, opts ...operations.Option) (*operations.GetThumbImageResponse, error)
+
+	// ListVersionsFunc mocks the ListVersions method.
+	ListVersionsFunc func(ctx context.Context, ratingKey string, opts ...operations.Option) ([]plexgo.MediaVersion, error)
+
+	// MarkPlayedFunc mocks the MarkPlayed method.
+	MarkPlayedFunc func(ctx context.Context, key float64, opts ...operations.Option) (*operations.MarkPlayedResponse, error)
+
+	// MarkUnplayedFunc mocks the MarkUnplayed method.
+	MarkUnplayedFunc func(ctx context.Context, key float64, opts ...operations.Option) (*operations.MarkUnplayedResponse, error)
+
+	// UpdatePlayProgressFunc mocks the UpdatePlayProgress method.
+	UpdatePlayProgressFunc func(ctx context.Context, key string, time float64, state string, opts ...operations.Option) (*operations.UpdatePlayProgressResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteVersion holds details about calls to the DeleteVersion method.
+		DeleteVersion []struct {
+			Ctx       context.Context
+			RatingKey string
+			MediaID   int64
+			Opts      []operations.Option
+		}
+		// GetBannerImage holds details about calls to the GetBannerImage method.
+		GetBannerImage []struct {
+			Ctx     context.Context
+			Request operations.GetBannerImageRequest
+			Opts    []operations.Option
+		}
+		// GetEdition holds details about calls to the GetEdition method.
+		GetEdition []struct {
+			Ctx          context.Context
+			RatingKey    string
+			EditionTitle string
+			Opts         []operations.Option
+		}
+		// GetEditions holds details about calls to the GetEditions method.
+		GetEditions []struct {
+			Ctx       context.Context
+			RatingKey string
+			Opts      []operations.Option
+		}
+		// GetLyrics holds details about calls to the GetLyrics method.
+		GetLyrics []struct {
+			Ctx            context.Context
+			TrackRatingKey int
+			Opts           []operations.Option
+		}
+		// GetThumbImage holds details about calls to the GetThumbImage method.
+		GetThumbImage []struct {
+			Ctx     context.Context
+			Request operations.GetThumbImageRequest
+			Opts    []operations.Option
+		}
+		// ListVersions holds details about calls to the ListVersions method.
+		ListVersions []struct {
+			Ctx       context.Context
+			RatingKey string
+			Opts      []operations.Option
+		}
+		// MarkPlayed holds details about calls to the MarkPlayed method.
+		MarkPlayed []struct {
+			Ctx  context.Context
+			Key  float64
+			Opts []operations.Option
+		}
+		// MarkUnplayed holds details about calls to the MarkUnplayed method.
+		MarkUnplayed []struct {
+			Ctx  context.Context
+			Key  float64
+			Opts []operations.Option
+		}
+		// UpdatePlayProgress holds details about calls to the UpdatePlayProgress method.
+		UpdatePlayProgress []struct {
+			Ctx   context.Context
+			Key   string
+			Time  float64
+			State string
+			Opts  []operations.Option
+		}
+	}
+	lockDeleteVersion      sync.RWMutex
+	lockGetBannerImage     sync.RWMutex
+	lockGetEdition         sync.RWMutex
+	lockGetEditions        sync.RWMutex
+	lockGetLyrics          sync.RWMutex
+	lockGetThumbImage      sync.RWMutex
+	lockListVersions       sync.RWMutex
+	lockMarkPlayed         sync.RWMutex
+	lockMarkUnplayed       sync.RWMutex
+	lockUpdatePlayProgress sync.RWMutex
+}
+
+// DeleteVersion calls DeleteVersionFunc.
+func (mock *MediaAPIMock) DeleteVersion(ctx context.Context, ratingKey string, mediaID int64, opts ...operations.Option) error {
+	if mock.DeleteVersionFunc == nil {
+		panic("MediaAPIMock.DeleteVersionFunc: method is nil but MediaAPI.DeleteVersion was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey string
+		MediaID   int64
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		MediaID:   mediaID,
+		Opts:      opts,
+	}
+	mock.lockDeleteVersion.Lock()
+	mock.calls.DeleteVersion = append(mock.calls.DeleteVersion, callInfo)
+	mock.lockDeleteVersion.Unlock()
+	return mock.DeleteVersionFunc(ctx, ratingKey, mediaID, opts...)
+}
+
+// DeleteVersionCalls gets all the calls that were made to DeleteVersion.
+// Check the length with:
+//
+//	len(mockedMedia.DeleteVersionCalls())
+func (mock *MediaAPIMock) DeleteVersionCalls() []struct {
+	Ctx       context.Context
+	RatingKey string
+	MediaID   int64
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey string
+		MediaID   int64
+		Opts      []operations.Option
+	}
+	mock.lockDeleteVersion.RLock()
+	calls = mock.calls.DeleteVersion
+	mock.lockDeleteVersion.RUnlock()
+	return calls
+}
+
+// GetBannerImage calls GetBannerImageFunc.
+func (mock *MediaAPIMock) GetBannerImage(ctx context.Context, request operations.GetBannerImageRequest, opts ...operations.Option) (*operations.GetBannerImageResponse, error) {
+	if mock.GetBannerImageFunc == nil {
+		panic("MediaAPIMock.GetBannerImageFunc: method is nil but MediaAPI.GetBannerImage was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetBannerImageRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetBannerImage.Lock()
+	mock.calls.GetBannerImage = append(mock.calls.GetBannerImage, callInfo)
+	mock.lockGetBannerImage.Unlock()
+	return mock.GetBannerImageFunc(ctx, request, opts...)
+}
+
+// GetBannerImageCalls gets all the calls that were made to GetBannerImage.
+// Check the length with:
+//
+//	len(mockedMedia.GetBannerImageCalls())
+func (mock *MediaAPIMock) GetBannerImageCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetBannerImageRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetBannerImageRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetBannerImage.RLock()
+	calls = mock.calls.GetBannerImage
+	mock.lockGetBannerImage.RUnlock()
+	return calls
+}
+
+// GetEdition calls GetEditionFunc.
+func (mock *MediaAPIMock) GetEdition(ctx context.Context, ratingKey string, editionTitle string, opts ...operations.Option) (*plexgo.Metadata, error) {
+	if mock.GetEditionFunc == nil {
+		panic("MediaAPIMock.GetEditionFunc: method is nil but MediaAPI.GetEdition was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		RatingKey    string
+		EditionTitle string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		RatingKey:    ratingKey,
+		EditionTitle: editionTitle,
+		Opts:         opts,
+	}
+	mock.lockGetEdition.Lock()
+	mock.calls.GetEdition = append(mock.calls.GetEdition, callInfo)
+	mock.lockGetEdition.Unlock()
+	return mock.GetEditionFunc(ctx, ratingKey, editionTitle, opts...)
+}
+
+// GetEditionCalls gets all the calls that were made to GetEdition.
+// Check the length with:
+//
+//	len(mockedMedia.GetEditionCalls())
+func (mock *MediaAPIMock) GetEditionCalls() []struct {
+	Ctx          context.Context
+	RatingKey    string
+	EditionTitle string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		RatingKey    string
+		EditionTitle string
+		Opts         []operations.Option
+	}
+	mock.lockGetEdition.RLock()
+	calls = mock.calls.GetEdition
+	mock.lockGetEdition.RUnlock()
+	return calls
+}
+
+// GetEditions calls GetEditionsFunc.
+func (mock *MediaAPIMock) GetEditions(ctx context.Context, ratingKey string, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.GetEditionsFunc == nil {
+		panic("MediaAPIMock.GetEditionsFunc: method is nil but MediaAPI.GetEditions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockGetEditions.Lock()
+	mock.calls.GetEditions = append(mock.calls.GetEditions, callInfo)
+	mock.lockGetEditions.Unlock()
+	return mock.GetEditionsFunc(ctx, ratingKey, opts...)
+}
+
+// GetEditionsCalls gets all the calls that were made to GetEditions.
+// Check the length with:
+//
+//	len(mockedMedia.GetEditionsCalls())
+func (mock *MediaAPIMock) GetEditionsCalls() []struct {
+	Ctx       context.Context
+	RatingKey string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey string
+		Opts      []operations.Option
+	}
+	mock.lockGetEditions.RLock()
+	calls = mock.calls.GetEditions
+	mock.lockGetEditions.RUnlock()
+	return calls
+}
+
+// GetLyrics calls GetLyricsFunc.
+func (mock *MediaAPIMock) GetLyrics(ctx context.Context, trackRatingKey int, opts ...operations.Option) (*plexgo.Lyrics, error) {
+	if mock.GetLyricsFunc == nil {
+		panic("MediaAPIMock.GetLyricsFunc: method is nil but MediaAPI.GetLyrics was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		TrackRatingKey int
+		Opts           []operations.Option
+	}{
+		Ctx:            ctx,
+		TrackRatingKey: trackRatingKey,
+		Opts:           opts,
+	}
+	mock.lockGetLyrics.Lock()
+	mock.calls.GetLyrics = append(mock.calls.GetLyrics, callInfo)
+	mock.lockGetLyrics.Unlock()
+	return mock.GetLyricsFunc(ctx, trackRatingKey, opts...)
+}
+
+// GetLyricsCalls gets all the calls that were made to GetLyrics.
+// Check the length with:
+//
+//	len(mockedMedia.GetLyricsCalls())
+func (mock *MediaAPIMock) GetLyricsCalls() []struct {
+	Ctx            context.Context
+	TrackRatingKey int
+	Opts           []operations.Option
+} {
+	var calls []struct {
+		Ctx            context.Context
+		TrackRatingKey int
+		Opts           []operations.Option
+	}
+	mock.lockGetLyrics.RLock()
+	calls = mock.calls.GetLyrics
+	mock.lockGetLyrics.RUnlock()
+	return calls
+}
+
+// GetThumbImage calls GetThumbImageFunc.
+func (mock *MediaAPIMock) GetThumbImage(ctx context.Context, request operations.GetThumbImageRequest, opts ...operations.Option) (*operations.GetThumbImageResponse, error) {
+	if mock.GetThumbImageFunc == nil {
+		panic("MediaAPIMock.GetThumbImageFunc: method is nil but MediaAPI.GetThumbImage was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetThumbImageRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetThumbImage.Lock()
+	mock.calls.GetThumbImage = append(mock.calls.GetThumbImage, callInfo)
+	mock.lockGetThumbImage.Unlock()
+	return mock.GetThumbImageFunc(ctx, request, opts...)
+}
+
+// GetThumbImageCalls gets all the calls that were made to GetThumbImage.
+// Check the length with:
+//
+//	len(mockedMedia.GetThumbImageCalls())
+func (mock *MediaAPIMock) GetThumbImageCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetThumbImageRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetThumbImageRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetThumbImage.RLock()
+	calls = mock.calls.GetThumbImage
+	mock.lockGetThumbImage.RUnlock()
+	return calls
+}
+
+// ListVersions calls ListVersionsFunc.
+func (mock *MediaAPIMock) ListVersions(ctx context.Context, ratingKey string, opts ...operations.Option) ([]plexgo.MediaVersion, error) {
+	if mock.ListVersionsFunc == nil {
+		panic("MediaAPIMock.ListVersionsFunc: method is nil but MediaAPI.ListVersions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockListVersions.Lock()
+	mock.calls.ListVersions = append(mock.calls.ListVersions, callInfo)
+	mock.lockListVersions.Unlock()
+	return mock.ListVersionsFunc(ctx, ratingKey, opts...)
+}
+
+// ListVersionsCalls gets all the calls that were made to ListVersions.
+// Check the length with:
+//
+//	len(mockedMedia.ListVersionsCalls())
+func (mock *MediaAPIMock) ListVersionsCalls() []struct {
+	Ctx       context.Context
+	RatingKey string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey string
+		Opts      []operations.Option
+	}
+	mock.lockListVersions.RLock()
+	calls = mock.calls.ListVersions
+	mock.lockListVersions.RUnlock()
+	return calls
+}
+
+// MarkPlayed calls MarkPlayedFunc.
+func (mock *MediaAPIMock) MarkPlayed(ctx context.Context, key float64, opts ...operations.Option) (*operations.MarkPlayedResponse, error) {
+	if mock.MarkPlayedFunc == nil {
+		panic("MediaAPIMock.MarkPlayedFunc: method is nil but MediaAPI.MarkPlayed was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Key  float64
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Key:  key,
+		Opts: opts,
+	}
+	mock.lockMarkPlayed.Lock()
+	mock.calls.MarkPlayed = append(mock.calls.MarkPlayed, callInfo)
+	mock.lockMarkPlayed.Unlock()
+	return mock.MarkPlayedFunc(ctx, key, opts...)
+}
+
+// MarkPlayedCalls gets all the calls that were made to MarkPlayed.
+// Check the length with:
+//
+//	len(mockedMedia.MarkPlayedCalls())
+func (mock *MediaAPIMock) MarkPlayedCalls() []struct {
+	Ctx  context.Context
+	Key  float64
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Key  float64
+		Opts []operations.Option
+	}
+	mock.lockMarkPlayed.RLock()
+	calls = mock.calls.MarkPlayed
+	mock.lockMarkPlayed.RUnlock()
+	return calls
+}
+
+// MarkUnplayed calls MarkUnplayedFunc.
+func (mock *MediaAPIMock) MarkUnplayed(ctx context.Context, key float64, opts ...operations.Option) (*operations.MarkUnplayedResponse, error) {
+	if mock.MarkUnplayedFunc == nil {
+		panic("MediaAPIMock.MarkUnplayedFunc: method is nil but MediaAPI.MarkUnplayed was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Key  float64
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Key:  key,
+		Opts: opts,
+	}
+	mock.lockMarkUnplayed.Lock()
+	mock.calls.MarkUnplayed = append(mock.calls.MarkUnplayed, callInfo)
+	mock.lockMarkUnplayed.Unlock()
+	return mock.MarkUnplayedFunc(ctx, key, opts...)
+}
+
+// MarkUnplayedCalls gets all the calls that were made to MarkUnplayed.
+// Check the length with:
+//
+//	len(mockedMedia.MarkUnplayedCalls())
+func (mock *MediaAPIMock) MarkUnplayedCalls() []struct {
+	Ctx  context.Context
+	Key  float64
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Key  float64
+		Opts []operations.Option
+	}
+	mock.lockMarkUnplayed.RLock()
+	calls = mock.calls.MarkUnplayed
+	mock.lockMarkUnplayed.RUnlock()
+	return calls
+}
+
+// UpdatePlayProgress calls UpdatePlayProgressFunc.
+func (mock *MediaAPIMock) UpdatePlayProgress(ctx context.Context, key string, time float64, state string, opts ...operations.Option) (*operations.UpdatePlayProgressResponse, error) {
+	if mock.UpdatePlayProgressFunc == nil {
+		panic("MediaAPIMock.UpdatePlayProgressFunc: method is nil but MediaAPI.UpdatePlayProgress was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Key   string
+		Time  float64
+		State string
+		Opts  []operations.Option
+	}{
+		Ctx:   ctx,
+		Key:   key,
+		Time:  time,
+		State: state,
+		Opts:  opts,
+	}
+	mock.lockUpdatePlayProgress.Lock()
+	mock.calls.UpdatePlayProgress = append(mock.calls.UpdatePlayProgress, callInfo)
+	mock.lockUpdatePlayProgress.Unlock()
+	return mock.UpdatePlayProgressFunc(ctx, key, time, state, opts...)
+}
+
+// UpdatePlayProgressCalls gets all the calls that were made to UpdatePlayProgress.
+// Check the length with:
+//
+//	len(mockedMedia.UpdatePlayProgressCalls())
+func (mock *MediaAPIMock) UpdatePlayProgressCalls() []struct {
+	Ctx   context.Context
+	Key   string
+	Time  float64
+	State string
+	Opts  []operations.Option
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Key   string
+		Time  float64
+		State string
+		Opts  []operations.Option
+	}
+	mock.lockUpdatePlayProgress.RLock()
+	calls = mock.calls.UpdatePlayProgress
+	mock.lockUpdatePlayProgress.RUnlock()
+	return calls
+}
+
+// Ensure, that VideoAPIMock does implement plexgo.VideoAPI.
+var _ plexgo.VideoAPI = &VideoAPIMock{}
+
+// VideoAPIMock is a mock implementation of plexgo.VideoAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type VideoAPIMock struct {
+	// GetStreamURLFunc mocks the GetStreamURL method.
+	GetStreamURLFunc func(ctx context.Context, ratingKey int, streamOptions plexgo.StreamOptions, opts ...operations.Option) (string, error)
+
+	// GetTimelineFunc mocks the GetTimeline method.
+	GetTimelineFunc func(ctx context.Context, request operations.GetTimelineRequest, opts ...operations.Option) (*operations.GetTimelineResponse, error)
+
+	// StartUniversalTranscodeFunc mocks the StartUniversalTranscode method.
+	StartUniversalTranscodeFunc func(ctx context.Context, request operations.StartUniversalTranscodeRequest, opts ...operations.Option) (*operations.StartUniversalTranscodeResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetStreamURL holds details about calls to the GetStreamURL method.
+		GetStreamURL []struct {
+			Ctx           context.Context
+			RatingKey     int
+			StreamOptions plexgo.StreamOptions
+			Opts          []operations.Option
+		}
+		// GetTimeline holds details about calls to the GetTimeline method.
+		GetTimeline []struct {
+			Ctx     context.Context
+			Request operations.GetTimelineRequest
+			Opts    []operations.Option
+		}
+		// StartUniversalTranscode holds details about calls to the StartUniversalTranscode method.
+		StartUniversalTranscode []struct {
+			Ctx     context.Context
+			Request operations.StartUniversalTranscodeRequest
+			Opts    []operations.Option
+		}
+	}
+	lockGetStreamURL            sync.RWMutex
+	lockGetTimeline             sync.RWMutex
+	lockStartUniversalTranscode sync.RWMutex
+}
+
+// GetStreamURL calls GetStreamURLFunc.
+func (mock *VideoAPIMock) GetStreamURL(ctx context.Context, ratingKey int, streamOptions plexgo.StreamOptions, opts ...operations.Option) (string, error) {
+	if mock.GetStreamURLFunc == nil {
+		panic("VideoAPIMock.GetStreamURLFunc: method is nil but VideoAPI.GetStreamURL was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		RatingKey     int
+		StreamOptions plexgo.StreamOptions
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		RatingKey:     ratingKey,
+		StreamOptions: streamOptions,
+		Opts:          opts,
+	}
+	mock.lockGetStreamURL.Lock()
+	mock.calls.GetStreamURL = append(mock.calls.GetStreamURL, callInfo)
+	mock.lockGetStreamURL.Unlock()
+	return mock.GetStreamURLFunc(ctx, ratingKey, streamOptions, opts...)
+}
+
+// GetStreamURLCalls gets all the calls that were made to GetStreamURL.
+// Check the length with:
+//
+//	len(mockedVideo.GetStreamURLCalls())
+func (mock *VideoAPIMock) GetStreamURLCalls() []struct {
+	Ctx           context.Context
+	RatingKey     int
+	StreamOptions plexgo.StreamOptions
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		RatingKey     int
+		StreamOptions plexgo.StreamOptions
+		Opts          []operations.Option
+	}
+	mock.lockGetStreamURL.RLock()
+	calls = mock.calls.GetStreamURL
+	mock.lockGetStreamURL.RUnlock()
+	return calls
+}
+
+// GetTimeline calls GetTimelineFunc.
+func (mock *VideoAPIMock) GetTimeline(ctx context.Context, request operations.GetTimelineRequest, opts ...operations.Option) (*operations.GetTimelineResponse, error) {
+	if mock.GetTimelineFunc == nil {
+		panic("VideoAPIMock.GetTimelineFunc: method is nil but VideoAPI.GetTimeline was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetTimelineRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetTimeline.Lock()
+	mock.calls.GetTimeline = append(mock.calls.GetTimeline, callInfo)
+	mock.lockGetTimeline.Unlock()
+	return mock.GetTimelineFunc(ctx, request, opts...)
+}
+
+// GetTimelineCalls gets all the calls that were made to GetTimeline.
+// Check the length with:
+//
+//	len(mockedVideo.GetTimelineCalls())
+func (mock *VideoAPIMock) GetTimelineCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetTimelineRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetTimelineRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetTimeline.RLock()
+	calls = mock.calls.GetTimeline
+	mock.lockGetTimeline.RUnlock()
+	return calls
+}
+
+// StartUniversalTranscode calls StartUniversalTranscodeFunc.
+func (mock *VideoAPIMock) StartUniversalTranscode(ctx context.Context, request operations.StartUniversalTranscodeRequest, opts ...operations.Option) (*operations.StartUniversalTranscodeResponse, error) {
+	if mock.StartUniversalTranscodeFunc == nil {
+		panic("VideoAPIMock.StartUniversalTranscodeFunc: method is nil but VideoAPI.StartUniversalTranscode was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.StartUniversalTranscodeRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockStartUniversalTranscode.Lock()
+	mock.calls.StartUniversalTranscode = append(mock.calls.StartUniversalTranscode, callInfo)
+	mock.lockStartUniversalTranscode.Unlock()
+	return mock.StartUniversalTranscodeFunc(ctx, request, opts...)
+}
+
+// StartUniversalTranscodeCalls gets all the calls that were made to StartUniversalTranscode.
+// Check the length with:
+//
+//	len(mockedVideo.StartUniversalTranscodeCalls())
+func (mock *VideoAPIMock) StartUniversalTranscodeCalls() []struct {
+	Ctx     context.Context
+	Request operations.StartUniversalTranscodeRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.StartUniversalTranscodeRequest
+		Opts    []operations.Option
+	}
+	mock.lockStartUniversalTranscode.RLock()
+	calls = mock.calls.StartUniversalTranscode
+	mock.lockStartUniversalTranscode.RUnlock()
+	return calls
+}
+
+// Ensure, that ActivitiesAPIMock does implement plexgo.ActivitiesAPI.
+var _ plexgo.ActivitiesAPI = &ActivitiesAPIMock{}
+
+// ActivitiesAPIMock is a mock implementation of plexgo.ActivitiesAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type ActivitiesAPIMock struct {
+	// CancelServerActivitiesFunc mocks the CancelServerActivities method.
+	CancelServerActivitiesFunc func(ctx context.Context, activityUUID string, opts ...operations.Option) (*operations.CancelServerActivitiesResponse, error)
+
+	// GetServerActivitiesFunc mocks the GetServerActivities method.
+	GetServerActivitiesFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetServerActivitiesResponse, error)
+
+	// WaitForFunc mocks the WaitFor method.
+	WaitForFunc func(ctx context.Context, predicate func(_ plexgo.Activity) bool, timeout time.Duration, opts ...operations.Option) (*plexgo.Activity, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CancelServerActivities holds details about calls to the CancelServerActivities method.
+		CancelServerActivities []struct {
+			Ctx          context.Context
+			ActivityUUID string
+			Opts         []operations.Option
+		}
+		// GetServerActivities holds details about calls to the GetServerActivities method.
+		GetServerActivities []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// WaitFor holds details about calls to the WaitFor method.
+		WaitFor []struct {
+			Ctx       context.Context
+			Predicate func(_ plexgo.Activity) bool
+			Timeout   time.Duration
+			Opts      []operations.Option
+		}
+	}
+	lockCancelServerActivities sync.RWMutex
+	lockGetServerActivities    sync.RWMutex
+	lockWaitFor                sync.RWMutex
+}
+
+// CancelServerActivities calls CancelServerActivitiesFunc.
+func (mock *ActivitiesAPIMock) CancelServerActivities(ctx context.Context, activityUUID string, opts ...operations.Option) (*operations.CancelServerActivitiesResponse, error) {
+	if mock.CancelServerActivitiesFunc == nil {
+		panic("ActivitiesAPIMock.CancelServerActivitiesFunc: method is nil but ActivitiesAPI.CancelServerActivities was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ActivityUUID string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		ActivityUUID: activityUUID,
+		Opts:         opts,
+	}
+	mock.lockCancelServerActivities.Lock()
+	mock.calls.CancelServerActivities = append(mock.calls.CancelServerActivities, callInfo)
+	mock.lockCancelServerActivities.Unlock()
+	return mock.CancelServerActivitiesFunc(ctx, activityUUID, opts...)
+}
+
+// CancelServerActivitiesCalls gets all the calls that were made to CancelServerActivities.
+// Check the length with:
+//
+//	len(mockedActivities.CancelServerActivitiesCalls())
+func (mock *ActivitiesAPIMock) CancelServerActivitiesCalls() []struct {
+	Ctx          context.Context
+	ActivityUUID string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ActivityUUID string
+		Opts         []operations.Option
+	}
+	mock.lockCancelServerActivities.RLock()
+	calls = mock.calls.CancelServerActivities
+	mock.lockCancelServerActivities.RUnlock()
+	return calls
+}
+
+// GetServerActivities calls GetServerActivitiesFunc.
+func (mock *ActivitiesAPIMock) GetServerActivities(ctx context.Context, opts ...operations.Option) (*operations.GetServerActivitiesResponse, error) {
+	if mock.GetServerActivitiesFunc == nil {
+		panic("ActivitiesAPIMock.GetServerActivitiesFunc: method is nil but ActivitiesAPI.GetServerActivities was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetServerActivities.Lock()
+	mock.calls.GetServerActivities = append(mock.calls.GetServerActivities, callInfo)
+	mock.lockGetServerActivities.Unlock()
+	return mock.GetServerActivitiesFunc(ctx, opts...)
+}
+
+// GetServerActivitiesCalls gets all the calls that were made to GetServerActivities.
+// Check the length with:
+//
+//	len(mockedActivities.GetServerActivitiesCalls())
+func (mock *ActivitiesAPIMock) GetServerActivitiesCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetServerActivities.RLock()
+	calls = mock.calls.GetServerActivities
+	mock.lockGetServerActivities.RUnlock()
+	return calls
+}
+
+// WaitFor calls WaitForFunc.
+func (mock *ActivitiesAPIMock) WaitFor(ctx context.Context, predicate func(_ plexgo.Activity) bool, timeout time.Duration, opts ...operations.Option) (*plexgo.Activity, error) {
+	if mock.WaitForFunc == nil {
+		panic("ActivitiesAPIMock.WaitForFunc: method is nil but ActivitiesAPI.WaitFor was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Predicate func(_ plexgo.Activity) bool
+		Timeout   time.Duration
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		Predicate: predicate,
+		Timeout:   timeout,
+		Opts:      opts,
+	}
+	mock.lockWaitFor.Lock()
+	mock.calls.WaitFor = append(mock.calls.WaitFor, callInfo)
+	mock.lockWaitFor.Unlock()
+	return mock.WaitForFunc(ctx, predicate, timeout, opts...)
+}
+
+// WaitForCalls gets all the calls that were made to WaitFor.
+// Check the length with:
+//
+//	len(mockedActivities.WaitForCalls())
+func (mock *ActivitiesAPIMock) WaitForCalls() []struct {
+	Ctx       context.Context
+	Predicate func(_ plexgo.Activity) bool
+	Timeout   time.Duration
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Predicate func(_ plexgo.Activity) bool
+		Timeout   time.Duration
+		Opts      []operations.Option
+	}
+	mock.lockWaitFor.RLock()
+	calls = mock.calls.WaitFor
+	mock.lockWaitFor.RUnlock()
+	return calls
+}
+
+// Ensure, that ButlerAPIMock does implement plexgo.ButlerAPI.
+var _ plexgo.ButlerAPI = &ButlerAPIMock{}
+
+// ButlerAPIMock is a mock implementation of plexgo.ButlerAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type ButlerAPIMock struct {
+	// GetButlerTasksFunc mocks the GetButlerTasks method.
+	GetButlerTasksFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetButlerTasksResponse, error)
+
+	// StartAllTasksFunc mocks the StartAllTasks method.
+	StartAllTasksFunc func(ctx context.Context, opts ...operations.Option) (*operations.StartAllTasksResponse, error)
+
+	// StartTaskFunc mocks the StartTask method.
+	StartTaskFunc func(ctx context.Context, taskName operations.TaskName, opts ...operations.Option) (*operations.StartTaskResponse, error)
+
+	// StopAllTasksFunc mocks the StopAllTasks method.
+	StopAllTasksFunc func(ctx context.Context, opts ...operations.Option) (*operations.StopAllTasksResponse, error)
+
+	// StopTaskFunc mocks the StopTask method.
+	StopTaskFunc func(ctx context.Context, taskName operations.PathParamTaskName, opts ...operations.Option) (*operations.StopTaskResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetButlerTasks holds details about calls to the GetButlerTasks method.
+		GetButlerTasks []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// StartAllTasks holds details about calls to the StartAllTasks method.
+		StartAllTasks []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// StartTask holds details about calls to the StartTask method.
+		StartTask []struct {
+			Ctx      context.Context
+			TaskName operations.TaskName
+			Opts     []operations.Option
+		}
+		// StopAllTasks holds details about calls to the StopAllTasks method.
+		StopAllTasks []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// StopTask holds details about calls to the StopTask method.
+		StopTask []struct {
+			Ctx      context.Context
+			TaskName operations.PathParamTaskName
+			Opts     []operations.Option
+		}
+	}
+	lockGetButlerTasks sync.RWMutex
+	lockStartAllTasks  sync.RWMutex
+	lockStartTask      sync.RWMutex
+	lockStopAllTasks   sync.RWMutex
+	lockStopTask       sync.RWMutex
+}
+
+// GetButlerTasks calls GetButlerTasksFunc.
+func (mock *ButlerAPIMock) GetButlerTasks(ctx context.Context, opts ...operations.Option) (*operations.GetButlerTasksResponse, error) {
+	if mock.GetButlerTasksFunc == nil {
+		panic("ButlerAPIMock.GetButlerTasksFunc: method is nil but ButlerAPI.GetButlerTasks was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetButlerTasks.Lock()
+	mock.calls.GetButlerTasks = append(mock.calls.GetButlerTasks, callInfo)
+	mock.lockGetButlerTasks.Unlock()
+	return mock.GetButlerTasksFunc(ctx, opts...)
+}
+
+// GetButlerTasksCalls gets all the calls that were made to GetButlerTasks.
+// Check the length with:
+//
+//	len(mockedButler.GetButlerTasksCalls())
+func (mock *ButlerAPIMock) GetButlerTasksCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetButlerTasks.RLock()
+	calls = mock.calls.GetButlerTasks
+	mock.lockGetButlerTasks.RUnlock()
+	return calls
+}
+
+// StartAllTasks calls StartAllTasksFunc.
+func (mock *ButlerAPIMock) StartAllTasks(ctx context.Context, opts ...operations.Option) (*operations.StartAllTasksResponse, error) {
+	if mock.StartAllTasksFunc == nil {
+		panic("ButlerAPIMock.StartAllTasksFunc: method is nil but ButlerAPI.StartAllTasks was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockStartAllTasks.Lock()
+	mock.calls.StartAllTasks = append(mock.calls.StartAllTasks, callInfo)
+	mock.lockStartAllTasks.Unlock()
+	return mock.StartAllTasksFunc(ctx, opts...)
+}
+
+// StartAllTasksCalls gets all the calls that were made to StartAllTasks.
+// Check the length with:
+//
+//	len(mockedButler.StartAllTasksCalls())
+func (mock *ButlerAPIMock) StartAllTasksCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockStartAllTasks.RLock()
+	calls = mock.calls.StartAllTasks
+	mock.lockStartAllTasks.RUnlock()
+	return calls
+}
+
+// StartTask calls StartTaskFunc.
+func (mock *ButlerAPIMock) StartTask(ctx context.Context, taskName operations.TaskName, opts ...operations.Option) (*operations.StartTaskResponse, error) {
+	if mock.StartTaskFunc == nil {
+		panic("ButlerAPIMock.StartTaskFunc: method is nil but ButlerAPI.StartTask was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		TaskName operations.TaskName
+		Opts     []operations.Option
+	}{
+		Ctx:      ctx,
+		TaskName: taskName,
+		Opts:     opts,
+	}
+	mock.lockStartTask.Lock()
+	mock.calls.StartTask = append(mock.calls.StartTask, callInfo)
+	mock.lockStartTask.Unlock()
+	return mock.StartTaskFunc(ctx, taskName, opts...)
+}
+
+// StartTaskCalls gets all the calls that were made to StartTask.
+// Check the length with:
+//
+//	len(mockedButler.StartTaskCalls())
+func (mock *ButlerAPIMock) StartTaskCalls() []struct {
+	Ctx      context.Context
+	TaskName operations.TaskName
+	Opts     []operations.Option
+} {
+	var calls []struct {
+		Ctx      context.Context
+		TaskName operations.TaskName
+		Opts     []operations.Option
+	}
+	mock.lockStartTask.RLock()
+	calls = mock.calls.StartTask
+	mock.lockStartTask.RUnlock()
+	return calls
+}
+
+// StopAllTasks calls StopAllTasksFunc.
+func (mock *ButlerAPIMock) StopAllTasks(ctx context.Context, opts ...operations.Option) (*operations.StopAllTasksResponse, error) {
+	if mock.StopAllTasksFunc == nil {
+		panic("ButlerAPIMock.StopAllTasksFunc: method is nil but ButlerAPI.StopAllTasks was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockStopAllTasks.Lock()
+	mock.calls.StopAllTasks = append(mock.calls.StopAllTasks, callInfo)
+	mock.lockStopAllTasks.Unlock()
+	return mock.StopAllTasksFunc(ctx, opts...)
+}
+
+// StopAllTasksCalls gets all the calls that were made to StopAllTasks.
+// Check the length with:
+//
+//	len(mockedButler.StopAllTasksCalls())
+func (mock *ButlerAPIMock) StopAllTasksCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockStopAllTasks.RLock()
+	calls = mock.calls.StopAllTasks
+	mock.lockStopAllTasks.RUnlock()
+	return calls
+}
+
+// StopTask calls StopTaskFunc.
+func (mock *ButlerAPIMock) StopTask(ctx context.Context, taskName operations.PathParamTaskName, opts ...operations.Option) (*operations.StopTaskResponse, error) {
+	if mock.StopTaskFunc == nil {
+		panic("ButlerAPIMock.StopTaskFunc: method is nil but ButlerAPI.StopTask was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		TaskName operations.PathParamTaskName
+		Opts     []operations.Option
+	}{
+		Ctx:      ctx,
+		TaskName: taskName,
+		Opts:     opts,
+	}
+	mock.lockStopTask.Lock()
+	mock.calls.StopTask = append(mock.calls.StopTask, callInfo)
+	mock.lockStopTask.Unlock()
+	return mock.StopTaskFunc(ctx, taskName, opts...)
+}
+
+// StopTaskCalls gets all the calls that were made to StopTask.
+// Check the length with:
+//
+//	len(mockedButler.StopTaskCalls())
+func (mock *ButlerAPIMock) StopTaskCalls() []struct {
+	Ctx      context.Context
+	TaskName operations.PathParamTaskName
+	Opts     []operations.Option
+} {
+	var calls []struct {
+		Ctx      context.Context
+		TaskName operations.PathParamTaskName
+		Opts     []operations.Option
+	}
+	mock.lockStopTask.RLock()
+	calls = mock.calls.StopTask
+	mock.lockStopTask.RUnlock()
+	return calls
+}
+
+// Ensure, that PlexTVAPIMock does implement plexgo.PlexTVAPI.
+var _ plexgo.PlexTVAPI = &PlexTVAPIMock{}
+
+// PlexTVAPIMock is a mock implementation of plexgo.PlexTVAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type PlexTVAPIMock struct {
+	// GetCompanionsDataFunc mocks the GetCompanionsData method.
+	GetCompanionsDataFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetCompanionsDataResponse, error)
+
+	// GetGeoDataFunc mocks the GetGeoData method.
+	GetGeoDataFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetGeoDataResponse, error)
+
+	// GetHomeDataFunc mocks the GetHomeData method.
+	GetHomeDataFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetHomeDataResponse, error)
+
+	// GetPinFunc mocks the GetPin method.
+	GetPinFunc func(ctx context.Context, request operations.GetPinRequest, opts ...operations.Option) (*operations.GetPinResponse, error)
+
+	// GetServerResourcesFunc mocks the GetServerResources method.
+	GetServerResourcesFunc func(ctx context.Context, clientID string, includeHTTPS *operations.IncludeHTTPS, includeRelay *operations.IncludeRelay, includeIPv6 *operations.IncludeIPv6, opts ...operations.Option) (*operations.GetServerResourcesResponse, error)
+
+	// GetTokenByPinIDFunc mocks the GetTokenByPinID method.
+	GetTokenByPinIDFunc func(ctx context.Context, request operations.GetTokenByPinIDRequest, opts ...operations.Option) (*operations.GetTokenByPinIDResponse, error)
+
+	// GetUserFriendsFunc mocks the GetUserFriends method.
+	GetUserFriendsFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetUserFriendsResponse, error)
+
+	// GetWatchHistoryFunc mocks the GetWatchHistory method.
+	GetWatchHistoryFunc func(ctx context.Context, filter plexgo.WatchHistoryFilter, opts ...operations.Option) ([]plexgo.WatchHistoryEntry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetCompanionsData holds details about calls to the GetCompanionsData method.
+		GetCompanionsData []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetGeoData holds details about calls to the GetGeoData method.
+		GetGeoData []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetHomeData holds details about calls to the GetHomeData method.
+		GetHomeData []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetPin holds details about calls to the GetPin method.
+		GetPin []struct {
+			Ctx     context.Context
+			Request operations.GetPinRequest
+			Opts    []operations.Option
+		}
+		// GetServerResources holds details about calls to the GetServerResources method.
+		GetServerResources []struct {
+			Ctx          context.Context
+			ClientID     string
+			IncludeHTTPS *operations.IncludeHTTPS
+			IncludeRelay *operations.IncludeRelay
+			IncludeIPv6  *operations.IncludeIPv6
+			Opts         []operations.Option
+		}
+		// GetTokenByPinID holds details about calls to the GetTokenByPinID method.
+		GetTokenByPinID []struct {
+			Ctx     context.Context
+			Request operations.GetTokenByPinIDRequest
+			Opts    []operations.Option
+		}
+		// GetUserFriends holds details about calls to the GetUserFriends method.
+		GetUserFriends []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetWatchHistory holds details about calls to the GetWatchHistory method.
+		GetWatchHistory []struct {
+			Ctx    context.Context
+			Filter plexgo.WatchHistoryFilter
+			Opts   []operations.Option
+		}
+	}
+	lockGetCompanionsData  sync.RWMutex
+	lockGetGeoData         sync.RWMutex
+	lockGetHomeData        sync.RWMutex
+	lockGetPin             sync.RWMutex
+	lockGetServerResources sync.RWMutex
+	lockGetTokenByPinID    sync.RWMutex
+	lockGetUserFriends     sync.RWMutex
+	lockGetWatchHistory    sync.RWMutex
+}
+
+// GetCompanionsData calls GetCompanionsDataFunc.
+func (mock *PlexTVAPIMock) GetCompanionsData(ctx context.Context, opts ...operations.Option) (*operations.GetCompanionsDataResponse, error) {
+	if mock.GetCompanionsDataFunc == nil {
+		panic("PlexTVAPIMock.GetCompanionsDataFunc: method is nil but PlexTVAPI.GetCompanionsData was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetCompanionsData.Lock()
+	mock.calls.GetCompanionsData = append(mock.calls.GetCompanionsData, callInfo)
+	mock.lockGetCompanionsData.Unlock()
+	return mock.GetCompanionsDataFunc(ctx, opts...)
+}
+
+// GetCompanionsDataCalls gets all the calls that were made to GetCompanionsData.
+// Check the length with:
+//
+//	len(mockedPlex.GetCompanionsDataCalls())
+func (mock *PlexTVAPIMock) GetCompanionsDataCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetCompanionsData.RLock()
+	calls = mock.calls.GetCompanionsData
+	mock.lockGetCompanionsData.RUnlock()
+	return calls
+}
+
+// GetGeoData calls GetGeoDataFunc.
+func (mock *PlexTVAPIMock) GetGeoData(ctx context.Context, opts ...operations.Option) (*operations.GetGeoDataResponse, error) {
+	if mock.GetGeoDataFunc == nil {
+		panic("PlexTVAPIMock.GetGeoDataFunc: method is nil but PlexTVAPI.GetGeoData was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetGeoData.Lock()
+	mock.calls.GetGeoData = append(mock.calls.GetGeoData, callInfo)
+	mock.lockGetGeoData.Unlock()
+	return mock.GetGeoDataFunc(ctx, opts...)
+}
+
+// GetGeoDataCalls gets all the calls that were made to GetGeoData.
+// Check the length with:
+//
+//	len(mockedPlex.GetGeoDataCalls())
+func (mock *PlexTVAPIMock) GetGeoDataCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetGeoData.RLock()
+	calls = mock.calls.GetGeoData
+	mock.lockGetGeoData.RUnlock()
+	return calls
+}
+
+// GetHomeData calls GetHomeDataFunc.
+func (mock *PlexTVAPIMock) GetHomeData(ctx context.Context, opts ...operations.Option) (*operations.GetHomeDataResponse, error) {
+	if mock.GetHomeDataFunc == nil {
+		panic("PlexTVAPIMock.GetHomeDataFunc: method is nil but PlexTVAPI.GetHomeData was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetHomeData.Lock()
+	mock.calls.GetHomeData = append(mock.calls.GetHomeData, callInfo)
+	mock.lockGetHomeData.Unlock()
+	return mock.GetHomeDataFunc(ctx, opts...)
+}
+
+// GetHomeDataCalls gets all the calls that were made to GetHomeData.
+// Check the length with:
+//
+//	len(mockedPlex.GetHomeDataCalls())
+func (mock *PlexTVAPIMock) GetHomeDataCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetHomeData.RLock()
+	calls = mock.calls.GetHomeData
+	mock.lockGetHomeData.RUnlock()
+	return calls
+}
+
+// GetPin calls GetPinFunc.
+func (mock *PlexTVAPIMock) GetPin(ctx context.Context, request operations.GetPinRequest, opts ...operations.Option) (*operations.GetPinResponse, error) {
+	if mock.GetPinFunc == nil {
+		panic("PlexTVAPIMock.GetPinFunc: method is nil but PlexTVAPI.GetPin was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetPinRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetPin.Lock()
+	mock.calls.GetPin = append(mock.calls.GetPin, callInfo)
+	mock.lockGetPin.Unlock()
+	return mock.GetPinFunc(ctx, request, opts...)
+}
+
+// GetPinCalls gets all the calls that were made to GetPin.
+// Check the length with:
+//
+//	len(mockedPlex.GetPinCalls())
+func (mock *PlexTVAPIMock) GetPinCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetPinRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetPinRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetPin.RLock()
+	calls = mock.calls.GetPin
+	mock.lockGetPin.RUnlock()
+	return calls
+}
+
+// GetServerResources calls GetServerResourcesFunc.
+func (mock *PlexTVAPIMock) GetServerResources(ctx context.Context, clientID string, includeHTTPS *operations.IncludeHTTPS, includeRelay *operations.IncludeRelay, includeIPv6 *operations.IncludeIPv6, opts ...operations.Option) (*operations.GetServerResourcesResponse, error) {
+	if mock.GetServerResourcesFunc == nil {
+		panic("PlexTVAPIMock.GetServerResourcesFunc: method is nil but PlexTVAPI.GetServerResources was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ClientID     string
+		IncludeHTTPS *operations.IncludeHTTPS
+		IncludeRelay *operations.IncludeRelay
+		IncludeIPv6  *operations.IncludeIPv6
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		ClientID:     clientID,
+		IncludeHTTPS: includeHTTPS,
+		IncludeRelay: includeRelay,
+		IncludeIPv6:  includeIPv6,
+		Opts:         opts,
+	}
+	mock.lockGetServerResources.Lock()
+	mock.calls.GetServerResources = append(mock.calls.GetServerResources, callInfo)
+	mock.lockGetServerResources.Unlock()
+	return mock.GetServerResourcesFunc(ctx, clientID, includeHTTPS, includeRelay, includeIPv6, opts...)
+}
+
+// GetServerResourcesCalls gets all the calls that were made to GetServerResources.
+// Check the length with:
+//
+//	len(mockedPlex.GetServerResourcesCalls())
+func (mock *PlexTVAPIMock) GetServerResourcesCalls() []struct {
+	Ctx          context.Context
+	ClientID     string
+	IncludeHTTPS *operations.IncludeHTTPS
+	IncludeRelay *operations.IncludeRelay
+	IncludeIPv6  *operations.IncludeIPv6
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ClientID     string
+		IncludeHTTPS *operations.IncludeHTTPS
+		IncludeRelay *operations.IncludeRelay
+		IncludeIPv6  *operations.IncludeIPv6
+		Opts         []operations.Option
+	}
+	mock.lockGetServerResources.RLock()
+	calls = mock.calls.GetServerResources
+	mock.lockGetServerResources.RUnlock()
+	return calls
+}
+
+// GetTokenByPinID calls GetTokenByPinIDFunc.
+func (mock *PlexTVAPIMock) GetTokenByPinID(ctx context.Context, request operations.GetTokenByPinIDRequest, opts ...operations.Option) (*operations.GetTokenByPinIDResponse, error) {
+	if mock.GetTokenByPinIDFunc == nil {
+		panic("PlexTVAPIMock.GetTokenByPinIDFunc: method is nil but PlexTVAPI.GetTokenByPinID was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetTokenByPinIDRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetTokenByPinID.Lock()
+	mock.calls.GetTokenByPinID = append(mock.calls.GetTokenByPinID, callInfo)
+	mock.lockGetTokenByPinID.Unlock()
+	return mock.GetTokenByPinIDFunc(ctx, request, opts...)
+}
+
+// GetTokenByPinIDCalls gets all the calls that were made to GetTokenByPinID.
+// Check the length with:
+//
+//	len(mockedPlex.GetTokenByPinIDCalls())
+func (mock *PlexTVAPIMock) GetTokenByPinIDCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetTokenByPinIDRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetTokenByPinIDRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetTokenByPinID.RLock()
+	calls = mock.calls.GetTokenByPinID
+	mock.lockGetTokenByPinID.RUnlock()
+	return calls
+}
+
+// GetUserFriends calls GetUserFriendsFunc.
+func (mock *PlexTVAPIMock) GetUserFriends(ctx context.Context, opts ...operations.Option) (*operations.GetUserFriendsResponse, error) {
+	if mock.GetUserFriendsFunc == nil {
+		panic("PlexTVAPIMock.GetUserFriendsFunc: method is nil but PlexTVAPI.GetUserFriends was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetUserFriends.Lock()
+	mock.calls.GetUserFriends = append(mock.calls.GetUserFriends, callInfo)
+	mock.lockGetUserFriends.Unlock()
+	return mock.GetUserFriendsFunc(ctx, opts...)
+}
+
+// GetUserFriendsCalls gets all the calls that were made to GetUserFriends.
+// Check the length with:
+//
+//	len(mockedPlex.GetUserFriendsCalls())
+func (mock *PlexTVAPIMock) GetUserFriendsCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetUserFriends.RLock()
+	calls = mock.calls.GetUserFriends
+	mock.lockGetUserFriends.RUnlock()
+	return calls
+}
+
+// GetWatchHistory calls GetWatchHistoryFunc.
+func (mock *PlexTVAPIMock) GetWatchHistory(ctx context.Context, filter plexgo.WatchHistoryFilter, opts ...operations.Option) ([]plexgo.WatchHistoryEntry, error) {
+	if mock.GetWatchHistoryFunc == nil {
+		panic("PlexTVAPIMock.GetWatchHistoryFunc: method is nil but PlexTVAPI.GetWatchHistory was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter plexgo.WatchHistoryFilter
+		Opts   []operations.Option
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+		Opts:   opts,
+	}
+	mock.lockGetWatchHistory.Lock()
+	mock.calls.GetWatchHistory = append(mock.calls.GetWatchHistory, callInfo)
+	mock.lockGetWatchHistory.Unlock()
+	return mock.GetWatchHistoryFunc(ctx, filter, opts...)
+}
+
+// GetWatchHistoryCalls gets all the calls that were made to GetWatchHistory.
+// Check the length with:
+//
+//	len(mockedPlex.GetWatchHistoryCalls())
+func (mock *PlexTVAPIMock) GetWatchHistoryCalls() []struct {
+	Ctx    context.Context
+	Filter plexgo.WatchHistoryFilter
+	Opts   []operations.Option
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter plexgo.WatchHistoryFilter
+		Opts   []operations.Option
+	}
+	mock.lockGetWatchHistory.RLock()
+	calls = mock.calls.GetWatchHistory
+	mock.lockGetWatchHistory.RUnlock()
+	return calls
+}
+
+// Ensure, that HubsAPIMock does implement plexgo.HubsAPI.
+var _ plexgo.HubsAPI = &HubsAPIMock{}
+
+// HubsAPIMock is a mock implementation of plexgo.HubsAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type HubsAPIMock struct {
+	// ExportLayoutFunc mocks the ExportLayout method.
+	ExportLayoutFunc func(ctx context.Context, w io.Writer, opts ...operations.Option) (*plexgo.HubLayout, error)
+
+	// GetGlobalHubFunc mocks the GetGlobalHub method.
+	GetGlobalHubFunc func(ctx context.Context, identifier plexgo.HubIdentifier, opts ...operations.Option) (*operations.Hub, error)
+
+	// GetGlobalHubsFunc mocks the GetGlobalHubs method.
+	GetGlobalHubsFunc func(ctx context.Context, count *float64, onlyTransient *operations.OnlyTransient, opts ...operations.Option) (*operations.GetGlobalHubsResponse, error)
+
+	// GetLibraryHubFunc mocks the GetLibraryHub method.
+	GetLibraryHubFunc func(ctx context.Context, sectionID int, identifier plexgo.HubIdentifier, opts ...operations.Option) (*operations.GetLibraryHubsHub, error)
+
+	// GetLibraryHubsFunc mocks the GetLibraryHubs method.
+	GetLibraryHubsFunc func(ctx context.Context, sectionID float64, count *float64, onlyTransient *operations.QueryParamOnlyTransient, opts ...operations.Option) (*operations.GetLibraryHubsResponse, error)
+
+	// GetManagedHubFunc mocks the GetManagedHub method.
+	GetManagedHubFunc func(ctx context.Context, sectionID int, identifier plexgo.HubIdentifier, opts ...operations.Option) (*plexgo.ManagedHub, error)
+
+	// GetManagedHubsFunc mocks the GetManagedHubs method.
+	GetManagedHubsFunc func(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.ManagedHub, error)
+
+	// GetRecentlyAddedFunc mocks the GetRecentlyAdded method.
+	GetRecentlyAddedFunc func(ctx context.Context, request operations.GetRecentlyAddedRequest, opts ...operations.Option) (*operations.GetRecentlyAddedResponse, error)
+
+	// MoveHubFunc mocks the MoveHub method.
+	MoveHubFunc func(ctx context.Context, sectionID int, identifier plexgo.HubIdentifier, after plexgo.HubIdentifier, opts ...operations.Option) error
+
+	// ReorderHubsFunc mocks the ReorderHubs method.
+	ReorderHubsFunc func(ctx context.Context, sectionID int, identifiers []plexgo.HubIdentifier, opts ...operations.Option) error
+
+	// RestoreLayoutFunc mocks the RestoreLayout method.
+	RestoreLayoutFunc func(ctx context.Context, r io.Reader, opts ...operations.Option) error
+
+	// UpdateHubVisibilityFunc mocks the UpdateHubVisibility method.
+	UpdateHubVisibilityFunc func(ctx context.Context, sectionID int, identifier plexgo.HubIdentifier, visibility plexgo.CollectionVisibility, opts ...operations.Option) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ExportLayout holds details about calls to the ExportLayout method.
+		ExportLayout []struct {
+			Ctx  context.Context
+			W    io.Writer
+			Opts []operations.Option
+		}
+		// GetGlobalHub holds details about calls to the GetGlobalHub method.
+		GetGlobalHub []struct {
+			Ctx        context.Context
+			Identifier plexgo.HubIdentifier
+			Opts       []operations.Option
+		}
+		// GetGlobalHubs holds details about calls to the GetGlobalHubs method.
+		GetGlobalHubs []struct {
+			Ctx           context.Context
+			Count         *float64
+			OnlyTransient *operations.OnlyTransient
+			Opts          []operations.Option
+		}
+		// GetLibraryHub holds details about calls to the GetLibraryHub method.
+		GetLibraryHub []struct {
+			Ctx        context.Context
+			SectionID  int
+			Identifier plexgo.HubIdentifier
+			Opts       []operations.Option
+		}
+		// GetLibraryHubs holds details about calls to the GetLibraryHubs method.
+		GetLibraryHubs []struct {
+			Ctx           context.Context
+			SectionID     float64
+			Count         *float64
+			OnlyTransient *operations.QueryParamOnlyTransient
+			Opts          []operations.Option
+		}
+		// GetManagedHub holds details about calls to the GetManagedHub method.
+		GetManagedHub []struct {
+			Ctx        context.Context
+			SectionID  int
+			Identifier plexgo.HubIdentifier
+			Opts       []operations.Option
+		}
+		// GetManagedHubs holds details about calls to the GetManagedHubs method.
+		GetManagedHubs []struct {
+			Ctx       context.Context
+			SectionID int
+			Opts      []operations.Option
+		}
+		// GetRecentlyAdded holds details about calls to the GetRecentlyAdded method.
+		GetRecentlyAdded []struct {
+			Ctx     context.Context
+			Request operations.GetRecentlyAddedRequest
+			Opts    []operations.Option
+		}
+		// MoveHub holds details about calls to the MoveHub method.
+		MoveHub []struct {
+			Ctx        context.Context
+			SectionID  int
+			Identifier plexgo.HubIdentifier
+			After      plexgo.HubIdentifier
+			Opts       []operations.Option
+		}
+		// ReorderHubs holds details about calls to the ReorderHubs method.
+		ReorderHubs []struct {
+			Ctx         context.Context
+			SectionID   int
+			Identifiers []plexgo.HubIdentifier
+			Opts        []operations.Option
+		}
+		// RestoreLayout holds details about calls to the RestoreLayout method.
+		RestoreLayout []struct {
+			Ctx  context.Context
+			R    io.Reader
+			Opts []operations.Option
+		}
+		// UpdateHubVisibility holds details about calls to the UpdateHubVisibility method.
+		UpdateHubVisibility []struct {
+			Ctx        context.Context
+			SectionID  int
+			Identifier plexgo.HubIdentifier
+			Visibility plexgo.CollectionVisibility
+			Opts       []operations.Option
+		}
+	}
+	lockExportLayout        sync.RWMutex
+	lockGetGlobalHub        sync.RWMutex
+	lockGetGlobalHubs       sync.RWMutex
+	lockGetLibraryHub       sync.RWMutex
+	lockGetLibraryHubs      sync.RWMutex
+	lockGetManagedHub       sync.RWMutex
+	lockGetManagedHubs      sync.RWMutex
+	lockGetRecentlyAdded    sync.RWMutex
+	lockMoveHub             sync.RWMutex
+	lockReorderHubs         sync.RWMutex
+	lockRestoreLayout       sync.RWMutex
+	lockUpdateHubVisibility sync.RWMutex
+}
+
+// ExportLayout calls ExportLayoutFunc.
+func (mock *HubsAPIMock) ExportLayout(ctx context.Context, w io.Writer, opts ...operations.Option) (*plexgo.HubLayout, error) {
+	if mock.ExportLayoutFunc == nil {
+		panic("HubsAPIMock.ExportLayoutFunc: method is nil but HubsAPI.ExportLayout was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		W    io.Writer
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		W:    w,
+		Opts: opts,
+	}
+	mock.lockExportLayout.Lock()
+	mock.calls.ExportLayout = append(mock.calls.ExportLayout, callInfo)
+	mock.lockExportLayout.Unlock()
+	return mock.ExportLayoutFunc(ctx, w, opts...)
+}
+
+// ExportLayoutCalls gets all the calls that were made to ExportLayout.
+// Check the length with:
+//
+//	len(mockedHubs.ExportLayoutCalls())
+func (mock *HubsAPIMock) ExportLayoutCalls() []struct {
+	Ctx  context.Context
+	W    io.Writer
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		W    io.Writer
+		Opts []operations.Option
+	}
+	mock.lockExportLayout.RLock()
+	calls = mock.calls.ExportLayout
+	mock.lockExportLayout.RUnlock()
+	return calls
+}
+
+// GetGlobalHub calls GetGlobalHubFunc.
+func (mock *HubsAPIMock) GetGlobalHub(ctx context.Context, identifier plexgo.HubIdentifier, opts ...operations.Option) (*operations.Hub, error) {
+	if mock.GetGlobalHubFunc == nil {
+		panic("HubsAPIMock.GetGlobalHubFunc: method is nil but HubsAPI.GetGlobalHub was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Identifier plexgo.HubIdentifier
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		Identifier: identifier,
+		Opts:       opts,
+	}
+	mock.lockGetGlobalHub.Lock()
+	mock.calls.GetGlobalHub = append(mock.calls.GetGlobalHub, callInfo)
+	mock.lockGetGlobalHub.Unlock()
+	return mock.GetGlobalHubFunc(ctx, identifier, opts...)
+}
+
+// GetGlobalHubCalls gets all the calls that were made to GetGlobalHub.
+// Check the length with:
+//
+//	len(mockedHubs.GetGlobalHubCalls())
+func (mock *HubsAPIMock) GetGlobalHubCalls() []struct {
+	Ctx        context.Context
+	Identifier plexgo.HubIdentifier
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Identifier plexgo.HubIdentifier
+		Opts       []operations.Option
+	}
+	mock.lockGetGlobalHub.RLock()
+	calls = mock.calls.GetGlobalHub
+	mock.lockGetGlobalHub.RUnlock()
+	return calls
+}
+
+// GetGlobalHubs calls GetGlobalHubsFunc.
+func (mock *HubsAPIMock) GetGlobalHubs(ctx context.Context, count *float64, onlyTransient *operations.OnlyTransient, opts ...operations.Option) (*operations.GetGlobalHubsResponse, error) {
+	if mock.GetGlobalHubsFunc == nil {
+		panic("HubsAPIMock.GetGlobalHubsFunc: method is nil but HubsAPI.GetGlobalHubs was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Count         *float64
+		OnlyTransient *operations.OnlyTransient
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		Count:         count,
+		OnlyTransient: onlyTransient,
+		Opts:          opts,
+	}
+	mock.lockGetGlobalHubs.Lock()
+	mock.calls.GetGlobalHubs = append(mock.calls.GetGlobalHubs, callInfo)
+	mock.lockGetGlobalHubs.Unlock()
+	return mock.GetGlobalHubsFunc(ctx, count, onlyTransient, opts...)
+}
+
+// GetGlobalHubsCalls gets all the calls that were made to GetGlobalHubs.
+// Check the length with:
+//
+//	len(mockedHubs.GetGlobalHubsCalls())
+func (mock *HubsAPIMock) GetGlobalHubsCalls() []struct {
+	Ctx           context.Context
+	Count         *float64
+	OnlyTransient *operations.OnlyTransient
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Count         *float64
+		OnlyTransient *operations.OnlyTransient
+		Opts          []operations.Option
+	}
+	mock.lockGetGlobalHubs.RLock()
+	calls = mock.calls.GetGlobalHubs
+	mock.lockGetGlobalHubs.RUnlock()
+	return calls
+}
+
+// GetLibraryHub calls GetLibraryHubFunc.
+func (mock *HubsAPIMock) GetLibraryHub(ctx context.Context, sectionID int, identifier plexgo.HubIdentifier, opts ...operations.Option) (*operations.GetLibraryHubsHub, error) {
+	if mock.GetLibraryHubFunc == nil {
+		panic("HubsAPIMock.GetLibraryHubFunc: method is nil but HubsAPI.GetLibraryHub was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionID  int
+		Identifier plexgo.HubIdentifier
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionID:  sectionID,
+		Identifier: identifier,
+		Opts:       opts,
+	}
+	mock.lockGetLibraryHub.Lock()
+	mock.calls.GetLibraryHub = append(mock.calls.GetLibraryHub, callInfo)
+	mock.lockGetLibraryHub.Unlock()
+	return mock.GetLibraryHubFunc(ctx, sectionID, identifier, opts...)
+}
+
+// GetLibraryHubCalls gets all the calls that were made to GetLibraryHub.
+// Check the length with:
+//
+//	len(mockedHubs.GetLibraryHubCalls())
+func (mock *HubsAPIMock) GetLibraryHubCalls() []struct {
+	Ctx        context.Context
+	SectionID  int
+	Identifier plexgo.HubIdentifier
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionID  int
+		Identifier plexgo.HubIdentifier
+		Opts       []operations.Option
+	}
+	mock.lockGetLibraryHub.RLock()
+	calls = mock.calls.GetLibraryHub
+	mock.lockGetLibraryHub.RUnlock()
+	return calls
+}
+
+// GetLibraryHubs calls GetLibraryHubsFunc.
+func (mock *HubsAPIMock) GetLibraryHubs(ctx context.Context, sectionID float64, count *float64, onlyTransient *operations.QueryParamOnlyTransient, opts ...operations.Option) (*operations.GetLibraryHubsResponse, error) {
+	if mock.GetLibraryHubsFunc == nil {
+		panic("HubsAPIMock.GetLibraryHubsFunc: method is nil but HubsAPI.GetLibraryHubs was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SectionID     float64
+		Count         *float64
+		OnlyTransient *operations.QueryParamOnlyTransient
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		SectionID:     sectionID,
+		Count:         count,
+		OnlyTransient: onlyTransient,
+		Opts:          opts,
+	}
+	mock.lockGetLibraryHubs.Lock()
+	mock.calls.GetLibraryHubs = append(mock.calls.GetLibraryHubs, callInfo)
+	mock.lockGetLibraryHubs.Unlock()
+	return mock.GetLibraryHubsFunc(ctx, sectionID, count, onlyTransient, opts...)
+}
+
+// GetLibraryHubsCalls gets all the calls that were made to GetLibraryHubs.
+// Check the length with:
+//
+//	len(mockedHubs.GetLibraryHubsCalls())
+func (mock *HubsAPIMock) GetLibraryHubsCalls() []struct {
+	Ctx           context.Context
+	SectionID     float64
+	Count         *float64
+	OnlyTransient *operations.QueryParamOnlyTransient
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SectionID     float64
+		Count         *float64
+		OnlyTransient *operations.QueryParamOnlyTransient
+		Opts          []operations.Option
+	}
+	mock.lockGetLibraryHubs.RLock()
+	calls = mock.calls.GetLibraryHubs
+	mock.lockGetLibraryHubs.RUnlock()
+	return calls
+}
+
+// GetManagedHub calls GetManagedHubFunc.
+func (mock *HubsAPIMock) GetManagedHub(ctx context.Context, sectionID int, identifier plexgo.HubIdentifier, opts ...operations.Option) (*plexgo.ManagedHub, error) {
+	if mock.GetManagedHubFunc == nil {
+		panic("HubsAPIMock.GetManagedHubFunc: method is nil but HubsAPI.GetManagedHub was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionID  int
+		Identifier plexgo.HubIdentifier
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionID:  sectionID,
+		Identifier: identifier,
+		Opts:       opts,
+	}
+	mock.lockGetManagedHub.Lock()
+	mock.calls.GetManagedHub = append(mock.calls.GetManagedHub, callInfo)
+	mock.lockGetManagedHub.Unlock()
+	return mock.GetManagedHubFunc(ctx, sectionID, identifier, opts...)
+}
+
+// GetManagedHubCalls gets all the calls that were made to GetManagedHub.
+// Check the length with:
+//
+//	len(mockedHubs.GetManagedHubCalls())
+func (mock *HubsAPIMock) GetManagedHubCalls() []struct {
+	Ctx        context.Context
+	SectionID  int
+	Identifier plexgo.HubIdentifier
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionID  int
+		Identifier plexgo.HubIdentifier
+		Opts       []operations.Option
+	}
+	mock.lockGetManagedHub.RLock()
+	calls = mock.calls.GetManagedHub
+	mock.lockGetManagedHub.RUnlock()
+	return calls
+}
+
+// GetManagedHubs calls GetManagedHubsFunc.
+func (mock *HubsAPIMock) GetManagedHubs(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.ManagedHub, error) {
+	if mock.GetManagedHubsFunc == nil {
+		panic("HubsAPIMock.GetManagedHubsFunc: method is nil but HubsAPI.GetManagedHubs was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockGetManagedHubs.Lock()
+	mock.calls.GetManagedHubs = append(mock.calls.GetManagedHubs, callInfo)
+	mock.lockGetManagedHubs.Unlock()
+	return mock.GetManagedHubsFunc(ctx, sectionID, opts...)
+}
+
+// GetManagedHubsCalls gets all the calls that were made to GetManagedHubs.
+// Check the length with:
+//
+//	len(mockedHubs.GetManagedHubsCalls())
+func (mock *HubsAPIMock) GetManagedHubsCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockGetManagedHubs.RLock()
+	calls = mock.calls.GetManagedHubs
+	mock.lockGetManagedHubs.RUnlock()
+	return calls
+}
+
+// GetRecentlyAdded calls GetRecentlyAddedFunc.
+func (mock *HubsAPIMock) GetRecentlyAdded(ctx context.Context, request operations.GetRecentlyAddedRequest, opts ...operations.Option) (*operations.GetRecentlyAddedResponse, error) {
+	if mock.GetRecentlyAddedFunc == nil {
+		panic("HubsAPIMock.GetRecentlyAddedFunc: method is nil but HubsAPI.GetRecentlyAdded was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetRecentlyAddedRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetRecentlyAdded.Lock()
+	mock.calls.GetRecentlyAdded = append(mock.calls.GetRecentlyAdded, callInfo)
+	mock.lockGetRecentlyAdded.Unlock()
+	return mock.GetRecentlyAddedFunc(ctx, request, opts...)
+}
+
+// GetRecentlyAddedCalls gets all the calls that were made to GetRecentlyAdded.
+// Check the length with:
+//
+//	len(mockedHubs.GetRecentlyAddedCalls())
+func (mock *HubsAPIMock) GetRecentlyAddedCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetRecentlyAddedRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetRecentlyAddedRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetRecentlyAdded.RLock()
+	calls = mock.calls.GetRecentlyAdded
+	mock.lockGetRecentlyAdded.RUnlock()
+	return calls
+}
+
+// MoveHub calls MoveHubFunc.
+func (mock *HubsAPIMock) MoveHub(ctx context.Context, sectionID int, identifier plexgo.HubIdentifier, after plexgo.HubIdentifier, opts ...operations.Option) error {
+	if mock.MoveHubFunc == nil {
+		panic("HubsAPIMock.MoveHubFunc: method is nil but HubsAPI.MoveHub was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionID  int
+		Identifier plexgo.HubIdentifier
+		After      plexgo.HubIdentifier
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionID:  sectionID,
+		Identifier: identifier,
+		After:      after,
+		Opts:       opts,
+	}
+	mock.lockMoveHub.Lock()
+	mock.calls.MoveHub = append(mock.calls.MoveHub, callInfo)
+	mock.lockMoveHub.Unlock()
+	return mock.MoveHubFunc(ctx, sectionID, identifier, after, opts...)
+}
+
+// MoveHubCalls gets all the calls that were made to MoveHub.
+// Check the length with:
+//
+//	len(mockedHubs.MoveHubCalls())
+func (mock *HubsAPIMock) MoveHubCalls() []struct {
+	Ctx        context.Context
+	SectionID  int
+	Identifier plexgo.HubIdentifier
+	After      plexgo.HubIdentifier
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionID  int
+		Identifier plexgo.HubIdentifier
+		After      plexgo.HubIdentifier
+		Opts       []operations.Option
+	}
+	mock.lockMoveHub.RLock()
+	calls = mock.calls.MoveHub
+	mock.lockMoveHub.RUnlock()
+	return calls
+}
+
+// ReorderHubs calls ReorderHubsFunc.
+func (mock *HubsAPIMock) ReorderHubs(ctx context.Context, sectionID int, identifiers []plexgo.HubIdentifier, opts ...operations.Option) error {
+	if mock.ReorderHubsFunc == nil {
+		panic("HubsAPIMock.ReorderHubsFunc: method is nil but HubsAPI.ReorderHubs was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		Identifiers []plexgo.HubIdentifier
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		Identifiers: identifiers,
+		Opts:        opts,
+	}
+	mock.lockReorderHubs.Lock()
+	mock.calls.ReorderHubs = append(mock.calls.ReorderHubs, callInfo)
+	mock.lockReorderHubs.Unlock()
+	return mock.ReorderHubsFunc(ctx, sectionID, identifiers, opts...)
+}
+
+// ReorderHubsCalls gets all the calls that were made to ReorderHubs.
+// Check the length with:
+//
+//	len(mockedHubs.ReorderHubsCalls())
+func (mock *HubsAPIMock) ReorderHubsCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	Identifiers []plexgo.HubIdentifier
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		Identifiers []plexgo.HubIdentifier
+		Opts        []operations.Option
+	}
+	mock.lockReorderHubs.RLock()
+	calls = mock.calls.ReorderHubs
+	mock.lockReorderHubs.RUnlock()
+	return calls
+}
+
+// RestoreLayout calls RestoreLayoutFunc.
+func (mock *HubsAPIMock) RestoreLayout(ctx context.Context, r io.Reader, opts ...operations.Option) error {
+	if mock.RestoreLayoutFunc == nil {
+		panic("HubsAPIMock.RestoreLayoutFunc: method is nil but HubsAPI.RestoreLayout was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		R    io.Reader
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		R:    r,
+		Opts: opts,
+	}
+	mock.lockRestoreLayout.Lock()
+	mock.calls.RestoreLayout = append(mock.calls.RestoreLayout, callInfo)
+	mock.lockRestoreLayout.Unlock()
+	return mock.RestoreLayoutFunc(ctx, r, opts...)
+}
+
+// RestoreLayoutCalls gets all the calls that were made to RestoreLayout.
+// Check the length with:
+//
+//	len(mockedHubs.RestoreLayoutCalls())
+func (mock *HubsAPIMock) RestoreLayoutCalls() []struct {
+	Ctx  context.Context
+	R    io.Reader
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		R    io.Reader
+		Opts []operations.Option
+	}
+	mock.lockRestoreLayout.RLock()
+	calls = mock.calls.RestoreLayout
+	mock.lockRestoreLayout.RUnlock()
+	return calls
+}
+
+// UpdateHubVisibility calls UpdateHubVisibilityFunc.
+func (mock *HubsAPIMock) UpdateHubVisibility(ctx context.Context, sectionID int, identifier plexgo.HubIdentifier, visibility plexgo.CollectionVisibility, opts ...operations.Option) error {
+	if mock.UpdateHubVisibilityFunc == nil {
+		panic("HubsAPIMock.UpdateHubVisibilityFunc: method is nil but HubsAPI.UpdateHubVisibility was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionID  int
+		Identifier plexgo.HubIdentifier
+		Visibility plexgo.CollectionVisibility
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionID:  sectionID,
+		Identifier: identifier,
+		Visibility: visibility,
+		Opts:       opts,
+	}
+	mock.lockUpdateHubVisibility.Lock()
+	mock.calls.UpdateHubVisibility = append(mock.calls.UpdateHubVisibility, callInfo)
+	mock.lockUpdateHubVisibility.Unlock()
+	return mock.UpdateHubVisibilityFunc(ctx, sectionID, identifier, visibility, opts...)
+}
+
+// UpdateHubVisibilityCalls gets all the calls that were made to UpdateHubVisibility.
+// Check the length with:
+//
+//	len(mockedHubs.UpdateHubVisibilityCalls())
+func (mock *HubsAPIMock) UpdateHubVisibilityCalls() []struct {
+	Ctx        context.Context
+	SectionID  int
+	Identifier plexgo.HubIdentifier
+	Visibility plexgo.CollectionVisibility
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionID  int
+		Identifier plexgo.HubIdentifier
+		Visibility plexgo.CollectionVisibility
+		Opts       []operations.Option
+	}
+	mock.lockUpdateHubVisibility.RLock()
+	calls = mock.calls.UpdateHubVisibility
+	mock.lockUpdateHubVisibility.RUnlock()
+	return calls
+}
+
+// Ensure, that SearchAPIMock does implement plexgo.SearchAPI.
+var _ plexgo.SearchAPI = &SearchAPIMock{}
+
+// SearchAPIMock is a mock implementation of plexgo.SearchAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type SearchAPIMock struct {
+	// AutocompleteFunc mocks the Autocomplete method.
+	AutocompleteFunc func(ctx context.Context, prefix string, limit int, opts ...operations.Option) ([]plexgo.AutocompleteResult, error)
+
+	// GetSearchResultsFunc mocks the GetSearchResults method.
+	GetSearchResultsFunc func(ctx context.Context, query string, opts ...operations.Option) (*operations.GetSearchResultsResponse, error)
+
+	// PerformSearchFunc mocks the PerformSearch method.
+	PerformSearchFunc func(ctx context.Context, query string, sectionID *float64, limit *float64, opts ...operations.Option) (*operations.PerformSearchResponse, error)
+
+	// PerformVoiceSearchFunc mocks the PerformVoiceSearch method.
+	PerformVoiceSearchFunc func(ctx context.Context, query string, sectionID *float64, limit *float64, opts ...operations.Option) (*operations.PerformVoiceSearchResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Autocomplete holds details about calls to the Autocomplete method.
+		Autocomplete []struct {
+			Ctx    context.Context
+			Prefix string
+			Limit  int
+			Opts   []operations.Option
+		}
+		// GetSearchResults holds details about calls to the GetSearchResults method.
+		GetSearchResults []struct {
+			Ctx   context.Context
+			Query string
+			Opts  []operations.Option
+		}
+		// PerformSearch holds details about calls to the PerformSearch method.
+		PerformSearch []struct {
+			Ctx       context.Context
+			Query     string
+			SectionID *float64
+			Limit     *float64
+			Opts      []operations.Option
+		}
+		// PerformVoiceSearch holds details about calls to the PerformVoiceSearch method.
+		PerformVoiceSearch []struct {
+			Ctx       context.Context
+			Query     string
+			SectionID *float64
+			Limit     *float64
+			Opts      []operations.Option
+		}
+	}
+	lockAutocomplete       sync.RWMutex
+	lockGetSearchResults   sync.RWMutex
+	lockPerformSearch      sync.RWMutex
+	lockPerformVoiceSearch sync.RWMutex
+}
+
+// Autocomplete calls AutocompleteFunc.
+func (mock *SearchAPIMock) Autocomplete(ctx context.Context, prefix string, limit int, opts ...operations.Option) ([]plexgo.AutocompleteResult, error) {
+	if mock.AutocompleteFunc == nil {
+		panic("SearchAPIMock.AutocompleteFunc: method is nil but SearchAPI.Autocomplete was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Prefix string
+		Limit  int
+		Opts   []operations.Option
+	}{
+		Ctx:    ctx,
+		Prefix: prefix,
+		Limit:  limit,
+		Opts:   opts,
+	}
+	mock.lockAutocomplete.Lock()
+	mock.calls.Autocomplete = append(mock.calls.Autocomplete, callInfo)
+	mock.lockAutocomplete.Unlock()
+	return mock.AutocompleteFunc(ctx, prefix, limit, opts...)
+}
+
+// AutocompleteCalls gets all the calls that were made to Autocomplete.
+// Check the length with:
+//
+//	len(mockedSearch.AutocompleteCalls())
+func (mock *SearchAPIMock) AutocompleteCalls() []struct {
+	Ctx    context.Context
+	Prefix string
+	Limit  int
+	Opts   []operations.Option
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Prefix string
+		Limit  int
+		Opts   []operations.Option
+	}
+	mock.lockAutocomplete.RLock()
+	calls = mock.calls.Autocomplete
+	mock.lockAutocomplete.RUnlock()
+	return calls
+}
+
+// GetSearchResults calls GetSearchResultsFunc.
+func (mock *SearchAPIMock) GetSearchResults(ctx context.Context, query string, opts ...operations.Option) (*operations.GetSearchResultsResponse, error) {
+	if mock.GetSearchResultsFunc == nil {
+		panic("SearchAPIMock.GetSearchResultsFunc: method is nil but SearchAPI.GetSearchResults was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Query string
+		Opts  []operations.Option
+	}{
+		Ctx:   ctx,
+		Query: query,
+		Opts:  opts,
+	}
+	mock.lockGetSearchResults.Lock()
+	mock.calls.GetSearchResults = append(mock.calls.GetSearchResults, callInfo)
+	mock.lockGetSearchResults.Unlock()
+	return mock.GetSearchResultsFunc(ctx, query, opts...)
+}
+
+// GetSearchResultsCalls gets all the calls that were made to GetSearchResults.
+// Check the length with:
+//
+//	len(mockedSearch.GetSearchResultsCalls())
+func (mock *SearchAPIMock) GetSearchResultsCalls() []struct {
+	Ctx   context.Context
+	Query string
+	Opts  []operations.Option
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Query string
+		Opts  []operations.Option
+	}
+	mock.lockGetSearchResults.RLock()
+	calls = mock.calls.GetSearchResults
+	mock.lockGetSearchResults.RUnlock()
+	return calls
+}
+
+// PerformSearch calls PerformSearchFunc.
+func (mock *SearchAPIMock) PerformSearch(ctx context.Context, query string, sectionID *float64, limit *float64, opts ...operations.Option) (*operations.PerformSearchResponse, error) {
+	if mock.PerformSearchFunc == nil {
+		panic("SearchAPIMock.PerformSearchFunc: method is nil but SearchAPI.PerformSearch was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Query     string
+		SectionID *float64
+		Limit     *float64
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		Query:     query,
+		SectionID: sectionID,
+		Limit:     limit,
+		Opts:      opts,
+	}
+	mock.lockPerformSearch.Lock()
+	mock.calls.PerformSearch = append(mock.calls.PerformSearch, callInfo)
+	mock.lockPerformSearch.Unlock()
+	return mock.PerformSearchFunc(ctx, query, sectionID, limit, opts...)
+}
+
+// PerformSearchCalls gets all the calls that were made to PerformSearch.
+// Check the length with:
+//
+//	len(mockedSearch.PerformSearchCalls())
+func (mock *SearchAPIMock) PerformSearchCalls() []struct {
+	Ctx       context.Context
+	Query     string
+	SectionID *float64
+	Limit     *float64
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Query     string
+		SectionID *float64
+		Limit     *float64
+		Opts      []operations.Option
+	}
+	mock.lockPerformSearch.RLock()
+	calls = mock.calls.PerformSearch
+	mock.lockPerformSearch.RUnlock()
+	return calls
+}
+
+// PerformVoiceSearch calls PerformVoiceSearchFunc.
+func (mock *SearchAPIMock) PerformVoiceSearch(ctx context.Context, query string, sectionID *float64, limit *float64, opts ...operations.Option) (*operations.PerformVoiceSearchResponse, error) {
+	if mock.PerformVoiceSearchFunc == nil {
+		panic("SearchAPIMock.PerformVoiceSearchFunc: method is nil but SearchAPI.PerformVoiceSearch was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Query     string
+		SectionID *float64
+		Limit     *float64
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		Query:     query,
+		SectionID: sectionID,
+		Limit:     limit,
+		Opts:      opts,
+	}
+	mock.lockPerformVoiceSearch.Lock()
+	mock.calls.PerformVoiceSearch = append(mock.calls.PerformVoiceSearch, callInfo)
+	mock.lockPerformVoiceSearch.Unlock()
+	return mock.PerformVoiceSearchFunc(ctx, query, sectionID, limit, opts...)
+}
+
+// PerformVoiceSearchCalls gets all the calls that were made to PerformVoiceSearch.
+// Check the length with:
+//
+//	len(mockedSearch.PerformVoiceSearchCalls())
+func (mock *SearchAPIMock) PerformVoiceSearchCalls() []struct {
+	Ctx       context.Context
+	Query     string
+	SectionID *float64
+	Limit     *float64
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Query     string
+		SectionID *float64
+		Limit     *float64
+		Opts      []operations.Option
+	}
+	mock.lockPerformVoiceSearch.RLock()
+	calls = mock.calls.PerformVoiceSearch
+	mock.lockPerformVoiceSearch.RUnlock()
+	return calls
+}
+
+// Ensure, that LibraryAPIMock does implement plexgo.LibraryAPI.
+var _ plexgo.LibraryAPI = &LibraryAPIMock{}
+
+// LibraryAPIMock is a mock implementation of plexgo.LibraryAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type LibraryAPIMock struct {
+	// AddLabelsActionFunc mocks the AddLabelsAction method.
+	AddLabelsActionFunc func(labels ...string) plexgo.NewItemAction
+
+	// ApplyAssetsFunc mocks the ApplyAssets method.
+	ApplyAssetsFunc func(ctx context.Context, sectionID int, assets fs.FS, assetOptions plexgo.AssetOptions, opts ...operations.Option) (*plexgo.AssetReport, error)
+
+	// BulkEditFunc mocks the BulkEdit method.
+	BulkEditFunc func(ctx context.Context, sectionID int, selector plexgo.ItemListOptions, edit plexgo.EditParams, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// DeleteLibraryFunc mocks the DeleteLibrary method.
+	DeleteLibraryFunc func(ctx context.Context, sectionKey int, opts ...operations.Option) (*operations.DeleteLibraryResponse, error)
+
+	// DiffFunc mocks the Diff method.
+	DiffFunc func(ctx context.Context, other *plexgo.PlexAPI, sectionA int, sectionB int, opts ...operations.Option) (*plexgo.LibraryDiff, error)
+
+	// EachItemFunc mocks the EachItem method.
+	EachItemFunc func(ctx context.Context, sectionID int, listOptions plexgo.ItemListOptions, fn func(item plexgo.Metadata) error, opts ...operations.Option) error
+
+	// FindByVideoAttributesFunc mocks the FindByVideoAttributes method.
+	FindByVideoAttributesFunc func(ctx context.Context, sectionID int, query plexgo.VideoAttributeQuery, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// FindExceedingRatingFunc mocks the FindExceedingRating method.
+	FindExceedingRatingFunc func(ctx context.Context, sectionID int, maxRating string, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// FindPersonFunc mocks the FindPerson method.
+	FindPersonFunc func(ctx context.Context, sectionID int, name string, role plexgo.PersonRole, opts ...operations.Option) (*plexgo.Tag, error)
+
+	// GetActorsLibraryFunc mocks the GetActorsLibrary method.
+	GetActorsLibraryFunc func(ctx context.Context, sectionKey int, type_ operations.GetActorsLibraryQueryParamType, opts ...operations.Option) (*operations.GetActorsLibraryResponse, error)
+
+	// GetAllLibrariesFunc mocks the GetAllLibraries method.
+	GetAllLibrariesFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetAllLibrariesResponse, error)
+
+	// GetAllMediaLibraryFunc mocks the GetAllMediaLibrary method.
+	GetAllMediaLibraryFunc func(ctx context.Context, request operations.GetAllMediaLibraryRequest, opts ...operations.Option) (*operations.GetAllMediaLibraryResponse, error)
+
+	// GetChildrenFunc mocks the GetChildren method.
+	GetChildrenFunc func(ctx context.Context, ratingKey int, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// GetContentRatingBreakdownFunc mocks the GetContentRatingBreakdown method.
+	GetContentRatingBreakdownFunc func(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.ContentRatingCount, error)
+
+	// GetCountriesLibraryFunc mocks the GetCountriesLibrary method.
+	GetCountriesLibraryFunc func(ctx context.Context, sectionKey int, type_ operations.GetCountriesLibraryQueryParamType, opts ...operations.Option) (*operations.GetCountriesLibraryResponse, error)
+
+	// GetFileHashFunc mocks the GetFileHash method.
+	GetFileHashFunc func(ctx context.Context, url_ string, type_ *float64, opts ...operations.Option) (*operations.GetFileHashResponse, error)
+
+	// GetGenresLibraryFunc mocks the GetGenresLibrary method.
+	GetGenresLibraryFunc func(ctx context.Context, sectionKey int, type_ operations.GetGenresLibraryQueryParamType, opts ...operations.Option) (*operations.GetGenresLibraryResponse, error)
+
+	// GetItemFunc mocks the GetItem method.
+	GetItemFunc func(ctx context.Context, ratingKey int, opts ...operations.Option) (*plexgo.Metadata, error)
+
+	// GetItemsFunc mocks the GetItems method.
+	GetItemsFunc func(ctx context.Context, ratingKeys []string, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// GetItemsByActorFunc mocks the GetItemsByActor method.
+	GetItemsByActorFunc func(ctx context.Context, sectionID int, actorTagID int64, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// GetItemsByPersonFunc mocks the GetItemsByPerson method.
+	GetItemsByPersonFunc func(ctx context.Context, sectionID int, role plexgo.PersonRole, tagID int64, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// GetLibraryDetailsFunc mocks the GetLibraryDetails method.
+	GetLibraryDetailsFunc func(ctx context.Context, sectionKey int, includeDetails *operations.IncludeDetails, opts ...operations.Option) (*operations.GetLibraryDetailsResponse, error)
+
+	// GetLibraryItemsFunc mocks the GetLibraryItems method.
+	GetLibraryItemsFunc func(ctx context.Context, request operations.GetLibraryItemsRequest, opts ...operations.Option) (*operations.GetLibraryItemsResponse, error)
+
+	// GetLocalizedMetadataFunc mocks the GetLocalizedMetadata method.
+	GetLocalizedMetadataFunc func(ctx context.Context, ratingKey int, languages []string, opts ...operations.Option) ([]plexgo.LocalizedMetadata, error)
+
+	// GetMediaArtsFunc mocks the GetMediaArts method.
+	GetMediaArtsFunc func(ctx context.Context, ratingKey int64, opts ...operations.Option) (*operations.GetMediaArtsResponse, error)
+
+	// GetMediaMetaDataFunc mocks the GetMediaMetaData method.
+	GetMediaMetaDataFunc func(ctx context.Context, request operations.GetMediaMetaDataRequest, opts ...operations.Option) (*operations.GetMediaMetaDataResponse, error)
+
+	// GetMediaPostersFunc mocks the GetMediaPosters method.
+	GetMediaPostersFunc func(ctx context.Context, ratingKey int64, opts ...operations.Option) (*operations.GetMediaPostersResponse, error)
+
+	// GetMediaTagURLFunc mocks the GetMediaTagURL method.
+	GetMediaTagURLFunc func(ctx context.Context, info plexgo.ContainerInfo, kind string, value string, opts ...operations.Option) (string, error)
+
+	// GetMetadataChildrenFunc mocks the GetMetadataChildren method.
+	GetMetadataChildrenFunc func(ctx context.Context, ratingKey float64, includeElements *string, opts ...operations.Option) (*operations.GetMetadataChildrenResponse, error)
+
+	// GetPeopleFunc mocks the GetPeople method.
+	GetPeopleFunc func(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.Tag, error)
+
+	// GetPeopleByRoleFunc mocks the GetPeopleByRole method.
+	GetPeopleByRoleFunc func(ctx context.Context, sectionID int, role plexgo.PersonRole, opts ...operations.Option) ([]plexgo.Tag, error)
+
+	// GetRandomItemFunc mocks the GetRandomItem method.
+	GetRandomItemFunc func(ctx context.Context, sectionID int, filter plexgo.FilterNode, opts ...operations.Option) (*plexgo.Metadata, error)
+
+	// GetRecentlyAddedLibraryFunc mocks the GetRecentlyAddedLibrary method.
+	GetRecentlyAddedLibraryFunc func(ctx context.Context, request operations.GetRecentlyAddedLibraryRequest, opts ...operations.Option) (*operations.GetRecentlyAddedLibraryResponse, error)
+
+	// GetRefreshLibraryMetadataFunc mocks the GetRefreshLibraryMetadata method.
+	GetRefreshLibraryMetadataFunc func(ctx context.Context, sectionKey int, force *operations.Force, opts ...operations.Option) (*operations.GetRefreshLibraryMetadataResponse, error)
+
+	// GetRefreshStatusFunc mocks the GetRefreshStatus method.
+	GetRefreshStatusFunc func(ctx context.Context, sectionID int, opts ...operations.Option) (*plexgo.RefreshStatus, error)
+
+	// GetSearchAllLibrariesFunc mocks the GetSearchAllLibraries method.
+	GetSearchAllLibrariesFunc func(ctx context.Context, request operations.GetSearchAllLibrariesRequest, opts ...operations.Option) (*operations.GetSearchAllLibrariesResponse, error)
+
+	// GetSearchLibraryFunc mocks the GetSearchLibrary method.
+	GetSearchLibraryFunc func(ctx context.Context, sectionKey int, type_ operations.GetSearchLibraryQueryParamType, opts ...operations.Option) (*operations.GetSearchLibraryResponse, error)
+
+	// GetShowPreferencesFunc mocks the GetShowPreferences method.
+	GetShowPreferencesFunc func(ctx context.Context, ratingKey int, opts ...operations.Option) (*plexgo.ShowPreferences, error)
+
+	// GetShowProgressFunc mocks the GetShowProgress method.
+	GetShowProgressFunc func(ctx context.Context, ratingKey int, opts ...operations.Option) (*plexgo.ShowProgress, error)
+
+	// GetSonicallySimilarFunc mocks the GetSonicallySimilar method.
+	GetSonicallySimilarFunc func(ctx context.Context, trackRatingKey int, limit int, maxDistance float64, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// GetStorageReportFunc mocks the GetStorageReport method.
+	GetStorageReportFunc func(ctx context.Context, sectionID int, opts ...operations.Option) (*plexgo.StorageReport, error)
+
+	// GetTopWatchedContentFunc mocks the GetTopWatchedContent method.
+	GetTopWatchedContentFunc func(ctx context.Context, type_ operations.GetTopWatchedContentQueryParamType, includeGuids *int64, opts ...operations.Option) (*operations.GetTopWatchedContentResponse, error)
+
+	// ItemsPageFunc mocks the ItemsPage method.
+	ItemsPageFunc func(ctx context.Context, sectionID int, listOptions plexgo.ItemListOptions, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error)
+
+	// ListItemsFunc mocks the ListItems method.
+	ListItemsFunc func(ctx context.Context, sectionID int, listOptions plexgo.ItemListOptions, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// ListItemsPageFunc mocks the ListItemsPage method.
+	ListItemsPageFunc func(ctx context.Context, sectionID int, listOptions plexgo.ItemListOptions, start int, opts ...operations.Option) (*plexgo.MetadataMediaContainer, error)
+
+	// LockFieldsFunc mocks the LockFields method.
+	LockFieldsFunc func(ctx context.Context, ratingKeys []string, fields ...string) error
+
+	// MigrateAgentFunc mocks the MigrateAgent method.
+	MigrateAgentFunc func(ctx context.Context, sectionID int, targetAgent string, opts ...operations.Option) (*plexgo.AgentMigration, error)
+
+	// NewItemPipelineFunc mocks the NewItemPipeline method.
+	NewItemPipelineFunc func(sectionID int, rules ...plexgo.NewItemRule) *plexgo.NewItemPipeline
+
+	// NewPrefetcherFunc mocks the NewPrefetcher method.
+	NewPrefetcherFunc func() *plexgo.Prefetcher
+
+	// NormalizeSortTitlesFunc mocks the NormalizeSortTitles method.
+	NormalizeSortTitlesFunc func(ctx context.Context, sectionID int, sortOptions plexgo.SortTitleOptions, opts ...operations.Option) ([]plexgo.SortTitleChange, error)
+
+	// PostMediaArtsFunc mocks the PostMediaArts method.
+	PostMediaArtsFunc func(ctx context.Context, ratingKey int64, url_ *string, requestBody *any, opts ...operations.Option) (*operations.PostMediaArtsResponse, error)
+
+	// PostMediaPosterFunc mocks the PostMediaPoster method.
+	PostMediaPosterFunc func(ctx context.Context, ratingKey int64, url_ *string, requestBody *any, opts ...operations.Option) (*operations.PostMediaPosterResponse, error)
+
+	// SetSectionVisibilityFunc mocks the SetSectionVisibility method.
+	SetSectionVisibilityFunc func(ctx context.Context, sectionID int, visibility string, opts ...operations.Option) error
+
+	// SyncWatchStateFunc mocks the SyncWatchState method.
+	SyncWatchStateFunc func(ctx context.Context, sectionID int, history []plexgo.WatchHistoryEntry, dryRun bool, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// UnlockFieldsFunc mocks the UnlockFields method.
+	UnlockFieldsFunc func(ctx context.Context, ratingKeys []string, fields ...string) error
+
+	// UpdateSectionShowPreferencesFunc mocks the UpdateSectionShowPreferences method.
+	UpdateSectionShowPreferencesFunc func(ctx context.Context, sectionID int, prefs map[string]string, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// UpdateShowPreferencesFunc mocks the UpdateShowPreferences method.
+	UpdateShowPreferencesFunc func(ctx context.Context, ratingKey int, prefs map[string]string, opts ...operations.Option) error
+
+	// UploadFunc mocks the Upload method.
+	UploadFunc func(ctx context.Context, sectionID int, path string, r io.Reader, opts ...operations.Option) error
+
+	// VerifyRatingKeysFunc mocks the VerifyRatingKeys method.
+	VerifyRatingKeysFunc func(ctx context.Context, ratingKeys []string, opts ...operations.Option) (*plexgo.RatingKeyAvailability, error)
+
+	// WaitForScanCompleteFunc mocks the WaitForScanComplete method.
+	WaitForScanCompleteFunc func(ctx context.Context, sectionID int, timeout time.Duration, opts ...operations.Option) error
+
+	// WalkTreeFunc mocks the WalkTree method.
+	WalkTreeFunc func(ctx context.Context, ratingKey int, fn plexgo.WalkFunc, opts ...operations.Option) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddLabelsAction holds details about calls to the AddLabelsAction method.
+		AddLabelsAction []struct {
+			Labels []string
+		}
+		// ApplyAssets holds details about calls to the ApplyAssets method.
+		ApplyAssets []struct {
+			Ctx          context.Context
+			SectionID    int
+			Assets       fs.FS
+			AssetOptions plexgo.AssetOptions
+			Opts         []operations.Option
+		}
+		// BulkEdit holds details about calls to the BulkEdit method.
+		BulkEdit []struct {
+			Ctx       context.Context
+			SectionID int
+			Selector  plexgo.ItemListOptions
+			Edit      plexgo.EditParams
+			Opts      []operations.Option
+		}
+		// DeleteLibrary holds details about calls to the DeleteLibrary method.
+		DeleteLibrary []struct {
+			Ctx        context.Context
+			SectionKey int
+			Opts       []operations.Option
+		}
+		// Diff holds details about calls to the Diff method.
+		Diff []struct {
+			Ctx      context.Context
+			Other    *plexgo.PlexAPI
+			SectionA int
+			SectionB int
+			Opts     []operations.Option
+		}
+		// EachItem holds details about calls to the EachItem method.
+		EachItem []struct {
+			Ctx         context.Context
+			SectionID   int
+			ListOptions plexgo.ItemListOptions
+			Fn          func(item plexgo.Metadata) error
+			Opts        []operations.Option
+		}
+		// FindByVideoAttributes holds details about calls to the FindByVideoAttributes method.
+		FindByVideoAttributes []struct {
+			Ctx       context.Context
+			SectionID int
+			Query     plexgo.VideoAttributeQuery
+			Opts      []operations.Option
+		}
+		// FindExceedingRating holds details about calls to the FindExceedingRating method.
+		FindExceedingRating []struct {
+			Ctx       context.Context
+			SectionID int
+			MaxRating string
+			Opts      []operations.Option
+		}
+		// FindPerson holds details about calls to the FindPerson method.
+		FindPerson []struct {
+			Ctx       context.Context
+			SectionID int
+			Name      string
+			Role      plexgo.PersonRole
+			Opts      []operations.Option
+		}
+		// GetActorsLibrary holds details about calls to the GetActorsLibrary method.
+		GetActorsLibrary []struct {
+			Ctx        context.Context
+			SectionKey int
+			Type_      operations.GetActorsLibraryQueryParamType
+			Opts       []operations.Option
+		}
+		// GetAllLibraries holds details about calls to the GetAllLibraries method.
+		GetAllLibraries []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetAllMediaLibrary holds details about calls to the GetAllMediaLibrary method.
+		GetAllMediaLibrary []struct {
+			Ctx     context.Context
+			Request operations.GetAllMediaLibraryRequest
+			Opts    []operations.Option
+		}
+		// GetChildren holds details about calls to the GetChildren method.
+		GetChildren []struct {
+			Ctx       context.Context
+			RatingKey int
+			Opts      []operations.Option
+		}
+		// GetContentRatingBreakdown holds details about calls to the GetContentRatingBreakdown method.
+		GetContentRatingBreakdown []struct {
+			Ctx       context.Context
+			SectionID int
+			Opts      []operations.Option
+		}
+		// GetCountriesLibrary holds details about calls to the GetCountriesLibrary method.
+		GetCountriesLibrary []struct {
+			Ctx        context.Context
+			SectionKey int
+			Type_      operations.GetCountriesLibraryQueryParamType
+			Opts       []operations.Option
+		}
+		// GetFileHash holds details about calls to the GetFileHash method.
+		GetFileHash []struct {
+			Ctx   context.Context
+			Url_  string
+			Type_ *float64
+			Opts  []operations.Option
+		}
+		// GetGenresLibrary holds details about calls to the GetGenresLibrary method.
+		GetGenresLibrary []struct {
+			Ctx        context.Context
+			SectionKey int
+			Type_      operations.GetGenresLibraryQueryParamType
+			Opts       []operations.Option
+		}
+		// GetItem holds details about calls to the GetItem method.
+		GetItem []struct {
+			Ctx       context.Context
+			RatingKey int
+			Opts      []operations.Option
+		}
+		// GetItems holds details about calls to the GetItems method.
+		GetItems []struct {
+			Ctx        context.Context
+			RatingKeys []string
+			Opts       []operations.Option
+		}
+		// GetItemsByActor holds details about calls to the GetItemsByActor method.
+		GetItemsByActor []struct {
+			Ctx        context.Context
+			SectionID  int
+			ActorTagID int64
+			Opts       []operations.Option
+		}
+		// GetItemsByPerson holds details about calls to the GetItemsByPerson method.
+		GetItemsByPerson []struct {
+			Ctx       context.Context
+			SectionID int
+			Role      plexgo.PersonRole
+			TagID     int64
+			Opts      []operations.Option
+		}
+		// GetLibraryDetails holds details about calls to the GetLibraryDetails method.
+		GetLibraryDetails []struct {
+			Ctx            context.Context
+			SectionKey     int
+			IncludeDetails *operations.IncludeDetails
+			Opts           []operations.Option
+		}
+		// GetLibraryItems holds details about calls to the GetLibraryItems method.
+		GetLibraryItems []struct {
+			Ctx     context.Context
+			Request operations.GetLibraryItemsRequest
+			Opts    []operations.Option
+		}
+		// GetLocalizedMetadata holds details about calls to the GetLocalizedMetadata method.
+		GetLocalizedMetadata []struct {
+			Ctx       context.Context
+			RatingKey int
+			Languages []string
+			Opts      []operations.Option
+		}
+		// GetMediaArts holds details about calls to the GetMediaArts method.
+		GetMediaArts []struct {
+			Ctx       context.Context
+			RatingKey int64
+			Opts      []operations.Option
+		}
+		// GetMediaMetaData holds details about calls to the GetMediaMetaData method.
+		GetMediaMetaData []struct {
+			Ctx     context.Context
+			Request operations.GetMediaMetaDataRequest
+			Opts    []operations.Option
+		}
+		// GetMediaPosters holds details about calls to the GetMediaPosters method.
+		GetMediaPosters []struct {
+			Ctx       context.Context
+			RatingKey int64
+			Opts      []operations.Option
+		}
+		// GetMediaTagURL holds details about calls to the GetMediaTagURL method.
+		GetMediaTagURL []struct {
+			Ctx   context.Context
+			Info  plexgo.ContainerInfo
+			Kind  string
+			Value string
+			Opts  []operations.Option
+		}
+		// GetMetadataChildren holds details about calls to the GetMetadataChildren method.
+		GetMetadataChildren []struct {
+			Ctx             context.Context
+			RatingKey       float64
+			IncludeElements *string
+			Opts            []operations.Option
+		}
+		// GetPeople holds details about calls to the GetPeople method.
+		GetPeople []struct {
+			Ctx       context.Context
+			SectionID int
+			Opts      []operations.Option
+		}
+		// GetPeopleByRole holds details about calls to the GetPeopleByRole method.
+		GetPeopleByRole []struct {
+			Ctx       context.Context
+			SectionID int
+			Role      plexgo.PersonRole
+			Opts      []operations.Option
+		}
+		// GetRandomItem holds details about calls to the GetRandomItem method.
+		GetRandomItem []struct {
+			Ctx       context.Context
+			SectionID int
+			Filter    plexgo.FilterNode
+			Opts      []operations.Option
+		}
+		// GetRecentlyAddedLibrary holds details about calls to the GetRecentlyAddedLibrary method.
+		GetRecentlyAddedLibrary []struct {
+			Ctx     context.Context
+			Request operations.GetRecentlyAddedLibraryRequest
+			Opts    []operations.Option
+		}
+		// GetRefreshLibraryMetadata holds details about calls to the GetRefreshLibraryMetadata method.
+		GetRefreshLibraryMetadata []struct {
+			Ctx        context.Context
+			SectionKey int
+			Force      *operations.Force
+			Opts       []operations.Option
+		}
+		// GetRefreshStatus holds details about calls to the GetRefreshStatus method.
+		GetRefreshStatus []struct {
+			Ctx       context.Context
+			SectionID int
+			Opts      []operations.Option
+		}
+		// GetSearchAllLibraries holds details about calls to the GetSearchAllLibraries method.
+		GetSearchAllLibraries []struct {
+			Ctx     context.Context
+			Request operations.GetSearchAllLibrariesRequest
+			Opts    []operations.Option
+		}
+		// GetSearchLibrary holds details about calls to the GetSearchLibrary method.
+		GetSearchLibrary []struct {
+			Ctx        context.Context
+			SectionKey int
+			Type_      operations.GetSearchLibraryQueryParamType
+			Opts       []operations.Option
+		}
+		// GetShowPreferences holds details about calls to the GetShowPreferences method.
+		GetShowPreferences []struct {
+			Ctx       context.Context
+			RatingKey int
+			Opts      []operations.Option
+		}
+		// GetShowProgress holds details about calls to the GetShowProgress method.
+		GetShowProgress []struct {
+			Ctx       context.Context
+			RatingKey int
+			Opts      []operations.Option
+		}
+		// GetSonicallySimilar holds details about calls to the GetSonicallySimilar method.
+		GetSonicallySimilar []struct {
+			Ctx            context.Context
+			TrackRatingKey int
+			Limit          int
+			MaxDistance    float64
+			Opts           []operations.Option
+		}
+		// GetStorageReport holds details about calls to the GetStorageReport method.
+		GetStorageReport []struct {
+			Ctx       context.Context
+			SectionID int
+			Opts      []operations.Option
+		}
+		// GetTopWatchedContent holds details about calls to the GetTopWatchedContent method.
+		GetTopWatchedContent []struct {
+			Ctx          context.Context
+			Type_        operations.GetTopWatchedContentQueryParamType
+			IncludeGuids *int64
+			Opts         []operations.Option
+		}
+		// ItemsPage holds details about calls to the ItemsPage method.
+		ItemsPage []struct {
+			Ctx         context.Context
+			SectionID   int
+			ListOptions plexgo.ItemListOptions
+			Cursor      plexgo.Cursor
+			Opts        []operations.Option
+		}
+		// ListItems holds details about calls to the ListItems method.
+		ListItems []struct {
+			Ctx         context.Context
+			SectionID   int
+			ListOptions plexgo.ItemListOptions
+			Opts        []operations.Option
+		}
+		// ListItemsPage holds details about calls to the ListItemsPage method.
+		ListItemsPage []struct {
+			Ctx         context.Context
+			SectionID   int
+			ListOptions plexgo.ItemListOptions
+			Start       int
+			Opts        []operations.Option
+		}
+		// LockFields holds details about calls to the LockFields method.
+		LockFields []struct {
+			Ctx        context.Context
+			RatingKeys []string
+			Fields     []string
+		}
+		// MigrateAgent holds details about calls to the MigrateAgent method.
+		MigrateAgent []struct {
+			Ctx         context.Context
+			SectionID   int
+			TargetAgent string
+			Opts        []operations.Option
+		}
+		// NewItemPipeline holds details about calls to the NewItemPipeline method.
+		NewItemPipeline []struct {
+			SectionID int
+			Rules     []plexgo.NewItemRule
+		}
+		// NewPrefetcher holds details about calls to the NewPrefetcher method.
+		NewPrefetcher []struct {
+		}
+		// NormalizeSortTitles holds details about calls to the NormalizeSortTitles method.
+		NormalizeSortTitles []struct {
+			Ctx         context.Context
+			SectionID   int
+			SortOptions plexgo.SortTitleOptions
+			Opts        []operations.Option
+		}
+		// PostMediaArts holds details about calls to the PostMediaArts method.
+		PostMediaArts []struct {
+			Ctx         context.Context
+			RatingKey   int64
+			Url_        *string
+			RequestBody *any
+			Opts        []operations.Option
+		}
+		// PostMediaPoster holds details about calls to the PostMediaPoster method.
+		PostMediaPoster []struct {
+			Ctx         context.Context
+			RatingKey   int64
+			Url_        *string
+			RequestBody *any
+			Opts        []operations.Option
+		}
+		// SetSectionVisibility holds details about calls to the SetSectionVisibility method.
+		SetSectionVisibility []struct {
+			Ctx        context.Context
+			SectionID  int
+			Visibility string
+			Opts       []operations.Option
+		}
+		// SyncWatchState holds details about calls to the SyncWatchState method.
+		SyncWatchState []struct {
+			Ctx       context.Context
+			SectionID int
+			History   []plexgo.WatchHistoryEntry
+			DryRun    bool
+			Opts      []operations.Option
+		}
+		// UnlockFields holds details about calls to the UnlockFields method.
+		UnlockFields []struct {
+			Ctx        context.Context
+			RatingKeys []string
+			Fields     []string
+		}
+		// UpdateSectionShowPreferences holds details about calls to the UpdateSectionShowPreferences method.
+		UpdateSectionShowPreferences []struct {
+			Ctx       context.Context
+			SectionID int
+			Prefs     map[string]string
+			Opts      []operations.Option
+		}
+		// UpdateShowPreferences holds details about calls to the UpdateShowPreferences method.
+		UpdateShowPreferences []struct {
+			Ctx       context.Context
+			RatingKey int
+			Prefs     map[string]string
+			Opts      []operations.Option
+		}
+		// Upload holds details about calls to the Upload method.
+		Upload []struct {
+			Ctx       context.Context
+			SectionID int
+			Path      string
+			R         io.Reader
+			Opts      []operations.Option
+		}
+		// VerifyRatingKeys holds details about calls to the VerifyRatingKeys method.
+		VerifyRatingKeys []struct {
+			Ctx        context.Context
+			RatingKeys []string
+			Opts       []operations.Option
+		}
+		// WaitForScanComplete holds details about calls to the WaitForScanComplete method.
+		WaitForScanComplete []struct {
+			Ctx       context.Context
+			SectionID int
+			Timeout   time.Duration
+			Opts      []operations.Option
+		}
+		// WalkTree holds details about calls to the WalkTree method.
+		WalkTree []struct {
+			Ctx       context.Context
+			RatingKey int
+			Fn        plexgo.WalkFunc
+			Opts      []operations.Option
+		}
+	}
+	lockAddLabelsAction              sync.RWMutex
+	lockApplyAssets                  sync.RWMutex
+	lockBulkEdit                     sync.RWMutex
+	lockDeleteLibrary                sync.RWMutex
+	lockDiff                         sync.RWMutex
+	lockEachItem                     sync.RWMutex
+	lockFindByVideoAttributes        sync.RWMutex
+	lockFindExceedingRating          sync.RWMutex
+	lockFindPerson                   sync.RWMutex
+	lockGetActorsLibrary             sync.RWMutex
+	lockGetAllLibraries              sync.RWMutex
+	lockGetAllMediaLibrary           sync.RWMutex
+	lockGetChildren                  sync.RWMutex
+	lockGetContentRatingBreakdown    sync.RWMutex
+	lockGetCountriesLibrary          sync.RWMutex
+	lockGetFileHash                  sync.RWMutex
+	lockGetGenresLibrary             sync.RWMutex
+	lockGetItem                      sync.RWMutex
+	lockGetItems                     sync.RWMutex
+	lockGetItemsByActor              sync.RWMutex
+	lockGetItemsByPerson             sync.RWMutex
+	lockGetLibraryDetails            sync.RWMutex
+	lockGetLibraryItems              sync.RWMutex
+	lockGetLocalizedMetadata         sync.RWMutex
+	lockGetMediaArts                 sync.RWMutex
+	lockGetMediaMetaData             sync.RWMutex
+	lockGetMediaPosters              sync.RWMutex
+	lockGetMediaTagURL               sync.RWMutex
+	lockGetMetadataChildren          sync.RWMutex
+	lockGetPeople                    sync.RWMutex
+	lockGetPeopleByRole              sync.RWMutex
+	lockGetRandomItem                sync.RWMutex
+	lockGetRecentlyAddedLibrary      sync.RWMutex
+	lockGetRefreshLibraryMetadata    sync.RWMutex
+	lockGetRefreshStatus             sync.RWMutex
+	lockGetSearchAllLibraries        sync.RWMutex
+	lockGetSearchLibrary             sync.RWMutex
+	lockGetShowPreferences           sync.RWMutex
+	lockGetShowProgress              sync.RWMutex
+	lockGetSonicallySimilar          sync.RWMutex
+	lockGetStorageReport             sync.RWMutex
+	lockGetTopWatchedContent         sync.RWMutex
+	lockItemsPage                    sync.RWMutex
+	lockListItems                    sync.RWMutex
+	lockListItemsPage                sync.RWMutex
+	lockLockFields                   sync.RWMutex
+	lockMigrateAgent                 sync.RWMutex
+	lockNewItemPipeline              sync.RWMutex
+	lockNewPrefetcher                sync.RWMutex
+	lockNormalizeSortTitles          sync.RWMutex
+	lockPostMediaArts                sync.RWMutex
+	lockPostMediaPoster              sync.RWMutex
+	lockSetSectionVisibility         sync.RWMutex
+	lockSyncWatchState               sync.RWMutex
+	lockUnlockFields                 sync.RWMutex
+	lockUpdateSectionShowPreferences sync.RWMutex
+	lockUpdateShowPreferences        sync.RWMutex
+	lockUpload                       sync.RWMutex
+	lockVerifyRatingKeys             sync.RWMutex
+	lockWaitForScanComplete          sync.RWMutex
+	lockWalkTree                     sync.RWMutex
+}
+
+// AddLabelsAction calls AddLabelsActionFunc.
+func (mock *LibraryAPIMock) AddLabelsAction(labels ...string) plexgo.NewItemAction {
+	if mock.AddLabelsActionFunc == nil {
+		panic("LibraryAPIMock.AddLabelsActionFunc: method is nil but LibraryAPI.AddLabelsAction was just called")
+	}
+	callInfo := struct {
+		Labels []string
+	}{
+		Labels: labels,
+	}
+	mock.lockAddLabelsAction.Lock()
+	mock.calls.AddLabelsAction = append(mock.calls.AddLabelsAction, callInfo)
+	mock.lockAddLabelsAction.Unlock()
+	return mock.AddLabelsActionFunc(labels...)
+}
+
+// AddLabelsActionCalls gets all the calls that were made to AddLabelsAction.
+// Check the length with:
+//
+//	len(mockedLibrary.AddLabelsActionCalls())
+func (mock *LibraryAPIMock) AddLabelsActionCalls() []struct {
+	Labels []string
+} {
+	var calls []struct {
+		Labels []string
+	}
+	mock.lockAddLabelsAction.RLock()
+	calls = mock.calls.AddLabelsAction
+	mock.lockAddLabelsAction.RUnlock()
+	return calls
+}
+
+// ApplyAssets calls ApplyAssetsFunc.
+func (mock *LibraryAPIMock) ApplyAssets(ctx context.Context, sectionID int, assets fs.FS, assetOptions plexgo.AssetOptions, opts ...operations.Option) (*plexgo.AssetReport, error) {
+	if mock.ApplyAssetsFunc == nil {
+		panic("LibraryAPIMock.ApplyAssetsFunc: method is nil but LibraryAPI.ApplyAssets was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		SectionID    int
+		Assets       fs.FS
+		AssetOptions plexgo.AssetOptions
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		SectionID:    sectionID,
+		Assets:       assets,
+		AssetOptions: assetOptions,
+		Opts:         opts,
+	}
+	mock.lockApplyAssets.Lock()
+	mock.calls.ApplyAssets = append(mock.calls.ApplyAssets, callInfo)
+	mock.lockApplyAssets.Unlock()
+	return mock.ApplyAssetsFunc(ctx, sectionID, assets, assetOptions, opts...)
+}
+
+// ApplyAssetsCalls gets all the calls that were made to ApplyAssets.
+// Check the length with:
+//
+//	len(mockedLibrary.ApplyAssetsCalls())
+func (mock *LibraryAPIMock) ApplyAssetsCalls() []struct {
+	Ctx          context.Context
+	SectionID    int
+	Assets       fs.FS
+	AssetOptions plexgo.AssetOptions
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		SectionID    int
+		Assets       fs.FS
+		AssetOptions plexgo.AssetOptions
+		Opts         []operations.Option
+	}
+	mock.lockApplyAssets.RLock()
+	calls = mock.calls.ApplyAssets
+	mock.lockApplyAssets.RUnlock()
+	return calls
+}
+
+// BulkEdit calls BulkEditFunc.
+func (mock *LibraryAPIMock) BulkEdit(ctx context.Context, sectionID int, selector plexgo.ItemListOptions, edit plexgo.EditParams, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.BulkEditFunc == nil {
+		panic("LibraryAPIMock.BulkEditFunc: method is nil but LibraryAPI.BulkEdit was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Selector  plexgo.ItemListOptions
+		Edit      plexgo.EditParams
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Selector:  selector,
+		Edit:      edit,
+		Opts:      opts,
+	}
+	mock.lockBulkEdit.Lock()
+	mock.calls.BulkEdit = append(mock.calls.BulkEdit, callInfo)
+	mock.lockBulkEdit.Unlock()
+	return mock.BulkEditFunc(ctx, sectionID, selector, edit, opts...)
+}
+
+// BulkEditCalls gets all the calls that were made to BulkEdit.
+// Check the length with:
+//
+//	len(mockedLibrary.BulkEditCalls())
+func (mock *LibraryAPIMock) BulkEditCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Selector  plexgo.ItemListOptions
+	Edit      plexgo.EditParams
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Selector  plexgo.ItemListOptions
+		Edit      plexgo.EditParams
+		Opts      []operations.Option
+	}
+	mock.lockBulkEdit.RLock()
+	calls = mock.calls.BulkEdit
+	mock.lockBulkEdit.RUnlock()
+	return calls
+}
+
+// DeleteLibrary calls DeleteLibraryFunc.
+func (mock *LibraryAPIMock) DeleteLibrary(ctx context.Context, sectionKey int, opts ...operations.Option) (*operations.DeleteLibraryResponse, error) {
+	if mock.DeleteLibraryFunc == nil {
+		panic("LibraryAPIMock.DeleteLibraryFunc: method is nil but LibraryAPI.DeleteLibrary was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionKey int
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionKey: sectionKey,
+		Opts:       opts,
+	}
+	mock.lockDeleteLibrary.Lock()
+	mock.calls.DeleteLibrary = append(mock.calls.DeleteLibrary, callInfo)
+	mock.lockDeleteLibrary.Unlock()
+	return mock.DeleteLibraryFunc(ctx, sectionKey, opts...)
+}
+
+// DeleteLibraryCalls gets all the calls that were made to DeleteLibrary.
+// Check the length with:
+//
+//	len(mockedLibrary.DeleteLibraryCalls())
+func (mock *LibraryAPIMock) DeleteLibraryCalls() []struct {
+	Ctx        context.Context
+	SectionKey int
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionKey int
+		Opts       []operations.Option
+	}
+	mock.lockDeleteLibrary.RLock()
+	calls = mock.calls.DeleteLibrary
+	mock.lockDeleteLibrary.RUnlock()
+	return calls
+}
+
+// Diff calls DiffFunc.
+func (mock *LibraryAPIMock) Diff(ctx context.Context, other *plexgo.PlexAPI, sectionA int, sectionB int, opts ...operations.Option) (*plexgo.LibraryDiff, error) {
+	if mock.DiffFunc == nil {
+		panic("LibraryAPIMock.DiffFunc: method is nil but LibraryAPI.Diff was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Other    *plexgo.PlexAPI
+		SectionA int
+		SectionB int
+		Opts     []operations.Option
+	}{
+		Ctx:      ctx,
+		Other:    other,
+		SectionA: sectionA,
+		SectionB: sectionB,
+		Opts:     opts,
+	}
+	mock.lockDiff.Lock()
+	mock.calls.Diff = append(mock.calls.Diff, callInfo)
+	mock.lockDiff.Unlock()
+	return mock.DiffFunc(ctx, other, sectionA, sectionB, opts...)
+}
+
+// DiffCalls gets all the calls that were made to Diff.
+// Check the length with:
+//
+//	len(mockedLibrary.DiffCalls())
+func (mock *LibraryAPIMock) DiffCalls() []struct {
+	Ctx      context.Context
+	Other    *plexgo.PlexAPI
+	SectionA int
+	SectionB int
+	Opts     []operations.Option
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Other    *plexgo.PlexAPI
+		SectionA int
+		SectionB int
+		Opts     []operations.Option
+	}
+	mock.lockDiff.RLock()
+	calls = mock.calls.Diff
+	mock.lockDiff.RUnlock()
+	return calls
+}
+
+// EachItem calls EachItemFunc.
+func (mock *LibraryAPIMock) EachItem(ctx context.Context, sectionID int, listOptions plexgo.ItemListOptions, fn func(item plexgo.Metadata) error, opts ...operations.Option) error {
+	if mock.EachItemFunc == nil {
+		panic("LibraryAPIMock.EachItemFunc: method is nil but LibraryAPI.EachItem was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.ItemListOptions
+		Fn          func(item plexgo.Metadata) error
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		ListOptions: listOptions,
+		Fn:          fn,
+		Opts:        opts,
+	}
+	mock.lockEachItem.Lock()
+	mock.calls.EachItem = append(mock.calls.EachItem, callInfo)
+	mock.lockEachItem.Unlock()
+	return mock.EachItemFunc(ctx, sectionID, listOptions, fn, opts...)
+}
+
+// EachItemCalls gets all the calls that were made to EachItem.
+// Check the length with:
+//
+//	len(mockedLibrary.EachItemCalls())
+func (mock *LibraryAPIMock) EachItemCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	ListOptions plexgo.ItemListOptions
+	Fn          func(item plexgo.Metadata) error
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.ItemListOptions
+		Fn          func(item plexgo.Metadata) error
+		Opts        []operations.Option
+	}
+	mock.lockEachItem.RLock()
+	calls = mock.calls.EachItem
+	mock.lockEachItem.RUnlock()
+	return calls
+}
+
+// FindByVideoAttributes calls FindByVideoAttributesFunc.
+func (mock *LibraryAPIMock) FindByVideoAttributes(ctx context.Context, sectionID int, query plexgo.VideoAttributeQuery, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.FindByVideoAttributesFunc == nil {
+		panic("LibraryAPIMock.FindByVideoAttributesFunc: method is nil but LibraryAPI.FindByVideoAttributes was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Query     plexgo.VideoAttributeQuery
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Query:     query,
+		Opts:      opts,
+	}
+	mock.lockFindByVideoAttributes.Lock()
+	mock.calls.FindByVideoAttributes = append(mock.calls.FindByVideoAttributes, callInfo)
+	mock.lockFindByVideoAttributes.Unlock()
+	return mock.FindByVideoAttributesFunc(ctx, sectionID, query, opts...)
+}
+
+// FindByVideoAttributesCalls gets all the calls that were made to FindByVideoAttributes.
+// Check the length with:
+//
+//	len(mockedLibrary.FindByVideoAttributesCalls())
+func (mock *LibraryAPIMock) FindByVideoAttributesCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Query     plexgo.VideoAttributeQuery
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Query     plexgo.VideoAttributeQuery
+		Opts      []operations.Option
+	}
+	mock.lockFindByVideoAttributes.RLock()
+	calls = mock.calls.FindByVideoAttributes
+	mock.lockFindByVideoAttributes.RUnlock()
+	return calls
+}
+
+// FindExceedingRating calls FindExceedingRatingFunc.
+func (mock *LibraryAPIMock) FindExceedingRating(ctx context.Context, sectionID int, maxRating string, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.FindExceedingRatingFunc == nil {
+		panic("LibraryAPIMock.FindExceedingRatingFunc: method is nil but LibraryAPI.FindExceedingRating was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		MaxRating string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		MaxRating: maxRating,
+		Opts:      opts,
+	}
+	mock.lockFindExceedingRating.Lock()
+	mock.calls.FindExceedingRating = append(mock.calls.FindExceedingRating, callInfo)
+	mock.lockFindExceedingRating.Unlock()
+	return mock.FindExceedingRatingFunc(ctx, sectionID, maxRating, opts...)
+}
+
+// FindExceedingRatingCalls gets all the calls that were made to FindExceedingRating.
+// Check the length with:
+//
+//	len(mockedLibrary.FindExceedingRatingCalls())
+func (mock *LibraryAPIMock) FindExceedingRatingCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	MaxRating string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		MaxRating string
+		Opts      []operations.Option
+	}
+	mock.lockFindExceedingRating.RLock()
+	calls = mock.calls.FindExceedingRating
+	mock.lockFindExceedingRating.RUnlock()
+	return calls
+}
+
+// FindPerson calls FindPersonFunc.
+func (mock *LibraryAPIMock) FindPerson(ctx context.Context, sectionID int, name string, role plexgo.PersonRole, opts ...operations.Option) (*plexgo.Tag, error) {
+	if mock.FindPersonFunc == nil {
+		panic("LibraryAPIMock.FindPersonFunc: method is nil but LibraryAPI.FindPerson was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Name      string
+		Role      plexgo.PersonRole
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Name:      name,
+		Role:      role,
+		Opts:      opts,
+	}
+	mock.lockFindPerson.Lock()
+	mock.calls.FindPerson = append(mock.calls.FindPerson, callInfo)
+	mock.lockFindPerson.Unlock()
+	return mock.FindPersonFunc(ctx, sectionID, name, role, opts...)
+}
+
+// FindPersonCalls gets all the calls that were made to FindPerson.
+// Check the length with:
+//
+//	len(mockedLibrary.FindPersonCalls())
+func (mock *LibraryAPIMock) FindPersonCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Name      string
+	Role      plexgo.PersonRole
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Name      string
+		Role      plexgo.PersonRole
+		Opts      []operations.Option
+	}
+	mock.lockFindPerson.RLock()
+	calls = mock.calls.FindPerson
+	mock.lockFindPerson.RUnlock()
+	return calls
+}
+
+// GetActorsLibrary calls GetActorsLibraryFunc.
+func (mock *LibraryAPIMock) GetActorsLibrary(ctx context.Context, sectionKey int, type_ operations.GetActorsLibraryQueryParamType, opts ...operations.Option) (*operations.GetActorsLibraryResponse, error) {
+	if mock.GetActorsLibraryFunc == nil {
+		panic("LibraryAPIMock.GetActorsLibraryFunc: method is nil but LibraryAPI.GetActorsLibrary was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionKey int
+		Type_      operations.GetActorsLibraryQueryParamType
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionKey: sectionKey,
+		Type_:      type_,
+		Opts:       opts,
+	}
+	mock.lockGetActorsLibrary.Lock()
+	mock.calls.GetActorsLibrary = append(mock.calls.GetActorsLibrary, callInfo)
+	mock.lockGetActorsLibrary.Unlock()
+	return mock.GetActorsLibraryFunc(ctx, sectionKey, type_, opts...)
+}
+
+// GetActorsLibraryCalls gets all the calls that were made to GetActorsLibrary.
+// Check the length with:
+//
+//	len(mockedLibrary.GetActorsLibraryCalls())
+func (mock *LibraryAPIMock) GetActorsLibraryCalls() []struct {
+	Ctx        context.Context
+	SectionKey int
+	Type_      operations.GetActorsLibraryQueryParamType
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionKey int
+		Type_      operations.GetActorsLibraryQueryParamType
+		Opts       []operations.Option
+	}
+	mock.lockGetActorsLibrary.RLock()
+	calls = mock.calls.GetActorsLibrary
+	mock.lockGetActorsLibrary.RUnlock()
+	return calls
+}
+
+// GetAllLibraries calls GetAllLibrariesFunc.
+func (mock *LibraryAPIMock) GetAllLibraries(ctx context.Context, opts ...operations.Option) (*operations.GetAllLibrariesResponse, error) {
+	if mock.GetAllLibrariesFunc == nil {
+		panic("LibraryAPIMock.GetAllLibrariesFunc: method is nil but LibraryAPI.GetAllLibraries was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetAllLibraries.Lock()
+	mock.calls.GetAllLibraries = append(mock.calls.GetAllLibraries, callInfo)
+	mock.lockGetAllLibraries.Unlock()
+	return mock.GetAllLibrariesFunc(ctx, opts...)
+}
+
+// GetAllLibrariesCalls gets all the calls that were made to GetAllLibraries.
+// Check the length with:
+//
+//	len(mockedLibrary.GetAllLibrariesCalls())
+func (mock *LibraryAPIMock) GetAllLibrariesCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetAllLibraries.RLock()
+	calls = mock.calls.GetAllLibraries
+	mock.lockGetAllLibraries.RUnlock()
+	return calls
+}
+
+// GetAllMediaLibrary calls GetAllMediaLibraryFunc.
+func (mock *LibraryAPIMock) GetAllMediaLibrary(ctx context.Context, request operations.GetAllMediaLibraryRequest, opts ...operations.Option) (*operations.GetAllMediaLibraryResponse, error) {
+	if mock.GetAllMediaLibraryFunc == nil {
+		panic("LibraryAPIMock.GetAllMediaLibraryFunc: method is nil but LibraryAPI.GetAllMediaLibrary was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetAllMediaLibraryRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetAllMediaLibrary.Lock()
+	mock.calls.GetAllMediaLibrary = append(mock.calls.GetAllMediaLibrary, callInfo)
+	mock.lockGetAllMediaLibrary.Unlock()
+	return mock.GetAllMediaLibraryFunc(ctx, request, opts...)
+}
+
+// GetAllMediaLibraryCalls gets all the calls that were made to GetAllMediaLibrary.
+// Check the length with:
+//
+//	len(mockedLibrary.GetAllMediaLibraryCalls())
+func (mock *LibraryAPIMock) GetAllMediaLibraryCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetAllMediaLibraryRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetAllMediaLibraryRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetAllMediaLibrary.RLock()
+	calls = mock.calls.GetAllMediaLibrary
+	mock.lockGetAllMediaLibrary.RUnlock()
+	return calls
+}
+
+// GetChildren calls GetChildrenFunc.
+func (mock *LibraryAPIMock) GetChildren(ctx context.Context, ratingKey int, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.GetChildrenFunc == nil {
+		panic("LibraryAPIMock.GetChildrenFunc: method is nil but LibraryAPI.GetChildren was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockGetChildren.Lock()
+	mock.calls.GetChildren = append(mock.calls.GetChildren, callInfo)
+	mock.lockGetChildren.Unlock()
+	return mock.GetChildrenFunc(ctx, ratingKey, opts...)
+}
+
+// GetChildrenCalls gets all the calls that were made to GetChildren.
+// Check the length with:
+//
+//	len(mockedLibrary.GetChildrenCalls())
+func (mock *LibraryAPIMock) GetChildrenCalls() []struct {
+	Ctx       context.Context
+	RatingKey int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int
+		Opts      []operations.Option
+	}
+	mock.lockGetChildren.RLock()
+	calls = mock.calls.GetChildren
+	mock.lockGetChildren.RUnlock()
+	return calls
+}
+
+// GetContentRatingBreakdown calls GetContentRatingBreakdownFunc.
+func (mock *LibraryAPIMock) GetContentRatingBreakdown(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.ContentRatingCount, error) {
+	if mock.GetContentRatingBreakdownFunc == nil {
+		panic("LibraryAPIMock.GetContentRatingBreakdownFunc: method is nil but LibraryAPI.GetContentRatingBreakdown was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockGetContentRatingBreakdown.Lock()
+	mock.calls.GetContentRatingBreakdown = append(mock.calls.GetContentRatingBreakdown, callInfo)
+	mock.lockGetContentRatingBreakdown.Unlock()
+	return mock.GetContentRatingBreakdownFunc(ctx, sectionID, opts...)
+}
+
+// GetContentRatingBreakdownCalls gets all the calls that were made to GetContentRatingBreakdown.
+// Check the length with:
+//
+//	len(mockedLibrary.GetContentRatingBreakdownCalls())
+func (mock *LibraryAPIMock) GetContentRatingBreakdownCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockGetContentRatingBreakdown.RLock()
+	calls = mock.calls.GetContentRatingBreakdown
+	mock.lockGetContentRatingBreakdown.RUnlock()
+	return calls
+}
+
+// GetCountriesLibrary calls GetCountriesLibraryFunc.
+func (mock *LibraryAPIMock) GetCountriesLibrary(ctx context.Context, sectionKey int, type_ operations.GetCountriesLibraryQueryParamType, opts ...operations.Option) (*operations.GetCountriesLibraryResponse, error) {
+	if mock.GetCountriesLibraryFunc == nil {
+		panic("LibraryAPIMock.GetCountriesLibraryFunc: method is nil but LibraryAPI.GetCountriesLibrary was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionKey int
+		Type_      operations.GetCountriesLibraryQueryParamType
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionKey: sectionKey,
+		Type_:      type_,
+		Opts:       opts,
+	}
+	mock.lockGetCountriesLibrary.Lock()
+	mock.calls.GetCountriesLibrary = append(mock.calls.GetCountriesLibrary, callInfo)
+	mock.lockGetCountriesLibrary.Unlock()
+	return mock.GetCountriesLibraryFunc(ctx, sectionKey, type_, opts...)
+}
+
+// GetCountriesLibraryCalls gets all the calls that were made to GetCountriesLibrary.
+// Check the length with:
+//
+//	len(mockedLibrary.GetCountriesLibraryCalls())
+func (mock *LibraryAPIMock) GetCountriesLibraryCalls() []struct {
+	Ctx        context.Context
+	SectionKey int
+	Type_      operations.GetCountriesLibraryQueryParamType
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionKey int
+		Type_      operations.GetCountriesLibraryQueryParamType
+		Opts       []operations.Option
+	}
+	mock.lockGetCountriesLibrary.RLock()
+	calls = mock.calls.GetCountriesLibrary
+	mock.lockGetCountriesLibrary.RUnlock()
+	return calls
+}
+
+// GetFileHash calls GetFileHashFunc.
+func (mock *LibraryAPIMock) GetFileHash(ctx context.Context, url_ string, type_ *float64, opts ...operations.Option) (*operations.GetFileHashResponse, error) {
+	if mock.GetFileHashFunc == nil {
+		panic("LibraryAPIMock.GetFileHashFunc: method is nil but LibraryAPI.GetFileHash was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Url_  string
+		Type_ *float64
+		Opts  []operations.Option
+	}{
+		Ctx:   ctx,
+		Url_:  url_,
+		Type_: type_,
+		Opts:  opts,
+	}
+	mock.lockGetFileHash.Lock()
+	mock.calls.GetFileHash = append(mock.calls.GetFileHash, callInfo)
+	mock.lockGetFileHash.Unlock()
+	return mock.GetFileHashFunc(ctx, url_, type_, opts...)
+}
+
+// GetFileHashCalls gets all the calls that were made to GetFileHash.
+// Check the length with:
+//
+//	len(mockedLibrary.GetFileHashCalls())
+func (mock *LibraryAPIMock) GetFileHashCalls() []struct {
+	Ctx   context.Context
+	Url_  string
+	Type_ *float64
+	Opts  []operations.Option
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Url_  string
+		Type_ *float64
+		Opts  []operations.Option
+	}
+	mock.lockGetFileHash.RLock()
+	calls = mock.calls.GetFileHash
+	mock.lockGetFileHash.RUnlock()
+	return calls
+}
+
+// GetGenresLibrary calls GetGenresLibraryFunc.
+func (mock *LibraryAPIMock) GetGenresLibrary(ctx context.Context, sectionKey int, type_ operations.GetGenresLibraryQueryParamType, opts ...operations.Option) (*operations.GetGenresLibraryResponse, error) {
+	if mock.GetGenresLibraryFunc == nil {
+		panic("LibraryAPIMock.GetGenresLibraryFunc: method is nil but LibraryAPI.GetGenresLibrary was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionKey int
+		Type_      operations.GetGenresLibraryQueryParamType
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionKey: sectionKey,
+		Type_:      type_,
+		Opts:       opts,
+	}
+	mock.lockGetGenresLibrary.Lock()
+	mock.calls.GetGenresLibrary = append(mock.calls.GetGenresLibrary, callInfo)
+	mock.lockGetGenresLibrary.Unlock()
+	return mock.GetGenresLibraryFunc(ctx, sectionKey, type_, opts...)
+}
+
+// GetGenresLibraryCalls gets all the calls that were made to GetGenresLibrary.
+// Check the length with:
+//
+//	len(mockedLibrary.GetGenresLibraryCalls())
+func (mock *LibraryAPIMock) GetGenresLibraryCalls() []struct {
+	Ctx        context.Context
+	SectionKey int
+	Type_      operations.GetGenresLibraryQueryParamType
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionKey int
+		Type_      operations.GetGenresLibraryQueryParamType
+		Opts       []operations.Option
+	}
+	mock.lockGetGenresLibrary.RLock()
+	calls = mock.calls.GetGenresLibrary
+	mock.lockGetGenresLibrary.RUnlock()
+	return calls
+}
+
+// GetItem calls GetItemFunc.
+func (mock *LibraryAPIMock) GetItem(ctx context.Context, ratingKey int, opts ...operations.Option) (*plexgo.Metadata, error) {
+	if mock.GetItemFunc == nil {
+		panic("LibraryAPIMock.GetItemFunc: method is nil but LibraryAPI.GetItem was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockGetItem.Lock()
+	mock.calls.GetItem = append(mock.calls.GetItem, callInfo)
+	mock.lockGetItem.Unlock()
+	return mock.GetItemFunc(ctx, ratingKey, opts...)
+}
+
+// GetItemCalls gets all the calls that were made to GetItem.
+// Check the length with:
+//
+//	len(mockedLibrary.GetItemCalls())
+func (mock *LibraryAPIMock) GetItemCalls() []struct {
+	Ctx       context.Context
+	RatingKey int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int
+		Opts      []operations.Option
+	}
+	mock.lockGetItem.RLock()
+	calls = mock.calls.GetItem
+	mock.lockGetItem.RUnlock()
+	return calls
+}
+
+// GetItems calls GetItemsFunc.
+func (mock *LibraryAPIMock) GetItems(ctx context.Context, ratingKeys []string, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.GetItemsFunc == nil {
+		panic("LibraryAPIMock.GetItemsFunc: method is nil but LibraryAPI.GetItems was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		RatingKeys []string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		RatingKeys: ratingKeys,
+		Opts:       opts,
+	}
+	mock.lockGetItems.Lock()
+	mock.calls.GetItems = append(mock.calls.GetItems, callInfo)
+	mock.lockGetItems.Unlock()
+	return mock.GetItemsFunc(ctx, ratingKeys, opts...)
+}
+
+// GetItemsCalls gets all the calls that were made to GetItems.
+// Check the length with:
+//
+//	len(mockedLibrary.GetItemsCalls())
+func (mock *LibraryAPIMock) GetItemsCalls() []struct {
+	Ctx        context.Context
+	RatingKeys []string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RatingKeys []string
+		Opts       []operations.Option
+	}
+	mock.lockGetItems.RLock()
+	calls = mock.calls.GetItems
+	mock.lockGetItems.RUnlock()
+	return calls
+}
+
+// GetItemsByActor calls GetItemsByActorFunc.
+func (mock *LibraryAPIMock) GetItemsByActor(ctx context.Context, sectionID int, actorTagID int64, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.GetItemsByActorFunc == nil {
+		panic("LibraryAPIMock.GetItemsByActorFunc: method is nil but LibraryAPI.GetItemsByActor was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionID  int
+		ActorTagID int64
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionID:  sectionID,
+		ActorTagID: actorTagID,
+		Opts:       opts,
+	}
+	mock.lockGetItemsByActor.Lock()
+	mock.calls.GetItemsByActor = append(mock.calls.GetItemsByActor, callInfo)
+	mock.lockGetItemsByActor.Unlock()
+	return mock.GetItemsByActorFunc(ctx, sectionID, actorTagID, opts...)
+}
+
+// GetItemsByActorCalls gets all the calls that were made to GetItemsByActor.
+// Check the length with:
+//
+//	len(mockedLibrary.GetItemsByActorCalls())
+func (mock *LibraryAPIMock) GetItemsByActorCalls() []struct {
+	Ctx        context.Context
+	SectionID  int
+	ActorTagID int64
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionID  int
+		ActorTagID int64
+		Opts       []operations.Option
+	}
+	mock.lockGetItemsByActor.RLock()
+	calls = mock.calls.GetItemsByActor
+	mock.lockGetItemsByActor.RUnlock()
+	return calls
+}
+
+// GetItemsByPerson calls GetItemsByPersonFunc.
+func (mock *LibraryAPIMock) GetItemsByPerson(ctx context.Context, sectionID int, role plexgo.PersonRole, tagID int64, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.GetItemsByPersonFunc == nil {
+		panic("LibraryAPIMock.GetItemsByPersonFunc: method is nil but LibraryAPI.GetItemsByPerson was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Role      plexgo.PersonRole
+		TagID     int64
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Role:      role,
+		TagID:     tagID,
+		Opts:      opts,
+	}
+	mock.lockGetItemsByPerson.Lock()
+	mock.calls.GetItemsByPerson = append(mock.calls.GetItemsByPerson, callInfo)
+	mock.lockGetItemsByPerson.Unlock()
+	return mock.GetItemsByPersonFunc(ctx, sectionID, role, tagID, opts...)
+}
+
+// GetItemsByPersonCalls gets all the calls that were made to GetItemsByPerson.
+// Check the length with:
+//
+//	len(mockedLibrary.GetItemsByPersonCalls())
+func (mock *LibraryAPIMock) GetItemsByPersonCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Role      plexgo.PersonRole
+	TagID     int64
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Role      plexgo.PersonRole
+		TagID     int64
+		Opts      []operations.Option
+	}
+	mock.lockGetItemsByPerson.RLock()
+	calls = mock.calls.GetItemsByPerson
+	mock.lockGetItemsByPerson.RUnlock()
+	return calls
+}
+
+// GetLibraryDetails calls GetLibraryDetailsFunc.
+func (mock *LibraryAPIMock) GetLibraryDetails(ctx context.Context, sectionKey int, includeDetails *operations.IncludeDetails, opts ...operations.Option) (*operations.GetLibraryDetailsResponse, error) {
+	if mock.GetLibraryDetailsFunc == nil {
+		panic("LibraryAPIMock.GetLibraryDetailsFunc: method is nil but LibraryAPI.GetLibraryDetails was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		SectionKey     int
+		IncludeDetails *operations.IncludeDetails
+		Opts           []operations.Option
+	}{
+		Ctx:            ctx,
+		SectionKey:     sectionKey,
+		IncludeDetails: includeDetails,
+		Opts:           opts,
+	}
+	mock.lockGetLibraryDetails.Lock()
+	mock.calls.GetLibraryDetails = append(mock.calls.GetLibraryDetails, callInfo)
+	mock.lockGetLibraryDetails.Unlock()
+	return mock.GetLibraryDetailsFunc(ctx, sectionKey, includeDetails, opts...)
+}
+
+// GetLibraryDetailsCalls gets all the calls that were made to GetLibraryDetails.
+// Check the length with:
+//
+//	len(mockedLibrary.GetLibraryDetailsCalls())
+func (mock *LibraryAPIMock) GetLibraryDetailsCalls() []struct {
+	Ctx            context.Context
+	SectionKey     int
+	IncludeDetails *operations.IncludeDetails
+	Opts           []operations.Option
+} {
+	var calls []struct {
+		Ctx            context.Context
+		SectionKey     int
+		IncludeDetails *operations.IncludeDetails
+		Opts           []operations.Option
+	}
+	mock.lockGetLibraryDetails.RLock()
+	calls = mock.calls.GetLibraryDetails
+	mock.lockGetLibraryDetails.RUnlock()
+	return calls
+}
+
+// GetLibraryItems calls GetLibraryItemsFunc.
+func (mock *LibraryAPIMock) GetLibraryItems(ctx context.Context, request operations.GetLibraryItemsRequest, opts ...operations.Option) (*operations.GetLibraryItemsResponse, error) {
+	if mock.GetLibraryItemsFunc == nil {
+		panic("LibraryAPIMock.GetLibraryItemsFunc: method is nil but LibraryAPI.GetLibraryItems was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetLibraryItemsRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetLibraryItems.Lock()
+	mock.calls.GetLibraryItems = append(mock.calls.GetLibraryItems, callInfo)
+	mock.lockGetLibraryItems.Unlock()
+	return mock.GetLibraryItemsFunc(ctx, request, opts...)
+}
+
+// GetLibraryItemsCalls gets all the calls that were made to GetLibraryItems.
+// Check the length with:
+//
+//	len(mockedLibrary.GetLibraryItemsCalls())
+func (mock *LibraryAPIMock) GetLibraryItemsCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetLibraryItemsRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetLibraryItemsRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetLibraryItems.RLock()
+	calls = mock.calls.GetLibraryItems
+	mock.lockGetLibraryItems.RUnlock()
+	return calls
+}
+
+// GetLocalizedMetadata calls GetLocalizedMetadataFunc.
+func (mock *LibraryAPIMock) GetLocalizedMetadata(ctx context.Context, ratingKey int, languages []string, opts ...operations.Option) ([]plexgo.LocalizedMetadata, error) {
+	if mock.GetLocalizedMetadataFunc == nil {
+		panic("LibraryAPIMock.GetLocalizedMetadataFunc: method is nil but LibraryAPI.GetLocalizedMetadata was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int
+		Languages []string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Languages: languages,
+		Opts:      opts,
+	}
+	mock.lockGetLocalizedMetadata.Lock()
+	mock.calls.GetLocalizedMetadata = append(mock.calls.GetLocalizedMetadata, callInfo)
+	mock.lockGetLocalizedMetadata.Unlock()
+	return mock.GetLocalizedMetadataFunc(ctx, ratingKey, languages, opts...)
+}
+
+// GetLocalizedMetadataCalls gets all the calls that were made to GetLocalizedMetadata.
+// Check the length with:
+//
+//	len(mockedLibrary.GetLocalizedMetadataCalls())
+func (mock *LibraryAPIMock) GetLocalizedMetadataCalls() []struct {
+	Ctx       context.Context
+	RatingKey int
+	Languages []string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int
+		Languages []string
+		Opts      []operations.Option
+	}
+	mock.lockGetLocalizedMetadata.RLock()
+	calls = mock.calls.GetLocalizedMetadata
+	mock.lockGetLocalizedMetadata.RUnlock()
+	return calls
+}
+
+// GetMediaArts calls GetMediaArtsFunc.
+func (mock *LibraryAPIMock) GetMediaArts(ctx context.Context, ratingKey int64, opts ...operations.Option) (*operations.GetMediaArtsResponse, error) {
+	if mock.GetMediaArtsFunc == nil {
+		panic("LibraryAPIMock.GetMediaArtsFunc: method is nil but LibraryAPI.GetMediaArts was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int64
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockGetMediaArts.Lock()
+	mock.calls.GetMediaArts = append(mock.calls.GetMediaArts, callInfo)
+	mock.lockGetMediaArts.Unlock()
+	return mock.GetMediaArtsFunc(ctx, ratingKey, opts...)
+}
+
+// GetMediaArtsCalls gets all the calls that were made to GetMediaArts.
+// Check the length with:
+//
+//	len(mockedLibrary.GetMediaArtsCalls())
+func (mock *LibraryAPIMock) GetMediaArtsCalls() []struct {
+	Ctx       context.Context
+	RatingKey int64
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int64
+		Opts      []operations.Option
+	}
+	mock.lockGetMediaArts.RLock()
+	calls = mock.calls.GetMediaArts
+	mock.lockGetMediaArts.RUnlock()
+	return calls
+}
+
+// GetMediaMetaData calls GetMediaMetaDataFunc.
+func (mock *LibraryAPIMock) GetMediaMetaData(ctx context.Context, request operations.GetMediaMetaDataRequest, opts ...operations.Option) (*operations.GetMediaMetaDataResponse, error) {
+	if mock.GetMediaMetaDataFunc == nil {
+		panic("LibraryAPIMock.GetMediaMetaDataFunc: method is nil but LibraryAPI.GetMediaMetaData was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetMediaMetaDataRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetMediaMetaData.Lock()
+	mock.calls.GetMediaMetaData = append(mock.calls.GetMediaMetaData, callInfo)
+	mock.lockGetMediaMetaData.Unlock()
+	return mock.GetMediaMetaDataFunc(ctx, request, opts...)
+}
+
+// GetMediaMetaDataCalls gets all the calls that were made to GetMediaMetaData.
+// Check the length with:
+//
+//	len(mockedLibrary.GetMediaMetaDataCalls())
+func (mock *LibraryAPIMock) GetMediaMetaDataCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetMediaMetaDataRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetMediaMetaDataRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetMediaMetaData.RLock()
+	calls = mock.calls.GetMediaMetaData
+	mock.lockGetMediaMetaData.RUnlock()
+	return calls
+}
+
+// GetMediaPosters calls GetMediaPostersFunc.
+func (mock *LibraryAPIMock) GetMediaPosters(ctx context.Context, ratingKey int64, opts ...operations.Option) (*operations.GetMediaPostersResponse, error) {
+	if mock.GetMediaPostersFunc == nil {
+		panic("LibraryAPIMock.GetMediaPostersFunc: method is nil but LibraryAPI.GetMediaPosters was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int64
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockGetMediaPosters.Lock()
+	mock.calls.GetMediaPosters = append(mock.calls.GetMediaPosters, callInfo)
+	mock.lockGetMediaPosters.Unlock()
+	return mock.GetMediaPostersFunc(ctx, ratingKey, opts...)
+}
+
+// GetMediaPostersCalls gets all the calls that were made to GetMediaPosters.
+// Check the length with:
+//
+//	len(mockedLibrary.GetMediaPostersCalls())
+func (mock *LibraryAPIMock) GetMediaPostersCalls() []struct {
+	Ctx       context.Context
+	RatingKey int64
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int64
+		Opts      []operations.Option
+	}
+	mock.lockGetMediaPosters.RLock()
+	calls = mock.calls.GetMediaPosters
+	mock.lockGetMediaPosters.RUnlock()
+	return calls
+}
+
+// GetMediaTagURL calls GetMediaTagURLFunc.
+func (mock *LibraryAPIMock) GetMediaTagURL(ctx context.Context, info plexgo.ContainerInfo, kind string, value string, opts ...operations.Option) (string, error) {
+	if mock.GetMediaTagURLFunc == nil {
+		panic("LibraryAPIMock.GetMediaTagURLFunc: method is nil but LibraryAPI.GetMediaTagURL was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Info  plexgo.ContainerInfo
+		Kind  string
+		Value string
+		Opts  []operations.Option
+	}{
+		Ctx:   ctx,
+		Info:  info,
+		Kind:  kind,
+		Value: value,
+		Opts:  opts,
+	}
+	mock.lockGetMediaTagURL.Lock()
+	mock.calls.GetMediaTagURL = append(mock.calls.GetMediaTagURL, callInfo)
+	mock.lockGetMediaTagURL.Unlock()
+	return mock.GetMediaTagURLFunc(ctx, info, kind, value, opts...)
+}
+
+// GetMediaTagURLCalls gets all the calls that were made to GetMediaTagURL.
+// Check the length with:
+//
+//	len(mockedLibrary.GetMediaTagURLCalls())
+func (mock *LibraryAPIMock) GetMediaTagURLCalls() []struct {
+	Ctx   context.Context
+	Info  plexgo.ContainerInfo
+	Kind  string
+	Value string
+	Opts  []operations.Option
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Info  plexgo.ContainerInfo
+		Kind  string
+		Value string
+		Opts  []operations.Option
+	}
+	mock.lockGetMediaTagURL.RLock()
+	calls = mock.calls.GetMediaTagURL
+	mock.lockGetMediaTagURL.RUnlock()
+	return calls
+}
+
+// GetMetadataChildren calls GetMetadataChildrenFunc.
+func (mock *LibraryAPIMock) GetMetadataChildren(ctx context.Context, ratingKey float64, includeElements *string, opts ...operations.Option) (*operations.GetMetadataChildrenResponse, error) {
+	if mock.GetMetadataChildrenFunc == nil {
+		panic("LibraryAPIMock.GetMetadataChildrenFunc: method is nil but LibraryAPI.GetMetadataChildren was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		RatingKey       float64
+		IncludeElements *string
+		Opts            []operations.Option
+	}{
+		Ctx:             ctx,
+		RatingKey:       ratingKey,
+		IncludeElements: includeElements,
+		Opts:            opts,
+	}
+	mock.lockGetMetadataChildren.Lock()
+	mock.calls.GetMetadataChildren = append(mock.calls.GetMetadataChildren, callInfo)
+	mock.lockGetMetadataChildren.Unlock()
+	return mock.GetMetadataChildrenFunc(ctx, ratingKey, includeElements, opts...)
+}
+
+// GetMetadataChildrenCalls gets all the calls that were made to GetMetadataChildren.
+// Check the length with:
+//
+//	len(mockedLibrary.GetMetadataChildrenCalls())
+func (mock *LibraryAPIMock) GetMetadataChildrenCalls() []struct {
+	Ctx             context.Context
+	RatingKey       float64
+	IncludeElements *string
+	Opts            []operations.Option
+} {
+	var calls []struct {
+		Ctx             context.Context
+		RatingKey       float64
+		IncludeElements *string
+		Opts            []operations.Option
+	}
+	mock.lockGetMetadataChildren.RLock()
+	calls = mock.calls.GetMetadataChildren
+	mock.lockGetMetadataChildren.RUnlock()
+	return calls
+}
+
+// GetPeople calls GetPeopleFunc.
+func (mock *LibraryAPIMock) GetPeople(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.Tag, error) {
+	if mock.GetPeopleFunc == nil {
+		panic("LibraryAPIMock.GetPeopleFunc: method is nil but LibraryAPI.GetPeople was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockGetPeople.Lock()
+	mock.calls.GetPeople = append(mock.calls.GetPeople, callInfo)
+	mock.lockGetPeople.Unlock()
+	return mock.GetPeopleFunc(ctx, sectionID, opts...)
+}
+
+// GetPeopleCalls gets all the calls that were made to GetPeople.
+// Check the length with:
+//
+//	len(mockedLibrary.GetPeopleCalls())
+func (mock *LibraryAPIMock) GetPeopleCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockGetPeople.RLock()
+	calls = mock.calls.GetPeople
+	mock.lockGetPeople.RUnlock()
+	return calls
+}
+
+// GetPeopleByRole calls GetPeopleByRoleFunc.
+func (mock *LibraryAPIMock) GetPeopleByRole(ctx context.Context, sectionID int, role plexgo.PersonRole, opts ...operations.Option) ([]plexgo.Tag, error) {
+	if mock.GetPeopleByRoleFunc == nil {
+		panic("LibraryAPIMock.GetPeopleByRoleFunc: method is nil but LibraryAPI.GetPeopleByRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Role      plexgo.PersonRole
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Role:      role,
+		Opts:      opts,
+	}
+	mock.lockGetPeopleByRole.Lock()
+	mock.calls.GetPeopleByRole = append(mock.calls.GetPeopleByRole, callInfo)
+	mock.lockGetPeopleByRole.Unlock()
+	return mock.GetPeopleByRoleFunc(ctx, sectionID, role, opts...)
+}
+
+// GetPeopleByRoleCalls gets all the calls that were made to GetPeopleByRole.
+// Check the length with:
+//
+//	len(mockedLibrary.GetPeopleByRoleCalls())
+func (mock *LibraryAPIMock) GetPeopleByRoleCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Role      plexgo.PersonRole
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Role      plexgo.PersonRole
+		Opts      []operations.Option
+	}
+	mock.lockGetPeopleByRole.RLock()
+	calls = mock.calls.GetPeopleByRole
+	mock.lockGetPeopleByRole.RUnlock()
+	return calls
+}
+
+// GetRandomItem calls GetRandomItemFunc.
+func (mock *LibraryAPIMock) GetRandomItem(ctx context.Context, sectionID int, filter plexgo.FilterNode, opts ...operations.Option) (*plexgo.Metadata, error) {
+	if mock.GetRandomItemFunc == nil {
+		panic("LibraryAPIMock.GetRandomItemFunc: method is nil but LibraryAPI.GetRandomItem was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Filter    plexgo.FilterNode
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Filter:    filter,
+		Opts:      opts,
+	}
+	mock.lockGetRandomItem.Lock()
+	mock.calls.GetRandomItem = append(mock.calls.GetRandomItem, callInfo)
+	mock.lockGetRandomItem.Unlock()
+	return mock.GetRandomItemFunc(ctx, sectionID, filter, opts...)
+}
+
+// GetRandomItemCalls gets all the calls that were made to GetRandomItem.
+// Check the length with:
+//
+//	len(mockedLibrary.GetRandomItemCalls())
+func (mock *LibraryAPIMock) GetRandomItemCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Filter    plexgo.FilterNode
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Filter    plexgo.FilterNode
+		Opts      []operations.Option
+	}
+	mock.lockGetRandomItem.RLock()
+	calls = mock.calls.GetRandomItem
+	mock.lockGetRandomItem.RUnlock()
+	return calls
+}
+
+// GetRecentlyAddedLibrary calls GetRecentlyAddedLibraryFunc.
+func (mock *LibraryAPIMock) GetRecentlyAddedLibrary(ctx context.Context, request operations.GetRecentlyAddedLibraryRequest, opts ...operations.Option) (*operations.GetRecentlyAddedLibraryResponse, error) {
+	if mock.GetRecentlyAddedLibraryFunc == nil {
+		panic("LibraryAPIMock.GetRecentlyAddedLibraryFunc: method is nil but LibraryAPI.GetRecentlyAddedLibrary was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetRecentlyAddedLibraryRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetRecentlyAddedLibrary.Lock()
+	mock.calls.GetRecentlyAddedLibrary = append(mock.calls.GetRecentlyAddedLibrary, callInfo)
+	mock.lockGetRecentlyAddedLibrary.Unlock()
+	return mock.GetRecentlyAddedLibraryFunc(ctx, request, opts...)
+}
+
+// GetRecentlyAddedLibraryCalls gets all the calls that were made to GetRecentlyAddedLibrary.
+// Check the length with:
+//
+//	len(mockedLibrary.GetRecentlyAddedLibraryCalls())
+func (mock *LibraryAPIMock) GetRecentlyAddedLibraryCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetRecentlyAddedLibraryRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetRecentlyAddedLibraryRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetRecentlyAddedLibrary.RLock()
+	calls = mock.calls.GetRecentlyAddedLibrary
+	mock.lockGetRecentlyAddedLibrary.RUnlock()
+	return calls
+}
+
+// GetRefreshLibraryMetadata calls GetRefreshLibraryMetadataFunc.
+func (mock *LibraryAPIMock) GetRefreshLibraryMetadata(ctx context.Context, sectionKey int, force *operations.Force, opts ...operations.Option) (*operations.GetRefreshLibraryMetadataResponse, error) {
+	if mock.GetRefreshLibraryMetadataFunc == nil {
+		panic("LibraryAPIMock.GetRefreshLibraryMetadataFunc: method is nil but LibraryAPI.GetRefreshLibraryMetadata was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionKey int
+		Force      *operations.Force
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionKey: sectionKey,
+		Force:      force,
+		Opts:       opts,
+	}
+	mock.lockGetRefreshLibraryMetadata.Lock()
+	mock.calls.GetRefreshLibraryMetadata = append(mock.calls.GetRefreshLibraryMetadata, callInfo)
+	mock.lockGetRefreshLibraryMetadata.Unlock()
+	return mock.GetRefreshLibraryMetadataFunc(ctx, sectionKey, force, opts...)
+}
+
+// GetRefreshLibraryMetadataCalls gets all the calls that were made to GetRefreshLibraryMetadata.
+// Check the length with:
+//
+//	len(mockedLibrary.GetRefreshLibraryMetadataCalls())
+func (mock *LibraryAPIMock) GetRefreshLibraryMetadataCalls() []struct {
+	Ctx        context.Context
+	SectionKey int
+	Force      *operations.Force
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionKey int
+		Force      *operations.Force
+		Opts       []operations.Option
+	}
+	mock.lockGetRefreshLibraryMetadata.RLock()
+	calls = mock.calls.GetRefreshLibraryMetadata
+	mock.lockGetRefreshLibraryMetadata.RUnlock()
+	return calls
+}
+
+// GetRefreshStatus calls GetRefreshStatusFunc.
+func (mock *LibraryAPIMock) GetRefreshStatus(ctx context.Context, sectionID int, opts ...operations.Option) (*plexgo.RefreshStatus, error) {
+	if mock.GetRefreshStatusFunc == nil {
+		panic("LibraryAPIMock.GetRefreshStatusFunc: method is nil but LibraryAPI.GetRefreshStatus was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockGetRefreshStatus.Lock()
+	mock.calls.GetRefreshStatus = append(mock.calls.GetRefreshStatus, callInfo)
+	mock.lockGetRefreshStatus.Unlock()
+	return mock.GetRefreshStatusFunc(ctx, sectionID, opts...)
+}
+
+// GetRefreshStatusCalls gets all the calls that were made to GetRefreshStatus.
+// Check the length with:
+//
+//	len(mockedLibrary.GetRefreshStatusCalls())
+func (mock *LibraryAPIMock) GetRefreshStatusCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockGetRefreshStatus.RLock()
+	calls = mock.calls.GetRefreshStatus
+	mock.lockGetRefreshStatus.RUnlock()
+	return calls
+}
+
+// GetSearchAllLibraries calls GetSearchAllLibrariesFunc.
+func (mock *LibraryAPIMock) GetSearchAllLibraries(ctx context.Context, request operations.GetSearchAllLibrariesRequest, opts ...operations.Option) (*operations.GetSearchAllLibrariesResponse, error) {
+	if mock.GetSearchAllLibrariesFunc == nil {
+		panic("LibraryAPIMock.GetSearchAllLibrariesFunc: method is nil but LibraryAPI.GetSearchAllLibraries was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetSearchAllLibrariesRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetSearchAllLibraries.Lock()
+	mock.calls.GetSearchAllLibraries = append(mock.calls.GetSearchAllLibraries, callInfo)
+	mock.lockGetSearchAllLibraries.Unlock()
+	return mock.GetSearchAllLibrariesFunc(ctx, request, opts...)
+}
+
+// GetSearchAllLibrariesCalls gets all the calls that were made to GetSearchAllLibraries.
+// Check the length with:
+//
+//	len(mockedLibrary.GetSearchAllLibrariesCalls())
+func (mock *LibraryAPIMock) GetSearchAllLibrariesCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetSearchAllLibrariesRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetSearchAllLibrariesRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetSearchAllLibraries.RLock()
+	calls = mock.calls.GetSearchAllLibraries
+	mock.lockGetSearchAllLibraries.RUnlock()
+	return calls
+}
+
+// GetSearchLibrary calls GetSearchLibraryFunc.
+func (mock *LibraryAPIMock) GetSearchLibrary(ctx context.Context, sectionKey int, type_ operations.GetSearchLibraryQueryParamType, opts ...operations.Option) (*operations.GetSearchLibraryResponse, error) {
+	if mock.GetSearchLibraryFunc == nil {
+		panic("LibraryAPIMock.GetSearchLibraryFunc: method is nil but LibraryAPI.GetSearchLibrary was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionKey int
+		Type_      operations.GetSearchLibraryQueryParamType
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionKey: sectionKey,
+		Type_:      type_,
+		Opts:       opts,
+	}
+	mock.lockGetSearchLibrary.Lock()
+	mock.calls.GetSearchLibrary = append(mock.calls.GetSearchLibrary, callInfo)
+	mock.lockGetSearchLibrary.Unlock()
+	return mock.GetSearchLibraryFunc(ctx, sectionKey, type_, opts...)
+}
+
+// GetSearchLibraryCalls gets all the calls that were made to GetSearchLibrary.
+// Check the length with:
+//
+//	len(mockedLibrary.GetSearchLibraryCalls())
+func (mock *LibraryAPIMock) GetSearchLibraryCalls() []struct {
+	Ctx        context.Context
+	SectionKey int
+	Type_      operations.GetSearchLibraryQueryParamType
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionKey int
+		Type_      operations.GetSearchLibraryQueryParamType
+		Opts       []operations.Option
+	}
+	mock.lockGetSearchLibrary.RLock()
+	calls = mock.calls.GetSearchLibrary
+	mock.lockGetSearchLibrary.RUnlock()
+	return calls
+}
+
+// GetShowPreferences calls GetShowPreferencesFunc.
+func (mock *LibraryAPIMock) GetShowPreferences(ctx context.Context, ratingKey int, opts ...operations.Option) (*plexgo.ShowPreferences, error) {
+	if mock.GetShowPreferencesFunc == nil {
+		panic("LibraryAPIMock.GetShowPreferencesFunc: method is nil but LibraryAPI.GetShowPreferences was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockGetShowPreferences.Lock()
+	mock.calls.GetShowPreferences = append(mock.calls.GetShowPreferences, callInfo)
+	mock.lockGetShowPreferences.Unlock()
+	return mock.GetShowPreferencesFunc(ctx, ratingKey, opts...)
+}
+
+// GetShowPreferencesCalls gets all the calls that were made to GetShowPreferences.
+// Check the length with:
+//
+//	len(mockedLibrary.GetShowPreferencesCalls())
+func (mock *LibraryAPIMock) GetShowPreferencesCalls() []struct {
+	Ctx       context.Context
+	RatingKey int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int
+		Opts      []operations.Option
+	}
+	mock.lockGetShowPreferences.RLock()
+	calls = mock.calls.GetShowPreferences
+	mock.lockGetShowPreferences.RUnlock()
+	return calls
+}
+
+// GetShowProgress calls GetShowProgressFunc.
+func (mock *LibraryAPIMock) GetShowProgress(ctx context.Context, ratingKey int, opts ...operations.Option) (*plexgo.ShowProgress, error) {
+	if mock.GetShowProgressFunc == nil {
+		panic("LibraryAPIMock.GetShowProgressFunc: method is nil but LibraryAPI.GetShowProgress was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockGetShowProgress.Lock()
+	mock.calls.GetShowProgress = append(mock.calls.GetShowProgress, callInfo)
+	mock.lockGetShowProgress.Unlock()
+	return mock.GetShowProgressFunc(ctx, ratingKey, opts...)
+}
+
+// GetShowProgressCalls gets all the calls that were made to GetShowProgress.
+// Check the length with:
+//
+//	len(mockedLibrary.GetShowProgressCalls())
+func (mock *LibraryAPIMock) GetShowProgressCalls() []struct {
+	Ctx       context.Context
+	RatingKey int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int
+		Opts      []operations.Option
+	}
+	mock.lockGetShowProgress.RLock()
+	calls = mock.calls.GetShowProgress
+	mock.lockGetShowProgress.RUnlock()
+	return calls
+}
+
+// GetSonicallySimilar calls GetSonicallySimilarFunc.
+func (mock *LibraryAPIMock) GetSonicallySimilar(ctx context.Context, trackRatingKey int, limit int, maxDistance float64, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.GetSonicallySimilarFunc == nil {
+		panic("LibraryAPIMock.GetSonicallySimilarFunc: method is nil but LibraryAPI.GetSonicallySimilar was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		TrackRatingKey int
+		Limit          int
+		MaxDistance    float64
+		Opts           []operations.Option
+	}{
+		Ctx:            ctx,
+		TrackRatingKey: trackRatingKey,
+		Limit:          limit,
+		MaxDistance:    maxDistance,
+		Opts:           opts,
+	}
+	mock.lockGetSonicallySimilar.Lock()
+	mock.calls.GetSonicallySimilar = append(mock.calls.GetSonicallySimilar, callInfo)
+	mock.lockGetSonicallySimilar.Unlock()
+	return mock.GetSonicallySimilarFunc(ctx, trackRatingKey, limit, maxDistance, opts...)
+}
+
+// GetSonicallySimilarCalls gets all the calls that were made to GetSonicallySimilar.
+// Check the length with:
+//
+//	len(mockedLibrary.GetSonicallySimilarCalls())
+func (mock *LibraryAPIMock) GetSonicallySimilarCalls() []struct {
+	Ctx            context.Context
+	TrackRatingKey int
+	Limit          int
+	MaxDistance    float64
+	Opts           []operations.Option
+} {
+	var calls []struct {
+		Ctx            context.Context
+		TrackRatingKey int
+		Limit          int
+		MaxDistance    float64
+		Opts           []operations.Option
+	}
+	mock.lockGetSonicallySimilar.RLock()
+	calls = mock.calls.GetSonicallySimilar
+	mock.lockGetSonicallySimilar.RUnlock()
+	return calls
+}
+
+// GetStorageReport calls GetStorageReportFunc.
+func (mock *LibraryAPIMock) GetStorageReport(ctx context.Context, sectionID int, opts ...operations.Option) (*plexgo.StorageReport, error) {
+	if mock.GetStorageReportFunc == nil {
+		panic("LibraryAPIMock.GetStorageReportFunc: method is nil but LibraryAPI.GetStorageReport was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockGetStorageReport.Lock()
+	mock.calls.GetStorageReport = append(mock.calls.GetStorageReport, callInfo)
+	mock.lockGetStorageReport.Unlock()
+	return mock.GetStorageReportFunc(ctx, sectionID, opts...)
+}
+
+// GetStorageReportCalls gets all the calls that were made to GetStorageReport.
+// Check the length with:
+//
+//	len(mockedLibrary.GetStorageReportCalls())
+func (mock *LibraryAPIMock) GetStorageReportCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockGetStorageReport.RLock()
+	calls = mock.calls.GetStorageReport
+	mock.lockGetStorageReport.RUnlock()
+	return calls
+}
+
+// GetTopWatchedContent calls GetTopWatchedContentFunc.
+func (mock *LibraryAPIMock) GetTopWatchedContent(ctx context.Context, type_ operations.GetTopWatchedContentQueryParamType, includeGuids *int64, opts ...operations.Option) (*operations.GetTopWatchedContentResponse, error) {
+	if mock.GetTopWatchedContentFunc == nil {
+		panic("LibraryAPIMock.GetTopWatchedContentFunc: method is nil but LibraryAPI.GetTopWatchedContent was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Type_        operations.GetTopWatchedContentQueryParamType
+		IncludeGuids *int64
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		Type_:        type_,
+		IncludeGuids: includeGuids,
+		Opts:         opts,
+	}
+	mock.lockGetTopWatchedContent.Lock()
+	mock.calls.GetTopWatchedContent = append(mock.calls.GetTopWatchedContent, callInfo)
+	mock.lockGetTopWatchedContent.Unlock()
+	return mock.GetTopWatchedContentFunc(ctx, type_, includeGuids, opts...)
+}
+
+// GetTopWatchedContentCalls gets all the calls that were made to GetTopWatchedContent.
+// Check the length with:
+//
+//	len(mockedLibrary.GetTopWatchedContentCalls())
+func (mock *LibraryAPIMock) GetTopWatchedContentCalls() []struct {
+	Ctx          context.Context
+	Type_        operations.GetTopWatchedContentQueryParamType
+	IncludeGuids *int64
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Type_        operations.GetTopWatchedContentQueryParamType
+		IncludeGuids *int64
+		Opts         []operations.Option
+	}
+	mock.lockGetTopWatchedContent.RLock()
+	calls = mock.calls.GetTopWatchedContent
+	mock.lockGetTopWatchedContent.RUnlock()
+	return calls
+}
+
+// ItemsPage calls ItemsPageFunc.
+func (mock *LibraryAPIMock) ItemsPage(ctx context.Context, sectionID int, listOptions plexgo.ItemListOptions, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error) {
+	if mock.ItemsPageFunc == nil {
+		panic("LibraryAPIMock.ItemsPageFunc: method is nil but LibraryAPI.ItemsPage was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.ItemListOptions
+		Cursor      plexgo.Cursor
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		ListOptions: listOptions,
+		Cursor:      cursor,
+		Opts:        opts,
+	}
+	mock.lockItemsPage.Lock()
+	mock.calls.ItemsPage = append(mock.calls.ItemsPage, callInfo)
+	mock.lockItemsPage.Unlock()
+	return mock.ItemsPageFunc(ctx, sectionID, listOptions, cursor, opts...)
+}
+
+// ItemsPageCalls gets all the calls that were made to ItemsPage.
+// Check the length with:
+//
+//	len(mockedLibrary.ItemsPageCalls())
+func (mock *LibraryAPIMock) ItemsPageCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	ListOptions plexgo.ItemListOptions
+	Cursor      plexgo.Cursor
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.ItemListOptions
+		Cursor      plexgo.Cursor
+		Opts        []operations.Option
+	}
+	mock.lockItemsPage.RLock()
+	calls = mock.calls.ItemsPage
+	mock.lockItemsPage.RUnlock()
+	return calls
+}
+
+// ListItems calls ListItemsFunc.
+func (mock *LibraryAPIMock) ListItems(ctx context.Context, sectionID int, listOptions plexgo.ItemListOptions, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.ListItemsFunc == nil {
+		panic("LibraryAPIMock.ListItemsFunc: method is nil but LibraryAPI.ListItems was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.ItemListOptions
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		ListOptions: listOptions,
+		Opts:        opts,
+	}
+	mock.lockListItems.Lock()
+	mock.calls.ListItems = append(mock.calls.ListItems, callInfo)
+	mock.lockListItems.Unlock()
+	return mock.ListItemsFunc(ctx, sectionID, listOptions, opts...)
+}
+
+// ListItemsCalls gets all the calls that were made to ListItems.
+// Check the length with:
+//
+//	len(mockedLibrary.ListItemsCalls())
+func (mock *LibraryAPIMock) ListItemsCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	ListOptions plexgo.ItemListOptions
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.ItemListOptions
+		Opts        []operations.Option
+	}
+	mock.lockListItems.RLock()
+	calls = mock.calls.ListItems
+	mock.lockListItems.RUnlock()
+	return calls
+}
+
+// ListItemsPage calls ListItemsPageFunc.
+func (mock *LibraryAPIMock) ListItemsPage(ctx context.Context, sectionID int, listOptions plexgo.ItemListOptions, start int, opts ...operations.Option) (*plexgo.MetadataMediaContainer, error) {
+	if mock.ListItemsPageFunc == nil {
+		panic("LibraryAPIMock.ListItemsPageFunc: method is nil but LibraryAPI.ListItemsPage was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.ItemListOptions
+		Start       int
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		ListOptions: listOptions,
+		Start:       start,
+		Opts:        opts,
+	}
+	mock.lockListItemsPage.Lock()
+	mock.calls.ListItemsPage = append(mock.calls.ListItemsPage, callInfo)
+	mock.lockListItemsPage.Unlock()
+	return mock.ListItemsPageFunc(ctx, sectionID, listOptions, start, opts...)
+}
+
+// ListItemsPageCalls gets all the calls that were made to ListItemsPage.
+// Check the length with:
+//
+//	len(mockedLibrary.ListItemsPageCalls())
+func (mock *LibraryAPIMock) ListItemsPageCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	ListOptions plexgo.ItemListOptions
+	Start       int
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.ItemListOptions
+		Start       int
+		Opts        []operations.Option
+	}
+	mock.lockListItemsPage.RLock()
+	calls = mock.calls.ListItemsPage
+	mock.lockListItemsPage.RUnlock()
+	return calls
+}
+
+// LockFields calls LockFieldsFunc.
+func (mock *LibraryAPIMock) LockFields(ctx context.Context, ratingKeys []string, fields ...string) error {
+	if mock.LockFieldsFunc == nil {
+		panic("LibraryAPIMock.LockFieldsFunc: method is nil but LibraryAPI.LockFields was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		RatingKeys []string
+		Fields     []string
+	}{
+		Ctx:        ctx,
+		RatingKeys: ratingKeys,
+		Fields:     fields,
+	}
+	mock.lockLockFields.Lock()
+	mock.calls.LockFields = append(mock.calls.LockFields, callInfo)
+	mock.lockLockFields.Unlock()
+	return mock.LockFieldsFunc(ctx, ratingKeys, fields...)
+}
+
+// LockFieldsCalls gets all the calls that were made to LockFields.
+// Check the length with:
+//
+//	len(mockedLibrary.LockFieldsCalls())
+func (mock *LibraryAPIMock) LockFieldsCalls() []struct {
+	Ctx        context.Context
+	RatingKeys []string
+	Fields     []string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RatingKeys []string
+		Fields     []string
+	}
+	mock.lockLockFields.RLock()
+	calls = mock.calls.LockFields
+	mock.lockLockFields.RUnlock()
+	return calls
+}
+
+// MigrateAgent calls MigrateAgentFunc.
+func (mock *LibraryAPIMock) MigrateAgent(ctx context.Context, sectionID int, targetAgent string, opts ...operations.Option) (*plexgo.AgentMigration, error) {
+	if mock.MigrateAgentFunc == nil {
+		panic("LibraryAPIMock.MigrateAgentFunc: method is nil but LibraryAPI.MigrateAgent was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		TargetAgent string
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		TargetAgent: targetAgent,
+		Opts:        opts,
+	}
+	mock.lockMigrateAgent.Lock()
+	mock.calls.MigrateAgent = append(mock.calls.MigrateAgent, callInfo)
+	mock.lockMigrateAgent.Unlock()
+	return mock.MigrateAgentFunc(ctx, sectionID, targetAgent, opts...)
+}
+
+// MigrateAgentCalls gets all the calls that were made to MigrateAgent.
+// Check the length with:
+//
+//	len(mockedLibrary.MigrateAgentCalls())
+func (mock *LibraryAPIMock) MigrateAgentCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	TargetAgent string
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		TargetAgent string
+		Opts        []operations.Option
+	}
+	mock.lockMigrateAgent.RLock()
+	calls = mock.calls.MigrateAgent
+	mock.lockMigrateAgent.RUnlock()
+	return calls
+}
+
+// NewItemPipeline calls NewItemPipelineFunc.
+func (mock *LibraryAPIMock) NewItemPipeline(sectionID int, rules ...plexgo.NewItemRule) *plexgo.NewItemPipeline {
+	if mock.NewItemPipelineFunc == nil {
+		panic("LibraryAPIMock.NewItemPipelineFunc: method is nil but LibraryAPI.NewItemPipeline was just called")
+	}
+	callInfo := struct {
+		SectionID int
+		Rules     []plexgo.NewItemRule
+	}{
+		SectionID: sectionID,
+		Rules:     rules,
+	}
+	mock.lockNewItemPipeline.Lock()
+	mock.calls.NewItemPipeline = append(mock.calls.NewItemPipeline, callInfo)
+	mock.lockNewItemPipeline.Unlock()
+	return mock.NewItemPipelineFunc(sectionID, rules...)
+}
+
+// NewItemPipelineCalls gets all the calls that were made to NewItemPipeline.
+// Check the length with:
+//
+//	len(mockedLibrary.NewItemPipelineCalls())
+func (mock *LibraryAPIMock) NewItemPipelineCalls() []struct {
+	SectionID int
+	Rules     []plexgo.NewItemRule
+} {
+	var calls []struct {
+		SectionID int
+		Rules     []plexgo.NewItemRule
+	}
+	mock.lockNewItemPipeline.RLock()
+	calls = mock.calls.NewItemPipeline
+	mock.lockNewItemPipeline.RUnlock()
+	return calls
+}
+
+// NewPrefetcher calls NewPrefetcherFunc.
+func (mock *LibraryAPIMock) NewPrefetcher() *plexgo.Prefetcher {
+	if mock.NewPrefetcherFunc == nil {
+		panic("LibraryAPIMock.NewPrefetcherFunc: method is nil but LibraryAPI.NewPrefetcher was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockNewPrefetcher.Lock()
+	mock.calls.NewPrefetcher = append(mock.calls.NewPrefetcher, callInfo)
+	mock.lockNewPrefetcher.Unlock()
+	return mock.NewPrefetcherFunc()
+}
+
+// NewPrefetcherCalls gets all the calls that were made to NewPrefetcher.
+// Check the length with:
+//
+//	len(mockedLibrary.NewPrefetcherCalls())
+func (mock *LibraryAPIMock) NewPrefetcherCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockNewPrefetcher.RLock()
+	calls = mock.calls.NewPrefetcher
+	mock.lockNewPrefetcher.RUnlock()
+	return calls
+}
+
+// NormalizeSortTitles calls NormalizeSortTitlesFunc.
+func (mock *LibraryAPIMock) NormalizeSortTitles(ctx context.Context, sectionID int, sortOptions plexgo.SortTitleOptions, opts ...operations.Option) ([]plexgo.SortTitleChange, error) {
+	if mock.NormalizeSortTitlesFunc == nil {
+		panic("LibraryAPIMock.NormalizeSortTitlesFunc: method is nil but LibraryAPI.NormalizeSortTitles was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		SortOptions plexgo.SortTitleOptions
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		SortOptions: sortOptions,
+		Opts:        opts,
+	}
+	mock.lockNormalizeSortTitles.Lock()
+	mock.calls.NormalizeSortTitles = append(mock.calls.NormalizeSortTitles, callInfo)
+	mock.lockNormalizeSortTitles.Unlock()
+	return mock.NormalizeSortTitlesFunc(ctx, sectionID, sortOptions, opts...)
+}
+
+// NormalizeSortTitlesCalls gets all the calls that were made to NormalizeSortTitles.
+// Check the length with:
+//
+//	len(mockedLibrary.NormalizeSortTitlesCalls())
+func (mock *LibraryAPIMock) NormalizeSortTitlesCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	SortOptions plexgo.SortTitleOptions
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		SortOptions plexgo.SortTitleOptions
+		Opts        []operations.Option
+	}
+	mock.lockNormalizeSortTitles.RLock()
+	calls = mock.calls.NormalizeSortTitles
+	mock.lockNormalizeSortTitles.RUnlock()
+	return calls
+}
+
+// PostMediaArts calls PostMediaArtsFunc.
+func (mock *LibraryAPIMock) PostMediaArts(ctx context.Context, ratingKey int64, url_ *string, requestBody *any, opts ...operations.Option) (*operations.PostMediaArtsResponse, error) {
+	if mock.PostMediaArtsFunc == nil {
+		panic("LibraryAPIMock.PostMediaArtsFunc: method is nil but LibraryAPI.PostMediaArts was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		RatingKey   int64
+		Url_        *string
+		RequestBody *any
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		RatingKey:   ratingKey,
+		Url_:        url_,
+		RequestBody: requestBody,
+		Opts:        opts,
+	}
+	mock.lockPostMediaArts.Lock()
+	mock.calls.PostMediaArts = append(mock.calls.PostMediaArts, callInfo)
+	mock.lockPostMediaArts.Unlock()
+	return mock.PostMediaArtsFunc(ctx, ratingKey, url_, requestBody, opts...)
+}
+
+// PostMediaArtsCalls gets all the calls that were made to PostMediaArts.
+// Check the length with:
+//
+//	len(mockedLibrary.PostMediaArtsCalls())
+func (mock *LibraryAPIMock) PostMediaArtsCalls() []struct {
+	Ctx         context.Context
+	RatingKey   int64
+	Url_        *string
+	RequestBody *any
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		RatingKey   int64
+		Url_        *string
+		RequestBody *any
+		Opts        []operations.Option
+	}
+	mock.lockPostMediaArts.RLock()
+	calls = mock.calls.PostMediaArts
+	mock.lockPostMediaArts.RUnlock()
+	return calls
+}
+
+// PostMediaPoster calls PostMediaPosterFunc.
+func (mock *LibraryAPIMock) PostMediaPoster(ctx context.Context, ratingKey int64, url_ *string, requestBody *any, opts ...operations.Option) (*operations.PostMediaPosterResponse, error) {
+	if mock.PostMediaPosterFunc == nil {
+		panic("LibraryAPIMock.PostMediaPosterFunc: method is nil but LibraryAPI.PostMediaPoster was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		RatingKey   int64
+		Url_        *string
+		RequestBody *any
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		RatingKey:   ratingKey,
+		Url_:        url_,
+		RequestBody: requestBody,
+		Opts:        opts,
+	}
+	mock.lockPostMediaPoster.Lock()
+	mock.calls.PostMediaPoster = append(mock.calls.PostMediaPoster, callInfo)
+	mock.lockPostMediaPoster.Unlock()
+	return mock.PostMediaPosterFunc(ctx, ratingKey, url_, requestBody, opts...)
+}
+
+// PostMediaPosterCalls gets all the calls that were made to PostMediaPoster.
+// Check the length with:
+//
+//	len(mockedLibrary.PostMediaPosterCalls())
+func (mock *LibraryAPIMock) PostMediaPosterCalls() []struct {
+	Ctx         context.Context
+	RatingKey   int64
+	Url_        *string
+	RequestBody *any
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		RatingKey   int64
+		Url_        *string
+		RequestBody *any
+		Opts        []operations.Option
+	}
+	mock.lockPostMediaPoster.RLock()
+	calls = mock.calls.PostMediaPoster
+	mock.lockPostMediaPoster.RUnlock()
+	return calls
+}
+
+// SetSectionVisibility calls SetSectionVisibilityFunc.
+func (mock *LibraryAPIMock) SetSectionVisibility(ctx context.Context, sectionID int, visibility string, opts ...operations.Option) error {
+	if mock.SetSectionVisibilityFunc == nil {
+		panic("LibraryAPIMock.SetSectionVisibilityFunc: method is nil but LibraryAPI.SetSectionVisibility was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionID  int
+		Visibility string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionID:  sectionID,
+		Visibility: visibility,
+		Opts:       opts,
+	}
+	mock.lockSetSectionVisibility.Lock()
+	mock.calls.SetSectionVisibility = append(mock.calls.SetSectionVisibility, callInfo)
+	mock.lockSetSectionVisibility.Unlock()
+	return mock.SetSectionVisibilityFunc(ctx, sectionID, visibility, opts...)
+}
+
+// SetSectionVisibilityCalls gets all the calls that were made to SetSectionVisibility.
+// Check the length with:
+//
+//	len(mockedLibrary.SetSectionVisibilityCalls())
+func (mock *LibraryAPIMock) SetSectionVisibilityCalls() []struct {
+	Ctx        context.Context
+	SectionID  int
+	Visibility string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionID  int
+		Visibility string
+		Opts       []operations.Option
+	}
+	mock.lockSetSectionVisibility.RLock()
+	calls = mock.calls.SetSectionVisibility
+	mock.lockSetSectionVisibility.RUnlock()
+	return calls
+}
+
+// SyncWatchState calls SyncWatchStateFunc.
+func (mock *LibraryAPIMock) SyncWatchState(ctx context.Context, sectionID int, history []plexgo.WatchHistoryEntry, dryRun bool, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.SyncWatchStateFunc == nil {
+		panic("LibraryAPIMock.SyncWatchStateFunc: method is nil but LibraryAPI.SyncWatchState was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		History   []plexgo.WatchHistoryEntry
+		DryRun    bool
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		History:   history,
+		DryRun:    dryRun,
+		Opts:      opts,
+	}
+	mock.lockSyncWatchState.Lock()
+	mock.calls.SyncWatchState = append(mock.calls.SyncWatchState, callInfo)
+	mock.lockSyncWatchState.Unlock()
+	return mock.SyncWatchStateFunc(ctx, sectionID, history, dryRun, opts...)
+}
+
+// SyncWatchStateCalls gets all the calls that were made to SyncWatchState.
+// Check the length with:
+//
+//	len(mockedLibrary.SyncWatchStateCalls())
+func (mock *LibraryAPIMock) SyncWatchStateCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	History   []plexgo.WatchHistoryEntry
+	DryRun    bool
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		History   []plexgo.WatchHistoryEntry
+		DryRun    bool
+		Opts      []operations.Option
+	}
+	mock.lockSyncWatchState.RLock()
+	calls = mock.calls.SyncWatchState
+	mock.lockSyncWatchState.RUnlock()
+	return calls
+}
+
+// UnlockFields calls UnlockFieldsFunc.
+func (mock *LibraryAPIMock) UnlockFields(ctx context.Context, ratingKeys []string, fields ...string) error {
+	if mock.UnlockFieldsFunc == nil {
+		panic("LibraryAPIMock.UnlockFieldsFunc: method is nil but LibraryAPI.UnlockFields was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		RatingKeys []string
+		Fields     []string
+	}{
+		Ctx:        ctx,
+		RatingKeys: ratingKeys,
+		Fields:     fields,
+	}
+	mock.lockUnlockFields.Lock()
+	mock.calls.UnlockFields = append(mock.calls.UnlockFields, callInfo)
+	mock.lockUnlockFields.Unlock()
+	return mock.UnlockFieldsFunc(ctx, ratingKeys, fields...)
+}
+
+// UnlockFieldsCalls gets all the calls that were made to UnlockFields.
+// Check the length with:
+//
+//	len(mockedLibrary.UnlockFieldsCalls())
+func (mock *LibraryAPIMock) UnlockFieldsCalls() []struct {
+	Ctx        context.Context
+	RatingKeys []string
+	Fields     []string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RatingKeys []string
+		Fields     []string
+	}
+	mock.lockUnlockFields.RLock()
+	calls = mock.calls.UnlockFields
+	mock.lockUnlockFields.RUnlock()
+	return calls
+}
+
+// UpdateSectionShowPreferences calls UpdateSectionShowPreferencesFunc.
+func (mock *LibraryAPIMock) UpdateSectionShowPreferences(ctx context.Context, sectionID int, prefs map[string]string, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.UpdateSectionShowPreferencesFunc == nil {
+		panic("LibraryAPIMock.UpdateSectionShowPreferencesFunc: method is nil but LibraryAPI.UpdateSectionShowPreferences was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Prefs     map[string]string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Prefs:     prefs,
+		Opts:      opts,
+	}
+	mock.lockUpdateSectionShowPreferences.Lock()
+	mock.calls.UpdateSectionShowPreferences = append(mock.calls.UpdateSectionShowPreferences, callInfo)
+	mock.lockUpdateSectionShowPreferences.Unlock()
+	return mock.UpdateSectionShowPreferencesFunc(ctx, sectionID, prefs, opts...)
+}
+
+// UpdateSectionShowPreferencesCalls gets all the calls that were made to UpdateSectionShowPreferences.
+// Check the length with:
+//
+//	len(mockedLibrary.UpdateSectionShowPreferencesCalls())
+func (mock *LibraryAPIMock) UpdateSectionShowPreferencesCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Prefs     map[string]string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Prefs     map[string]string
+		Opts      []operations.Option
+	}
+	mock.lockUpdateSectionShowPreferences.RLock()
+	calls = mock.calls.UpdateSectionShowPreferences
+	mock.lockUpdateSectionShowPreferences.RUnlock()
+	return calls
+}
+
+// UpdateShowPreferences calls UpdateShowPreferencesFunc.
+func (mock *LibraryAPIMock) UpdateShowPreferences(ctx context.Context, ratingKey int, prefs map[string]string, opts ...operations.Option) error {
+	if mock.UpdateShowPreferencesFunc == nil {
+		panic("LibraryAPIMock.UpdateShowPreferencesFunc: method is nil but LibraryAPI.UpdateShowPreferences was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int
+		Prefs     map[string]string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Prefs:     prefs,
+		Opts:      opts,
+	}
+	mock.lockUpdateShowPreferences.Lock()
+	mock.calls.UpdateShowPreferences = append(mock.calls.UpdateShowPreferences, callInfo)
+	mock.lockUpdateShowPreferences.Unlock()
+	return mock.UpdateShowPreferencesFunc(ctx, ratingKey, prefs, opts...)
+}
+
+// UpdateShowPreferencesCalls gets all the calls that were made to UpdateShowPreferences.
+// Check the length with:
+//
+//	len(mockedLibrary.UpdateShowPreferencesCalls())
+func (mock *LibraryAPIMock) UpdateShowPreferencesCalls() []struct {
+	Ctx       context.Context
+	RatingKey int
+	Prefs     map[string]string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int
+		Prefs     map[string]string
+		Opts      []operations.Option
+	}
+	mock.lockUpdateShowPreferences.RLock()
+	calls = mock.calls.UpdateShowPreferences
+	mock.lockUpdateShowPreferences.RUnlock()
+	return calls
+}
+
+// Upload calls UploadFunc.
+func (mock *LibraryAPIMock) Upload(ctx context.Context, sectionID int, path string, r io.Reader, opts ...operations.Option) error {
+	if mock.UploadFunc == nil {
+		panic("LibraryAPIMock.UploadFunc: method is nil but LibraryAPI.Upload was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Path      string
+		R         io.Reader
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Path:      path,
+		R:         r,
+		Opts:      opts,
+	}
+	mock.lockUpload.Lock()
+	mock.calls.Upload = append(mock.calls.Upload, callInfo)
+	mock.lockUpload.Unlock()
+	return mock.UploadFunc(ctx, sectionID, path, r, opts...)
+}
+
+// UploadCalls gets all the calls that were made to Upload.
+// Check the length with:
+//
+//	len(mockedLibrary.UploadCalls())
+func (mock *LibraryAPIMock) UploadCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Path      string
+	R         io.Reader
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Path      string
+		R         io.Reader
+		Opts      []operations.Option
+	}
+	mock.lockUpload.RLock()
+	calls = mock.calls.Upload
+	mock.lockUpload.RUnlock()
+	return calls
+}
+
+// VerifyRatingKeys calls VerifyRatingKeysFunc.
+func (mock *LibraryAPIMock) VerifyRatingKeys(ctx context.Context, ratingKeys []string, opts ...operations.Option) (*plexgo.RatingKeyAvailability, error) {
+	if mock.VerifyRatingKeysFunc == nil {
+		panic("LibraryAPIMock.VerifyRatingKeysFunc: method is nil but LibraryAPI.VerifyRatingKeys was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		RatingKeys []string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		RatingKeys: ratingKeys,
+		Opts:       opts,
+	}
+	mock.lockVerifyRatingKeys.Lock()
+	mock.calls.VerifyRatingKeys = append(mock.calls.VerifyRatingKeys, callInfo)
+	mock.lockVerifyRatingKeys.Unlock()
+	return mock.VerifyRatingKeysFunc(ctx, ratingKeys, opts...)
+}
+
+// VerifyRatingKeysCalls gets all the calls that were made to VerifyRatingKeys.
+// Check the length with:
+//
+//	len(mockedLibrary.VerifyRatingKeysCalls())
+func (mock *LibraryAPIMock) VerifyRatingKeysCalls() []struct {
+	Ctx        context.Context
+	RatingKeys []string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RatingKeys []string
+		Opts       []operations.Option
+	}
+	mock.lockVerifyRatingKeys.RLock()
+	calls = mock.calls.VerifyRatingKeys
+	mock.lockVerifyRatingKeys.RUnlock()
+	return calls
+}
+
+// WaitForScanComplete calls WaitForScanCompleteFunc.
+func (mock *LibraryAPIMock) WaitForScanComplete(ctx context.Context, sectionID int, timeout time.Duration, opts ...operations.Option) error {
+	if mock.WaitForScanCompleteFunc == nil {
+		panic("LibraryAPIMock.WaitForScanCompleteFunc: method is nil but LibraryAPI.WaitForScanComplete was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Timeout   time.Duration
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Timeout:   timeout,
+		Opts:      opts,
+	}
+	mock.lockWaitForScanComplete.Lock()
+	mock.calls.WaitForScanComplete = append(mock.calls.WaitForScanComplete, callInfo)
+	mock.lockWaitForScanComplete.Unlock()
+	return mock.WaitForScanCompleteFunc(ctx, sectionID, timeout, opts...)
+}
+
+// WaitForScanCompleteCalls gets all the calls that were made to WaitForScanComplete.
+// Check the length with:
+//
+//	len(mockedLibrary.WaitForScanCompleteCalls())
+func (mock *LibraryAPIMock) WaitForScanCompleteCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Timeout   time.Duration
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Timeout   time.Duration
+		Opts      []operations.Option
+	}
+	mock.lockWaitForScanComplete.RLock()
+	calls = mock.calls.WaitForScanComplete
+	mock.lockWaitForScanComplete.RUnlock()
+	return calls
+}
+
+// WalkTree calls WalkTreeFunc.
+func (mock *LibraryAPIMock) WalkTree(ctx context.Context, ratingKey int, fn plexgo.WalkFunc, opts ...operations.Option) error {
+	if mock.WalkTreeFunc == nil {
+		panic("LibraryAPIMock.WalkTreeFunc: method is nil but LibraryAPI.WalkTree was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int
+		Fn        plexgo.WalkFunc
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Fn:        fn,
+		Opts:      opts,
+	}
+	mock.lockWalkTree.Lock()
+	mock.calls.WalkTree = append(mock.calls.WalkTree, callInfo)
+	mock.lockWalkTree.Unlock()
+	return mock.WalkTreeFunc(ctx, ratingKey, fn, opts...)
+}
+
+// WalkTreeCalls gets all the calls that were made to WalkTree.
+// Check the length with:
+//
+//	len(mockedLibrary.WalkTreeCalls())
+func (mock *LibraryAPIMock) WalkTreeCalls() []struct {
+	Ctx       context.Context
+	RatingKey int
+	Fn        plexgo.WalkFunc
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int
+		Fn        plexgo.WalkFunc
+		Opts      []operations.Option
+	}
+	mock.lockWalkTree.RLock()
+	calls = mock.calls.WalkTree
+	mock.lockWalkTree.RUnlock()
+	return calls
+}
+
+// Ensure, that WatchlistAPIMock does implement plexgo.WatchlistAPI.
+var _ plexgo.WatchlistAPI = &WatchlistAPIMock{}
+
+// WatchlistAPIMock is a mock implementation of plexgo.WatchlistAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type WatchlistAPIMock struct {
+	// CheckAvailabilityFunc mocks the CheckAvailability method.
+	CheckAvailabilityFunc func(ctx context.Context, guid string, servers []operations.PlexDevice, opts ...operations.Option) (*plexgo.WatchlistAvailability, error)
+
+	// GetMetadataFunc mocks the GetMetadata method.
+	GetMetadataFunc func(ctx context.Context, guid string, opts ...operations.Option) (*plexgo.Metadata, error)
+
+	// GetWatchListFunc mocks the GetWatchList method.
+	GetWatchListFunc func(ctx context.Context, request operations.GetWatchListRequest, opts ...operations.Option) (*operations.GetWatchListResponse, error)
+
+	// GetWatchlistPageFunc mocks the GetWatchlistPage method.
+	GetWatchlistPageFunc func(ctx context.Context, filter string, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CheckAvailability holds details about calls to the CheckAvailability method.
+		CheckAvailability []struct {
+			Ctx     context.Context
+			Guid    string
+			Servers []operations.PlexDevice
+			Opts    []operations.Option
+		}
+		// GetMetadata holds details about calls to the GetMetadata method.
+		GetMetadata []struct {
+			Ctx  context.Context
+			Guid string
+			Opts []operations.Option
+		}
+		// GetWatchList holds details about calls to the GetWatchList method.
+		GetWatchList []struct {
+			Ctx     context.Context
+			Request operations.GetWatchListRequest
+			Opts    []operations.Option
+		}
+		// GetWatchlistPage holds details about calls to the GetWatchlistPage method.
+		GetWatchlistPage []struct {
+			Ctx    context.Context
+			Filter string
+			Cursor plexgo.Cursor
+			Opts   []operations.Option
+		}
+	}
+	lockCheckAvailability sync.RWMutex
+	lockGetMetadata       sync.RWMutex
+	lockGetWatchList      sync.RWMutex
+	lockGetWatchlistPage  sync.RWMutex
+}
+
+// CheckAvailability calls CheckAvailabilityFunc.
+func (mock *WatchlistAPIMock) CheckAvailability(ctx context.Context, guid string, servers []operations.PlexDevice, opts ...operations.Option) (*plexgo.WatchlistAvailability, error) {
+	if mock.CheckAvailabilityFunc == nil {
+		panic("WatchlistAPIMock.CheckAvailabilityFunc: method is nil but WatchlistAPI.CheckAvailability was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Guid    string
+		Servers []operations.PlexDevice
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Guid:    guid,
+		Servers: servers,
+		Opts:    opts,
+	}
+	mock.lockCheckAvailability.Lock()
+	mock.calls.CheckAvailability = append(mock.calls.CheckAvailability, callInfo)
+	mock.lockCheckAvailability.Unlock()
+	return mock.CheckAvailabilityFunc(ctx, guid, servers, opts...)
+}
+
+// CheckAvailabilityCalls gets all the calls that were made to CheckAvailability.
+// Check the length with:
+//
+//	len(mockedWatchlist.CheckAvailabilityCalls())
+func (mock *WatchlistAPIMock) CheckAvailabilityCalls() []struct {
+	Ctx     context.Context
+	Guid    string
+	Servers []operations.PlexDevice
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Guid    string
+		Servers []operations.PlexDevice
+		Opts    []operations.Option
+	}
+	mock.lockCheckAvailability.RLock()
+	calls = mock.calls.CheckAvailability
+	mock.lockCheckAvailability.RUnlock()
+	return calls
+}
+
+// GetMetadata calls GetMetadataFunc.
+func (mock *WatchlistAPIMock) GetMetadata(ctx context.Context, guid string, opts ...operations.Option) (*plexgo.Metadata, error) {
+	if mock.GetMetadataFunc == nil {
+		panic("WatchlistAPIMock.GetMetadataFunc: method is nil but WatchlistAPI.GetMetadata was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Guid string
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Guid: guid,
+		Opts: opts,
+	}
+	mock.lockGetMetadata.Lock()
+	mock.calls.GetMetadata = append(mock.calls.GetMetadata, callInfo)
+	mock.lockGetMetadata.Unlock()
+	return mock.GetMetadataFunc(ctx, guid, opts...)
+}
+
+// GetMetadataCalls gets all the calls that were made to GetMetadata.
+// Check the length with:
+//
+//	len(mockedWatchlist.GetMetadataCalls())
+func (mock *WatchlistAPIMock) GetMetadataCalls() []struct {
+	Ctx  context.Context
+	Guid string
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Guid string
+		Opts []operations.Option
+	}
+	mock.lockGetMetadata.RLock()
+	calls = mock.calls.GetMetadata
+	mock.lockGetMetadata.RUnlock()
+	return calls
+}
+
+// GetWatchList calls GetWatchListFunc.
+func (mock *WatchlistAPIMock) GetWatchList(ctx context.Context, request operations.GetWatchListRequest, opts ...operations.Option) (*operations.GetWatchListResponse, error) {
+	if mock.GetWatchListFunc == nil {
+		panic("WatchlistAPIMock.GetWatchListFunc: method is nil but WatchlistAPI.GetWatchList was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetWatchListRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetWatchList.Lock()
+	mock.calls.GetWatchList = append(mock.calls.GetWatchList, callInfo)
+	mock.lockGetWatchList.Unlock()
+	return mock.GetWatchListFunc(ctx, request, opts...)
+}
+
+// GetWatchListCalls gets all the calls that were made to GetWatchList.
+// Check the length with:
+//
+//	len(mockedWatchlist.GetWatchListCalls())
+func (mock *WatchlistAPIMock) GetWatchListCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetWatchListRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetWatchListRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetWatchList.RLock()
+	calls = mock.calls.GetWatchList
+	mock.lockGetWatchList.RUnlock()
+	return calls
+}
+
+// GetWatchlistPage calls GetWatchlistPageFunc.
+func (mock *WatchlistAPIMock) GetWatchlistPage(ctx context.Context, filter string, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error) {
+	if mock.GetWatchlistPageFunc == nil {
+		panic("WatchlistAPIMock.GetWatchlistPageFunc: method is nil but WatchlistAPI.GetWatchlistPage was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter string
+		Cursor plexgo.Cursor
+		Opts   []operations.Option
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+		Cursor: cursor,
+		Opts:   opts,
+	}
+	mock.lockGetWatchlistPage.Lock()
+	mock.calls.GetWatchlistPage = append(mock.calls.GetWatchlistPage, callInfo)
+	mock.lockGetWatchlistPage.Unlock()
+	return mock.GetWatchlistPageFunc(ctx, filter, cursor, opts...)
+}
+
+// GetWatchlistPageCalls gets all the calls that were made to GetWatchlistPage.
+// Check the length with:
+//
+//	len(mockedWatchlist.GetWatchlistPageCalls())
+func (mock *WatchlistAPIMock) GetWatchlistPageCalls() []struct {
+	Ctx    context.Context
+	Filter string
+	Cursor plexgo.Cursor
+	Opts   []operations.Option
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter string
+		Cursor plexgo.Cursor
+		Opts   []operations.Option
+	}
+	mock.lockGetWatchlistPage.RLock()
+	calls = mock.calls.GetWatchlistPage
+	mock.lockGetWatchlistPage.RUnlock()
+	return calls
+}
+
+// Ensure, that LogAPIMock does implement plexgo.LogAPI.
+var _ plexgo.LogAPI = &LogAPIMock{}
+
+// LogAPIMock is a mock implementation of plexgo.LogAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type LogAPIMock struct {
+	// EnablePaperTrailFunc mocks the EnablePaperTrail method.
+	EnablePaperTrailFunc func(ctx context.Context, opts ...operations.Option) (*operations.EnablePaperTrailResponse, error)
+
+	// LogLineFunc mocks the LogLine method.
+	LogLineFunc func(ctx context.Context, level operations.Level, message string, source string, opts ...operations.Option) (*operations.LogLineResponse, error)
+
+	// LogMultiLineFunc mocks the LogMultiLine method.
+	LogMultiLineFunc func(ctx context.Context, request string, opts ...operations.Option) (*operations.LogMultiLineResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// EnablePaperTrail holds details about calls to the EnablePaperTrail method.
+		EnablePaperTrail []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// LogLine holds details about calls to the LogLine method.
+		LogLine []struct {
+			Ctx     context.Context
+			Level   operations.Level
+			Message string
+			Source  string
+			Opts    []operations.Option
+		}
+		// LogMultiLine holds details about calls to the LogMultiLine method.
+		LogMultiLine []struct {
+			Ctx     context.Context
+			Request string
+			Opts    []operations.Option
+		}
+	}
+	lockEnablePaperTrail sync.RWMutex
+	lockLogLine          sync.RWMutex
+	lockLogMultiLine     sync.RWMutex
+}
+
+// EnablePaperTrail calls EnablePaperTrailFunc.
+func (mock *LogAPIMock) EnablePaperTrail(ctx context.Context, opts ...operations.Option) (*operations.EnablePaperTrailResponse, error) {
+	if mock.EnablePaperTrailFunc == nil {
+		panic("LogAPIMock.EnablePaperTrailFunc: method is nil but LogAPI.EnablePaperTrail was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockEnablePaperTrail.Lock()
+	mock.calls.EnablePaperTrail = append(mock.calls.EnablePaperTrail, callInfo)
+	mock.lockEnablePaperTrail.Unlock()
+	return mock.EnablePaperTrailFunc(ctx, opts...)
+}
+
+// EnablePaperTrailCalls gets all the calls that were made to EnablePaperTrail.
+// Check the length with:
+//
+//	len(mockedLog.EnablePaperTrailCalls())
+func (mock *LogAPIMock) EnablePaperTrailCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockEnablePaperTrail.RLock()
+	calls = mock.calls.EnablePaperTrail
+	mock.lockEnablePaperTrail.RUnlock()
+	return calls
+}
+
+// LogLine calls LogLineFunc.
+func (mock *LogAPIMock) LogLine(ctx context.Context, level operations.Level, message string, source string, opts ...operations.Option) (*operations.LogLineResponse, error) {
+	if mock.LogLineFunc == nil {
+		panic("LogAPIMock.LogLineFunc: method is nil but LogAPI.LogLine was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Level   operations.Level
+		Message string
+		Source  string
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Level:   level,
+		Message: message,
+		Source:  source,
+		Opts:    opts,
+	}
+	mock.lockLogLine.Lock()
+	mock.calls.LogLine = append(mock.calls.LogLine, callInfo)
+	mock.lockLogLine.Unlock()
+	return mock.LogLineFunc(ctx, level, message, source, opts...)
+}
+
+// LogLineCalls gets all the calls that were made to LogLine.
+// Check the length with:
+//
+//	len(mockedLog.LogLineCalls())
+func (mock *LogAPIMock) LogLineCalls() []struct {
+	Ctx     context.Context
+	Level   operations.Level
+	Message string
+	Source  string
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Level   operations.Level
+		Message string
+		Source  string
+		Opts    []operations.Option
+	}
+	mock.lockLogLine.RLock()
+	calls = mock.calls.LogLine
+	mock.lockLogLine.RUnlock()
+	return calls
+}
+
+// LogMultiLine calls LogMultiLineFunc.
+func (mock *LogAPIMock) LogMultiLine(ctx context.Context, request string, opts ...operations.Option) (*operations.LogMultiLineResponse, error) {
+	if mock.LogMultiLineFunc == nil {
+		panic("LogAPIMock.LogMultiLineFunc: method is nil but LogAPI.LogMultiLine was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request string
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockLogMultiLine.Lock()
+	mock.calls.LogMultiLine = append(mock.calls.LogMultiLine, callInfo)
+	mock.lockLogMultiLine.Unlock()
+	return mock.LogMultiLineFunc(ctx, request, opts...)
+}
+
+// LogMultiLineCalls gets all the calls that were made to LogMultiLine.
+// Check the length with:
+//
+//	len(mockedLog.LogMultiLineCalls())
+func (mock *LogAPIMock) LogMultiLineCalls() []struct {
+	Ctx     context.Context
+	Request string
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request string
+		Opts    []operations.Option
+	}
+	mock.lockLogMultiLine.RLock()
+	calls = mock.calls.LogMultiLine
+	mock.lockLogMultiLine.RUnlock()
+	return calls
+}
+
+// Ensure, that PlaylistsAPIMock does implement plexgo.PlaylistsAPI.
+var _ plexgo.PlaylistsAPI = &PlaylistsAPIMock{}
+
+// PlaylistsAPIMock is a mock implementation of plexgo.PlaylistsAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type PlaylistsAPIMock struct {
+	// AddPlaylistContentsFunc mocks the AddPlaylistContents method.
+	AddPlaylistContentsFunc func(ctx context.Context, playlistID float64, uri string, playQueueID *float64, opts ...operations.Option) (*operations.AddPlaylistContentsResponse, error)
+
+	// AddToPlaylistFunc mocks the AddToPlaylist method.
+	AddToPlaylistFunc func(ctx context.Context, playlistID int, ratingKeys []string, opts ...operations.Option) error
+
+	// ClearPlaylistContentsFunc mocks the ClearPlaylistContents method.
+	ClearPlaylistContentsFunc func(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.ClearPlaylistContentsResponse, error)
+
+	// CreatePlaylistFunc mocks the CreatePlaylist method.
+	CreatePlaylistFunc func(ctx context.Context, request operations.CreatePlaylistRequest, opts ...operations.Option) (*operations.CreatePlaylistResponse, error)
+
+	// CreateStationFromFunc mocks the CreateStationFrom method.
+	CreateStationFromFunc func(ctx context.Context, trackRatingKey int, size int, opts ...operations.Option) (*plexgo.Station, error)
+
+	// DeletePlaylistFunc mocks the DeletePlaylist method.
+	DeletePlaylistFunc func(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.DeletePlaylistResponse, error)
+
+	// GetPlaylistFunc mocks the GetPlaylist method.
+	GetPlaylistFunc func(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.GetPlaylistResponse, error)
+
+	// GetPlaylistContentsFunc mocks the GetPlaylistContents method.
+	GetPlaylistContentsFunc func(ctx context.Context, playlistID float64, type_ operations.GetPlaylistContentsQueryParamType, opts ...operations.Option) (*operations.GetPlaylistContentsResponse, error)
+
+	// GetPlaylistItemsPageFunc mocks the GetPlaylistItemsPage method.
+	GetPlaylistItemsPageFunc func(ctx context.Context, playlistID int, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error)
+
+	// GetPlaylistsFunc mocks the GetPlaylists method.
+	GetPlaylistsFunc func(ctx context.Context, playlistType *operations.PlaylistType, smart *operations.QueryParamSmart, opts ...operations.Option) (*operations.GetPlaylistsResponse, error)
+
+	// GetPlaylistsPageFunc mocks the GetPlaylistsPage method.
+	GetPlaylistsPageFunc func(ctx context.Context, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error)
+
+	// GetStationTracksFunc mocks the GetStationTracks method.
+	GetStationTracksFunc func(ctx context.Context, trackRatingKey int, size int, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// UpdatePlaylistFunc mocks the UpdatePlaylist method.
+	UpdatePlaylistFunc func(ctx context.Context, playlistID float64, title *string, summary *string, opts ...operations.Option) (*operations.UpdatePlaylistResponse, error)
+
+	// UploadPlaylistFunc mocks the UploadPlaylist method.
+	UploadPlaylistFunc func(ctx context.Context, path string, force operations.QueryParamForce, sectionID int64, opts ...operations.Option) (*operations.UploadPlaylistResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddPlaylistContents holds details about calls to the AddPlaylistContents method.
+		AddPlaylistContents []struct {
+			Ctx         context.Context
+			PlaylistID  float64
+			Uri         string
+			PlayQueueID *float64
+			Opts        []operations.Option
+		}
+		// AddToPlaylist holds details about calls to the AddToPlaylist method.
+		AddToPlaylist []struct {
+			Ctx        context.Context
+			PlaylistID int
+			RatingKeys []string
+			Opts       []operations.Option
+		}
+		// ClearPlaylistContents holds details about calls to the ClearPlaylistContents method.
+		ClearPlaylistContents []struct {
+			Ctx        context.Context
+			PlaylistID float64
+			Opts       []operations.Option
+		}
+		// CreatePlaylist holds details about calls to the CreatePlaylist method.
+		CreatePlaylist []struct {
+			Ctx     context.Context
+			Request operations.CreatePlaylistRequest
+			Opts    []operations.Option
+		}
+		// CreateStationFrom holds details about calls to the CreateStationFrom method.
+		CreateStationFrom []struct {
+			Ctx            context.Context
+			TrackRatingKey int
+			Size           int
+			Opts           []operations.Option
+		}
+		// DeletePlaylist holds details about calls to the DeletePlaylist method.
+		DeletePlaylist []struct {
+			Ctx        context.Context
+			PlaylistID float64
+			Opts       []operations.Option
+		}
+		// GetPlaylist holds details about calls to the GetPlaylist method.
+		GetPlaylist []struct {
+			Ctx        context.Context
+			PlaylistID float64
+			Opts       []operations.Option
+		}
+		// GetPlaylistContents holds details about calls to the GetPlaylistContents method.
+		GetPlaylistContents []struct {
+			Ctx        context.Context
+			PlaylistID float64
+			Type_      operations.GetPlaylistContentsQueryParamType
+			Opts       []operations.Option
+		}
+		// GetPlaylistItemsPage holds details about calls to the GetPlaylistItemsPage method.
+		GetPlaylistItemsPage []struct {
+			Ctx        context.Context
+			PlaylistID int
+			Cursor     plexgo.Cursor
+			Opts       []operations.Option
+		}
+		// GetPlaylists holds details about calls to the GetPlaylists method.
+		GetPlaylists []struct {
+			Ctx          context.Context
+			PlaylistType *operations.PlaylistType
+			Smart        *operations.QueryParamSmart
+			Opts         []operations.Option
+		}
+		// GetPlaylistsPage holds details about calls to the GetPlaylistsPage method.
+		GetPlaylistsPage []struct {
+			Ctx    context.Context
+			Cursor plexgo.Cursor
+			Opts   []operations.Option
+		}
+		// GetStationTracks holds details about calls to the GetStationTracks method.
+		GetStationTracks []struct {
+			Ctx            context.Context
+			TrackRatingKey int
+			Size           int
+			Opts           []operations.Option
+		}
+		// UpdatePlaylist holds details about calls to the UpdatePlaylist method.
+		UpdatePlaylist []struct {
+			Ctx        context.Context
+			PlaylistID float64
+			Title      *string
+			Summary    *string
+			Opts       []operations.Option
+		}
+		// UploadPlaylist holds details about calls to the UploadPlaylist method.
+		UploadPlaylist []struct {
+			Ctx       context.Context
+			Path      string
+			Force     operations.QueryParamForce
+			SectionID int64
+			Opts      []operations.Option
+		}
+	}
+	lockAddPlaylistContents   sync.RWMutex
+	lockAddToPlaylist         sync.RWMutex
+	lockClearPlaylistContents sync.RWMutex
+	lockCreatePlaylist        sync.RWMutex
+	lockCreateStationFrom     sync.RWMutex
+	lockDeletePlaylist        sync.RWMutex
+	lockGetPlaylist           sync.RWMutex
+	lockGetPlaylistContents   sync.RWMutex
+	lockGetPlaylistItemsPage  sync.RWMutex
+	lockGetPlaylists          sync.RWMutex
+	lockGetPlaylistsPage      sync.RWMutex
+	lockGetStationTracks      sync.RWMutex
+	lockUpdatePlaylist        sync.RWMutex
+	lockUploadPlaylist        sync.RWMutex
+}
+
+// AddPlaylistContents calls AddPlaylistContentsFunc.
+func (mock *PlaylistsAPIMock) AddPlaylistContents(ctx context.Context, playlistID float64, uri string, playQueueID *float64, opts ...operations.Option) (*operations.AddPlaylistContentsResponse, error) {
+	if mock.AddPlaylistContentsFunc == nil {
+		panic("PlaylistsAPIMock.AddPlaylistContentsFunc: method is nil but PlaylistsAPI.AddPlaylistContents was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		PlaylistID  float64
+		Uri         string
+		PlayQueueID *float64
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		PlaylistID:  playlistID,
+		Uri:         uri,
+		PlayQueueID: playQueueID,
+		Opts:        opts,
+	}
+	mock.lockAddPlaylistContents.Lock()
+	mock.calls.AddPlaylistContents = append(mock.calls.AddPlaylistContents, callInfo)
+	mock.lockAddPlaylistContents.Unlock()
+	return mock.AddPlaylistContentsFunc(ctx, playlistID, uri, playQueueID, opts...)
+}
+
+// AddPlaylistContentsCalls gets all the calls that were made to AddPlaylistContents.
+// Check the length with:
+//
+//	len(mockedPlaylists.AddPlaylistContentsCalls())
+func (mock *PlaylistsAPIMock) AddPlaylistContentsCalls() []struct {
+	Ctx         context.Context
+	PlaylistID  float64
+	Uri         string
+	PlayQueueID *float64
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		PlaylistID  float64
+		Uri         string
+		PlayQueueID *float64
+		Opts        []operations.Option
+	}
+	mock.lockAddPlaylistContents.RLock()
+	calls = mock.calls.AddPlaylistContents
+	mock.lockAddPlaylistContents.RUnlock()
+	return calls
+}
+
+// AddToPlaylist calls AddToPlaylistFunc.
+func (mock *PlaylistsAPIMock) AddToPlaylist(ctx context.Context, playlistID int, ratingKeys []string, opts ...operations.Option) error {
+	if mock.AddToPlaylistFunc == nil {
+		panic("PlaylistsAPIMock.AddToPlaylistFunc: method is nil but PlaylistsAPI.AddToPlaylist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PlaylistID int
+		RatingKeys []string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		PlaylistID: playlistID,
+		RatingKeys: ratingKeys,
+		Opts:       opts,
+	}
+	mock.lockAddToPlaylist.Lock()
+	mock.calls.AddToPlaylist = append(mock.calls.AddToPlaylist, callInfo)
+	mock.lockAddToPlaylist.Unlock()
+	return mock.AddToPlaylistFunc(ctx, playlistID, ratingKeys, opts...)
+}
+
+// AddToPlaylistCalls gets all the calls that were made to AddToPlaylist.
+// Check the length with:
+//
+//	len(mockedPlaylists.AddToPlaylistCalls())
+func (mock *PlaylistsAPIMock) AddToPlaylistCalls() []struct {
+	Ctx        context.Context
+	PlaylistID int
+	RatingKeys []string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PlaylistID int
+		RatingKeys []string
+		Opts       []operations.Option
+	}
+	mock.lockAddToPlaylist.RLock()
+	calls = mock.calls.AddToPlaylist
+	mock.lockAddToPlaylist.RUnlock()
+	return calls
+}
+
+// ClearPlaylistContents calls ClearPlaylistContentsFunc.
+func (mock *PlaylistsAPIMock) ClearPlaylistContents(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.ClearPlaylistContentsResponse, error) {
+	if mock.ClearPlaylistContentsFunc == nil {
+		panic("PlaylistsAPIMock.ClearPlaylistContentsFunc: method is nil but PlaylistsAPI.ClearPlaylistContents was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		PlaylistID: playlistID,
+		Opts:       opts,
+	}
+	mock.lockClearPlaylistContents.Lock()
+	mock.calls.ClearPlaylistContents = append(mock.calls.ClearPlaylistContents, callInfo)
+	mock.lockClearPlaylistContents.Unlock()
+	return mock.ClearPlaylistContentsFunc(ctx, playlistID, opts...)
+}
+
+// ClearPlaylistContentsCalls gets all the calls that were made to ClearPlaylistContents.
+// Check the length with:
+//
+//	len(mockedPlaylists.ClearPlaylistContentsCalls())
+func (mock *PlaylistsAPIMock) ClearPlaylistContentsCalls() []struct {
+	Ctx        context.Context
+	PlaylistID float64
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Opts       []operations.Option
+	}
+	mock.lockClearPlaylistContents.RLock()
+	calls = mock.calls.ClearPlaylistContents
+	mock.lockClearPlaylistContents.RUnlock()
+	return calls
+}
+
+// CreatePlaylist calls CreatePlaylistFunc.
+func (mock *PlaylistsAPIMock) CreatePlaylist(ctx context.Context, request operations.CreatePlaylistRequest, opts ...operations.Option) (*operations.CreatePlaylistResponse, error) {
+	if mock.CreatePlaylistFunc == nil {
+		panic("PlaylistsAPIMock.CreatePlaylistFunc: method is nil but PlaylistsAPI.CreatePlaylist was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.CreatePlaylistRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockCreatePlaylist.Lock()
+	mock.calls.CreatePlaylist = append(mock.calls.CreatePlaylist, callInfo)
+	mock.lockCreatePlaylist.Unlock()
+	return mock.CreatePlaylistFunc(ctx, request, opts...)
+}
+
+// CreatePlaylistCalls gets all the calls that were made to CreatePlaylist.
+// Check the length with:
+//
+//	len(mockedPlaylists.CreatePlaylistCalls())
+func (mock *PlaylistsAPIMock) CreatePlaylistCalls() []struct {
+	Ctx     context.Context
+	Request operations.CreatePlaylistRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.CreatePlaylistRequest
+		Opts    []operations.Option
+	}
+	mock.lockCreatePlaylist.RLock()
+	calls = mock.calls.CreatePlaylist
+	mock.lockCreatePlaylist.RUnlock()
+	return calls
+}
+
+// CreateStationFrom calls CreateStationFromFunc.
+func (mock *PlaylistsAPIMock) CreateStationFrom(ctx context.Context, trackRatingKey int, size int, opts ...operations.Option) (*plexgo.Station, error) {
+	if mock.CreateStationFromFunc == nil {
+		panic("PlaylistsAPIMock.CreateStationFromFunc: method is nil but PlaylistsAPI.CreateStationFrom was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		TrackRatingKey int
+		Size           int
+		Opts           []operations.Option
+	}{
+		Ctx:            ctx,
+		TrackRatingKey: trackRatingKey,
+		Size:           size,
+		Opts:           opts,
+	}
+	mock.lockCreateStationFrom.Lock()
+	mock.calls.CreateStationFrom = append(mock.calls.CreateStationFrom, callInfo)
+	mock.lockCreateStationFrom.Unlock()
+	return mock.CreateStationFromFunc(ctx, trackRatingKey, size, opts...)
+}
+
+// CreateStationFromCalls gets all the calls that were made to CreateStationFrom.
+// Check the length with:
+//
+//	len(mockedPlaylists.CreateStationFromCalls())
+func (mock *PlaylistsAPIMock) CreateStationFromCalls() []struct {
+	Ctx            context.Context
+	TrackRatingKey int
+	Size           int
+	Opts           []operations.Option
+} {
+	var calls []struct {
+		Ctx            context.Context
+		TrackRatingKey int
+		Size           int
+		Opts           []operations.Option
+	}
+	mock.lockCreateStationFrom.RLock()
+	calls = mock.calls.CreateStationFrom
+	mock.lockCreateStationFrom.RUnlock()
+	return calls
+}
+
+// DeletePlaylist calls DeletePlaylistFunc.
+func (mock *PlaylistsAPIMock) DeletePlaylist(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.DeletePlaylistResponse, error) {
+	if mock.DeletePlaylistFunc == nil {
+		panic("PlaylistsAPIMock.DeletePlaylistFunc: method is nil but PlaylistsAPI.DeletePlaylist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		PlaylistID: playlistID,
+		Opts:       opts,
+	}
+	mock.lockDeletePlaylist.Lock()
+	mock.calls.DeletePlaylist = append(mock.calls.DeletePlaylist, callInfo)
+	mock.lockDeletePlaylist.Unlock()
+	return mock.DeletePlaylistFunc(ctx, playlistID, opts...)
+}
+
+// DeletePlaylistCalls gets all the calls that were made to DeletePlaylist.
+// Check the length with:
+//
+//	len(mockedPlaylists.DeletePlaylistCalls())
+func (mock *PlaylistsAPIMock) DeletePlaylistCalls() []struct {
+	Ctx        context.Context
+	PlaylistID float64
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Opts       []operations.Option
+	}
+	mock.lockDeletePlaylist.RLock()
+	calls = mock.calls.DeletePlaylist
+	mock.lockDeletePlaylist.RUnlock()
+	return calls
+}
+
+// GetPlaylist calls GetPlaylistFunc.
+func (mock *PlaylistsAPIMock) GetPlaylist(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.GetPlaylistResponse, error) {
+	if mock.GetPlaylistFunc == nil {
+		panic("PlaylistsAPIMock.GetPlaylistFunc: method is nil but PlaylistsAPI.GetPlaylist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		PlaylistID: playlistID,
+		Opts:       opts,
+	}
+	mock.lockGetPlaylist.Lock()
+	mock.calls.GetPlaylist = append(mock.calls.GetPlaylist, callInfo)
+	mock.lockGetPlaylist.Unlock()
+	return mock.GetPlaylistFunc(ctx, playlistID, opts...)
+}
+
+// GetPlaylistCalls gets all the calls that were made to GetPlaylist.
+// Check the length with:
+//
+//	len(mockedPlaylists.GetPlaylistCalls())
+func (mock *PlaylistsAPIMock) GetPlaylistCalls() []struct {
+	Ctx        context.Context
+	PlaylistID float64
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Opts       []operations.Option
+	}
+	mock.lockGetPlaylist.RLock()
+	calls = mock.calls.GetPlaylist
+	mock.lockGetPlaylist.RUnlock()
+	return calls
+}
+
+// GetPlaylistContents calls GetPlaylistContentsFunc.
+func (mock *PlaylistsAPIMock) GetPlaylistContents(ctx context.Context, playlistID float64, type_ operations.GetPlaylistContentsQueryParamType, opts ...operations.Option) (*operations.GetPlaylistContentsResponse, error) {
+	if mock.GetPlaylistContentsFunc == nil {
+		panic("PlaylistsAPIMock.GetPlaylistContentsFunc: method is nil but PlaylistsAPI.GetPlaylistContents was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Type_      operations.GetPlaylistContentsQueryParamType
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		PlaylistID: playlistID,
+		Type_:      type_,
+		Opts:       opts,
+	}
+	mock.lockGetPlaylistContents.Lock()
+	mock.calls.GetPlaylistContents = append(mock.calls.GetPlaylistContents, callInfo)
+	mock.lockGetPlaylistContents.Unlock()
+	return mock.GetPlaylistContentsFunc(ctx, playlistID, type_, opts...)
+}
+
+// GetPlaylistContentsCalls gets all the calls that were made to GetPlaylistContents.
+// Check the length with:
+//
+//	len(mockedPlaylists.GetPlaylistContentsCalls())
+func (mock *PlaylistsAPIMock) GetPlaylistContentsCalls() []struct {
+	Ctx        context.Context
+	PlaylistID float64
+	Type_      operations.GetPlaylistContentsQueryParamType
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Type_      operations.GetPlaylistContentsQueryParamType
+		Opts       []operations.Option
+	}
+	mock.lockGetPlaylistContents.RLock()
+	calls = mock.calls.GetPlaylistContents
+	mock.lockGetPlaylistContents.RUnlock()
+	return calls
+}
+
+// GetPlaylistItemsPage calls GetPlaylistItemsPageFunc.
+func (mock *PlaylistsAPIMock) GetPlaylistItemsPage(ctx context.Context, playlistID int, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error) {
+	if mock.GetPlaylistItemsPageFunc == nil {
+		panic("PlaylistsAPIMock.GetPlaylistItemsPageFunc: method is nil but PlaylistsAPI.GetPlaylistItemsPage was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PlaylistID int
+		Cursor     plexgo.Cursor
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		PlaylistID: playlistID,
+		Cursor:     cursor,
+		Opts:       opts,
+	}
+	mock.lockGetPlaylistItemsPage.Lock()
+	mock.calls.GetPlaylistItemsPage = append(mock.calls.GetPlaylistItemsPage, callInfo)
+	mock.lockGetPlaylistItemsPage.Unlock()
+	return mock.GetPlaylistItemsPageFunc(ctx, playlistID, cursor, opts...)
+}
+
+// GetPlaylistItemsPageCalls gets all the calls that were made to GetPlaylistItemsPage.
+// Check the length with:
+//
+//	len(mockedPlaylists.GetPlaylistItemsPageCalls())
+func (mock *PlaylistsAPIMock) GetPlaylistItemsPageCalls() []struct {
+	Ctx        context.Context
+	PlaylistID int
+	Cursor     plexgo.Cursor
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PlaylistID int
+		Cursor     plexgo.Cursor
+		Opts       []operations.Option
+	}
+	mock.lockGetPlaylistItemsPage.RLock()
+	calls = mock.calls.GetPlaylistItemsPage
+	mock.lockGetPlaylistItemsPage.RUnlock()
+	return calls
+}
+
+// GetPlaylists calls GetPlaylistsFunc.
+func (mock *PlaylistsAPIMock) GetPlaylists(ctx context.Context, playlistType *operations.PlaylistType, smart *operations.QueryParamSmart, opts ...operations.Option) (*operations.GetPlaylistsResponse, error) {
+	if mock.GetPlaylistsFunc == nil {
+		panic("PlaylistsAPIMock.GetPlaylistsFunc: method is nil but PlaylistsAPI.GetPlaylists was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		PlaylistType *operations.PlaylistType
+		Smart        *operations.QueryParamSmart
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		PlaylistType: playlistType,
+		Smart:        smart,
+		Opts:         opts,
+	}
+	mock.lockGetPlaylists.Lock()
+	mock.calls.GetPlaylists = append(mock.calls.GetPlaylists, callInfo)
+	mock.lockGetPlaylists.Unlock()
+	return mock.GetPlaylistsFunc(ctx, playlistType, smart, opts...)
+}
+
+// GetPlaylistsCalls gets all the calls that were made to GetPlaylists.
+// Check the length with:
+//
+//	len(mockedPlaylists.GetPlaylistsCalls())
+func (mock *PlaylistsAPIMock) GetPlaylistsCalls() []struct {
+	Ctx          context.Context
+	PlaylistType *operations.PlaylistType
+	Smart        *operations.QueryParamSmart
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		PlaylistType *operations.PlaylistType
+		Smart        *operations.QueryParamSmart
+		Opts         []operations.Option
+	}
+	mock.lockGetPlaylists.RLock()
+	calls = mock.calls.GetPlaylists
+	mock.lockGetPlaylists.RUnlock()
+	return calls
+}
+
+// GetPlaylistsPage calls GetPlaylistsPageFunc.
+func (mock *PlaylistsAPIMock) GetPlaylistsPage(ctx context.Context, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error) {
+	if mock.GetPlaylistsPageFunc == nil {
+		panic("PlaylistsAPIMock.GetPlaylistsPageFunc: method is nil but PlaylistsAPI.GetPlaylistsPage was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Cursor plexgo.Cursor
+		Opts   []operations.Option
+	}{
+		Ctx:    ctx,
+		Cursor: cursor,
+		Opts:   opts,
+	}
+	mock.lockGetPlaylistsPage.Lock()
+	mock.calls.GetPlaylistsPage = append(mock.calls.GetPlaylistsPage, callInfo)
+	mock.lockGetPlaylistsPage.Unlock()
+	return mock.GetPlaylistsPageFunc(ctx, cursor, opts...)
+}
+
+// GetPlaylistsPageCalls gets all the calls that were made to GetPlaylistsPage.
+// Check the length with:
+//
+//	len(mockedPlaylists.GetPlaylistsPageCalls())
+func (mock *PlaylistsAPIMock) GetPlaylistsPageCalls() []struct {
+	Ctx    context.Context
+	Cursor plexgo.Cursor
+	Opts   []operations.Option
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Cursor plexgo.Cursor
+		Opts   []operations.Option
+	}
+	mock.lockGetPlaylistsPage.RLock()
+	calls = mock.calls.GetPlaylistsPage
+	mock.lockGetPlaylistsPage.RUnlock()
+	return calls
+}
+
+// GetStationTracks calls GetStationTracksFunc.
+func (mock *PlaylistsAPIMock) GetStationTracks(ctx context.Context, trackRatingKey int, size int, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.GetStationTracksFunc == nil {
+		panic("PlaylistsAPIMock.GetStationTracksFunc: method is nil but PlaylistsAPI.GetStationTracks was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		TrackRatingKey int
+		Size           int
+		Opts           []operations.Option
+	}{
+		Ctx:            ctx,
+		TrackRatingKey: trackRatingKey,
+		Size:           size,
+		Opts:           opts,
+	}
+	mock.lockGetStationTracks.Lock()
+	mock.calls.GetStationTracks = append(mock.calls.GetStationTracks, callInfo)
+	mock.lockGetStationTracks.Unlock()
+	return mock.GetStationTracksFunc(ctx, trackRatingKey, size, opts...)
+}
+
+// GetStationTracksCalls gets all the calls that were made to GetStationTracks.
+// Check the length with:
+//
+//	len(mockedPlaylists.GetStationTracksCalls())
+func (mock *PlaylistsAPIMock) GetStationTracksCalls() []struct {
+	Ctx            context.Context
+	TrackRatingKey int
+	Size           int
+	Opts           []operations.Option
+} {
+	var calls []struct {
+		Ctx            context.Context
+		TrackRatingKey int
+		Size           int
+		Opts           []operations.Option
+	}
+	mock.lockGetStationTracks.RLock()
+	calls = mock.calls.GetStationTracks
+	mock.lockGetStationTracks.RUnlock()
+	return calls
+}
+
+// UpdatePlaylist calls UpdatePlaylistFunc.
+func (mock *PlaylistsAPIMock) UpdatePlaylist(ctx context.Context, playlistID float64, title *string, summary *string, opts ...operations.Option) (*operations.UpdatePlaylistResponse, error) {
+	if mock.UpdatePlaylistFunc == nil {
+		panic("PlaylistsAPIMock.UpdatePlaylistFunc: method is nil but PlaylistsAPI.UpdatePlaylist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Title      *string
+		Summary    *string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		PlaylistID: playlistID,
+		Title:      title,
+		Summary:    summary,
+		Opts:       opts,
+	}
+	mock.lockUpdatePlaylist.Lock()
+	mock.calls.UpdatePlaylist = append(mock.calls.UpdatePlaylist, callInfo)
+	mock.lockUpdatePlaylist.Unlock()
+	return mock.UpdatePlaylistFunc(ctx, playlistID, title, summary, opts...)
+}
+
+// UpdatePlaylistCalls gets all the calls that were made to UpdatePlaylist.
+// Check the length with:
+//
+//	len(mockedPlaylists.UpdatePlaylistCalls())
+func (mock *PlaylistsAPIMock) UpdatePlaylistCalls() []struct {
+	Ctx        context.Context
+	PlaylistID float64
+	Title      *string
+	Summary    *string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PlaylistID float64
+		Title      *string
+		Summary    *string
+		Opts       []operations.Option
+	}
+	mock.lockUpdatePlaylist.RLock()
+	calls = mock.calls.UpdatePlaylist
+	mock.lockUpdatePlaylist.RUnlock()
+	return calls
+}
+
+// UploadPlaylist calls UploadPlaylistFunc.
+func (mock *PlaylistsAPIMock) UploadPlaylist(ctx context.Context, path string, force operations.QueryParamForce, sectionID int64, opts ...operations.Option) (*operations.UploadPlaylistResponse, error) {
+	if mock.UploadPlaylistFunc == nil {
+		panic("PlaylistsAPIMock.UploadPlaylistFunc: method is nil but PlaylistsAPI.UploadPlaylist was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Path      string
+		Force     operations.QueryParamForce
+		SectionID int64
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		Path:      path,
+		Force:     force,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockUploadPlaylist.Lock()
+	mock.calls.UploadPlaylist = append(mock.calls.UploadPlaylist, callInfo)
+	mock.lockUploadPlaylist.Unlock()
+	return mock.UploadPlaylistFunc(ctx, path, force, sectionID, opts...)
+}
+
+// UploadPlaylistCalls gets all the calls that were made to UploadPlaylist.
+// Check the length with:
+//
+//	len(mockedPlaylists.UploadPlaylistCalls())
+func (mock *PlaylistsAPIMock) UploadPlaylistCalls() []struct {
+	Ctx       context.Context
+	Path      string
+	Force     operations.QueryParamForce
+	SectionID int64
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Path      string
+		Force     operations.QueryParamForce
+		SectionID int64
+		Opts      []operations.Option
+	}
+	mock.lockUploadPlaylist.RLock()
+	calls = mock.calls.UploadPlaylist
+	mock.lockUploadPlaylist.RUnlock()
+	return calls
+}
+
+// Ensure, that AuthenticationAPIMock does implement plexgo.AuthenticationAPI.
+var _ plexgo.AuthenticationAPI = &AuthenticationAPIMock{}
+
+// AuthenticationAPIMock is a mock implementation of plexgo.AuthenticationAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type AuthenticationAPIMock struct {
+	// GetSourceConnectionInformationFunc mocks the GetSourceConnectionInformation method.
+	GetSourceConnectionInformationFunc func(ctx context.Context, source string, opts ...operations.Option) (*operations.GetSourceConnectionInformationResponse, error)
+
+	// GetTokenDetailsFunc mocks the GetTokenDetails method.
+	GetTokenDetailsFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetTokenDetailsResponse, error)
+
+	// GetTransientTokenFunc mocks the GetTransientToken method.
+	GetTransientTokenFunc func(ctx context.Context, type_ operations.GetTransientTokenQueryParamType, scope operations.Scope, opts ...operations.Option) (*operations.GetTransientTokenResponse, error)
+
+	// PostUsersSignInDataFunc mocks the PostUsersSignInData method.
+	PostUsersSignInDataFunc func(ctx context.Context, request operations.PostUsersSignInDataRequest, opts ...operations.Option) (*operations.PostUsersSignInDataResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetSourceConnectionInformation holds details about calls to the GetSourceConnectionInformation method.
+		GetSourceConnectionInformation []struct {
+			Ctx    context.Context
+			Source string
+			Opts   []operations.Option
+		}
+		// GetTokenDetails holds details about calls to the GetTokenDetails method.
+		GetTokenDetails []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetTransientToken holds details about calls to the GetTransientToken method.
+		GetTransientToken []struct {
+			Ctx   context.Context
+			Type_ operations.GetTransientTokenQueryParamType
+			Scope operations.Scope
+			Opts  []operations.Option
+		}
+		// PostUsersSignInData holds details about calls to the PostUsersSignInData method.
+		PostUsersSignInData []struct {
+			Ctx     context.Context
+			Request operations.PostUsersSignInDataRequest
+			Opts    []operations.Option
+		}
+	}
+	lockGetSourceConnectionInformation sync.RWMutex
+	lockGetTokenDetails                sync.RWMutex
+	lockGetTransientToken              sync.RWMutex
+	lockPostUsersSignInData            sync.RWMutex
+}
+
+// GetSourceConnectionInformation calls GetSourceConnectionInformationFunc.
+func (mock *AuthenticationAPIMock) GetSourceConnectionInformation(ctx context.Context, source string, opts ...operations.Option) (*operations.GetSourceConnectionInformationResponse, error) {
+	if mock.GetSourceConnectionInformationFunc == nil {
+		panic("AuthenticationAPIMock.GetSourceConnectionInformationFunc: method is nil but AuthenticationAPI.GetSourceConnectionInformation was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Source string
+		Opts   []operations.Option
+	}{
+		Ctx:    ctx,
+		Source: source,
+		Opts:   opts,
+	}
+	mock.lockGetSourceConnectionInformation.Lock()
+	mock.calls.GetSourceConnectionInformation = append(mock.calls.GetSourceConnectionInformation, callInfo)
+	mock.lockGetSourceConnectionInformation.Unlock()
+	return mock.GetSourceConnectionInformationFunc(ctx, source, opts...)
+}
+
+// GetSourceConnectionInformationCalls gets all the calls that were made to GetSourceConnectionInformation.
+// Check the length with:
+//
+//	len(mockedAuthentication.GetSourceConnectionInformationCalls())
+func (mock *AuthenticationAPIMock) GetSourceConnectionInformationCalls() []struct {
+	Ctx    context.Context
+	Source string
+	Opts   []operations.Option
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Source string
+		Opts   []operations.Option
+	}
+	mock.lockGetSourceConnectionInformation.RLock()
+	calls = mock.calls.GetSourceConnectionInformation
+	mock.lockGetSourceConnectionInformation.RUnlock()
+	return calls
+}
+
+// GetTokenDetails calls GetTokenDetailsFunc.
+func (mock *AuthenticationAPIMock) GetTokenDetails(ctx context.Context, opts ...operations.Option) (*operations.GetTokenDetailsResponse, error) {
+	if mock.GetTokenDetailsFunc == nil {
+		panic("AuthenticationAPIMock.GetTokenDetailsFunc: method is nil but AuthenticationAPI.GetTokenDetails was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetTokenDetails.Lock()
+	mock.calls.GetTokenDetails = append(mock.calls.GetTokenDetails, callInfo)
+	mock.lockGetTokenDetails.Unlock()
+	return mock.GetTokenDetailsFunc(ctx, opts...)
+}
+
+// GetTokenDetailsCalls gets all the calls that were made to GetTokenDetails.
+// Check the length with:
+//
+//	len(mockedAuthentication.GetTokenDetailsCalls())
+func (mock *AuthenticationAPIMock) GetTokenDetailsCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetTokenDetails.RLock()
+	calls = mock.calls.GetTokenDetails
+	mock.lockGetTokenDetails.RUnlock()
+	return calls
+}
+
+// GetTransientToken calls GetTransientTokenFunc.
+func (mock *AuthenticationAPIMock) GetTransientToken(ctx context.Context, type_ operations.GetTransientTokenQueryParamType, scope operations.Scope, opts ...operations.Option) (*operations.GetTransientTokenResponse, error) {
+	if mock.GetTransientTokenFunc == nil {
+		panic("AuthenticationAPIMock.GetTransientTokenFunc: method is nil but AuthenticationAPI.GetTransientToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Type_ operations.GetTransientTokenQueryParamType
+		Scope operations.Scope
+		Opts  []operations.Option
+	}{
+		Ctx:   ctx,
+		Type_: type_,
+		Scope: scope,
+		Opts:  opts,
+	}
+	mock.lockGetTransientToken.Lock()
+	mock.calls.GetTransientToken = append(mock.calls.GetTransientToken, callInfo)
+	mock.lockGetTransientToken.Unlock()
+	return mock.GetTransientTokenFunc(ctx, type_, scope, opts...)
+}
+
+// GetTransientTokenCalls gets all the calls that were made to GetTransientToken.
+// Check the length with:
+//
+//	len(mockedAuthentication.GetTransientTokenCalls())
+func (mock *AuthenticationAPIMock) GetTransientTokenCalls() []struct {
+	Ctx   context.Context
+	Type_ operations.GetTransientTokenQueryParamType
+	Scope operations.Scope
+	Opts  []operations.Option
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Type_ operations.GetTransientTokenQueryParamType
+		Scope operations.Scope
+		Opts  []operations.Option
+	}
+	mock.lockGetTransientToken.RLock()
+	calls = mock.calls.GetTransientToken
+	mock.lockGetTransientToken.RUnlock()
+	return calls
+}
+
+// PostUsersSignInData calls PostUsersSignInDataFunc.
+func (mock *AuthenticationAPIMock) PostUsersSignInData(ctx context.Context, request operations.PostUsersSignInDataRequest, opts ...operations.Option) (*operations.PostUsersSignInDataResponse, error) {
+	if mock.PostUsersSignInDataFunc == nil {
+		panic("AuthenticationAPIMock.PostUsersSignInDataFunc: method is nil but AuthenticationAPI.PostUsersSignInData was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.PostUsersSignInDataRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockPostUsersSignInData.Lock()
+	mock.calls.PostUsersSignInData = append(mock.calls.PostUsersSignInData, callInfo)
+	mock.lockPostUsersSignInData.Unlock()
+	return mock.PostUsersSignInDataFunc(ctx, request, opts...)
+}
+
+// PostUsersSignInDataCalls gets all the calls that were made to PostUsersSignInData.
+// Check the length with:
+//
+//	len(mockedAuthentication.PostUsersSignInDataCalls())
+func (mock *AuthenticationAPIMock) PostUsersSignInDataCalls() []struct {
+	Ctx     context.Context
+	Request operations.PostUsersSignInDataRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.PostUsersSignInDataRequest
+		Opts    []operations.Option
+	}
+	mock.lockPostUsersSignInData.RLock()
+	calls = mock.calls.PostUsersSignInData
+	mock.lockPostUsersSignInData.RUnlock()
+	return calls
+}
+
+// Ensure, that StatisticsAPIMock does implement plexgo.StatisticsAPI.
+var _ plexgo.StatisticsAPI = &StatisticsAPIMock{}
+
+// StatisticsAPIMock is a mock implementation of plexgo.StatisticsAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type StatisticsAPIMock struct {
+	// GetBandwidthStatisticsFunc mocks the GetBandwidthStatistics method.
+	GetBandwidthStatisticsFunc func(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetBandwidthStatisticsResponse, error)
+
+	// GetCollectionPlayCountsFunc mocks the GetCollectionPlayCounts method.
+	GetCollectionPlayCountsFunc func(ctx context.Context, collectionID int, window time.Duration, opts ...operations.Option) (*plexgo.CollectionPlayCounts, error)
+
+	// GetResourcesStatisticsFunc mocks the GetResourcesStatistics method.
+	GetResourcesStatisticsFunc func(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetResourcesStatisticsResponse, error)
+
+	// GetStatisticsFunc mocks the GetStatistics method.
+	GetStatisticsFunc func(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetStatisticsResponse, error)
+
+	// GetStreamQualityReportFunc mocks the GetStreamQualityReport method.
+	GetStreamQualityReportFunc func(ctx context.Context, window time.Duration, opts ...operations.Option) (*plexgo.StreamQualityReport, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetBandwidthStatistics holds details about calls to the GetBandwidthStatistics method.
+		GetBandwidthStatistics []struct {
+			Ctx      context.Context
+			Timespan *int64
+			Opts     []operations.Option
+		}
+		// GetCollectionPlayCounts holds details about calls to the GetCollectionPlayCounts method.
+		GetCollectionPlayCounts []struct {
+			Ctx          context.Context
+			CollectionID int
+			Window       time.Duration
+			Opts         []operations.Option
+		}
+		// GetResourcesStatistics holds details about calls to the GetResourcesStatistics method.
+		GetResourcesStatistics []struct {
+			Ctx      context.Context
+			Timespan *int64
+			Opts     []operations.Option
+		}
+		// GetStatistics holds details about calls to the GetStatistics method.
+		GetStatistics []struct {
+			Ctx      context.Context
+			Timespan *int64
+			Opts     []operations.Option
+		}
+		// GetStreamQualityReport holds details about calls to the GetStreamQualityReport method.
+		GetStreamQualityReport []struct {
+			Ctx    context.Context
+			Window time.Duration
+			Opts   []operations.Option
+		}
+	}
+	lockGetBandwidthStatistics  sync.RWMutex
+	lockGetCollectionPlayCounts sync.RWMutex
+	lockGetResourcesStatistics  sync.RWMutex
+	lockGetStatistics           sync.RWMutex
+	lockGetStreamQualityReport  sync.RWMutex
+}
+
+// GetBandwidthStatistics calls GetBandwidthStatisticsFunc.
+func (mock *StatisticsAPIMock) GetBandwidthStatistics(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetBandwidthStatisticsResponse, error) {
+	if mock.GetBandwidthStatisticsFunc == nil {
+		panic("StatisticsAPIMock.GetBandwidthStatisticsFunc: method is nil but StatisticsAPI.GetBandwidthStatistics was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Timespan *int64
+		Opts     []operations.Option
+	}{
+		Ctx:      ctx,
+		Timespan: timespan,
+		Opts:     opts,
+	}
+	mock.lockGetBandwidthStatistics.Lock()
+	mock.calls.GetBandwidthStatistics = append(mock.calls.GetBandwidthStatistics, callInfo)
+	mock.lockGetBandwidthStatistics.Unlock()
+	return mock.GetBandwidthStatisticsFunc(ctx, timespan, opts...)
+}
+
+// GetBandwidthStatisticsCalls gets all the calls that were made to GetBandwidthStatistics.
+// Check the length with:
+//
+//	len(mockedStatistics.GetBandwidthStatisticsCalls())
+func (mock *StatisticsAPIMock) GetBandwidthStatisticsCalls() []struct {
+	Ctx      context.Context
+	Timespan *int64
+	Opts     []operations.Option
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Timespan *int64
+		Opts     []operations.Option
+	}
+	mock.lockGetBandwidthStatistics.RLock()
+	calls = mock.calls.GetBandwidthStatistics
+	mock.lockGetBandwidthStatistics.RUnlock()
+	return calls
+}
+
+// GetCollectionPlayCounts calls GetCollectionPlayCountsFunc.
+func (mock *StatisticsAPIMock) GetCollectionPlayCounts(ctx context.Context, collectionID int, window time.Duration, opts ...operations.Option) (*plexgo.CollectionPlayCounts, error) {
+	if mock.GetCollectionPlayCountsFunc == nil {
+		panic("StatisticsAPIMock.GetCollectionPlayCountsFunc: method is nil but StatisticsAPI.GetCollectionPlayCounts was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Window       time.Duration
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Window:       window,
+		Opts:         opts,
+	}
+	mock.lockGetCollectionPlayCounts.Lock()
+	mock.calls.GetCollectionPlayCounts = append(mock.calls.GetCollectionPlayCounts, callInfo)
+	mock.lockGetCollectionPlayCounts.Unlock()
+	return mock.GetCollectionPlayCountsFunc(ctx, collectionID, window, opts...)
+}
+
+// GetCollectionPlayCountsCalls gets all the calls that were made to GetCollectionPlayCounts.
+// Check the length with:
+//
+//	len(mockedStatistics.GetCollectionPlayCountsCalls())
+func (mock *StatisticsAPIMock) GetCollectionPlayCountsCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Window       time.Duration
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Window       time.Duration
+		Opts         []operations.Option
+	}
+	mock.lockGetCollectionPlayCounts.RLock()
+	calls = mock.calls.GetCollectionPlayCounts
+	mock.lockGetCollectionPlayCounts.RUnlock()
+	return calls
+}
+
+// GetResourcesStatistics calls GetResourcesStatisticsFunc.
+func (mock *StatisticsAPIMock) GetResourcesStatistics(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetResourcesStatisticsResponse, error) {
+	if mock.GetResourcesStatisticsFunc == nil {
+		panic("StatisticsAPIMock.GetResourcesStatisticsFunc: method is nil but StatisticsAPI.GetResourcesStatistics was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Timespan *int64
+		Opts     []operations.Option
+	}{
+		Ctx:      ctx,
+		Timespan: timespan,
+		Opts:     opts,
+	}
+	mock.lockGetResourcesStatistics.Lock()
+	mock.calls.GetResourcesStatistics = append(mock.calls.GetResourcesStatistics, callInfo)
+	mock.lockGetResourcesStatistics.Unlock()
+	return mock.GetResourcesStatisticsFunc(ctx, timespan, opts...)
+}
+
+// GetResourcesStatisticsCalls gets all the calls that were made to GetResourcesStatistics.
+// Check the length with:
+//
+//	len(mockedStatistics.GetResourcesStatisticsCalls())
+func (mock *StatisticsAPIMock) GetResourcesStatisticsCalls() []struct {
+	Ctx      context.Context
+	Timespan *int64
+	Opts     []operations.Option
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Timespan *int64
+		Opts     []operations.Option
+	}
+	mock.lockGetResourcesStatistics.RLock()
+	calls = mock.calls.GetResourcesStatistics
+	mock.lockGetResourcesStatistics.RUnlock()
+	return calls
+}
+
+// GetStatistics calls GetStatisticsFunc.
+func (mock *StatisticsAPIMock) GetStatistics(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetStatisticsResponse, error) {
+	if mock.GetStatisticsFunc == nil {
+		panic("StatisticsAPIMock.GetStatisticsFunc: method is nil but StatisticsAPI.GetStatistics was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Timespan *int64
+		Opts     []operations.Option
+	}{
+		Ctx:      ctx,
+		Timespan: timespan,
+		Opts:     opts,
+	}
+	mock.lockGetStatistics.Lock()
+	mock.calls.GetStatistics = append(mock.calls.GetStatistics, callInfo)
+	mock.lockGetStatistics.Unlock()
+	return mock.GetStatisticsFunc(ctx, timespan, opts...)
+}
+
+// GetStatisticsCalls gets all the calls that were made to GetStatistics.
+// Check the length with:
+//
+//	len(mockedStatistics.GetStatisticsCalls())
+func (mock *StatisticsAPIMock) GetStatisticsCalls() []struct {
+	Ctx      context.Context
+	Timespan *int64
+	Opts     []operations.Option
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Timespan *int64
+		Opts     []operations.Option
+	}
+	mock.lockGetStatistics.RLock()
+	calls = mock.calls.GetStatistics
+	mock.lockGetStatistics.RUnlock()
+	return calls
+}
+
+// GetStreamQualityReport calls GetStreamQualityReportFunc.
+func (mock *StatisticsAPIMock) GetStreamQualityReport(ctx context.Context, window time.Duration, opts ...operations.Option) (*plexgo.StreamQualityReport, error) {
+	if mock.GetStreamQualityReportFunc == nil {
+		panic("StatisticsAPIMock.GetStreamQualityReportFunc: method is nil but StatisticsAPI.GetStreamQualityReport was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Window time.Duration
+		Opts   []operations.Option
+	}{
+		Ctx:    ctx,
+		Window: window,
+		Opts:   opts,
+	}
+	mock.lockGetStreamQualityReport.Lock()
+	mock.calls.GetStreamQualityReport = append(mock.calls.GetStreamQualityReport, callInfo)
+	mock.lockGetStreamQualityReport.Unlock()
+	return mock.GetStreamQualityReportFunc(ctx, window, opts...)
+}
+
+// GetStreamQualityReportCalls gets all the calls that were made to GetStreamQualityReport.
+// Check the length with:
+//
+//	len(mockedStatistics.GetStreamQualityReportCalls())
+func (mock *StatisticsAPIMock) GetStreamQualityReportCalls() []struct {
+	Ctx    context.Context
+	Window time.Duration
+	Opts   []operations.Option
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Window time.Duration
+		Opts   []operations.Option
+	}
+	mock.lockGetStreamQualityReport.RLock()
+	calls = mock.calls.GetStreamQualityReport
+	mock.lockGetStreamQualityReport.RUnlock()
+	return calls
+}
+
+// Ensure, that SessionsAPIMock does implement plexgo.SessionsAPI.
+var _ plexgo.SessionsAPI = &SessionsAPIMock{}
+
+// SessionsAPIMock is a mock implementation of plexgo.SessionsAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type SessionsAPIMock struct {
+	// GetActiveSessionsFunc mocks the GetActiveSessions method.
+	GetActiveSessionsFunc func(ctx context.Context, opts ...operations.Option) ([]plexgo.ActiveSession, error)
+
+	// GetHistoryPageFunc mocks the GetHistoryPage method.
+	GetHistoryPageFunc func(ctx context.Context, accountID int, cursor plexgo.Cursor, opts ...operations.Option) ([]operations.GetSessionHistoryMetadata, plexgo.Cursor, error)
+
+	// GetSessionHistoryFunc mocks the GetSessionHistory method.
+	GetSessionHistoryFunc func(ctx context.Context, sort *string, accountID *int64, filter *operations.QueryParamFilter, librarySectionID *int64, opts ...operations.Option) (*operations.GetSessionHistoryResponse, error)
+
+	// GetSessionsFunc mocks the GetSessions method.
+	GetSessionsFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetSessionsResponse, error)
+
+	// GetTranscodeSessionsFunc mocks the GetTranscodeSessions method.
+	GetTranscodeSessionsFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetTranscodeSessionsResponse, error)
+
+	// NewMonitorFunc mocks the NewMonitor method.
+	NewMonitorFunc func(policies ...plexgo.SessionPolicy) *plexgo.SessionMonitor
+
+	// StopTranscodeSessionFunc mocks the StopTranscodeSession method.
+	StopTranscodeSessionFunc func(ctx context.Context, sessionKey string, opts ...operations.Option) (*operations.StopTranscodeSessionResponse, error)
+
+	// TerminateSessionFunc mocks the TerminateSession method.
+	TerminateSessionFunc func(ctx context.Context, sessionID string, reason string, opts ...operations.Option) error
+
+	// TerminateSessionByKeyFunc mocks the TerminateSessionByKey method.
+	TerminateSessionByKeyFunc func(ctx context.Context, sessionKey string, message string, opts ...operations.Option) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetActiveSessions holds details about calls to the GetActiveSessions method.
+		GetActiveSessions []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetHistoryPage holds details about calls to the GetHistoryPage method.
+		GetHistoryPage []struct {
+			Ctx       context.Context
+			AccountID int
+			Cursor    plexgo.Cursor
+			Opts      []operations.Option
+		}
+		// GetSessionHistory holds details about calls to the GetSessionHistory method.
+		GetSessionHistory []struct {
+			Ctx              context.Context
+			Sort             *string
+			AccountID        *int64
+			Filter           *operations.QueryParamFilter
+			LibrarySectionID *int64
+			Opts             []operations.Option
+		}
+		// GetSessions holds details about calls to the GetSessions method.
+		GetSessions []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// GetTranscodeSessions holds details about calls to the GetTranscodeSessions method.
+		GetTranscodeSessions []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+		// NewMonitor holds details about calls to the NewMonitor method.
+		NewMonitor []struct {
+			Policies []plexgo.SessionPolicy
+		}
+		// StopTranscodeSession holds details about calls to the StopTranscodeSession method.
+		StopTranscodeSession []struct {
+			Ctx        context.Context
+			SessionKey string
+			Opts       []operations.Option
+		}
+		// TerminateSession holds details about calls to the TerminateSession method.
+		TerminateSession []struct {
+			Ctx       context.Context
+			SessionID string
+			Reason    string
+			Opts      []operations.Option
+		}
+		// TerminateSessionByKey holds details about calls to the TerminateSessionByKey method.
+		TerminateSessionByKey []struct {
+			Ctx        context.Context
+			SessionKey string
+			Message    string
+			Opts       []operations.Option
+		}
+	}
+	lockGetActiveSessions     sync.RWMutex
+	lockGetHistoryPage        sync.RWMutex
+	lockGetSessionHistory     sync.RWMutex
+	lockGetSessions           sync.RWMutex
+	lockGetTranscodeSessions  sync.RWMutex
+	lockNewMonitor            sync.RWMutex
+	lockStopTranscodeSession  sync.RWMutex
+	lockTerminateSession      sync.RWMutex
+	lockTerminateSessionByKey sync.RWMutex
+}
+
+// GetActiveSessions calls GetActiveSessionsFunc.
+func (mock *SessionsAPIMock) GetActiveSessions(ctx context.Context, opts ...operations.Option) ([]plexgo.ActiveSession, error) {
+	if mock.GetActiveSessionsFunc == nil {
+		panic("SessionsAPIMock.GetActiveSessionsFunc: method is nil but SessionsAPI.GetActiveSessions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetActiveSessions.Lock()
+	mock.calls.GetActiveSessions = append(mock.calls.GetActiveSessions, callInfo)
+	mock.lockGetActiveSessions.Unlock()
+	return mock.GetActiveSessionsFunc(ctx, opts...)
+}
+
+// GetActiveSessionsCalls gets all the calls that were made to GetActiveSessions.
+// Check the length with:
+//
+//	len(mockedSessions.GetActiveSessionsCalls())
+func (mock *SessionsAPIMock) GetActiveSessionsCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetActiveSessions.RLock()
+	calls = mock.calls.GetActiveSessions
+	mock.lockGetActiveSessions.RUnlock()
+	return calls
+}
+
+// GetHistoryPage calls GetHistoryPageFunc.
+func (mock *SessionsAPIMock) GetHistoryPage(ctx context.Context, accountID int, cursor plexgo.Cursor, opts ...operations.Option) ([]operations.GetSessionHistoryMetadata, plexgo.Cursor, error) {
+	if mock.GetHistoryPageFunc == nil {
+		panic("SessionsAPIMock.GetHistoryPageFunc: method is nil but SessionsAPI.GetHistoryPage was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AccountID int
+		Cursor    plexgo.Cursor
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+		Cursor:    cursor,
+		Opts:      opts,
+	}
+	mock.lockGetHistoryPage.Lock()
+	mock.calls.GetHistoryPage = append(mock.calls.GetHistoryPage, callInfo)
+	mock.lockGetHistoryPage.Unlock()
+	return mock.GetHistoryPageFunc(ctx, accountID, cursor, opts...)
+}
+
+// GetHistoryPageCalls gets all the calls that were made to GetHistoryPage.
+// Check the length with:
+//
+//	len(mockedSessions.GetHistoryPageCalls())
+func (mock *SessionsAPIMock) GetHistoryPageCalls() []struct {
+	Ctx       context.Context
+	AccountID int
+	Cursor    plexgo.Cursor
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AccountID int
+		Cursor    plexgo.Cursor
+		Opts      []operations.Option
+	}
+	mock.lockGetHistoryPage.RLock()
+	calls = mock.calls.GetHistoryPage
+	mock.lockGetHistoryPage.RUnlock()
+	return calls
+}
+
+// GetSessionHistory calls GetSessionHistoryFunc.
+func (mock *SessionsAPIMock) GetSessionHistory(ctx context.Context, sort *string, accountID *int64, filter *operations.QueryParamFilter, librarySectionID *int64, opts ...operations.Option) (*operations.GetSessionHistoryResponse, error) {
+	if mock.GetSessionHistoryFunc == nil {
+		panic("SessionsAPIMock.GetSessionHistoryFunc: method is nil but SessionsAPI.GetSessionHistory was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		Sort             *string
+		AccountID        *int64
+		Filter           *operations.QueryParamFilter
+		LibrarySectionID *int64
+		Opts             []operations.Option
+	}{
+		Ctx:              ctx,
+		Sort:             sort,
+		AccountID:        accountID,
+		Filter:           filter,
+		LibrarySectionID: librarySectionID,
+		Opts:             opts,
+	}
+	mock.lockGetSessionHistory.Lock()
+	mock.calls.GetSessionHistory = append(mock.calls.GetSessionHistory, callInfo)
+	mock.lockGetSessionHistory.Unlock()
+	return mock.GetSessionHistoryFunc(ctx, sort, accountID, filter, librarySectionID, opts...)
+}
+
+// GetSessionHistoryCalls gets all the calls that were made to GetSessionHistory.
+// Check the length with:
+//
+//	len(mockedSessions.GetSessionHistoryCalls())
+func (mock *SessionsAPIMock) GetSessionHistoryCalls() []struct {
+	Ctx              context.Context
+	Sort             *string
+	AccountID        *int64
+	Filter           *operations.QueryParamFilter
+	LibrarySectionID *int64
+	Opts             []operations.Option
+} {
+	var calls []struct {
+		Ctx              context.Context
+		Sort             *string
+		AccountID        *int64
+		Filter           *operations.QueryParamFilter
+		LibrarySectionID *int64
+		Opts             []operations.Option
+	}
+	mock.lockGetSessionHistory.RLock()
+	calls = mock.calls.GetSessionHistory
+	mock.lockGetSessionHistory.RUnlock()
+	return calls
+}
+
+// GetSessions calls GetSessionsFunc.
+func (mock *SessionsAPIMock) GetSessions(ctx context.Context, opts ...operations.Option) (*operations.GetSessionsResponse, error) {
+	if mock.GetSessionsFunc == nil {
+		panic("SessionsAPIMock.GetSessionsFunc: method is nil but SessionsAPI.GetSessions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetSessions.Lock()
+	mock.calls.GetSessions = append(mock.calls.GetSessions, callInfo)
+	mock.lockGetSessions.Unlock()
+	return mock.GetSessionsFunc(ctx, opts...)
+}
+
+// GetSessionsCalls gets all the calls that were made to GetSessions.
+// Check the length with:
+//
+//	len(mockedSessions.GetSessionsCalls())
+func (mock *SessionsAPIMock) GetSessionsCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetSessions.RLock()
+	calls = mock.calls.GetSessions
+	mock.lockGetSessions.RUnlock()
+	return calls
+}
+
+// GetTranscodeSessions calls GetTranscodeSessionsFunc.
+func (mock *SessionsAPIMock) GetTranscodeSessions(ctx context.Context, opts ...operations.Option) (*operations.GetTranscodeSessionsResponse, error) {
+	if mock.GetTranscodeSessionsFunc == nil {
+		panic("SessionsAPIMock.GetTranscodeSessionsFunc: method is nil but SessionsAPI.GetTranscodeSessions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetTranscodeSessions.Lock()
+	mock.calls.GetTranscodeSessions = append(mock.calls.GetTranscodeSessions, callInfo)
+	mock.lockGetTranscodeSessions.Unlock()
+	return mock.GetTranscodeSessionsFunc(ctx, opts...)
+}
+
+// GetTranscodeSessionsCalls gets all the calls that were made to GetTranscodeSessions.
+// Check the length with:
+//
+//	len(mockedSessions.GetTranscodeSessionsCalls())
+func (mock *SessionsAPIMock) GetTranscodeSessionsCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetTranscodeSessions.RLock()
+	calls = mock.calls.GetTranscodeSessions
+	mock.lockGetTranscodeSessions.RUnlock()
+	return calls
+}
+
+// NewMonitor calls NewMonitorFunc.
+func (mock *SessionsAPIMock) NewMonitor(policies ...plexgo.SessionPolicy) *plexgo.SessionMonitor {
+	if mock.NewMonitorFunc == nil {
+		panic("SessionsAPIMock.NewMonitorFunc: method is nil but SessionsAPI.NewMonitor was just called")
+	}
+	callInfo := struct {
+		Policies []plexgo.SessionPolicy
+	}{
+		Policies: policies,
+	}
+	mock.lockNewMonitor.Lock()
+	mock.calls.NewMonitor = append(mock.calls.NewMonitor, callInfo)
+	mock.lockNewMonitor.Unlock()
+	return mock.NewMonitorFunc(policies...)
+}
+
+// NewMonitorCalls gets all the calls that were made to NewMonitor.
+// Check the length with:
+//
+//	len(mockedSessions.NewMonitorCalls())
+func (mock *SessionsAPIMock) NewMonitorCalls() []struct {
+	Policies []plexgo.SessionPolicy
+} {
+	var calls []struct {
+		Policies []plexgo.SessionPolicy
+	}
+	mock.lockNewMonitor.RLock()
+	calls = mock.calls.NewMonitor
+	mock.lockNewMonitor.RUnlock()
+	return calls
+}
+
+// StopTranscodeSession calls StopTranscodeSessionFunc.
+func (mock *SessionsAPIMock) StopTranscodeSession(ctx context.Context, sessionKey string, opts ...operations.Option) (*operations.StopTranscodeSessionResponse, error) {
+	if mock.StopTranscodeSessionFunc == nil {
+		panic("SessionsAPIMock.StopTranscodeSessionFunc: method is nil but SessionsAPI.StopTranscodeSession was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SessionKey string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SessionKey: sessionKey,
+		Opts:       opts,
+	}
+	mock.lockStopTranscodeSession.Lock()
+	mock.calls.StopTranscodeSession = append(mock.calls.StopTranscodeSession, callInfo)
+	mock.lockStopTranscodeSession.Unlock()
+	return mock.StopTranscodeSessionFunc(ctx, sessionKey, opts...)
+}
+
+// StopTranscodeSessionCalls gets all the calls that were made to StopTranscodeSession.
+// Check the length with:
+//
+//	len(mockedSessions.StopTranscodeSessionCalls())
+func (mock *SessionsAPIMock) StopTranscodeSessionCalls() []struct {
+	Ctx        context.Context
+	SessionKey string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SessionKey string
+		Opts       []operations.Option
+	}
+	mock.lockStopTranscodeSession.RLock()
+	calls = mock.calls.StopTranscodeSession
+	mock.lockStopTranscodeSession.RUnlock()
+	return calls
+}
+
+// TerminateSession calls TerminateSessionFunc.
+func (mock *SessionsAPIMock) TerminateSession(ctx context.Context, sessionID string, reason string, opts ...operations.Option) error {
+	if mock.TerminateSessionFunc == nil {
+		panic("SessionsAPIMock.TerminateSessionFunc: method is nil but SessionsAPI.TerminateSession was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SessionID string
+		Reason    string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SessionID: sessionID,
+		Reason:    reason,
+		Opts:      opts,
+	}
+	mock.lockTerminateSession.Lock()
+	mock.calls.TerminateSession = append(mock.calls.TerminateSession, callInfo)
+	mock.lockTerminateSession.Unlock()
+	return mock.TerminateSessionFunc(ctx, sessionID, reason, opts...)
+}
+
+// TerminateSessionCalls gets all the calls that were made to TerminateSession.
+// Check the length with:
+//
+//	len(mockedSessions.TerminateSessionCalls())
+func (mock *SessionsAPIMock) TerminateSessionCalls() []struct {
+	Ctx       context.Context
+	SessionID string
+	Reason    string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SessionID string
+		Reason    string
+		Opts      []operations.Option
+	}
+	mock.lockTerminateSession.RLock()
+	calls = mock.calls.TerminateSession
+	mock.lockTerminateSession.RUnlock()
+	return calls
+}
+
+// TerminateSessionByKey calls TerminateSessionByKeyFunc.
+func (mock *SessionsAPIMock) TerminateSessionByKey(ctx context.Context, sessionKey string, message string, opts ...operations.Option) error {
+	if mock.TerminateSessionByKeyFunc == nil {
+		panic("SessionsAPIMock.TerminateSessionByKeyFunc: method is nil but SessionsAPI.TerminateSessionByKey was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SessionKey string
+		Message    string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SessionKey: sessionKey,
+		Message:    message,
+		Opts:       opts,
+	}
+	mock.lockTerminateSessionByKey.Lock()
+	mock.calls.TerminateSessionByKey = append(mock.calls.TerminateSessionByKey, callInfo)
+	mock.lockTerminateSessionByKey.Unlock()
+	return mock.TerminateSessionByKeyFunc(ctx, sessionKey, message, opts...)
+}
+
+// TerminateSessionByKeyCalls gets all the calls that were made to TerminateSessionByKey.
+// Check the length with:
+//
+//	len(mockedSessions.TerminateSessionByKeyCalls())
+func (mock *SessionsAPIMock) TerminateSessionByKeyCalls() []struct {
+	Ctx        context.Context
+	SessionKey string
+	Message    string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SessionKey string
+		Message    string
+		Opts       []operations.Option
+	}
+	mock.lockTerminateSessionByKey.RLock()
+	calls = mock.calls.TerminateSessionByKey
+	mock.lockTerminateSessionByKey.RUnlock()
+	return calls
+}
+
+// Ensure, that UpdaterAPIMock does implement plexgo.UpdaterAPI.
+var _ plexgo.UpdaterAPI = &UpdaterAPIMock{}
+
+// UpdaterAPIMock is a mock implementation of plexgo.UpdaterAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type UpdaterAPIMock struct {
+	// ApplyUpdatesFunc mocks the ApplyUpdates method.
+	ApplyUpdatesFunc func(ctx context.Context, tonight *operations.Tonight, skip *operations.Skip, opts ...operations.Option) (*operations.ApplyUpdatesResponse, error)
+
+	// CheckForUpdatesFunc mocks the CheckForUpdates method.
+	CheckForUpdatesFunc func(ctx context.Context, download *operations.Download, opts ...operations.Option) (*operations.CheckForUpdatesResponse, error)
+
+	// GetUpdateStatusFunc mocks the GetUpdateStatus method.
+	GetUpdateStatusFunc func(ctx context.Context, opts ...operations.Option) (*operations.GetUpdateStatusResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ApplyUpdates holds details about calls to the ApplyUpdates method.
+		ApplyUpdates []struct {
+			Ctx     context.Context
+			Tonight *operations.Tonight
+			Skip    *operations.Skip
+			Opts    []operations.Option
+		}
+		// CheckForUpdates holds details about calls to the CheckForUpdates method.
+		CheckForUpdates []struct {
+			Ctx      context.Context
+			Download *operations.Download
+			Opts     []operations.Option
+		}
+		// GetUpdateStatus holds details about calls to the GetUpdateStatus method.
+		GetUpdateStatus []struct {
+			Ctx  context.Context
+			Opts []operations.Option
+		}
+	}
+	lockApplyUpdates    sync.RWMutex
+	lockCheckForUpdates sync.RWMutex
+	lockGetUpdateStatus sync.RWMutex
+}
+
+// ApplyUpdates calls ApplyUpdatesFunc.
+func (mock *UpdaterAPIMock) ApplyUpdates(ctx context.Context, tonight *operations.Tonight, skip *operations.Skip, opts ...operations.Option) (*operations.ApplyUpdatesResponse, error) {
+	if mock.ApplyUpdatesFunc == nil {
+		panic("UpdaterAPIMock.ApplyUpdatesFunc: method is nil but UpdaterAPI.ApplyUpdates was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tonight *operations.Tonight
+		Skip    *operations.Skip
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Tonight: tonight,
+		Skip:    skip,
+		Opts:    opts,
+	}
+	mock.lockApplyUpdates.Lock()
+	mock.calls.ApplyUpdates = append(mock.calls.ApplyUpdates, callInfo)
+	mock.lockApplyUpdates.Unlock()
+	return mock.ApplyUpdatesFunc(ctx, tonight, skip, opts...)
+}
+
+// ApplyUpdatesCalls gets all the calls that were made to ApplyUpdates.
+// Check the length with:
+//
+//	len(mockedUpdater.ApplyUpdatesCalls())
+func (mock *UpdaterAPIMock) ApplyUpdatesCalls() []struct {
+	Ctx     context.Context
+	Tonight *operations.Tonight
+	Skip    *operations.Skip
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tonight *operations.Tonight
+		Skip    *operations.Skip
+		Opts    []operations.Option
+	}
+	mock.lockApplyUpdates.RLock()
+	calls = mock.calls.ApplyUpdates
+	mock.lockApplyUpdates.RUnlock()
+	return calls
+}
+
+// CheckForUpdates calls CheckForUpdatesFunc.
+func (mock *UpdaterAPIMock) CheckForUpdates(ctx context.Context, download *operations.Download, opts ...operations.Option) (*operations.CheckForUpdatesResponse, error) {
+	if mock.CheckForUpdatesFunc == nil {
+		panic("UpdaterAPIMock.CheckForUpdatesFunc: method is nil but UpdaterAPI.CheckForUpdates was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Download *operations.Download
+		Opts     []operations.Option
+	}{
+		Ctx:      ctx,
+		Download: download,
+		Opts:     opts,
+	}
+	mock.lockCheckForUpdates.Lock()
+	mock.calls.CheckForUpdates = append(mock.calls.CheckForUpdates, callInfo)
+	mock.lockCheckForUpdates.Unlock()
+	return mock.CheckForUpdatesFunc(ctx, download, opts...)
+}
+
+// CheckForUpdatesCalls gets all the calls that were made to CheckForUpdates.
+// Check the length with:
+//
+//	len(mockedUpdater.CheckForUpdatesCalls())
+func (mock *UpdaterAPIMock) CheckForUpdatesCalls() []struct {
+	Ctx      context.Context
+	Download *operations.Download
+	Opts     []operations.Option
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Download *operations.Download
+		Opts     []operations.Option
+	}
+	mock.lockCheckForUpdates.RLock()
+	calls = mock.calls.CheckForUpdates
+	mock.lockCheckForUpdates.RUnlock()
+	return calls
+}
+
+// GetUpdateStatus calls GetUpdateStatusFunc.
+func (mock *UpdaterAPIMock) GetUpdateStatus(ctx context.Context, opts ...operations.Option) (*operations.GetUpdateStatusResponse, error) {
+	if mock.GetUpdateStatusFunc == nil {
+		panic("UpdaterAPIMock.GetUpdateStatusFunc: method is nil but UpdaterAPI.GetUpdateStatus was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockGetUpdateStatus.Lock()
+	mock.calls.GetUpdateStatus = append(mock.calls.GetUpdateStatus, callInfo)
+	mock.lockGetUpdateStatus.Unlock()
+	return mock.GetUpdateStatusFunc(ctx, opts...)
+}
+
+// GetUpdateStatusCalls gets all the calls that were made to GetUpdateStatus.
+// Check the length with:
+//
+//	len(mockedUpdater.GetUpdateStatusCalls())
+func (mock *UpdaterAPIMock) GetUpdateStatusCalls() []struct {
+	Ctx  context.Context
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []operations.Option
+	}
+	mock.lockGetUpdateStatus.RLock()
+	calls = mock.calls.GetUpdateStatus
+	mock.lockGetUpdateStatus.RUnlock()
+	return calls
+}
+
+// Ensure, that UsersAPIMock does implement plexgo.UsersAPI.
+var _ plexgo.UsersAPI = &UsersAPIMock{}
+
+// UsersAPIMock is a mock implementation of plexgo.UsersAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type UsersAPIMock struct {
+	// GetUserTokenFunc mocks the GetUserToken method.
+	GetUserTokenFunc func(ctx context.Context, machineID string, userID int64, opts ...operations.Option) (string, error)
+
+	// GetUsersFunc mocks the GetUsers method.
+	GetUsersFunc func(ctx context.Context, request operations.GetUsersRequest, opts ...operations.Option) (*operations.GetUsersResponse, error)
+
+	// HideSectionFunc mocks the HideSection method.
+	HideSectionFunc func(ctx context.Context, machineID string, userID int64, sectionID int, opts ...operations.Option) error
+
+	// SetLibraryFiltersFunc mocks the SetLibraryFilters method.
+	SetLibraryFiltersFunc func(ctx context.Context, userID int64, filters plexgo.LibraryFilters, opts ...operations.Option) error
+
+	// ShowSectionFunc mocks the ShowSection method.
+	ShowSectionFunc func(ctx context.Context, machineID string, userID int64, sectionID int, opts ...operations.Option) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetUserToken holds details about calls to the GetUserToken method.
+		GetUserToken []struct {
+			Ctx       context.Context
+			MachineID string
+			UserID    int64
+			Opts      []operations.Option
+		}
+		// GetUsers holds details about calls to the GetUsers method.
+		GetUsers []struct {
+			Ctx     context.Context
+			Request operations.GetUsersRequest
+			Opts    []operations.Option
+		}
+		// HideSection holds details about calls to the HideSection method.
+		HideSection []struct {
+			Ctx       context.Context
+			MachineID string
+			UserID    int64
+			SectionID int
+			Opts      []operations.Option
+		}
+		// SetLibraryFilters holds details about calls to the SetLibraryFilters method.
+		SetLibraryFilters []struct {
+			Ctx     context.Context
+			UserID  int64
+			Filters plexgo.LibraryFilters
+			Opts    []operations.Option
+		}
+		// ShowSection holds details about calls to the ShowSection method.
+		ShowSection []struct {
+			Ctx       context.Context
+			MachineID string
+			UserID    int64
+			SectionID int
+			Opts      []operations.Option
+		}
+	}
+	lockGetUserToken      sync.RWMutex
+	lockGetUsers          sync.RWMutex
+	lockHideSection       sync.RWMutex
+	lockSetLibraryFilters sync.RWMutex
+	lockShowSection       sync.RWMutex
+}
+
+// GetUserToken calls GetUserTokenFunc.
+func (mock *UsersAPIMock) GetUserToken(ctx context.Context, machineID string, userID int64, opts ...operations.Option) (string, error) {
+	if mock.GetUserTokenFunc == nil {
+		panic("UsersAPIMock.GetUserTokenFunc: method is nil but UsersAPI.GetUserToken was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		MachineID string
+		UserID    int64
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		MachineID: machineID,
+		UserID:    userID,
+		Opts:      opts,
+	}
+	mock.lockGetUserToken.Lock()
+	mock.calls.GetUserToken = append(mock.calls.GetUserToken, callInfo)
+	mock.lockGetUserToken.Unlock()
+	return mock.GetUserTokenFunc(ctx, machineID, userID, opts...)
+}
+
+// GetUserTokenCalls gets all the calls that were made to GetUserToken.
+// Check the length with:
+//
+//	len(mockedUsers.GetUserTokenCalls())
+func (mock *UsersAPIMock) GetUserTokenCalls() []struct {
+	Ctx       context.Context
+	MachineID string
+	UserID    int64
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		MachineID string
+		UserID    int64
+		Opts      []operations.Option
+	}
+	mock.lockGetUserToken.RLock()
+	calls = mock.calls.GetUserToken
+	mock.lockGetUserToken.RUnlock()
+	return calls
+}
+
+// GetUsers calls GetUsersFunc.
+func (mock *UsersAPIMock) GetUsers(ctx context.Context, request operations.GetUsersRequest, opts ...operations.Option) (*operations.GetUsersResponse, error) {
+	if mock.GetUsersFunc == nil {
+		panic("UsersAPIMock.GetUsersFunc: method is nil but UsersAPI.GetUsers was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request operations.GetUsersRequest
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Opts:    opts,
+	}
+	mock.lockGetUsers.Lock()
+	mock.calls.GetUsers = append(mock.calls.GetUsers, callInfo)
+	mock.lockGetUsers.Unlock()
+	return mock.GetUsersFunc(ctx, request, opts...)
+}
+
+// GetUsersCalls gets all the calls that were made to GetUsers.
+// Check the length with:
+//
+//	len(mockedUsers.GetUsersCalls())
+func (mock *UsersAPIMock) GetUsersCalls() []struct {
+	Ctx     context.Context
+	Request operations.GetUsersRequest
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request operations.GetUsersRequest
+		Opts    []operations.Option
+	}
+	mock.lockGetUsers.RLock()
+	calls = mock.calls.GetUsers
+	mock.lockGetUsers.RUnlock()
+	return calls
+}
+
+// HideSection calls HideSectionFunc.
+func (mock *UsersAPIMock) HideSection(ctx context.Context, machineID string, userID int64, sectionID int, opts ...operations.Option) error {
+	if mock.HideSectionFunc == nil {
+		panic("UsersAPIMock.HideSectionFunc: method is nil but UsersAPI.HideSection was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		MachineID string
+		UserID    int64
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		MachineID: machineID,
+		UserID:    userID,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockHideSection.Lock()
+	mock.calls.HideSection = append(mock.calls.HideSection, callInfo)
+	mock.lockHideSection.Unlock()
+	return mock.HideSectionFunc(ctx, machineID, userID, sectionID, opts...)
+}
+
+// HideSectionCalls gets all the calls that were made to HideSection.
+// Check the length with:
+//
+//	len(mockedUsers.HideSectionCalls())
+func (mock *UsersAPIMock) HideSectionCalls() []struct {
+	Ctx       context.Context
+	MachineID string
+	UserID    int64
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		MachineID string
+		UserID    int64
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockHideSection.RLock()
+	calls = mock.calls.HideSection
+	mock.lockHideSection.RUnlock()
+	return calls
+}
+
+// SetLibraryFilters calls SetLibraryFiltersFunc.
+func (mock *UsersAPIMock) SetLibraryFilters(ctx context.Context, userID int64, filters plexgo.LibraryFilters, opts ...operations.Option) error {
+	if mock.SetLibraryFiltersFunc == nil {
+		panic("UsersAPIMock.SetLibraryFiltersFunc: method is nil but UsersAPI.SetLibraryFilters was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		UserID  int64
+		Filters plexgo.LibraryFilters
+		Opts    []operations.Option
+	}{
+		Ctx:     ctx,
+		UserID:  userID,
+		Filters: filters,
+		Opts:    opts,
+	}
+	mock.lockSetLibraryFilters.Lock()
+	mock.calls.SetLibraryFilters = append(mock.calls.SetLibraryFilters, callInfo)
+	mock.lockSetLibraryFilters.Unlock()
+	return mock.SetLibraryFiltersFunc(ctx, userID, filters, opts...)
+}
+
+// SetLibraryFiltersCalls gets all the calls that were made to SetLibraryFilters.
+// Check the length with:
+//
+//	len(mockedUsers.SetLibraryFiltersCalls())
+func (mock *UsersAPIMock) SetLibraryFiltersCalls() []struct {
+	Ctx     context.Context
+	UserID  int64
+	Filters plexgo.LibraryFilters
+	Opts    []operations.Option
+} {
+	var calls []struct {
+		Ctx     context.Context
+		UserID  int64
+		Filters plexgo.LibraryFilters
+		Opts    []operations.Option
+	}
+	mock.lockSetLibraryFilters.RLock()
+	calls = mock.calls.SetLibraryFilters
+	mock.lockSetLibraryFilters.RUnlock()
+	return calls
+}
+
+// ShowSection calls ShowSectionFunc.
+func (mock *UsersAPIMock) ShowSection(ctx context.Context, machineID string, userID int64, sectionID int, opts ...operations.Option) error {
+	if mock.ShowSectionFunc == nil {
+		panic("UsersAPIMock.ShowSectionFunc: method is nil but UsersAPI.ShowSection was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		MachineID string
+		UserID    int64
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		MachineID: machineID,
+		UserID:    userID,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockShowSection.Lock()
+	mock.calls.ShowSection = append(mock.calls.ShowSection, callInfo)
+	mock.lockShowSection.Unlock()
+	return mock.ShowSectionFunc(ctx, machineID, userID, sectionID, opts...)
+}
+
+// ShowSectionCalls gets all the calls that were made to ShowSection.
+// Check the length with:
+//
+//	len(mockedUsers.ShowSectionCalls())
+func (mock *UsersAPIMock) ShowSectionCalls() []struct {
+	Ctx       context.Context
+	MachineID string
+	UserID    int64
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		MachineID string
+		UserID    int64
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockShowSection.RLock()
+	calls = mock.calls.ShowSection
+	mock.lockShowSection.RUnlock()
+	return calls
+}
+
+// Ensure, that CollectionsAPIMock does implement plexgo.CollectionsAPI.
+var _ plexgo.CollectionsAPI = &CollectionsAPIMock{}
+
+// CollectionsAPIMock is a mock implementation of plexgo.CollectionsAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type CollectionsAPIMock struct {
+	// AddToCollectionFunc mocks the AddToCollection method.
+	AddToCollectionFunc func(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error
+
+	// AddToCollectionActionFunc mocks the AddToCollectionAction method.
+	AddToCollectionActionFunc func(collectionID int) plexgo.NewItemAction
+
+	// ApplyFunc mocks the Apply method.
+	ApplyFunc func(ctx context.Context, plan *plexgo.CollectionPlan, opts ...operations.Option) ([]plexgo.PlannedChange, error)
+
+	// ApplyPolicyFunc mocks the ApplyPolicy method.
+	ApplyPolicyFunc func(ctx context.Context, sectionID int, policy plexgo.CollectionPolicy, opts ...operations.Option) ([]plexgo.CollectionPolicyChange, error)
+
+	// ApplyViewingOrderFunc mocks the ApplyViewingOrder method.
+	ApplyViewingOrderFunc func(ctx context.Context, collectionID int, order *plexgo.ViewingOrder, opts ...operations.Option) (*plexgo.ViewingOrderResult, error)
+
+	// AuditFunc mocks the Audit method.
+	AuditFunc func(ctx context.Context, collectionID int, expectedGUIDs []string, opts ...operations.Option) (*plexgo.CollectionAudit, error)
+
+	// AutoGroupFranchisesFunc mocks the AutoGroupFranchises method.
+	AutoGroupFranchisesFunc func(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.Franchise, error)
+
+	// BuildSmartFilterURIFunc mocks the BuildSmartFilterURI method.
+	BuildSmartFilterURIFunc func(sectionID int, filterQuery string, opts ...operations.Option) string
+
+	// CreateCollectionFunc mocks the CreateCollection method.
+	CreateCollectionFunc func(ctx context.Context, sectionID int, title string, itemIDs []string, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// CreateCollectionOfTypeFunc mocks the CreateCollectionOfType method.
+	CreateCollectionOfTypeFunc func(ctx context.Context, sectionID int, title string, itemType plexgo.MetadataType, itemIDs []string, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// CreateCollectionWithOptionsFunc mocks the CreateCollectionWithOptions method.
+	CreateCollectionWithOptionsFunc func(ctx context.Context, sectionID int, title string, itemIDs []string, createOptions plexgo.CreateCollectionOptions, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// CreateForPersonFunc mocks the CreateForPerson method.
+	CreateForPersonFunc func(ctx context.Context, sectionID int, personName string, role plexgo.PersonRole, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// CreateFromHistoryFunc mocks the CreateFromHistory method.
+	CreateFromHistoryFunc func(ctx context.Context, sectionID int, title string, criteria plexgo.HistoryCriteria, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// CreatePresetsFunc mocks the CreatePresets method.
+	CreatePresetsFunc func(ctx context.Context, sectionID int, presets []plexgo.Preset, opts ...operations.Option) ([]*plexgo.Collection, error)
+
+	// CreateSmartCollectionFunc mocks the CreateSmartCollection method.
+	CreateSmartCollectionFunc func(ctx context.Context, sectionID int, title string, smartType plexgo.MetadataType, filterArgs string, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// CreateSmartCollectionFromFilterFunc mocks the CreateSmartCollectionFromFilter method.
+	CreateSmartCollectionFromFilterFunc func(ctx context.Context, sectionID int, title string, filter *plexgo.SmartFilter, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// CreateSmartCollectionWithOptionsFunc mocks the CreateSmartCollectionWithOptions method.
+	CreateSmartCollectionWithOptionsFunc func(ctx context.Context, sectionID int, title string, smartType plexgo.MetadataType, filterArgs string, createOptions plexgo.CreateCollectionOptions, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// DeleteCollectionFunc mocks the DeleteCollection method.
+	DeleteCollectionFunc func(ctx context.Context, collectionID int, opts ...operations.Option) error
+
+	// DeleteCollectionByKeyFunc mocks the DeleteCollectionByKey method.
+	DeleteCollectionByKeyFunc func(ctx context.Context, ratingKey string, opts ...operations.Option) error
+
+	// EnqueuePlanFunc mocks the EnqueuePlan method.
+	EnqueuePlanFunc func(ctx context.Context, q *plexgo.TaskQueue, plan *plexgo.CollectionPlan, opts ...operations.Option) error
+
+	// ExportCollectionFunc mocks the ExportCollection method.
+	ExportCollectionFunc func(ctx context.Context, collectionID int, w io.Writer, opts ...operations.Option) (*plexgo.CollectionBackup, error)
+
+	// ExportCollectionsFunc mocks the ExportCollections method.
+	ExportCollectionsFunc func(ctx context.Context, sectionID int, dir string, resume *plexgo.Checkpoint, opts ...operations.Option) (*plexgo.Checkpoint, error)
+
+	// GetAllCollectionsFunc mocks the GetAllCollections method.
+	GetAllCollectionsFunc func(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.Collection, error)
+
+	// GetAllCollectionsWithOptionsFunc mocks the GetAllCollectionsWithOptions method.
+	GetAllCollectionsWithOptionsFunc func(ctx context.Context, sectionID int, listOptions plexgo.GetAllCollectionsOptions, opts ...operations.Option) ([]plexgo.Collection, error)
+
+	// GetCollectionFunc mocks the GetCollection method.
+	GetCollectionFunc func(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// GetCollectionByKeyFunc mocks the GetCollectionByKey method.
+	GetCollectionByKeyFunc func(ctx context.Context, ratingKey string, opts ...operations.Option) (*plexgo.Collection, error)
+
+	// GetCollectionChildrenPageFunc mocks the GetCollectionChildrenPage method.
+	GetCollectionChildrenPageFunc func(ctx context.Context, collectionID int, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error)
+
+	// GetCollectionHubFunc mocks the GetCollectionHub method.
+	GetCollectionHubFunc func(ctx context.Context, sectionID int, collectionID int, opts ...operations.Option) (*plexgo.ManagedHub, error)
+
+	// GetCollectionItemsFunc mocks the GetCollectionItems method.
+	GetCollectionItemsFunc func(ctx context.Context, collectionID int, opts ...operations.Option) ([]string, error)
+
+	// GetCollectionStatsFunc mocks the GetCollectionStats method.
+	GetCollectionStatsFunc func(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.CollectionStats, error)
+
+	// GetCollectionVisibilityFunc mocks the GetCollectionVisibility method.
+	GetCollectionVisibilityFunc func(ctx context.Context, sectionID int, collectionID int, opts ...operations.Option) (*plexgo.CollectionVisibility, error)
+
+	// GetCollectionsPageFunc mocks the GetCollectionsPage method.
+	GetCollectionsPageFunc func(ctx context.Context, sectionID int, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Collection, plexgo.Cursor, error)
+
+	// GetFilterTemplateFunc mocks the GetFilterTemplate method.
+	GetFilterTemplateFunc func(sectionID int, name string) (plexgo.FilterTemplate, bool)
+
+	// GetFilterTemplatesFunc mocks the GetFilterTemplates method.
+	GetFilterTemplatesFunc func(sectionID int) []plexgo.FilterTemplate
+
+	// GetNextUnwatchedFunc mocks the GetNextUnwatched method.
+	GetNextUnwatchedFunc func(ctx context.Context, collectionID int, accountID int, opts ...operations.Option) ([]plexgo.NextEpisode, error)
+
+	// GetPreferencesFunc mocks the GetPreferences method.
+	GetPreferencesFunc func(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.CollectionPreferences, error)
+
+	// GetRandomItemFunc mocks the GetRandomItem method.
+	GetRandomItemFunc func(ctx context.Context, collectionID int, unwatchedOnly bool, opts ...operations.Option) (*plexgo.Metadata, error)
+
+	// GetSharingReportFunc mocks the GetSharingReport method.
+	GetSharingReportFunc func(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.CollectionSharingReport, error)
+
+	// GetSmartFilterFunc mocks the GetSmartFilter method.
+	GetSmartFilterFunc func(ctx context.Context, collection *plexgo.Collection, opts ...operations.Option) (string, error)
+
+	// GetSmartFilterConfigFunc mocks the GetSmartFilterConfig method.
+	GetSmartFilterConfigFunc func(ctx context.Context, collection *plexgo.Collection, opts ...operations.Option) (*plexgo.SmartFilterConfig, error)
+
+	// GetWatchNextFeedFunc mocks the GetWatchNextFeed method.
+	GetWatchNextFeedFunc func(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.WatchNextEntry, error)
+
+	// HandleTasksFunc mocks the HandleTasks method.
+	HandleTasksFunc func(q *plexgo.TaskQueue, opts ...operations.Option)
+
+	// HideFromUserFunc mocks the HideFromUser method.
+	HideFromUserFunc func(ctx context.Context, collectionID int, label string, user operations.User, opts ...operations.Option) error
+
+	// MoveCollectionItemFunc mocks the MoveCollectionItem method.
+	MoveCollectionItemFunc func(ctx context.Context, collectionID int, itemID string, afterItemID string, opts ...operations.Option) error
+
+	// NewFeaturedRotationFunc mocks the NewFeaturedRotation method.
+	NewFeaturedRotationFunc func(sectionID int, collectionIDs ...int) *plexgo.FeaturedRotation
+
+	// NewWatcherFunc mocks the NewWatcher method.
+	NewWatcherFunc func(sectionID int) *plexgo.CollectionWatcher
+
+	// NormalizeSortTitlesFunc mocks the NormalizeSortTitles method.
+	NormalizeSortTitlesFunc func(ctx context.Context, collectionID int, sortOptions plexgo.SortTitleOptions, opts ...operations.Option) ([]plexgo.SortTitleChange, error)
+
+	// PlanFunc mocks the Plan method.
+	PlanFunc func(ctx context.Context, state *plexgo.CollectionState, opts ...operations.Option) (*plexgo.CollectionPlan, error)
+
+	// RegisterFilterTemplateFunc mocks the RegisterFilterTemplate method.
+	RegisterFilterTemplateFunc func(ctx context.Context, sectionID int, name string, itemType plexgo.MetadataType, filter plexgo.FilterGroup, opts ...operations.Option) (*plexgo.FilterTemplate, error)
+
+	// RemoveFromCollectionFunc mocks the RemoveFromCollection method.
+	RemoveFromCollectionFunc func(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error
+
+	// RemoveThemeFunc mocks the RemoveTheme method.
+	RemoveThemeFunc func(ctx context.Context, collectionID int, opts ...operations.Option) error
+
+	// RenderCollectionTemplateFunc mocks the RenderCollectionTemplate method.
+	RenderCollectionTemplateFunc func(ctx context.Context, collectionID int, tmpl *plexgo.CollectionTemplate, opts ...operations.Option) (bool, error)
+
+	// RepairSmartFunc mocks the RepairSmart method.
+	RepairSmartFunc func(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.SmartRepair, error)
+
+	// ResolveFilterTemplatesFunc mocks the ResolveFilterTemplates method.
+	ResolveFilterTemplatesFunc func(sectionID int, filter *plexgo.SmartFilter) (*plexgo.SmartFilter, error)
+
+	// ResolveSmartFilterURIFunc mocks the ResolveSmartFilterURI method.
+	ResolveSmartFilterURIFunc func(uri string, opts ...operations.Option) (string, error)
+
+	// RestoreFunc mocks the Restore method.
+	RestoreFunc func(ctx context.Context, r io.Reader, opts ...operations.Option) (*plexgo.RestoredCollection, error)
+
+	// RestoreCollectionsFunc mocks the RestoreCollections method.
+	RestoreCollectionsFunc func(ctx context.Context, dir string, resume *plexgo.Checkpoint, opts ...operations.Option) ([]plexgo.RestoredCollection, *plexgo.Checkpoint, error)
+
+	// RunKometaCollectionsFunc mocks the RunKometaCollections method.
+	RunKometaCollectionsFunc func(ctx context.Context, sectionID int, collections []plexgo.KometaCollection, opts ...operations.Option) ([]*plexgo.Collection, error)
+
+	// SearchItemsFunc mocks the SearchItems method.
+	SearchItemsFunc func(ctx context.Context, collectionID int, query plexgo.CollectionItemQuery, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// SetContentRatingLockedFunc mocks the SetContentRatingLocked method.
+	SetContentRatingLockedFunc func(ctx context.Context, collectionID int, locked bool, opts ...operations.Option) error
+
+	// ShowToUserFunc mocks the ShowToUser method.
+	ShowToUserFunc func(ctx context.Context, collectionID int, label string, user operations.User, opts ...operations.Option) error
+
+	// TestSmartFilterFunc mocks the TestSmartFilter method.
+	TestSmartFilterFunc func(ctx context.Context, sectionID int, filterQuery string, opts ...operations.Option) (bool, error)
+
+	// UnregisterFilterTemplateFunc mocks the UnregisterFilterTemplate method.
+	UnregisterFilterTemplateFunc func(sectionID int, name string)
+
+	// UpdateCollectionModeFunc mocks the UpdateCollectionMode method.
+	UpdateCollectionModeFunc func(ctx context.Context, collectionID int, mode string, opts ...operations.Option) (string, error)
+
+	// UpdateCollectionSortFunc mocks the UpdateCollectionSort method.
+	UpdateCollectionSortFunc func(ctx context.Context, collectionID int, sort string, opts ...operations.Option) (string, error)
+
+	// UpdateCollectionVisibilityFunc mocks the UpdateCollectionVisibility method.
+	UpdateCollectionVisibilityFunc func(ctx context.Context, sectionID int, collectionID int, visibility *plexgo.CollectionVisibility, opts ...operations.Option) error
+
+	// UpdateContentRatingFunc mocks the UpdateContentRating method.
+	UpdateContentRatingFunc func(ctx context.Context, collectionID int, contentRating string, locked bool, opts ...operations.Option) error
+
+	// UpdateSmartCollectionFunc mocks the UpdateSmartCollection method.
+	UpdateSmartCollectionFunc func(ctx context.Context, collectionID int, filterURI string, opts ...operations.Option) error
+
+	// UploadThemeFunc mocks the UploadTheme method.
+	UploadThemeFunc func(ctx context.Context, collectionID int, r io.Reader, opts ...operations.Option) error
+
+	// UploadThemeFromURLFunc mocks the UploadThemeFromURL method.
+	UploadThemeFromURLFunc func(ctx context.Context, collectionID int, themeURL string, opts ...operations.Option) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddToCollection holds details about calls to the AddToCollection method.
+		AddToCollection []struct {
+			Ctx          context.Context
+			CollectionID int
+			ItemIDs      []string
+			Opts         []operations.Option
+		}
+		// AddToCollectionAction holds details about calls to the AddToCollectionAction method.
+		AddToCollectionAction []struct {
+			CollectionID int
+		}
+		// Apply holds details about calls to the Apply method.
+		Apply []struct {
+			Ctx  context.Context
+			Plan *plexgo.CollectionPlan
+			Opts []operations.Option
+		}
+		// ApplyPolicy holds details about calls to the ApplyPolicy method.
+		ApplyPolicy []struct {
+			Ctx       context.Context
+			SectionID int
+			Policy    plexgo.CollectionPolicy
+			Opts      []operations.Option
+		}
+		// ApplyViewingOrder holds details about calls to the ApplyViewingOrder method.
+		ApplyViewingOrder []struct {
+			Ctx          context.Context
+			CollectionID int
+			Order        *plexgo.ViewingOrder
+			Opts         []operations.Option
+		}
+		// Audit holds details about calls to the Audit method.
+		Audit []struct {
+			Ctx           context.Context
+			CollectionID  int
+			ExpectedGUIDs []string
+			Opts          []operations.Option
+		}
+		// AutoGroupFranchises holds details about calls to the AutoGroupFranchises method.
+		AutoGroupFranchises []struct {
+			Ctx       context.Context
+			SectionID int
+			Opts      []operations.Option
+		}
+		// BuildSmartFilterURI holds details about calls to the BuildSmartFilterURI method.
+		BuildSmartFilterURI []struct {
+			SectionID   int
+			FilterQuery string
+			Opts        []operations.Option
+		}
+		// CreateCollection holds details about calls to the CreateCollection method.
+		CreateCollection []struct {
+			Ctx       context.Context
+			SectionID int
+			Title     string
+			ItemIDs   []string
+			Opts      []operations.Option
+		}
+		// CreateCollectionOfType holds details about calls to the CreateCollectionOfType method.
+		CreateCollectionOfType []struct {
+			Ctx       context.Context
+			SectionID int
+			Title     string
+			ItemType  plexgo.MetadataType
+			ItemIDs   []string
+			Opts      []operations.Option
+		}
+		// CreateCollectionWithOptions holds details about calls to the CreateCollectionWithOptions method.
+		CreateCollectionWithOptions []struct {
+			Ctx           context.Context
+			SectionID     int
+			Title         string
+			ItemIDs       []string
+			CreateOptions plexgo.CreateCollectionOptions
+			Opts          []operations.Option
+		}
+		// CreateForPerson holds details about calls to the CreateForPerson method.
+		CreateForPerson []struct {
+			Ctx        context.Context
+			SectionID  int
+			PersonName string
+			Role       plexgo.PersonRole
+			Opts       []operations.Option
+		}
+		// CreateFromHistory holds details about calls to the CreateFromHistory method.
+		CreateFromHistory []struct {
+			Ctx       context.Context
+			SectionID int
+			Title     string
+			Criteria  plexgo.HistoryCriteria
+			Opts      []operations.Option
+		}
+		// CreatePresets holds details about calls to the CreatePresets method.
+		CreatePresets []struct {
+			Ctx       context.Context
+			SectionID int
+			Presets   []plexgo.Preset
+			Opts      []operations.Option
+		}
+		// CreateSmartCollection holds details about calls to the CreateSmartCollection method.
+		CreateSmartCollection []struct {
+			Ctx        context.Context
+			SectionID  int
+			Title      string
+			SmartType  plexgo.MetadataType
+			FilterArgs string
+			Opts       []operations.Option
+		}
+		// CreateSmartCollectionFromFilter holds details about calls to the CreateSmartCollectionFromFilter method.
+		CreateSmartCollectionFromFilter []struct {
+			Ctx       context.Context
+			SectionID int
+			Title     string
+			Filter    *plexgo.SmartFilter
+			Opts      []operations.Option
+		}
+		// CreateSmartCollectionWithOptions holds details about calls to the CreateSmartCollectionWithOptions method.
+		CreateSmartCollectionWithOptions []struct {
+			Ctx           context.Context
+			SectionID     int
+			Title         string
+			SmartType     plexgo.MetadataType
+			FilterArgs    string
+			CreateOptions plexgo.CreateCollectionOptions
+			Opts          []operations.Option
+		}
+		// DeleteCollection holds details about calls to the DeleteCollection method.
+		DeleteCollection []struct {
+			Ctx          context.Context
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// DeleteCollectionByKey holds details about calls to the DeleteCollectionByKey method.
+		DeleteCollectionByKey []struct {
+			Ctx       context.Context
+			RatingKey string
+			Opts      []operations.Option
+		}
+		// EnqueuePlan holds details about calls to the EnqueuePlan method.
+		EnqueuePlan []struct {
+			Ctx  context.Context
+			Q    *plexgo.TaskQueue
+			Plan *plexgo.CollectionPlan
+			Opts []operations.Option
+		}
+		// ExportCollection holds details about calls to the ExportCollection method.
+		ExportCollection []struct {
+			Ctx          context.Context
+			CollectionID int
+			W            io.Writer
+			Opts         []operations.Option
+		}
+		// ExportCollections holds details about calls to the ExportCollections method.
+		ExportCollections []struct {
+			Ctx       context.Context
+			SectionID int
+			Dir       string
+			Resume    *plexgo.Checkpoint
+			Opts      []operations.Option
+		}
+		// GetAllCollections holds details about calls to the GetAllCollections method.
+		GetAllCollections []struct {
+			Ctx       context.Context
+			SectionID int
+			Opts      []operations.Option
+		}
+		// GetAllCollectionsWithOptions holds details about calls to the GetAllCollectionsWithOptions method.
+		GetAllCollectionsWithOptions []struct {
+			Ctx         context.Context
+			SectionID   int
+			ListOptions plexgo.GetAllCollectionsOptions
+			Opts        []operations.Option
+		}
+		// GetCollection holds details about calls to the GetCollection method.
+		GetCollection []struct {
+			Ctx          context.Context
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// GetCollectionByKey holds details about calls to the GetCollectionByKey method.
+		GetCollectionByKey []struct {
+			Ctx       context.Context
+			RatingKey string
+			Opts      []operations.Option
+		}
+		// GetCollectionChildrenPage holds details about calls to the GetCollectionChildrenPage method.
+		GetCollectionChildrenPage []struct {
+			Ctx          context.Context
+			CollectionID int
+			Cursor       plexgo.Cursor
+			Opts         []operations.Option
+		}
+		// GetCollectionHub holds details about calls to the GetCollectionHub method.
+		GetCollectionHub []struct {
+			Ctx          context.Context
+			SectionID    int
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// GetCollectionItems holds details about calls to the GetCollectionItems method.
+		GetCollectionItems []struct {
+			Ctx          context.Context
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// GetCollectionStats holds details about calls to the GetCollectionStats method.
+		GetCollectionStats []struct {
+			Ctx          context.Context
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// GetCollectionVisibility holds details about calls to the GetCollectionVisibility method.
+		GetCollectionVisibility []struct {
+			Ctx          context.Context
+			SectionID    int
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// GetCollectionsPage holds details about calls to the GetCollectionsPage method.
+		GetCollectionsPage []struct {
+			Ctx       context.Context
+			SectionID int
+			Cursor    plexgo.Cursor
+			Opts      []operations.Option
+		}
+		// GetFilterTemplate holds details about calls to the GetFilterTemplate method.
+		GetFilterTemplate []struct {
+			SectionID int
+			Name      string
+		}
+		// GetFilterTemplates holds details about calls to the GetFilterTemplates method.
+		GetFilterTemplates []struct {
+			SectionID int
+		}
+		// GetNextUnwatched holds details about calls to the GetNextUnwatched method.
+		GetNextUnwatched []struct {
+			Ctx          context.Context
+			CollectionID int
+			AccountID    int
+			Opts         []operations.Option
+		}
+		// GetPreferences holds details about calls to the GetPreferences method.
+		GetPreferences []struct {
+			Ctx          context.Context
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// GetRandomItem holds details about calls to the GetRandomItem method.
+		GetRandomItem []struct {
+			Ctx           context.Context
+			CollectionID  int
+			UnwatchedOnly bool
+			Opts          []operations.Option
+		}
+		// GetSharingReport holds details about calls to the GetSharingReport method.
+		GetSharingReport []struct {
+			Ctx          context.Context
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// GetSmartFilter holds details about calls to the GetSmartFilter method.
+		GetSmartFilter []struct {
+			Ctx        context.Context
+			Collection *plexgo.Collection
+			Opts       []operations.Option
+		}
+		// GetSmartFilterConfig holds details about calls to the GetSmartFilterConfig method.
+		GetSmartFilterConfig []struct {
+			Ctx        context.Context
+			Collection *plexgo.Collection
+			Opts       []operations.Option
+		}
+		// GetWatchNextFeed holds details about calls to the GetWatchNextFeed method.
+		GetWatchNextFeed []struct {
+			Ctx       context.Context
+			SectionID int
+			Opts      []operations.Option
+		}
+		// HandleTasks holds details about calls to the HandleTasks method.
+		HandleTasks []struct {
+			Q    *plexgo.TaskQueue
+			Opts []operations.Option
+		}
+		// HideFromUser holds details about calls to the HideFromUser method.
+		HideFromUser []struct {
+			Ctx          context.Context
+			CollectionID int
+			Label        string
+			User         operations.User
+			Opts         []operations.Option
+		}
+		// MoveCollectionItem holds details about calls to the MoveCollectionItem method.
+		MoveCollectionItem []struct {
+			Ctx          context.Context
+			CollectionID int
+			ItemID       string
+			AfterItemID  string
+			Opts         []operations.Option
+		}
+		// NewFeaturedRotation holds details about calls to the NewFeaturedRotation method.
+		NewFeaturedRotation []struct {
+			SectionID     int
+			CollectionIDs []int
+		}
+		// NewWatcher holds details about calls to the NewWatcher method.
+		NewWatcher []struct {
+			SectionID int
+		}
+		// NormalizeSortTitles holds details about calls to the NormalizeSortTitles method.
+		NormalizeSortTitles []struct {
+			Ctx          context.Context
+			CollectionID int
+			SortOptions  plexgo.SortTitleOptions
+			Opts         []operations.Option
+		}
+		// Plan holds details about calls to the Plan method.
+		Plan []struct {
+			Ctx   context.Context
+			State *plexgo.CollectionState
+			Opts  []operations.Option
+		}
+		// RegisterFilterTemplate holds details about calls to the RegisterFilterTemplate method.
+		RegisterFilterTemplate []struct {
+			Ctx       context.Context
+			SectionID int
+			Name      string
+			ItemType  plexgo.MetadataType
+			Filter    plexgo.FilterGroup
+			Opts      []operations.Option
+		}
+		// RemoveFromCollection holds details about calls to the RemoveFromCollection method.
+		RemoveFromCollection []struct {
+			Ctx          context.Context
+			CollectionID int
+			ItemIDs      []string
+			Opts         []operations.Option
+		}
+		// RemoveTheme holds details about calls to the RemoveTheme method.
+		RemoveTheme []struct {
+			Ctx          context.Context
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// RenderCollectionTemplate holds details about calls to the RenderCollectionTemplate method.
+		RenderCollectionTemplate []struct {
+			Ctx          context.Context
+			CollectionID int
+			Tmpl         *plexgo.CollectionTemplate
+			Opts         []operations.Option
+		}
+		// RepairSmart holds details about calls to the RepairSmart method.
+		RepairSmart []struct {
+			Ctx          context.Context
+			CollectionID int
+			Opts         []operations.Option
+		}
+		// ResolveFilterTemplates holds details about calls to the ResolveFilterTemplates method.
+		ResolveFilterTemplates []struct {
+			SectionID int
+			Filter    *plexgo.SmartFilter
+		}
+		// ResolveSmartFilterURI holds details about calls to the ResolveSmartFilterURI method.
+		ResolveSmartFilterURI []struct {
+			Uri  string
+			Opts []operations.Option
+		}
+		// Restore holds details about calls to the Restore method.
+		Restore []struct {
+			Ctx  context.Context
+			R    io.Reader
+			Opts []operations.Option
+		}
+		// RestoreCollections holds details about calls to the RestoreCollections method.
+		RestoreCollections []struct {
+			Ctx    context.Context
+			Dir    string
+			Resume *plexgo.Checkpoint
+			Opts   []operations.Option
+		}
+		// RunKometaCollections holds details about calls to the RunKometaCollections method.
+		RunKometaCollections []struct {
+			Ctx         context.Context
+			SectionID   int
+			Collections []plexgo.KometaCollection
+			Opts        []operations.Option
+		}
+		// SearchItems holds details about calls to the SearchItems method.
+		SearchItems []struct {
+			Ctx          context.Context
+			CollectionID int
+			Query        plexgo.CollectionItemQuery
+			Opts         []operations.Option
+		}
+		// SetContentRatingLocked holds details about calls to the SetContentRatingLocked method.
+		SetContentRatingLocked []struct {
+			Ctx          context.Context
+			CollectionID int
+			Locked       bool
+			Opts         []operations.Option
+		}
+		// ShowToUser holds details about calls to the ShowToUser method.
+		ShowToUser []struct {
+			Ctx          context.Context
+			CollectionID int
+			Label        string
+			User         operations.User
+			Opts         []operations.Option
+		}
+		// TestSmartFilter holds details about calls to the TestSmartFilter method.
+		TestSmartFilter []struct {
+			Ctx         context.Context
+			SectionID   int
+			FilterQuery string
+			Opts        []operations.Option
+		}
+		// UnregisterFilterTemplate holds details about calls to the UnregisterFilterTemplate method.
+		UnregisterFilterTemplate []struct {
+			SectionID int
+			Name      string
+		}
+		// UpdateCollectionMode holds details about calls to the UpdateCollectionMode method.
+		UpdateCollectionMode []struct {
+			Ctx          context.Context
+			CollectionID int
+			Mode         string
+			Opts         []operations.Option
+		}
+		// UpdateCollectionSort holds details about calls to the UpdateCollectionSort method.
+		UpdateCollectionSort []struct {
+			Ctx          context.Context
+			CollectionID int
+			Sort         string
+			Opts         []operations.Option
+		}
+		// UpdateCollectionVisibility holds details about calls to the UpdateCollectionVisibility method.
+		UpdateCollectionVisibility []struct {
+			Ctx          context.Context
+			SectionID    int
+			CollectionID int
+			Visibility   *plexgo.CollectionVisibility
+			Opts         []operations.Option
+		}
+		// UpdateContentRating holds details about calls to the UpdateContentRating method.
+		UpdateContentRating []struct {
+			Ctx           context.Context
+			CollectionID  int
+			ContentRating string
+			Locked        bool
+			Opts          []operations.Option
+		}
+		// UpdateSmartCollection holds details about calls to the UpdateSmartCollection method.
+		UpdateSmartCollection []struct {
+			Ctx          context.Context
+			CollectionID int
+			FilterURI    string
+			Opts         []operations.Option
+		}
+		// UploadTheme holds details about calls to the UploadTheme method.
+		UploadTheme []struct {
+			Ctx          context.Context
+			CollectionID int
+			R            io.Reader
+			Opts         []operations.Option
+		}
+		// UploadThemeFromURL holds details about calls to the UploadThemeFromURL method.
+		UploadThemeFromURL []struct {
+			Ctx          context.Context
+			CollectionID int
+			ThemeURL     string
+			Opts         []operations.Option
+		}
+	}
+	lockAddToCollection                  sync.RWMutex
+	lockAddToCollectionAction            sync.RWMutex
+	lockApply                            sync.RWMutex
+	lockApplyPolicy                      sync.RWMutex
+	lockApplyViewingOrder                sync.RWMutex
+	lockAudit                            sync.RWMutex
+	lockAutoGroupFranchises              sync.RWMutex
+	lockBuildSmartFilterURI              sync.RWMutex
+	lockCreateCollection                 sync.RWMutex
+	lockCreateCollectionOfType           sync.RWMutex
+	lockCreateCollectionWithOptions      sync.RWMutex
+	lockCreateForPerson                  sync.RWMutex
+	lockCreateFromHistory                sync.RWMutex
+	lockCreatePresets                    sync.RWMutex
+	lockCreateSmartCollection            sync.RWMutex
+	lockCreateSmartCollectionFromFilter  sync.RWMutex
+	lockCreateSmartCollectionWithOptions sync.RWMutex
+	lockDeleteCollection                 sync.RWMutex
+	lockDeleteCollectionByKey            sync.RWMutex
+	lockEnqueuePlan                      sync.RWMutex
+	lockExportCollection                 sync.RWMutex
+	lockExportCollections                sync.RWMutex
+	lockGetAllCollections                sync.RWMutex
+	lockGetAllCollectionsWithOptions     sync.RWMutex
+	lockGetCollection                    sync.RWMutex
+	lockGetCollectionByKey               sync.RWMutex
+	lockGetCollectionChildrenPage        sync.RWMutex
+	lockGetCollectionHub                 sync.RWMutex
+	lockGetCollectionItems               sync.RWMutex
+	lockGetCollectionStats               sync.RWMutex
+	lockGetCollectionVisibility          sync.RWMutex
+	lockGetCollectionsPage               sync.RWMutex
+	lockGetFilterTemplate                sync.RWMutex
+	lockGetFilterTemplates               sync.RWMutex
+	lockGetNextUnwatched                 sync.RWMutex
+	lockGetPreferences                   sync.RWMutex
+	lockGetRandomItem                    sync.RWMutex
+	lockGetSharingReport                 sync.RWMutex
+	lockGetSmartFilter                   sync.RWMutex
+	lockGetSmartFilterConfig             sync.RWMutex
+	lockGetWatchNextFeed                 sync.RWMutex
+	lockHandleTasks                      sync.RWMutex
+	lockHideFromUser                     sync.RWMutex
+	lockMoveCollectionItem               sync.RWMutex
+	lockNewFeaturedRotation              sync.RWMutex
+	lockNewWatcher                       sync.RWMutex
+	lockNormalizeSortTitles              sync.RWMutex
+	lockPlan                             sync.RWMutex
+	lockRegisterFilterTemplate           sync.RWMutex
+	lockRemoveFromCollection             sync.RWMutex
+	lockRemoveTheme                      sync.RWMutex
+	lockRenderCollectionTemplate         sync.RWMutex
+	lockRepairSmart                      sync.RWMutex
+	lockResolveFilterTemplates           sync.RWMutex
+	lockResolveSmartFilterURI            sync.RWMutex
+	lockRestore                          sync.RWMutex
+	lockRestoreCollections               sync.RWMutex
+	lockRunKometaCollections             sync.RWMutex
+	lockSearchItems                      sync.RWMutex
+	lockSetContentRatingLocked           sync.RWMutex
+	lockShowToUser                       sync.RWMutex
+	lockTestSmartFilter                  sync.RWMutex
+	lockUnregisterFilterTemplate         sync.RWMutex
+	lockUpdateCollectionMode             sync.RWMutex
+	lockUpdateCollectionSort             sync.RWMutex
+	lockUpdateCollectionVisibility       sync.RWMutex
+	lockUpdateContentRating              sync.RWMutex
+	lockUpdateSmartCollection            sync.RWMutex
+	lockUploadTheme                      sync.RWMutex
+	lockUploadThemeFromURL               sync.RWMutex
+}
+
+// AddToCollection calls AddToCollectionFunc.
+func (mock *CollectionsAPIMock) AddToCollection(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error {
+	if mock.AddToCollectionFunc == nil {
+		panic("CollectionsAPIMock.AddToCollectionFunc: method is nil but CollectionsAPI.AddToCollection was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		ItemIDs      []string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		ItemIDs:      itemIDs,
+		Opts:         opts,
+	}
+	mock.lockAddToCollection.Lock()
+	mock.calls.AddToCollection = append(mock.calls.AddToCollection, callInfo)
+	mock.lockAddToCollection.Unlock()
+	return mock.AddToCollectionFunc(ctx, collectionID, itemIDs, opts...)
+}
+
+// AddToCollectionCalls gets all the calls that were made to AddToCollection.
+// Check the length with:
+//
+//	len(mockedCollections.AddToCollectionCalls())
+func (mock *CollectionsAPIMock) AddToCollectionCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	ItemIDs      []string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		ItemIDs      []string
+		Opts         []operations.Option
+	}
+	mock.lockAddToCollection.RLock()
+	calls = mock.calls.AddToCollection
+	mock.lockAddToCollection.RUnlock()
+	return calls
+}
+
+// AddToCollectionAction calls AddToCollectionActionFunc.
+func (mock *CollectionsAPIMock) AddToCollectionAction(collectionID int) plexgo.NewItemAction {
+	if mock.AddToCollectionActionFunc == nil {
+		panic("CollectionsAPIMock.AddToCollectionActionFunc: method is nil but CollectionsAPI.AddToCollectionAction was just called")
+	}
+	callInfo := struct {
+		CollectionID int
+	}{
+		CollectionID: collectionID,
+	}
+	mock.lockAddToCollectionAction.Lock()
+	mock.calls.AddToCollectionAction = append(mock.calls.AddToCollectionAction, callInfo)
+	mock.lockAddToCollectionAction.Unlock()
+	return mock.AddToCollectionActionFunc(collectionID)
+}
+
+// AddToCollectionActionCalls gets all the calls that were made to AddToCollectionAction.
+// Check the length with:
+//
+//	len(mockedCollections.AddToCollectionActionCalls())
+func (mock *CollectionsAPIMock) AddToCollectionActionCalls() []struct {
+	CollectionID int
+} {
+	var calls []struct {
+		CollectionID int
+	}
+	mock.lockAddToCollectionAction.RLock()
+	calls = mock.calls.AddToCollectionAction
+	mock.lockAddToCollectionAction.RUnlock()
+	return calls
+}
+
+// Apply calls ApplyFunc.
+func (mock *CollectionsAPIMock) Apply(ctx context.Context, plan *plexgo.CollectionPlan, opts ...operations.Option) ([]plexgo.PlannedChange, error) {
+	if mock.ApplyFunc == nil {
+		panic("CollectionsAPIMock.ApplyFunc: method is nil but CollectionsAPI.Apply was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Plan *plexgo.CollectionPlan
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Plan: plan,
+		Opts: opts,
+	}
+	mock.lockApply.Lock()
+	mock.calls.Apply = append(mock.calls.Apply, callInfo)
+	mock.lockApply.Unlock()
+	return mock.ApplyFunc(ctx, plan, opts...)
+}
+
+// ApplyCalls gets all the calls that were made to Apply.
+// Check the length with:
+//
+//	len(mockedCollections.ApplyCalls())
+func (mock *CollectionsAPIMock) ApplyCalls() []struct {
+	Ctx  context.Context
+	Plan *plexgo.CollectionPlan
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Plan *plexgo.CollectionPlan
+		Opts []operations.Option
+	}
+	mock.lockApply.RLock()
+	calls = mock.calls.Apply
+	mock.lockApply.RUnlock()
+	return calls
+}
+
+// ApplyPolicy calls ApplyPolicyFunc.
+func (mock *CollectionsAPIMock) ApplyPolicy(ctx context.Context, sectionID int, policy plexgo.CollectionPolicy, opts ...operations.Option) ([]plexgo.CollectionPolicyChange, error) {
+	if mock.ApplyPolicyFunc == nil {
+		panic("CollectionsAPIMock.ApplyPolicyFunc: method is nil but CollectionsAPI.ApplyPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Policy    plexgo.CollectionPolicy
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Policy:    policy,
+		Opts:      opts,
+	}
+	mock.lockApplyPolicy.Lock()
+	mock.calls.ApplyPolicy = append(mock.calls.ApplyPolicy, callInfo)
+	mock.lockApplyPolicy.Unlock()
+	return mock.ApplyPolicyFunc(ctx, sectionID, policy, opts...)
+}
+
+// ApplyPolicyCalls gets all the calls that were made to ApplyPolicy.
+// Check the length with:
+//
+//	len(mockedCollections.ApplyPolicyCalls())
+func (mock *CollectionsAPIMock) ApplyPolicyCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Policy    plexgo.CollectionPolicy
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Policy    plexgo.CollectionPolicy
+		Opts      []operations.Option
+	}
+	mock.lockApplyPolicy.RLock()
+	calls = mock.calls.ApplyPolicy
+	mock.lockApplyPolicy.RUnlock()
+	return calls
+}
+
+// ApplyViewingOrder calls ApplyViewingOrderFunc.
+func (mock *CollectionsAPIMock) ApplyViewingOrder(ctx context.Context, collectionID int, order *plexgo.ViewingOrder, opts ...operations.Option) (*plexgo.ViewingOrderResult, error) {
+	if mock.ApplyViewingOrderFunc == nil {
+		panic("CollectionsAPIMock.ApplyViewingOrderFunc: method is nil but CollectionsAPI.ApplyViewingOrder was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Order        *plexgo.ViewingOrder
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Order:        order,
+		Opts:         opts,
+	}
+	mock.lockApplyViewingOrder.Lock()
+	mock.calls.ApplyViewingOrder = append(mock.calls.ApplyViewingOrder, callInfo)
+	mock.lockApplyViewingOrder.Unlock()
+	return mock.ApplyViewingOrderFunc(ctx, collectionID, order, opts...)
+}
+
+// ApplyViewingOrderCalls gets all the calls that were made to ApplyViewingOrder.
+// Check the length with:
+//
+//	len(mockedCollections.ApplyViewingOrderCalls())
+func (mock *CollectionsAPIMock) ApplyViewingOrderCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Order        *plexgo.ViewingOrder
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Order        *plexgo.ViewingOrder
+		Opts         []operations.Option
+	}
+	mock.lockApplyViewingOrder.RLock()
+	calls = mock.calls.ApplyViewingOrder
+	mock.lockApplyViewingOrder.RUnlock()
+	return calls
+}
+
+// Audit calls AuditFunc.
+func (mock *CollectionsAPIMock) Audit(ctx context.Context, collectionID int, expectedGUIDs []string, opts ...operations.Option) (*plexgo.CollectionAudit, error) {
+	if mock.AuditFunc == nil {
+		panic("CollectionsAPIMock.AuditFunc: method is nil but CollectionsAPI.Audit was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		CollectionID  int
+		ExpectedGUIDs []string
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		CollectionID:  collectionID,
+		ExpectedGUIDs: expectedGUIDs,
+		Opts:          opts,
+	}
+	mock.lockAudit.Lock()
+	mock.calls.Audit = append(mock.calls.Audit, callInfo)
+	mock.lockAudit.Unlock()
+	return mock.AuditFunc(ctx, collectionID, expectedGUIDs, opts...)
+}
+
+// AuditCalls gets all the calls that were made to Audit.
+// Check the length with:
+//
+//	len(mockedCollections.AuditCalls())
+func (mock *CollectionsAPIMock) AuditCalls() []struct {
+	Ctx           context.Context
+	CollectionID  int
+	ExpectedGUIDs []string
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		CollectionID  int
+		ExpectedGUIDs []string
+		Opts          []operations.Option
+	}
+	mock.lockAudit.RLock()
+	calls = mock.calls.Audit
+	mock.lockAudit.RUnlock()
+	return calls
+}
+
+// AutoGroupFranchises calls AutoGroupFranchisesFunc.
+func (mock *CollectionsAPIMock) AutoGroupFranchises(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.Franchise, error) {
+	if mock.AutoGroupFranchisesFunc == nil {
+		panic("CollectionsAPIMock.AutoGroupFranchisesFunc: method is nil but CollectionsAPI.AutoGroupFranchises was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockAutoGroupFranchises.Lock()
+	mock.calls.AutoGroupFranchises = append(mock.calls.AutoGroupFranchises, callInfo)
+	mock.lockAutoGroupFranchises.Unlock()
+	return mock.AutoGroupFranchisesFunc(ctx, sectionID, opts...)
+}
+
+// AutoGroupFranchisesCalls gets all the calls that were made to AutoGroupFranchises.
+// Check the length with:
+//
+//	len(mockedCollections.AutoGroupFranchisesCalls())
+func (mock *CollectionsAPIMock) AutoGroupFranchisesCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockAutoGroupFranchises.RLock()
+	calls = mock.calls.AutoGroupFranchises
+	mock.lockAutoGroupFranchises.RUnlock()
+	return calls
+}
+
+// BuildSmartFilterURI calls BuildSmartFilterURIFunc.
+func (mock *CollectionsAPIMock) BuildSmartFilterURI(sectionID int, filterQuery string, opts ...operations.Option) string {
+	if mock.BuildSmartFilterURIFunc == nil {
+		panic("CollectionsAPIMock.BuildSmartFilterURIFunc: method is nil but CollectionsAPI.BuildSmartFilterURI was just called")
+	}
+	callInfo := struct {
+		SectionID   int
+		FilterQuery string
+		Opts        []operations.Option
+	}{
+		SectionID:   sectionID,
+		FilterQuery: filterQuery,
+		Opts:        opts,
+	}
+	mock.lockBuildSmartFilterURI.Lock()
+	mock.calls.BuildSmartFilterURI = append(mock.calls.BuildSmartFilterURI, callInfo)
+	mock.lockBuildSmartFilterURI.Unlock()
+	return mock.BuildSmartFilterURIFunc(sectionID, filterQuery, opts...)
+}
+
+// BuildSmartFilterURICalls gets all the calls that were made to BuildSmartFilterURI.
+// Check the length with:
+//
+//	len(mockedCollections.BuildSmartFilterURICalls())
+func (mock *CollectionsAPIMock) BuildSmartFilterURICalls() []struct {
+	SectionID   int
+	FilterQuery string
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		SectionID   int
+		FilterQuery string
+		Opts        []operations.Option
+	}
+	mock.lockBuildSmartFilterURI.RLock()
+	calls = mock.calls.BuildSmartFilterURI
+	mock.lockBuildSmartFilterURI.RUnlock()
+	return calls
+}
+
+// CreateCollection calls CreateCollectionFunc.
+func (mock *CollectionsAPIMock) CreateCollection(ctx context.Context, sectionID int, title string, itemIDs []string, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.CreateCollectionFunc == nil {
+		panic("CollectionsAPIMock.CreateCollectionFunc: method is nil but CollectionsAPI.CreateCollection was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Title     string
+		ItemIDs   []string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Title:     title,
+		ItemIDs:   itemIDs,
+		Opts:      opts,
+	}
+	mock.lockCreateCollection.Lock()
+	mock.calls.CreateCollection = append(mock.calls.CreateCollection, callInfo)
+	mock.lockCreateCollection.Unlock()
+	return mock.CreateCollectionFunc(ctx, sectionID, title, itemIDs, opts...)
+}
+
+// CreateCollectionCalls gets all the calls that were made to CreateCollection.
+// Check the length with:
+//
+//	len(mockedCollections.CreateCollectionCalls())
+func (mock *CollectionsAPIMock) CreateCollectionCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Title     string
+	ItemIDs   []string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Title     string
+		ItemIDs   []string
+		Opts      []operations.Option
+	}
+	mock.lockCreateCollection.RLock()
+	calls = mock.calls.CreateCollection
+	mock.lockCreateCollection.RUnlock()
+	return calls
+}
+
+// CreateCollectionOfType calls CreateCollectionOfTypeFunc.
+func (mock *CollectionsAPIMock) CreateCollectionOfType(ctx context.Context, sectionID int, title string, itemType plexgo.MetadataType, itemIDs []string, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.CreateCollectionOfTypeFunc == nil {
+		panic("CollectionsAPIMock.CreateCollectionOfTypeFunc: method is nil but CollectionsAPI.CreateCollectionOfType was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Title     string
+		ItemType  plexgo.MetadataType
+		ItemIDs   []string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Title:     title,
+		ItemType:  itemType,
+		ItemIDs:   itemIDs,
+		Opts:      opts,
+	}
+	mock.lockCreateCollectionOfType.Lock()
+	mock.calls.CreateCollectionOfType = append(mock.calls.CreateCollectionOfType, callInfo)
+	mock.lockCreateCollectionOfType.Unlock()
+	return mock.CreateCollectionOfTypeFunc(ctx, sectionID, title, itemType, itemIDs, opts...)
+}
+
+// CreateCollectionOfTypeCalls gets all the calls that were made to CreateCollectionOfType.
+// Check the length with:
+//
+//	len(mockedCollections.CreateCollectionOfTypeCalls())
+func (mock *CollectionsAPIMock) CreateCollectionOfTypeCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Title     string
+	ItemType  plexgo.MetadataType
+	ItemIDs   []string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Title     string
+		ItemType  plexgo.MetadataType
+		ItemIDs   []string
+		Opts      []operations.Option
+	}
+	mock.lockCreateCollectionOfType.RLock()
+	calls = mock.calls.CreateCollectionOfType
+	mock.lockCreateCollectionOfType.RUnlock()
+	return calls
+}
+
+// CreateCollectionWithOptions calls CreateCollectionWithOptionsFunc.
+func (mock *CollectionsAPIMock) CreateCollectionWithOptions(ctx context.Context, sectionID int, title string, itemIDs []string, createOptions plexgo.CreateCollectionOptions, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.CreateCollectionWithOptionsFunc == nil {
+		panic("CollectionsAPIMock.CreateCollectionWithOptionsFunc: method is nil but CollectionsAPI.CreateCollectionWithOptions was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SectionID     int
+		Title         string
+		ItemIDs       []string
+		CreateOptions plexgo.CreateCollectionOptions
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		SectionID:     sectionID,
+		Title:         title,
+		ItemIDs:       itemIDs,
+		CreateOptions: createOptions,
+		Opts:          opts,
+	}
+	mock.lockCreateCollectionWithOptions.Lock()
+	mock.calls.CreateCollectionWithOptions = append(mock.calls.CreateCollectionWithOptions, callInfo)
+	mock.lockCreateCollectionWithOptions.Unlock()
+	return mock.CreateCollectionWithOptionsFunc(ctx, sectionID, title, itemIDs, createOptions, opts...)
+}
+
+// CreateCollectionWithOptionsCalls gets all the calls that were made to CreateCollectionWithOptions.
+// Check the length with:
+//
+//	len(mockedCollections.CreateCollectionWithOptionsCalls())
+func (mock *CollectionsAPIMock) CreateCollectionWithOptionsCalls() []struct {
+	Ctx           context.Context
+	SectionID     int
+	Title         string
+	ItemIDs       []string
+	CreateOptions plexgo.CreateCollectionOptions
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SectionID     int
+		Title         string
+		ItemIDs       []string
+		CreateOptions plexgo.CreateCollectionOptions
+		Opts          []operations.Option
+	}
+	mock.lockCreateCollectionWithOptions.RLock()
+	calls = mock.calls.CreateCollectionWithOptions
+	mock.lockCreateCollectionWithOptions.RUnlock()
+	return calls
+}
+
+// CreateForPerson calls CreateForPersonFunc.
+func (mock *CollectionsAPIMock) CreateForPerson(ctx context.Context, sectionID int, personName string, role plexgo.PersonRole, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.CreateForPersonFunc == nil {
+		panic("CollectionsAPIMock.CreateForPersonFunc: method is nil but CollectionsAPI.CreateForPerson was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionID  int
+		PersonName string
+		Role       plexgo.PersonRole
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionID:  sectionID,
+		PersonName: personName,
+		Role:       role,
+		Opts:       opts,
+	}
+	mock.lockCreateForPerson.Lock()
+	mock.calls.CreateForPerson = append(mock.calls.CreateForPerson, callInfo)
+	mock.lockCreateForPerson.Unlock()
+	return mock.CreateForPersonFunc(ctx, sectionID, personName, role, opts...)
+}
+
+// CreateForPersonCalls gets all the calls that were made to CreateForPerson.
+// Check the length with:
+//
+//	len(mockedCollections.CreateForPersonCalls())
+func (mock *CollectionsAPIMock) CreateForPersonCalls() []struct {
+	Ctx        context.Context
+	SectionID  int
+	PersonName string
+	Role       plexgo.PersonRole
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionID  int
+		PersonName string
+		Role       plexgo.PersonRole
+		Opts       []operations.Option
+	}
+	mock.lockCreateForPerson.RLock()
+	calls = mock.calls.CreateForPerson
+	mock.lockCreateForPerson.RUnlock()
+	return calls
+}
+
+// CreateFromHistory calls CreateFromHistoryFunc.
+func (mock *CollectionsAPIMock) CreateFromHistory(ctx context.Context, sectionID int, title string, criteria plexgo.HistoryCriteria, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.CreateFromHistoryFunc == nil {
+		panic("CollectionsAPIMock.CreateFromHistoryFunc: method is nil but CollectionsAPI.CreateFromHistory was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Title     string
+		Criteria  plexgo.HistoryCriteria
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Title:     title,
+		Criteria:  criteria,
+		Opts:      opts,
+	}
+	mock.lockCreateFromHistory.Lock()
+	mock.calls.CreateFromHistory = append(mock.calls.CreateFromHistory, callInfo)
+	mock.lockCreateFromHistory.Unlock()
+	return mock.CreateFromHistoryFunc(ctx, sectionID, title, criteria, opts...)
+}
+
+// CreateFromHistoryCalls gets all the calls that were made to CreateFromHistory.
+// Check the length with:
+//
+//	len(mockedCollections.CreateFromHistoryCalls())
+func (mock *CollectionsAPIMock) CreateFromHistoryCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Title     string
+	Criteria  plexgo.HistoryCriteria
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Title     string
+		Criteria  plexgo.HistoryCriteria
+		Opts      []operations.Option
+	}
+	mock.lockCreateFromHistory.RLock()
+	calls = mock.calls.CreateFromHistory
+	mock.lockCreateFromHistory.RUnlock()
+	return calls
+}
+
+// CreatePresets calls CreatePresetsFunc.
+func (mock *CollectionsAPIMock) CreatePresets(ctx context.Context, sectionID int, presets []plexgo.Preset, opts ...operations.Option) ([]*plexgo.Collection, error) {
+	if mock.CreatePresetsFunc == nil {
+		panic("CollectionsAPIMock.CreatePresetsFunc: method is nil but CollectionsAPI.CreatePresets was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Presets   []plexgo.Preset
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Presets:   presets,
+		Opts:      opts,
+	}
+	mock.lockCreatePresets.Lock()
+	mock.calls.CreatePresets = append(mock.calls.CreatePresets, callInfo)
+	mock.lockCreatePresets.Unlock()
+	return mock.CreatePresetsFunc(ctx, sectionID, presets, opts...)
+}
+
+// CreatePresetsCalls gets all the calls that were made to CreatePresets.
+// Check the length with:
+//
+//	len(mockedCollections.CreatePresetsCalls())
+func (mock *CollectionsAPIMock) CreatePresetsCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Presets   []plexgo.Preset
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Presets   []plexgo.Preset
+		Opts      []operations.Option
+	}
+	mock.lockCreatePresets.RLock()
+	calls = mock.calls.CreatePresets
+	mock.lockCreatePresets.RUnlock()
+	return calls
+}
+
+// CreateSmartCollection calls CreateSmartCollectionFunc.
+func (mock *CollectionsAPIMock) CreateSmartCollection(ctx context.Context, sectionID int, title string, smartType plexgo.MetadataType, filterArgs string, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.CreateSmartCollectionFunc == nil {
+		panic("CollectionsAPIMock.CreateSmartCollectionFunc: method is nil but CollectionsAPI.CreateSmartCollection was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SectionID  int
+		Title      string
+		SmartType  plexgo.MetadataType
+		FilterArgs string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		SectionID:  sectionID,
+		Title:      title,
+		SmartType:  smartType,
+		FilterArgs: filterArgs,
+		Opts:       opts,
+	}
+	mock.lockCreateSmartCollection.Lock()
+	mock.calls.CreateSmartCollection = append(mock.calls.CreateSmartCollection, callInfo)
+	mock.lockCreateSmartCollection.Unlock()
+	return mock.CreateSmartCollectionFunc(ctx, sectionID, title, smartType, filterArgs, opts...)
+}
+
+// CreateSmartCollectionCalls gets all the calls that were made to CreateSmartCollection.
+// Check the length with:
+//
+//	len(mockedCollections.CreateSmartCollectionCalls())
+func (mock *CollectionsAPIMock) CreateSmartCollectionCalls() []struct {
+	Ctx        context.Context
+	SectionID  int
+	Title      string
+	SmartType  plexgo.MetadataType
+	FilterArgs string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SectionID  int
+		Title      string
+		SmartType  plexgo.MetadataType
+		FilterArgs string
+		Opts       []operations.Option
+	}
+	mock.lockCreateSmartCollection.RLock()
+	calls = mock.calls.CreateSmartCollection
+	mock.lockCreateSmartCollection.RUnlock()
+	return calls
+}
+
+// CreateSmartCollectionFromFilter calls CreateSmartCollectionFromFilterFunc.
+func (mock *CollectionsAPIMock) CreateSmartCollectionFromFilter(ctx context.Context, sectionID int, title string, filter *plexgo.SmartFilter, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.CreateSmartCollectionFromFilterFunc == nil {
+		panic("CollectionsAPIMock.CreateSmartCollectionFromFilterFunc: method is nil but CollectionsAPI.CreateSmartCollectionFromFilter was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Title     string
+		Filter    *plexgo.SmartFilter
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Title:     title,
+		Filter:    filter,
+		Opts:      opts,
+	}
+	mock.lockCreateSmartCollectionFromFilter.Lock()
+	mock.calls.CreateSmartCollectionFromFilter = append(mock.calls.CreateSmartCollectionFromFilter, callInfo)
+	mock.lockCreateSmartCollectionFromFilter.Unlock()
+	return mock.CreateSmartCollectionFromFilterFunc(ctx, sectionID, title, filter, opts...)
+}
+
+// CreateSmartCollectionFromFilterCalls gets all the calls that were made to CreateSmartCollectionFromFilter.
+// Check the length with:
+//
+//	len(mockedCollections.CreateSmartCollectionFromFilterCalls())
+func (mock *CollectionsAPIMock) CreateSmartCollectionFromFilterCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Title     string
+	Filter    *plexgo.SmartFilter
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Title     string
+		Filter    *plexgo.SmartFilter
+		Opts      []operations.Option
+	}
+	mock.lockCreateSmartCollectionFromFilter.RLock()
+	calls = mock.calls.CreateSmartCollectionFromFilter
+	mock.lockCreateSmartCollectionFromFilter.RUnlock()
+	return calls
+}
+
+// CreateSmartCollectionWithOptions calls CreateSmartCollectionWithOptionsFunc.
+func (mock *CollectionsAPIMock) CreateSmartCollectionWithOptions(ctx context.Context, sectionID int, title string, smartType plexgo.MetadataType, filterArgs string, createOptions plexgo.CreateCollectionOptions, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.CreateSmartCollectionWithOptionsFunc == nil {
+		panic("CollectionsAPIMock.CreateSmartCollectionWithOptionsFunc: method is nil but CollectionsAPI.CreateSmartCollectionWithOptions was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SectionID     int
+		Title         string
+		SmartType     plexgo.MetadataType
+		FilterArgs    string
+		CreateOptions plexgo.CreateCollectionOptions
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		SectionID:     sectionID,
+		Title:         title,
+		SmartType:     smartType,
+		FilterArgs:    filterArgs,
+		CreateOptions: createOptions,
+		Opts:          opts,
+	}
+	mock.lockCreateSmartCollectionWithOptions.Lock()
+	mock.calls.CreateSmartCollectionWithOptions = append(mock.calls.CreateSmartCollectionWithOptions, callInfo)
+	mock.lockCreateSmartCollectionWithOptions.Unlock()
+	return mock.CreateSmartCollectionWithOptionsFunc(ctx, sectionID, title, smartType, filterArgs, createOptions, opts...)
+}
+
+// CreateSmartCollectionWithOptionsCalls gets all the calls that were made to CreateSmartCollectionWithOptions.
+// Check the length with:
+//
+//	len(mockedCollections.CreateSmartCollectionWithOptionsCalls())
+func (mock *CollectionsAPIMock) CreateSmartCollectionWithOptionsCalls() []struct {
+	Ctx           context.Context
+	SectionID     int
+	Title         string
+	SmartType     plexgo.MetadataType
+	FilterArgs    string
+	CreateOptions plexgo.CreateCollectionOptions
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SectionID     int
+		Title         string
+		SmartType     plexgo.MetadataType
+		FilterArgs    string
+		CreateOptions plexgo.CreateCollectionOptions
+		Opts          []operations.Option
+	}
+	mock.lockCreateSmartCollectionWithOptions.RLock()
+	calls = mock.calls.CreateSmartCollectionWithOptions
+	mock.lockCreateSmartCollectionWithOptions.RUnlock()
+	return calls
+}
+
+// DeleteCollection calls DeleteCollectionFunc.
+func (mock *CollectionsAPIMock) DeleteCollection(ctx context.Context, collectionID int, opts ...operations.Option) error {
+	if mock.DeleteCollectionFunc == nil {
+		panic("CollectionsAPIMock.DeleteCollectionFunc: method is nil but CollectionsAPI.DeleteCollection was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockDeleteCollection.Lock()
+	mock.calls.DeleteCollection = append(mock.calls.DeleteCollection, callInfo)
+	mock.lockDeleteCollection.Unlock()
+	return mock.DeleteCollectionFunc(ctx, collectionID, opts...)
+}
+
+// DeleteCollectionCalls gets all the calls that were made to DeleteCollection.
+// Check the length with:
+//
+//	len(mockedCollections.DeleteCollectionCalls())
+func (mock *CollectionsAPIMock) DeleteCollectionCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockDeleteCollection.RLock()
+	calls = mock.calls.DeleteCollection
+	mock.lockDeleteCollection.RUnlock()
+	return calls
+}
+
+// DeleteCollectionByKey calls DeleteCollectionByKeyFunc.
+func (mock *CollectionsAPIMock) DeleteCollectionByKey(ctx context.Context, ratingKey string, opts ...operations.Option) error {
+	if mock.DeleteCollectionByKeyFunc == nil {
+		panic("CollectionsAPIMock.DeleteCollectionByKeyFunc: method is nil but CollectionsAPI.DeleteCollectionByKey was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockDeleteCollectionByKey.Lock()
+	mock.calls.DeleteCollectionByKey = append(mock.calls.DeleteCollectionByKey, callInfo)
+	mock.lockDeleteCollectionByKey.Unlock()
+	return mock.DeleteCollectionByKeyFunc(ctx, ratingKey, opts...)
+}
+
+// DeleteCollectionByKeyCalls gets all the calls that were made to DeleteCollectionByKey.
+// Check the length with:
+//
+//	len(mockedCollections.DeleteCollectionByKeyCalls())
+func (mock *CollectionsAPIMock) DeleteCollectionByKeyCalls() []struct {
+	Ctx       context.Context
+	RatingKey string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey string
+		Opts      []operations.Option
+	}
+	mock.lockDeleteCollectionByKey.RLock()
+	calls = mock.calls.DeleteCollectionByKey
+	mock.lockDeleteCollectionByKey.RUnlock()
+	return calls
+}
+
+// EnqueuePlan calls EnqueuePlanFunc.
+func (mock *CollectionsAPIMock) EnqueuePlan(ctx context.Context, q *plexgo.TaskQueue, plan *plexgo.CollectionPlan, opts ...operations.Option) error {
+	if mock.EnqueuePlanFunc == nil {
+		panic("CollectionsAPIMock.EnqueuePlanFunc: method is nil but CollectionsAPI.EnqueuePlan was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Q    *plexgo.TaskQueue
+		Plan *plexgo.CollectionPlan
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		Q:    q,
+		Plan: plan,
+		Opts: opts,
+	}
+	mock.lockEnqueuePlan.Lock()
+	mock.calls.EnqueuePlan = append(mock.calls.EnqueuePlan, callInfo)
+	mock.lockEnqueuePlan.Unlock()
+	return mock.EnqueuePlanFunc(ctx, q, plan, opts...)
+}
+
+// EnqueuePlanCalls gets all the calls that were made to EnqueuePlan.
+// Check the length with:
+//
+//	len(mockedCollections.EnqueuePlanCalls())
+func (mock *CollectionsAPIMock) EnqueuePlanCalls() []struct {
+	Ctx  context.Context
+	Q    *plexgo.TaskQueue
+	Plan *plexgo.CollectionPlan
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Q    *plexgo.TaskQueue
+		Plan *plexgo.CollectionPlan
+		Opts []operations.Option
+	}
+	mock.lockEnqueuePlan.RLock()
+	calls = mock.calls.EnqueuePlan
+	mock.lockEnqueuePlan.RUnlock()
+	return calls
+}
+
+// ExportCollection calls ExportCollectionFunc.
+func (mock *CollectionsAPIMock) ExportCollection(ctx context.Context, collectionID int, w io.Writer, opts ...operations.Option) (*plexgo.CollectionBackup, error) {
+	if mock.ExportCollectionFunc == nil {
+		panic("CollectionsAPIMock.ExportCollectionFunc: method is nil but CollectionsAPI.ExportCollection was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		W            io.Writer
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		W:            w,
+		Opts:         opts,
+	}
+	mock.lockExportCollection.Lock()
+	mock.calls.ExportCollection = append(mock.calls.ExportCollection, callInfo)
+	mock.lockExportCollection.Unlock()
+	return mock.ExportCollectionFunc(ctx, collectionID, w, opts...)
+}
+
+// ExportCollectionCalls gets all the calls that were made to ExportCollection.
+// Check the length with:
+//
+//	len(mockedCollections.ExportCollectionCalls())
+func (mock *CollectionsAPIMock) ExportCollectionCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	W            io.Writer
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		W            io.Writer
+		Opts         []operations.Option
+	}
+	mock.lockExportCollection.RLock()
+	calls = mock.calls.ExportCollection
+	mock.lockExportCollection.RUnlock()
+	return calls
+}
+
+// ExportCollections calls ExportCollectionsFunc.
+func (mock *CollectionsAPIMock) ExportCollections(ctx context.Context, sectionID int, dir string, resume *plexgo.Checkpoint, opts ...operations.Option) (*plexgo.Checkpoint, error) {
+	if mock.ExportCollectionsFunc == nil {
+		panic("CollectionsAPIMock.ExportCollectionsFunc: method is nil but CollectionsAPI.ExportCollections was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Dir       string
+		Resume    *plexgo.Checkpoint
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Dir:       dir,
+		Resume:    resume,
+		Opts:      opts,
+	}
+	mock.lockExportCollections.Lock()
+	mock.calls.ExportCollections = append(mock.calls.ExportCollections, callInfo)
+	mock.lockExportCollections.Unlock()
+	return mock.ExportCollectionsFunc(ctx, sectionID, dir, resume, opts...)
+}
+
+// ExportCollectionsCalls gets all the calls that were made to ExportCollections.
+// Check the length with:
+//
+//	len(mockedCollections.ExportCollectionsCalls())
+func (mock *CollectionsAPIMock) ExportCollectionsCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Dir       string
+	Resume    *plexgo.Checkpoint
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Dir       string
+		Resume    *plexgo.Checkpoint
+		Opts      []operations.Option
+	}
+	mock.lockExportCollections.RLock()
+	calls = mock.calls.ExportCollections
+	mock.lockExportCollections.RUnlock()
+	return calls
+}
+
+// GetAllCollections calls GetAllCollectionsFunc.
+func (mock *CollectionsAPIMock) GetAllCollections(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.Collection, error) {
+	if mock.GetAllCollectionsFunc == nil {
+		panic("CollectionsAPIMock.GetAllCollectionsFunc: method is nil but CollectionsAPI.GetAllCollections was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockGetAllCollections.Lock()
+	mock.calls.GetAllCollections = append(mock.calls.GetAllCollections, callInfo)
+	mock.lockGetAllCollections.Unlock()
+	return mock.GetAllCollectionsFunc(ctx, sectionID, opts...)
+}
+
+// GetAllCollectionsCalls gets all the calls that were made to GetAllCollections.
+// Check the length with:
+//
+//	len(mockedCollections.GetAllCollectionsCalls())
+func (mock *CollectionsAPIMock) GetAllCollectionsCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockGetAllCollections.RLock()
+	calls = mock.calls.GetAllCollections
+	mock.lockGetAllCollections.RUnlock()
+	return calls
+}
+
+// GetAllCollectionsWithOptions calls GetAllCollectionsWithOptionsFunc.
+func (mock *CollectionsAPIMock) GetAllCollectionsWithOptions(ctx context.Context, sectionID int, listOptions plexgo.GetAllCollectionsOptions, opts ...operations.Option) ([]plexgo.Collection, error) {
+	if mock.GetAllCollectionsWithOptionsFunc == nil {
+		panic("CollectionsAPIMock.GetAllCollectionsWithOptionsFunc: method is nil but CollectionsAPI.GetAllCollectionsWithOptions was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.GetAllCollectionsOptions
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		ListOptions: listOptions,
+		Opts:        opts,
+	}
+	mock.lockGetAllCollectionsWithOptions.Lock()
+	mock.calls.GetAllCollectionsWithOptions = append(mock.calls.GetAllCollectionsWithOptions, callInfo)
+	mock.lockGetAllCollectionsWithOptions.Unlock()
+	return mock.GetAllCollectionsWithOptionsFunc(ctx, sectionID, listOptions, opts...)
+}
+
+// GetAllCollectionsWithOptionsCalls gets all the calls that were made to GetAllCollectionsWithOptions.
+// Check the length with:
+//
+//	len(mockedCollections.GetAllCollectionsWithOptionsCalls())
+func (mock *CollectionsAPIMock) GetAllCollectionsWithOptionsCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	ListOptions plexgo.GetAllCollectionsOptions
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		ListOptions plexgo.GetAllCollectionsOptions
+		Opts        []operations.Option
+	}
+	mock.lockGetAllCollectionsWithOptions.RLock()
+	calls = mock.calls.GetAllCollectionsWithOptions
+	mock.lockGetAllCollectionsWithOptions.RUnlock()
+	return calls
+}
+
+// GetCollection calls GetCollectionFunc.
+func (mock *CollectionsAPIMock) GetCollection(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.GetCollectionFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionFunc: method is nil but CollectionsAPI.GetCollection was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockGetCollection.Lock()
+	mock.calls.GetCollection = append(mock.calls.GetCollection, callInfo)
+	mock.lockGetCollection.Unlock()
+	return mock.GetCollectionFunc(ctx, collectionID, opts...)
+}
+
+// GetCollectionCalls gets all the calls that were made to GetCollection.
+// Check the length with:
+//
+//	len(mockedCollections.GetCollectionCalls())
+func (mock *CollectionsAPIMock) GetCollectionCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockGetCollection.RLock()
+	calls = mock.calls.GetCollection
+	mock.lockGetCollection.RUnlock()
+	return calls
+}
+
+// GetCollectionByKey calls GetCollectionByKeyFunc.
+func (mock *CollectionsAPIMock) GetCollectionByKey(ctx context.Context, ratingKey string, opts ...operations.Option) (*plexgo.Collection, error) {
+	if mock.GetCollectionByKeyFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionByKeyFunc: method is nil but CollectionsAPI.GetCollectionByKey was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey string
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Opts:      opts,
+	}
+	mock.lockGetCollectionByKey.Lock()
+	mock.calls.GetCollectionByKey = append(mock.calls.GetCollectionByKey, callInfo)
+	mock.lockGetCollectionByKey.Unlock()
+	return mock.GetCollectionByKeyFunc(ctx, ratingKey, opts...)
+}
+
+// GetCollectionByKeyCalls gets all the calls that were made to GetCollectionByKey.
+// Check the length with:
+//
+//	len(mockedCollections.GetCollectionByKeyCalls())
+func (mock *CollectionsAPIMock) GetCollectionByKeyCalls() []struct {
+	Ctx       context.Context
+	RatingKey string
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey string
+		Opts      []operations.Option
+	}
+	mock.lockGetCollectionByKey.RLock()
+	calls = mock.calls.GetCollectionByKey
+	mock.lockGetCollectionByKey.RUnlock()
+	return calls
+}
+
+// GetCollectionChildrenPage calls GetCollectionChildrenPageFunc.
+func (mock *CollectionsAPIMock) GetCollectionChildrenPage(ctx context.Context, collectionID int, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Metadata, plexgo.Cursor, error) {
+	if mock.GetCollectionChildrenPageFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionChildrenPageFunc: method is nil but CollectionsAPI.GetCollectionChildrenPage was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Cursor       plexgo.Cursor
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Cursor:       cursor,
+		Opts:         opts,
+	}
+	mock.lockGetCollectionChildrenPage.Lock()
+	mock.calls.GetCollectionChildrenPage = append(mock.calls.GetCollectionChildrenPage, callInfo)
+	mock.lockGetCollectionChildrenPage.Unlock()
+	return mock.GetCollectionChildrenPageFunc(ctx, collectionID, cursor, opts...)
+}
+
+// GetCollectionChildrenPageCalls gets all the calls that were made to GetCollectionChildrenPage.
+// Check the length with:
+//
+//	len(mockedCollections.GetCollectionChildrenPageCalls())
+func (mock *CollectionsAPIMock) GetCollectionChildrenPageCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Cursor       plexgo.Cursor
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Cursor       plexgo.Cursor
+		Opts         []operations.Option
+	}
+	mock.lockGetCollectionChildrenPage.RLock()
+	calls = mock.calls.GetCollectionChildrenPage
+	mock.lockGetCollectionChildrenPage.RUnlock()
+	return calls
+}
+
+// GetCollectionHub calls GetCollectionHubFunc.
+func (mock *CollectionsAPIMock) GetCollectionHub(ctx context.Context, sectionID int, collectionID int, opts ...operations.Option) (*plexgo.ManagedHub, error) {
+	if mock.GetCollectionHubFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionHubFunc: method is nil but CollectionsAPI.GetCollectionHub was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		SectionID    int
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		SectionID:    sectionID,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockGetCollectionHub.Lock()
+	mock.calls.GetCollectionHub = append(mock.calls.GetCollectionHub, callInfo)
+	mock.lockGetCollectionHub.Unlock()
+	return mock.GetCollectionHubFunc(ctx, sectionID, collectionID, opts...)
+}
+
+// GetCollectionHubCalls gets all the calls that were made to GetCollectionHub.
+// Check the length with:
+//
+//	len(mockedCollections.GetCollectionHubCalls())
+func (mock *CollectionsAPIMock) GetCollectionHubCalls() []struct {
+	Ctx          context.Context
+	SectionID    int
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		SectionID    int
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockGetCollectionHub.RLock()
+	calls = mock.calls.GetCollectionHub
+	mock.lockGetCollectionHub.RUnlock()
+	return calls
+}
+
+// GetCollectionItems calls GetCollectionItemsFunc.
+func (mock *CollectionsAPIMock) GetCollectionItems(ctx context.Context, collectionID int, opts ...operations.Option) ([]string, error) {
+	if mock.GetCollectionItemsFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionItemsFunc: method is nil but CollectionsAPI.GetCollectionItems was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockGetCollectionItems.Lock()
+	mock.calls.GetCollectionItems = append(mock.calls.GetCollectionItems, callInfo)
+	mock.lockGetCollectionItems.Unlock()
+	return mock.GetCollectionItemsFunc(ctx, collectionID, opts...)
+}
+
+// GetCollectionItemsCalls gets all the calls that were made to GetCollectionItems.
+// Check the length with:
+//
+//	len(mockedCollections.GetCollectionItemsCalls())
+func (mock *CollectionsAPIMock) GetCollectionItemsCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockGetCollectionItems.RLock()
+	calls = mock.calls.GetCollectionItems
+	mock.lockGetCollectionItems.RUnlock()
+	return calls
+}
+
+// GetCollectionStats calls GetCollectionStatsFunc.
+func (mock *CollectionsAPIMock) GetCollectionStats(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.CollectionStats, error) {
+	if mock.GetCollectionStatsFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionStatsFunc: method is nil but CollectionsAPI.GetCollectionStats was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockGetCollectionStats.Lock()
+	mock.calls.GetCollectionStats = append(mock.calls.GetCollectionStats, callInfo)
+	mock.lockGetCollectionStats.Unlock()
+	return mock.GetCollectionStatsFunc(ctx, collectionID, opts...)
+}
+
+// GetCollectionStatsCalls gets all the calls that were made to GetCollectionStats.
+// Check the length with:
+//
+//	len(mockedCollections.GetCollectionStatsCalls())
+func (mock *CollectionsAPIMock) GetCollectionStatsCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockGetCollectionStats.RLock()
+	calls = mock.calls.GetCollectionStats
+	mock.lockGetCollectionStats.RUnlock()
+	return calls
+}
+
+// GetCollectionVisibility calls GetCollectionVisibilityFunc.
+func (mock *CollectionsAPIMock) GetCollectionVisibility(ctx context.Context, sectionID int, collectionID int, opts ...operations.Option) (*plexgo.CollectionVisibility, error) {
+	if mock.GetCollectionVisibilityFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionVisibilityFunc: method is nil but CollectionsAPI.GetCollectionVisibility was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		SectionID    int
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		SectionID:    sectionID,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockGetCollectionVisibility.Lock()
+	mock.calls.GetCollectionVisibility = append(mock.calls.GetCollectionVisibility, callInfo)
+	mock.lockGetCollectionVisibility.Unlock()
+	return mock.GetCollectionVisibilityFunc(ctx, sectionID, collectionID, opts...)
+}
+
+// GetCollectionVisibilityCalls gets all the calls that were made to GetCollectionVisibility.
+// Check the length with:
+//
+//	len(mockedCollections.GetCollectionVisibilityCalls())
+func (mock *CollectionsAPIMock) GetCollectionVisibilityCalls() []struct {
+	Ctx          context.Context
+	SectionID    int
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		SectionID    int
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockGetCollectionVisibility.RLock()
+	calls = mock.calls.GetCollectionVisibility
+	mock.lockGetCollectionVisibility.RUnlock()
+	return calls
+}
+
+// GetCollectionsPage calls GetCollectionsPageFunc.
+func (mock *CollectionsAPIMock) GetCollectionsPage(ctx context.Context, sectionID int, cursor plexgo.Cursor, opts ...operations.Option) ([]plexgo.Collection, plexgo.Cursor, error) {
+	if mock.GetCollectionsPageFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionsPageFunc: method is nil but CollectionsAPI.GetCollectionsPage was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Cursor    plexgo.Cursor
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Cursor:    cursor,
+		Opts:      opts,
+	}
+	mock.lockGetCollectionsPage.Lock()
+	mock.calls.GetCollectionsPage = append(mock.calls.GetCollectionsPage, callInfo)
+	mock.lockGetCollectionsPage.Unlock()
+	return mock.GetCollectionsPageFunc(ctx, sectionID, cursor, opts...)
+}
+
+// GetCollectionsPageCalls gets all the calls that were made to GetCollectionsPage.
+// Check the length with:
+//
+//	len(mockedCollections.GetCollectionsPageCalls())
+func (mock *CollectionsAPIMock) GetCollectionsPageCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Cursor    plexgo.Cursor
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Cursor    plexgo.Cursor
+		Opts      []operations.Option
+	}
+	mock.lockGetCollectionsPage.RLock()
+	calls = mock.calls.GetCollectionsPage
+	mock.lockGetCollectionsPage.RUnlock()
+	return calls
+}
+
+// GetFilterTemplate calls GetFilterTemplateFunc.
+func (mock *CollectionsAPIMock) GetFilterTemplate(sectionID int, name string) (plexgo.FilterTemplate, bool) {
+	if mock.GetFilterTemplateFunc == nil {
+		panic("CollectionsAPIMock.GetFilterTemplateFunc: method is nil but CollectionsAPI.GetFilterTemplate was just called")
+	}
+	callInfo := struct {
+		SectionID int
+		Name      string
+	}{
+		SectionID: sectionID,
+		Name:      name,
+	}
+	mock.lockGetFilterTemplate.Lock()
+	mock.calls.GetFilterTemplate = append(mock.calls.GetFilterTemplate, callInfo)
+	mock.lockGetFilterTemplate.Unlock()
+	return mock.GetFilterTemplateFunc(sectionID, name)
+}
+
+// GetFilterTemplateCalls gets all the calls that were made to GetFilterTemplate.
+// Check the length with:
+//
+//	len(mockedCollections.GetFilterTemplateCalls())
+func (mock *CollectionsAPIMock) GetFilterTemplateCalls() []struct {
+	SectionID int
+	Name      string
+} {
+	var calls []struct {
+		SectionID int
+		Name      string
+	}
+	mock.lockGetFilterTemplate.RLock()
+	calls = mock.calls.GetFilterTemplate
+	mock.lockGetFilterTemplate.RUnlock()
+	return calls
+}
+
+// GetFilterTemplates calls GetFilterTemplatesFunc.
+func (mock *CollectionsAPIMock) GetFilterTemplates(sectionID int) []plexgo.FilterTemplate {
+	if mock.GetFilterTemplatesFunc == nil {
+		panic("CollectionsAPIMock.GetFilterTemplatesFunc: method is nil but CollectionsAPI.GetFilterTemplates was just called")
+	}
+	callInfo := struct {
+		SectionID int
+	}{
+		SectionID: sectionID,
+	}
+	mock.lockGetFilterTemplates.Lock()
+	mock.calls.GetFilterTemplates = append(mock.calls.GetFilterTemplates, callInfo)
+	mock.lockGetFilterTemplates.Unlock()
+	return mock.GetFilterTemplatesFunc(sectionID)
+}
+
+// GetFilterTemplatesCalls gets all the calls that were made to GetFilterTemplates.
+// Check the length with:
+//
+//	len(mockedCollections.GetFilterTemplatesCalls())
+func (mock *CollectionsAPIMock) GetFilterTemplatesCalls() []struct {
+	SectionID int
+} {
+	var calls []struct {
+		SectionID int
+	}
+	mock.lockGetFilterTemplates.RLock()
+	calls = mock.calls.GetFilterTemplates
+	mock.lockGetFilterTemplates.RUnlock()
+	return calls
+}
+
+// GetNextUnwatched calls GetNextUnwatchedFunc.
+func (mock *CollectionsAPIMock) GetNextUnwatched(ctx context.Context, collectionID int, accountID int, opts ...operations.Option) ([]plexgo.NextEpisode, error) {
+	if mock.GetNextUnwatchedFunc == nil {
+		panic("CollectionsAPIMock.GetNextUnwatchedFunc: method is nil but CollectionsAPI.GetNextUnwatched was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		AccountID    int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		AccountID:    accountID,
+		Opts:         opts,
+	}
+	mock.lockGetNextUnwatched.Lock()
+	mock.calls.GetNextUnwatched = append(mock.calls.GetNextUnwatched, callInfo)
+	mock.lockGetNextUnwatched.Unlock()
+	return mock.GetNextUnwatchedFunc(ctx, collectionID, accountID, opts...)
+}
+
+// GetNextUnwatchedCalls gets all the calls that were made to GetNextUnwatched.
+// Check the length with:
+//
+//	len(mockedCollections.GetNextUnwatchedCalls())
+func (mock *CollectionsAPIMock) GetNextUnwatchedCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	AccountID    int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		AccountID    int
+		Opts         []operations.Option
+	}
+	mock.lockGetNextUnwatched.RLock()
+	calls = mock.calls.GetNextUnwatched
+	mock.lockGetNextUnwatched.RUnlock()
+	return calls
+}
+
+// GetPreferences calls GetPreferencesFunc.
+func (mock *CollectionsAPIMock) GetPreferences(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.CollectionPreferences, error) {
+	if mock.GetPreferencesFunc == nil {
+		panic("CollectionsAPIMock.GetPreferencesFunc: method is nil but CollectionsAPI.GetPreferences was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockGetPreferences.Lock()
+	mock.calls.GetPreferences = append(mock.calls.GetPreferences, callInfo)
+	mock.lockGetPreferences.Unlock()
+	return mock.GetPreferencesFunc(ctx, collectionID, opts...)
+}
+
+// GetPreferencesCalls gets all the calls that were made to GetPreferences.
+// Check the length with:
+//
+//	len(mockedCollections.GetPreferencesCalls())
+func (mock *CollectionsAPIMock) GetPreferencesCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockGetPreferences.RLock()
+	calls = mock.calls.GetPreferences
+	mock.lockGetPreferences.RUnlock()
+	return calls
+}
+
+// GetRandomItem calls GetRandomItemFunc.
+func (mock *CollectionsAPIMock) GetRandomItem(ctx context.Context, collectionID int, unwatchedOnly bool, opts ...operations.Option) (*plexgo.Metadata, error) {
+	if mock.GetRandomItemFunc == nil {
+		panic("CollectionsAPIMock.GetRandomItemFunc: method is nil but CollectionsAPI.GetRandomItem was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		CollectionID  int
+		UnwatchedOnly bool
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		CollectionID:  collectionID,
+		UnwatchedOnly: unwatchedOnly,
+		Opts:          opts,
+	}
+	mock.lockGetRandomItem.Lock()
+	mock.calls.GetRandomItem = append(mock.calls.GetRandomItem, callInfo)
+	mock.lockGetRandomItem.Unlock()
+	return mock.GetRandomItemFunc(ctx, collectionID, unwatchedOnly, opts...)
+}
+
+// GetRandomItemCalls gets all the calls that were made to GetRandomItem.
+// Check the length with:
+//
+//	len(mockedCollections.GetRandomItemCalls())
+func (mock *CollectionsAPIMock) GetRandomItemCalls() []struct {
+	Ctx           context.Context
+	CollectionID  int
+	UnwatchedOnly bool
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		CollectionID  int
+		UnwatchedOnly bool
+		Opts          []operations.Option
+	}
+	mock.lockGetRandomItem.RLock()
+	calls = mock.calls.GetRandomItem
+	mock.lockGetRandomItem.RUnlock()
+	return calls
+}
+
+// GetSharingReport calls GetSharingReportFunc.
+func (mock *CollectionsAPIMock) GetSharingReport(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.CollectionSharingReport, error) {
+	if mock.GetSharingReportFunc == nil {
+		panic("CollectionsAPIMock.GetSharingReportFunc: method is nil but CollectionsAPI.GetSharingReport was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockGetSharingReport.Lock()
+	mock.calls.GetSharingReport = append(mock.calls.GetSharingReport, callInfo)
+	mock.lockGetSharingReport.Unlock()
+	return mock.GetSharingReportFunc(ctx, collectionID, opts...)
+}
+
+// GetSharingReportCalls gets all the calls that were made to GetSharingReport.
+// Check the length with:
+//
+//	len(mockedCollections.GetSharingReportCalls())
+func (mock *CollectionsAPIMock) GetSharingReportCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockGetSharingReport.RLock()
+	calls = mock.calls.GetSharingReport
+	mock.lockGetSharingReport.RUnlock()
+	return calls
+}
+
+// GetSmartFilter calls GetSmartFilterFunc.
+func (mock *CollectionsAPIMock) GetSmartFilter(ctx context.Context, collection *plexgo.Collection, opts ...operations.Option) (string, error) {
+	if mock.GetSmartFilterFunc == nil {
+		panic("CollectionsAPIMock.GetSmartFilterFunc: method is nil but CollectionsAPI.GetSmartFilter was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Collection *plexgo.Collection
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		Collection: collection,
+		Opts:       opts,
+	}
+	mock.lockGetSmartFilter.Lock()
+	mock.calls.GetSmartFilter = append(mock.calls.GetSmartFilter, callInfo)
+	mock.lockGetSmartFilter.Unlock()
+	return mock.GetSmartFilterFunc(ctx, collection, opts...)
+}
+
+// GetSmartFilterCalls gets all the calls that were made to GetSmartFilter.
+// Check the length with:
+//
+//	len(mockedCollections.GetSmartFilterCalls())
+func (mock *CollectionsAPIMock) GetSmartFilterCalls() []struct {
+	Ctx        context.Context
+	Collection *plexgo.Collection
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Collection *plexgo.Collection
+		Opts       []operations.Option
+	}
+	mock.lockGetSmartFilter.RLock()
+	calls = mock.calls.GetSmartFilter
+	mock.lockGetSmartFilter.RUnlock()
+	return calls
+}
+
+// GetSmartFilterConfig calls GetSmartFilterConfigFunc.
+func (mock *CollectionsAPIMock) GetSmartFilterConfig(ctx context.Context, collection *plexgo.Collection, opts ...operations.Option) (*plexgo.SmartFilterConfig, error) {
+	if mock.GetSmartFilterConfigFunc == nil {
+		panic("CollectionsAPIMock.GetSmartFilterConfigFunc: method is nil but CollectionsAPI.GetSmartFilterConfig was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Collection *plexgo.Collection
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		Collection: collection,
+		Opts:       opts,
+	}
+	mock.lockGetSmartFilterConfig.Lock()
+	mock.calls.GetSmartFilterConfig = append(mock.calls.GetSmartFilterConfig, callInfo)
+	mock.lockGetSmartFilterConfig.Unlock()
+	return mock.GetSmartFilterConfigFunc(ctx, collection, opts...)
+}
+
+// GetSmartFilterConfigCalls gets all the calls that were made to GetSmartFilterConfig.
+// Check the length with:
+//
+//	len(mockedCollections.GetSmartFilterConfigCalls())
+func (mock *CollectionsAPIMock) GetSmartFilterConfigCalls() []struct {
+	Ctx        context.Context
+	Collection *plexgo.Collection
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Collection *plexgo.Collection
+		Opts       []operations.Option
+	}
+	mock.lockGetSmartFilterConfig.RLock()
+	calls = mock.calls.GetSmartFilterConfig
+	mock.lockGetSmartFilterConfig.RUnlock()
+	return calls
+}
+
+// GetWatchNextFeed calls GetWatchNextFeedFunc.
+func (mock *CollectionsAPIMock) GetWatchNextFeed(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.WatchNextEntry, error) {
+	if mock.GetWatchNextFeedFunc == nil {
+		panic("CollectionsAPIMock.GetWatchNextFeedFunc: method is nil but CollectionsAPI.GetWatchNextFeed was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Opts:      opts,
+	}
+	mock.lockGetWatchNextFeed.Lock()
+	mock.calls.GetWatchNextFeed = append(mock.calls.GetWatchNextFeed, callInfo)
+	mock.lockGetWatchNextFeed.Unlock()
+	return mock.GetWatchNextFeedFunc(ctx, sectionID, opts...)
+}
+
+// GetWatchNextFeedCalls gets all the calls that were made to GetWatchNextFeed.
+// Check the length with:
+//
+//	len(mockedCollections.GetWatchNextFeedCalls())
+func (mock *CollectionsAPIMock) GetWatchNextFeedCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Opts      []operations.Option
+	}
+	mock.lockGetWatchNextFeed.RLock()
+	calls = mock.calls.GetWatchNextFeed
+	mock.lockGetWatchNextFeed.RUnlock()
+	return calls
+}
+
+// HandleTasks calls HandleTasksFunc.
+func (mock *CollectionsAPIMock) HandleTasks(q *plexgo.TaskQueue, opts ...operations.Option) {
+	if mock.HandleTasksFunc == nil {
+		panic("CollectionsAPIMock.HandleTasksFunc: method is nil but CollectionsAPI.HandleTasks was just called")
+	}
+	callInfo := struct {
+		Q    *plexgo.TaskQueue
+		Opts []operations.Option
+	}{
+		Q:    q,
+		Opts: opts,
+	}
+	mock.lockHandleTasks.Lock()
+	mock.calls.HandleTasks = append(mock.calls.HandleTasks, callInfo)
+	mock.lockHandleTasks.Unlock()
+	mock.HandleTasksFunc(q, opts...)
+}
+
+// HandleTasksCalls gets all the calls that were made to HandleTasks.
+// Check the length with:
+//
+//	len(mockedCollections.HandleTasksCalls())
+func (mock *CollectionsAPIMock) HandleTasksCalls() []struct {
+	Q    *plexgo.TaskQueue
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Q    *plexgo.TaskQueue
+		Opts []operations.Option
+	}
+	mock.lockHandleTasks.RLock()
+	calls = mock.calls.HandleTasks
+	mock.lockHandleTasks.RUnlock()
+	return calls
+}
+
+// HideFromUser calls HideFromUserFunc.
+func (mock *CollectionsAPIMock) HideFromUser(ctx context.Context, collectionID int, label string, user operations.User, opts ...operations.Option) error {
+	if mock.HideFromUserFunc == nil {
+		panic("CollectionsAPIMock.HideFromUserFunc: method is nil but CollectionsAPI.HideFromUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Label        string
+		User         operations.User
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Label:        label,
+		User:         user,
+		Opts:         opts,
+	}
+	mock.lockHideFromUser.Lock()
+	mock.calls.HideFromUser = append(mock.calls.HideFromUser, callInfo)
+	mock.lockHideFromUser.Unlock()
+	return mock.HideFromUserFunc(ctx, collectionID, label, user, opts...)
+}
+
+// HideFromUserCalls gets all the calls that were made to HideFromUser.
+// Check the length with:
+//
+//	len(mockedCollections.HideFromUserCalls())
+func (mock *CollectionsAPIMock) HideFromUserCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Label        string
+	User         operations.User
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Label        string
+		User         operations.User
+		Opts         []operations.Option
+	}
+	mock.lockHideFromUser.RLock()
+	calls = mock.calls.HideFromUser
+	mock.lockHideFromUser.RUnlock()
+	return calls
+}
+
+// MoveCollectionItem calls MoveCollectionItemFunc.
+func (mock *CollectionsAPIMock) MoveCollectionItem(ctx context.Context, collectionID int, itemID string, afterItemID string, opts ...operations.Option) error {
+	if mock.MoveCollectionItemFunc == nil {
+		panic("CollectionsAPIMock.MoveCollectionItemFunc: method is nil but CollectionsAPI.MoveCollectionItem was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		ItemID       string
+		AfterItemID  string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		ItemID:       itemID,
+		AfterItemID:  afterItemID,
+		Opts:         opts,
+	}
+	mock.lockMoveCollectionItem.Lock()
+	mock.calls.MoveCollectionItem = append(mock.calls.MoveCollectionItem, callInfo)
+	mock.lockMoveCollectionItem.Unlock()
+	return mock.MoveCollectionItemFunc(ctx, collectionID, itemID, afterItemID, opts...)
+}
+
+// MoveCollectionItemCalls gets all the calls that were made to MoveCollectionItem.
+// Check the length with:
+//
+//	len(mockedCollections.MoveCollectionItemCalls())
+func (mock *CollectionsAPIMock) MoveCollectionItemCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	ItemID       string
+	AfterItemID  string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		ItemID       string
+		AfterItemID  string
+		Opts         []operations.Option
+	}
+	mock.lockMoveCollectionItem.RLock()
+	calls = mock.calls.MoveCollectionItem
+	mock.lockMoveCollectionItem.RUnlock()
+	return calls
+}
+
+// NewFeaturedRotation calls NewFeaturedRotationFunc.
+func (mock *CollectionsAPIMock) NewFeaturedRotation(sectionID int, collectionIDs ...int) *plexgo.FeaturedRotation {
+	if mock.NewFeaturedRotationFunc == nil {
+		panic("CollectionsAPIMock.NewFeaturedRotationFunc: method is nil but CollectionsAPI.NewFeaturedRotation was just called")
+	}
+	callInfo := struct {
+		SectionID     int
+		CollectionIDs []int
+	}{
+		SectionID:     sectionID,
+		CollectionIDs: collectionIDs,
+	}
+	mock.lockNewFeaturedRotation.Lock()
+	mock.calls.NewFeaturedRotation = append(mock.calls.NewFeaturedRotation, callInfo)
+	mock.lockNewFeaturedRotation.Unlock()
+	return mock.NewFeaturedRotationFunc(sectionID, collectionIDs...)
+}
+
+// NewFeaturedRotationCalls gets all the calls that were made to NewFeaturedRotation.
+// Check the length with:
+//
+//	len(mockedCollections.NewFeaturedRotationCalls())
+func (mock *CollectionsAPIMock) NewFeaturedRotationCalls() []struct {
+	SectionID     int
+	CollectionIDs []int
+} {
+	var calls []struct {
+		SectionID     int
+		CollectionIDs []int
+	}
+	mock.lockNewFeaturedRotation.RLock()
+	calls = mock.calls.NewFeaturedRotation
+	mock.lockNewFeaturedRotation.RUnlock()
+	return calls
+}
+
+// NewWatcher calls NewWatcherFunc.
+func (mock *CollectionsAPIMock) NewWatcher(sectionID int) *plexgo.CollectionWatcher {
+	if mock.NewWatcherFunc == nil {
+		panic("CollectionsAPIMock.NewWatcherFunc: method is nil but CollectionsAPI.NewWatcher was just called")
+	}
+	callInfo := struct {
+		SectionID int
+	}{
+		SectionID: sectionID,
+	}
+	mock.lockNewWatcher.Lock()
+	mock.calls.NewWatcher = append(mock.calls.NewWatcher, callInfo)
+	mock.lockNewWatcher.Unlock()
+	return mock.NewWatcherFunc(sectionID)
+}
+
+// NewWatcherCalls gets all the calls that were made to NewWatcher.
+// Check the length with:
+//
+//	len(mockedCollections.NewWatcherCalls())
+func (mock *CollectionsAPIMock) NewWatcherCalls() []struct {
+	SectionID int
+} {
+	var calls []struct {
+		SectionID int
+	}
+	mock.lockNewWatcher.RLock()
+	calls = mock.calls.NewWatcher
+	mock.lockNewWatcher.RUnlock()
+	return calls
+}
+
+// NormalizeSortTitles calls NormalizeSortTitlesFunc.
+func (mock *CollectionsAPIMock) NormalizeSortTitles(ctx context.Context, collectionID int, sortOptions plexgo.SortTitleOptions, opts ...operations.Option) ([]plexgo.SortTitleChange, error) {
+	if mock.NormalizeSortTitlesFunc == nil {
+		panic("CollectionsAPIMock.NormalizeSortTitlesFunc: method is nil but CollectionsAPI.NormalizeSortTitles was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		SortOptions  plexgo.SortTitleOptions
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		SortOptions:  sortOptions,
+		Opts:         opts,
+	}
+	mock.lockNormalizeSortTitles.Lock()
+	mock.calls.NormalizeSortTitles = append(mock.calls.NormalizeSortTitles, callInfo)
+	mock.lockNormalizeSortTitles.Unlock()
+	return mock.NormalizeSortTitlesFunc(ctx, collectionID, sortOptions, opts...)
+}
+
+// NormalizeSortTitlesCalls gets all the calls that were made to NormalizeSortTitles.
+// Check the length with:
+//
+//	len(mockedCollections.NormalizeSortTitlesCalls())
+func (mock *CollectionsAPIMock) NormalizeSortTitlesCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	SortOptions  plexgo.SortTitleOptions
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		SortOptions  plexgo.SortTitleOptions
+		Opts         []operations.Option
+	}
+	mock.lockNormalizeSortTitles.RLock()
+	calls = mock.calls.NormalizeSortTitles
+	mock.lockNormalizeSortTitles.RUnlock()
+	return calls
+}
+
+// Plan calls PlanFunc.
+func (mock *CollectionsAPIMock) Plan(ctx context.Context, state *plexgo.CollectionState, opts ...operations.Option) (*plexgo.CollectionPlan, error) {
+	if mock.PlanFunc == nil {
+		panic("CollectionsAPIMock.PlanFunc: method is nil but CollectionsAPI.Plan was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		State *plexgo.CollectionState
+		Opts  []operations.Option
+	}{
+		Ctx:   ctx,
+		State: state,
+		Opts:  opts,
+	}
+	mock.lockPlan.Lock()
+	mock.calls.Plan = append(mock.calls.Plan, callInfo)
+	mock.lockPlan.Unlock()
+	return mock.PlanFunc(ctx, state, opts...)
+}
+
+// PlanCalls gets all the calls that were made to Plan.
+// Check the length with:
+//
+//	len(mockedCollections.PlanCalls())
+func (mock *CollectionsAPIMock) PlanCalls() []struct {
+	Ctx   context.Context
+	State *plexgo.CollectionState
+	Opts  []operations.Option
+} {
+	var calls []struct {
+		Ctx   context.Context
+		State *plexgo.CollectionState
+		Opts  []operations.Option
+	}
+	mock.lockPlan.RLock()
+	calls = mock.calls.Plan
+	mock.lockPlan.RUnlock()
+	return calls
+}
+
+// RegisterFilterTemplate calls RegisterFilterTemplateFunc.
+func (mock *CollectionsAPIMock) RegisterFilterTemplate(ctx context.Context, sectionID int, name string, itemType plexgo.MetadataType, filter plexgo.FilterGroup, opts ...operations.Option) (*plexgo.FilterTemplate, error) {
+	if mock.RegisterFilterTemplateFunc == nil {
+		panic("CollectionsAPIMock.RegisterFilterTemplateFunc: method is nil but CollectionsAPI.RegisterFilterTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Name      string
+		ItemType  plexgo.MetadataType
+		Filter    plexgo.FilterGroup
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Name:      name,
+		ItemType:  itemType,
+		Filter:    filter,
+		Opts:      opts,
+	}
+	mock.lockRegisterFilterTemplate.Lock()
+	mock.calls.RegisterFilterTemplate = append(mock.calls.RegisterFilterTemplate, callInfo)
+	mock.lockRegisterFilterTemplate.Unlock()
+	return mock.RegisterFilterTemplateFunc(ctx, sectionID, name, itemType, filter, opts...)
+}
+
+// RegisterFilterTemplateCalls gets all the calls that were made to RegisterFilterTemplate.
+// Check the length with:
+//
+//	len(mockedCollections.RegisterFilterTemplateCalls())
+func (mock *CollectionsAPIMock) RegisterFilterTemplateCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Name      string
+	ItemType  plexgo.MetadataType
+	Filter    plexgo.FilterGroup
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Name      string
+		ItemType  plexgo.MetadataType
+		Filter    plexgo.FilterGroup
+		Opts      []operations.Option
+	}
+	mock.lockRegisterFilterTemplate.RLock()
+	calls = mock.calls.RegisterFilterTemplate
+	mock.lockRegisterFilterTemplate.RUnlock()
+	return calls
+}
+
+// RemoveFromCollection calls RemoveFromCollectionFunc.
+func (mock *CollectionsAPIMock) RemoveFromCollection(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error {
+	if mock.RemoveFromCollectionFunc == nil {
+		panic("CollectionsAPIMock.RemoveFromCollectionFunc: method is nil but CollectionsAPI.RemoveFromCollection was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		ItemIDs      []string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		ItemIDs:      itemIDs,
+		Opts:         opts,
+	}
+	mock.lockRemoveFromCollection.Lock()
+	mock.calls.RemoveFromCollection = append(mock.calls.RemoveFromCollection, callInfo)
+	mock.lockRemoveFromCollection.Unlock()
+	return mock.RemoveFromCollectionFunc(ctx, collectionID, itemIDs, opts...)
+}
+
+// RemoveFromCollectionCalls gets all the calls that were made to RemoveFromCollection.
+// Check the length with:
+//
+//	len(mockedCollections.RemoveFromCollectionCalls())
+func (mock *CollectionsAPIMock) RemoveFromCollectionCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	ItemIDs      []string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		ItemIDs      []string
+		Opts         []operations.Option
+	}
+	mock.lockRemoveFromCollection.RLock()
+	calls = mock.calls.RemoveFromCollection
+	mock.lockRemoveFromCollection.RUnlock()
+	return calls
+}
+
+// RemoveTheme calls RemoveThemeFunc.
+func (mock *CollectionsAPIMock) RemoveTheme(ctx context.Context, collectionID int, opts ...operations.Option) error {
+	if mock.RemoveThemeFunc == nil {
+		panic("CollectionsAPIMock.RemoveThemeFunc: method is nil but CollectionsAPI.RemoveTheme was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockRemoveTheme.Lock()
+	mock.calls.RemoveTheme = append(mock.calls.RemoveTheme, callInfo)
+	mock.lockRemoveTheme.Unlock()
+	return mock.RemoveThemeFunc(ctx, collectionID, opts...)
+}
+
+// RemoveThemeCalls gets all the calls that were made to RemoveTheme.
+// Check the length with:
+//
+//	len(mockedCollections.RemoveThemeCalls())
+func (mock *CollectionsAPIMock) RemoveThemeCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockRemoveTheme.RLock()
+	calls = mock.calls.RemoveTheme
+	mock.lockRemoveTheme.RUnlock()
+	return calls
+}
+
+// RenderCollectionTemplate calls RenderCollectionTemplateFunc.
+func (mock *CollectionsAPIMock) RenderCollectionTemplate(ctx context.Context, collectionID int, tmpl *plexgo.CollectionTemplate, opts ...operations.Option) (bool, error) {
+	if mock.RenderCollectionTemplateFunc == nil {
+		panic("CollectionsAPIMock.RenderCollectionTemplateFunc: method is nil but CollectionsAPI.RenderCollectionTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Tmpl         *plexgo.CollectionTemplate
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Tmpl:         tmpl,
+		Opts:         opts,
+	}
+	mock.lockRenderCollectionTemplate.Lock()
+	mock.calls.RenderCollectionTemplate = append(mock.calls.RenderCollectionTemplate, callInfo)
+	mock.lockRenderCollectionTemplate.Unlock()
+	return mock.RenderCollectionTemplateFunc(ctx, collectionID, tmpl, opts...)
+}
+
+// RenderCollectionTemplateCalls gets all the calls that were made to RenderCollectionTemplate.
+// Check the length with:
+//
+//	len(mockedCollections.RenderCollectionTemplateCalls())
+func (mock *CollectionsAPIMock) RenderCollectionTemplateCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Tmpl         *plexgo.CollectionTemplate
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Tmpl         *plexgo.CollectionTemplate
+		Opts         []operations.Option
+	}
+	mock.lockRenderCollectionTemplate.RLock()
+	calls = mock.calls.RenderCollectionTemplate
+	mock.lockRenderCollectionTemplate.RUnlock()
+	return calls
+}
+
+// RepairSmart calls RepairSmartFunc.
+func (mock *CollectionsAPIMock) RepairSmart(ctx context.Context, collectionID int, opts ...operations.Option) (*plexgo.SmartRepair, error) {
+	if mock.RepairSmartFunc == nil {
+		panic("CollectionsAPIMock.RepairSmartFunc: method is nil but CollectionsAPI.RepairSmart was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Opts:         opts,
+	}
+	mock.lockRepairSmart.Lock()
+	mock.calls.RepairSmart = append(mock.calls.RepairSmart, callInfo)
+	mock.lockRepairSmart.Unlock()
+	return mock.RepairSmartFunc(ctx, collectionID, opts...)
+}
+
+// RepairSmartCalls gets all the calls that were made to RepairSmart.
+// Check the length with:
+//
+//	len(mockedCollections.RepairSmartCalls())
+func (mock *CollectionsAPIMock) RepairSmartCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Opts         []operations.Option
+	}
+	mock.lockRepairSmart.RLock()
+	calls = mock.calls.RepairSmart
+	mock.lockRepairSmart.RUnlock()
+	return calls
+}
+
+// ResolveFilterTemplates calls ResolveFilterTemplatesFunc.
+func (mock *CollectionsAPIMock) ResolveFilterTemplates(sectionID int, filter *plexgo.SmartFilter) (*plexgo.SmartFilter, error) {
+	if mock.ResolveFilterTemplatesFunc == nil {
+		panic("CollectionsAPIMock.ResolveFilterTemplatesFunc: method is nil but CollectionsAPI.ResolveFilterTemplates was just called")
+	}
+	callInfo := struct {
+		SectionID int
+		Filter    *plexgo.SmartFilter
+	}{
+		SectionID: sectionID,
+		Filter:    filter,
+	}
+	mock.lockResolveFilterTemplates.Lock()
+	mock.calls.ResolveFilterTemplates = append(mock.calls.ResolveFilterTemplates, callInfo)
+	mock.lockResolveFilterTemplates.Unlock()
+	return mock.ResolveFilterTemplatesFunc(sectionID, filter)
+}
+
+// ResolveFilterTemplatesCalls gets all the calls that were made to ResolveFilterTemplates.
+// Check the length with:
+//
+//	len(mockedCollections.ResolveFilterTemplatesCalls())
+func (mock *CollectionsAPIMock) ResolveFilterTemplatesCalls() []struct {
+	SectionID int
+	Filter    *plexgo.SmartFilter
+} {
+	var calls []struct {
+		SectionID int
+		Filter    *plexgo.SmartFilter
+	}
+	mock.lockResolveFilterTemplates.RLock()
+	calls = mock.calls.ResolveFilterTemplates
+	mock.lockResolveFilterTemplates.RUnlock()
+	return calls
+}
+
+// ResolveSmartFilterURI calls ResolveSmartFilterURIFunc.
+func (mock *CollectionsAPIMock) ResolveSmartFilterURI(uri string, opts ...operations.Option) (string, error) {
+	if mock.ResolveSmartFilterURIFunc == nil {
+		panic("CollectionsAPIMock.ResolveSmartFilterURIFunc: method is nil but CollectionsAPI.ResolveSmartFilterURI was just called")
+	}
+	callInfo := struct {
+		Uri  string
+		Opts []operations.Option
+	}{
+		Uri:  uri,
+		Opts: opts,
+	}
+	mock.lockResolveSmartFilterURI.Lock()
+	mock.calls.ResolveSmartFilterURI = append(mock.calls.ResolveSmartFilterURI, callInfo)
+	mock.lockResolveSmartFilterURI.Unlock()
+	return mock.ResolveSmartFilterURIFunc(uri, opts...)
+}
+
+// ResolveSmartFilterURICalls gets all the calls that were made to ResolveSmartFilterURI.
+// Check the length with:
+//
+//	len(mockedCollections.ResolveSmartFilterURICalls())
+func (mock *CollectionsAPIMock) ResolveSmartFilterURICalls() []struct {
+	Uri  string
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Uri  string
+		Opts []operations.Option
+	}
+	mock.lockResolveSmartFilterURI.RLock()
+	calls = mock.calls.ResolveSmartFilterURI
+	mock.lockResolveSmartFilterURI.RUnlock()
+	return calls
+}
+
+// Restore calls RestoreFunc.
+func (mock *CollectionsAPIMock) Restore(ctx context.Context, r io.Reader, opts ...operations.Option) (*plexgo.RestoredCollection, error) {
+	if mock.RestoreFunc == nil {
+		panic("CollectionsAPIMock.RestoreFunc: method is nil but CollectionsAPI.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		R    io.Reader
+		Opts []operations.Option
+	}{
+		Ctx:  ctx,
+		R:    r,
+		Opts: opts,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, r, opts...)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//
+//	len(mockedCollections.RestoreCalls())
+func (mock *CollectionsAPIMock) RestoreCalls() []struct {
+	Ctx  context.Context
+	R    io.Reader
+	Opts []operations.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		R    io.Reader
+		Opts []operations.Option
+	}
+	mock.lockRestore.RLock()
+	calls = mock.calls.Restore
+	mock.lockRestore.RUnlock()
+	return calls
+}
+
+// RestoreCollections calls RestoreCollectionsFunc.
+func (mock *CollectionsAPIMock) RestoreCollections(ctx context.Context, dir string, resume *plexgo.Checkpoint, opts ...operations.Option) ([]plexgo.RestoredCollection, *plexgo.Checkpoint, error) {
+	if mock.RestoreCollectionsFunc == nil {
+		panic("CollectionsAPIMock.RestoreCollectionsFunc: method is nil but CollectionsAPI.RestoreCollections was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Dir    string
+		Resume *plexgo.Checkpoint
+		Opts   []operations.Option
+	}{
+		Ctx:    ctx,
+		Dir:    dir,
+		Resume: resume,
+		Opts:   opts,
+	}
+	mock.lockRestoreCollections.Lock()
+	mock.calls.RestoreCollections = append(mock.calls.RestoreCollections, callInfo)
+	mock.lockRestoreCollections.Unlock()
+	return mock.RestoreCollectionsFunc(ctx, dir, resume, opts...)
+}
+
+// RestoreCollectionsCalls gets all the calls that were made to RestoreCollections.
+// Check the length with:
+//
+//	len(mockedCollections.RestoreCollectionsCalls())
+func (mock *CollectionsAPIMock) RestoreCollectionsCalls() []struct {
+	Ctx    context.Context
+	Dir    string
+	Resume *plexgo.Checkpoint
+	Opts   []operations.Option
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Dir    string
+		Resume *plexgo.Checkpoint
+		Opts   []operations.Option
+	}
+	mock.lockRestoreCollections.RLock()
+	calls = mock.calls.RestoreCollections
+	mock.lockRestoreCollections.RUnlock()
+	return calls
+}
+
+// RunKometaCollections calls RunKometaCollectionsFunc.
+func (mock *CollectionsAPIMock) RunKometaCollections(ctx context.Context, sectionID int, collections []plexgo.KometaCollection, opts ...operations.Option) ([]*plexgo.Collection, error) {
+	if mock.RunKometaCollectionsFunc == nil {
+		panic("CollectionsAPIMock.RunKometaCollectionsFunc: method is nil but CollectionsAPI.RunKometaCollections was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		Collections []plexgo.KometaCollection
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		Collections: collections,
+		Opts:        opts,
+	}
+	mock.lockRunKometaCollections.Lock()
+	mock.calls.RunKometaCollections = append(mock.calls.RunKometaCollections, callInfo)
+	mock.lockRunKometaCollections.Unlock()
+	return mock.RunKometaCollectionsFunc(ctx, sectionID, collections, opts...)
+}
+
+// RunKometaCollectionsCalls gets all the calls that were made to RunKometaCollections.
+// Check the length with:
+//
+//	len(mockedCollections.RunKometaCollectionsCalls())
+func (mock *CollectionsAPIMock) RunKometaCollectionsCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	Collections []plexgo.KometaCollection
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		Collections []plexgo.KometaCollection
+		Opts        []operations.Option
+	}
+	mock.lockRunKometaCollections.RLock()
+	calls = mock.calls.RunKometaCollections
+	mock.lockRunKometaCollections.RUnlock()
+	return calls
+}
+
+// SearchItems calls SearchItemsFunc.
+func (mock *CollectionsAPIMock) SearchItems(ctx context.Context, collectionID int, query plexgo.CollectionItemQuery, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.SearchItemsFunc == nil {
+		panic("CollectionsAPIMock.SearchItemsFunc: method is nil but CollectionsAPI.SearchItems was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Query        plexgo.CollectionItemQuery
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Query:        query,
+		Opts:         opts,
+	}
+	mock.lockSearchItems.Lock()
+	mock.calls.SearchItems = append(mock.calls.SearchItems, callInfo)
+	mock.lockSearchItems.Unlock()
+	return mock.SearchItemsFunc(ctx, collectionID, query, opts...)
+}
+
+// SearchItemsCalls gets all the calls that were made to SearchItems.
+// Check the length with:
+//
+//	len(mockedCollections.SearchItemsCalls())
+func (mock *CollectionsAPIMock) SearchItemsCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Query        plexgo.CollectionItemQuery
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Query        plexgo.CollectionItemQuery
+		Opts         []operations.Option
+	}
+	mock.lockSearchItems.RLock()
+	calls = mock.calls.SearchItems
+	mock.lockSearchItems.RUnlock()
+	return calls
+}
+
+// SetContentRatingLocked calls SetContentRatingLockedFunc.
+func (mock *CollectionsAPIMock) SetContentRatingLocked(ctx context.Context, collectionID int, locked bool, opts ...operations.Option) error {
+	if mock.SetContentRatingLockedFunc == nil {
+		panic("CollectionsAPIMock.SetContentRatingLockedFunc: method is nil but CollectionsAPI.SetContentRatingLocked was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Locked       bool
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Locked:       locked,
+		Opts:         opts,
+	}
+	mock.lockSetContentRatingLocked.Lock()
+	mock.calls.SetContentRatingLocked = append(mock.calls.SetContentRatingLocked, callInfo)
+	mock.lockSetContentRatingLocked.Unlock()
+	return mock.SetContentRatingLockedFunc(ctx, collectionID, locked, opts...)
+}
+
+// SetContentRatingLockedCalls gets all the calls that were made to SetContentRatingLocked.
+// Check the length with:
+//
+//	len(mockedCollections.SetContentRatingLockedCalls())
+func (mock *CollectionsAPIMock) SetContentRatingLockedCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Locked       bool
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Locked       bool
+		Opts         []operations.Option
+	}
+	mock.lockSetContentRatingLocked.RLock()
+	calls = mock.calls.SetContentRatingLocked
+	mock.lockSetContentRatingLocked.RUnlock()
+	return calls
+}
+
+// ShowToUser calls ShowToUserFunc.
+func (mock *CollectionsAPIMock) ShowToUser(ctx context.Context, collectionID int, label string, user operations.User, opts ...operations.Option) error {
+	if mock.ShowToUserFunc == nil {
+		panic("CollectionsAPIMock.ShowToUserFunc: method is nil but CollectionsAPI.ShowToUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Label        string
+		User         operations.User
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Label:        label,
+		User:         user,
+		Opts:         opts,
+	}
+	mock.lockShowToUser.Lock()
+	mock.calls.ShowToUser = append(mock.calls.ShowToUser, callInfo)
+	mock.lockShowToUser.Unlock()
+	return mock.ShowToUserFunc(ctx, collectionID, label, user, opts...)
+}
+
+// ShowToUserCalls gets all the calls that were made to ShowToUser.
+// Check the length with:
+//
+//	len(mockedCollections.ShowToUserCalls())
+func (mock *CollectionsAPIMock) ShowToUserCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Label        string
+	User         operations.User
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Label        string
+		User         operations.User
+		Opts         []operations.Option
+	}
+	mock.lockShowToUser.RLock()
+	calls = mock.calls.ShowToUser
+	mock.lockShowToUser.RUnlock()
+	return calls
+}
+
+// TestSmartFilter calls TestSmartFilterFunc.
+func (mock *CollectionsAPIMock) TestSmartFilter(ctx context.Context, sectionID int, filterQuery string, opts ...operations.Option) (bool, error) {
+	if mock.TestSmartFilterFunc == nil {
+		panic("CollectionsAPIMock.TestSmartFilterFunc: method is nil but CollectionsAPI.TestSmartFilter was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		SectionID   int
+		FilterQuery string
+		Opts        []operations.Option
+	}{
+		Ctx:         ctx,
+		SectionID:   sectionID,
+		FilterQuery: filterQuery,
+		Opts:        opts,
+	}
+	mock.lockTestSmartFilter.Lock()
+	mock.calls.TestSmartFilter = append(mock.calls.TestSmartFilter, callInfo)
+	mock.lockTestSmartFilter.Unlock()
+	return mock.TestSmartFilterFunc(ctx, sectionID, filterQuery, opts...)
+}
+
+// TestSmartFilterCalls gets all the calls that were made to TestSmartFilter.
+// Check the length with:
+//
+//	len(mockedCollections.TestSmartFilterCalls())
+func (mock *CollectionsAPIMock) TestSmartFilterCalls() []struct {
+	Ctx         context.Context
+	SectionID   int
+	FilterQuery string
+	Opts        []operations.Option
+} {
+	var calls []struct {
+		Ctx         context.Context
+		SectionID   int
+		FilterQuery string
+		Opts        []operations.Option
+	}
+	mock.lockTestSmartFilter.RLock()
+	calls = mock.calls.TestSmartFilter
+	mock.lockTestSmartFilter.RUnlock()
+	return calls
+}
+
+// UnregisterFilterTemplate calls UnregisterFilterTemplateFunc.
+func (mock *CollectionsAPIMock) UnregisterFilterTemplate(sectionID int, name string) {
+	if mock.UnregisterFilterTemplateFunc == nil {
+		panic("CollectionsAPIMock.UnregisterFilterTemplateFunc: method is nil but CollectionsAPI.UnregisterFilterTemplate was just called")
+	}
+	callInfo := struct {
+		SectionID int
+		Name      string
+	}{
+		SectionID: sectionID,
+		Name:      name,
+	}
+	mock.lockUnregisterFilterTemplate.Lock()
+	mock.calls.UnregisterFilterTemplate = append(mock.calls.UnregisterFilterTemplate, callInfo)
+	mock.lockUnregisterFilterTemplate.Unlock()
+	mock.UnregisterFilterTemplateFunc(sectionID, name)
+}
+
+// UnregisterFilterTemplateCalls gets all the calls that were made to UnregisterFilterTemplate.
+// Check the length with:
+//
+//	len(mockedCollections.UnregisterFilterTemplateCalls())
+func (mock *CollectionsAPIMock) UnregisterFilterTemplateCalls() []struct {
+	SectionID int
+	Name      string
+} {
+	var calls []struct {
+		SectionID int
+		Name      string
+	}
+	mock.lockUnregisterFilterTemplate.RLock()
+	calls = mock.calls.UnregisterFilterTemplate
+	mock.lockUnregisterFilterTemplate.RUnlock()
+	return calls
+}
+
+// UpdateCollectionMode calls UpdateCollectionModeFunc.
+func (mock *CollectionsAPIMock) UpdateCollectionMode(ctx context.Context, collectionID int, mode string, opts ...operations.Option) (string, error) {
+	if mock.UpdateCollectionModeFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionModeFunc: method is nil but CollectionsAPI.UpdateCollectionMode was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Mode         string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Mode:         mode,
+		Opts:         opts,
+	}
+	mock.lockUpdateCollectionMode.Lock()
+	mock.calls.UpdateCollectionMode = append(mock.calls.UpdateCollectionMode, callInfo)
+	mock.lockUpdateCollectionMode.Unlock()
+	return mock.UpdateCollectionModeFunc(ctx, collectionID, mode, opts...)
+}
+
+// UpdateCollectionModeCalls gets all the calls that were made to UpdateCollectionMode.
+// Check the length with:
+//
+//	len(mockedCollections.UpdateCollectionModeCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionModeCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Mode         string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Mode         string
+		Opts         []operations.Option
+	}
+	mock.lockUpdateCollectionMode.RLock()
+	calls = mock.calls.UpdateCollectionMode
+	mock.lockUpdateCollectionMode.RUnlock()
+	return calls
+}
+
+// UpdateCollectionSort calls UpdateCollectionSortFunc.
+func (mock *CollectionsAPIMock) UpdateCollectionSort(ctx context.Context, collectionID int, sort string, opts ...operations.Option) (string, error) {
+	if mock.UpdateCollectionSortFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionSortFunc: method is nil but CollectionsAPI.UpdateCollectionSort was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		Sort         string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		Sort:         sort,
+		Opts:         opts,
+	}
+	mock.lockUpdateCollectionSort.Lock()
+	mock.calls.UpdateCollectionSort = append(mock.calls.UpdateCollectionSort, callInfo)
+	mock.lockUpdateCollectionSort.Unlock()
+	return mock.UpdateCollectionSortFunc(ctx, collectionID, sort, opts...)
+}
+
+// UpdateCollectionSortCalls gets all the calls that were made to UpdateCollectionSort.
+// Check the length with:
+//
+//	len(mockedCollections.UpdateCollectionSortCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionSortCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	Sort         string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		Sort         string
+		Opts         []operations.Option
+	}
+	mock.lockUpdateCollectionSort.RLock()
+	calls = mock.calls.UpdateCollectionSort
+	mock.lockUpdateCollectionSort.RUnlock()
+	return calls
+}
+
+// UpdateCollectionVisibility calls UpdateCollectionVisibilityFunc.
+func (mock *CollectionsAPIMock) UpdateCollectionVisibility(ctx context.Context, sectionID int, collectionID int, visibility *plexgo.CollectionVisibility, opts ...operations.Option) error {
+	if mock.UpdateCollectionVisibilityFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionVisibilityFunc: method is nil but CollectionsAPI.UpdateCollectionVisibility was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		SectionID    int
+		CollectionID int
+		Visibility   *plexgo.CollectionVisibility
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		SectionID:    sectionID,
+		CollectionID: collectionID,
+		Visibility:   visibility,
+		Opts:         opts,
+	}
+	mock.lockUpdateCollectionVisibility.Lock()
+	mock.calls.UpdateCollectionVisibility = append(mock.calls.UpdateCollectionVisibility, callInfo)
+	mock.lockUpdateCollectionVisibility.Unlock()
+	return mock.UpdateCollectionVisibilityFunc(ctx, sectionID, collectionID, visibility, opts...)
+}
+
+// UpdateCollectionVisibilityCalls gets all the calls that were made to UpdateCollectionVisibility.
+// Check the length with:
+//
+//	len(mockedCollections.UpdateCollectionVisibilityCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionVisibilityCalls() []struct {
+	Ctx          context.Context
+	SectionID    int
+	CollectionID int
+	Visibility   *plexgo.CollectionVisibility
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		SectionID    int
+		CollectionID int
+		Visibility   *plexgo.CollectionVisibility
+		Opts         []operations.Option
+	}
+	mock.lockUpdateCollectionVisibility.RLock()
+	calls = mock.calls.UpdateCollectionVisibility
+	mock.lockUpdateCollectionVisibility.RUnlock()
+	return calls
+}
+
+// UpdateContentRating calls UpdateContentRatingFunc.
+func (mock *CollectionsAPIMock) UpdateContentRating(ctx context.Context, collectionID int, contentRating string, locked bool, opts ...operations.Option) error {
+	if mock.UpdateContentRatingFunc == nil {
+		panic("CollectionsAPIMock.UpdateContentRatingFunc: method is nil but CollectionsAPI.UpdateContentRating was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		CollectionID  int
+		ContentRating string
+		Locked        bool
+		Opts          []operations.Option
+	}{
+		Ctx:           ctx,
+		CollectionID:  collectionID,
+		ContentRating: contentRating,
+		Locked:        locked,
+		Opts:          opts,
+	}
+	mock.lockUpdateContentRating.Lock()
+	mock.calls.UpdateContentRating = append(mock.calls.UpdateContentRating, callInfo)
+	mock.lockUpdateContentRating.Unlock()
+	return mock.UpdateContentRatingFunc(ctx, collectionID, contentRating, locked, opts...)
+}
+
+// UpdateContentRatingCalls gets all the calls that were made to UpdateContentRating.
+// Check the length with:
+//
+//	len(mockedCollections.UpdateContentRatingCalls())
+func (mock *CollectionsAPIMock) UpdateContentRatingCalls() []struct {
+	Ctx           context.Context
+	CollectionID  int
+	ContentRating string
+	Locked        bool
+	Opts          []operations.Option
+} {
+	var calls []struct {
+		Ctx           context.Context
+		CollectionID  int
+		ContentRating string
+		Locked        bool
+		Opts          []operations.Option
+	}
+	mock.lockUpdateContentRating.RLock()
+	calls = mock.calls.UpdateContentRating
+	mock.lockUpdateContentRating.RUnlock()
+	return calls
+}
+
+// UpdateSmartCollection calls UpdateSmartCollectionFunc.
+func (mock *CollectionsAPIMock) UpdateSmartCollection(ctx context.Context, collectionID int, filterURI string, opts ...operations.Option) error {
+	if mock.UpdateSmartCollectionFunc == nil {
+		panic("CollectionsAPIMock.UpdateSmartCollectionFunc: method is nil but CollectionsAPI.UpdateSmartCollection was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		FilterURI    string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		FilterURI:    filterURI,
+		Opts:         opts,
+	}
+	mock.lockUpdateSmartCollection.Lock()
+	mock.calls.UpdateSmartCollection = append(mock.calls.UpdateSmartCollection, callInfo)
+	mock.lockUpdateSmartCollection.Unlock()
+	return mock.UpdateSmartCollectionFunc(ctx, collectionID, filterURI, opts...)
+}
+
+// UpdateSmartCollectionCalls gets all the calls that were made to UpdateSmartCollection.
+// Check the length with:
+//
+//	len(mockedCollections.UpdateSmartCollectionCalls())
+func (mock *CollectionsAPIMock) UpdateSmartCollectionCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	FilterURI    string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		FilterURI    string
+		Opts         []operations.Option
+	}
+	mock.lockUpdateSmartCollection.RLock()
+	calls = mock.calls.UpdateSmartCollection
+	mock.lockUpdateSmartCollection.RUnlock()
+	return calls
+}
+
+// UploadTheme calls UploadThemeFunc.
+func (mock *CollectionsAPIMock) UploadTheme(ctx context.Context, collectionID int, r io.Reader, opts ...operations.Option) error {
+	if mock.UploadThemeFunc == nil {
+		panic("CollectionsAPIMock.UploadThemeFunc: method is nil but CollectionsAPI.UploadTheme was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		R            io.Reader
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		R:            r,
+		Opts:         opts,
+	}
+	mock.lockUploadTheme.Lock()
+	mock.calls.UploadTheme = append(mock.calls.UploadTheme, callInfo)
+	mock.lockUploadTheme.Unlock()
+	return mock.UploadThemeFunc(ctx, collectionID, r, opts...)
+}
+
+// UploadThemeCalls gets all the calls that were made to UploadTheme.
+// Check the length with:
+//
+//	len(mockedCollections.UploadThemeCalls())
+func (mock *CollectionsAPIMock) UploadThemeCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	R            io.Reader
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		R            io.Reader
+		Opts         []operations.Option
+	}
+	mock.lockUploadTheme.RLock()
+	calls = mock.calls.UploadTheme
+	mock.lockUploadTheme.RUnlock()
+	return calls
+}
+
+// UploadThemeFromURL calls UploadThemeFromURLFunc.
+func (mock *CollectionsAPIMock) UploadThemeFromURL(ctx context.Context, collectionID int, themeURL string, opts ...operations.Option) error {
+	if mock.UploadThemeFromURLFunc == nil {
+		panic("CollectionsAPIMock.UploadThemeFromURLFunc: method is nil but CollectionsAPI.UploadThemeFromURL was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CollectionID int
+		ThemeURL     string
+		Opts         []operations.Option
+	}{
+		Ctx:          ctx,
+		CollectionID: collectionID,
+		ThemeURL:     themeURL,
+		Opts:         opts,
+	}
+	mock.lockUploadThemeFromURL.Lock()
+	mock.calls.UploadThemeFromURL = append(mock.calls.UploadThemeFromURL, callInfo)
+	mock.lockUploadThemeFromURL.Unlock()
+	return mock.UploadThemeFromURLFunc(ctx, collectionID, themeURL, opts...)
+}
+
+// UploadThemeFromURLCalls gets all the calls that were made to UploadThemeFromURL.
+// Check the length with:
+//
+//	len(mockedCollections.UploadThemeFromURLCalls())
+func (mock *CollectionsAPIMock) UploadThemeFromURLCalls() []struct {
+	Ctx          context.Context
+	CollectionID int
+	ThemeURL     string
+	Opts         []operations.Option
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CollectionID int
+		ThemeURL     string
+		Opts         []operations.Option
+	}
+	mock.lockUploadThemeFromURL.RLock()
+	calls = mock.calls.UploadThemeFromURL
+	mock.lockUploadThemeFromURL.RUnlock()
+	return calls
+}
+
+// Ensure, that PhotosAPIMock does implement plexgo.PhotosAPI.
+var _ plexgo.PhotosAPI = &PhotosAPIMock{}
+
+// PhotosAPIMock is a mock implementation of plexgo.PhotosAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type PhotosAPIMock struct {
+	// GetOnThisDayFunc mocks the GetOnThisDay method.
+	GetOnThisDayFunc func(ctx context.Context, sectionID int, day time.Time, opts ...operations.Option) ([]plexgo.Memory, error)
+
+	// GetTimelineFunc mocks the GetTimeline method.
+	GetTimelineFunc func(ctx context.Context, sectionID int, from time.Time, to time.Time, opts ...operations.Option) ([]plexgo.Metadata, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetOnThisDay holds details about calls to the GetOnThisDay method.
+		GetOnThisDay []struct {
+			Ctx       context.Context
+			SectionID int
+			Day       time.Time
+			Opts      []operations.Option
+		}
+		// GetTimeline holds details about calls to the GetTimeline method.
+		GetTimeline []struct {
+			Ctx       context.Context
+			SectionID int
+			From      time.Time
+			To        time.Time
+			Opts      []operations.Option
+		}
+	}
+	lockGetOnThisDay sync.RWMutex
+	lockGetTimeline  sync.RWMutex
+}
+
+// GetOnThisDay calls GetOnThisDayFunc.
+func (mock *PhotosAPIMock) GetOnThisDay(ctx context.Context, sectionID int, day time.Time, opts ...operations.Option) ([]plexgo.Memory, error) {
+	if mock.GetOnThisDayFunc == nil {
+		panic("PhotosAPIMock.GetOnThisDayFunc: method is nil but PhotosAPI.GetOnThisDay was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		Day       time.Time
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		Day:       day,
+		Opts:      opts,
+	}
+	mock.lockGetOnThisDay.Lock()
+	mock.calls.GetOnThisDay = append(mock.calls.GetOnThisDay, callInfo)
+	mock.lockGetOnThisDay.Unlock()
+	return mock.GetOnThisDayFunc(ctx, sectionID, day, opts...)
+}
+
+// GetOnThisDayCalls gets all the calls that were made to GetOnThisDay.
+// Check the length with:
+//
+//	len(mockedPhotos.GetOnThisDayCalls())
+func (mock *PhotosAPIMock) GetOnThisDayCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	Day       time.Time
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		Day       time.Time
+		Opts      []operations.Option
+	}
+	mock.lockGetOnThisDay.RLock()
+	calls = mock.calls.GetOnThisDay
+	mock.lockGetOnThisDay.RUnlock()
+	return calls
+}
+
+// GetTimeline calls GetTimelineFunc.
+func (mock *PhotosAPIMock) GetTimeline(ctx context.Context, sectionID int, from time.Time, to time.Time, opts ...operations.Option) ([]plexgo.Metadata, error) {
+	if mock.GetTimelineFunc == nil {
+		panic("PhotosAPIMock.GetTimelineFunc: method is nil but PhotosAPI.GetTimeline was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SectionID int
+		From      time.Time
+		To        time.Time
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		SectionID: sectionID,
+		From:      from,
+		To:        to,
+		Opts:      opts,
+	}
+	mock.lockGetTimeline.Lock()
+	mock.calls.GetTimeline = append(mock.calls.GetTimeline, callInfo)
+	mock.lockGetTimeline.Unlock()
+	return mock.GetTimelineFunc(ctx, sectionID, from, to, opts...)
+}
+
+// GetTimelineCalls gets all the calls that were made to GetTimeline.
+// Check the length with:
+//
+//	len(mockedPhotos.GetTimelineCalls())
+func (mock *PhotosAPIMock) GetTimelineCalls() []struct {
+	Ctx       context.Context
+	SectionID int
+	From      time.Time
+	To        time.Time
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SectionID int
+		From      time.Time
+		To        time.Time
+		Opts      []operations.Option
+	}
+	mock.lockGetTimeline.RLock()
+	calls = mock.calls.GetTimeline
+	mock.lockGetTimeline.RUnlock()
+	return calls
+}
+
+// Ensure, that PlayQueuesAPIMock does implement plexgo.PlayQueuesAPI.
+var _ plexgo.PlayQueuesAPI = &PlayQueuesAPIMock{}
+
+// PlayQueuesAPIMock is a mock implementation of plexgo.PlayQueuesAPI. Set the Func field of each
+// method a test calls; the calls are recorded and returned by the Calls methods.
+type PlayQueuesAPIMock struct {
+	// CreateCinemaQueueFunc mocks the CreateCinemaQueue method.
+	CreateCinemaQueueFunc func(ctx context.Context, ratingKey int, trailers int, opts ...operations.Option) (*plexgo.PlayQueue, error)
+
+	// CreatePlayQueueFunc mocks the CreatePlayQueue method.
+	CreatePlayQueueFunc func(ctx context.Context, queueType string, ratingKeys []string, opts ...operations.Option) (*plexgo.PlayQueue, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateCinemaQueue holds details about calls to the CreateCinemaQueue method.
+		CreateCinemaQueue []struct {
+			Ctx       context.Context
+			RatingKey int
+			Trailers  int
+			Opts      []operations.Option
+		}
+		// CreatePlayQueue holds details about calls to the CreatePlayQueue method.
+		CreatePlayQueue []struct {
+			Ctx        context.Context
+			QueueType  string
+			RatingKeys []string
+			Opts       []operations.Option
+		}
+	}
+	lockCreateCinemaQueue sync.RWMutex
+	lockCreatePlayQueue   sync.RWMutex
+}
+
+// CreateCinemaQueue calls CreateCinemaQueueFunc.
+func (mock *PlayQueuesAPIMock) CreateCinemaQueue(ctx context.Context, ratingKey int, trailers int, opts ...operations.Option) (*plexgo.PlayQueue, error) {
+	if mock.CreateCinemaQueueFunc == nil {
+		panic("PlayQueuesAPIMock.CreateCinemaQueueFunc: method is nil but PlayQueuesAPI.CreateCinemaQueue was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RatingKey int
+		Trailers  int
+		Opts      []operations.Option
+	}{
+		Ctx:       ctx,
+		RatingKey: ratingKey,
+		Trailers:  trailers,
+		Opts:      opts,
+	}
+	mock.lockCreateCinemaQueue.Lock()
+	mock.calls.CreateCinemaQueue = append(mock.calls.CreateCinemaQueue, callInfo)
+	mock.lockCreateCinemaQueue.Unlock()
+	return mock.CreateCinemaQueueFunc(ctx, ratingKey, trailers, opts...)
+}
+
+// CreateCinemaQueueCalls gets all the calls that were made to CreateCinemaQueue.
+// Check the length with:
+//
+//	len(mockedPlayQueues.CreateCinemaQueueCalls())
+func (mock *PlayQueuesAPIMock) CreateCinemaQueueCalls() []struct {
+	Ctx       context.Context
+	RatingKey int
+	Trailers  int
+	Opts      []operations.Option
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RatingKey int
+		Trailers  int
+		Opts      []operations.Option
+	}
+	mock.lockCreateCinemaQueue.RLock()
+	calls = mock.calls.CreateCinemaQueue
+	mock.lockCreateCinemaQueue.RUnlock()
+	return calls
+}
+
+// CreatePlayQueue calls CreatePlayQueueFunc.
+func (mock *PlayQueuesAPIMock) CreatePlayQueue(ctx context.Context, queueType string, ratingKeys []string, opts ...operations.Option) (*plexgo.PlayQueue, error) {
+	if mock.CreatePlayQueueFunc == nil {
+		panic("PlayQueuesAPIMock.CreatePlayQueueFunc: method is nil but PlayQueuesAPI.CreatePlayQueue was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		QueueType  string
+		RatingKeys []string
+		Opts       []operations.Option
+	}{
+		Ctx:        ctx,
+		QueueType:  queueType,
+		RatingKeys: ratingKeys,
+		Opts:       opts,
+	}
+	mock.lockCreatePlayQueue.Lock()
+	mock.calls.CreatePlayQueue = append(mock.calls.CreatePlayQueue, callInfo)
+	mock.lockCreatePlayQueue.Unlock()
+	return mock.CreatePlayQueueFunc(ctx, queueType, ratingKeys, opts...)
+}
+
+// CreatePlayQueueCalls gets all the calls that were made to CreatePlayQueue.
+// Check the length with:
+//
+//	len(mockedPlayQueues.CreatePlayQueueCalls())
+func (mock *PlayQueuesAPIMock) CreatePlayQueueCalls() []struct {
+	Ctx        context.Context
+	QueueType  string
+	RatingKeys []string
+	Opts       []operations.Option
+} {
+	var calls []struct {
+		Ctx        context.Context
+		QueueType  string
+		RatingKeys []string
+		Opts       []operations.Option
+	}
+	mock.lockCreatePlayQueue.RLock()
+	calls = mock.calls.CreatePlayQueue
+	mock.lockCreatePlayQueue.RUnlock()
+	return calls
+}
@@ -0,0 +1,60 @@
+package plexmock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// collectionTitles is application code under test, depending on the interface of Collections
+func collectionTitles(ctx context.Context, collections plexgo.CollectionsAPI, sectionID int) ([]string, error) {
+	all, err := collections.GetAllCollections(ctx, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	titles := make([]string, 0, len(all))
+	for _, collection := range all {
+		titles = append(titles, collection.Title)
+	}
+	return titles, nil
+}
+
+func TestCollectionsAPIMock(t *testing.T) {
+	mock := &CollectionsAPIMock{
+		GetAllCollectionsFunc: func(ctx context.Context, sectionID int, opts ...operations.Option) ([]plexgo.Collection, error) {
+			return []plexgo.Collection{{Title: "Heist"}, {Title: "Marvel"}}, nil
+		},
+	}
+
+	titles, err := collectionTitles(context.Background(), mock, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(titles) != 2 || titles[1] != "Marvel" {
+		t.Errorf("Unexpected titles: %v", titles)
+	}
+
+	calls := mock.GetAllCollectionsCalls()
+	if len(calls) != 1 || calls[0].SectionID != 1 {
+		t.Errorf("Expected one call for section 1, got: %+v", calls)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a method without a Func to panic")
+		}
+	}()
+	mock.DeleteCollection(context.Background(), 7)
+}
+
+func TestClientImplementsInterfaces(t *testing.T) {
+	client := plexgo.New()
+
+	var _ plexgo.CollectionsAPI = client.Collections
+	var _ plexgo.LibraryAPI = client.Library
+	var _ plexgo.SessionsAPI = client.Sessions
+	var _ plexgo.PlexTVAPI = client.Plex
+}
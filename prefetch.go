@@ -0,0 +1,236 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// defaultPrefetchInterval is the time between requests when Prefetcher.Interval is not set
+const defaultPrefetchInterval = 200 * time.Millisecond
+
+// Prefetcher warms caches for a set of library items ahead of a UI showing them, e.g. the items
+// of a collection a user is about to open. It requests the metadata of the items and their
+// thumb and art images at a controlled rate, so the server isn't flooded; the responses pass
+// through the SDK's client and middlewares, and go to OnItem and OnArtwork for apps that keep
+// their own cache. Requesting images at the size the UI shows them also warms the server's
+// transcoded image cache. Create one with Library.NewPrefetcher and set its fields before
+// running it.
+type Prefetcher struct {
+	Interval  time.Duration                                 // Minimum time between requests, 200ms if not set
+	Width     int                                           // Width to request images at; original size if 0
+	Height    int                                           // Height to request images at; original size if 0
+	NoArtwork bool                                          // Only prefetch metadata
+	OnItem    func(item Metadata)                           // Receives the metadata of each item
+	OnArtwork func(item Metadata, path string, data []byte) // Receives each image of an item
+	OnError   func(error)                                   // Receives prefetch errors, which don't stop Prefetch
+
+	library *Library
+}
+
+// NewPrefetcher returns a prefetcher for items of the library
+func (s *Library) NewPrefetcher() *Prefetcher {
+	return &Prefetcher{library: s}
+}
+
+// Prefetch requests the metadata and artwork of the items with ratingKeys, waiting Interval
+// between requests, and returns once all were requested or ctx is done. It is meant to be run in
+// a goroutine while the UI carries on; failed requests are passed to OnError and skipped. It
+// returns ctx.Err() if stopped early.
+func (p *Prefetcher) Prefetch(ctx context.Context, ratingKeys []string, opts ...operations.Option) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = defaultPrefetchInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	first := true
+	wait := func() error {
+		if first {
+			first = false
+			return ctx.Err()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			return nil
+		}
+	}
+
+	for start := 0; start < len(ratingKeys); start += metadataBatchSize {
+		end := start + metadataBatchSize
+		if end > len(ratingKeys) {
+			end = len(ratingKeys)
+		}
+
+		if err := wait(); err != nil {
+			return err
+		}
+		items, err := p.library.listMetadata(ctx, "/library/metadata/"+strings.Join(ratingKeys[start:end], ","), nil, "getMediaMetaData", opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			p.reportError(fmt.Errorf("error prefetching items: %w", err))
+			continue
+		}
+
+		for _, item := range items {
+			if p.OnItem != nil {
+				p.OnItem(item)
+			}
+			if p.NoArtwork {
+				continue
+			}
+
+			for _, path := range []string{item.Thumb, item.Art} {
+				if path == "" {
+					continue
+				}
+				if err := wait(); err != nil {
+					return err
+				}
+				data, err := p.library.getArtwork(ctx, path, p.Width, p.Height, opts...)
+				if err != nil {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					p.reportError(fmt.Errorf("error prefetching %s of %s: %w", path, item.Title, err))
+					continue
+				}
+				if p.OnArtwork != nil {
+					p.OnArtwork(item, path, data)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// PrefetchCollection prefetches the items of a collection
+func (p *Prefetcher) PrefetchCollection(ctx context.Context, collectionID int, opts ...operations.Option) error {
+	items, err := p.library.listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), nil, "getCollectionChildren", opts...)
+	if err != nil {
+		return fmt.Errorf("error getting items of collection %d: %w", collectionID, err)
+	}
+
+	ratingKeys := make([]string, 0, len(items))
+	for _, item := range items {
+		ratingKeys = append(ratingKeys, item.RatingKey)
+	}
+
+	return p.Prefetch(ctx, ratingKeys, opts...)
+}
+
+// reportError passes an error to OnError, if set
+func (p *Prefetcher) reportError(err error) {
+	if p.OnError != nil {
+		p.OnError(err)
+	}
+}
+
+// getArtwork downloads an image of an item, such as its thumb, through the photo transcoder if
+// a width and height are given
+func (s *Library) getArtwork(ctx context.Context, path string, width int, height int, opts ...operations.Option) ([]byte, error) {
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	operationID := "getArtwork"
+	var opURL string
+	var err error
+	if width > 0 && height > 0 {
+		operationID = "transcodeImage"
+		opURL, err = url.JoinPath(baseURL, "/photo/:/transcode")
+		if err == nil {
+			queryParams := url.Values{}
+			queryParams.Add("width", strconv.Itoa(width))
+			queryParams.Add("height", strconv.Itoa(height))
+			queryParams.Add("minSize", "1")
+			queryParams.Add("upscale", "1")
+			queryParams.Add("url", path)
+			opURL += "?" + queryParams.Encode()
+		}
+	} else {
+		opURL, err = url.JoinPath(baseURL, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error generating URL: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    operationID,
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "image/*")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return nil, err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return nil, err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return utils.ConsumeRawBody(httpRes)
+}
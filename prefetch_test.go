@@ -0,0 +1,101 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPrefetcher(t *testing.T) {
+	var mu sync.Mutex
+	var requests []time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, time.Now())
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/library/collections/42/children":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[{"ratingKey":"1"},{"ratingKey":"2"}]}}`))
+		case "/library/metadata/1,2":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[{"ratingKey":"1","title":"Alien","thumb":"/library/metadata/1/thumb/100","art":"/library/metadata/1/art/100"},{"ratingKey":"2","title":"Aliens","thumb":"/library/metadata/2/thumb/200"}]}}`))
+		case "/photo/:/transcode":
+			query := r.URL.Query()
+			if query.Get("width") != "300" || query.Get("height") != "450" {
+				t.Errorf("Expected images at 300x450, got: %s", r.URL.RawQuery)
+			}
+			if query.Get("url") == "/library/metadata/2/thumb/200" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Write([]byte("jpeg:" + query.Get("url")))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	var items []string
+	images := map[string]string{}
+	var errs []error
+
+	prefetcher := client.Library.NewPrefetcher()
+	prefetcher.Interval = 10 * time.Millisecond
+	prefetcher.Width = 300
+	prefetcher.Height = 450
+	prefetcher.OnItem = func(item Metadata) { items = append(items, item.RatingKey) }
+	prefetcher.OnArtwork = func(item Metadata, path string, data []byte) { images[path] = string(data) }
+	prefetcher.OnError = func(err error) { errs = append(errs, err) }
+
+	if err := prefetcher.PrefetchCollection(context.Background(), 42); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(items) != 2 {
+		t.Errorf("Expected the metadata of 2 items, got: %v", items)
+	}
+	if len(images) != 2 || images["/library/metadata/1/art/100"] != "jpeg:/library/metadata/1/art/100" {
+		t.Errorf("Expected the thumb and art of the first item, got: %v", images)
+	}
+	if len(errs) != 1 {
+		t.Errorf("Expected the missing thumb to be reported, got: %v", errs)
+	}
+
+	// The collection is listed right away, then the batch of metadata and the 3 images are rate limited
+	if len(requests) != 5 {
+		t.Fatalf("Expected 5 requests, got %d", len(requests))
+	}
+	for i := 2; i < len(requests); i++ {
+		if gap := requests[i].Sub(requests[i-1]); gap < 5*time.Millisecond {
+			t.Errorf("Expected requests to be spaced out, request %d came %v after the previous one", i, gap)
+		}
+	}
+}
+
+func TestPrefetcherCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"1","thumb":"/library/metadata/1/thumb/100"}]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	prefetcher := client.Library.NewPrefetcher()
+	prefetcher.Interval = time.Hour
+	prefetcher.OnItem = func(item Metadata) { cancel() }
+
+	if err := prefetcher.Prefetch(ctx, []string{"1"}); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Preset describes a smart collection that CreatePresets can create in one call.
+// Custom presets only need a Title, Type and Filter; the built-in constructors below
+// cover the common cases.
+type Preset struct {
+	Title  string       // Collection title
+	Type   MetadataType // Item type the collection holds (CollectionItemTypeMovie, CollectionItemTypeShow, ...)
+	Filter string       // Smart filter query without the type, e.g. "decade=1990"
+
+	// tagField and tagName are set by presets whose filter needs a tag ID resolved by name
+	tagField string
+	tagName  string
+}
+
+// DecadePreset returns a preset for items released in the decade starting with the given year (e.g. 1990).
+// Music artists and tracks are matched by the decade of their albums.
+func DecadePreset(itemType MetadataType, decade int) Preset {
+	decade -= decade % 10
+	return Preset{
+		Title:  fmt.Sprintf("%ds", decade),
+		Type:   itemType,
+		Filter: InDecade(itemType, decade).String(),
+	}
+}
+
+// GenrePreset returns a preset for items of the given genre. The genre's tag ID is resolved
+// by name when the collection is created. Music tracks are matched by the genres of their albums.
+func GenrePreset(itemType MetadataType, genre string) Preset {
+	return Preset{
+		Title:    genre,
+		Type:     itemType,
+		tagField: "genre",
+		tagName:  genre,
+	}
+}
+
+// MoodPreset returns a preset for music artists, albums or tracks with the given mood, e.g.
+// "Melancholy". The mood's tag ID is resolved by name when the collection is created.
+func MoodPreset(itemType MetadataType, mood string) Preset {
+	return Preset{
+		Title:    mood,
+		Type:     itemType,
+		tagField: "mood",
+		tagName:  mood,
+	}
+}
+
+// StudioPreset returns a preset for items from the given studio
+func StudioPreset(itemType MetadataType, studio string) Preset {
+	return Preset{
+		Title:  studio,
+		Type:   itemType,
+		Filter: "studio=" + url.QueryEscape(studio),
+	}
+}
+
+// UHDPreset returns a preset for items available in 4K. For shows the episode resolution is used.
+func UHDPreset(itemType MetadataType) Preset {
+	field := "resolution"
+	if itemType == CollectionItemTypeShow {
+		field = "episode.resolution"
+	}
+	return Preset{
+		Title:  "4K",
+		Type:   itemType,
+		Filter: field + "=4k",
+	}
+}
+
+// RecentlyAddedPreset returns a preset for items added within the given number of days
+func RecentlyAddedPreset(itemType MetadataType, days int) Preset {
+	return Preset{
+		Title:  "Recently Added",
+		Type:   itemType,
+		Filter: InTheLast("addedAt", time.Duration(days)*24*time.Hour).String(),
+	}
+}
+
+// filterArgs returns the smart filter query for the preset, resolving tag IDs where needed
+func (p Preset) filterArgs(ctx context.Context, library *Library, sectionID int, opts ...operations.Option) (string, error) {
+	filter := p.Filter
+	if p.tagField != "" {
+		tag, err := library.findTag(ctx, sectionID, p.tagField, p.tagName, opts...)
+		if err != nil {
+			return "", err
+		}
+		filter = fmt.Sprintf("%s=%d", filterField(p.Type, p.tagField), tag.ID)
+	}
+
+	if filter == "" {
+		return fmt.Sprintf("?type=%d", p.Type), nil
+	}
+	return fmt.Sprintf("?type=%d&%s", p.Type, filter), nil
+}
+
+// CreatePresets creates a smart collection for each preset in a library section. A preset that
+// fails (e.g. because its filter matches no items) does not stop the others; the collections that
+// were created are returned together with an error describing each failed preset.
+func (s *Collections) CreatePresets(ctx context.Context, sectionID int, presets []Preset, opts ...operations.Option) ([]*Collection, error) {
+	library := newLibrary(s.sdkConfiguration)
+	options := processOptions(opts)
+
+	collections := []*Collection{}
+	var errs []error
+
+	reportProgress(options, 0, len(presets), "creating presets")
+	for i, preset := range presets {
+		collection, err := s.createPreset(ctx, library, sectionID, preset, opts...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("preset %s: %w", preset.Title, err))
+		} else {
+			collections = append(collections, collection)
+		}
+		reportProgress(options, i+1, len(presets), "creating presets")
+	}
+
+	return collections, errors.Join(errs...)
+}
+
+// createPreset creates the smart collection of a single preset
+func (s *Collections) createPreset(ctx context.Context, library *Library, sectionID int, preset Preset, opts ...operations.Option) (*Collection, error) {
+	if _, ok := CollectionItemTypeKeys[preset.Type]; !ok {
+		return nil, fmt.Errorf("invalid item type %d", preset.Type)
+	}
+
+	filterArgs, err := preset.filterArgs(ctx, library, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CreateSmartCollection(ctx, sectionID, preset.Title, preset.Type, filterArgs, opts...)
+}
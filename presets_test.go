@@ -0,0 +1,84 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPresetFilters(t *testing.T) {
+	tests := []struct {
+		preset Preset
+		title  string
+		filter string
+	}{
+		{DecadePreset(CollectionItemTypeMovie, 1994), "1990s", "decade=1990"},
+		{StudioPreset(CollectionItemTypeMovie, "Walt Disney Pictures"), "Walt Disney Pictures", "studio=Walt+Disney+Pictures"},
+		{UHDPreset(CollectionItemTypeShow), "4K", "episode.resolution=4k"},
+		{RecentlyAddedPreset(CollectionItemTypeMovie, 30), "Recently Added", "addedAt>>=-30d"},
+	}
+
+	for _, tt := range tests {
+		if tt.preset.Title != tt.title {
+			t.Errorf("Expected title %q, got: %q", tt.title, tt.preset.Title)
+		}
+		if tt.preset.Filter != tt.filter {
+			t.Errorf("Expected filter %q, got: %q", tt.filter, tt.preset.Filter)
+		}
+	}
+}
+
+func TestCreatePresets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/genre":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"key":"55","title":"Action"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			// Only the genre filter matches any items
+			if r.URL.Query().Get("genre") == "55" {
+				w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"101","title":"Die Hard","type":"movie"}]}}`))
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			if !strings.Contains(r.URL.Query().Get("uri"), "genre=55") {
+				t.Errorf("Expected uri with genre=55, got: %s", r.URL.Query().Get("uri"))
+			}
+			w.Header().Set("Location", "/library/collections/8")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Action","smart":"1","type":"collection"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	var progress []string
+	collections, err := client.Collections.CreatePresets(context.Background(), 1, []Preset{
+		GenrePreset(CollectionItemTypeMovie, "action"),
+		DecadePreset(CollectionItemTypeMovie, 1920),
+	}, WithProgress(func(done, total int, stage string) {
+		progress = append(progress, fmt.Sprintf("%s %d/%d", stage, done, total))
+	}))
+
+	if len(collections) != 1 || collections[0].RatingKey != "8" {
+		t.Fatalf("Expected the genre collection to be created, got: %+v", collections)
+	}
+
+	if err == nil || !strings.Contains(err.Error(), "preset 1920s") {
+		t.Errorf("Expected an error for the empty decade preset, got: %v", err)
+	}
+
+	expected := "creating presets 0/2,creating presets 1/2,creating presets 2/2"
+	if strings.Join(progress, ",") != expected {
+		t.Errorf("Expected progress %s, got: %s", expected, strings.Join(progress, ","))
+	}
+}
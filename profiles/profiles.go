@@ -0,0 +1,309 @@
+// Package profiles describes what a playback client can play, so the universal transcoder can
+// decide between direct play, direct stream and transcoding. A Profile generates the
+// X-Plex-Client-Profile-Extra string and the query parameters the transcoder expects, so callers
+// don't have to hand-craft them.
+package profiles
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Headers and query parameters that identify a client profile to the transcoder
+const (
+	HeaderProfileExtra = "X-Plex-Client-Profile-Extra"
+	HeaderProfileName  = "X-Plex-Client-Profile-Name"
+	HeaderPlatform     = "X-Plex-Platform"
+	HeaderProduct      = "X-Plex-Product"
+	HeaderDevice       = "X-Plex-Device"
+)
+
+// Streaming protocols of the universal transcoder
+const (
+	ProtocolHLS  = "hls"
+	ProtocolDASH = "dash"
+	ProtocolHTTP = "http" // Progressive download, e.g. for downloads and simple players
+)
+
+// Limitation types
+const (
+	LimitUpperBound = "upperBound"
+	LimitLowerBound = "lowerBound"
+	LimitMatch      = "match"
+	LimitNotMatch   = "notMatch"
+)
+
+// Limitation scopes
+const (
+	ScopeVideoCodec      = "videoCodec"
+	ScopeVideoAudioCodec = "videoAudioCodec"
+	ScopeVideoContainer  = "videoContainer"
+)
+
+var protocols = map[string]bool{ProtocolHLS: true, ProtocolDASH: true, ProtocolHTTP: true}
+
+var limitationTypes = map[string]bool{LimitUpperBound: true, LimitLowerBound: true, LimitMatch: true, LimitNotMatch: true}
+
+var limitationScopes = map[string]bool{ScopeVideoCodec: true, ScopeVideoAudioCodec: true, ScopeVideoContainer: true}
+
+// Profile is a playback client's capabilities
+type Profile struct {
+	Name     string // Server-side profile to start from, sent as X-Plex-Client-Profile-Name; empty for the platform's default
+	Platform string // X-Plex-Platform, e.g. "Chrome" or "tvOS"
+	Product  string // X-Plex-Product
+	Device   string // X-Plex-Device
+
+	// Transcode target: what the server converts to when the client can't play the source
+	Protocol       string   // One of the Protocol constants
+	Container      string   // e.g. "mpegts" for HLS or "mp4" for DASH
+	VideoCodecs    []string // In order of preference
+	AudioCodecs    []string // In order of preference
+	SubtitleCodecs []string // Subtitle formats the client renders itself; others are burned in
+
+	// Sources matching a direct play profile are sent to the client untouched
+	DirectPlay []DirectPlayProfile
+
+	// Limitations narrow the codecs above, e.g. a maximum H.264 level or audio channel count
+	Limitations []Limitation
+
+	DisableDirectPlay   bool // Never direct play, e.g. to force a transcode for testing
+	DisableDirectStream bool // Never remux without re-encoding
+}
+
+// DirectPlayProfile is a combination of container and codecs the client plays natively
+type DirectPlayProfile struct {
+	Containers  []string
+	VideoCodecs []string
+	AudioCodecs []string
+}
+
+// Limitation restricts a stream attribute, e.g. video.level of h264 to an upper bound of 42
+type Limitation struct {
+	Scope     string // One of the Scope constants
+	ScopeName string // Codec or container the limitation applies to, or "*" for all
+	Type      string // One of the Limit constants
+	Name      string // Stream attribute, e.g. "video.level", "video.bitDepth" or "audio.channels"
+	Value     string
+}
+
+// MaxAudioChannels limits the audio channels of all video streams, e.g. 2 for stereo clients
+func MaxAudioChannels(channels int) Limitation {
+	return Limitation{
+		Scope:     ScopeVideoAudioCodec,
+		ScopeName: "*",
+		Type:      LimitUpperBound,
+		Name:      "audio.channels",
+		Value:     strconv.Itoa(channels),
+	}
+}
+
+// Chrome returns the profile of a Chromium-based browser playing HLS: H.264 video with AAC, MP3
+// or Opus audio in stereo, and MP4 or WebM files played directly
+func Chrome() Profile {
+	return Profile{
+		Platform:       "Chrome",
+		Product:        "Plex Web",
+		Device:         "Chrome",
+		Protocol:       ProtocolHLS,
+		Container:      "mpegts",
+		VideoCodecs:    []string{"h264"},
+		AudioCodecs:    []string{"aac", "mp3"},
+		SubtitleCodecs: []string{"webvtt"},
+		DirectPlay: []DirectPlayProfile{
+			{Containers: []string{"mp4"}, VideoCodecs: []string{"h264"}, AudioCodecs: []string{"aac", "mp3"}},
+			{Containers: []string{"webm"}, VideoCodecs: []string{"vp8", "vp9", "av1"}, AudioCodecs: []string{"opus", "vorbis"}},
+		},
+		Limitations: []Limitation{
+			{Scope: ScopeVideoCodec, ScopeName: "h264", Type: LimitUpperBound, Name: "video.level", Value: "51"},
+			{Scope: ScopeVideoCodec, ScopeName: "h264", Type: LimitUpperBound, Name: "video.bitDepth", Value: "8"},
+			MaxAudioChannels(2),
+		},
+	}
+}
+
+// AppleTV returns the profile of an Apple TV 4K: HEVC or H.264 over HLS with Dolby Digital and
+// Dolby Digital Plus surround sound, and MP4, MOV or MKV files played directly
+func AppleTV() Profile {
+	return Profile{
+		Platform:       "tvOS",
+		Product:        "Plex for Apple TV",
+		Device:         "Apple TV",
+		Protocol:       ProtocolHLS,
+		Container:      "mpegts",
+		VideoCodecs:    []string{"hevc", "h264"},
+		AudioCodecs:    []string{"eac3", "ac3", "aac"},
+		SubtitleCodecs: []string{"srt", "webvtt", "ass", "pgs"},
+		DirectPlay: []DirectPlayProfile{
+			{
+				Containers:  []string{"mp4", "mov", "mkv"},
+				VideoCodecs: []string{"h264", "hevc"},
+				AudioCodecs: []string{"aac", "ac3", "eac3", "alac", "flac", "mp3"},
+			},
+		},
+		Limitations: []Limitation{
+			{Scope: ScopeVideoCodec, ScopeName: "hevc", Type: LimitUpperBound, Name: "video.bitDepth", Value: "10"},
+			MaxAudioChannels(8),
+		},
+	}
+}
+
+// DirectPlay returns a generic profile for players that decode nearly everything themselves,
+// such as mpv or VLC based players, so the server only transcodes exotic sources
+func DirectPlay() Profile {
+	return Profile{
+		Platform:       "Generic",
+		Product:        "plexgo",
+		Device:         "Generic",
+		Protocol:       ProtocolHLS,
+		Container:      "mpegts",
+		VideoCodecs:    []string{"h264", "hevc"},
+		AudioCodecs:    []string{"aac", "ac3", "eac3", "mp3"},
+		SubtitleCodecs: []string{"srt", "ass", "ssa", "webvtt", "pgs", "vobsub"},
+		DirectPlay: []DirectPlayProfile{
+			{
+				Containers:  []string{"mkv", "mp4", "mov", "avi", "mpegts", "webm"},
+				VideoCodecs: []string{"h264", "hevc", "av1", "vp9", "mpeg4", "mpeg2video", "vc1"},
+				AudioCodecs: []string{"aac", "ac3", "eac3", "dca", "truehd", "flac", "mp3", "opus", "vorbis", "pcm"},
+			},
+		},
+	}
+}
+
+// Validate checks that the profile has a known protocol, a transcode target and valid limitations
+func (p Profile) Validate() error {
+	if !protocols[p.Protocol] {
+		return fmt.Errorf("invalid protocol %q", p.Protocol)
+	}
+	if p.Container == "" {
+		return fmt.Errorf("profile is missing a transcode container")
+	}
+	if len(p.VideoCodecs) == 0 || len(p.AudioCodecs) == 0 {
+		return fmt.Errorf("profile needs at least one video and one audio codec")
+	}
+
+	for _, dp := range p.DirectPlay {
+		if len(dp.Containers) == 0 {
+			return fmt.Errorf("direct play profile is missing a container")
+		}
+	}
+
+	for _, l := range p.Limitations {
+		if !limitationScopes[l.Scope] {
+			return fmt.Errorf("invalid limitation scope %q", l.Scope)
+		}
+		if !limitationTypes[l.Type] {
+			return fmt.Errorf("invalid limitation type %q", l.Type)
+		}
+		if l.ScopeName == "" || l.Name == "" || l.Value == "" {
+			return fmt.Errorf("limitation %s %s is incomplete", l.ScopeName, l.Name)
+		}
+	}
+	return nil
+}
+
+// Extra returns the X-Plex-Client-Profile-Extra value of the profile, e.g.
+// add-transcode-target(type=videoProfile&context=streaming&protocol=hls&...)+add-limitation(...)
+func (p Profile) Extra() string {
+	directives := []string{
+		directive("add-transcode-target", [][2]string{
+			{"type", "videoProfile"},
+			{"context", "streaming"},
+			{"protocol", p.Protocol},
+			{"container", p.Container},
+			{"videoCodec", strings.Join(p.VideoCodecs, ",")},
+			{"audioCodec", strings.Join(p.AudioCodecs, ",")},
+			{"subtitleCodec", strings.Join(p.SubtitleCodecs, ",")},
+			{"replace", "true"},
+		}),
+	}
+
+	for _, dp := range p.DirectPlay {
+		directives = append(directives, directive("add-direct-play-profile", [][2]string{
+			{"type", "videoProfile"},
+			{"container", strings.Join(dp.Containers, ",")},
+			{"videoCodec", strings.Join(dp.VideoCodecs, ",")},
+			{"audioCodec", strings.Join(dp.AudioCodecs, ",")},
+		}))
+	}
+
+	for _, l := range p.Limitations {
+		directives = append(directives, directive("add-limitation", [][2]string{
+			{"scope", l.Scope},
+			{"scopeName", l.ScopeName},
+			{"type", l.Type},
+			{"name", l.Name},
+			{"value", l.Value},
+		}))
+	}
+
+	return strings.Join(directives, "+")
+}
+
+// directive encodes a profile directive, leaving out empty parameters
+func directive(name string, params [][2]string) string {
+	parts := make([]string, 0, len(params))
+	for _, param := range params {
+		if param[1] != "" {
+			parts = append(parts, param[0]+"="+param[1])
+		}
+	}
+	return name + "(" + strings.Join(parts, "&") + ")"
+}
+
+// Headers returns the headers identifying the client and its profile
+func (p Profile) Headers() map[string]string {
+	headers := map[string]string{HeaderProfileExtra: p.Extra()}
+	for name, value := range map[string]string{
+		HeaderProfileName: p.Name,
+		HeaderPlatform:    p.Platform,
+		HeaderProduct:     p.Product,
+		HeaderDevice:      p.Device,
+	} {
+		if value != "" {
+			headers[name] = value
+		}
+	}
+	return headers
+}
+
+// Params returns the universal transcoder query parameters of the profile: the protocol, whether
+// direct play and direct stream are allowed, and the profile headers, which the transcoder also
+// accepts as parameters so stream URLs can be handed to players that can't set headers
+func (p Profile) Params() url.Values {
+	params := url.Values{}
+	params.Set("protocol", p.Protocol)
+	params.Set("directPlay", boolParam(!p.DisableDirectPlay))
+	params.Set("directStream", boolParam(!p.DisableDirectStream))
+	for name, value := range p.Headers() {
+		params.Set(name, value)
+	}
+	return params
+}
+
+// Option sends the profile headers with a request, e.g. Video.StartUniversalTranscode. The headers
+// are copied first, so a map passed to operations.WithSetHeaders is left unchanged.
+func (p Profile) Option() operations.Option {
+	headers := p.Headers()
+	return func(opts *operations.Options, supportedOptions ...string) error {
+		merged := make(map[string]string, len(opts.SetHeaders)+len(headers))
+		for name, value := range opts.SetHeaders {
+			merged[name] = value
+		}
+		for name, value := range headers {
+			merged[name] = value
+		}
+		opts.SetHeaders = merged
+		return nil
+	}
+}
+
+func boolParam(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,93 @@
+package profiles_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/unfaiyted/plexgo"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/profiles"
+)
+
+func TestBuiltInProfiles(t *testing.T) {
+	for name, profile := range map[string]profiles.Profile{"Chrome": profiles.Chrome(), "AppleTV": profiles.AppleTV(), "DirectPlay": profiles.DirectPlay()} {
+		if err := profile.Validate(); err != nil {
+			t.Errorf("Expected the %s profile to be valid, got: %v", name, err)
+		}
+	}
+
+	extra := profiles.Chrome().Extra()
+	want := "add-transcode-target(type=videoProfile&context=streaming&protocol=hls&container=mpegts&videoCodec=h264&audioCodec=aac,mp3&subtitleCodec=webvtt&replace=true)" +
+		"+add-direct-play-profile(type=videoProfile&container=mp4&videoCodec=h264&audioCodec=aac,mp3)" +
+		"+add-direct-play-profile(type=videoProfile&container=webm&videoCodec=vp8,vp9,av1&audioCodec=opus,vorbis)" +
+		"+add-limitation(scope=videoCodec&scopeName=h264&type=upperBound&name=video.level&value=51)" +
+		"+add-limitation(scope=videoCodec&scopeName=h264&type=upperBound&name=video.bitDepth&value=8)" +
+		"+add-limitation(scope=videoAudioCodec&scopeName=*&type=upperBound&name=audio.channels&value=2)"
+	if extra != want {
+		t.Errorf("Unexpected profile extra:\n%s\nwant:\n%s", extra, want)
+	}
+
+	profile := profiles.AppleTV()
+	profile.DisableDirectPlay = true
+	params := profile.Params()
+	if params.Get("protocol") != "hls" || params.Get("directPlay") != "0" || params.Get("directStream") != "1" ||
+		params.Get(profiles.HeaderPlatform) != "tvOS" || !strings.HasPrefix(params.Get(profiles.HeaderProfileExtra), "add-transcode-target(") {
+		t.Errorf("Unexpected params: %v", params)
+	}
+	if _, ok := params[profiles.HeaderProfileName]; ok {
+		t.Errorf("Expected no profile name, got: %v", params)
+	}
+}
+
+func TestProfileValidate(t *testing.T) {
+	invalid := map[string]func(p *profiles.Profile){
+		"protocol":  func(p *profiles.Profile) { p.Protocol = "rtsp" },
+		"container": func(p *profiles.Profile) { p.Container = "" },
+		"codecs":    func(p *profiles.Profile) { p.AudioCodecs = nil },
+		"direct play": func(p *profiles.Profile) {
+			p.DirectPlay = []profiles.DirectPlayProfile{{VideoCodecs: []string{"h264"}}}
+		},
+		"limitation": func(p *profiles.Profile) {
+			p.Limitations = []profiles.Limitation{{Scope: "audioCodec", ScopeName: "*", Type: profiles.LimitUpperBound, Name: "audio.channels", Value: "2"}}
+		},
+		"limitation type": func(p *profiles.Profile) {
+			p.Limitations = []profiles.Limitation{{Scope: profiles.ScopeVideoCodec, ScopeName: "h264", Type: "max", Name: "video.level", Value: "41"}}
+		},
+	}
+	for name, mutate := range invalid {
+		profile := profiles.Chrome()
+		mutate(&profile)
+		if err := profile.Validate(); err == nil {
+			t.Errorf("Expected an error for an invalid %s", name)
+		}
+	}
+}
+
+func TestProfileOption(t *testing.T) {
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := plexgo.New(plexgo.WithServerURL(server.URL))
+	shared := map[string]string{"X-Tool": "sync"}
+	_, err := client.Video.StartUniversalTranscode(context.Background(), operations.StartUniversalTranscodeRequest{
+		Path:     "/library/metadata/23409",
+		Protocol: "hls",
+	}, operations.WithSetHeaders(shared), profiles.AppleTV().Option(), plexgo.WithOperationLanguage("de"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(shared) != 1 {
+		t.Errorf("Expected the caller's headers to be unchanged, got: %v", shared)
+	}
+
+	if headers.Get(profiles.HeaderProfileExtra) != profiles.AppleTV().Extra() || headers.Get(profiles.HeaderPlatform) != "tvOS" || headers.Get(plexgo.LanguageHeader) != "de" {
+		t.Errorf("Unexpected headers: %v", headers)
+	}
+}
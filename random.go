@@ -0,0 +1,56 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// ErrNoItems is returned by GetRandomItem when no item matches
+var ErrNoItems = errors.New("no matching items")
+
+// GetRandomItem picks a random item of a library section matching filter, or of the whole section
+// if filter is nil, e.g. for a "surprise me" command. The server does the picking, so only one
+// item is transferred however large the section is. It returns ErrNoItems if nothing matches.
+func (s *Library) GetRandomItem(ctx context.Context, sectionID int, filter FilterNode, opts ...operations.Option) (*Metadata, error) {
+	return s.getRandomItem(ctx, sectionID, ItemListOptions{Filter: filter}, opts...)
+}
+
+// GetRandomItem picks a random item of a collection, leaving out watched items if unwatchedOnly
+// is set. It returns ErrNoItems if the collection has no such item.
+func (s *Collections) GetRandomItem(ctx context.Context, collectionID int, unwatchedOnly bool, opts ...operations.Option) (*Metadata, error) {
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection: %w", err)
+	}
+
+	// The collection's items are picked from its section, so the server can sort them randomly
+	filter := AllOf(Cond("collection", FilterOpContains, strconv.Itoa(collectionID)))
+	if unwatchedOnly {
+		filter.Nodes = append(filter.Nodes, Cond("unwatched", FilterOpContains, "1"))
+	}
+
+	return newLibrary(s.sdkConfiguration).getRandomItem(ctx, collection.SectionID, ItemListOptions{
+		Type:   collectionItemTypeFromName(collection.SubType),
+		Filter: filter,
+	}, opts...)
+}
+
+// getRandomItem requests a single item of a library section in random order
+func (s *Library) getRandomItem(ctx context.Context, sectionID int, listOptions ItemListOptions, opts ...operations.Option) (*Metadata, error) {
+	listOptions.Sort = "random"
+	listOptions.Limit = 1
+
+	items, err := s.ListItems(ctx, sectionID, listOptions, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ErrNoItems
+	}
+
+	return &items[0], nil
+}
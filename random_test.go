@@ -0,0 +1,49 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetRandomItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+
+		switch r.URL.Path {
+		case "/library/collections/42":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"42","title":"Marvel","type":"collection","subtype":"movie","librarySectionID":2}]}}`))
+		case "/library/sections/2/all":
+			if query.Get("sort") != "random" || query.Get("X-Plex-Container-Size") != "1" {
+				t.Errorf("Expected a single random item, got: %s", r.URL.RawQuery)
+			}
+			if query.Get("collection") != "42" || query.Get("unwatched") != "1" || query.Get("type") != "1" {
+				t.Errorf("Expected unwatched movies of the collection, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"totalSize":12,"Metadata":[{"ratingKey":"7","type":"movie","title":"Iron Man"}]}}`))
+		case "/library/sections/3/all":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"totalSize":0}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	item, err := client.Collections.GetRandomItem(context.Background(), 42, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if item.RatingKey != "7" {
+		t.Errorf("Expected item 7, got: %+v", item)
+	}
+
+	_, err = client.Library.GetRandomItem(context.Background(), 3, Cond("year", FilterOpGreaterThan, "2030"))
+	if !errors.Is(err, ErrNoItems) {
+		t.Errorf("Expected ErrNoItems, got: %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+	"github.com/unfaiyted/plexgo/retry"
+)
+
+func TestRaw(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Plex-Token") != "secret" {
+			t.Errorf("Expected X-Plex-Token header, got: %v", r.Header)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/sections/1/analyze":
+			if r.URL.Query().Get("force") != "1" || r.URL.Query().Get("async") != "1" {
+				t.Errorf("Expected force=1 and async=1, got: %s", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/hubs/custom":
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			if r.Method != "POST" || string(body) != `{"title":"Custom"}` {
+				t.Errorf("Expected POST with body, got: %s %s", r.Method, body)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Hub":[{"title":"Custom"}]}}`))
+		case "/identity.json":
+			w.Write([]byte(`{"machineIdentifier":"abc"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":1002,"message":"Not found","status":404}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithSecurity("secret"))
+	ctx := context.Background()
+
+	out, err := client.Raw(ctx, "PUT", "/library/sections/1/analyze?force=1", url.Values{"async": {"1"}}, nil)
+	if err != nil || out != nil {
+		t.Fatalf("Expected an empty response, got: %s %v", out, err)
+	}
+
+	backoff := retry.Config{
+		Strategy: "backoff",
+		Backoff:  &retry.BackoffStrategy{InitialInterval: 1, MaxInterval: 2, Exponent: 1, MaxElapsedTime: 1000},
+	}
+	out, err = client.Raw(ctx, "POST", "/hubs/custom", nil, strings.NewReader(`{"title":"Custom"}`), operations.WithRetries(backoff))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if string(out) != `{"size":1,"Hub":[{"title":"Custom"}]}` {
+		t.Errorf("Expected the MediaContainer, got: %s", out)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got: %d", attempts)
+	}
+
+	out, err = client.Raw(ctx, "GET", "/identity.json", nil, nil)
+	if err != nil || string(out) != `{"machineIdentifier":"abc"}` {
+		t.Errorf("Expected the whole response without a MediaContainer, got: %s %v", out, err)
+	}
+
+	_, err = client.Raw(ctx, "GET", "/missing", nil, nil)
+	var plexErr *sdkerrors.PlexError
+	if !errors.As(err, &plexErr) || plexErr.Code != 1002 {
+		t.Errorf("Expected a PlexError with code 1002, got: %v", err)
+	}
+}
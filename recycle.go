@@ -0,0 +1,414 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// collectionBackupVersion is the version of the collection backup format
+const collectionBackupVersion = 1
+
+// CollectionBackup is the definition of a collection as exported by ExportCollection, from which
+// Restore recreates it. Smart collections are defined by their filter, other collections by
+// their members.
+type CollectionBackup struct {
+	Version       int                      `json:"version"`
+	ExportedAt    time.Time                `json:"exportedAt"`
+	CollectionID  int                      `json:"collectionId"` // Rating key the collection had
+	SectionID     int                      `json:"sectionId"`
+	Title         string                   `json:"title"`
+	Summary       string                   `json:"summary,omitempty"`
+	ContentRating string                   `json:"contentRating,omitempty"`
+	Mode          string                   `json:"mode,omitempty"` // One of the CollectionMode constants
+	Sort          string                   `json:"sort,omitempty"` // One of the CollectionSort constants
+	Labels        []string                 `json:"labels,omitempty"`
+	Visibility    *CollectionVisibility    `json:"visibility,omitempty"`
+	Thumb         string                   `json:"thumb,omitempty"` // Artwork references, only restored if they are remote images
+	Art           string                   `json:"art,omitempty"`
+	Theme         string                   `json:"theme,omitempty"`
+	SmartType     MetadataType             `json:"smartType,omitempty"` // Item type of the smart filter
+	Filter        string                   `json:"filter,omitempty"`    // Smart filter query, e.g. "?type=1&decade=1990"
+	Members       []CollectionBackupMember `json:"members,omitempty"`
+}
+
+// CollectionBackupMember is an item of a backed up collection, matched on its GUIDs when the
+// collection is restored
+type CollectionBackupMember struct {
+	RatingKey string   `json:"ratingKey"`
+	Title     string   `json:"title,omitempty"`
+	GUIDs     []string `json:"guids,omitempty"`
+}
+
+// RestoredCollection is the result of Restore
+type RestoredCollection struct {
+	Collection *Collection
+	Unmatched  []CollectionBackupMember // Members that match no item of the section, which were skipped
+}
+
+// WithCollectionRecycleBin makes DeleteCollection export each collection to a file in dir before
+// deleting it, so that deletions, e.g. by an automation gone wrong, can be undone with Restore.
+// A collection is not deleted if it can't be exported. The directory is created if needed.
+func WithCollectionRecycleBin(dir string) SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.CollectionRecycleBin = dir
+	}
+}
+
+// ExportCollection writes the definition of a collection as JSON to w: its settings, labels,
+// visibility and artwork references, along with its smart filter or its members and their GUIDs.
+// Restore recreates the collection from it.
+func (s *Collections) ExportCollection(ctx context.Context, collectionID int, w io.Writer, opts ...operations.Option) (*CollectionBackup, error) {
+	backup, err := s.backupCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(backup); err != nil {
+		return nil, fmt.Errorf("error writing collection backup: %w", err)
+	}
+	return backup, nil
+}
+
+// backupCollection reads the definition of a collection
+func (s *Collections) backupCollection(ctx context.Context, collectionID int, opts ...operations.Option) (*CollectionBackup, error) {
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection: %w", err)
+	}
+
+	backup := &CollectionBackup{
+		Version:       collectionBackupVersion,
+		ExportedAt:    time.Now().UTC(),
+		CollectionID:  collectionID,
+		SectionID:     collection.SectionID,
+		Title:         collection.Title,
+		Summary:       collection.Summary,
+		ContentRating: collection.ContentRating,
+		Mode:          collection.CollectionMode,
+		Sort:          collection.CollectionSort,
+		Thumb:         collection.Thumb,
+		Art:           collection.Art,
+		Theme:         collection.Theme,
+	}
+	for _, label := range collection.Label {
+		backup.Labels = append(backup.Labels, label.Tag)
+	}
+
+	if backup.Visibility, err = s.GetCollectionVisibility(ctx, collection.SectionID, collectionID, opts...); err != nil {
+		return nil, fmt.Errorf("error getting visibility of %s: %w", collection.Title, err)
+	}
+
+	if collection.IsSmartCollection() {
+		config, err := s.GetSmartFilterConfig(ctx, collection, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting smart filter of %s: %w", collection.Title, err)
+		}
+		backup.SmartType, backup.Filter = config.Type, config.Filter
+		return backup, nil
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("includeGuids", "1")
+
+	members, err := newLibrary(s.sdkConfiguration).listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), queryParams, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection items: %w", err)
+	}
+	for _, item := range members {
+		backup.Members = append(backup.Members, CollectionBackupMember{
+			RatingKey: item.RatingKey,
+			Title:     item.Title,
+			GUIDs:     metadataGUIDs(item),
+		})
+	}
+
+	return backup, nil
+}
+
+// recycleCollection exports a collection to the recycle bin set with WithCollectionRecycleBin and
+// returns the path of the file
+func (s *Collections) recycleCollection(ctx context.Context, collectionID int, opts ...operations.Option) (string, error) {
+	dir := s.sdkConfiguration.CollectionRecycleBin
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("error creating recycle bin: %w", err)
+	}
+
+	name := fmt.Sprintf("collection-%d-%s.json", collectionID, time.Now().UTC().Format("20060102T150405.000000000Z"))
+	return s.exportCollectionFile(ctx, collectionID, dir, name, opts...)
+}
+
+// exportCollectionFile exports a collection to the named file in dir and returns its path
+func (s *Collections) exportCollectionFile(ctx context.Context, collectionID int, dir, name string, opts ...operations.Option) (string, error) {
+	file, err := os.CreateTemp(dir, name+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("error creating collection backup: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	_, err = s.ExportCollection(ctx, collectionID, file, opts...)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("error writing collection backup: %w", closeErr)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// The backup only appears under its name once complete
+	path := filepath.Join(dir, name)
+	if err := os.Rename(file.Name(), path); err != nil {
+		return "", fmt.Errorf("error saving collection backup: %w", err)
+	}
+	return path, nil
+}
+
+// Restore recreates a collection from a backup written by ExportCollection or by DeleteCollection
+// with WithCollectionRecycleBin, in the section it was exported from. Members are matched on
+// their GUIDs, so a backup can be restored after items were re-added, and on their rating key if
+// they have no GUIDs; members matching no item are reported and skipped. Restoring is idempotent:
+// a collection with the same title is reused. Artwork is only restored if it refers to a remote
+// image, as artwork uploaded to the server is deleted with the collection.
+func (s *Collections) Restore(ctx context.Context, r io.Reader, opts ...operations.Option) (*RestoredCollection, error) {
+	ctx = withOperationCorrelationID(ctx)
+
+	var backup CollectionBackup
+	if err := json.NewDecoder(r).Decode(&backup); err != nil {
+		return nil, fmt.Errorf("error reading collection backup: %w", err)
+	}
+	if backup.Version != collectionBackupVersion {
+		return nil, fmt.Errorf("unsupported collection backup version %d", backup.Version)
+	}
+	if backup.SectionID == 0 || backup.Title == "" {
+		return nil, fmt.Errorf("collection backup has no section or title")
+	}
+
+	createOptions := CreateCollectionOptions{
+		Mode:       backup.Mode,
+		Sort:       backup.Sort,
+		Visibility: backup.Visibility,
+		Summary:    backup.Summary,
+		Idempotent: true,
+	}
+	if strings.HasPrefix(backup.Thumb, "http://") || strings.HasPrefix(backup.Thumb, "https://") {
+		createOptions.PosterURL = backup.Thumb
+	}
+
+	result := &RestoredCollection{Unmatched: []CollectionBackupMember{}}
+
+	var err error
+	if backup.Filter != "" {
+		result.Collection, err = s.CreateSmartCollectionWithOptions(ctx, backup.SectionID, backup.Title, backup.SmartType, backup.Filter, createOptions, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error restoring %s: %w", backup.Title, err)
+		}
+	} else {
+		keys, err := s.sectionGUIDKeys(ctx, backup.SectionID, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		itemIDs := []string{}
+		for _, member := range backup.Members {
+			ratingKey := restoreMemberKey(member, keys)
+			if ratingKey == "" {
+				result.Unmatched = append(result.Unmatched, member)
+				continue
+			}
+			itemIDs = append(itemIDs, ratingKey)
+		}
+		if len(itemIDs) == 0 {
+			return nil, fmt.Errorf("error restoring %s: none of its %d members match an item in section %d", backup.Title, len(backup.Members), backup.SectionID)
+		}
+
+		result.Collection, err = s.CreateCollectionWithOptions(ctx, backup.SectionID, backup.Title, itemIDs, createOptions, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error restoring %s: %w", backup.Title, err)
+		}
+	}
+
+	if err := s.restoreDetails(ctx, &backup, result.Collection, opts...); err != nil {
+		return result, fmt.Errorf("error restoring details of %s: %w", backup.Title, err)
+	}
+
+	return result, nil
+}
+
+// restoreMemberKey returns the rating key of the item matching a backed up member, "" if none does
+func restoreMemberKey(member CollectionBackupMember, keys map[string]string) string {
+	if len(member.GUIDs) == 0 {
+		return member.RatingKey
+	}
+	for _, guid := range member.GUIDs {
+		if ratingKey, ok := keys[normalizeGUID(guid)]; ok {
+			return ratingKey
+		}
+	}
+	return ""
+}
+
+// restoreDetails restores the content rating and labels of a backup, which are not part of
+// CreateCollectionOptions
+func (s *Collections) restoreDetails(ctx context.Context, backup *CollectionBackup, collection *Collection, opts ...operations.Option) error {
+	collectionID, err := collection.ID()
+	if err != nil {
+		return fmt.Errorf("error converting collection ID to int: %w", err)
+	}
+
+	if backup.ContentRating != "" && backup.ContentRating != collection.ContentRating {
+		if err := s.UpdateContentRating(ctx, collectionID, backup.ContentRating, true, opts...); err != nil {
+			return err
+		}
+	}
+
+	if len(backup.Labels) == 0 {
+		return nil
+	}
+
+	// Section listings don't include labels
+	current, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return err
+	}
+	for _, label := range backup.Labels {
+		if err := s.addCollectionLabel(ctx, current, label, opts...); err != nil {
+			return err
+		}
+		if !collectionHasLabel(current, label) {
+			current.Label = append(current.Label, Tag{Tag: label})
+		}
+	}
+	collection.Label = current.Label
+	return nil
+}
+
+// ExportCollections exports every collection of a section to dir, as collection-<ratingKey>.json
+// files that RestoreCollections or Restore recreate the collections from, in order of rating key.
+// If it stops part way, e.g. because the process is shutting down, it returns a checkpoint with
+// the error; pass it back as resume, e.g. after saving it with SaveCheckpoint, to continue after
+// the last collection exported. A nil resume exports every collection.
+func (s *Collections) ExportCollections(ctx context.Context, sectionID int, dir string, resume *Checkpoint, opts ...operations.Option) (*Checkpoint, error) {
+	ctx = withOperationCorrelationID(ctx)
+
+	checkpoint := &Checkpoint{Operation: "exportCollections", Scope: fmt.Sprintf("section %d to %s", sectionID, dir)}
+	last := 0
+	if resume != nil {
+		if !resume.Matches(checkpoint.Operation, checkpoint.Scope) {
+			return nil, fmt.Errorf("checkpoint of %s %s can't resume exporting %s", resume.Operation, resume.Scope, checkpoint.Scope)
+		}
+		checkpoint.Offset, checkpoint.Last = resume.Offset, resume.Last
+		if resume.Last != "" {
+			var err error
+			if last, err = strconv.Atoi(resume.Last); err != nil {
+				return nil, fmt.Errorf("invalid checkpoint rating key %q: %w", resume.Last, err)
+			}
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating backup directory: %w", err)
+	}
+
+	collections, err := s.GetAllCollections(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collections: %w", err)
+	}
+
+	ids := []int{}
+	for _, collection := range collections {
+		id, err := collection.ID()
+		if err != nil {
+			return nil, fmt.Errorf("error converting collection ID to int: %w", err)
+		}
+		if id > last {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	options := processOptions(opts)
+	reportProgress(options, 0, len(ids), "exporting collections")
+	for i, id := range ids {
+		if ctx.Err() != nil {
+			return checkpoint.touch(), ctx.Err()
+		}
+		if _, err := s.exportCollectionFile(ctx, id, dir, fmt.Sprintf("collection-%d.json", id), opts...); err != nil {
+			return checkpoint.touch(), fmt.Errorf("error exporting collection %d: %w", id, err)
+		}
+		checkpoint.Offset++
+		checkpoint.Last = strconv.Itoa(id)
+		reportProgress(options, i+1, len(ids), "exporting collections")
+	}
+
+	return checkpoint.touch(), nil
+}
+
+// RestoreCollections restores the collections of every backup in dir, the .json files written by
+// ExportCollections or by DeleteCollection with WithCollectionRecycleBin, as Restore does, in
+// order of file name. If it stops part way, it returns a checkpoint with the error; pass it back
+// as resume to continue after the last backup restored. The collections restored by this run are
+// returned either way.
+func (s *Collections) RestoreCollections(ctx context.Context, dir string, resume *Checkpoint, opts ...operations.Option) ([]RestoredCollection, *Checkpoint, error) {
+	ctx = withOperationCorrelationID(ctx)
+
+	checkpoint := &Checkpoint{Operation: "restoreCollections", Scope: dir}
+	if resume != nil {
+		if !resume.Matches(checkpoint.Operation, checkpoint.Scope) {
+			return nil, nil, fmt.Errorf("checkpoint of %s %s can't resume restoring %s", resume.Operation, resume.Scope, dir)
+		}
+		checkpoint.Offset, checkpoint.Last = resume.Offset, resume.Last
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing backups: %w", err)
+	}
+	names := []string{}
+	for _, path := range paths {
+		if name := filepath.Base(path); name > checkpoint.Last {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	restored := []RestoredCollection{}
+	options := processOptions(opts)
+	reportProgress(options, 0, len(names), "restoring collections")
+	for i, name := range names {
+		if ctx.Err() != nil {
+			return restored, checkpoint.touch(), ctx.Err()
+		}
+		result, err := s.restoreFile(ctx, filepath.Join(dir, name), opts...)
+		if err != nil {
+			return restored, checkpoint.touch(), fmt.Errorf("error restoring %s: %w", name, err)
+		}
+		restored = append(restored, *result)
+		checkpoint.Offset++
+		checkpoint.Last = name
+		reportProgress(options, i+1, len(names), "restoring collections")
+	}
+
+	return restored, checkpoint.touch(), nil
+}
+
+// restoreFile restores the collection of a backup file
+func (s *Collections) restoreFile(ctx context.Context, path string, opts ...operations.Option) (*RestoredCollection, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return s.Restore(ctx, file, opts...)
+}
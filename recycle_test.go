@@ -0,0 +1,224 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCollectionRecycleBin(t *testing.T) {
+	deleted := false
+	created := ""
+	labeled := ""
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Heist","type":"collection","subtype":"movie","librarySectionID":1,"collectionMode":"0","contentRating":"PG-13","Label":[{"tag":"Kids"}]}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/hubs/sections/1/manage":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"promotedToRecommended":"1","promotedToOwnHome":"0","promotedToSharedHome":"0"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7/children":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"10","title":"Heat","type":"movie","Guid":[{"id":"imdb://tt0113277"}]},
+				{"ratingKey":"11","title":"Ronin","type":"movie","Guid":[{"id":"imdb://tt0122690"}]}
+			]}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/library/collections/7":
+			deleted = true
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all" && r.URL.Query().Get("includeGuids") == "1":
+			// Heat was re-added with a new rating key, Ronin is gone
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"20","title":"Heat","type":"movie","Guid":[{"id":"imdb://tt0113277"}]}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/20":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"20","type":"movie"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			created = r.URL.Query().Get("uri")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Heist","type":"collection","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Heist","type":"collection","librarySectionID":1}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			if label := r.URL.Query().Get("label[0].tag.tag"); label != "" {
+				labeled = label
+			}
+		case r.Method == "PUT" || r.Method == "POST":
+			// Settings of the restored collection
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "recycle")
+	client := New(WithServerURL(server.URL), WithCollectionRecycleBin(dir))
+	ctx := context.Background()
+
+	if err := client.Collections.DeleteCollection(ctx, 7, WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !deleted {
+		t.Fatal("Expected the collection to be deleted")
+	}
+
+	backups, err := filepath.Glob(filepath.Join(dir, "collection-7-*.json"))
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("Expected one backup, got: %v %v", backups, err)
+	}
+	file, err := os.Open(backups[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	result, err := client.Collections.Restore(ctx, file, WithWaitForActivity(time.Second))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Collection.RatingKey != "8" || created != "server://abc123/com.plexapp.plugins.library/library/metadata/20" {
+		t.Errorf("Expected the collection to be recreated with Heat, got %+v from %q", result.Collection, created)
+	}
+	if len(result.Unmatched) != 1 || result.Unmatched[0].Title != "Ronin" {
+		t.Errorf("Expected Ronin to be unmatched, got: %+v", result.Unmatched)
+	}
+	if labeled != "Kids" {
+		t.Errorf("Expected the label to be restored, got: %q", labeled)
+	}
+
+	// Collections aren't deleted if they can't be exported
+	deleted = false
+	blocked := filepath.Join(t.TempDir(), "file")
+	os.WriteFile(blocked, nil, 0o600)
+	client = New(WithServerURL(server.URL), WithCollectionRecycleBin(blocked))
+	if err := client.Collections.DeleteCollection(ctx, 7, WithWaitForActivity(time.Second)); err == nil || deleted {
+		t.Errorf("Expected the deletion to fail without a backup, got: %v", err)
+	}
+}
+
+func TestExportCollectionsResume(t *testing.T) {
+	exports := map[string]int{}
+	failing := "9"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		id := strings.Split(strings.TrimPrefix(r.URL.Path, "/library/collections/"), "/")[0]
+		switch {
+		case r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"ratingKey":"12","title":"Noir","type":"collection"},
+				{"ratingKey":"7","title":"Heist","type":"collection"},
+				{"ratingKey":"9","title":"Crime","type":"collection"}
+			]}}`))
+		case r.URL.Path == "/hubs/sections/1/manage":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Directory":[{"promotedToRecommended":"1","promotedToOwnHome":"0","promotedToSharedHome":"0"}]}}`))
+		case strings.HasSuffix(r.URL.Path, "/children"):
+			if id == failing {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","title":"Heat","type":"movie","Guid":[{"id":"imdb://tt0113277"}]}]}}`))
+		case strings.HasPrefix(r.URL.Path, "/library/collections/"):
+			exports[id]++
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"` + id + `","title":"Collection ` + id + `","type":"collection","subtype":"movie","librarySectionID":1}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	checkpoint, err := client.Collections.ExportCollections(ctx, 1, dir, nil)
+	if err == nil || checkpoint == nil || checkpoint.Last != "7" || checkpoint.Offset != 1 {
+		t.Fatalf("Expected the export to stop after collection 7, got: %+v, %v", checkpoint, err)
+	}
+
+	// The checkpoint survives a restart
+	path := filepath.Join(t.TempDir(), "export.checkpoint")
+	if err := SaveCheckpoint(path, checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	resumed, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failing = ""
+	checkpoint, err = client.Collections.ExportCollections(ctx, 1, dir, resumed)
+	if err != nil || checkpoint.Last != "12" || checkpoint.Offset != 3 {
+		t.Fatalf("Expected the export to finish, got: %+v, %v", checkpoint, err)
+	}
+	if exports["7"] != 1 || exports["9"] != 2 || exports["12"] != 1 {
+		t.Errorf("Expected only the remaining collections to be exported again, got: %v", exports)
+	}
+	for _, name := range []string{"collection-7.json", "collection-9.json", "collection-12.json"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("Expected %s to be written: %v", name, err)
+		}
+	}
+
+	if _, err := client.Collections.ExportCollections(ctx, 2, dir, checkpoint); err == nil {
+		t.Error("Expected an error for the checkpoint of another section")
+	}
+}
+
+func TestRestoreCollectionsResume(t *testing.T) {
+	created := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"20","title":"Heat","type":"movie","Guid":[{"id":"imdb://tt0113277"}]}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/20":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"20","type":"movie"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "POST" && r.URL.Path == "/library/collections":
+			title := r.URL.Query().Get("title")
+			created = append(created, title)
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"` + title + `","type":"collection","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"` + created[len(created)-1] + `","type":"collection","librarySectionID":1}]}}`))
+		case r.Method == "PUT" || r.Method == "POST":
+			// Settings of the restored collections
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	backup := func(title string) string {
+		return `{"version":1,"sectionId":1,"title":"` + title + `","members":[{"ratingKey":"10","title":"Heat","guids":["imdb://tt0113277"]}]}`
+	}
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "collection-7.json"), []byte(backup("Heist")), 0o600)
+	os.WriteFile(filepath.Join(dir, "collection-9.json"), []byte(`{"version":99}`), 0o600)
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	restored, checkpoint, err := client.Collections.RestoreCollections(ctx, dir, nil, WithWaitForActivity(time.Second))
+	if err == nil || checkpoint == nil || checkpoint.Last != "collection-7.json" || checkpoint.Offset != 1 || len(restored) != 1 {
+		t.Fatalf("Expected the restore to stop after collection-7.json, got: %+v, %v", checkpoint, err)
+	}
+
+	os.WriteFile(filepath.Join(dir, "collection-9.json"), []byte(backup("Crime")), 0o600)
+	restored, checkpoint, err = client.Collections.RestoreCollections(ctx, dir, checkpoint, WithWaitForActivity(time.Second))
+	if err != nil || checkpoint.Last != "collection-9.json" || checkpoint.Offset != 2 || len(restored) != 1 {
+		t.Fatalf("Expected the restore to finish, got: %+v, %v", checkpoint, err)
+	}
+	if strings.Join(created, ",") != "Heist,Crime" {
+		t.Errorf("Expected each collection to be created once, got: %v", created)
+	}
+}
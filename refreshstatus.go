@@ -0,0 +1,138 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// scanStartTimeout is how long WaitForScanComplete waits for a scan to start before assuming it
+// already finished or was never triggered
+var scanStartTimeout = 10 * time.Second
+
+// ScanActivity is a server activity working on a library section, e.g. a scan or a metadata refresh
+type ScanActivity struct {
+	UUID     string
+	Type     string // e.g. "library.update.section" or "library.refresh.items"
+	Title    string
+	Subtitle string
+	Progress float64 // Percent complete
+}
+
+// RefreshStatus is the scan state of a library section
+type RefreshStatus struct {
+	SectionID  int
+	Refreshing bool           // The section is being scanned or refreshed
+	Activities []ScanActivity // Server activities working on the section
+	ScannedAt  time.Time      // When the section was last scanned, zero if never
+}
+
+// GetRefreshStatus reports whether a library section is being scanned or refreshed, combining the
+// section's refreshing flag with the server activities working on it
+func (s *Library) GetRefreshStatus(ctx context.Context, sectionID int, opts ...operations.Option) (*RefreshStatus, error) {
+	section, err := s.getSection(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	options := processOptions(opts)
+	var activityOpts []operations.Option
+	if options.ServerURL != nil {
+		activityOpts = append(activityOpts, operations.WithServerURL(*options.ServerURL))
+	}
+	res, err := newActivities(s.sdkConfiguration).GetServerActivities(ctx, activityOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server activities: %w", err)
+	}
+
+	status := &RefreshStatus{
+		SectionID:  sectionID,
+		Refreshing: section.Refreshing,
+		Activities: sectionActivities(res, sectionID),
+	}
+	if len(status.Activities) > 0 {
+		status.Refreshing = true
+	}
+	if section.ScannedAt > 0 {
+		status.ScannedAt = time.Unix(section.ScannedAt, 0)
+	}
+
+	return status, nil
+}
+
+// WaitForScanComplete waits for the scan or refresh of a library section to finish, for up to
+// timeout, e.g. to create collections from new items once a scan triggered by
+// GetRefreshLibraryMetadata is done. Since a scan triggered just before may not have started yet,
+// it waits a few seconds for one to start unless the section was scanned since the call.
+// ErrActivityTimeout is returned if the section is still refreshing after the timeout.
+func (s *Library) WaitForScanComplete(ctx context.Context, sectionID int, timeout time.Duration, opts ...operations.Option) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// scannedAt has a resolution of seconds
+	start := time.Now().Truncate(time.Second)
+	started := false
+
+	for {
+		status, err := s.GetRefreshStatus(waitCtx, sectionID, opts...)
+		if err != nil {
+			if ctx.Err() == nil && waitCtx.Err() != nil {
+				return fmt.Errorf("%w: section %d is still refreshing after %s", ErrActivityTimeout, sectionID, timeout)
+			}
+			return err
+		}
+
+		if status.Refreshing {
+			started = true
+		} else if started || !status.ScannedAt.Before(start) || time.Since(start) > scanStartTimeout {
+			return nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("%w: section %d is still refreshing after %s", ErrActivityTimeout, sectionID, timeout)
+		case <-time.After(activityPollInterval):
+		}
+	}
+}
+
+// sectionActivities returns the library activities of a section
+func sectionActivities(res *operations.GetServerActivitiesResponse, sectionID int) []ScanActivity {
+	if res.Object == nil || res.Object.MediaContainer == nil {
+		return nil
+	}
+
+	var activities []ScanActivity
+	for _, activity := range res.Object.MediaContainer.Activity {
+		if activity.Context == nil || activity.Context.LibrarySectionID == nil || *activity.Context.LibrarySectionID != strconv.Itoa(sectionID) {
+			continue
+		}
+		if activity.Type == nil || !strings.HasPrefix(*activity.Type, "library.") {
+			continue
+		}
+
+		scan := ScanActivity{Type: *activity.Type}
+		if activity.UUID != nil {
+			scan.UUID = *activity.UUID
+		}
+		if activity.Title != nil {
+			scan.Title = *activity.Title
+		}
+		if activity.Subtitle != nil {
+			scan.Subtitle = *activity.Subtitle
+		}
+		if activity.Progress != nil {
+			scan.Progress = *activity.Progress
+		}
+		activities = append(activities, scan)
+	}
+
+	return activities
+}
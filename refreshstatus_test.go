@@ -0,0 +1,97 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetRefreshStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/sections":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Directory":[
+				{"key":"1","type":"movie","title":"Movies","refreshing":false,"scannedAt":1714564800},
+				{"key":"2","type":"show","title":"TV Shows","refreshing":false,"scannedAt":0}]}}`))
+		case "/activities":
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Activity":[
+				{"uuid":"a1","type":"library.update.section","title":"Scanning Movies","progress":40,"Context":{"librarySectionID":"1"}},
+				{"uuid":"a2","type":"media.generate.bif","title":"Generating thumbnails","Context":{"librarySectionID":"1"}},
+				{"uuid":"a3","type":"library.update.section","title":"Scanning Music","Context":{"librarySectionID":"3"}}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	status, err := client.Library.GetRefreshStatus(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !status.Refreshing || len(status.Activities) != 1 || status.Activities[0].UUID != "a1" || status.Activities[0].Progress != 40 {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+	if !status.ScannedAt.Equal(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected scan time: %s", status.ScannedAt)
+	}
+
+	status, err = client.Library.GetRefreshStatus(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Refreshing || len(status.Activities) != 0 || !status.ScannedAt.IsZero() {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+
+	if _, err := client.Library.GetRefreshStatus(context.Background(), 9); err == nil {
+		t.Errorf("Expected an error for an unknown section")
+	}
+}
+
+func TestWaitForScanComplete(t *testing.T) {
+	defer func(interval, start time.Duration) {
+		activityPollInterval, scanStartTimeout = interval, start
+	}(activityPollInterval, scanStartTimeout)
+	activityPollInterval = time.Millisecond
+	scanStartTimeout = time.Hour
+
+	// The scan starts on the second poll and runs for three polls
+	polls := 0
+	stuck := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/sections":
+			polls++
+			refreshing := stuck || (polls >= 2 && polls <= 4)
+			fmt.Fprintf(w, `{"MediaContainer":{"size":1,"Directory":[{"key":"1","type":"movie","title":"Movies","refreshing":%t,"scannedAt":1714564800}]}}`, refreshing)
+		case "/activities":
+			w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	if err := client.Library.WaitForScanComplete(context.Background(), 1, time.Second); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if polls != 5 {
+		t.Errorf("Expected to wait for the scan to start and finish, got %d polls", polls)
+	}
+
+	stuck = true
+	err := client.Library.WaitForScanComplete(context.Background(), 1, 20*time.Millisecond)
+	if !errors.Is(err, ErrActivityTimeout) {
+		t.Errorf("Expected ErrActivityTimeout, got: %v", err)
+	}
+}
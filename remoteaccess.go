@@ -0,0 +1,178 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// RemoteAccessStatus is the plex.tv account linkage and remote access state of the server
+type RemoteAccessStatus struct {
+	Username           string
+	SignInState        string // "ok" when the server is signed in to plex.tv
+	MappingState       string // "mapped" when plex.tv can reach the server, e.g. "unknown" or "failed" otherwise
+	MappingError       string // e.g. "unreachable" or "doubleNat"
+	PublicAddress      string
+	PublicPort         int
+	PrivateAddress     string
+	PrivatePort        int
+	SubscriptionActive bool // Whether the account has an active Plex Pass
+}
+
+// SignedIn returns true if the server is linked to a plex.tv account
+func (r RemoteAccessStatus) SignedIn() bool {
+	return r.SignInState == "ok"
+}
+
+// Reachable returns true if plex.tv could reach the server from outside its network
+func (r RemoteAccessStatus) Reachable() bool {
+	return r.MappingState == "mapped"
+}
+
+// GetRemoteAccessStatus gets the plex.tv account linkage and remote access state of the server,
+// as reported by GetMyPlexAccount, to diagnose why a server is not reachable remotely
+func (s *Server) GetRemoteAccessStatus(ctx context.Context, opts ...operations.Option) (*RemoteAccessStatus, error) {
+	res, err := s.GetMyPlexAccount(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	myPlex := res.GetObject().GetMyPlex()
+	if myPlex == nil {
+		return nil, fmt.Errorf("server did not return its plex.tv account")
+	}
+
+	status := &RemoteAccessStatus{
+		Username:       stringValue(myPlex.Username),
+		SignInState:    stringValue(myPlex.SignInState),
+		MappingState:   stringValue(myPlex.MappingState),
+		MappingError:   stringValue(myPlex.MappingError),
+		PublicAddress:  stringValue(myPlex.PublicAddress),
+		PrivateAddress: stringValue(myPlex.PrivateAddress),
+	}
+	if myPlex.PublicPort != nil {
+		status.PublicPort = int(*myPlex.PublicPort)
+	}
+	if myPlex.PrivatePort != nil {
+		status.PrivatePort = int(*myPlex.PrivatePort)
+	}
+	if myPlex.SubscriptionActive != nil {
+		status.SubscriptionActive = *myPlex.SubscriptionActive
+	}
+
+	return status, nil
+}
+
+// SetRemoteAccess enables or disables remote access to the server. With manualMapping, the server
+// is published on publicPort, which must be forwarded to it by the router; otherwise the port is
+// mapped automatically with UPnP or NAT-PMP. Use GetRemoteAccessStatus to check the result, as
+// plex.tv takes a few seconds to test the new mapping.
+func (s *Server) SetRemoteAccess(ctx context.Context, enabled bool, publicPort int, manualMapping bool, opts ...operations.Option) error {
+	if manualMapping && (publicPort < 1 || publicPort > 65535) {
+		return fmt.Errorf("invalid public port %d", publicPort)
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, "/:/prefs")
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("PublishServerOnPlexOnlineKey", boolPref(enabled))
+	queryParams.Add("ManualPortMappingMode", boolPref(manualMapping))
+	if manualMapping {
+		queryParams.Add("ManualPortMappingPort", strconv.Itoa(publicPort))
+	}
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "setRemoteAccess",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		_, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// boolPref returns the value of a boolean server preference
+func boolPref(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+// stringValue returns the value of an optional string, or "" if it is not set
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
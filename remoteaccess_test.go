@@ -0,0 +1,64 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetRemoteAccessStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/myplex/account" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MyPlex":{"username":"alice","signInState":"ok","mappingState":"failed","mappingError":"doubleNat","publicAddress":"203.0.113.5","publicPort":0,"privateAddress":"10.0.0.2","privatePort":32400,"subscriptionActive":true}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	status, err := client.Server.GetRemoteAccessStatus(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !status.SignedIn() || status.Reachable() {
+		t.Errorf("Expected a signed in but unreachable server, got: %+v", status)
+	}
+	if status.MappingError != "doubleNat" || status.PrivatePort != 32400 || status.Username != "alice" || !status.SubscriptionActive {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+}
+
+func TestSetRemoteAccess(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/:/prefs" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		query = r.URL.RawQuery
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	if err := client.Server.SetRemoteAccess(context.Background(), true, 32401, true); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if expected := "ManualPortMappingMode=1&ManualPortMappingPort=32401&PublishServerOnPlexOnlineKey=1"; query != expected {
+		t.Errorf("Expected query %s, got: %s", expected, query)
+	}
+
+	if err := client.Server.SetRemoteAccess(context.Background(), false, 0, false); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if expected := "ManualPortMappingMode=0&PublishServerOnPlexOnlineKey=0"; query != expected {
+		t.Errorf("Expected query %s, got: %s", expected, query)
+	}
+
+	if err := client.Server.SetRemoteAccess(context.Background(), true, 0, true); err == nil {
+		t.Error("Expected an error for a manual mapping without a port")
+	}
+}
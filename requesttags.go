@@ -0,0 +1,29 @@
+package plexgo
+
+import (
+	"context"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+)
+
+// WithRequestTag returns a copy of ctx that tags every request the SDK sends with it, e.g. the job
+// or tenant a call is made for. Tags aren't sent to the server; middlewares read them from the
+// request's context with RequestTags to attribute traffic in their logs and metrics. A later tag
+// with the same key replaces the earlier one.
+func WithRequestTag(ctx context.Context, key, value string) context.Context {
+	return hooks.WithTag(ctx, key, value)
+}
+
+// RequestTags returns a copy of the tags attached to ctx with WithRequestTag, or nil if there are none
+func RequestTags(ctx context.Context) map[string]string {
+	tags := hooks.Tags(ctx)
+	if tags == nil {
+		return nil
+	}
+
+	out := make(map[string]string, len(tags))
+	for k, v := range tags {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithRequestTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for name := range r.Header {
+			if strings.Contains(strings.ToLower(name), "tenant") {
+				t.Errorf("Expected tags not to be sent to the server, got header %s", name)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Action","type":"collection"}]}}`))
+	}))
+	defer server.Close()
+
+	var seen []map[string]string
+	metrics := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			seen = append(seen, RequestTags(req.Context()))
+			return next.RoundTrip(req)
+		})
+	}
+
+	client := New(WithServerURL(server.URL), WithMiddleware(metrics))
+
+	ctx := WithRequestTag(context.Background(), "tenant", "acme")
+	jobCtx := WithRequestTag(WithRequestTag(ctx, "job", "nightly"), "job", "hourly")
+
+	// Generated operations and helpers both carry the tags of their context
+	if _, err := client.Activities.GetServerActivities(jobCtx); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := client.Collections.GetCollection(ctx, 7); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := client.Activities.GetServerActivities(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(seen) != 3 {
+		t.Fatalf("Expected 3 requests, got: %d", len(seen))
+	}
+	if seen[0]["tenant"] != "acme" || seen[0]["job"] != "hourly" || len(seen[0]) != 2 {
+		t.Errorf("Expected the tenant and the latest job tag, got: %v", seen[0])
+	}
+	if seen[1]["tenant"] != "acme" || len(seen[1]) != 1 {
+		t.Errorf("Expected only the tenant tag, got: %v", seen[1])
+	}
+	if seen[2] != nil {
+		t.Errorf("Expected no tags, got: %v", seen[2])
+	}
+
+	// RequestTags returns a copy
+	RequestTags(ctx)["tenant"] = "changed"
+	if RequestTags(ctx)["tenant"] != "acme" {
+		t.Errorf("Expected the tags of the context to be unchanged, got: %v", RequestTags(ctx))
+	}
+}
@@ -0,0 +1,157 @@
+package plexgo
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// requiredResponseFields are the fields that must be present in the elements of the arrays of a
+// response with WithResponseValidation, by array name: the rating key of each item or collection
+// and the key of each library section, i.e. its section ID
+var requiredResponseFields = map[string]string{
+	"Metadata":  "ratingKey",
+	"Directory": "key",
+}
+
+// MissingFieldError is returned with WithResponseValidation when a response lacks a field the
+// SDK and its callers depend on. Field is the path of the missing field, e.g.
+// "MediaContainer.Metadata[3].ratingKey".
+type MissingFieldError struct {
+	OperationID string
+	Field       string
+}
+
+func (e *MissingFieldError) Error() string {
+	return fmt.Sprintf("%s: response is missing %s", e.OperationID, e.Field)
+}
+
+// WithResponseValidation makes operations fail with a *MissingFieldError when an item or
+// collection in a response has no rating key, or a library section has no key. Without it, such
+// responses decode into zero values that only fail later, e.g. when a rating key is converted to
+// an int.
+func WithResponseValidation() SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.ValidateResponses = true
+	}
+}
+
+// decodeResponse decodes a JSON response body into out with the configured codec, validating it
+// if WithResponseValidation is set
+func (c *sdkConfiguration) decodeResponse(operationID string, rawBody []byte, out interface{}) error {
+	if err := c.decodeJSON(rawBody, out); err != nil {
+		return err
+	}
+
+	return c.validateResponse(operationID, out)
+}
+
+// validateResponse checks a decoded response for missing required fields if WithResponseValidation is set
+func (c *sdkConfiguration) validateResponse(operationID string, out interface{}) error {
+	if !c.ValidateResponses {
+		return nil
+	}
+
+	if field := findMissingField(reflect.ValueOf(out), ""); field != "" {
+		return &MissingFieldError{OperationID: operationID, Field: field}
+	}
+
+	return nil
+}
+
+// findMissingField walks a decoded value alongside its JSON path and returns the path of the first
+// required field that is missing, or ""
+func findMissingField(v reflect.Value, path string) string {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+		return findMissingField(v.Elem(), path)
+
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := jsonFieldName(field)
+			if name == "" {
+				continue
+			}
+
+			fieldValue := v.Field(i)
+			if required, ok := requiredResponseFields[name]; ok && fieldValue.Kind() == reflect.Slice {
+				for j := 0; j < fieldValue.Len(); j++ {
+					elemPath := fmt.Sprintf("%s[%d]", joinFieldPath(path, name), j)
+					if missing := missingRequiredField(fieldValue.Index(j), required); missing {
+						return elemPath + "." + required
+					}
+					if found := findMissingField(fieldValue.Index(j), elemPath); found != "" {
+						return found
+					}
+				}
+				continue
+			}
+
+			if found := findMissingField(fieldValue, joinFieldPath(path, name)); found != "" {
+				return found
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if found := findMissingField(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); found != "" {
+				return found
+			}
+		}
+	}
+
+	return ""
+}
+
+// missingRequiredField returns true if an array element has a field with the JSON name required
+// that is empty. Elements without such a field aren't checked.
+func missingRequiredField(v reflect.Value, required string) bool {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if jsonFieldName(t.Field(i)) != required {
+			continue
+		}
+
+		field := v.Field(i)
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				return true
+			}
+			field = field.Elem()
+		}
+		return field.IsZero()
+	}
+
+	return false
+}
+
+// jsonFieldName returns the JSON name of an exported struct field, or "" if it isn't encoded
+func jsonFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,71 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResponseValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/sections":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Directory":[
+				{"key":"1","type":"movie","title":"Movies"},
+				{"type":"show","title":"TV Shows"}]}}`))
+		case "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"1","type":"movie","title":"Heat"},
+				{"type":"movie","title":"Ronin"}]}}`))
+		case "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"title":"Heist Movies"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	// Lenient by default
+	client := New(WithServerURL(server.URL))
+	items, err := client.Library.ListItems(context.Background(), 1, ItemListOptions{})
+	if err != nil || len(items) != 2 {
+		t.Fatalf("Expected 2 items, got: %v, %v", items, err)
+	}
+
+	client = New(WithServerURL(server.URL), WithResponseValidation())
+
+	tests := []struct {
+		name      string
+		call      func() error
+		operation string
+		field     string
+	}{
+		{"hand-written", func() error {
+			_, err := client.Library.ListItems(context.Background(), 1, ItemListOptions{})
+			return err
+		}, "getAllMediaLibrary", "MediaContainer.Metadata[1].ratingKey"},
+		{"collections", func() error {
+			_, err := client.Collections.GetAllCollections(context.Background(), 1)
+			return err
+		}, "getAllCollections", "MediaContainer.Metadata[0].ratingKey"},
+		{"generated", func() error {
+			_, err := client.Library.GetAllLibraries(context.Background())
+			return err
+		}, "get-all-libraries", "MediaContainer.Directory[1].key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var missingErr *MissingFieldError
+			if err := tt.call(); !errors.As(err, &missingErr) {
+				t.Fatalf("Expected *MissingFieldError, got: %v", err)
+			}
+			if missingErr.Field != tt.field || missingErr.OperationID != tt.operation {
+				t.Errorf("Expected %s of %s, got %s of %s", tt.field, tt.operation, missingErr.Field, missingErr.OperationID)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package retry
+
+import "sync"
+
+// Budget limits retries across all operations that share it, so a burst of failures from an
+// overloaded server doesn't turn into a retry storm across concurrent workers. Each retry spends
+// a token, and each successful request earns back a fraction of one, up to the initial amount.
+// A Budget is safe for concurrent use.
+type Budget struct {
+	mu        sync.Mutex
+	tokens    float64
+	maxTokens float64
+	ratio     float64
+}
+
+// NewBudget returns a budget that allows a burst of maxRetries retries and earns back ratio
+// retries per successful request, e.g. NewBudget(10, 0.1) allows one retry per ten successful
+// requests once the initial ten retries have been spent.
+func NewBudget(maxRetries int, ratio float64) *Budget {
+	return &Budget{
+		tokens:    float64(maxRetries),
+		maxTokens: float64(maxRetries),
+		ratio:     ratio,
+	}
+}
+
+// Withdraw spends a token for a retry, returning false if the budget is exhausted and the
+// operation should not be retried.
+func (b *Budget) Withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+// Deposit earns back part of a token after a successful request.
+func (b *Budget) Deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens += b.ratio
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+}
+
+// Available returns the number of retries the budget currently allows.
+func (b *Budget) Available() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return int(b.tokens)
+}
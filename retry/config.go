@@ -26,6 +26,13 @@ type Config struct {
 	Strategy              string
 	Backoff               *BackoffStrategy
 	RetryConnectionErrors bool
+	// IntervalStrategy, if set, computes the wait before each retry instead of the exponential
+	// intervals of Backoff, whose MaxElapsedTime still limits how long an operation is retried.
+	// It requires Backoff: operations fail with ErrIntervalWithoutBackoff without one.
+	IntervalStrategy Strategy
+	// Budget limits the retries made with this config together with every other config sharing
+	// the same Budget. Nil allows unlimited retries.
+	Budget *Budget
 }
 
 // PermanentError is an error that signals that some operation has terminally
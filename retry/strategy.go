@@ -0,0 +1,63 @@
+package retry
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Strategy computes how long to wait before a retry. attempt is 0 before the first retry, and
+// previous is the wait before the last retry (0 before the first). Set a Strategy as a Config's
+// IntervalStrategy to replace the default exponential intervals of its Backoff.
+type Strategy interface {
+	NextInterval(attempt int, previous time.Duration) time.Duration
+}
+
+// ErrIntervalWithoutBackoff is returned by operations whose retry config sets an IntervalStrategy
+// without a Backoff, whose MaxElapsedTime limits how long the strategy retries.
+var ErrIntervalWithoutBackoff = errors.New("retry config sets an interval strategy without a backoff")
+
+// StrategyFunc adapts a function to a Strategy.
+type StrategyFunc func(attempt int, previous time.Duration) time.Duration
+
+// NextInterval calls f(attempt, previous).
+func (f StrategyFunc) NextInterval(attempt int, previous time.Duration) time.Duration {
+	return f(attempt, previous)
+}
+
+// Constant waits the same interval before every retry.
+func Constant(interval time.Duration) Strategy {
+	return StrategyFunc(func(attempt int, previous time.Duration) time.Duration {
+		return interval
+	})
+}
+
+// ExponentialFullJitter waits a random interval between zero and an exponentially growing cap of
+// initial*multiplier^attempt, limited to max. Spreading retries over the whole interval keeps
+// concurrent clients from retrying in lockstep.
+func ExponentialFullJitter(initial time.Duration, max time.Duration, multiplier float64) Strategy {
+	return StrategyFunc(func(attempt int, previous time.Duration) time.Duration {
+		ceiling := float64(initial) * math.Pow(multiplier, float64(attempt))
+		if ceiling > float64(max) || math.IsInf(ceiling, 1) {
+			ceiling = float64(max)
+		}
+		return time.Duration(rand.Float64() * ceiling)
+	})
+}
+
+// DecorrelatedJitter waits a random interval between base and three times the previous wait,
+// limited to max, so waits grow like exponential backoff without being tied to the attempt count.
+func DecorrelatedJitter(base time.Duration, max time.Duration) Strategy {
+	return StrategyFunc(func(attempt int, previous time.Duration) time.Duration {
+		if previous < base {
+			previous = base
+		}
+
+		interval := base + time.Duration(rand.Float64()*float64(3*previous-base))
+		if interval > max {
+			interval = max
+		}
+		return interval
+	})
+}
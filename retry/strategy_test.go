@@ -0,0 +1,70 @@
+package retry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStrategies(t *testing.T) {
+	if got := Constant(time.Second).NextInterval(5, time.Second); got != time.Second {
+		t.Errorf("Expected constant interval of 1s, got: %s", got)
+	}
+
+	fullJitter := ExponentialFullJitter(10*time.Millisecond, time.Second, 2)
+	for attempt := 0; attempt < 20; attempt++ {
+		ceiling := 10 * time.Millisecond << attempt
+		if ceiling > time.Second || ceiling <= 0 {
+			ceiling = time.Second
+		}
+
+		for i := 0; i < 50; i++ {
+			if got := fullJitter.NextInterval(attempt, 0); got < 0 || got > ceiling {
+				t.Fatalf("Expected attempt %d to wait between 0 and %s, got: %s", attempt, ceiling, got)
+			}
+		}
+	}
+
+	decorrelated := DecorrelatedJitter(10*time.Millisecond, 200*time.Millisecond)
+	previous := time.Duration(0)
+	for attempt := 0; attempt < 50; attempt++ {
+		next := decorrelated.NextInterval(attempt, previous)
+		upper := 3 * previous
+		if upper < 30*time.Millisecond {
+			upper = 30 * time.Millisecond
+		}
+		if upper > 200*time.Millisecond {
+			upper = 200 * time.Millisecond
+		}
+		if next < 10*time.Millisecond || next > upper {
+			t.Fatalf("Expected attempt %d after %s to wait between 10ms and %s, got: %s", attempt, previous, upper, next)
+		}
+		previous = next
+	}
+}
+
+func TestBudget(t *testing.T) {
+	budget := NewBudget(2, 0.5)
+
+	if !budget.Withdraw() || !budget.Withdraw() {
+		t.Fatal("Expected the first two retries to be allowed")
+	}
+	if budget.Withdraw() {
+		t.Fatal("Expected the budget to be exhausted")
+	}
+
+	budget.Deposit()
+	if budget.Withdraw() {
+		t.Fatal("Expected half a token not to allow a retry")
+	}
+	budget.Deposit()
+	if !budget.Withdraw() {
+		t.Fatal("Expected two successes to earn back a retry")
+	}
+
+	for i := 0; i < 10; i++ {
+		budget.Deposit()
+	}
+	if budget.Available() != 2 {
+		t.Errorf("Expected the budget to be capped at 2, got: %d", budget.Available())
+	}
+}
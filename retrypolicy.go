@@ -0,0 +1,214 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/retry"
+)
+
+// ErrAlreadyApplied is returned when a failed attempt of an operation took effect anyway, as
+// reported by the check set with WithIdempotency before a retry. The operation is not repeated.
+var ErrAlreadyApplied = errors.New("operation took effect in an earlier attempt")
+
+// idempotentPostOperations are the POST operations that are safe to repeat, e.g. because they set
+// a value or start a task that is already running. Other POST requests, such as creating a
+// collection or playlist, may have taken effect when their response is lost.
+var idempotentPostOperations = map[string]bool{
+	"post-media-arts":            true,
+	"post-media-poster":          true,
+	"startAllTasks":              true,
+	"startTask":                  true,
+	"updateCollectionVisibility": true,
+	"updatePlayProgress":         true,
+}
+
+// appendingPutOperations are the PUT operations that append rather than set, so repeating one
+// after a lost response adds the same items again
+var appendingPutOperations = map[string]bool{
+	"addPlaylistContents": true,
+}
+
+// WithIdempotentOperation marks an operation, by its operation ID, as safe to retry or not,
+// overriding the SDK's own marking. Operations that aren't idempotent, like "createCollection"
+// and POST requests in general, are only retried with the WithRetries option of a call or a
+// policy set for them with WithOperationRetryConfig.
+func WithIdempotentOperation(operationID string, idempotent bool) SDKOption {
+	return func(sdk *PlexAPI) {
+		if sdk.sdkConfiguration.IdempotentOperations == nil {
+			sdk.sdkConfiguration.IdempotentOperations = map[string]bool{}
+		}
+		sdk.sdkConfiguration.IdempotentOperations[operationID] = idempotent
+	}
+}
+
+// IdempotencyFunc checks, before an operation is retried, whether the failed attempt took effect
+// anyway, e.g. whether a timed out creation created the item. Returning true stops the retries.
+type IdempotencyFunc func(ctx context.Context) (bool, error)
+
+// WithIdempotency makes a call of a non-idempotent operation, like CreateCollection, safe to
+// retry with the SDK's retry policies: before each retry check reports whether the failed attempt
+// took effect anyway, in which case the call stops with ErrAlreadyApplied instead of repeating it.
+func WithIdempotency(check IdempotencyFunc) operations.Option {
+	return helperOption(func(opts *helperOptions) {
+		opts.Idempotency = check
+	})
+}
+
+// WithOperationRetryConfig overrides the retry policy of a single operation by its operation ID,
+// e.g. "getLibraryItems". It takes precedence over WithMethodRetryConfig and WithRetryConfig, but
+// not over the WithRetries option of a call.
+func WithOperationRetryConfig(operationID string, retryConfig retry.Config) SDKOption {
+	return func(sdk *PlexAPI) {
+		if sdk.sdkConfiguration.OperationRetryConfigs == nil {
+			sdk.sdkConfiguration.OperationRetryConfigs = map[string]*retry.Config{}
+		}
+		sdk.sdkConfiguration.OperationRetryConfigs[operationID] = &retryConfig
+	}
+}
+
+// WithMethodRetryConfig overrides the retry policy of all operations using an HTTP method, e.g.
+// aggressive retries for "GET" and none for non-idempotent "POST" requests. It takes precedence
+// over WithRetryConfig.
+func WithMethodRetryConfig(method string, retryConfig retry.Config) SDKOption {
+	return func(sdk *PlexAPI) {
+		if sdk.sdkConfiguration.MethodRetryConfigs == nil {
+			sdk.sdkConfiguration.MethodRetryConfigs = map[string]*retry.Config{}
+		}
+		sdk.sdkConfiguration.MethodRetryConfigs[strings.ToUpper(method)] = &retryConfig
+	}
+}
+
+// WithRetryBudget limits the retries of every operation to a shared budget, unless its retry
+// config has a Budget of its own. Share the budget between SDK instances used by concurrent
+// workers to limit their retries together.
+func WithRetryBudget(budget *retry.Budget) SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.RetryBudget = budget
+	}
+}
+
+// isIdempotent returns true if an operation can be repeated without changing its outcome: requests
+// with any method but POST and PATCH, except PUT requests that append, and the POST operations
+// known to be safe
+func (c *sdkConfiguration) isIdempotent(operationID string, method string) bool {
+	if idempotent, ok := c.IdempotentOperations[operationID]; ok {
+		return idempotent
+	}
+
+	switch strings.ToUpper(method) {
+	case "POST":
+		return idempotentPostOperations[operationID]
+	case "PATCH":
+		return false
+	case "PUT":
+		return !appendingPutOperations[operationID]
+	default:
+		return true
+	}
+}
+
+// retryConfigFor returns the retry policy of an operation: the call's override if set, then the
+// operation's, the HTTP method's and finally the global policy, with the global budget applied.
+// Operations that aren't idempotent are only retried with the call's or the operation's policy.
+func (c *sdkConfiguration) retryConfigFor(operationID string, method string, override *retry.Config) *retry.Config {
+	if override == nil && c.OperationRetryConfigs[operationID] == nil && !c.isIdempotent(operationID, method) {
+		return nil
+	}
+
+	return c.retryPolicyFor(operationID, method, override)
+}
+
+// retryPolicyFor resolves the retry policy of an operation like retryConfigFor, whether it is
+// idempotent or not
+func (c *sdkConfiguration) retryPolicyFor(operationID string, method string, override *retry.Config) *retry.Config {
+	retryConfig := override
+	if retryConfig == nil {
+		retryConfig = c.OperationRetryConfigs[operationID]
+	}
+	if retryConfig == nil {
+		retryConfig = c.MethodRetryConfigs[method]
+	}
+	if retryConfig == nil {
+		retryConfig = c.RetryConfig
+	}
+
+	if retryConfig == nil || retryConfig.Budget != nil || c.RetryBudget == nil {
+		return retryConfig
+	}
+
+	budgeted := *retryConfig
+	budgeted.Budget = c.RetryBudget
+	return &budgeted
+}
+
+// send sends a request with the hooks of an operation, retrying it with retryConfig if set. If the
+// operation has an idempotency check, it is run before each retry and stops the retries with
+// ErrAlreadyApplied if the previous attempt took effect. Like Client.Do, the response is returned
+// whatever its status code.
+func (c *sdkConfiguration) send(ctx context.Context, hookCtx hooks.HookContext, req *http.Request, retryConfig *retry.Config, idempotency IdempotencyFunc) (*http.Response, error) {
+	do := func() (*http.Response, error) {
+		// Later attempts send the request returned by the hooks, so they can tell them from the first
+		var err error
+		req, err = c.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+		if err != nil {
+			return nil, err
+		}
+
+		httpRes, err := c.Client.Do(req)
+		if err != nil || httpRes == nil {
+			if err != nil {
+				err = fmt.Errorf("error sending request: %w", err)
+			} else {
+				err = fmt.Errorf("error sending request: no response")
+			}
+
+			_, err = c.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+			return nil, err
+		}
+		return httpRes, nil
+	}
+
+	if retryConfig == nil {
+		return do()
+	}
+
+	attempts := 0
+	return utils.Retry(ctx, utils.Retries{
+		Config: retryConfig,
+		StatusCodes: []string{
+			"429",
+			"500",
+			"502",
+			"503",
+			"504",
+		},
+	}, func() (*http.Response, error) {
+		attempts++
+		if attempts > 1 && idempotency != nil {
+			applied, err := idempotency(ctx)
+			if err != nil {
+				return nil, retry.Permanent(fmt.Errorf("error checking for an earlier attempt: %w", err))
+			}
+			if applied {
+				return nil, retry.Permanent(ErrAlreadyApplied)
+			}
+		}
+
+		if req.Body != nil && req.GetBody != nil {
+			copyBody, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = copyBody
+		}
+
+		return do()
+	})
+}
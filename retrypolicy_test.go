@@ -0,0 +1,249 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/retry"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	attempts := 0
+	failures := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if failures < 0 || attempts <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+	}))
+	defer server.Close()
+
+	backoff := retry.Config{
+		Strategy: "backoff",
+		Backoff: &retry.BackoffStrategy{
+			InitialInterval: 1,
+			MaxInterval:     2,
+			Exponent:        1,
+			MaxElapsedTime:  1000,
+		},
+	}
+
+	t.Run("budget", func(t *testing.T) {
+		attempts, failures = 0, -1
+		budget := retry.NewBudget(2, 0.5)
+		client := New(WithServerURL(server.URL), WithRetryConfig(backoff), WithRetryBudget(budget))
+
+		if _, err := client.Activities.GetServerActivities(context.Background()); err == nil {
+			t.Fatal("Expected an error")
+		}
+		if attempts != 3 {
+			t.Errorf("Expected 3 attempts with a budget of 2 retries, got: %d", attempts)
+		}
+
+		attempts = 0
+		if _, err := client.Activities.GetServerActivities(context.Background()); err == nil {
+			t.Fatal("Expected an error")
+		}
+		if attempts != 1 {
+			t.Errorf("Expected no retries with an exhausted budget, got %d attempts", attempts)
+		}
+
+		// Successful requests earn the budget back
+		attempts, failures = 0, 0
+		client.Activities.GetServerActivities(context.Background())
+		client.Activities.GetServerActivities(context.Background())
+		if budget.Available() != 1 {
+			t.Errorf("Expected 1 retry available, got: %d", budget.Available())
+		}
+	})
+
+	t.Run("method override", func(t *testing.T) {
+		attempts, failures = 0, 1
+		client := New(WithServerURL(server.URL), WithRetryConfig(backoff),
+			WithMethodRetryConfig("get", retry.Config{Strategy: "none"}))
+
+		if _, err := client.Activities.GetServerActivities(context.Background()); err == nil {
+			t.Fatal("Expected an error")
+		}
+		if attempts != 1 {
+			t.Errorf("Expected 1 attempt, got: %d", attempts)
+		}
+	})
+
+	t.Run("operation override", func(t *testing.T) {
+		attempts, failures = 0, 1
+		client := New(WithServerURL(server.URL),
+			WithMethodRetryConfig("GET", retry.Config{Strategy: "none"}),
+			WithOperationRetryConfig("getServerActivities", backoff))
+
+		if _, err := client.Activities.GetServerActivities(context.Background()); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if attempts != 2 {
+			t.Errorf("Expected 2 attempts, got: %d", attempts)
+		}
+	})
+
+	t.Run("custom strategy", func(t *testing.T) {
+		attempts, failures = 0, 3
+		var waits []time.Duration
+		custom := backoff
+		custom.IntervalStrategy = retry.StrategyFunc(func(attempt int, previous time.Duration) time.Duration {
+			waits = append(waits, previous)
+			return time.Duration(attempt+1) * time.Millisecond
+		})
+		client := New(WithServerURL(server.URL), WithRetryConfig(custom))
+
+		if _, err := client.Activities.GetServerActivities(context.Background()); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if attempts != 4 {
+			t.Errorf("Expected 4 attempts, got: %d", attempts)
+		}
+		if len(waits) != 3 || waits[0] != 0 || waits[1] != time.Millisecond || waits[2] != 2*time.Millisecond {
+			t.Errorf("Expected the strategy to receive the previous waits 0s, 1ms and 2ms, got: %v", waits)
+		}
+	})
+
+	t.Run("strategy without backoff", func(t *testing.T) {
+		attempts, failures = 0, 1
+		client := New(WithServerURL(server.URL), WithRetryConfig(retry.Config{
+			Strategy:         "backoff",
+			IntervalStrategy: retry.Constant(time.Millisecond),
+		}))
+
+		if _, err := client.Activities.GetServerActivities(context.Background()); !errors.Is(err, retry.ErrIntervalWithoutBackoff) {
+			t.Errorf("Expected ErrIntervalWithoutBackoff, got: %v", err)
+		}
+		if attempts != 0 {
+			t.Errorf("Expected no attempts, got: %d", attempts)
+		}
+	})
+}
+
+func TestRetryDroppedConnection(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			// Close the connection without a response, like a server restarting mid-request
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+	}))
+	defer server.Close()
+
+	config := retry.Config{
+		Strategy:              "backoff",
+		Backoff:               &retry.BackoffStrategy{InitialInterval: 1, MaxInterval: 2, Exponent: 1, MaxElapsedTime: 1000},
+		RetryConnectionErrors: true,
+	}
+	client := New(WithServerURL(server.URL), WithRetryConfig(config))
+
+	if _, err := client.Activities.GetServerActivities(context.Background()); err != nil {
+		t.Fatalf("Expected the dropped connection to be retried, got: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got: %d", attempts)
+	}
+}
+
+func TestRetryAppendingPut(t *testing.T) {
+	puts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/playlists/5/items" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		// The items are added, but the connection drops before the response
+		puts++
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	}))
+	defer server.Close()
+
+	config := retry.Config{
+		Strategy:              "backoff",
+		Backoff:               &retry.BackoffStrategy{InitialInterval: 1, MaxInterval: 2, Exponent: 1, MaxElapsedTime: 1000},
+		RetryConnectionErrors: true,
+	}
+	client := New(WithServerURL(server.URL), WithRetryConfig(config))
+
+	if _, err := client.Playlists.AddPlaylistContents(context.Background(), 5, "server://abc123/com.plexapp.plugins.library/library/metadata/1", nil); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if puts != 1 {
+		t.Errorf("Expected the appended items not to be sent again, got %d attempts", puts)
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	posts := 0
+	created := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST":
+			// The collection is created, but the response is lost on the first attempt
+			posts++
+			created = true
+			if posts == 1 {
+				w.WriteHeader(http.StatusGatewayTimeout)
+				return
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Heist","type":"collection"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/collections":
+			if created {
+				w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Heist","type":"collection"}]}}`))
+			} else {
+				w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+			}
+		case r.Method == "GET" && r.URL.Path == "/library/collections/8":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"8","title":"Heist","type":"collection","librarySectionID":1}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	backoff := retry.Config{
+		Strategy: "backoff",
+		Backoff:  &retry.BackoffStrategy{InitialInterval: 1, MaxInterval: 2, Exponent: 1, MaxElapsedTime: 1000},
+	}
+	client := New(WithServerURL(server.URL), WithRetryConfig(backoff))
+	ctx := context.Background()
+
+	if _, err := client.Collections.CreateCollection(ctx, 1, "Heist", nil); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if posts != 1 {
+		t.Errorf("Expected the creation not to be retried, got %d attempts", posts)
+	}
+
+	posts, created = 0, false
+	collection, err := client.Collections.CreateCollectionWithOptions(ctx, 1, "Heist", nil, CreateCollectionOptions{Idempotent: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if posts != 1 || collection.RatingKey != "8" {
+		t.Errorf("Expected the collection created by the failed attempt, got %d attempts and %+v", posts, collection)
+	}
+
+	posts, created = 0, false
+	client = New(WithServerURL(server.URL), WithRetryConfig(backoff), WithIdempotentOperation("createCollection", true))
+	if _, err := client.Collections.CreateCollection(ctx, 1, "Heist", nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if posts != 2 {
+		t.Errorf("Expected the creation to be retried once, got %d attempts", posts)
+	}
+}
@@ -0,0 +1,190 @@
+// Package scheduler runs the jobs of a long-running tool, such as syncing collections or
+// applying policies, at fixed intervals. A job never runs concurrently with itself, and can be
+// run on demand, e.g. from an admin endpoint.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrUnknownJob is returned by Trigger for a job that was not added
+var ErrUnknownJob = errors.New("scheduler: unknown job")
+
+// Job is the work of a scheduled job
+type Job func(ctx context.Context) error
+
+// JobStatus is the state of a job, e.g. for a status endpoint
+type JobStatus struct {
+	Name     string        `json:"name"`
+	Interval time.Duration `json:"interval"`
+	Running  bool          `json:"running"`
+	LastRun  time.Time     `json:"lastRun,omitempty"` // Start of the last finished run, zero if it never ran
+	LastErr  string        `json:"lastError,omitempty"`
+	NextRun  time.Time     `json:"nextRun,omitempty"` // Zero while the scheduler isn't running
+}
+
+// job is a job added to a Scheduler
+type job struct {
+	name     string
+	interval time.Duration
+	fn       Job
+	trigger  chan struct{}
+
+	// Guarded by the scheduler's mutex
+	running bool
+	lastRun time.Time
+	lastErr error
+	nextRun time.Time
+}
+
+// Scheduler runs jobs at intervals. Create one with New, add the jobs with Every and set OnError
+// before calling Run.
+type Scheduler struct {
+	OnError func(error) // Receives the errors of jobs, prefixed with the job name; they don't stop Run
+
+	mu      sync.Mutex
+	jobs    map[string]*job
+	started bool
+}
+
+// New returns a scheduler without jobs
+func New() *Scheduler {
+	return &Scheduler{jobs: map[string]*job{}}
+}
+
+// Every adds a job run when Run starts and then every interval after the start of its previous
+// run; a run that takes longer than the interval delays the next one. Adding a job with the name
+// of another replaces it.
+func (s *Scheduler) Every(name string, interval time.Duration, fn Job) error {
+	if interval <= 0 {
+		return fmt.Errorf("scheduler: interval of job %q must be positive", name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return fmt.Errorf("scheduler: job %q added after Run", name)
+	}
+	s.jobs[name] = &job{name: name, interval: interval, fn: fn, trigger: make(chan struct{}, 1)}
+	return nil
+}
+
+// Trigger runs a job now, outside its schedule, or once its current run finishes; triggering a
+// job that is already triggered has no effect. The job's schedule is unchanged. It returns
+// ErrUnknownJob if there is no job with the name.
+func (s *Scheduler) Trigger(name string) error {
+	s.mu.Lock()
+	j, ok := s.jobs[name]
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownJob, name)
+	}
+
+	select {
+	case j.trigger <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Status returns the state of each job, ordered by name
+func (s *Scheduler) Status() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		status := JobStatus{
+			Name:     j.name,
+			Interval: j.interval,
+			Running:  j.running,
+			LastRun:  j.lastRun,
+			NextRun:  j.nextRun,
+		}
+		if j.lastErr != nil {
+			status.LastErr = j.lastErr.Error()
+		}
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// Run runs the jobs until ctx is done, then waits for the running jobs, whose contexts are
+// cancelled, to return. It returns ctx.Err() when stopped.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.mu.Lock()
+	if s.started {
+		s.mu.Unlock()
+		return errors.New("scheduler: already running")
+	}
+	s.started = true
+	jobs := make([]*job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, j)
+	}
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, j := range jobs {
+		wg.Add(1)
+		go func(j *job) {
+			defer wg.Done()
+			s.loop(ctx, j)
+		}(j)
+	}
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// loop runs a job on its schedule and when triggered, until ctx is done
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			next := time.Now().Add(j.interval)
+			s.mu.Lock()
+			j.nextRun = next
+			s.mu.Unlock()
+
+			s.run(ctx, j)
+			timer.Reset(time.Until(next))
+		case <-j.trigger:
+			s.run(ctx, j)
+		}
+	}
+}
+
+// run runs a job once and records the result
+func (s *Scheduler) run(ctx context.Context, j *job) {
+	start := time.Now()
+
+	s.mu.Lock()
+	j.running = true
+	s.mu.Unlock()
+
+	err := j.fn(ctx)
+
+	s.mu.Lock()
+	j.running = false
+	j.lastRun = start
+	j.lastErr = err
+	s.mu.Unlock()
+
+	if err != nil && ctx.Err() == nil && s.OnError != nil {
+		s.OnError(fmt.Errorf("%s: %w", j.name, err))
+	}
+}
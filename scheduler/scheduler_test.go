@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestScheduler(t *testing.T) {
+	s := New()
+
+	var mu sync.Mutex
+	runs := map[string]int{}
+	triggered := make(chan struct{})
+	count := func(name string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return runs[name]
+	}
+
+	if err := s.Every("sync", 20*time.Millisecond, func(ctx context.Context) error {
+		mu.Lock()
+		runs["sync"]++
+		mu.Unlock()
+		return errors.New("server unreachable")
+	}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := s.Every("report", time.Hour, func(ctx context.Context) error {
+		mu.Lock()
+		runs["report"]++
+		n := runs["report"]
+		mu.Unlock()
+		if n == 2 {
+			close(triggered)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := s.Every("broken", 0, func(ctx context.Context) error { return nil }); err == nil {
+		t.Error("Expected an error for a zero interval")
+	}
+
+	var errs []error
+	s.OnError = func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	// Jobs run when Run starts, then on their schedule or when triggered
+	deadline := time.Now().Add(5 * time.Second)
+	for (count("sync") < 3 || count("report") < 1) && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if err := s.Trigger("report"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	select {
+	case <-triggered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the triggered job to run")
+	}
+	if err := s.Trigger("nightly"); !errors.Is(err, ErrUnknownJob) {
+		t.Errorf("Expected ErrUnknownJob, got: %v", err)
+	}
+	if err := s.Every("late", time.Hour, func(ctx context.Context) error { return nil }); err == nil {
+		t.Error("Expected an error for a job added after Run")
+	}
+
+	status := s.Status()
+	if len(status) != 2 || status[0].Name != "report" || status[1].Name != "sync" {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+	if status[0].NextRun.IsZero() || status[0].LastErr != "" || status[1].LastErr != "server unreachable" {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(errs) < 3 || errs[0].Error() != "sync: server unreachable" {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+}
@@ -0,0 +1,196 @@
+package plexgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// Home screen visibilities of a library section, the values of its hidden preference
+const (
+	SectionVisibilityIncluded          = "0" // Shown on the home screen and in global search
+	SectionVisibilityExcludeHome       = "1" // Left off the home screen
+	SectionVisibilityExcludeHomeSearch = "2" // Left off the home screen and out of global search
+)
+
+// SetSectionVisibility sets whether a library section is shown on the home screens and in global
+// search of all users, e.g. SectionVisibilityExcludeHome for a section only browsed directly.
+// Users.HideSection hides a section from a single user instead.
+func (s *Library) SetSectionVisibility(ctx context.Context, sectionID int, visibility string, opts ...operations.Option) error {
+	switch visibility {
+	case SectionVisibilityIncluded, SectionVisibilityExcludeHome, SectionVisibilityExcludeHomeSearch:
+	default:
+		return fmt.Errorf("unknown section visibility %q", visibility)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("hidden", visibility)
+
+	return s.put(ctx, s.baseURL(opts), fmt.Sprintf("/library/sections/%d/prefs", sectionID), queryParams, nil, "setSectionVisibility", opts...)
+}
+
+// HideSection stops sharing a library section with a user the server is shared with, so it
+// disappears from their home screen and sidebar. The userID is the plex.tv ID from
+// Users.GetUsers and machineID the server's machine identifier, e.g. from Server.GetIdentity.
+// Library.SetSectionVisibility hides a section from the home screens of all users instead. Only
+// the server owner can change what is shared.
+func (s *Users) HideSection(ctx context.Context, machineID string, userID int64, sectionID int, opts ...operations.Option) error {
+	return s.setSectionShared(ctx, machineID, userID, sectionID, false, opts...)
+}
+
+// ShowSection shares a library section with a user the server is shared with, reverting
+// HideSection
+func (s *Users) ShowSection(ctx context.Context, machineID string, userID int64, sectionID int, opts ...operations.Option) error {
+	return s.setSectionShared(ctx, machineID, userID, sectionID, true, opts...)
+}
+
+// setSectionShared adds or removes a library section from the sections shared with a user,
+// keeping the others
+func (s *Users) setSectionShared(ctx context.Context, machineID string, userID int64, sectionID int, shared bool, opts ...operations.Option) error {
+	shares, err := s.getSharedServers(ctx, machineID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting shared users: %w", err)
+	}
+
+	var share *sharedServer
+	for i := range shares {
+		if shares[i].UserID == userID {
+			share = &shares[i]
+			break
+		}
+	}
+	if share == nil {
+		return fmt.Errorf("%w %d", ErrUserNotShared, userID)
+	}
+
+	found := false
+	sectionIDs := []int64{}
+	for _, section := range share.Sections {
+		sectionShared := share.AllLibraries || section.Shared
+		if section.Key == sectionID {
+			found = true
+			sectionShared = shared
+		}
+		if sectionShared {
+			sectionIDs = append(sectionIDs, section.ID)
+		}
+	}
+	if !found {
+		return fmt.Errorf("library section %d not found", sectionID)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"server_id": machineID,
+		"shared_server": map[string]interface{}{
+			"library_section_ids": sectionIDs,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	options := processOptions(opts)
+
+	baseURL := utils.ReplaceParameters(operations.GetUsersServerList[0], map[string]string{})
+	if options.ServerURL != nil {
+		baseURL = *options.ServerURL
+	}
+
+	path := fmt.Sprintf("/servers/%s/shared_servers/%s", machineID, strconv.FormatInt(share.ID, 10))
+	return newLibrary(s.sdkConfiguration).put(ctx, baseURL, path, nil, body, "updateSharedServer", opts...)
+}
+
+// baseURL returns the URL of the server, or the URL set with operations.WithServerURL
+func (s *Library) baseURL(opts []operations.Option) string {
+	options := processOptions(opts)
+	if options.ServerURL != nil {
+		return *options.ServerURL
+	}
+
+	serverURL, params := s.sdkConfiguration.GetServerDetails()
+	return utils.ReplaceParameters(serverURL, params)
+}
+
+// put sends a PUT request with an optional JSON body, discarding the response
+func (s *Library) put(ctx context.Context, baseURL string, path string, queryParams url.Values, body []byte, operationID string, opts ...operations.Option) error {
+	options := processOptions(opts)
+
+	opURL, err := url.JoinPath(baseURL, path)
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+	if len(queryParams) > 0 {
+		opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    operationID,
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(operationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = utils.ConsumeRawBody(httpRes)
+	return err
+}
@@ -0,0 +1,93 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSectionVisibility(t *testing.T) {
+	var shared []int64
+	var moves []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/3/prefs":
+			if r.URL.Query().Get("hidden") != SectionVisibilityExcludeHome {
+				t.Errorf("Expected the section to be left off the home screen, got: %s", r.URL.RawQuery)
+			}
+		case r.Method == "GET" && r.URL.Path == "/hubs/sections/1/manage":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Hub":[
+				{"identifier":"movie.inprogress","title":"Continue Watching","promotedToRecommended":true},
+				{"identifier":"movie.recentlyadded","title":"Recently Added Movies","promotedToRecommended":true,"promotedToOwnHome":true},
+				{"identifier":"custom.collection.1.42","title":"Marvel","promotedToRecommended":true,"promotedToOwnHome":true,"promotedToSharedHome":true}
+			]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/hubs/sections/1/manage/move":
+			moves = append(moves, r.URL.Query().Get("identifier")+" after "+r.URL.Query().Get("after"))
+		case r.Method == "GET" && r.URL.Path == "/api/servers/abc123/shared_servers":
+			w.Header().Set("Content-Type", "application/xml")
+			w.Write([]byte(`<MediaContainer size="1">
+				<SharedServer id="900" userID="5" username="erin" allLibraries="0">
+					<Section id="11" key="1" shared="1" /><Section id="12" key="2" shared="0" /><Section id="13" key="3" shared="1" />
+				</SharedServer>
+			</MediaContainer>`))
+		case r.Method == "PUT" && r.URL.Path == "/api/servers/abc123/shared_servers/900":
+			var body struct {
+				SharedServer struct {
+					LibrarySectionIDs []int64 `json:"library_section_ids"`
+				} `json:"shared_server"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("Expected a JSON body, got: %v", err)
+			}
+			shared = body.SharedServer.LibrarySectionIDs
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithMiddleware(routePlexTV(server.URL)))
+	ctx := context.Background()
+
+	if err := client.Library.SetSectionVisibility(ctx, 3, SectionVisibilityExcludeHome); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := client.Library.SetSectionVisibility(ctx, 3, "hidden"); err == nil {
+		t.Error("Expected an error for an unknown visibility")
+	}
+
+	hubs, err := client.Hubs.GetManagedHubs(ctx, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(hubs) != 3 || !hubs[2].Identifier.IsCollectionHub() || !hubs[2].Visibility.Shared || hubs[0].Visibility.Home {
+		t.Errorf("Unexpected hubs: %+v", hubs)
+	}
+
+	err = client.Hubs.ReorderHubs(ctx, 1, []HubIdentifier{CollectionHubIdentifier(1, 42), HubIdentifierMovieRecentlyAdded})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if fmt.Sprint(moves) != "[custom.collection.1.42 after  movie.recentlyadded after custom.collection.1.42]" {
+		t.Errorf("Unexpected moves: %v", moves)
+	}
+
+	if err := client.Users.HideSection(ctx, "abc123", 5, 1); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if fmt.Sprint(shared) != "[13]" {
+		t.Errorf("Expected only section 3 to stay shared, got: %v", shared)
+	}
+
+	if err := client.Users.ShowSection(ctx, "abc123", 5, 2); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if fmt.Sprint(shared) != "[11 12 13]" {
+		t.Errorf("Expected section 2 to be shared, got: %v", shared)
+	}
+}
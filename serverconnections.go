@@ -0,0 +1,115 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// ConnectionType is how a connection reaches the server
+type ConnectionType string
+
+const (
+	ConnectionLocal  ConnectionType = "local"  // Address on the server's own network
+	ConnectionRemote ConnectionType = "remote" // Public address, requires remote access
+	ConnectionRelay  ConnectionType = "relay"  // Bandwidth limited relay through plex.tv
+)
+
+// connectionPreference orders connection types from most to least preferred
+var connectionPreference = map[ConnectionType]int{
+	ConnectionLocal:  0,
+	ConnectionRemote: 1,
+	ConnectionRelay:  2,
+}
+
+// ServerConnection is an address advertised by a server to plex.tv
+type ServerConnection struct {
+	Type     ConnectionType
+	URI      string // e.g. "https://10-0-0-2.abc123.plex.direct:32400"
+	Protocol string // "http" or "https"
+	Address  string
+	Port     int
+	IPv6     bool
+}
+
+// ServerConnections is what plex.tv knows about how to reach a server
+type ServerConnections struct {
+	Name                 string
+	MachineIdentifier    string
+	PublicAddress        string
+	PublicAddressMatches bool // Whether the caller shares the server's public address, i.e. is on its network
+	HTTPSRequired        bool
+	Presence             bool // Whether the server is currently connected to plex.tv
+	Connections          []ServerConnection
+}
+
+// GetConnections gets the connections the server advertises to plex.tv, including relays, sorted
+// local first, then remote and relay, for diagnostics and choosing the best connection. clientID
+// identifies the calling app to plex.tv, as for Plex.GetServerResources. The server must be
+// claimed by (or shared with) the account of the SDK's token. A per-call WithServerURL selects
+// the server; the resources are always requested from plex.tv.
+func (s *Server) GetConnections(ctx context.Context, clientID string, opts ...operations.Option) (*ServerConnections, error) {
+	identity, err := s.GetIdentity(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server identity: %w", err)
+	}
+
+	https := operations.IncludeHTTPSEnable
+	relay := operations.IncludeRelayEnable
+	ipv6 := operations.IncludeIPv6Enable
+	res, err := newPlex(s.sdkConfiguration).GetServerResources(ctx, clientID, &https, &relay, &ipv6, withoutServerURL(opts)...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server resources: %w", err)
+	}
+
+	for _, device := range res.GetPlexDevices() {
+		if device.ClientIdentifier != identity.MachineIdentifier {
+			continue
+		}
+
+		return NewServerConnections(device), nil
+	}
+
+	return nil, fmt.Errorf("server %s is not listed in the plex.tv resources of this account", identity.MachineIdentifier)
+}
+
+// NewServerConnections returns the connections of a device from Plex.GetServerResources, sorted
+// local first, then remote and relay, e.g. to pick a connection to each server of an account
+func NewServerConnections(device operations.PlexDevice) *ServerConnections {
+	connections := &ServerConnections{
+		Name:                 device.Name,
+		MachineIdentifier:    device.ClientIdentifier,
+		PublicAddress:        device.PublicAddress,
+		PublicAddressMatches: device.PublicAddressMatches,
+		HTTPSRequired:        device.HTTPSRequired,
+		Presence:             device.Presence,
+		Connections:          make([]ServerConnection, 0, len(device.Connections)),
+	}
+
+	for _, c := range device.Connections {
+		connectionType := ConnectionRemote
+		switch {
+		case c.Relay:
+			connectionType = ConnectionRelay
+		case c.Local:
+			connectionType = ConnectionLocal
+		}
+
+		connections.Connections = append(connections.Connections, ServerConnection{
+			Type:     connectionType,
+			URI:      c.URI,
+			Protocol: string(c.Protocol),
+			Address:  c.Address,
+			Port:     c.Port,
+			IPv6:     c.IPv6,
+		})
+	}
+
+	sort.SliceStable(connections.Connections, func(i, j int) bool {
+		return connectionPreference[connections.Connections[i].Type] < connectionPreference[connections.Connections[j].Type]
+	})
+
+	return connections
+}
@@ -0,0 +1,58 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetConnections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case "/api/v2/resources":
+			if r.URL.Query().Get("includeRelay") != "1" {
+				t.Errorf("Expected relays to be included, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`[
+				{"name":"Other","clientIdentifier":"other","provides":"server","connections":[]},
+				{"name":"Home","clientIdentifier":"abc123","provides":"server","publicAddress":"203.0.113.5","presence":true,"connections":[
+					{"protocol":"https","address":"203.0.113.5","port":32400,"uri":"https://203-0-113-5.abc123.plex.direct:32400","local":false,"relay":false,"IPv6":false},
+					{"protocol":"https","address":"10.0.0.2","port":8443,"uri":"https://10-0-0-2.abc123.plex.direct:8443","local":false,"relay":true,"IPv6":false},
+					{"protocol":"https","address":"10.0.0.2","port":32400,"uri":"https://10-0-0-2.abc123.plex.direct:32400","local":true,"relay":false,"IPv6":false}
+				]}
+			]`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithMiddleware(routePlexTV(server.URL)))
+
+	connections, err := client.Server.GetConnections(context.Background(), "test-client")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if connections.Name != "Home" || connections.PublicAddress != "203.0.113.5" || !connections.Presence {
+		t.Errorf("Unexpected server: %+v", connections)
+	}
+
+	expected := []ConnectionType{ConnectionLocal, ConnectionRemote, ConnectionRelay}
+	if len(connections.Connections) != len(expected) {
+		t.Fatalf("Expected %d connections, got: %+v", len(expected), connections.Connections)
+	}
+	for i, connectionType := range expected {
+		if connections.Connections[i].Type != connectionType {
+			t.Errorf("Expected connection %d to be %s, got: %+v", i, connectionType, connections.Connections[i])
+		}
+	}
+	if connections.Connections[0].URI != "https://10-0-0-2.abc123.plex.direct:32400" || connections.Connections[0].Port != 32400 {
+		t.Errorf("Unexpected local connection: %+v", connections.Connections[0])
+	}
+}
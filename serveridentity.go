@@ -0,0 +1,90 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// ServerIdentity identifies a Plex Media Server
+type ServerIdentity struct {
+	MachineIdentifier string // Unique ID of the server, used in server:// URIs and plex.tv resources
+	Version           string // e.g. "1.40.2.8395-c67dce28e"
+	Claimed           bool   // Whether the server is linked to a plex.tv account
+}
+
+// identityCache caches server identities by base URL. It is shared by all services of an SDK
+// instance, so the identity is only requested once per server.
+type identityCache struct {
+	mu         sync.Mutex
+	identities map[string]ServerIdentity
+}
+
+// newIdentityCache returns an empty identity cache
+func newIdentityCache() *identityCache {
+	return &identityCache{identities: map[string]ServerIdentity{}}
+}
+
+// GetIdentity gets the machine identifier, version and claim status of the server. The identity is
+// cached per server URL for the lifetime of the SDK instance; use ClearIdentityCache after updating
+// or claiming the server to fetch it again.
+func (s *Server) GetIdentity(ctx context.Context, opts ...operations.Option) (*ServerIdentity, error) {
+	options := processOptions(opts)
+
+	baseURL := utils.ReplaceParameters(s.sdkConfiguration.GetServerDetails())
+	if options.ServerURL != nil {
+		baseURL = *options.ServerURL
+	}
+
+	cache := s.sdkConfiguration.identities
+	if cache != nil {
+		cache.mu.Lock()
+		identity, ok := cache.identities[baseURL]
+		cache.mu.Unlock()
+		if ok {
+			return &identity, nil
+		}
+	}
+
+	res, err := s.GetServerIdentity(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	container := res.Object.GetMediaContainer()
+	if container.GetMachineIdentifier() == nil || *container.GetMachineIdentifier() == "" {
+		return nil, fmt.Errorf("server identity response did not include a machine identifier")
+	}
+
+	identity := ServerIdentity{MachineIdentifier: *container.GetMachineIdentifier()}
+	if container.GetVersion() != nil {
+		identity.Version = *container.GetVersion()
+	}
+	if container.GetClaimed() != nil {
+		identity.Claimed = *container.GetClaimed()
+	}
+
+	if cache != nil {
+		cache.mu.Lock()
+		cache.identities[baseURL] = identity
+		cache.mu.Unlock()
+	}
+
+	return &identity, nil
+}
+
+// ClearIdentityCache forgets the cached identities of all servers, so the next GetIdentity call
+// requests them again
+func (s *Server) ClearIdentityCache() {
+	cache := s.sdkConfiguration.identities
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	cache.identities = map[string]ServerIdentity{}
+	cache.mu.Unlock()
+}
@@ -0,0 +1,61 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetIdentityIsCached(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithSmartFilterURIStyle(SmartFilterURIMachine))
+
+	identity, err := client.Server.GetIdentity(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if identity.MachineIdentifier != "abc123" || identity.Version != "1.40.0" || !identity.Claimed {
+		t.Errorf("Unexpected identity: %+v", identity)
+	}
+
+	// Other services share the cached identity
+	if _, err := client.Collections.buildSmartFilterURI(context.Background(), 1, "type=1"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected the identity to be requested once, got %d requests", requests)
+	}
+
+	client.Server.ClearIdentityCache()
+	if _, err := client.Server.GetIdentity(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected the identity to be requested again after clearing the cache, got %d requests", requests)
+	}
+}
+
+func TestGetIdentityWithoutMachineIdentifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	if _, err := client.Server.GetIdentity(context.Background()); err == nil {
+		t.Fatal("Expected an error for a response without a machine identifier")
+	}
+}
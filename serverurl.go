@@ -0,0 +1,93 @@
+package plexgo
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// defaultServerPort is the port Plex Media Server listens on unless configured otherwise
+const defaultServerPort = "32400"
+
+// ParseServerURL validates and normalizes the URL of a Plex Media Server. It accepts full URLs as
+// well as the forms users tend to copy from elsewhere:
+//
+//   - "10.0.0.5:32400" or "plex.lan", which default to http and, without a port, port 32400
+//   - "10-0-0-5.abc123.plex.direct:32400", which defaults to https, as plex.direct requires it
+//   - Plex Web URLs such as "http://10.0.0.5:32400/web/index.html#!/", which are cut at /web
+//
+// Trailing slashes, queries and fragments are removed, and the scheme and host are lowercased. A
+// path is kept, for servers behind a reverse proxy at e.g. https://example.com/plex.
+func ParseServerURL(rawURL string) (string, error) {
+	trimmed := strings.TrimSpace(rawURL)
+	if trimmed == "" {
+		return "", fmt.Errorf("server URL is empty")
+	}
+
+	hasScheme := strings.Contains(trimmed, "://")
+	if !hasScheme {
+		trimmed = "http://" + trimmed
+	}
+
+	u, err := url.Parse(trimmed)
+	if err != nil {
+		return "", fmt.Errorf("invalid server URL %q: %w", rawURL, err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+
+	if scheme != "http" && scheme != "https" {
+		return "", fmt.Errorf("invalid server URL %q: scheme must be http or https", rawURL)
+	}
+	if host == "" {
+		return "", fmt.Errorf("invalid server URL %q: missing host", rawURL)
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("invalid server URL %q: credentials belong in the token, not the URL", rawURL)
+	}
+
+	if !hasScheme {
+		if strings.HasSuffix(host, ".plex.direct") {
+			scheme = "https"
+		}
+		if port == "" {
+			port = defaultServerPort
+		}
+	}
+
+	if port != "" {
+		n, err := strconv.Atoi(port)
+		if err != nil || n < 1 || n > 65535 {
+			return "", fmt.Errorf("invalid server URL %q: invalid port %s", rawURL, port)
+		}
+	}
+
+	path := u.EscapedPath()
+	if i := strings.Index(path+"/", "/web/"); i >= 0 {
+		path = path[:i]
+	}
+	path = strings.TrimRight(path, "/")
+
+	hostPort := host
+	if port != "" {
+		hostPort = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		hostPort = "[" + host + "]"
+	}
+
+	return scheme + "://" + hostPort + path, nil
+}
+
+// errorMiddleware fails every request with err, for a client configured with an invalid server URL
+func errorMiddleware(err error) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, err
+		})
+	}
+}
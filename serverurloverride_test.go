@@ -0,0 +1,91 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// routePlexTV is a middleware sending the SDK's plex.tv requests to a test server
+func routePlexTV(serverURL string) Middleware {
+	target, _ := url.Parse(serverURL)
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Host == "plex.tv" {
+				req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func TestPerCallServerURLOverride(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to the default server: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+
+	var addedURI, filterMovies string
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"secondary","version":"1.40.0"}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/5":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"5","title":"Heists","type":"collection","subtype":"movie","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/10,11":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"10","type":"movie"},{"ratingKey":"11","type":"movie"}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/5/items":
+			addedURI = r.URL.Query().Get("uri")
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+		default:
+			t.Errorf("Unexpected request to the secondary server: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer secondary.Close()
+
+	plexTV := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/friends/42" {
+			t.Errorf("Unexpected request to plex.tv: %s %s", r.Method, r.URL.Path)
+		}
+		filterMovies = r.URL.Query().Get("filterMovies")
+	}))
+	defer plexTV.Close()
+
+	client := New(WithServerURL(primary.URL), WithSmartFilterURIStyle(SmartFilterURIMachine), WithMiddleware(routePlexTV(plexTV.URL)))
+	ctx := context.Background()
+	override := operations.WithServerURL(secondary.URL)
+
+	identity, err := client.Server.GetIdentity(ctx, override)
+	if err != nil || identity.MachineIdentifier != "secondary" {
+		t.Errorf("Expected the secondary server's identity, got: %+v, %v", identity, err)
+	}
+
+	uri, err := client.Collections.buildSmartFilterURI(ctx, 1, "type=1", override)
+	if err != nil || !strings.HasPrefix(uri, "server://secondary/") {
+		t.Errorf("Expected a smart filter URI on the secondary server, got: %s, %v", uri, err)
+	}
+
+	if err := client.Collections.AddToCollection(ctx, 5, []string{"10", "11"}, override, WithWaitForActivity(time.Second)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if addedURI != "server://secondary/com.plexapp.plugins.library/library/metadata/10,11" {
+		t.Errorf("Expected items to be added by the secondary server's URI, got: %s", addedURI)
+	}
+
+	// The override selects the media server; plex.tv requests still go to plex.tv
+	if err := client.Collections.HideFromUser(ctx, 5, "hidden", operations.User{ID: 42}, override); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if filterMovies != "label!=hidden" {
+		t.Errorf("Expected the user's filter to be set on plex.tv, got: %q", filterMovies)
+	}
+}
@@ -0,0 +1,7 @@
+package plexgo
+
+//go:generate go run ./internal/cmd/genapi
+
+// The interfaces of serviceapi_gen.go, such as CollectionsAPI and LibraryAPI, list the methods of
+// the SDK's services, so that applications can depend on an interface and substitute the mocks of
+// the plexmock package in their tests. Run go generate after changing the methods of a service.
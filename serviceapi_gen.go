@@ -0,0 +1,374 @@
+// Code generated by genapi. DO NOT EDIT.
+
+package plexgo
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// ServerAPI is the interface of Server, implemented by PlexAPI.Server and by plexmock.ServerAPIMock
+type ServerAPI interface {
+	ClearIdentityCache()
+	GetAvailableClients(ctx context.Context, opts ...operations.Option) (*operations.GetAvailableClientsResponse, error)
+	GetConnections(ctx context.Context, clientID string, opts ...operations.Option) (*ServerConnections, error)
+	GetDevices(ctx context.Context, opts ...operations.Option) (*operations.GetDevicesResponse, error)
+	GetIdentity(ctx context.Context, opts ...operations.Option) (*ServerIdentity, error)
+	GetMediaProviders(ctx context.Context, xPlexToken string, opts ...operations.Option) (*operations.GetMediaProvidersResponse, error)
+	GetMyPlexAccount(ctx context.Context, opts ...operations.Option) (*operations.GetMyPlexAccountResponse, error)
+	GetRemoteAccessStatus(ctx context.Context, opts ...operations.Option) (*RemoteAccessStatus, error)
+	GetResizedPhoto(ctx context.Context, request operations.GetResizedPhotoRequest, opts ...operations.Option) (*operations.GetResizedPhotoResponse, error)
+	GetServerCapabilities(ctx context.Context, opts ...operations.Option) (*operations.GetServerCapabilitiesResponse, error)
+	GetServerIdentity(ctx context.Context, opts ...operations.Option) (*operations.GetServerIdentityResponse, error)
+	GetServerList(ctx context.Context, opts ...operations.Option) (*operations.GetServerListResponse, error)
+	GetServerPreferences(ctx context.Context, opts ...operations.Option) (*operations.GetServerPreferencesResponse, error)
+	SetRemoteAccess(ctx context.Context, enabled bool, publicPort int, manualMapping bool, opts ...operations.Option) error
+	TailLogs(ctx context.Context, level operations.Level, opts ...operations.Option) (<-chan LogEntry, error)
+}
+
+var _ ServerAPI = (*Server)(nil)
+
+// MediaAPI is the interface of Media, implemented by PlexAPI.Media and by plexmock.MediaAPIMock
+type MediaAPI interface {
+	DeleteVersion(ctx context.Context, ratingKey string, mediaID int64, opts ...operations.Option) error
+	GetBannerImage(ctx context.Context, request operations.GetBannerImageRequest, opts ...operations.Option) (*operations.GetBannerImageResponse, error)
+	GetEdition(ctx context.Context, ratingKey string, editionTitle string, opts ...operations.Option) (*Metadata, error)
+	GetEditions(ctx context.Context, ratingKey string, opts ...operations.Option) ([]Metadata, error)
+	GetLyrics(ctx context.Context, trackRatingKey int, opts ...operations.Option) (*Lyrics, error)
+	GetThumbImage(ctx context.Context, request operations.GetThumbImageRequest, opts ...operations.Option) (*operations.GetThumbImageResponse, error)
+	ListVersions(ctx context.Context, ratingKey string, opts ...operations.Option) ([]MediaVersion, error)
+	MarkPlayed(ctx context.Context, key float64, opts ...operations.Option) (*operations.MarkPlayedResponse, error)
+	MarkUnplayed(ctx context.Context, key float64, opts ...operations.Option) (*operations.MarkUnplayedResponse, error)
+	UpdatePlayProgress(ctx context.Context, key string, time float64, state string, opts ...operations.Option) (*operations.UpdatePlayProgressResponse, error)
+}
+
+var _ MediaAPI = (*Media)(nil)
+
+// VideoAPI is the interface of Video, implemented by PlexAPI.Video and by plexmock.VideoAPIMock
+type VideoAPI interface {
+	GetStreamURL(ctx context.Context, ratingKey int, streamOptions StreamOptions, opts ...operations.Option) (string, error)
+	GetTimeline(ctx context.Context, request operations.GetTimelineRequest, opts ...operations.Option) (*operations.GetTimelineResponse, error)
+	StartUniversalTranscode(ctx context.Context, request operations.StartUniversalTranscodeRequest, opts ...operations.Option) (*operations.StartUniversalTranscodeResponse, error)
+}
+
+var _ VideoAPI = (*Video)(nil)
+
+// ActivitiesAPI is the interface of Activities, implemented by PlexAPI.Activities and by plexmock.ActivitiesAPIMock
+type ActivitiesAPI interface {
+	CancelServerActivities(ctx context.Context, activityUUID string, opts ...operations.Option) (*operations.CancelServerActivitiesResponse, error)
+	GetServerActivities(ctx context.Context, opts ...operations.Option) (*operations.GetServerActivitiesResponse, error)
+	WaitFor(ctx context.Context, predicate func(_ Activity) bool, timeout time.Duration, opts ...operations.Option) (*Activity, error)
+}
+
+var _ ActivitiesAPI = (*Activities)(nil)
+
+// ButlerAPI is the interface of Butler, implemented by PlexAPI.Butler and by plexmock.ButlerAPIMock
+type ButlerAPI interface {
+	GetButlerTasks(ctx context.Context, opts ...operations.Option) (*operations.GetButlerTasksResponse, error)
+	StartAllTasks(ctx context.Context, opts ...operations.Option) (*operations.StartAllTasksResponse, error)
+	StartTask(ctx context.Context, taskName operations.TaskName, opts ...operations.Option) (*operations.StartTaskResponse, error)
+	StopAllTasks(ctx context.Context, opts ...operations.Option) (*operations.StopAllTasksResponse, error)
+	StopTask(ctx context.Context, taskName operations.PathParamTaskName, opts ...operations.Option) (*operations.StopTaskResponse, error)
+}
+
+var _ ButlerAPI = (*Butler)(nil)
+
+// PlexTVAPI is the interface of Plex, implemented by PlexAPI.Plex and by plexmock.PlexTVAPIMock
+type PlexTVAPI interface {
+	GetCompanionsData(ctx context.Context, opts ...operations.Option) (*operations.GetCompanionsDataResponse, error)
+	GetGeoData(ctx context.Context, opts ...operations.Option) (*operations.GetGeoDataResponse, error)
+	GetHomeData(ctx context.Context, opts ...operations.Option) (*operations.GetHomeDataResponse, error)
+	GetPin(ctx context.Context, request operations.GetPinRequest, opts ...operations.Option) (*operations.GetPinResponse, error)
+	GetServerResources(ctx context.Context, clientID string, includeHTTPS *operations.IncludeHTTPS, includeRelay *operations.IncludeRelay, includeIPv6 *operations.IncludeIPv6, opts ...operations.Option) (*operations.GetServerResourcesResponse, error)
+	GetTokenByPinID(ctx context.Context, request operations.GetTokenByPinIDRequest, opts ...operations.Option) (*operations.GetTokenByPinIDResponse, error)
+	GetUserFriends(ctx context.Context, opts ...operations.Option) (*operations.GetUserFriendsResponse, error)
+	GetWatchHistory(ctx context.Context, filter WatchHistoryFilter, opts ...operations.Option) ([]WatchHistoryEntry, error)
+}
+
+var _ PlexTVAPI = (*Plex)(nil)
+
+// HubsAPI is the interface of Hubs, implemented by PlexAPI.Hubs and by plexmock.HubsAPIMock
+type HubsAPI interface {
+	ExportLayout(ctx context.Context, w io.Writer, opts ...operations.Option) (*HubLayout, error)
+	GetGlobalHub(ctx context.Context, identifier HubIdentifier, opts ...operations.Option) (*operations.Hub, error)
+	GetGlobalHubs(ctx context.Context, count *float64, onlyTransient *operations.OnlyTransient, opts ...operations.Option) (*operations.GetGlobalHubsResponse, error)
+	GetLibraryHub(ctx context.Context, sectionID int, identifier HubIdentifier, opts ...operations.Option) (*operations.GetLibraryHubsHub, error)
+	GetLibraryHubs(ctx context.Context, sectionID float64, count *float64, onlyTransient *operations.QueryParamOnlyTransient, opts ...operations.Option) (*operations.GetLibraryHubsResponse, error)
+	GetManagedHub(ctx context.Context, sectionID int, identifier HubIdentifier, opts ...operations.Option) (*ManagedHub, error)
+	GetManagedHubs(ctx context.Context, sectionID int, opts ...operations.Option) ([]ManagedHub, error)
+	GetRecentlyAdded(ctx context.Context, request operations.GetRecentlyAddedRequest, opts ...operations.Option) (*operations.GetRecentlyAddedResponse, error)
+	MoveHub(ctx context.Context, sectionID int, identifier HubIdentifier, after HubIdentifier, opts ...operations.Option) error
+	ReorderHubs(ctx context.Context, sectionID int, identifiers []HubIdentifier, opts ...operations.Option) error
+	RestoreLayout(ctx context.Context, r io.Reader, opts ...operations.Option) error
+	UpdateHubVisibility(ctx context.Context, sectionID int, identifier HubIdentifier, visibility CollectionVisibility, opts ...operations.Option) error
+}
+
+var _ HubsAPI = (*Hubs)(nil)
+
+// SearchAPI is the interface of Search, implemented by PlexAPI.Search and by plexmock.SearchAPIMock
+type SearchAPI interface {
+	Autocomplete(ctx context.Context, prefix string, limit int, opts ...operations.Option) ([]AutocompleteResult, error)
+	GetSearchResults(ctx context.Context, query string, opts ...operations.Option) (*operations.GetSearchResultsResponse, error)
+	PerformSearch(ctx context.Context, query string, sectionID *float64, limit *float64, opts ...operations.Option) (*operations.PerformSearchResponse, error)
+	PerformVoiceSearch(ctx context.Context, query string, sectionID *float64, limit *float64, opts ...operations.Option) (*operations.PerformVoiceSearchResponse, error)
+}
+
+var _ SearchAPI = (*Search)(nil)
+
+// LibraryAPI is the interface of Library, implemented by PlexAPI.Library and by plexmock.LibraryAPIMock
+type LibraryAPI interface {
+	AddLabelsAction(labels ...string) NewItemAction
+	ApplyAssets(ctx context.Context, sectionID int, assets fs.FS, assetOptions AssetOptions, opts ...operations.Option) (*AssetReport, error)
+	BulkEdit(ctx context.Context, sectionID int, selector ItemListOptions, edit EditParams, opts ...operations.Option) ([]Metadata, error)
+	DeleteLibrary(ctx context.Context, sectionKey int, opts ...operations.Option) (*operations.DeleteLibraryResponse, error)
+	Diff(ctx context.Context, other *PlexAPI, sectionA int, sectionB int, opts ...operations.Option) (*LibraryDiff, error)
+	EachItem(ctx context.Context, sectionID int, listOptions ItemListOptions, fn func(item Metadata) error, opts ...operations.Option) error
+	FindByVideoAttributes(ctx context.Context, sectionID int, query VideoAttributeQuery, opts ...operations.Option) ([]Metadata, error)
+	FindExceedingRating(ctx context.Context, sectionID int, maxRating string, opts ...operations.Option) ([]Metadata, error)
+	FindPerson(ctx context.Context, sectionID int, name string, role PersonRole, opts ...operations.Option) (*Tag, error)
+	GetActorsLibrary(ctx context.Context, sectionKey int, type_ operations.GetActorsLibraryQueryParamType, opts ...operations.Option) (*operations.GetActorsLibraryResponse, error)
+	GetAllLibraries(ctx context.Context, opts ...operations.Option) (*operations.GetAllLibrariesResponse, error)
+	GetAllMediaLibrary(ctx context.Context, request operations.GetAllMediaLibraryRequest, opts ...operations.Option) (*operations.GetAllMediaLibraryResponse, error)
+	GetChildren(ctx context.Context, ratingKey int, opts ...operations.Option) ([]Metadata, error)
+	GetContentRatingBreakdown(ctx context.Context, sectionID int, opts ...operations.Option) ([]ContentRatingCount, error)
+	GetCountriesLibrary(ctx context.Context, sectionKey int, type_ operations.GetCountriesLibraryQueryParamType, opts ...operations.Option) (*operations.GetCountriesLibraryResponse, error)
+	GetFileHash(ctx context.Context, url_ string, type_ *float64, opts ...operations.Option) (*operations.GetFileHashResponse, error)
+	GetGenresLibrary(ctx context.Context, sectionKey int, type_ operations.GetGenresLibraryQueryParamType, opts ...operations.Option) (*operations.GetGenresLibraryResponse, error)
+	GetItem(ctx context.Context, ratingKey int, opts ...operations.Option) (*Metadata, error)
+	GetItems(ctx context.Context, ratingKeys []string, opts ...operations.Option) ([]Metadata, error)
+	GetItemsByActor(ctx context.Context, sectionID int, actorTagID int64, opts ...operations.Option) ([]Metadata, error)
+	GetItemsByPerson(ctx context.Context, sectionID int, role PersonRole, tagID int64, opts ...operations.Option) ([]Metadata, error)
+	GetLibraryDetails(ctx context.Context, sectionKey int, includeDetails *operations.IncludeDetails, opts ...operations.Option) (*operations.GetLibraryDetailsResponse, error)
+	GetLibraryItems(ctx context.Context, request operations.GetLibraryItemsRequest, opts ...operations.Option) (*operations.GetLibraryItemsResponse, error)
+	GetLocalizedMetadata(ctx context.Context, ratingKey int, languages []string, opts ...operations.Option) ([]LocalizedMetadata, error)
+	GetMediaArts(ctx context.Context, ratingKey int64, opts ...operations.Option) (*operations.GetMediaArtsResponse, error)
+	GetMediaMetaData(ctx context.Context, request operations.GetMediaMetaDataRequest, opts ...operations.Option) (*operations.GetMediaMetaDataResponse, error)
+	GetMediaPosters(ctx context.Context, ratingKey int64, opts ...operations.Option) (*operations.GetMediaPostersResponse, error)
+	GetMediaTagURL(ctx context.Context, info ContainerInfo, kind string, value string, opts ...operations.Option) (string, error)
+	GetMetadataChildren(ctx context.Context, ratingKey float64, includeElements *string, opts ...operations.Option) (*operations.GetMetadataChildrenResponse, error)
+	GetPeople(ctx context.Context, sectionID int, opts ...operations.Option) ([]Tag, error)
+	GetPeopleByRole(ctx context.Context, sectionID int, role PersonRole, opts ...operations.Option) ([]Tag, error)
+	GetRandomItem(ctx context.Context, sectionID int, filter FilterNode, opts ...operations.Option) (*Metadata, error)
+	GetRecentlyAddedLibrary(ctx context.Context, request operations.GetRecentlyAddedLibraryRequest, opts ...operations.Option) (*operations.GetRecentlyAddedLibraryResponse, error)
+	GetRefreshLibraryMetadata(ctx context.Context, sectionKey int, force *operations.Force, opts ...operations.Option) (*operations.GetRefreshLibraryMetadataResponse, error)
+	GetRefreshStatus(ctx context.Context, sectionID int, opts ...operations.Option) (*RefreshStatus, error)
+	GetSearchAllLibraries(ctx context.Context, request operations.GetSearchAllLibrariesRequest, opts ...operations.Option) (*operations.GetSearchAllLibrariesResponse, error)
+	GetSearchLibrary(ctx context.Context, sectionKey int, type_ operations.GetSearchLibraryQueryParamType, opts ...operations.Option) (*operations.GetSearchLibraryResponse, error)
+	GetShowPreferences(ctx context.Context, ratingKey int, opts ...operations.Option) (*ShowPreferences, error)
+	GetShowProgress(ctx context.Context, ratingKey int, opts ...operations.Option) (*ShowProgress, error)
+	GetSonicallySimilar(ctx context.Context, trackRatingKey int, limit int, maxDistance float64, opts ...operations.Option) ([]Metadata, error)
+	GetStorageReport(ctx context.Context, sectionID int, opts ...operations.Option) (*StorageReport, error)
+	GetTopWatchedContent(ctx context.Context, type_ operations.GetTopWatchedContentQueryParamType, includeGuids *int64, opts ...operations.Option) (*operations.GetTopWatchedContentResponse, error)
+	ItemsPage(ctx context.Context, sectionID int, listOptions ItemListOptions, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error)
+	ListItems(ctx context.Context, sectionID int, listOptions ItemListOptions, opts ...operations.Option) ([]Metadata, error)
+	ListItemsPage(ctx context.Context, sectionID int, listOptions ItemListOptions, start int, opts ...operations.Option) (*MetadataMediaContainer, error)
+	LockFields(ctx context.Context, ratingKeys []string, fields ...string) error
+	MigrateAgent(ctx context.Context, sectionID int, targetAgent string, opts ...operations.Option) (*AgentMigration, error)
+	NewItemPipeline(sectionID int, rules ...NewItemRule) *NewItemPipeline
+	NewPrefetcher() *Prefetcher
+	NormalizeSortTitles(ctx context.Context, sectionID int, sortOptions SortTitleOptions, opts ...operations.Option) ([]SortTitleChange, error)
+	PostMediaArts(ctx context.Context, ratingKey int64, url_ *string, requestBody *any, opts ...operations.Option) (*operations.PostMediaArtsResponse, error)
+	PostMediaPoster(ctx context.Context, ratingKey int64, url_ *string, requestBody *any, opts ...operations.Option) (*operations.PostMediaPosterResponse, error)
+	SetSectionVisibility(ctx context.Context, sectionID int, visibility string, opts ...operations.Option) error
+	SyncWatchState(ctx context.Context, sectionID int, history []WatchHistoryEntry, dryRun bool, opts ...operations.Option) ([]Metadata, error)
+	UnlockFields(ctx context.Context, ratingKeys []string, fields ...string) error
+	UpdateSectionShowPreferences(ctx context.Context, sectionID int, prefs map[string]string, opts ...operations.Option) ([]Metadata, error)
+	UpdateShowPreferences(ctx context.Context, ratingKey int, prefs map[string]string, opts ...operations.Option) error
+	Upload(ctx context.Context, sectionID int, path string, r io.Reader, opts ...operations.Option) error
+	VerifyRatingKeys(ctx context.Context, ratingKeys []string, opts ...operations.Option) (*RatingKeyAvailability, error)
+	WaitForScanComplete(ctx context.Context, sectionID int, timeout time.Duration, opts ...operations.Option) error
+	WalkTree(ctx context.Context, ratingKey int, fn WalkFunc, opts ...operations.Option) error
+}
+
+var _ LibraryAPI = (*Library)(nil)
+
+// WatchlistAPI is the interface of Watchlist, implemented by PlexAPI.Watchlist and by plexmock.WatchlistAPIMock
+type WatchlistAPI interface {
+	CheckAvailability(ctx context.Context, guid string, servers []operations.PlexDevice, opts ...operations.Option) (*WatchlistAvailability, error)
+	GetMetadata(ctx context.Context, guid string, opts ...operations.Option) (*Metadata, error)
+	GetWatchList(ctx context.Context, request operations.GetWatchListRequest, opts ...operations.Option) (*operations.GetWatchListResponse, error)
+	GetWatchlistPage(ctx context.Context, filter string, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error)
+}
+
+var _ WatchlistAPI = (*Watchlist)(nil)
+
+// LogAPI is the interface of Log, implemented by PlexAPI.Log and by plexmock.LogAPIMock
+type LogAPI interface {
+	EnablePaperTrail(ctx context.Context, opts ...operations.Option) (*operations.EnablePaperTrailResponse, error)
+	LogLine(ctx context.Context, level operations.Level, message string, source string, opts ...operations.Option) (*operations.LogLineResponse, error)
+	LogMultiLine(ctx context.Context, request string, opts ...operations.Option) (*operations.LogMultiLineResponse, error)
+}
+
+var _ LogAPI = (*Log)(nil)
+
+// PlaylistsAPI is the interface of Playlists, implemented by PlexAPI.Playlists and by plexmock.PlaylistsAPIMock
+type PlaylistsAPI interface {
+	AddPlaylistContents(ctx context.Context, playlistID float64, uri string, playQueueID *float64, opts ...operations.Option) (*operations.AddPlaylistContentsResponse, error)
+	AddToPlaylist(ctx context.Context, playlistID int, ratingKeys []string, opts ...operations.Option) error
+	ClearPlaylistContents(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.ClearPlaylistContentsResponse, error)
+	CreatePlaylist(ctx context.Context, request operations.CreatePlaylistRequest, opts ...operations.Option) (*operations.CreatePlaylistResponse, error)
+	CreateStationFrom(ctx context.Context, trackRatingKey int, size int, opts ...operations.Option) (*Station, error)
+	DeletePlaylist(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.DeletePlaylistResponse, error)
+	GetPlaylist(ctx context.Context, playlistID float64, opts ...operations.Option) (*operations.GetPlaylistResponse, error)
+	GetPlaylistContents(ctx context.Context, playlistID float64, type_ operations.GetPlaylistContentsQueryParamType, opts ...operations.Option) (*operations.GetPlaylistContentsResponse, error)
+	GetPlaylistItemsPage(ctx context.Context, playlistID int, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error)
+	GetPlaylists(ctx context.Context, playlistType *operations.PlaylistType, smart *operations.QueryParamSmart, opts ...operations.Option) (*operations.GetPlaylistsResponse, error)
+	GetPlaylistsPage(ctx context.Context, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error)
+	GetStationTracks(ctx context.Context, trackRatingKey int, size int, opts ...operations.Option) ([]Metadata, error)
+	UpdatePlaylist(ctx context.Context, playlistID float64, title *string, summary *string, opts ...operations.Option) (*operations.UpdatePlaylistResponse, error)
+	UploadPlaylist(ctx context.Context, path string, force operations.QueryParamForce, sectionID int64, opts ...operations.Option) (*operations.UploadPlaylistResponse, error)
+}
+
+var _ PlaylistsAPI = (*Playlists)(nil)
+
+// AuthenticationAPI is the interface of Authentication, implemented by PlexAPI.Authentication and by plexmock.AuthenticationAPIMock
+type AuthenticationAPI interface {
+	GetSourceConnectionInformation(ctx context.Context, source string, opts ...operations.Option) (*operations.GetSourceConnectionInformationResponse, error)
+	GetTokenDetails(ctx context.Context, opts ...operations.Option) (*operations.GetTokenDetailsResponse, error)
+	GetTransientToken(ctx context.Context, type_ operations.GetTransientTokenQueryParamType, scope operations.Scope, opts ...operations.Option) (*operations.GetTransientTokenResponse, error)
+	PostUsersSignInData(ctx context.Context, request operations.PostUsersSignInDataRequest, opts ...operations.Option) (*operations.PostUsersSignInDataResponse, error)
+}
+
+var _ AuthenticationAPI = (*Authentication)(nil)
+
+// StatisticsAPI is the interface of Statistics, implemented by PlexAPI.Statistics and by plexmock.StatisticsAPIMock
+type StatisticsAPI interface {
+	GetBandwidthStatistics(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetBandwidthStatisticsResponse, error)
+	GetCollectionPlayCounts(ctx context.Context, collectionID int, window time.Duration, opts ...operations.Option) (*CollectionPlayCounts, error)
+	GetResourcesStatistics(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetResourcesStatisticsResponse, error)
+	GetStatistics(ctx context.Context, timespan *int64, opts ...operations.Option) (*operations.GetStatisticsResponse, error)
+	GetStreamQualityReport(ctx context.Context, window time.Duration, opts ...operations.Option) (*StreamQualityReport, error)
+}
+
+var _ StatisticsAPI = (*Statistics)(nil)
+
+// SessionsAPI is the interface of Sessions, implemented by PlexAPI.Sessions and by plexmock.SessionsAPIMock
+type SessionsAPI interface {
+	GetActiveSessions(ctx context.Context, opts ...operations.Option) ([]ActiveSession, error)
+	GetHistoryPage(ctx context.Context, accountID int, cursor Cursor, opts ...operations.Option) ([]operations.GetSessionHistoryMetadata, Cursor, error)
+	GetSessionHistory(ctx context.Context, sort *string, accountID *int64, filter *operations.QueryParamFilter, librarySectionID *int64, opts ...operations.Option) (*operations.GetSessionHistoryResponse, error)
+	GetSessions(ctx context.Context, opts ...operations.Option) (*operations.GetSessionsResponse, error)
+	GetTranscodeSessions(ctx context.Context, opts ...operations.Option) (*operations.GetTranscodeSessionsResponse, error)
+	NewMonitor(policies ...SessionPolicy) *SessionMonitor
+	StopTranscodeSession(ctx context.Context, sessionKey string, opts ...operations.Option) (*operations.StopTranscodeSessionResponse, error)
+	TerminateSession(ctx context.Context, sessionID string, reason string, opts ...operations.Option) error
+	TerminateSessionByKey(ctx context.Context, sessionKey string, message string, opts ...operations.Option) error
+}
+
+var _ SessionsAPI = (*Sessions)(nil)
+
+// UpdaterAPI is the interface of Updater, implemented by PlexAPI.Updater and by plexmock.UpdaterAPIMock
+type UpdaterAPI interface {
+	ApplyUpdates(ctx context.Context, tonight *operations.Tonight, skip *operations.Skip, opts ...operations.Option) (*operations.ApplyUpdatesResponse, error)
+	CheckForUpdates(ctx context.Context, download *operations.Download, opts ...operations.Option) (*operations.CheckForUpdatesResponse, error)
+	GetUpdateStatus(ctx context.Context, opts ...operations.Option) (*operations.GetUpdateStatusResponse, error)
+}
+
+var _ UpdaterAPI = (*Updater)(nil)
+
+// UsersAPI is the interface of Users, implemented by PlexAPI.Users and by plexmock.UsersAPIMock
+type UsersAPI interface {
+	GetUserToken(ctx context.Context, machineID string, userID int64, opts ...operations.Option) (string, error)
+	GetUsers(ctx context.Context, request operations.GetUsersRequest, opts ...operations.Option) (*operations.GetUsersResponse, error)
+	HideSection(ctx context.Context, machineID string, userID int64, sectionID int, opts ...operations.Option) error
+	SetLibraryFilters(ctx context.Context, userID int64, filters LibraryFilters, opts ...operations.Option) error
+	ShowSection(ctx context.Context, machineID string, userID int64, sectionID int, opts ...operations.Option) error
+}
+
+var _ UsersAPI = (*Users)(nil)
+
+// CollectionsAPI is the interface of Collections, implemented by PlexAPI.Collections and by plexmock.CollectionsAPIMock
+type CollectionsAPI interface {
+	AddToCollection(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error
+	AddToCollectionAction(collectionID int) NewItemAction
+	Apply(ctx context.Context, plan *CollectionPlan, opts ...operations.Option) ([]PlannedChange, error)
+	ApplyPolicy(ctx context.Context, sectionID int, policy CollectionPolicy, opts ...operations.Option) ([]CollectionPolicyChange, error)
+	ApplyViewingOrder(ctx context.Context, collectionID int, order *ViewingOrder, opts ...operations.Option) (*ViewingOrderResult, error)
+	Audit(ctx context.Context, collectionID int, expectedGUIDs []string, opts ...operations.Option) (*CollectionAudit, error)
+	AutoGroupFranchises(ctx context.Context, sectionID int, opts ...operations.Option) ([]Franchise, error)
+	BuildSmartFilterURI(sectionID int, filterQuery string, opts ...operations.Option) string
+	CreateCollection(ctx context.Context, sectionID int, title string, itemIDs []string, opts ...operations.Option) (*Collection, error)
+	CreateCollectionOfType(ctx context.Context, sectionID int, title string, itemType MetadataType, itemIDs []string, opts ...operations.Option) (*Collection, error)
+	CreateCollectionWithOptions(ctx context.Context, sectionID int, title string, itemIDs []string, createOptions CreateCollectionOptions, opts ...operations.Option) (*Collection, error)
+	CreateForPerson(ctx context.Context, sectionID int, personName string, role PersonRole, opts ...operations.Option) (*Collection, error)
+	CreateFromHistory(ctx context.Context, sectionID int, title string, criteria HistoryCriteria, opts ...operations.Option) (*Collection, error)
+	CreatePresets(ctx context.Context, sectionID int, presets []Preset, opts ...operations.Option) ([]*Collection, error)
+	CreateSmartCollection(ctx context.Context, sectionID int, title string, smartType MetadataType, filterArgs string, opts ...operations.Option) (*Collection, error)
+	CreateSmartCollectionFromFilter(ctx context.Context, sectionID int, title string, filter *SmartFilter, opts ...operations.Option) (*Collection, error)
+	CreateSmartCollectionWithOptions(ctx context.Context, sectionID int, title string, smartType MetadataType, filterArgs string, createOptions CreateCollectionOptions, opts ...operations.Option) (*Collection, error)
+	DeleteCollection(ctx context.Context, collectionID int, opts ...operations.Option) error
+	DeleteCollectionByKey(ctx context.Context, ratingKey string, opts ...operations.Option) error
+	EnqueuePlan(ctx context.Context, q *TaskQueue, plan *CollectionPlan, opts ...operations.Option) error
+	ExportCollection(ctx context.Context, collectionID int, w io.Writer, opts ...operations.Option) (*CollectionBackup, error)
+	ExportCollections(ctx context.Context, sectionID int, dir string, resume *Checkpoint, opts ...operations.Option) (*Checkpoint, error)
+	GetAllCollections(ctx context.Context, sectionID int, opts ...operations.Option) ([]Collection, error)
+	GetAllCollectionsWithOptions(ctx context.Context, sectionID int, listOptions GetAllCollectionsOptions, opts ...operations.Option) ([]Collection, error)
+	GetCollection(ctx context.Context, collectionID int, opts ...operations.Option) (*Collection, error)
+	GetCollectionByKey(ctx context.Context, ratingKey string, opts ...operations.Option) (*Collection, error)
+	GetCollectionChildrenPage(ctx context.Context, collectionID int, cursor Cursor, opts ...operations.Option) ([]Metadata, Cursor, error)
+	GetCollectionHub(ctx context.Context, sectionID int, collectionID int, opts ...operations.Option) (*ManagedHub, error)
+	GetCollectionItems(ctx context.Context, collectionID int, opts ...operations.Option) ([]string, error)
+	GetCollectionStats(ctx context.Context, collectionID int, opts ...operations.Option) (*CollectionStats, error)
+	GetCollectionVisibility(ctx context.Context, sectionID int, collectionID int, opts ...operations.Option) (*CollectionVisibility, error)
+	GetCollectionsPage(ctx context.Context, sectionID int, cursor Cursor, opts ...operations.Option) ([]Collection, Cursor, error)
+	GetFilterTemplate(sectionID int, name string) (FilterTemplate, bool)
+	GetFilterTemplates(sectionID int) []FilterTemplate
+	GetNextUnwatched(ctx context.Context, collectionID int, accountID int, opts ...operations.Option) ([]NextEpisode, error)
+	GetPreferences(ctx context.Context, collectionID int, opts ...operations.Option) (*CollectionPreferences, error)
+	GetRandomItem(ctx context.Context, collectionID int, unwatchedOnly bool, opts ...operations.Option) (*Metadata, error)
+	GetSharingReport(ctx context.Context, collectionID int, opts ...operations.Option) (*CollectionSharingReport, error)
+	GetSmartFilter(ctx context.Context, collection *Collection, opts ...operations.Option) (string, error)
+	GetSmartFilterConfig(ctx context.Context, collection *Collection, opts ...operations.Option) (*SmartFilterConfig, error)
+	GetWatchNextFeed(ctx context.Context, sectionID int, opts ...operations.Option) ([]WatchNextEntry, error)
+	HandleTasks(q *TaskQueue, opts ...operations.Option)
+	HideFromUser(ctx context.Context, collectionID int, label string, user operations.User, opts ...operations.Option) error
+	MoveCollectionItem(ctx context.Context, collectionID int, itemID string, afterItemID string, opts ...operations.Option) error
+	NewFeaturedRotation(sectionID int, collectionIDs ...int) *FeaturedRotation
+	NewWatcher(sectionID int) *CollectionWatcher
+	NormalizeSortTitles(ctx context.Context, collectionID int, sortOptions SortTitleOptions, opts ...operations.Option) ([]SortTitleChange, error)
+	Plan(ctx context.Context, state *CollectionState, opts ...operations.Option) (*CollectionPlan, error)
+	RegisterFilterTemplate(ctx context.Context, sectionID int, name string, itemType MetadataType, filter FilterGroup, opts ...operations.Option) (*FilterTemplate, error)
+	RemoveFromCollection(ctx context.Context, collectionID int, itemIDs []string, opts ...operations.Option) error
+	RemoveTheme(ctx context.Context, collectionID int, opts ...operations.Option) error
+	RenderCollectionTemplate(ctx context.Context, collectionID int, tmpl *CollectionTemplate, opts ...operations.Option) (bool, error)
+	RepairSmart(ctx context.Context, collectionID int, opts ...operations.Option) (*SmartRepair, error)
+	ResolveFilterTemplates(sectionID int, filter *SmartFilter) (*SmartFilter, error)
+	ResolveSmartFilterURI(uri string, opts ...operations.Option) (string, error)
+	Restore(ctx context.Context, r io.Reader, opts ...operations.Option) (*RestoredCollection, error)
+	RestoreCollections(ctx context.Context, dir string, resume *Checkpoint, opts ...operations.Option) ([]RestoredCollection, *Checkpoint, error)
+	RunKometaCollections(ctx context.Context, sectionID int, collections []KometaCollection, opts ...operations.Option) ([]*Collection, error)
+	SearchItems(ctx context.Context, collectionID int, query CollectionItemQuery, opts ...operations.Option) ([]Metadata, error)
+	SetContentRatingLocked(ctx context.Context, collectionID int, locked bool, opts ...operations.Option) error
+	ShowToUser(ctx context.Context, collectionID int, label string, user operations.User, opts ...operations.Option) error
+	TestSmartFilter(ctx context.Context, sectionID int, filterQuery string, opts ...operations.Option) (bool, error)
+	UnregisterFilterTemplate(sectionID int, name string)
+	UpdateCollectionMode(ctx context.Context, collectionID int, mode string, opts ...operations.Option) (string, error)
+	UpdateCollectionSort(ctx context.Context, collectionID int, sort string, opts ...operations.Option) (string, error)
+	UpdateCollectionVisibility(ctx context.Context, sectionID int, collectionID int, visibility *CollectionVisibility, opts ...operations.Option) error
+	UpdateContentRating(ctx context.Context, collectionID int, contentRating string, locked bool, opts ...operations.Option) error
+	UpdateSmartCollection(ctx context.Context, collectionID int, filterURI string, opts ...operations.Option) error
+	UploadTheme(ctx context.Context, collectionID int, r io.Reader, opts ...operations.Option) error
+	UploadThemeFromURL(ctx context.Context, collectionID int, themeURL string, opts ...operations.Option) error
+}
+
+var _ CollectionsAPI = (*Collections)(nil)
+
+// PhotosAPI is the interface of Photos, implemented by PlexAPI.Photos and by plexmock.PhotosAPIMock
+type PhotosAPI interface {
+	GetOnThisDay(ctx context.Context, sectionID int, day time.Time, opts ...operations.Option) ([]Memory, error)
+	GetTimeline(ctx context.Context, sectionID int, from time.Time, to time.Time, opts ...operations.Option) ([]Metadata, error)
+}
+
+var _ PhotosAPI = (*Photos)(nil)
+
+// PlayQueuesAPI is the interface of PlayQueues, implemented by PlexAPI.PlayQueues and by plexmock.PlayQueuesAPIMock
+type PlayQueuesAPI interface {
+	CreateCinemaQueue(ctx context.Context, ratingKey int, trailers int, opts ...operations.Option) (*PlayQueue, error)
+	CreatePlayQueue(ctx context.Context, queueType string, ratingKeys []string, opts ...operations.Option) (*PlayQueue, error)
+}
+
+var _ PlayQueuesAPI = (*PlayQueues)(nil)
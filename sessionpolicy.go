@@ -0,0 +1,567 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// defaultMonitorInterval is the time between session checks when SessionMonitor.Interval is not set
+const defaultMonitorInterval = 30 * time.Second
+
+// ActiveSession is a playback session on the server, with the details session policies check
+type ActiveSession struct {
+	SessionID       string // Used to terminate the session
+	SessionKey      string
+	UserID          string
+	User            string
+	Title           string // e.g. "Heat" or "Severance - Good News About Hell"
+	Type            string // e.g. "movie", "episode" or "track"
+	Player          string
+	Product         string
+	State           string // "playing", "paused" or "buffering"
+	Local           bool
+	Location        string // "lan" or "wan"
+	Bandwidth       int    // In kbps
+	VideoResolution string // e.g. "1080" or "4k"
+	VideoDecision   string // "directplay", "copy" or "transcode"
+}
+
+// Remote returns true if the session streams outside the server's network
+func (a ActiveSession) Remote() bool {
+	return a.Location == "wan" || (a.Location == "" && !a.Local)
+}
+
+// Transcoding returns true if the video of the session is transcoded
+func (a ActiveSession) Transcoding() bool {
+	return a.VideoDecision == "transcode"
+}
+
+// GetActiveSessions gets the playback sessions on the server
+func (s *Sessions) GetActiveSessions(ctx context.Context, opts ...operations.Option) ([]ActiveSession, error) {
+	var out struct {
+		MediaContainer struct {
+			Metadata []struct {
+				SessionKey       string `json:"sessionKey"`
+				Title            string `json:"title"`
+				GrandparentTitle string `json:"grandparentTitle"`
+				Type             string `json:"type"`
+				User             struct {
+					ID    string `json:"id"`
+					Title string `json:"title"`
+				} `json:"User"`
+				Player struct {
+					Title   string `json:"title"`
+					Product string `json:"product"`
+					State   string `json:"state"`
+					Local   bool   `json:"local"`
+				} `json:"Player"`
+				Session struct {
+					ID        string `json:"id"`
+					Bandwidth int    `json:"bandwidth"`
+					Location  string `json:"location"`
+				} `json:"Session"`
+				Media []struct {
+					VideoResolution string `json:"videoResolution"`
+					Selected        bool   `json:"selected"`
+				} `json:"Media"`
+				TranscodeSession *struct {
+					VideoDecision string `json:"videoDecision"`
+				} `json:"TranscodeSession"`
+			} `json:"Metadata"`
+		} `json:"MediaContainer"`
+	}
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, "/status/sessions", nil, "getSessions", &out, opts...); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]ActiveSession, 0, len(out.MediaContainer.Metadata))
+	for _, item := range out.MediaContainer.Metadata {
+		session := ActiveSession{
+			SessionID:     item.Session.ID,
+			SessionKey:    item.SessionKey,
+			UserID:        item.User.ID,
+			User:          item.User.Title,
+			Title:         item.Title,
+			Type:          item.Type,
+			Player:        item.Player.Title,
+			Product:       item.Player.Product,
+			State:         item.Player.State,
+			Local:         item.Player.Local,
+			Location:      item.Session.Location,
+			Bandwidth:     item.Session.Bandwidth,
+			VideoDecision: "directplay",
+		}
+		if item.GrandparentTitle != "" {
+			session.Title = item.GrandparentTitle + " - " + item.Title
+		}
+		if item.TranscodeSession != nil && item.TranscodeSession.VideoDecision != "" {
+			session.VideoDecision = item.TranscodeSession.VideoDecision
+		}
+
+		// Sessions list the version being played as selected, or only that version
+		for _, media := range item.Media {
+			if media.Selected || len(item.Media) == 1 {
+				session.VideoResolution = strings.ToLower(media.VideoResolution)
+			}
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// TerminateSession stops a playback session, showing reason to the user. Terminating sessions
+// requires a Plex Pass.
+func (s *Sessions) TerminateSession(ctx context.Context, sessionID string, reason string, opts ...operations.Option) error {
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, "/status/sessions/terminate")
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("sessionId", sessionID)
+	queryParams.Add("reason", reason)
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "terminateSession",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		_, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TerminateSessionByKey stops the playback session with a session key, e.g. ActiveSession.SessionKey
+// or the sessionKey of a webhook or notification, showing message to the user. Plex has no way to
+// send a message to a player without stopping playback, so the message is the user's only warning.
+func (s *Sessions) TerminateSessionByKey(ctx context.Context, sessionKey string, message string, opts ...operations.Option) error {
+	sessions, err := s.GetActiveSessions(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting sessions: %w", err)
+	}
+
+	for _, session := range sessions {
+		if session.SessionKey == sessionKey {
+			return s.TerminateSession(ctx, session.SessionID, message, opts...)
+		}
+	}
+
+	return fmt.Errorf("session %s not found", sessionKey)
+}
+
+// PolicyViolation is a session that breaks a session policy
+type PolicyViolation struct {
+	Policy     string
+	Session    ActiveSession
+	Message    string // Explains the violation; shown to the user when the session is terminated
+	Terminated bool
+	DetectedAt time.Time
+}
+
+// SessionPolicy checks the active sessions for violations. Policies are called with every
+// snapshot of the sessions, so they can track sessions over time.
+type SessionPolicy interface {
+	Name() string
+	Check(sessions []ActiveSession, now time.Time) []PolicyViolation
+}
+
+// maxStreamsPolicy limits the concurrent streams of each user
+type maxStreamsPolicy struct {
+	max int
+}
+
+// MaxStreamsPerUser reports the streams of a user beyond the first max
+func MaxStreamsPerUser(max int) SessionPolicy {
+	return maxStreamsPolicy{max: max}
+}
+
+// Name returns the name of the policy
+func (p maxStreamsPolicy) Name() string {
+	return "max-streams-per-user"
+}
+
+// Check reports the sessions of each user beyond the limit
+func (p maxStreamsPolicy) Check(sessions []ActiveSession, now time.Time) []PolicyViolation {
+	var violations []PolicyViolation
+	streams := map[string]int{}
+	for _, session := range sessions {
+		streams[session.UserID]++
+		if streams[session.UserID] > p.max {
+			violations = append(violations, PolicyViolation{
+				Policy:  p.Name(),
+				Session: session,
+				Message: fmt.Sprintf("Only %d concurrent streams are allowed", p.max),
+			})
+		}
+	}
+	return violations
+}
+
+// noRemote4KTranscodePolicy forbids transcoding 4K video for remote streams
+type noRemote4KTranscodePolicy struct{}
+
+// NoRemote4KTranscode reports remote sessions transcoding 4K video
+func NoRemote4KTranscode() SessionPolicy {
+	return noRemote4KTranscodePolicy{}
+}
+
+// Name returns the name of the policy
+func (p noRemote4KTranscodePolicy) Name() string {
+	return "no-remote-4k-transcode"
+}
+
+// Check reports remote sessions transcoding 4K video
+func (p noRemote4KTranscodePolicy) Check(sessions []ActiveSession, now time.Time) []PolicyViolation {
+	var violations []PolicyViolation
+	for _, session := range sessions {
+		if session.Remote() && session.Transcoding() && session.VideoResolution == "4k" {
+			violations = append(violations, PolicyViolation{
+				Policy:  p.Name(),
+				Session: session,
+				Message: "4K video cannot be transcoded for remote streams, please play a lower resolution version",
+			})
+		}
+	}
+	return violations
+}
+
+// pausedTooLongPolicy limits how long sessions stay paused
+type pausedTooLongPolicy struct {
+	max    time.Duration
+	paused map[string]time.Time // When each session was first seen paused
+}
+
+// PausedTooLong reports sessions that stay paused for longer than max. Sessions are tracked
+// across checks, so the pause is measured from the first check that saw it.
+func PausedTooLong(max time.Duration) SessionPolicy {
+	return &pausedTooLongPolicy{max: max, paused: map[string]time.Time{}}
+}
+
+// Name returns the name of the policy
+func (p *pausedTooLongPolicy) Name() string {
+	return "paused-too-long"
+}
+
+// Check reports the sessions paused for longer than the limit
+func (p *pausedTooLongPolicy) Check(sessions []ActiveSession, now time.Time) []PolicyViolation {
+	var violations []PolicyViolation
+	paused := make(map[string]time.Time, len(p.paused))
+	for _, session := range sessions {
+		if session.State != "paused" {
+			continue
+		}
+
+		since, ok := p.paused[session.SessionKey]
+		if !ok {
+			since = now
+		}
+		paused[session.SessionKey] = since
+
+		if now.Sub(since) > p.max {
+			violations = append(violations, PolicyViolation{
+				Policy:  p.Name(),
+				Session: session,
+				Message: fmt.Sprintf("Playback was paused for more than %s", p.max),
+			})
+		}
+	}
+	p.paused = paused
+	return violations
+}
+
+// ScheduleRule forbids users from streaming during a daily time window, e.g. a managed user after
+// 21:00 on school nights. Times are durations since midnight; a window ending before it starts
+// spans midnight.
+type ScheduleRule struct {
+	Users    []string       // Names or IDs of the users the rule applies to
+	From     time.Duration  // Start of the window, e.g. 21 * time.Hour
+	To       time.Duration  // End of the window, e.g. 7 * time.Hour
+	Weekdays []time.Weekday // Days the window starts on; every day if empty
+	Location *time.Location // Time zone of the window; the local time zone if nil
+	Message  string         // Shown to the user when the session is terminated; a default naming the window if ""
+}
+
+// applies returns true if the rule applies to the user of a session
+func (r ScheduleRule) applies(session ActiveSession) bool {
+	for _, user := range r.Users {
+		if user == session.UserID || strings.EqualFold(user, session.User) {
+			return true
+		}
+	}
+	return false
+}
+
+// active returns true if t falls within the rule's window
+func (r ScheduleRule) active(t time.Time) bool {
+	location := r.Location
+	if location == nil {
+		location = time.Local
+	}
+	t = t.In(location)
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+	sinceMidnight := t.Sub(midnight)
+
+	// Windows spanning midnight started the day before during their early hours
+	startDay := t.Weekday()
+	switch {
+	case r.From <= r.To:
+		if sinceMidnight < r.From || sinceMidnight >= r.To {
+			return false
+		}
+	case sinceMidnight >= r.From:
+	case sinceMidnight < r.To:
+		startDay = (startDay + 6) % 7
+	default:
+		return false
+	}
+
+	if len(r.Weekdays) == 0 {
+		return true
+	}
+	for _, weekday := range r.Weekdays {
+		if weekday == startDay {
+			return true
+		}
+	}
+	return false
+}
+
+// message returns the message shown to users streaming during the window
+func (r ScheduleRule) message() string {
+	if r.Message != "" {
+		return r.Message
+	}
+	return fmt.Sprintf("Streaming is not allowed between %s and %s", formatClock(r.From), formatClock(r.To))
+}
+
+// formatClock formats a duration since midnight as e.g. "21:00"
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours())%24, int(d.Minutes())%60)
+}
+
+// scheduleRestrictionPolicy forbids users from streaming during time windows
+type scheduleRestrictionPolicy struct {
+	rules []ScheduleRule
+}
+
+// ScheduleRestriction reports the sessions of users streaming during a time window of one of
+// the rules. With SessionMonitor.Terminate set, the sessions are stopped with the rule's message.
+func ScheduleRestriction(rules ...ScheduleRule) SessionPolicy {
+	return scheduleRestrictionPolicy{rules: rules}
+}
+
+// Name returns the name of the policy
+func (p scheduleRestrictionPolicy) Name() string {
+	return "schedule-restriction"
+}
+
+// Check reports the sessions of users with an active window
+func (p scheduleRestrictionPolicy) Check(sessions []ActiveSession, now time.Time) []PolicyViolation {
+	var violations []PolicyViolation
+	for _, session := range sessions {
+		for _, rule := range p.rules {
+			if rule.applies(session) && rule.active(now) {
+				violations = append(violations, PolicyViolation{
+					Policy:  p.Name(),
+					Session: session,
+					Message: rule.message(),
+				})
+				break
+			}
+		}
+	}
+	return violations
+}
+
+// SessionMonitor periodically checks the active sessions against policies, reporting each new
+// violation once and optionally terminating the violating sessions. Create one with
+// Sessions.NewMonitor and set its fields before running it.
+type SessionMonitor struct {
+	Interval  time.Duration // Time between checks, 30 seconds if not set
+	Terminate bool          // Terminate violating sessions, showing the violation message to the user
+	OnError   func(error)   // Receives session and termination errors, which don't stop Run
+
+	sessions *Sessions
+	policies []SessionPolicy
+	reported map[string]bool // Violations already reported, by policy and session
+}
+
+// NewMonitor returns a monitor checking the active sessions against policies
+func (s *Sessions) NewMonitor(policies ...SessionPolicy) *SessionMonitor {
+	return &SessionMonitor{
+		sessions: s,
+		policies: policies,
+		reported: map[string]bool{},
+	}
+}
+
+// Check checks the active sessions once and returns the violations that were not reported by
+// a previous check. A violation is reported again if it stops and later recurs.
+func (m *SessionMonitor) Check(ctx context.Context, opts ...operations.Option) ([]PolicyViolation, error) {
+	sessions, err := m.sessions.GetActiveSessions(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sessions: %w", err)
+	}
+
+	now := time.Now()
+	current := map[string]bool{}
+	violations := []PolicyViolation{}
+
+	for _, policy := range m.policies {
+		for _, violation := range policy.Check(sessions, now) {
+			key := violation.Policy + "/" + violation.Session.SessionKey
+			if current[key] {
+				continue
+			}
+			current[key] = true
+			if m.reported[key] {
+				continue
+			}
+
+			violation.DetectedAt = now
+			violations = append(violations, violation)
+		}
+	}
+	m.reported = current
+
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Session.SessionKey < violations[j].Session.SessionKey })
+
+	if !m.Terminate {
+		return violations, nil
+	}
+
+	terminated := map[string]bool{}
+	for i, violation := range violations {
+		if terminated[violation.Session.SessionKey] {
+			violations[i].Terminated = true
+			continue
+		}
+		if err := m.sessions.TerminateSession(ctx, violation.Session.SessionID, violation.Message, opts...); err != nil {
+			m.reportError(fmt.Errorf("error terminating the session of %s: %w", violation.Session.User, err))
+			continue
+		}
+		terminated[violation.Session.SessionKey] = true
+		violations[i].Terminated = true
+	}
+
+	return violations, nil
+}
+
+// Run checks the active sessions every Interval until ctx is done, sending each new violation
+// to violations (if not nil). It returns ctx.Err() when stopped.
+func (m *SessionMonitor) Run(ctx context.Context, violations chan<- PolicyViolation, opts ...operations.Option) error {
+	interval := m.Interval
+	if interval <= 0 {
+		interval = defaultMonitorInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		detected, err := m.Check(ctx, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			m.reportError(err)
+		}
+
+		for _, violation := range detected {
+			if violations == nil {
+				continue
+			}
+			select {
+			case violations <- violation:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// reportError passes an error to OnError, if set
+func (m *SessionMonitor) reportError(err error) {
+	if m.OnError != nil {
+		m.OnError(err)
+	}
+}
@@ -0,0 +1,153 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSessionMonitor(t *testing.T) {
+	var terminated []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/status/sessions":
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"sessionKey":"1","title":"Heat","type":"movie","User":{"id":"10","title":"alice"},"Player":{"title":"TV","state":"playing","local":true},"Session":{"id":"s1","location":"lan"},"Media":[{"videoResolution":"4k"}]},
+				{"sessionKey":"2","title":"Ronin","type":"movie","User":{"id":"10","title":"alice"},"Player":{"title":"Phone","state":"playing"},"Session":{"id":"s2","location":"wan"},"Media":[{"videoResolution":"1080"},{"videoResolution":"4K","selected":true}],"TranscodeSession":{"videoDecision":"transcode"}},
+				{"sessionKey":"3","title":"Good News About Hell","grandparentTitle":"Severance","type":"episode","User":{"id":"20","title":"bob"},"Player":{"title":"Laptop","state":"paused"},"Session":{"id":"s3","location":"wan"},"Media":[{"videoResolution":"1080"}]}
+			]}}`))
+		case "/status/sessions/terminate":
+			if r.URL.Query().Get("reason") == "" {
+				t.Errorf("Expected a reason, got: %s", r.URL.RawQuery)
+			}
+			terminated = append(terminated, r.URL.Query().Get("sessionId"))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	sessions, err := client.Sessions.GetActiveSessions(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sessions[1].VideoResolution != "4k" || !sessions[1].Remote() || !sessions[1].Transcoding() {
+		t.Errorf("Unexpected remote transcode session: %+v", sessions[1])
+	}
+	if sessions[2].Title != "Severance - Good News About Hell" {
+		t.Errorf("Expected the show title to be included, got: %s", sessions[2].Title)
+	}
+
+	paused := PausedTooLong(0)
+	monitor := client.Sessions.NewMonitor(MaxStreamsPerUser(1), NoRemote4KTranscode(), paused)
+	monitor.Terminate = true
+
+	// The pause is first seen by this check, so it hasn't exceeded the limit yet
+	violations, err := monitor.Check(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(violations) != 2 || violations[0].Policy != "max-streams-per-user" || violations[1].Policy != "no-remote-4k-transcode" {
+		t.Fatalf("Unexpected violations: %+v", violations)
+	}
+	if !violations[0].Terminated || !violations[1].Terminated || len(terminated) != 1 || terminated[0] != "s2" {
+		t.Errorf("Expected session s2 to be terminated once, got: %v", terminated)
+	}
+
+	time.Sleep(time.Millisecond)
+
+	// Violations already reported are not reported again
+	violations, err = monitor.Check(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(violations) != 1 || violations[0].Policy != "paused-too-long" || violations[0].Session.User != "bob" {
+		t.Errorf("Expected only the pause to be reported, got: %+v", violations)
+	}
+}
+
+func TestTerminateSessionByKey(t *testing.T) {
+	var terminated string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/status/sessions":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[
+				{"sessionKey":"7","title":"Heat","type":"movie","User":{"id":"10","title":"alice"},"Session":{"id":"abc"}}
+			]}}`))
+		case "/status/sessions/terminate":
+			terminated = r.URL.Query().Get("sessionId") + ": " + r.URL.Query().Get("reason")
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	if err := client.Sessions.TerminateSessionByKey(context.Background(), "7", "Server maintenance in 5 minutes"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if terminated != "abc: Server maintenance in 5 minutes" {
+		t.Errorf("Expected session abc to be terminated with the message, got: %q", terminated)
+	}
+
+	if err := client.Sessions.TerminateSessionByKey(context.Background(), "8", "bye"); err == nil {
+		t.Errorf("Expected an error for an unknown session")
+	}
+}
+
+func TestScheduleRestriction(t *testing.T) {
+	policy := ScheduleRestriction(ScheduleRule{
+		Users:    []string{"Kid"},
+		From:     21 * time.Hour,
+		To:       7 * time.Hour,
+		Weekdays: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday},
+		Location: time.UTC,
+	})
+	sessions := []ActiveSession{
+		{SessionKey: "1", UserID: "30", User: "kid"},
+		{SessionKey: "2", UserID: "10", User: "alice"},
+	}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		violated bool
+	}{
+		{"school night", time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC), true},                     // Monday
+		{"after midnight of a school night", time.Date(2024, 1, 2, 6, 30, 0, 0, time.UTC), true}, // Tuesday, window from Monday
+		{"before the window", time.Date(2024, 1, 1, 20, 59, 0, 0, time.UTC), false},
+		{"end of the window", time.Date(2024, 1, 2, 7, 0, 0, 0, time.UTC), false},
+		{"friday night", time.Date(2024, 1, 5, 22, 0, 0, 0, time.UTC), false},
+		{"after midnight of a friday night", time.Date(2024, 1, 6, 1, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := policy.Check(sessions, tt.now)
+			if !tt.violated {
+				if len(violations) != 0 {
+					t.Errorf("Expected no violations, got: %+v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || violations[0].Session.SessionKey != "1" {
+				t.Fatalf("Expected the kid's session to violate the policy, got: %+v", violations)
+			}
+			if violations[0].Message != "Streaming is not allowed between 21:00 and 07:00" {
+				t.Errorf("Unexpected message: %s", violations[0].Message)
+			}
+		})
+	}
+}
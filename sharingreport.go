@@ -0,0 +1,244 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// CollectionAccess is whether a user the server is shared with can see a collection
+type CollectionAccess struct {
+	UserID        int64
+	Username      string
+	Visible       bool
+	Reason        string // Why the collection is hidden, "" if it is visible
+	ItemsFiltered bool   // Content rating restrictions may hide some of the collection's items
+}
+
+// CollectionSharingReport lists which users the server is shared with can see a collection. The
+// server owner sees every collection and isn't listed.
+type CollectionSharingReport struct {
+	CollectionID int
+	Title        string
+	SectionID    int
+	Labels       []string
+	Users        []CollectionAccess // In the order plex.tv lists the users
+}
+
+// Visible returns the users that can see the collection
+func (r CollectionSharingReport) Visible() []CollectionAccess {
+	var visible []CollectionAccess
+	for _, access := range r.Users {
+		if access.Visible {
+			visible = append(visible, access)
+		}
+	}
+	return visible
+}
+
+// sharedServer is a user the server is shared with, as listed by plex.tv
+type sharedServer struct {
+	ID               int64  `xml:"id,attr"`
+	UserID           int64  `xml:"userID,attr"`
+	Username         string `xml:"username,attr"`
+	AccessToken      string `xml:"accessToken,attr"` // Only listed for the server owner
+	AllLibraries     bool   `xml:"allLibraries,attr"`
+	FilterMovies     string `xml:"filterMovies,attr"`
+	FilterTelevision string `xml:"filterTelevision,attr"`
+	FilterMusic      string `xml:"filterMusic,attr"`
+	Sections         []struct {
+		ID     int64 `xml:"id,attr"` // plex.tv ID of the section, used to change the sharing
+		Key    int   `xml:"key,attr"`
+		Shared bool  `xml:"shared,attr"`
+	} `xml:"Section"`
+}
+
+// sharesSection returns true if the user has access to a library section
+func (s sharedServer) sharesSection(sectionID int) bool {
+	if s.AllLibraries {
+		return true
+	}
+	for _, section := range s.Sections {
+		if section.Key == sectionID && section.Shared {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSharingReport reports which users the server is shared with can see a collection, combining
+// the library sections shared with each user and their label restrictions with the collection's
+// labels. Content rating restrictions don't hide the collection itself, but may hide some of its
+// items, which is reported as ItemsFiltered.
+func (s *Collections) GetSharingReport(ctx context.Context, collectionID int, opts ...operations.Option) (*CollectionSharingReport, error) {
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection: %w", err)
+	}
+
+	identity, err := newServer(s.sdkConfiguration).GetIdentity(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server identity: %w", err)
+	}
+
+	shares, err := newUsers(s.sdkConfiguration).getSharedServers(ctx, identity.MachineIdentifier, withoutServerURL(opts)...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting shared users: %w", err)
+	}
+
+	report := &CollectionSharingReport{
+		CollectionID: collectionID,
+		Title:        collection.Title,
+		SectionID:    collection.SectionID,
+		Labels:       []string{},
+		Users:        make([]CollectionAccess, 0, len(shares)),
+	}
+	for _, label := range collection.Label {
+		report.Labels = append(report.Labels, label.Tag)
+	}
+
+	family := collectionItemFamily(collectionItemTypeFromName(collection.SubType))
+	for _, share := range shares {
+		access := CollectionAccess{UserID: share.UserID, Username: share.Username}
+
+		var restriction string
+		switch family {
+		case CollectionItemTypeMovie:
+			restriction = share.FilterMovies
+		case CollectionItemTypeShow:
+			restriction = share.FilterTelevision
+		case CollectionItemTypeArtist:
+			restriction = share.FilterMusic
+		}
+
+		filter, err := ParseLibraryFilter(restriction)
+		switch {
+		case !share.sharesSection(collection.SectionID):
+			access.Reason = fmt.Sprintf("library section %d is not shared", collection.SectionID)
+		case err != nil:
+			return nil, fmt.Errorf("error reading the sharing restrictions of %s: %w", share.Username, err)
+		default:
+			access.Reason = filterHidesCollection(filter, collection)
+			access.ItemsFiltered = len(filter.ContentRatings) > 0 || len(filter.ExcludeContentRatings) > 0
+		}
+		access.Visible = access.Reason == ""
+
+		report.Users = append(report.Users, access)
+	}
+
+	return report, nil
+}
+
+// filterHidesCollection returns why a sharing restriction hides a collection by its labels, or ""
+// if it doesn't
+func filterHidesCollection(filter LibraryFilter, collection *Collection) string {
+	for _, label := range filter.ExcludeLabels {
+		if collectionHasLabel(collection, label) {
+			return fmt.Sprintf("label %q is excluded", label)
+		}
+	}
+
+	if len(filter.Labels) == 0 {
+		return ""
+	}
+	for _, label := range filter.Labels {
+		if collectionHasLabel(collection, label) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("only labels %s are shared", strings.Join(filter.Labels, ", "))
+}
+
+// getSharedServers lists the users a server is shared with, along with their shared library
+// sections and sharing restrictions
+func (s *Users) getSharedServers(ctx context.Context, machineIdentifier string, opts ...operations.Option) ([]sharedServer, error) {
+	options := processOptions(opts)
+
+	baseURL := utils.ReplaceParameters(operations.GetUsersServerList[0], map[string]string{})
+	if options.ServerURL != nil {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, "/servers", machineIdentifier, "shared_servers")
+	if err != nil {
+		return nil, fmt.Errorf("error generating URL: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "getSharedServers",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "GET", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/xml")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return nil, err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return nil, err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rawBody, err := utils.ConsumeRawBody(httpRes)
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		SharedServers []sharedServer `xml:"SharedServer"`
+	}
+	if err := xml.Unmarshal(rawBody, &out); err != nil {
+		return nil, fmt.Errorf("error decoding shared users: %w", err)
+	}
+
+	return out.SharedServers, nil
+}
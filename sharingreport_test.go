@@ -0,0 +1,66 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSharingReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/identity":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"machineIdentifier":"abc123"}}`))
+		case "/library/collections/7":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"7","title":"Cartoons","type":"collection","subtype":"movie","librarySectionID":1,"Label":[{"tag":"kids"}]}]}}`))
+		case "/api/servers/abc123/shared_servers":
+			w.Header().Set("Content-Type", "application/xml")
+			w.Write([]byte(`<MediaContainer size="5">
+				<SharedServer userID="1" username="alice" allLibraries="1" filterMovies="" />
+				<SharedServer userID="2" username="bob" allLibraries="0"><Section key="2" shared="1" /><Section key="1" shared="0" /></SharedServer>
+				<SharedServer userID="3" username="carol" allLibraries="1" filterMovies="label!=kids" />
+				<SharedServer userID="4" username="dave" allLibraries="0" filterMovies="label=family"><Section key="1" shared="1" /></SharedServer>
+				<SharedServer userID="5" username="erin" allLibraries="0" filterMovies="contentRating=G%2CPG|label=kids"><Section key="1" shared="1" /></SharedServer>
+			</MediaContainer>`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithMiddleware(routePlexTV(server.URL)))
+
+	report, err := client.Collections.GetSharingReport(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(report.Users) != 5 || len(report.Labels) != 1 || report.Labels[0] != "kids" {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+
+	expected := []struct {
+		visible  bool
+		reason   string
+		filtered bool
+	}{
+		{true, "", false},
+		{false, "library section 1 is not shared", false},
+		{false, `label "kids" is excluded`, false},
+		{false, "only labels family are shared", false},
+		{true, "", true},
+	}
+	for i, want := range expected {
+		got := report.Users[i]
+		if got.Visible != want.visible || got.Reason != want.reason || got.ItemsFiltered != want.filtered {
+			t.Errorf("Unexpected access of %s: %+v", got.Username, got)
+		}
+	}
+
+	if visible := report.Visible(); len(visible) != 2 || visible[0].Username != "alice" || visible[1].Username != "erin" {
+		t.Errorf("Expected alice and erin to see the collection, got: %+v", visible)
+	}
+}
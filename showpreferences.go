@@ -0,0 +1,237 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// IDs of show preferences
+const (
+	ShowPreferenceEpisodeOrdering = "showOrdering"
+	ShowPreferenceSeasonDisplay   = "flattenSeasons"
+	ShowPreferenceEpisodeSort     = "episodeSort"
+	ShowPreferenceLanguage        = "languageOverride" // Metadata language, e.g. "en-US", or "" for the library default
+)
+
+// Episode orderings of the showOrdering preference
+const (
+	ShowOrderingLibraryDefault = ""
+	ShowOrderingTMDBAired      = "tmdbAiring"
+	ShowOrderingTVDBAired      = "aired"
+	ShowOrderingTVDBDVD        = "dvd"
+	ShowOrderingTVDBAbsolute   = "absolute"
+)
+
+// Season display settings of the flattenSeasons preference
+const (
+	SeasonDisplayLibraryDefault = "-1"
+	SeasonDisplayShow           = "0"
+	SeasonDisplayHide           = "1" // List the episodes of single season shows without the season
+)
+
+// Episode sort orders of the episodeSort preference
+const (
+	EpisodeSortLibraryDefault = "-1"
+	EpisodeSortOldestFirst    = "0"
+	EpisodeSortNewestFirst    = "1"
+)
+
+// ShowPreferences are the preferences of a show, as returned by GetShowPreferences. Settings use the
+// same format as the preferences of a collection.
+type ShowPreferences struct {
+	Settings []CollectionPreference
+}
+
+// Get returns the value of a preference by ID
+func (p *ShowPreferences) Get(id string) (string, bool) {
+	for _, setting := range p.Settings {
+		if setting.ID == id {
+			return setting.Value, true
+		}
+	}
+	return "", false
+}
+
+// EpisodeOrdering returns the episode ordering as a ShowOrdering constant
+func (p *ShowPreferences) EpisodeOrdering() string {
+	value, _ := p.Get(ShowPreferenceEpisodeOrdering)
+	return value
+}
+
+// SeasonDisplay returns the season display setting as a SeasonDisplay constant
+func (p *ShowPreferences) SeasonDisplay() string {
+	value, _ := p.Get(ShowPreferenceSeasonDisplay)
+	return value
+}
+
+// EpisodeSort returns the episode sort order as an EpisodeSort constant
+func (p *ShowPreferences) EpisodeSort() string {
+	value, _ := p.Get(ShowPreferenceEpisodeSort)
+	return value
+}
+
+// Language returns the metadata language, or "" for the library default
+func (p *ShowPreferences) Language() string {
+	value, _ := p.Get(ShowPreferenceLanguage)
+	return value
+}
+
+// GetShowPreferences gets the preferences of a show, including its episode ordering, season display
+// and metadata language
+func (s *Library) GetShowPreferences(ctx context.Context, ratingKey int, opts ...operations.Option) (*ShowPreferences, error) {
+	var out struct {
+		MediaContainer struct {
+			Metadata []struct {
+				Preferences struct {
+					Setting []CollectionPreference `json:"Setting"`
+				} `json:"Preferences"`
+			} `json:"Metadata"`
+		} `json:"MediaContainer"`
+	}
+
+	// Preferences are only included in the show's metadata when requested
+	queryParams := url.Values{}
+	queryParams.Add("includePreferences", "1")
+
+	path := fmt.Sprintf("/library/metadata/%d", ratingKey)
+	if err := s.getJSON(ctx, path, queryParams, "getShowPreferences", &out, opts...); err != nil {
+		return nil, err
+	}
+
+	if len(out.MediaContainer.Metadata) == 0 {
+		return nil, fmt.Errorf("show %d not found", ratingKey)
+	}
+
+	prefs := &ShowPreferences{Settings: out.MediaContainer.Metadata[0].Preferences.Setting}
+	if prefs.Settings == nil {
+		prefs.Settings = []CollectionPreference{}
+	}
+
+	return prefs, nil
+}
+
+// UpdateShowPreferences sets preferences of a show by ID, e.g.
+// {ShowPreferenceEpisodeOrdering: ShowOrderingTVDBDVD}. Preferences that are not given are left
+// unchanged. Changing the episode ordering or language takes effect after the show is refreshed.
+func (s *Library) UpdateShowPreferences(ctx context.Context, ratingKey int, prefs map[string]string, opts ...operations.Option) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/metadata/%d/prefs", ratingKey))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	for id, value := range prefs {
+		queryParams.Set(id, value)
+	}
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "updateShowPreferences",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "PUT", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		_, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateSectionShowPreferences sets preferences of every show in a TV library section, e.g. to
+// switch all shows to DVD ordering after an agent migration. The shows that were updated are
+// returned; if an update fails, the shows updated before it are returned with the error.
+func (s *Library) UpdateSectionShowPreferences(ctx context.Context, sectionID int, prefs map[string]string, opts ...operations.Option) ([]Metadata, error) {
+	queryParams := url.Values{}
+	queryParams.Add("type", strconv.Itoa(int(CollectionItemTypeShow)))
+
+	shows, err := s.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), queryParams, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting shows: %w", err)
+	}
+
+	options := processOptions(opts)
+
+	reportProgress(options, 0, len(shows), "updating shows")
+	for i, show := range shows {
+		ratingKey, err := strconv.Atoi(show.RatingKey)
+		if err != nil {
+			return shows[:i], fmt.Errorf("error converting rating key %s: %w", show.RatingKey, err)
+		}
+
+		if err := s.UpdateShowPreferences(ctx, ratingKey, prefs, opts...); err != nil {
+			return shows[:i], fmt.Errorf("error updating %s: %w", show.Title, err)
+		}
+		reportProgress(options, i+1, len(shows), "updating shows")
+	}
+
+	return shows, nil
+}
@@ -0,0 +1,62 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestShowPreferences(t *testing.T) {
+	var updated []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/10":
+			if r.URL.Query().Get("includePreferences") != "1" {
+				t.Errorf("Expected includePreferences=1, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","type":"show","Preferences":{"Setting":[
+				{"id":"episodeSort","type":"int","default":-1,"value":1},
+				{"id":"flattenSeasons","type":"int","default":-1,"value":-1},
+				{"id":"showOrdering","type":"text","default":"","value":"dvd"},
+				{"id":"languageOverride","type":"text","default":"","value":"de-DE"}
+			]}}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/2/all":
+			if r.URL.Query().Get("type") != "2" {
+				t.Errorf("Expected type=2, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[{"ratingKey":"10","type":"show"},{"ratingKey":"20","type":"show"}]}}`))
+		case r.Method == "PUT" && (r.URL.Path == "/library/metadata/10/prefs" || r.URL.Path == "/library/metadata/20/prefs"):
+			if r.URL.Query().Get("showOrdering") != "tmdbAiring" || r.URL.Query().Get("flattenSeasons") != "1" {
+				t.Errorf("Unexpected preferences: %s", r.URL.RawQuery)
+			}
+			updated = append(updated, r.URL.Path)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	prefs, err := client.Library.GetShowPreferences(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if prefs.EpisodeOrdering() != ShowOrderingTVDBDVD || prefs.SeasonDisplay() != SeasonDisplayLibraryDefault || prefs.EpisodeSort() != EpisodeSortNewestFirst || prefs.Language() != "de-DE" {
+		t.Errorf("Unexpected preferences: %+v", prefs.Settings)
+	}
+
+	shows, err := client.Library.UpdateSectionShowPreferences(context.Background(), 2, map[string]string{
+		ShowPreferenceEpisodeOrdering: ShowOrderingTMDBAired,
+		ShowPreferenceSeasonDisplay:   SeasonDisplayHide,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(shows) != 2 || len(updated) != 2 {
+		t.Errorf("Expected 2 shows to be updated, got: %d shows and requests %v", len(shows), updated)
+	}
+}
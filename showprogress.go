@@ -0,0 +1,107 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// WatchProgress counts the watched episodes of a show or season
+type WatchProgress struct {
+	LeafCount    int   // Number of episodes
+	Watched      int   // Episodes watched at least once
+	InProgress   int   // Unwatched episodes that were partially watched
+	LastViewedAt int64 // Unix time an episode was last watched, 0 if none was
+	LastAddedAt  int64 // Unix time the newest episode was added
+}
+
+// Unwatched returns the number of episodes that have not been watched
+func (p WatchProgress) Unwatched() int {
+	return p.LeafCount - p.Watched
+}
+
+// Completed returns true if every episode has been watched, and there is at least one
+func (p WatchProgress) Completed() bool {
+	return p.LeafCount > 0 && p.Watched == p.LeafCount
+}
+
+// PercentWatched returns the share of watched episodes from 0 to 100
+func (p WatchProgress) PercentWatched() float64 {
+	if p.LeafCount == 0 {
+		return 0
+	}
+	return float64(p.Watched) * 100 / float64(p.LeafCount)
+}
+
+// add counts an episode
+func (p *WatchProgress) add(episode Metadata) {
+	p.LeafCount++
+	if episode.ViewCount > 0 {
+		p.Watched++
+	} else if episode.ViewOffset > 0 {
+		p.InProgress++
+	}
+	if episode.LastViewedAt > p.LastViewedAt {
+		p.LastViewedAt = episode.LastViewedAt
+	}
+	if episode.AddedAt > p.LastAddedAt {
+		p.LastAddedAt = episode.AddedAt
+	}
+}
+
+// SeasonProgress is the watch progress of a season
+type SeasonProgress struct {
+	WatchProgress
+	Season Metadata
+}
+
+// ShowProgress is the watch progress of a show, in total and by season
+type ShowProgress struct {
+	WatchProgress
+	Show    Metadata
+	Seasons []SeasonProgress
+}
+
+// GetShowProgress rolls the watch state of a show's episodes up into watched and unwatched counts
+// and the time of the last activity, for the show and each of its seasons, e.g. to find completed
+// or abandoned series. The counts are those of the authenticated user.
+func (s *Library) GetShowProgress(ctx context.Context, ratingKey int, opts ...operations.Option) (*ShowProgress, error) {
+	show, err := s.GetItem(ctx, ratingKey, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if show.Type != "show" {
+		return nil, fmt.Errorf("item %d is a %s, not a show", ratingKey, show.Type)
+	}
+
+	seasons, err := s.GetChildren(ctx, ratingKey, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting seasons of %s: %w", show.Title, err)
+	}
+
+	episodes, err := s.listMetadata(ctx, fmt.Sprintf("/library/metadata/%d/allLeaves", ratingKey), nil, "getAllLeaves", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting episodes of %s: %w", show.Title, err)
+	}
+
+	progress := &ShowProgress{
+		Show:    *show,
+		Seasons: make([]SeasonProgress, 0, len(seasons)),
+	}
+
+	seasonIndexes := make(map[string]int, len(seasons))
+	for _, season := range seasons {
+		seasonIndexes[season.RatingKey] = len(progress.Seasons)
+		progress.Seasons = append(progress.Seasons, SeasonProgress{Season: season})
+	}
+
+	for _, episode := range episodes {
+		progress.add(episode)
+		if i, ok := seasonIndexes[episode.ParentRatingKey]; ok {
+			progress.Seasons[i].add(episode)
+		}
+	}
+
+	return progress, nil
+}
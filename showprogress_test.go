@@ -0,0 +1,66 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetShowProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/library/metadata/10":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","type":"show","title":"Severance"}]}}`))
+		case "/library/metadata/10/children":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"11","type":"season","title":"Season 1","index":1},
+				{"ratingKey":"12","type":"season","title":"Season 2","index":2}
+			]}}`))
+		case "/library/metadata/10/allLeaves":
+			w.Write([]byte(`{"MediaContainer":{"size":4,"Metadata":[
+				{"ratingKey":"111","type":"episode","parentRatingKey":"11","viewCount":1,"lastViewedAt":1700000000,"addedAt":1600000000},
+				{"ratingKey":"112","type":"episode","parentRatingKey":"11","viewCount":2,"lastViewedAt":1700500000,"addedAt":1600000000},
+				{"ratingKey":"121","type":"episode","parentRatingKey":"12","viewOffset":60000,"addedAt":1690000000},
+				{"ratingKey":"122","type":"episode","parentRatingKey":"12","addedAt":1690100000}
+			]}}`))
+		case "/library/metadata/111":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"111","type":"episode","title":"Good News About Hell"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	progress, err := client.Library.GetShowProgress(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if progress.Show.Title != "Severance" || progress.LeafCount != 4 || progress.Watched != 2 || progress.Unwatched() != 2 || progress.InProgress != 1 {
+		t.Errorf("Unexpected show progress: %+v", progress.WatchProgress)
+	}
+	if progress.LastViewedAt != 1700500000 || progress.LastAddedAt != 1690100000 || progress.PercentWatched() != 50 || progress.Completed() {
+		t.Errorf("Unexpected show activity: %+v", progress.WatchProgress)
+	}
+
+	if len(progress.Seasons) != 2 {
+		t.Fatalf("Expected 2 seasons, got: %d", len(progress.Seasons))
+	}
+	first, second := progress.Seasons[0], progress.Seasons[1]
+	if first.Season.Index != 1 || !first.Completed() || first.LastViewedAt != 1700500000 {
+		t.Errorf("Expected the first season to be completed, got: %+v", first)
+	}
+	if second.Season.Index != 2 || second.Watched != 0 || second.InProgress != 1 || second.LastViewedAt != 0 {
+		t.Errorf("Expected the second season to be in progress, got: %+v", second)
+	}
+
+	if _, err := client.Library.GetShowProgress(context.Background(), 111); err == nil || !strings.Contains(err.Error(), "not a show") {
+		t.Errorf("Expected an error for an episode, got: %v", err)
+	}
+}
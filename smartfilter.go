@@ -0,0 +1,638 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// FilterOperator is the operator of a smart filter condition, encoded between the field and the value
+type FilterOperator string
+
+// Smart filter operators. String fields use contains/is semantics, numeric and date fields use
+// is/greater than/less than.
+const (
+	FilterOpContains    FilterOperator = "="   // Contains (strings) or is (numbers and tags)
+	FilterOpNotContains FilterOperator = "!="  // Does not contain (strings) or is not (numbers and tags)
+	FilterOpIs          FilterOperator = "=="  // Is exactly (strings)
+	FilterOpIsNot       FilterOperator = "!==" // Is not exactly (strings)
+	FilterOpBeginsWith  FilterOperator = "<="  // Begins with (strings)
+	FilterOpEndsWith    FilterOperator = ">="  // Ends with (strings)
+	FilterOpGreaterThan FilterOperator = ">>=" // Greater than (numbers) or after (dates)
+	FilterOpLessThan    FilterOperator = "<<=" // Less than (numbers) or before (dates)
+)
+
+// Smart filter grouping parameters. push=1 opens a group, pop=1 closes it and or=1 joins the
+// surrounding conditions with OR instead of AND.
+const (
+	filterOperatorChars = "!<>="
+	filterGroupPush     = "push=1"
+	filterGroupPop      = "pop=1"
+	filterGroupOr       = "or=1"
+)
+
+// filterOperators lists the valid operators
+var filterOperators = map[FilterOperator]bool{
+	FilterOpContains:    true,
+	FilterOpNotContains: true,
+	FilterOpIs:          true,
+	FilterOpIsNot:       true,
+	FilterOpBeginsWith:  true,
+	FilterOpEndsWith:    true,
+	FilterOpGreaterThan: true,
+	FilterOpLessThan:    true,
+}
+
+// FilterNode is a node of a smart filter expression, either a FilterCondition or a FilterGroup
+type FilterNode interface {
+	encode() []string
+}
+
+// FilterCondition is a single smart filter condition, e.g. year >>= 2000
+type FilterCondition struct {
+	Field    string
+	Operator FilterOperator
+	Value    string
+}
+
+// FilterGroup is a group of conditions and nested groups joined by AND, or by OR when Or is set
+type FilterGroup struct {
+	Or    bool
+	Nodes []FilterNode
+}
+
+// Cond returns a smart filter condition
+func Cond(field string, op FilterOperator, value string) FilterCondition {
+	return FilterCondition{Field: field, Operator: op, Value: value}
+}
+
+// AllOf returns a group matching items that satisfy all nodes
+func AllOf(nodes ...FilterNode) FilterGroup {
+	return FilterGroup{Nodes: nodes}
+}
+
+// AnyOf returns a group matching items that satisfy any of the nodes
+func AnyOf(nodes ...FilterNode) FilterGroup {
+	return FilterGroup{Or: true, Nodes: nodes}
+}
+
+// encode returns the query parameter of the condition
+func (c FilterCondition) encode() []string {
+	return []string{c.Field + string(c.Operator) + url.QueryEscape(c.Value)}
+}
+
+// String returns the condition as a query parameter, e.g. year>>=2000
+func (c FilterCondition) String() string {
+	return c.encode()[0]
+}
+
+// encode returns the query parameters of the group's nodes, wrapping nested groups in push/pop
+func (g FilterGroup) encode() []string {
+	params := []string{}
+	for i, node := range g.Nodes {
+		if i > 0 && g.Or {
+			params = append(params, filterGroupOr)
+		}
+		if group, ok := node.(FilterGroup); ok {
+			params = append(params, filterGroupPush)
+			params = append(params, group.encode()...)
+			params = append(params, filterGroupPop)
+		} else {
+			params = append(params, node.encode()...)
+		}
+	}
+	return params
+}
+
+// relativeDateUnits are the units of Plex's relative date syntax, largest first. Months are
+// omitted because they have no fixed length.
+var relativeDateUnits = []struct {
+	suffix   string
+	duration time.Duration
+}{
+	{"y", 365 * 24 * time.Hour},
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+}
+
+// RelativeDate encodes a duration in Plex's relative date syntax, counting back from now
+// (e.g. 30 days becomes "-30d" and 365 days becomes "-1y"). The largest unit that divides
+// the duration evenly is used; durations below a second are rounded down to whole seconds.
+func RelativeDate(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	for _, unit := range relativeDateUnits {
+		if d >= unit.duration && d%unit.duration == 0 {
+			return fmt.Sprintf("-%d%s", d/unit.duration, unit.suffix)
+		}
+	}
+	return fmt.Sprintf("-%ds", d/time.Second)
+}
+
+// ParseRelativeDate parses Plex's relative date syntax (e.g. "-30d", "-3mon" or "-1y") into a
+// duration. Months are treated as 30 days.
+func ParseRelativeDate(value string) (time.Duration, error) {
+	trimmed := strings.TrimPrefix(value, "-")
+	if strings.HasSuffix(trimmed, "mon") {
+		n, err := strconv.Atoi(strings.TrimSuffix(trimmed, "mon"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid relative date %q: %w", value, err)
+		}
+		return time.Duration(n) * 30 * 24 * time.Hour, nil
+	}
+
+	for _, unit := range relativeDateUnits {
+		if strings.HasSuffix(trimmed, unit.suffix) {
+			n, err := strconv.Atoi(strings.TrimSuffix(trimmed, unit.suffix))
+			if err != nil {
+				return 0, fmt.Errorf("invalid relative date %q: %w", value, err)
+			}
+			return time.Duration(n) * unit.duration, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid relative date %q", value)
+}
+
+// InTheLast returns a condition matching items whose date field is within the given duration
+// of now, e.g. InTheLast("addedAt", 30*24*time.Hour) encodes as addedAt>>=-30d
+func InTheLast(field string, d time.Duration) FilterCondition {
+	return Cond(field, FilterOpGreaterThan, RelativeDate(d))
+}
+
+// NotInTheLast returns a condition matching items whose date field is older than the given
+// duration, e.g. NotInTheLast("lastViewedAt", 365*24*time.Hour) encodes as lastViewedAt<<=-1y
+func NotInTheLast(field string, d time.Duration) FilterCondition {
+	return Cond(field, FilterOpLessThan, RelativeDate(d))
+}
+
+// Unwatched returns a condition matching unwatched items. For shows and seasons it matches those
+// with unwatched episodes.
+func Unwatched() FilterCondition {
+	return Cond("unwatched", FilterOpContains, "1")
+}
+
+// InProgress returns a condition matching partially watched movies or episodes. Use
+// Cond("episode.inProgress", FilterOpContains, "1") to match shows with an episode in progress.
+func InProgress() FilterCondition {
+	return Cond("inProgress", FilterOpContains, "1")
+}
+
+// ViewCount returns a condition on the number of times an item was played, e.g.
+// ViewCount(FilterOpLessThan, 3). Use the episode.viewCount field to filter shows by plays.
+func ViewCount(op FilterOperator, count int) FilterCondition {
+	return Cond("viewCount", op, strconv.Itoa(count))
+}
+
+// watchStateFieldTypes lists the item types that support each watch state field. Plex silently
+// returns no items when these fields are used with other types.
+var watchStateFieldTypes = map[string][]MetadataType{
+	"unwatched":  {CollectionItemTypeMovie, CollectionItemTypeShow, CollectionItemTypeSeason, CollectionItemTypeEpisode},
+	"inProgress": {CollectionItemTypeMovie, CollectionItemTypeEpisode},
+	"viewCount":  {CollectionItemTypeMovie, CollectionItemTypeEpisode, CollectionItemTypeTrack},
+}
+
+// filterFieldPrefixTypes maps field prefixes that filter on related items (e.g. episode.unwatched
+// in a show filter) to the item type they refer to
+var filterFieldPrefixTypes = map[string]MetadataType{
+	"show":    CollectionItemTypeShow,
+	"season":  CollectionItemTypeSeason,
+	"episode": CollectionItemTypeEpisode,
+	"artist":  CollectionItemTypeArtist,
+	"album":   CollectionItemTypeAlbum,
+	"track":   CollectionItemTypeTrack,
+}
+
+// filterFieldType splits a prefixed field (e.g. album.decade) into the item type it filters and
+// the field name. Unprefixed fields filter the filter's own item type.
+func filterFieldType(itemType MetadataType, field string) (MetadataType, string) {
+	if prefix, name, ok := strings.Cut(field, "."); ok {
+		if t, ok := filterFieldPrefixTypes[prefix]; ok {
+			return t, name
+		}
+	}
+	return itemType, field
+}
+
+// validateWatchStateCondition checks that a watch state condition (unwatched, inProgress or
+// viewCount) is supported by the item type and has a valid operator and value
+func validateWatchStateCondition(itemType MetadataType, c FilterCondition) error {
+	fieldType, field := filterFieldType(itemType, c.Field)
+
+	types, ok := watchStateFieldTypes[field]
+	if !ok {
+		return nil
+	}
+
+	supported := map[MetadataType]bool{}
+	for _, t := range types {
+		supported[t] = true
+	}
+	if !supported[fieldType] {
+		// Suggest filtering on the leaf items instead, e.g. episode.inProgress for shows
+		leafType := CollectionItemTypeEpisode
+		if collectionItemFamily(fieldType) == CollectionItemTypeArtist {
+			leafType = CollectionItemTypeTrack
+		}
+		if supported[leafType] && collectionItemFamily(leafType) == collectionItemFamily(fieldType) {
+			return fmt.Errorf("%s cannot filter %s items; use %s.%s", c.Field, CollectionItemTypeKeys[fieldType], CollectionItemTypeKeys[leafType], field)
+		}
+		return fmt.Errorf("%s cannot filter %s items", c.Field, CollectionItemTypeKeys[fieldType])
+	}
+
+	if field == "viewCount" {
+		if _, err := strconv.Atoi(c.Value); err != nil || c.Operator == FilterOpBeginsWith || c.Operator == FilterOpEndsWith {
+			return invalidArgument("invalid %s condition %s", c.Field, c)
+		}
+		return nil
+	}
+
+	if (c.Operator != FilterOpContains && c.Operator != FilterOpNotContains) || (c.Value != "0" && c.Value != "1") {
+		return invalidArgument("invalid %s condition %s; use %s=1 or %s=0", c.Field, c, c.Field, c.Field)
+	}
+	return nil
+}
+
+// SmartFilter is a smart collection filter for a library item type. Conditions added to the
+// filter are joined by AND; use AnyOf and AllOf to build grouped OR/AND expressions.
+type SmartFilter struct {
+	Type  MetadataType
+	Root  FilterGroup
+	Sort  []FilterSort // Sort order of the results, applied in order
+	Limit int          // Maximum number of items, 0 for no limit
+}
+
+// FilterSort is a sort field of a smart filter
+type FilterSort struct {
+	Field      string
+	Descending bool
+}
+
+// String returns the sort in Plex's sort syntax, e.g. rating:desc
+func (s FilterSort) String() string {
+	if s.Descending {
+		return s.Field + ":desc"
+	}
+	return s.Field
+}
+
+// smartFilterSortFields lists the sort fields Plex accepts for each item type
+var smartFilterSortFields = map[MetadataType][]string{
+	CollectionItemTypeMovie: {
+		"titleSort", "addedAt", "originallyAvailableAt", "year", "rating", "audienceRating", "userRating",
+		"contentRating", "duration", "lastViewedAt", "viewCount", "lastRatedAt", "mediaHeight", "mediaBitrate",
+		"studio", "random", "id",
+	},
+	CollectionItemTypeShow: {
+		"titleSort", "addedAt", "originallyAvailableAt", "year", "rating", "audienceRating", "userRating",
+		"contentRating", "lastViewedAt", "viewCount", "episode.addedAt", "unviewedLeafCount", "random", "id",
+	},
+	CollectionItemTypeSeason: {
+		"index", "titleSort", "addedAt", "lastViewedAt", "viewCount", "random",
+	},
+	CollectionItemTypeEpisode: {
+		"titleSort", "addedAt", "originallyAvailableAt", "rating", "audienceRating", "userRating",
+		"contentRating", "duration", "lastViewedAt", "viewCount", "show.titleSort", "season.index", "index",
+		"mediaHeight", "mediaBitrate", "random", "id",
+	},
+	CollectionItemTypeArtist: {
+		"titleSort", "addedAt", "lastViewedAt", "viewCount", "userRating", "random",
+	},
+	CollectionItemTypeAlbum: {
+		"titleSort", "artist.titleSort", "addedAt", "originallyAvailableAt", "year", "lastViewedAt",
+		"viewCount", "userRating", "random",
+	},
+	CollectionItemTypeTrack: {
+		"titleSort", "artist.titleSort", "album.titleSort", "addedAt", "duration", "lastViewedAt",
+		"viewCount", "userRating", "ratingCount", "random",
+	},
+}
+
+// validateSmartFilterSort checks that a Plex sort parameter (e.g. "rating:desc,titleSort") only
+// uses sort fields that are legal for the item type
+func validateSmartFilterSort(itemType MetadataType, sort string) error {
+	fields, ok := smartFilterSortFields[itemType]
+	if !ok {
+		return invalidArgument("invalid smart filter type %d", itemType)
+	}
+
+	for _, part := range strings.Split(sort, ",") {
+		field := strings.TrimSuffix(strings.TrimSuffix(part, ":desc"), ":asc")
+		valid := false
+		for _, f := range fields {
+			if f == field {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return invalidArgument("cannot sort %s items by %q", CollectionItemTypeKeys[itemType], field)
+		}
+	}
+	return nil
+}
+
+// validateSmartFilterArgs validates the sort and limit parameters of a smart filter query string,
+// which Plex silently ignores when they are invalid
+func validateSmartFilterArgs(itemType MetadataType, filterArgs string) error {
+	for _, param := range strings.Split(strings.TrimPrefix(filterArgs, "?"), "&") {
+		switch {
+		case strings.HasPrefix(param, "sort="):
+			sort, err := url.QueryUnescape(strings.TrimPrefix(param, "sort="))
+			if err != nil {
+				return invalidArgument("invalid smart filter sort %q: %w", param, err)
+			}
+			if err := validateSmartFilterSort(itemType, sort); err != nil {
+				return err
+			}
+		case strings.HasPrefix(param, "limit="):
+			limit, err := strconv.Atoi(strings.TrimPrefix(param, "limit="))
+			if err != nil || limit <= 0 {
+				return invalidArgument("invalid smart filter limit %q", param)
+			}
+		default:
+			// Malformed conditions are left for Plex to reject
+			field, op, value, err := splitFilterParam(param)
+			if err != nil {
+				continue
+			}
+			if err := validateWatchStateCondition(itemType, Cond(field, op, value)); err != nil {
+				return err
+			}
+			if err := validateMusicCondition(itemType, Cond(field, op, value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseFilterSort parses a Plex sort parameter (e.g. "rating:desc,titleSort")
+func parseFilterSort(sort string) []FilterSort {
+	sorts := []FilterSort{}
+	for _, part := range strings.Split(sort, ",") {
+		if part == "" {
+			continue
+		}
+		sorts = append(sorts, FilterSort{
+			Field:      strings.TrimSuffix(strings.TrimSuffix(part, ":desc"), ":asc"),
+			Descending: strings.HasSuffix(part, ":desc"),
+		})
+	}
+	return sorts
+}
+
+// NewSmartFilter returns an empty smart filter for the given item type (CollectionItemTypeMovie, ...)
+func NewSmartFilter(itemType MetadataType) *SmartFilter {
+	return &SmartFilter{Type: itemType}
+}
+
+// Where adds a condition to the filter
+func (f *SmartFilter) Where(field string, op FilterOperator, value string) *SmartFilter {
+	f.Root.Nodes = append(f.Root.Nodes, Cond(field, op, value))
+	return f
+}
+
+// Add adds conditions or groups to the filter
+func (f *SmartFilter) Add(nodes ...FilterNode) *SmartFilter {
+	f.Root.Nodes = append(f.Root.Nodes, nodes...)
+	return f
+}
+
+// AnyOf adds a group matching items that satisfy any of the nodes
+func (f *SmartFilter) AnyOf(nodes ...FilterNode) *SmartFilter {
+	return f.Add(AnyOf(nodes...))
+}
+
+// AllOf adds a group matching items that satisfy all of the nodes
+func (f *SmartFilter) AllOf(nodes ...FilterNode) *SmartFilter {
+	return f.Add(AllOf(nodes...))
+}
+
+// SortBy adds a sort field to the filter
+func (f *SmartFilter) SortBy(field string, descending bool) *SmartFilter {
+	f.Sort = append(f.Sort, FilterSort{Field: field, Descending: descending})
+	return f
+}
+
+// WithLimit limits the filter to the first n items
+func (f *SmartFilter) WithLimit(n int) *SmartFilter {
+	f.Limit = n
+	return f
+}
+
+// sortParam returns the sort query parameter value, or an empty string if the filter is unsorted
+func (f *SmartFilter) sortParam() string {
+	sorts := make([]string, 0, len(f.Sort))
+	for _, sort := range f.Sort {
+		sorts = append(sorts, sort.String())
+	}
+	return strings.Join(sorts, ",")
+}
+
+// Validate checks that every condition has a field and a known operator, and that the sort
+// fields and limit are legal for the item type
+func (f *SmartFilter) Validate() error {
+	if _, ok := CollectionItemTypeKeys[f.Type]; !ok {
+		return invalidArgument("invalid smart filter type %d", f.Type)
+	}
+	if f.Limit < 0 {
+		return invalidArgument("invalid smart filter limit %d", f.Limit)
+	}
+	if len(f.Sort) > 0 {
+		if err := validateSmartFilterSort(f.Type, f.sortParam()); err != nil {
+			return err
+		}
+	}
+	return validateFilterGroup(f.Root, f.Type)
+}
+
+// validateFilterGroup checks the conditions of a group and its nested groups
+func validateFilterGroup(g FilterGroup, itemType MetadataType) error {
+	for _, node := range g.Nodes {
+		switch n := node.(type) {
+		case FilterCondition:
+			if n.Field == "" {
+				return fmt.Errorf("smart filter condition is missing a field")
+			}
+			if !filterOperators[n.Operator] {
+				return invalidArgument("invalid operator %q for field %s", n.Operator, n.Field)
+			}
+			if err := validateWatchStateCondition(itemType, n); err != nil {
+				return err
+			}
+			if err := validateMusicCondition(itemType, n); err != nil {
+				return err
+			}
+		case FilterGroup:
+			if err := validateFilterGroup(n, itemType); err != nil {
+				return err
+			}
+		case FilterTemplateRef:
+			return fmt.Errorf("filter template %q is not resolved; see ResolveFilterTemplates", n.Name)
+		}
+	}
+	return nil
+}
+
+// String returns the filter as a query string with a leading '?', as accepted by CreateSmartCollection
+func (f *SmartFilter) String() string {
+	params := []string{fmt.Sprintf("type=%d", f.Type)}
+	params = append(params, f.Root.encode()...)
+	if len(f.Sort) > 0 {
+		params = append(params, "sort="+f.sortParam())
+	}
+	if f.Limit > 0 {
+		params = append(params, fmt.Sprintf("limit=%d", f.Limit))
+	}
+	return "?" + strings.Join(params, "&")
+}
+
+// ParseSmartFilter parses a smart filter query string (with or without a leading '?'), such as
+// the Filter returned by GetSmartFilterConfig, including push/pop groups and or=1 joins
+func ParseSmartFilter(query string) (*SmartFilter, error) {
+	query = strings.TrimPrefix(query, "?")
+
+	filter := &SmartFilter{}
+	stack := []*FilterGroup{&filter.Root}
+	// joins records how each group's nodes have been joined so far: "and", "or" or ""
+	joins := []string{""}
+	// pendingOr is set by or=1 and applies to the next node; orStack saves it across nested groups
+	pendingOr := false
+	orStack := []bool{}
+
+	addNode := func(node FilterNode) error {
+		group := stack[len(stack)-1]
+		if len(group.Nodes) > 0 {
+			join := "and"
+			if pendingOr {
+				join = "or"
+			}
+			if joins[len(joins)-1] != "" && joins[len(joins)-1] != join {
+				return fmt.Errorf("smart filter mixes AND and OR at the same level; group them with push/pop")
+			}
+			joins[len(joins)-1] = join
+			group.Or = join == "or"
+		} else if pendingOr {
+			return fmt.Errorf("smart filter has or=1 without a preceding condition")
+		}
+		pendingOr = false
+		group.Nodes = append(group.Nodes, node)
+		return nil
+	}
+
+	for _, param := range strings.Split(query, "&") {
+		switch param {
+		case "":
+			continue
+		case filterGroupPush:
+			stack = append(stack, &FilterGroup{})
+			joins = append(joins, "")
+			orStack = append(orStack, pendingOr)
+			pendingOr = false
+			continue
+		case filterGroupPop:
+			if len(stack) == 1 {
+				return nil, fmt.Errorf("smart filter has pop=1 without a matching push=1")
+			}
+			group := *stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			joins = joins[:len(joins)-1]
+			if pendingOr {
+				return nil, fmt.Errorf("smart filter has or=1 without a following condition")
+			}
+			pendingOr = orStack[len(orStack)-1]
+			orStack = orStack[:len(orStack)-1]
+			if err := addNode(group); err != nil {
+				return nil, err
+			}
+			continue
+		case filterGroupOr:
+			pendingOr = true
+			continue
+		}
+
+		field, op, value, err := splitFilterParam(param)
+		if err != nil {
+			return nil, err
+		}
+
+		if field == "type" && op == FilterOpContains && len(stack) == 1 {
+			smartType, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid smart filter type %q: %w", value, err)
+			}
+			filter.Type = MetadataType(smartType)
+			continue
+		}
+
+		if field == "sort" && op == FilterOpContains && len(stack) == 1 {
+			filter.Sort = append(filter.Sort, parseFilterSort(value)...)
+			continue
+		}
+
+		if field == "limit" && op == FilterOpContains && len(stack) == 1 {
+			filter.Limit, err = strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid smart filter limit %q: %w", value, err)
+			}
+			continue
+		}
+
+		if !filterOperators[op] {
+			return nil, fmt.Errorf("invalid operator %q in smart filter condition %q", op, param)
+		}
+
+		if err := addNode(Cond(field, op, value)); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("smart filter has push=1 without a matching pop=1")
+	}
+
+	return filter, nil
+}
+
+// splitFilterParam splits a smart filter query parameter into its field, operator and unescaped value
+func splitFilterParam(param string) (string, FilterOperator, string, error) {
+	start := strings.IndexAny(param, filterOperatorChars)
+	if start <= 0 {
+		return "", "", "", fmt.Errorf("invalid smart filter condition %q", param)
+	}
+	end := start
+	for end < len(param) && strings.ContainsRune(filterOperatorChars, rune(param[end])) {
+		end++
+	}
+
+	value, err := url.QueryUnescape(param[end:])
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid value in smart filter condition %q: %w", param, err)
+	}
+
+	return param[:start], FilterOperator(param[start:end]), value, nil
+}
+
+// CreateSmartCollectionFromFilter creates a new smart collection from a SmartFilter, replacing its
+// template references with the filter templates of the section
+func (s *Collections) CreateSmartCollectionFromFilter(ctx context.Context, sectionID int, title string, filter *SmartFilter, opts ...operations.Option) (*Collection, error) {
+	filter, err := s.ResolveFilterTemplates(sectionID, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateSmartCollection(ctx, sectionID, title, filter.Type, filter.String(), opts...)
+}
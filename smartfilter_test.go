@@ -0,0 +1,216 @@
+package plexgo
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSmartFilterString(t *testing.T) {
+	filter := NewSmartFilter(CollectionItemTypeMovie).
+		Where("year", FilterOpGreaterThan, "1999").
+		AnyOf(
+			Cond("genre", FilterOpContains, "55"),
+			AllOf(
+				Cond("studio", FilterOpIs, "A24"),
+				Cond("title", FilterOpNotContains, "the end"),
+			),
+		)
+
+	expected := "?type=1&year>>=1999&push=1&genre=55&or=1&push=1&studio==A24&title!=the+end&pop=1&pop=1"
+	if filter.String() != expected {
+		t.Errorf("Expected %s, got: %s", expected, filter.String())
+	}
+
+	if err := filter.Validate(); err != nil {
+		t.Errorf("Expected a valid filter, got: %v", err)
+	}
+}
+
+func TestParseSmartFilterRoundTrip(t *testing.T) {
+	queries := []string{
+		"?type=1&year>>=1999&push=1&genre=55&or=1&push=1&studio==A24&title!=the+end&pop=1&pop=1",
+		"?type=2&genre=1&or=1&genre=2",
+		"?type=1&push=1&push=1&year<<=1980&pop=1&or=1&decade=2010&pop=1&resolution=4k",
+	}
+
+	for _, query := range queries {
+		filter, err := ParseSmartFilter(query)
+		if err != nil {
+			t.Errorf("Expected no error parsing %s, got: %v", query, err)
+			continue
+		}
+
+		if filter.String() != query {
+			t.Errorf("Expected round trip of %s, got: %s", query, filter.String())
+		}
+	}
+}
+
+func TestParseSmartFilterGroups(t *testing.T) {
+	filter, err := ParseSmartFilter("type=1&push=1&genre=1&or=1&genre=2&pop=1&year>>=2000")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := FilterGroup{Nodes: []FilterNode{
+		AnyOf(Cond("genre", FilterOpContains, "1"), Cond("genre", FilterOpContains, "2")),
+		Cond("year", FilterOpGreaterThan, "2000"),
+	}}
+	if filter.Type != CollectionItemTypeMovie || !reflect.DeepEqual(filter.Root, expected) {
+		t.Errorf("Unexpected parsed filter: %+v", filter)
+	}
+}
+
+func TestParseSmartFilterErrors(t *testing.T) {
+	invalid := []string{
+		"?type=1&genre=1&or=1&genre=2&year=2000",
+		"?type=1&push=1&genre=1",
+		"?type=1&genre=1&pop=1",
+		"?type=1&or=1&genre=1",
+		"?type=1&=1",
+	}
+
+	for _, query := range invalid {
+		if _, err := ParseSmartFilter(query); err == nil {
+			t.Errorf("Expected an error parsing %s, got nil", query)
+		}
+	}
+}
+
+func TestRelativeDate(t *testing.T) {
+	day := 24 * time.Hour
+	tests := map[time.Duration]string{
+		30 * day:         "-30d",
+		14 * day:         "-2w",
+		365 * day:        "-1y",
+		36 * time.Hour:   "-36h",
+		90 * time.Minute: "-90m",
+	}
+
+	for d, expected := range tests {
+		if got := RelativeDate(d); got != expected {
+			t.Errorf("Expected %s for %v, got: %s", expected, d, got)
+		}
+
+		parsed, err := ParseRelativeDate(expected)
+		if err != nil || parsed != d {
+			t.Errorf("Expected %s to parse to %v, got: %v (%v)", expected, d, parsed, err)
+		}
+	}
+
+	if parsed, err := ParseRelativeDate("-3mon"); err != nil || parsed != 90*day {
+		t.Errorf("Expected -3mon to parse to 90 days, got: %v (%v)", parsed, err)
+	}
+
+	if _, err := ParseRelativeDate("-3x"); err == nil {
+		t.Error("Expected an error for an unknown unit, got nil")
+	}
+
+	filter := NewSmartFilter(CollectionItemTypeMovie).Add(
+		InTheLast("addedAt", 30*day),
+		NotInTheLast("lastViewedAt", 365*day),
+	)
+	if filter.String() != "?type=1&addedAt>>=-30d&lastViewedAt<<=-1y" {
+		t.Errorf("Unexpected filter: %s", filter.String())
+	}
+}
+
+func TestSmartFilterSortAndLimit(t *testing.T) {
+	filter := NewSmartFilter(CollectionItemTypeMovie).
+		Where("unwatched", FilterOpContains, "1").
+		SortBy("rating", true).
+		SortBy("titleSort", false).
+		WithLimit(10)
+
+	expected := "?type=1&unwatched=1&sort=rating:desc,titleSort&limit=10"
+	if filter.String() != expected {
+		t.Errorf("Expected %s, got: %s", expected, filter.String())
+	}
+
+	if err := filter.Validate(); err != nil {
+		t.Errorf("Expected a valid filter, got: %v", err)
+	}
+
+	parsed, err := ParseSmartFilter(expected)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if parsed.Limit != 10 || len(parsed.Sort) != 2 || !parsed.Sort[0].Descending || parsed.String() != expected {
+		t.Errorf("Unexpected parsed filter: %+v", parsed)
+	}
+
+	if err := NewSmartFilter(CollectionItemTypeArtist).SortBy("mediaBitrate", true).Validate(); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument sorting artists by mediaBitrate, got: %v", err)
+	}
+
+	if err := validateSmartFilterArgs(CollectionItemTypeMovie, "?genre=1&limit=0"); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for a zero limit, got: %v", err)
+	}
+}
+
+func TestWatchStateFilters(t *testing.T) {
+	filter := NewSmartFilter(CollectionItemTypeMovie).Add(Unwatched(), ViewCount(FilterOpLessThan, 3))
+	if filter.String() != "?type=1&unwatched=1&viewCount<<=3" {
+		t.Errorf("Unexpected filter: %s", filter.String())
+	}
+	if err := filter.Validate(); err != nil {
+		t.Errorf("Expected a valid filter, got: %v", err)
+	}
+
+	valid := []*SmartFilter{
+		NewSmartFilter(CollectionItemTypeShow).Add(Unwatched()),
+		NewSmartFilter(CollectionItemTypeShow).Where("episode.inProgress", FilterOpContains, "1"),
+		NewSmartFilter(CollectionItemTypeEpisode).Add(InProgress()),
+	}
+	for _, f := range valid {
+		if err := f.Validate(); err != nil {
+			t.Errorf("Expected %s to be valid, got: %v", f, err)
+		}
+	}
+
+	invalid := []*SmartFilter{
+		NewSmartFilter(CollectionItemTypeShow).Add(InProgress()),
+		NewSmartFilter(CollectionItemTypeAlbum).Add(Unwatched()),
+		NewSmartFilter(CollectionItemTypeMovie).Where("unwatched", FilterOpContains, "yes"),
+		NewSmartFilter(CollectionItemTypeMovie).Where("viewCount", FilterOpBeginsWith, "1"),
+	}
+	for _, f := range invalid {
+		if err := f.Validate(); err == nil {
+			t.Errorf("Expected %s to be invalid, got nil", f)
+		}
+	}
+
+	if err := validateSmartFilterArgs(CollectionItemTypeShow, "?type=2&push=1&inProgress=1&pop=1"); err == nil || !strings.Contains(err.Error(), "episode.inProgress") {
+		t.Errorf("Expected an error suggesting episode.inProgress, got: %v", err)
+	}
+}
+
+func FuzzParseSmartFilter(f *testing.F) {
+	f.Add("?type=1&year>>=1999&push=1&genre=55&or=1&push=1&studio==A24&title!=the+end&pop=1&pop=1")
+	f.Add("?type=2&genre=1&or=1&genre=2")
+	f.Add("?type=1&push=1&push=1&year<<=1980&pop=1&or=1&decade=2010&pop=1&resolution=4k")
+	f.Add("type=1&addedAt>>=-30d&unwatched=1&sort=titleSort:desc&limit=10")
+	f.Add("?type=1&pop=1&push=1")
+
+	f.Fuzz(func(t *testing.T, query string) {
+		filter, err := ParseSmartFilter(query)
+		if err != nil {
+			return
+		}
+
+		// A parsed filter serializes to a query that parses back to the same filter
+		encoded := filter.String()
+		again, err := ParseSmartFilter(encoded)
+		if err != nil {
+			t.Fatalf("%q: expected %q to parse, got: %v", query, encoded, err)
+		}
+		if again.String() != encoded {
+			t.Errorf("%q: expected %q to round-trip, got %q", query, encoded, again.String())
+		}
+
+		_ = filter.Validate()
+	})
+}
@@ -0,0 +1,83 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// SmartFilterURIStyle controls how the smart filter URI stored in a smart collection refers to the server
+type SmartFilterURIStyle int
+
+const (
+	// SmartFilterURIAbsolute stores the SDK's base URL (e.g. http://10.0.0.2:32400/library/sections/1/all?...).
+	// The collection breaks if the server's address changes.
+	SmartFilterURIAbsolute SmartFilterURIStyle = iota
+	// SmartFilterURIMachine stores a machine-relative URI
+	// (server://{machineID}/com.plexapp.plugins.library/library/sections/1/all?...), as Plex Web does
+	SmartFilterURIMachine
+	// SmartFilterURIRelative stores a library-relative path (/library/sections/1/all?...)
+	SmartFilterURIRelative
+)
+
+// WithSmartFilterURIStyle sets how smart filter URIs are built when creating smart collections.
+// SmartFilterURIMachine insulates collections from changes to the server's address.
+func WithSmartFilterURIStyle(style SmartFilterURIStyle) SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.SmartFilterURIStyle = style
+	}
+}
+
+// buildSmartFilterURI builds the smart filter URI for a section in the configured style
+func (s *Collections) buildSmartFilterURI(ctx context.Context, sectionID int, filterQuery string, opts ...operations.Option) (string, error) {
+	if !strings.HasPrefix(filterQuery, "?") {
+		filterQuery = "?" + filterQuery
+	}
+	path := fmt.Sprintf("/library/sections/%d/all%s", sectionID, filterQuery)
+
+	switch s.sdkConfiguration.SmartFilterURIStyle {
+	case SmartFilterURIMachine:
+		identity, err := newServer(s.sdkConfiguration).GetIdentity(ctx, opts...)
+		if err != nil {
+			return "", fmt.Errorf("error getting server identity: %w", err)
+		}
+
+		return s.sdkConfiguration.GetURIRoot(identity.MachineIdentifier) + path, nil
+	case SmartFilterURIRelative:
+		return path, nil
+	default:
+		return s.BuildSmartFilterURI(sectionID, filterQuery, opts...), nil
+	}
+}
+
+// ResolveSmartFilterURI translates a stored smart filter URI of any style into an absolute URL on
+// the SDK's current server, so filters saved with an old address or as machine-relative URIs can
+// be requested directly
+func (s *Collections) ResolveSmartFilterURI(uri string, opts ...operations.Option) (string, error) {
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	parsedURI, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("error parsing smart filter URI: %w", err)
+	}
+
+	// Machine-relative URIs carry the library plugin identifier before the library path
+	path := strings.TrimPrefix(parsedURI.Path, "/com.plexapp.plugins.library")
+	if parsedURI.RawQuery != "" {
+		path += "?" + parsedURI.RawQuery
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + path, nil
+}
@@ -0,0 +1,60 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBuildSmartFilterURIStyles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		style    SmartFilterURIStyle
+		expected string
+	}{
+		{SmartFilterURIAbsolute, server.URL + "/library/sections/1/all?type=1&genre=55"},
+		{SmartFilterURIMachine, "server://abc123/com.plexapp.plugins.library/library/sections/1/all?type=1&genre=55"},
+		{SmartFilterURIRelative, "/library/sections/1/all?type=1&genre=55"},
+	}
+
+	for _, tt := range tests {
+		client := New(WithServerURL(server.URL), WithSmartFilterURIStyle(tt.style))
+
+		uri, err := client.Collections.buildSmartFilterURI(context.Background(), 1, "type=1&genre=55")
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if uri != tt.expected {
+			t.Errorf("Expected %s, got: %s", tt.expected, uri)
+		}
+	}
+}
+
+func TestResolveSmartFilterURI(t *testing.T) {
+	client := New(WithServerURL("http://10.0.0.5:32400"))
+
+	uris := []string{
+		"http://192.168.1.2:32400/library/sections/1/all?type=1&genre=55",
+		"server://abc123/com.plexapp.plugins.library/library/sections/1/all?type=1&genre=55",
+		"/library/sections/1/all?type=1&genre=55",
+	}
+
+	for _, uri := range uris {
+		resolved, err := client.Collections.ResolveSmartFilterURI(uri)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if resolved != "http://10.0.0.5:32400/library/sections/1/all?type=1&genre=55" {
+			t.Errorf("Unexpected resolved URI for %s: %s", uri, resolved)
+		}
+	}
+}
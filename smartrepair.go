@@ -0,0 +1,197 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// FilterFieldRenames maps smart filter fields older servers accepted to the fields that replaced
+// them. RepairSmart only rewrites a field when the server no longer offers it and offers its
+// replacement, so mappings that don't apply to a server are harmless. Add to it for renames not
+// listed here.
+var FilterFieldRenames = map[string]string{
+	"viewedAt":     "lastViewedAt",
+	"lastPlayedAt": "lastViewedAt",
+	"playCount":    "viewCount",
+	"unplayed":     "unwatched",
+}
+
+// SmartRepair is the result of RepairSmart
+type SmartRepair struct {
+	CollectionID int
+	Filter       string            // Filter query string before the repair
+	Repaired     string            // Filter query string after the repair, reapplied to the collection
+	Renamed      map[string]string // Fields rewritten, old name to new name
+	Unknown      []string          // Fields the server doesn't offer and that have no replacement
+}
+
+// Changed returns true if any field was rewritten
+func (r *SmartRepair) Changed() bool {
+	return len(r.Renamed) > 0
+}
+
+// RepairSmart fixes a smart collection that went empty after a server upgrade changed its filter
+// fields. The stored filter is checked against the fields the server currently offers for the
+// section, fields with a replacement in FilterFieldRenames or a music field that moved to albums
+// (e.g. decade of artists to album.decade) are rewritten, and the filter is reapplied to the
+// collection, keeping the style of its stored URI. Fields that can't be repaired are listed in
+// Unknown and left as they are. Filters using syntax ParseSmartFilter doesn't understand return
+// an error without changing the collection.
+func (s *Collections) RepairSmart(ctx context.Context, collectionID int, opts ...operations.Option) (*SmartRepair, error) {
+	ctx = withOperationCorrelationID(ctx)
+
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection: %w", err)
+	}
+
+	config, err := s.GetSmartFilterConfig(ctx, collection, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if config.Parsed == nil {
+		return nil, fmt.Errorf("cannot repair smart filter %s: the filter could not be parsed", config.Filter)
+	}
+
+	fields, err := s.sectionFilterFields(ctx, collection.SectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	repair := &SmartRepair{CollectionID: collectionID, Filter: config.Filter, Renamed: map[string]string{}}
+	unknown := map[string]bool{}
+	filter := config.Parsed
+	filter.Root = repairFilterGroup(filter.Root, filter.Type, fields, repair.Renamed, unknown)
+	for field := range unknown {
+		repair.Unknown = append(repair.Unknown, field)
+	}
+	sort.Strings(repair.Unknown)
+	repair.Repaired = filter.String()
+
+	// Keep the address style of the stored URI, replacing only its filter
+	uri, err := url.Parse(config.URI)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing smart filter URI: %w", err)
+	}
+	uri.RawQuery = strings.TrimPrefix(repair.Repaired, "?")
+
+	if err := s.putSmartFilterURI(ctx, collectionID, uri.String(), opts...); err != nil {
+		return nil, fmt.Errorf("error reapplying smart filter: %w", err)
+	}
+
+	return repair, nil
+}
+
+// repairFilterGroup returns a copy of a group with the fields the server doesn't offer replaced
+// where possible, recording the replaced fields in renamed and the others in unknown. If the
+// server listed no fields for an item type, its fields are left alone.
+func repairFilterGroup(g FilterGroup, itemType MetadataType, fields map[MetadataType]map[string]bool, renamed map[string]string, unknown map[string]bool) FilterGroup {
+	repaired := FilterGroup{Or: g.Or}
+	for _, node := range g.Nodes {
+		switch n := node.(type) {
+		case FilterCondition:
+			if replacement, ok := repairFilterField(n.Field, itemType, fields); !ok {
+				unknown[n.Field] = true
+			} else if replacement != n.Field {
+				renamed[n.Field] = replacement
+				n.Field = replacement
+			}
+			node = n
+		case FilterGroup:
+			node = repairFilterGroup(n, itemType, fields, renamed, unknown)
+		}
+		repaired.Nodes = append(repaired.Nodes, node)
+	}
+	return repaired
+}
+
+// repairFilterField returns the field to filter items of itemType by in place of field, which is
+// field itself if the server offers it, and false if neither it nor a replacement is offered
+func repairFilterField(field string, itemType MetadataType, fields map[MetadataType]map[string]bool) (string, bool) {
+	offered := func(field string) (bool, bool) {
+		fieldType, name := filterFieldType(itemType, field)
+		typeFields, listed := fields[fieldType]
+		return !listed || typeFields[name], listed
+	}
+
+	if ok, listed := offered(field); ok || !listed {
+		return field, true
+	}
+
+	replacement := filterField(itemType, field)
+	if replacement == field {
+		fieldType, name := filterFieldType(itemType, field)
+		renamed, ok := FilterFieldRenames[name]
+		if !ok {
+			return field, false
+		}
+		replacement = renamed
+		if fieldType != itemType {
+			replacement = CollectionItemTypeKeys[fieldType] + "." + renamed
+		}
+	}
+
+	if ok, _ := offered(replacement); ok {
+		return replacement, true
+	}
+	return field, false
+}
+
+// sectionFilterFields lists the fields the server offers for filtering each item type of a
+// section, by the item type they filter, e.g. "unwatched" of episodes for "episode.unwatched"
+func (s *Collections) sectionFilterFields(ctx context.Context, sectionID int, opts ...operations.Option) (map[MetadataType]map[string]bool, error) {
+	queryParams := url.Values{}
+	queryParams.Add("includeMeta", "1")
+	queryParams.Add("X-Plex-Container-Start", "0")
+	queryParams.Add("X-Plex-Container-Size", "0")
+
+	var out struct {
+		MediaContainer struct {
+			Meta struct {
+				Type []struct {
+					Type   string `json:"type"`
+					Filter []struct {
+						Filter string `json:"filter"`
+					} `json:"Filter"`
+					Field []struct {
+						Key string `json:"key"`
+					} `json:"Field"`
+				} `json:"Type"`
+			} `json:"Meta"`
+		} `json:"MediaContainer"`
+	}
+	path := fmt.Sprintf("/library/sections/%d/all", sectionID)
+	if err := newLibrary(s.sdkConfiguration).getJSON(ctx, path, queryParams, "getLibraryItems", &out, opts...); err != nil {
+		return nil, fmt.Errorf("error getting filter fields of section %d: %w", sectionID, err)
+	}
+
+	fields := map[MetadataType]map[string]bool{}
+	for _, t := range out.MediaContainer.Meta.Type {
+		itemType := metadataTypeFromName(t.Type)
+		if itemType == 0 {
+			continue
+		}
+
+		keys := []string{}
+		for _, filter := range t.Filter {
+			keys = append(keys, filter.Filter)
+		}
+		for _, field := range t.Field {
+			keys = append(keys, field.Key)
+		}
+		for _, key := range keys {
+			fieldType, name := filterFieldType(itemType, key)
+			if fields[fieldType] == nil {
+				fields[fieldType] = map[string]bool{}
+			}
+			fields[fieldType][name] = true
+		}
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,83 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRepairSmart(t *testing.T) {
+	var applied string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,
+				"content":"server://abc123/com.plexapp.plugins.library/library/sections/1/all?type=1&genre=1&viewedAt>>=-30d&mystery=1",
+				"Metadata":[{"ratingKey":"7","title":"Recently Watched","smart":"1","subtype":"movie","type":"collection","librarySectionID":1}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("includeMeta") != "1" {
+				t.Errorf("Expected includeMeta=1, got: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"size":0,"Meta":{"Type":[{"type":"movie",
+				"Filter":[{"filter":"genre"},{"filter":"unwatched"}],
+				"Field":[{"key":"title"},{"key":"lastViewedAt"}]}]}}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/7/items":
+			applied = r.URL.Query().Get("uri")
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	repair, err := client.Collections.RepairSmart(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !repair.Changed() || repair.Renamed["viewedAt"] != "lastViewedAt" || len(repair.Renamed) != 1 {
+		t.Errorf("Expected viewedAt to be renamed, got: %v", repair.Renamed)
+	}
+	if len(repair.Unknown) != 1 || repair.Unknown[0] != "mystery" {
+		t.Errorf("Expected mystery to be unknown, got: %v", repair.Unknown)
+	}
+	if !strings.HasPrefix(applied, "server://abc123/com.plexapp.plugins.library/library/sections/1/all?type=1&genre=1&lastViewedAt") {
+		t.Errorf("Expected the repaired filter in the stored URI style, got: %s", applied)
+	}
+	if !strings.Contains(applied, "mystery=1") {
+		t.Errorf("Expected unknown fields to be kept, got: %s", applied)
+	}
+}
+
+func TestRepairFilterField(t *testing.T) {
+	fields := map[MetadataType]map[string]bool{
+		CollectionItemTypeArtist:  {"title": true, "genre": true},
+		CollectionItemTypeAlbum:   {"decade": true, "genre": true},
+		CollectionItemTypeEpisode: {"viewCount": true},
+	}
+
+	tests := []struct {
+		itemType MetadataType
+		field    string
+		expected string
+		ok       bool
+	}{
+		{CollectionItemTypeArtist, "genre", "genre", true},
+		{CollectionItemTypeArtist, "decade", "album.decade", true},
+		{CollectionItemTypeShow, "episode.playCount", "episode.viewCount", true},
+		{CollectionItemTypeShow, "title", "title", true}, // No fields listed for shows
+		{CollectionItemTypeArtist, "mood", "mood", false},
+	}
+
+	for _, tt := range tests {
+		field, ok := repairFilterField(tt.field, tt.itemType, fields)
+		if field != tt.expected || ok != tt.ok {
+			t.Errorf("repairFilterField(%s, %s) = %s, %v; expected %s, %v", tt.field, tt.itemType, field, ok, tt.expected, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,432 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+	"github.com/unfaiyted/plexgo/retry"
+)
+
+// mockCollectionServer is an in-memory Plex server holding the collections of library section 1.
+// It serves the endpoints used by the collection helpers and keeps their state between requests.
+type mockCollectionServer struct {
+	mu          sync.Mutex
+	nextID      int
+	collections map[int]*mockCollection
+}
+
+type mockCollection struct {
+	title string
+	items []string
+}
+
+func newMockCollectionServer() *mockCollectionServer {
+	return &mockCollectionServer{nextID: 1000, collections: map[int]*mockCollection{}}
+}
+
+func (m *mockCollectionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch {
+	case r.Method == "GET" && path == "/identity":
+		w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"soak","version":"1.40.0"}}`))
+	case r.Method == "GET" && path == "/library/sections/1/collections":
+		ids := make([]int, 0, len(m.collections))
+		for id := range m.collections {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+
+		metadata := make([]map[string]interface{}, 0, len(ids))
+		for _, id := range ids {
+			metadata = append(metadata, m.metadata(id))
+		}
+		m.writeContainer(w, metadata)
+	case r.Method == "POST" && path == "/library/collections":
+		m.nextID++
+		m.collections[m.nextID] = &mockCollection{title: r.URL.Query().Get("title"), items: []string{}}
+		w.Header().Set("Location", fmt.Sprintf("/library/collections/%d", m.nextID))
+		w.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(path, "/library/collections/"):
+		m.serveCollection(w, r, strings.Split(strings.TrimPrefix(path, "/library/collections/"), "/"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveCollection serves /library/collections/{id}[/children|/items[/{item}]]
+func (m *mockCollectionServer) serveCollection(w http.ResponseWriter, r *http.Request, parts []string) {
+	id, _ := strconv.Atoi(parts[0])
+	collection, ok := m.collections[id]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch {
+	case r.Method == "GET" && len(parts) == 1:
+		m.writeContainer(w, []map[string]interface{}{m.metadata(id)})
+	case r.Method == "DELETE" && len(parts) == 1:
+		delete(m.collections, id)
+	case r.Method == "GET" && len(parts) == 2 && parts[1] == "children":
+		metadata := make([]map[string]interface{}, 0, len(collection.items))
+		for _, item := range collection.items {
+			metadata = append(metadata, map[string]interface{}{"ratingKey": item, "type": "movie"})
+		}
+		m.writeContainer(w, metadata)
+	case r.Method == "PUT" && len(parts) == 2 && parts[1] == "items":
+		uri := r.URL.Query().Get("uri")
+		for _, item := range strings.Split(uri[strings.LastIndex(uri, "/")+1:], ",") {
+			if !containsString(collection.items, item) {
+				collection.items = append(collection.items, item)
+			}
+		}
+	case r.Method == "DELETE" && len(parts) == 3 && parts[1] == "items":
+		for i, item := range collection.items {
+			if item == parts[2] {
+				collection.items = append(collection.items[:i], collection.items[i+1:]...)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (m *mockCollectionServer) metadata(id int) map[string]interface{} {
+	collection := m.collections[id]
+	return map[string]interface{}{
+		"ratingKey":        strconv.Itoa(id),
+		"title":            collection.title,
+		"type":             "collection",
+		"smart":            "0",
+		"librarySectionID": 1,
+	}
+}
+
+func (m *mockCollectionServer) writeContainer(w http.ResponseWriter, metadata []map[string]interface{}) {
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"MediaContainer": map[string]interface{}{"size": len(metadata), "Metadata": metadata},
+	})
+}
+
+// byTitle returns the collections by title
+func (m *mockCollectionServer) byTitle() map[string][]*mockCollection {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	titles := map[string][]*mockCollection{}
+	for _, collection := range m.collections {
+		titles[collection.title] = append(titles[collection.title], collection)
+	}
+	return titles
+}
+
+// chaosConfig sets the faults chaosHandler injects. Probabilities are per request.
+type chaosConfig struct {
+	Seed        int64
+	MaxLatency  time.Duration // Each request is delayed by up to this long
+	MaxInFlight int           // Requests beyond this many concurrent ones are rate limited, 0 for no limit
+	RateLimited float64       // 429 Too Many Requests
+	ServerError float64       // 503 Service Unavailable
+	DropRequest float64       // The connection drops before the server handles the request
+	// DropResponse drops the connection after the server handled the request, so a mutation is
+	// applied but the client never learns whether it succeeded
+	DropResponse float64
+}
+
+// chaosStats counts the faults injected by chaosHandler
+type chaosStats struct {
+	requests, rateLimited, serverErrors, droppedRequests, droppedResponses atomic.Int64
+}
+
+func (s *chaosStats) String() string {
+	return fmt.Sprintf("%d requests, %d rate limited, %d server errors, %d dropped requests, %d dropped responses",
+		s.requests.Load(), s.rateLimited.Load(), s.serverErrors.Load(), s.droppedRequests.Load(), s.droppedResponses.Load())
+}
+
+// chaosHandler wraps a handler with randomly injected latency, rate limiting, server errors and
+// dropped connections
+func chaosHandler(t *testing.T, next http.Handler, config chaosConfig, stats *chaosStats) http.Handler {
+	var mu sync.Mutex
+	rng := rand.New(rand.NewSource(config.Seed))
+	var inFlight atomic.Int64
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stats.requests.Add(1)
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		mu.Lock()
+		latency := time.Duration(rng.Int63n(int64(config.MaxLatency) + 1))
+		roll := rng.Float64()
+		mu.Unlock()
+		time.Sleep(latency)
+
+		drop := func() {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("Error hijacking connection: %v", err)
+				return
+			}
+			conn.Close()
+		}
+
+		switch {
+		case config.MaxInFlight > 0 && current > int64(config.MaxInFlight), roll < config.RateLimited:
+			stats.rateLimited.Add(1)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case roll < config.RateLimited+config.ServerError:
+			stats.serverErrors.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case roll < config.RateLimited+config.ServerError+config.DropRequest:
+			stats.droppedRequests.Add(1)
+			drop()
+		case roll < config.RateLimited+config.ServerError+config.DropRequest+config.DropResponse:
+			stats.droppedResponses.Add(1)
+			next.ServeHTTP(httptest.NewRecorder(), r)
+			drop()
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// isTransient returns true if an error is worth retrying: a rate limit, a server error or a
+// failure to get a response at all
+func isTransient(err error) bool {
+	var sdkErr *sdkerrors.SDKError
+	if errors.As(err, &sdkErr) {
+		return sdkErr.StatusCode == http.StatusTooManyRequests || sdkErr.StatusCode >= 500
+	}
+	return strings.Contains(err.Error(), "error sending request")
+}
+
+// isNotFound returns true if an error is a 404 response
+func isNotFound(err error) bool {
+	var sdkErr *sdkerrors.SDKError
+	return errors.As(err, &sdkErr) && sdkErr.StatusCode == http.StatusNotFound
+}
+
+// soakDuration returns how long TestCollectionSoak runs its workers, set with PLEXGO_SOAK_DURATION
+// for long runs, e.g. PLEXGO_SOAK_DURATION=10m go test -run TestCollectionSoak -timeout 0
+func soakDuration(t *testing.T) time.Duration {
+	value := os.Getenv("PLEXGO_SOAK_DURATION")
+	if value == "" {
+		return 0
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		t.Fatalf("Invalid PLEXGO_SOAK_DURATION %q: %v", value, err)
+	}
+	return duration
+}
+
+// TestCollectionSoak runs concurrent sequences of collection mutations against a flaky mock server.
+// Each worker retries failed calls the way a careful caller would and tracks the state it expects;
+// at the end the server must hold exactly that state: no duplicate collections from retried
+// creations whose responses were lost, and every collection with exactly the expected items.
+func TestCollectionSoak(t *testing.T) {
+	if testing.Short() {
+		t.Skip("soak test")
+	}
+
+	seed := time.Now().UnixNano()
+	if value := os.Getenv("PLEXGO_SOAK_SEED"); value != "" {
+		seed, _ = strconv.ParseInt(value, 10, 64)
+	}
+	t.Logf("PLEXGO_SOAK_SEED=%d", seed)
+
+	mock := newMockCollectionServer()
+	stats := &chaosStats{}
+	server := httptest.NewServer(chaosHandler(t, mock, chaosConfig{
+		Seed:         seed,
+		MaxLatency:   2 * time.Millisecond,
+		MaxInFlight:  6,
+		RateLimited:  0.05,
+		ServerError:  0.05,
+		DropRequest:  0.03,
+		DropResponse: 0.03,
+	}, stats))
+	defer server.Close()
+
+	// Generated operations retry on their own; the collection helpers are retried by the workers
+	client := New(WithServerURL(server.URL), WithRetryConfig(retry.Config{
+		Strategy:              "backoff",
+		IntervalStrategy:      retry.Constant(time.Millisecond),
+		Backoff:               &retry.BackoffStrategy{MaxElapsedTime: 5000},
+		RetryConnectionErrors: true,
+	}))
+	opts := []operations.Option{WithWaitForActivity(time.Second)}
+
+	const workers = 8
+	const collectionsPerWorker = 3
+	opsPerWorker := 40
+	deadline := time.Now().Add(soakDuration(t))
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	expected := make([]map[string][]string, workers) // Per worker, the items of each live collection by title
+	retries := atomic.Int64{}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			rng := rand.New(rand.NewSource(seed + int64(w)))
+			state := map[string][]string{}
+			expected[w] = state
+
+			// call retries fn until it succeeds or fails permanently
+			call := func(op string, fn func() error) error {
+				for attempt := 0; ; attempt++ {
+					err := fn()
+					if err == nil || !isTransient(err) || attempt == 50 {
+						if err != nil {
+							err = fmt.Errorf("%s: %w", op, err)
+						}
+						return err
+					}
+					retries.Add(1)
+				}
+			}
+
+			ids := map[string]int{}
+			for i := 0; i < opsPerWorker || time.Now().Before(deadline); i++ {
+				title := fmt.Sprintf("Soak %d-%d", w, rng.Intn(collectionsPerWorker))
+				item := strconv.Itoa(100 + rng.Intn(20))
+
+				var err error
+				switch op := rng.Intn(10); {
+				case ids[title] == 0 || op == 0:
+					// Ensure the collection exists: retrying an idempotent creation must not duplicate it
+					err = call("create", func() error {
+						collection, err := client.Collections.CreateCollectionWithOptions(ctx, 1, title, nil, CreateCollectionOptions{Idempotent: true}, opts...)
+						if err == nil {
+							ids[title], _ = strconv.Atoi(collection.RatingKey)
+						}
+						return err
+					})
+					if err == nil && state[title] == nil {
+						state[title] = []string{}
+					}
+				case op <= 4:
+					err = call("add", func() error {
+						return client.Collections.AddToCollection(ctx, ids[title], []string{item}, opts...)
+					})
+					if err == nil && !containsString(state[title], item) {
+						state[title] = append(state[title], item)
+					}
+				case op <= 7:
+					err = call("remove", func() error {
+						return client.Collections.RemoveFromCollection(ctx, ids[title], []string{item}, opts...)
+					})
+					if err == nil {
+						state[title] = removeString(state[title], item)
+					}
+				case op == 8:
+					var items []string
+					err = call("get items", func() (err error) {
+						items, err = client.Collections.GetCollectionItems(ctx, ids[title], opts...)
+						return err
+					})
+					if err == nil && strings.Join(items, ",") != strings.Join(state[title], ",") {
+						t.Errorf("Worker %d: expected %s to hold %v, got %v", w, title, state[title], items)
+					}
+				default:
+					// A retried deletion finds the collection already gone if the first response was lost
+					attempts := 0
+					err = call("delete", func() error {
+						attempts++
+						err := client.Collections.DeleteCollection(ctx, ids[title], opts...)
+						if attempts > 1 && isNotFound(err) {
+							return nil
+						}
+						return err
+					})
+					if err == nil {
+						delete(state, title)
+						delete(ids, title)
+					}
+				}
+				if err != nil {
+					t.Errorf("Worker %d: %v", w, err)
+					return
+				}
+
+				// Generated operations must ride out the faults with their own retries
+				if i%10 == 0 {
+					if _, err := client.Server.GetServerIdentity(ctx); err != nil {
+						t.Errorf("Worker %d: expected the identity request to be retried, got: %v", w, err)
+						return
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	t.Logf("%s, %d retries by workers", stats, retries.Load())
+	if stats.rateLimited.Load() == 0 || stats.serverErrors.Load() == 0 || stats.droppedRequests.Load() == 0 || stats.droppedResponses.Load() == 0 {
+		t.Errorf("Expected every kind of fault to be injected, got: %s", stats)
+	}
+
+	titles := mock.byTitle()
+	for w, state := range expected {
+		for title, items := range state {
+			collections := titles[title]
+			if len(collections) != 1 {
+				t.Errorf("Worker %d: expected one collection titled %s, got %d", w, title, len(collections))
+				continue
+			}
+			if strings.Join(collections[0].items, ",") != strings.Join(items, ",") {
+				t.Errorf("Worker %d: expected %s to hold %v, got %v", w, title, items, collections[0].items)
+			}
+			delete(titles, title)
+		}
+	}
+	for title := range titles {
+		t.Errorf("Expected %s to be deleted", title)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(values []string, value string) []string {
+	out := []string{}
+	for _, v := range values {
+		if v != value {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,164 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// sortTitleArticles are the leading articles stripped from sort titles, by language
+var sortTitleArticles = map[string][]string{
+	"en": {"the", "a", "an"},
+	"fr": {"le", "la", "les", "l'", "un", "une"},
+	"de": {"der", "die", "das", "ein", "eine"},
+	"es": {"el", "la", "los", "las", "un", "una"},
+	"it": {"il", "lo", "la", "i", "gli", "le", "l'", "un", "uno", "una"},
+	"pt": {"o", "a", "os", "as", "um", "uma"},
+	"nl": {"de", "het", "een"},
+	"sv": {"en", "ett"},
+}
+
+// SortTitleOptions configures how sort titles are normalized
+type SortTitleOptions struct {
+	// Language selects the articles to strip, e.g. "en" or "fr-FR". Defaults to the language of the
+	// library section.
+	Language string
+	// Romanize, if set, converts titles containing CJK characters to a Latin form to sort by, e.g.
+	// with a pinyin or Hepburn converter. Returning "" keeps the title as is.
+	Romanize func(title string, language string) string
+	// DryRun reports the changes without editing any items
+	DryRun bool
+}
+
+// SortTitleChange is a sort title that was (or with DryRun would be) changed
+type SortTitleChange struct {
+	Item Metadata
+	Old  string
+	New  string
+}
+
+// SortTitle returns the sort title of a title in a language: the title without its leading
+// article, e.g. "Matrix" for "The Matrix" or "Haine" for "La Haine". Languages without known
+// articles keep the title as is.
+func SortTitle(title string, language string) string {
+	language = strings.ToLower(language)
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+
+	trimmed := strings.TrimSpace(title)
+	lower := strings.ToLower(trimmed)
+	for _, article := range sortTitleArticles[language] {
+		// Elided articles (e.g. "L'Auberge") are followed directly by the word
+		if !strings.HasSuffix(article, "'") {
+			article += " "
+		}
+		if strings.HasPrefix(lower, article) && len(strings.TrimSpace(trimmed[len(article):])) > 0 {
+			return strings.TrimSpace(trimmed[len(article):])
+		}
+	}
+
+	return trimmed
+}
+
+// NormalizeSortTitles applies consistent sort titles to the top-level items of a library section
+// (movies, shows, artists or photo albums). Changed sort titles are locked so agent refreshes keep them.
+func (s *Library) NormalizeSortTitles(ctx context.Context, sectionID int, sortOptions SortTitleOptions, opts ...operations.Option) ([]SortTitleChange, error) {
+	items, err := s.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), nil, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting library items: %w", err)
+	}
+
+	return s.normalizeSortTitles(ctx, sectionID, items, sortOptions, opts...)
+}
+
+// NormalizeSortTitles applies consistent sort titles to the items of a collection, like
+// Library.NormalizeSortTitles
+func (s *Collections) NormalizeSortTitles(ctx context.Context, collectionID int, sortOptions SortTitleOptions, opts ...operations.Option) ([]SortTitleChange, error) {
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection: %w", err)
+	}
+
+	library := newLibrary(s.sdkConfiguration)
+
+	items, err := library.listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), nil, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection items: %w", err)
+	}
+
+	return library.normalizeSortTitles(ctx, collection.SectionID, items, sortOptions, opts...)
+}
+
+// normalizeSortTitles updates the sort titles of items in a section that differ from their normalized form
+func (s *Library) normalizeSortTitles(ctx context.Context, sectionID int, items []Metadata, sortOptions SortTitleOptions, opts ...operations.Option) ([]SortTitleChange, error) {
+	language := sortOptions.Language
+	if language == "" {
+		var err error
+		if language, err = s.getSectionLanguage(ctx, sectionID, opts...); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := []SortTitleChange{}
+	for _, item := range items {
+		title := item.Title
+		if sortOptions.Romanize != nil && containsCJK(title) {
+			if romanized := sortOptions.Romanize(title, language); romanized != "" {
+				title = romanized
+			}
+		}
+
+		current := item.TitleSort
+		if current == "" {
+			current = item.Title
+		}
+
+		if sortTitle := SortTitle(title, language); sortTitle != current {
+			changes = append(changes, SortTitleChange{Item: item, Old: current, New: sortTitle})
+		}
+	}
+
+	if sortOptions.DryRun {
+		return changes, nil
+	}
+
+	options := processOptions(opts)
+
+	reportProgress(options, 0, len(changes), "updating sort titles")
+	for i, change := range changes {
+		fields := map[string]string{
+			"titleSort.value":  change.New,
+			"titleSort.locked": "1",
+		}
+		if err := s.editMetadata(ctx, sectionID, change.Item, fields, "updateSortTitle", opts...); err != nil {
+			return changes[:i], fmt.Errorf("error updating the sort title of %s: %w", change.Item.Title, err)
+		}
+		reportProgress(options, i+1, len(changes), "updating sort titles")
+	}
+
+	return changes, nil
+}
+
+// getSectionLanguage returns the metadata language of a library section, e.g. "en-US"
+func (s *Library) getSectionLanguage(ctx context.Context, sectionID int, opts ...operations.Option) (string, error) {
+	section, err := s.getSection(ctx, sectionID, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	return section.Language, nil
+}
+
+// containsCJK returns true if the text contains Chinese, Japanese or Korean characters
+func containsCJK(text string) bool {
+	for _, r := range text {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSortTitle(t *testing.T) {
+	tests := []struct {
+		title, language, expected string
+	}{
+		{"The Matrix", "en-US", "Matrix"},
+		{"A Quiet Place", "en", "Quiet Place"},
+		{"La Haine", "fr", "Haine"},
+		{"L'Auberge espagnole", "fr-FR", "Auberge espagnole"},
+		{"Das Boot", "de", "Boot"},
+		{"The", "en", "The"},
+		{"Theodore Rex", "en", "Theodore Rex"},
+		{"The Matrix", "ja", "The Matrix"},
+	}
+
+	for _, tt := range tests {
+		if sortTitle := SortTitle(tt.title, tt.language); sortTitle != tt.expected {
+			t.Errorf("Expected %q for %q (%s), got: %q", tt.expected, tt.title, tt.language, sortTitle)
+		}
+	}
+}
+
+func TestNormalizeSortTitles(t *testing.T) {
+	var edited []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections":
+			w.Write([]byte(`{"MediaContainer":{"Directory":[{"key":"1","language":"en-US"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"101","type":"movie","title":"The Matrix","titleSort":"Matrix"},
+				{"ratingKey":"102","type":"movie","title":"A Quiet Place"},
+				{"ratingKey":"103","type":"movie","title":"千と千尋の神隠し"},
+				{"ratingKey":"104","type":"movie","title":"Heat"}
+			]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/library/sections/1/all":
+			if r.URL.Query().Get("type") != "1" || r.URL.Query().Get("titleSort.locked") != "1" {
+				t.Errorf("Expected type=1 and titleSort.locked=1, got: %s", r.URL.RawQuery)
+			}
+			edited = append(edited, r.URL.Query().Get("id")+"="+r.URL.Query().Get("titleSort.value"))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	sortOptions := SortTitleOptions{
+		DryRun: true,
+		Romanize: func(title string, language string) string {
+			return "Sen to Chihiro no Kamikakushi"
+		},
+	}
+
+	changes, err := client.Library.NormalizeSortTitles(context.Background(), 1, sortOptions)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(changes) != 2 || changes[0].New != "Quiet Place" || changes[1].New != "Sen to Chihiro no Kamikakushi" {
+		t.Fatalf("Unexpected changes: %+v", changes)
+	}
+	if len(edited) != 0 {
+		t.Fatalf("Expected no edits in a dry run, got: %v", edited)
+	}
+
+	sortOptions.DryRun = false
+	if _, err := client.Library.NormalizeSortTitles(context.Background(), 1, sortOptions); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(edited) != 2 || edited[0] != "102=Quiet Place" || edited[1] != "103=Sen to Chihiro no Kamikakushi" {
+		t.Errorf("Unexpected edits: %v", edited)
+	}
+}
@@ -0,0 +1,243 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// defaultSonicDistance is the largest sonic distance of the similar tracks a station starts from,
+// the default of the server's nearest tracks endpoint
+const defaultSonicDistance = 0.25
+
+// albumItemType and trackItemType are the library types of music tracks and albums
+const (
+	albumItemType = 9
+	trackItemType = 10
+)
+
+// Station is a playlist of tracks similar to a seed track, created by Playlists.CreateStationFrom
+type Station struct {
+	PlaylistID int        `json:"playlistId"`
+	Title      string     `json:"title"`
+	Seed       Metadata   `json:"seed"`
+	Tracks     []Metadata `json:"tracks"` // Tracks in play order, starting with the seed
+}
+
+// GetSonicallySimilar returns the tracks of a library that sound like a track, nearest first, based
+// on the server's sonic analysis. Tracks further than maxDistance (0 to 1, 0.25 if 0) are left out.
+// Sections without sonic analysis return no tracks.
+func (s *Library) GetSonicallySimilar(ctx context.Context, trackRatingKey int, limit int, maxDistance float64, opts ...operations.Option) ([]Metadata, error) {
+	if maxDistance <= 0 {
+		maxDistance = defaultSonicDistance
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("maxDistance", strconv.FormatFloat(maxDistance, 'f', -1, 64))
+	if limit > 0 {
+		queryParams.Add("limit", strconv.Itoa(limit))
+	}
+
+	return s.listMetadata(ctx, fmt.Sprintf("/library/metadata/%d/nearest", trackRatingKey), queryParams, "getSonicallySimilar", opts...)
+}
+
+// GetStationTracks picks up to size tracks for a station seeded by a track, the way Plexamp builds
+// track radio: the seed, then sonically similar tracks, then tracks sharing the seed's moods, then
+// tracks from albums sharing the seed album's styles. No artist fills more than a quarter of the
+// station, so it doesn't turn into the seed artist's discography.
+func (s *Playlists) GetStationTracks(ctx context.Context, trackRatingKey int, size int, opts ...operations.Option) ([]Metadata, error) {
+	if size < 2 {
+		return nil, fmt.Errorf("station size must be at least 2")
+	}
+
+	library := newLibrary(s.sdkConfiguration)
+
+	seed, err := library.GetItem(ctx, trackRatingKey, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting seed track: %w", err)
+	}
+	if seed.Type != "track" {
+		return nil, fmt.Errorf("item %d is a %s, not a track", trackRatingKey, seed.Type)
+	}
+
+	picker := newStationPicker(size)
+	picker.add(*seed)
+
+	// Each source is only read if the ones before it didn't fill the station
+	sources := []func() ([]Metadata, error){
+		func() ([]Metadata, error) {
+			return library.GetSonicallySimilar(ctx, trackRatingKey, size*2, 0, opts...)
+		},
+		func() ([]Metadata, error) {
+			return s.itemsByTags(ctx, library, seed.SectionID, trackItemType, "mood", seed.Mood, opts...)
+		},
+		func() ([]Metadata, error) {
+			return s.tracksOfStyledAlbums(ctx, library, seed, size, opts...)
+		},
+	}
+
+	for _, source := range sources {
+		if picker.full() {
+			break
+		}
+
+		candidates, err := source()
+		if err != nil {
+			return nil, err
+		}
+		for _, candidate := range candidates {
+			if picker.full() {
+				break
+			}
+			picker.add(candidate)
+		}
+	}
+
+	return picker.tracks, nil
+}
+
+// CreateStationFrom creates a playlist of up to size tracks similar to a seed track, titled after
+// it, e.g. "Teardrop Radio". See GetStationTracks for how tracks are picked.
+func (s *Playlists) CreateStationFrom(ctx context.Context, trackRatingKey int, size int, opts ...operations.Option) (*Station, error) {
+	tracks, err := s.GetStationTracks(ctx, trackRatingKey, size, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(tracks) < 2 {
+		return nil, fmt.Errorf("no tracks similar to %q found", tracks[0].Title)
+	}
+
+	identity, err := newServer(s.sdkConfiguration).GetIdentity(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server identity: %w", err)
+	}
+
+	ratingKeys := make([]string, 0, len(tracks))
+	for _, track := range tracks {
+		ratingKeys = append(ratingKeys, track.RatingKey)
+	}
+
+	station := &Station{Title: tracks[0].Title + " Radio", Seed: tracks[0], Tracks: tracks}
+
+	res, err := s.CreatePlaylist(ctx, operations.CreatePlaylistRequest{
+		Title: station.Title,
+		Type:  operations.CreatePlaylistQueryParamTypeAudio,
+		Smart: operations.SmartZero,
+		URI:   fmt.Sprintf("%s/library/metadata/%s", s.sdkConfiguration.GetURIRoot(identity.MachineIdentifier), strings.Join(ratingKeys, ",")),
+	}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating playlist: %w", err)
+	}
+
+	if res.Object == nil || res.Object.MediaContainer == nil || len(res.Object.MediaContainer.Metadata) == 0 || res.Object.MediaContainer.Metadata[0].RatingKey == nil {
+		return nil, fmt.Errorf("server did not return the created playlist")
+	}
+	station.PlaylistID, err = strconv.Atoi(*res.Object.MediaContainer.Metadata[0].RatingKey)
+	if err != nil {
+		return nil, fmt.Errorf("error converting playlist ID to int: %w", err)
+	}
+
+	return station, nil
+}
+
+// itemsByTags returns the items of a section of the given type with any of the tags
+func (s *Playlists) itemsByTags(ctx context.Context, library *Library, sectionID int, itemType MetadataType, field string, tags []Tag, opts ...operations.Option) ([]Metadata, error) {
+	ids := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag.ID > 0 {
+			ids = append(ids, strconv.FormatInt(tag.ID, 10))
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("type", strconv.Itoa(int(itemType)))
+	queryParams.Add(field, strings.Join(ids, ","))
+
+	items, err := library.listMetadata(ctx, fmt.Sprintf("/library/sections/%d/all", sectionID), queryParams, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting items by %s: %w", field, err)
+	}
+	return items, nil
+}
+
+// tracksOfStyledAlbums returns the tracks of albums sharing the styles of the seed's album, reading
+// albums until there are enough tracks
+func (s *Playlists) tracksOfStyledAlbums(ctx context.Context, library *Library, seed *Metadata, size int, opts ...operations.Option) ([]Metadata, error) {
+	albumKey, err := strconv.Atoi(seed.ParentRatingKey)
+	if err != nil {
+		return nil, nil
+	}
+
+	album, err := library.GetItem(ctx, albumKey, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting seed album: %w", err)
+	}
+
+	albums, err := s.itemsByTags(ctx, library, seed.SectionID, albumItemType, "style", album.Style, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := []Metadata{}
+	for _, candidate := range albums {
+		if len(tracks) >= size*2 {
+			break
+		}
+		if candidate.RatingKey == album.RatingKey {
+			continue
+		}
+
+		ratingKey, err := strconv.Atoi(candidate.RatingKey)
+		if err != nil {
+			return nil, fmt.Errorf("error converting album ID to int: %w", err)
+		}
+		children, err := library.GetChildren(ctx, ratingKey, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tracks of %s: %w", candidate.Title, err)
+		}
+		tracks = append(tracks, children...)
+	}
+	return tracks, nil
+}
+
+// stationPicker collects station tracks, skipping duplicates and artists that already fill their share
+type stationPicker struct {
+	size         int
+	maxPerArtist int
+	tracks       []Metadata
+	picked       map[string]bool
+	perArtist    map[string]int
+}
+
+func newStationPicker(size int) *stationPicker {
+	return &stationPicker{
+		size:         size,
+		maxPerArtist: (size + 3) / 4,
+		picked:       map[string]bool{},
+		perArtist:    map[string]int{},
+	}
+}
+
+func (p *stationPicker) full() bool {
+	return len(p.tracks) >= p.size
+}
+
+func (p *stationPicker) add(track Metadata) {
+	if track.Type != "" && track.Type != "track" {
+		return
+	}
+	if p.picked[track.RatingKey] || p.perArtist[track.GrandparentRatingKey] >= p.maxPerArtist {
+		return
+	}
+
+	p.picked[track.RatingKey] = true
+	p.perArtist[track.GrandparentRatingKey]++
+	p.tracks = append(p.tracks, track)
+}
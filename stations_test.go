@@ -0,0 +1,119 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateStationFrom(t *testing.T) {
+	var createdURI, createdTitle string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/library/metadata/100":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"100","type":"track","title":"Teardrop","librarySectionID":5,
+				"parentRatingKey":"50","grandparentRatingKey":"1","Mood":[{"id":7,"tag":"Melancholy"}]}]}}`))
+		case r.URL.Path == "/library/metadata/100/nearest":
+			if query.Get("limit") != "16" || query.Get("maxDistance") != "0.25" {
+				t.Errorf("Unexpected nearest query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"101","type":"track","grandparentRatingKey":"1"},
+				{"ratingKey":"102","type":"track","grandparentRatingKey":"1"},
+				{"ratingKey":"103","type":"track","grandparentRatingKey":"2"}
+			]}}`))
+		case r.URL.Path == "/library/sections/5/all" && query.Get("type") == "10":
+			if query.Get("mood") != "7" {
+				t.Errorf("Unexpected mood query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"104","type":"track","grandparentRatingKey":"3"},
+				{"ratingKey":"103","type":"track","grandparentRatingKey":"2"},
+				{"ratingKey":"105","type":"track","grandparentRatingKey":"2"}
+			]}}`))
+		case r.URL.Path == "/library/metadata/50":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"50","type":"album","Style":[{"id":30,"tag":"Trip Hop"}]}]}}`))
+		case r.URL.Path == "/library/sections/5/all" && query.Get("type") == "9":
+			if query.Get("style") != "30" {
+				t.Errorf("Unexpected style query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"50","type":"album"},{"ratingKey":"60","type":"album"}]}}`))
+		case r.URL.Path == "/library/metadata/60/children":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"106","type":"track","grandparentRatingKey":"4"},
+				{"ratingKey":"107","type":"track","grandparentRatingKey":"4"},
+				{"ratingKey":"108","type":"track","grandparentRatingKey":"4"}
+			]}}`))
+		case r.URL.Path == "/identity":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"claimed":true,"machineIdentifier":"abc123","version":"1.40.0"}}`))
+		case r.Method == "POST" && r.URL.Path == "/playlists":
+			createdURI = query.Get("uri")
+			createdTitle = query.Get("title")
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"900","title":"Teardrop Radio","playlistType":"audio"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	station, err := client.Playlists.CreateStationFrom(context.Background(), 100, 8)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// At most 2 of the 8 tracks per artist
+	keys := make([]string, 0, len(station.Tracks))
+	for _, track := range station.Tracks {
+		keys = append(keys, track.RatingKey)
+	}
+	if strings.Join(keys, ",") != "100,101,103,104,105,106,107" {
+		t.Errorf("Unexpected station tracks: %v", keys)
+	}
+
+	if station.PlaylistID != 900 || station.Title != "Teardrop Radio" || createdTitle != "Teardrop Radio" {
+		t.Errorf("Unexpected station: %+v", station)
+	}
+	if createdURI != "server://abc123/com.plexapp.plugins.library/library/metadata/100,101,103,104,105,106,107" {
+		t.Errorf("Unexpected playlist URI: %s", createdURI)
+	}
+
+	if _, err := client.Playlists.CreateStationFrom(context.Background(), 100, 1); err == nil {
+		t.Errorf("Expected an error for a station of one track")
+	}
+}
+
+func TestGetStationTracksFilledBySonicMatches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/metadata/100":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[{"ratingKey":"100","type":"track","grandparentRatingKey":"1","Mood":[{"id":7,"tag":"Melancholy"}]}]}}`))
+		case "/library/metadata/100/nearest":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"101","type":"track","grandparentRatingKey":"2"},
+				{"ratingKey":"102","type":"track","grandparentRatingKey":"3"},
+				{"ratingKey":"103","type":"track","grandparentRatingKey":"4"}
+			]}}`))
+		default:
+			// Moods and styles aren't needed once the sonic matches fill the station
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	tracks, err := New(WithServerURL(server.URL)).Playlists.GetStationTracks(context.Background(), 100, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(tracks) != 3 || tracks[2].RatingKey != "102" {
+		t.Errorf("Unexpected tracks: %+v", tracks)
+	}
+}
@@ -0,0 +1,41 @@
+package plexgo
+
+import (
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+)
+
+// OperationStats counts the usage of a single operation
+type OperationStats = hooks.OperationStats
+
+// Stats is a snapshot of the usage of a client, for exposing SDK usage in health endpoints or
+// enforcing quotas. It includes the requests made by helpers and by services derived from the client.
+type Stats struct {
+	Operations map[string]OperationStats // Usage by operation ID, e.g. "getLibraryItems"
+	Since      time.Time                 // When the client was created or its stats were last reset
+}
+
+// Total returns the usage summed over all operations
+func (s Stats) Total() OperationStats {
+	var total OperationStats
+	for _, operation := range s.Operations {
+		total.Requests += operation.Requests
+		total.Retries += operation.Retries
+		total.Errors += operation.Errors
+		total.BytesDownloaded += operation.BytesDownloaded
+	}
+	return total
+}
+
+// Stats returns the requests, retries, errors and downloaded bytes counted by the client since it
+// was created or ResetStats was last called
+func (s *PlexAPI) Stats() Stats {
+	operations, since := s.sdkConfiguration.Hooks.Stats()
+	return Stats{Operations: operations, Since: since}
+}
+
+// ResetStats clears the counts returned by Stats, e.g. at the start of each reporting period
+func (s *PlexAPI) ResetStats() {
+	s.sdkConfiguration.Hooks.ResetStats()
+}
@@ -0,0 +1,102 @@
+package plexgo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/retry"
+)
+
+func TestStats(t *testing.T) {
+	const body = `{"MediaContainer":{"size":0}}`
+	activityAttempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/activities":
+			activityAttempts++
+			if activityAttempts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(body))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(body))
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithRetryConfig(retry.Config{
+		Strategy: "backoff",
+		Backoff:  &retry.BackoffStrategy{InitialInterval: 1, MaxInterval: 2, Exponent: 1, MaxElapsedTime: 1000},
+	}))
+	created := client.Stats().Since
+
+	res, err := client.Activities.GetServerActivities(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	io.ReadAll(res.RawResponse.Body)
+
+	if _, err := client.Collections.GetCollection(context.Background(), 7); err == nil {
+		t.Fatal("Expected an error for the missing collection")
+	}
+
+	stats := client.Stats()
+	activities := stats.Operations["getServerActivities"]
+	if activities.Requests != 2 || activities.Retries != 1 || activities.Errors != 0 || activities.BytesDownloaded != int64(len(body)) {
+		t.Errorf("Unexpected activities stats: %+v", activities)
+	}
+	collection := stats.Operations["getCollection"]
+	if collection.Requests != 1 || collection.Retries != 0 || collection.Errors != 1 || collection.BytesDownloaded != int64(len(body)) {
+		t.Errorf("Unexpected collection stats: %+v", collection)
+	}
+	if total := stats.Total(); total.Requests != 3 || total.Retries != 1 || total.Errors != 1 || total.BytesDownloaded != int64(2*len(body)) {
+		t.Errorf("Unexpected total stats: %+v", total)
+	}
+
+	client.ResetStats()
+	stats = client.Stats()
+	if len(stats.Operations) != 0 || stats.Total().Requests != 0 {
+		t.Errorf("Expected the stats to be reset, got: %+v", stats)
+	}
+	if stats.Since.Before(created) {
+		t.Errorf("Expected the reset time %s not to be before the creation time %s", stats.Since, created)
+	}
+}
+
+func TestStatsIdempotentRetry(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Action","type":"collection"}]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithRetryConfig(retry.Config{
+		Strategy: "backoff",
+		Backoff:  &retry.BackoffStrategy{InitialInterval: 1, MaxInterval: 2, Exponent: 1, MaxElapsedTime: 1000},
+	}))
+
+	check := WithIdempotency(func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	_, err := client.Collections.CreateCollection(context.Background(), 1, "Action", nil, check, WithWaitForActivity(time.Second))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if stats := client.Stats().Operations["createCollection"]; stats.Requests != 2 || stats.Retries != 1 {
+		t.Errorf("Expected the second attempt to count as a retry, got: %+v", stats)
+	}
+}
@@ -0,0 +1,171 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// StorageBucket aggregates the number of files and their total size for a group of media
+type StorageBucket struct {
+	Count int   `json:"count"`
+	Size  int64 `json:"size"` // Total size in bytes
+}
+
+// BitrateBucket is a bitrate histogram bucket covering MinKbps up to (but excluding) MaxKbps.
+// A MaxKbps of 0 means the bucket is unbounded.
+type BitrateBucket struct {
+	MinKbps int   `json:"minKbps"`
+	MaxKbps int   `json:"maxKbps"`
+	Count   int   `json:"count"`
+	Size    int64 `json:"size"`
+}
+
+// ItemStorage summarizes the files of a single library item
+type ItemStorage struct {
+	RatingKey       string `json:"ratingKey"`
+	Title           string `json:"title"`
+	Type            string `json:"type"`
+	Files           int    `json:"files"`
+	Size            int64  `json:"size"`    // Total size of all files in bytes
+	Bitrate         int    `json:"bitrate"` // Highest bitrate of the item's versions in kbps
+	VideoCodec      string `json:"videoCodec,omitempty"`
+	VideoResolution string `json:"videoResolution,omitempty"`
+}
+
+// StorageReport aggregates file sizes, codecs, resolutions and bitrates of a library section
+type StorageReport struct {
+	SectionID        int                      `json:"sectionID"`
+	ItemCount        int                      `json:"itemCount"`
+	FileCount        int                      `json:"fileCount"`
+	TotalSize        int64                    `json:"totalSize"` // Total size in bytes
+	ByVideoCodec     map[string]StorageBucket `json:"byVideoCodec"`
+	ByAudioCodec     map[string]StorageBucket `json:"byAudioCodec"`
+	ByResolution     map[string]StorageBucket `json:"byResolution"`
+	ByContainer      map[string]StorageBucket `json:"byContainer"`
+	BitrateHistogram []BitrateBucket          `json:"bitrateHistogram"`
+	Items            []ItemStorage            `json:"items"` // Sorted by size, largest first
+}
+
+// storageBitrateEdges are the lower bounds (in kbps) of the bitrate histogram buckets
+var storageBitrateEdges = []int{0, 2000, 5000, 10000, 20000, 40000}
+
+// GetStorageReport aggregates the file sizes, codecs, resolutions and bitrates of all media in a
+// library section. For show and music sections the episodes and tracks are inspected, since those
+// are the items that hold files.
+func (s *Library) GetStorageReport(ctx context.Context, sectionID int, opts ...operations.Option) (*StorageReport, error) {
+	items, err := s.getLeafItems(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &StorageReport{
+		SectionID:    sectionID,
+		ItemCount:    len(items),
+		ByVideoCodec: map[string]StorageBucket{},
+		ByAudioCodec: map[string]StorageBucket{},
+		ByResolution: map[string]StorageBucket{},
+		ByContainer:  map[string]StorageBucket{},
+		Items:        make([]ItemStorage, 0, len(items)),
+	}
+
+	for i, edge := range storageBitrateEdges {
+		bucket := BitrateBucket{MinKbps: edge}
+		if i+1 < len(storageBitrateEdges) {
+			bucket.MaxKbps = storageBitrateEdges[i+1]
+		}
+		report.BitrateHistogram = append(report.BitrateHistogram, bucket)
+	}
+
+	for _, item := range items {
+		itemStorage := ItemStorage{
+			RatingKey: item.RatingKey,
+			Title:     item.Title,
+			Type:      item.Type,
+		}
+
+		for _, media := range item.Media {
+			var size int64
+			for _, part := range media.Part {
+				size += part.Size
+			}
+			files := len(media.Part)
+
+			itemStorage.Files += files
+			itemStorage.Size += size
+			if media.Bitrate >= itemStorage.Bitrate {
+				itemStorage.Bitrate = media.Bitrate
+				itemStorage.VideoCodec = media.VideoCodec
+				itemStorage.VideoResolution = media.VideoResolution
+			}
+
+			addToStorageBucket(report.ByVideoCodec, media.VideoCodec, files, size)
+			addToStorageBucket(report.ByAudioCodec, media.AudioCodec, files, size)
+			addToStorageBucket(report.ByResolution, media.VideoResolution, files, size)
+			addToStorageBucket(report.ByContainer, media.Container, files, size)
+
+			for i := len(report.BitrateHistogram) - 1; i >= 0; i-- {
+				if media.Bitrate >= report.BitrateHistogram[i].MinKbps {
+					report.BitrateHistogram[i].Count += files
+					report.BitrateHistogram[i].Size += size
+					break
+				}
+			}
+		}
+
+		report.FileCount += itemStorage.Files
+		report.TotalSize += itemStorage.Size
+		report.Items = append(report.Items, itemStorage)
+	}
+
+	sort.SliceStable(report.Items, func(i, j int) bool {
+		return report.Items[i].Size > report.Items[j].Size
+	})
+
+	return report, nil
+}
+
+// addToStorageBucket adds files to the bucket for key, using "unknown" for media missing the attribute
+func addToStorageBucket(buckets map[string]StorageBucket, key string, files int, size int64) {
+	if key == "" {
+		key = "unknown"
+	}
+	bucket := buckets[key]
+	bucket.Count += files
+	bucket.Size += size
+	buckets[key] = bucket
+}
+
+// getLeafItems gets the items of a library section that hold media files. Show and music sections
+// list shows and artists by default, so their episodes and tracks are requested instead.
+func (s *Library) getLeafItems(ctx context.Context, sectionID int, opts ...operations.Option) ([]Metadata, error) {
+	path := fmt.Sprintf("/library/sections/%d/all", sectionID)
+
+	items, err := s.listMetadata(ctx, path, nil, "getLibraryItems", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	var leafType MetadataType
+	switch collectionItemFamily(collectionItemTypeFromName(items[0].Type)) {
+	case CollectionItemTypeShow:
+		leafType = CollectionItemTypeEpisode
+	case CollectionItemTypeArtist:
+		leafType = CollectionItemTypeTrack
+	default:
+		return items, nil
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("type", strconv.Itoa(int(leafType)))
+
+	return s.listMetadata(ctx, path, queryParams, "getLibraryItems", opts...)
+}
@@ -0,0 +1,69 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetStorageReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/sections/2/all" || r.Method != "GET" {
+			t.Errorf("Expected GET /library/sections/2/all, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		// Show sections are reported on their episodes
+		if r.URL.Query().Get("type") != "4" {
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","title":"Severance","type":"show"}]}}`))
+			return
+		}
+
+		w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+			{"ratingKey":"11","title":"Good News About Hell","type":"episode","Media":[
+				{"id":1,"bitrate":25000,"videoCodec":"hevc","audioCodec":"eac3","videoResolution":"4k","container":"mkv",
+				 "Part":[{"id":1,"size":4000000000}]},
+				{"id":2,"bitrate":4000,"videoCodec":"h264","audioCodec":"aac","videoResolution":"1080","container":"mp4",
+				 "Part":[{"id":2,"size":1000000000}]}
+			]},
+			{"ratingKey":"12","title":"Half Loop","type":"episode","Media":[
+				{"id":3,"bitrate":1500,"videoCodec":"h264","audioCodec":"aac","videoResolution":"720","container":"mp4",
+				 "Part":[{"id":3,"size":500000000}]}
+			]}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	report, err := client.Library.GetStorageReport(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if report.ItemCount != 2 || report.FileCount != 3 || report.TotalSize != 5500000000 {
+		t.Errorf("Unexpected totals: items=%d files=%d size=%d", report.ItemCount, report.FileCount, report.TotalSize)
+	}
+
+	if h264 := report.ByVideoCodec["h264"]; h264.Count != 2 || h264.Size != 1500000000 {
+		t.Errorf("Unexpected h264 bucket: %+v", h264)
+	}
+
+	if uhd := report.ByResolution["4k"]; uhd.Count != 1 {
+		t.Errorf("Expected one 4k file, got: %+v", uhd)
+	}
+
+	// 1500 kbps falls in the first bucket, 4000 in the second and 25000 in the fifth
+	expected := []int{1, 1, 0, 0, 1, 0}
+	for i, bucket := range report.BitrateHistogram {
+		if bucket.Count != expected[i] {
+			t.Errorf("Expected %d files in bitrate bucket %d-%d, got: %d", expected[i], bucket.MinKbps, bucket.MaxKbps, bucket.Count)
+		}
+	}
+
+	if report.Items[0].RatingKey != "11" || report.Items[0].VideoResolution != "4k" {
+		t.Errorf("Expected the largest item first with its best version, got: %+v", report.Items[0])
+	}
+}
@@ -0,0 +1,241 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// Statistics timespans, the granularity of the buckets returned by the statistics endpoints
+const (
+	statisticsTimespanHours = 4
+	statisticsTimespanDays  = 3
+)
+
+// metadataBatchSize is the number of items requested at once when looking items up by rating key
+const metadataBatchSize = 50
+
+// transcodeBitrateRatio is the share of the source bitrate below which a user's delivered bitrate
+// suggests their streams are transcoded
+var transcodeBitrateRatio = 0.8
+
+// StreamQuality summarizes how a user streamed over a time window
+type StreamQuality struct {
+	AccountID     int
+	User          string
+	Plays         int           // Plays recorded in the watch history
+	WatchTime     time.Duration // Playback time recorded in the media statistics
+	LANBytes      int64
+	WANBytes      int64
+	SourceKbps    int // Average bitrate of the played media
+	DeliveredKbps int // Average bitrate the server sent, from the bandwidth statistics and watch time
+
+	// LikelyTranscoding is set when the delivered bitrate is well below the source bitrate. Plex
+	// doesn't record past transcode decisions, so it is an estimate.
+	LikelyTranscoding bool
+
+	// Decisions of the user's current sessions
+	ActiveDirectPlays int
+	ActiveTranscodes  int
+}
+
+// StreamQualityReport reports who direct plays and who transcodes, and at what bitrates, for
+// server sizing decisions
+type StreamQualityReport struct {
+	Since time.Time
+	Users []StreamQuality // By WAN then LAN bytes, largest first
+}
+
+// GetStreamQualityReport correlates the watch history, media and bandwidth statistics and the
+// active sessions of the last window into a per-user report of playback quality. Users whose
+// delivered bitrate is well below the bitrate of what they played are flagged as likely
+// transcoding; the transcode decisions of current sessions are reported exactly.
+func (s *Statistics) GetStreamQualityReport(ctx context.Context, window time.Duration, opts ...operations.Option) (*StreamQualityReport, error) {
+	since := time.Now().Add(-window)
+	library := newLibrary(s.sdkConfiguration)
+
+	timespan := statisticsTimespanHours
+	if window > 7*24*time.Hour {
+		timespan = statisticsTimespanDays
+	}
+	statsParams := url.Values{}
+	statsParams.Add("timespan", strconv.Itoa(timespan))
+
+	type account struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	var bandwidth struct {
+		MediaContainer struct {
+			Account             []account `json:"Account"`
+			StatisticsBandwidth []struct {
+				AccountID int   `json:"accountID"`
+				At        int64 `json:"at"`
+				Lan       bool  `json:"lan"`
+				Bytes     int64 `json:"bytes"`
+			} `json:"StatisticsBandwidth"`
+		} `json:"MediaContainer"`
+	}
+	if err := library.getJSON(ctx, "/statistics/bandwidth", statsParams, "getBandwidthStatistics", &bandwidth, opts...); err != nil {
+		return nil, fmt.Errorf("error getting bandwidth statistics: %w", err)
+	}
+
+	var media struct {
+		MediaContainer struct {
+			Account         []account `json:"Account"`
+			StatisticsMedia []struct {
+				AccountID int   `json:"accountID"`
+				At        int64 `json:"at"`
+				Duration  int64 `json:"duration"` // In seconds
+			} `json:"StatisticsMedia"`
+		} `json:"MediaContainer"`
+	}
+	if err := library.getJSON(ctx, "/statistics/media", statsParams, "getStatistics", &media, opts...); err != nil {
+		return nil, fmt.Errorf("error getting media statistics: %w", err)
+	}
+
+	var history struct {
+		MediaContainer struct {
+			Metadata []struct {
+				RatingKey string `json:"ratingKey"`
+				AccountID int    `json:"accountID"`
+			} `json:"Metadata"`
+		} `json:"MediaContainer"`
+	}
+	historyParams := url.Values{}
+	historyParams.Add("viewedAt>", strconv.FormatInt(since.Unix(), 10))
+	if err := library.getJSON(ctx, "/status/sessions/history/all", historyParams, "getSessionHistory", &history, opts...); err != nil {
+		return nil, fmt.Errorf("error getting watch history: %w", err)
+	}
+
+	sessions, err := newSessions(s.sdkConfiguration).GetActiveSessions(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sessions: %w", err)
+	}
+
+	users := map[int]*StreamQuality{}
+	user := func(accountID int) *StreamQuality {
+		if users[accountID] == nil {
+			users[accountID] = &StreamQuality{AccountID: accountID}
+		}
+		return users[accountID]
+	}
+
+	for _, a := range append(bandwidth.MediaContainer.Account, media.MediaContainer.Account...) {
+		user(a.ID).User = a.Name
+	}
+
+	for _, entry := range bandwidth.MediaContainer.StatisticsBandwidth {
+		if entry.At < since.Unix() {
+			continue
+		}
+		if entry.Lan {
+			user(entry.AccountID).LANBytes += entry.Bytes
+		} else {
+			user(entry.AccountID).WANBytes += entry.Bytes
+		}
+	}
+
+	for _, entry := range media.MediaContainer.StatisticsMedia {
+		if entry.At < since.Unix() {
+			continue
+		}
+		user(entry.AccountID).WatchTime += time.Duration(entry.Duration) * time.Second
+	}
+
+	ratingKeys := []string{}
+	seen := map[string]bool{}
+	for _, entry := range history.MediaContainer.Metadata {
+		user(entry.AccountID).Plays++
+		if !seen[entry.RatingKey] {
+			seen[entry.RatingKey] = true
+			ratingKeys = append(ratingKeys, entry.RatingKey)
+		}
+	}
+
+	bitrates, err := library.getBitrates(ctx, ratingKeys, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceTotals := map[int]int{}
+	sourceCounts := map[int]int{}
+	for _, entry := range history.MediaContainer.Metadata {
+		if bitrate, ok := bitrates[entry.RatingKey]; ok {
+			sourceTotals[entry.AccountID] += bitrate
+			sourceCounts[entry.AccountID]++
+		}
+	}
+
+	for _, session := range sessions {
+		accountID, err := strconv.Atoi(session.UserID)
+		if err != nil {
+			continue
+		}
+		if session.Transcoding() {
+			user(accountID).ActiveTranscodes++
+		} else {
+			user(accountID).ActiveDirectPlays++
+		}
+	}
+
+	report := &StreamQualityReport{Since: since, Users: []StreamQuality{}}
+	for accountID, quality := range users {
+		if sourceCounts[accountID] > 0 {
+			quality.SourceKbps = sourceTotals[accountID] / sourceCounts[accountID]
+		}
+		if seconds := quality.WatchTime.Seconds(); seconds > 0 {
+			quality.DeliveredKbps = int(float64(quality.LANBytes+quality.WANBytes) * 8 / 1000 / seconds)
+		}
+		quality.LikelyTranscoding = quality.SourceKbps > 0 && quality.DeliveredKbps > 0 &&
+			float64(quality.DeliveredKbps) < float64(quality.SourceKbps)*transcodeBitrateRatio
+
+		report.Users = append(report.Users, *quality)
+	}
+
+	sort.Slice(report.Users, func(i, j int) bool {
+		a, b := report.Users[i], report.Users[j]
+		if a.WANBytes != b.WANBytes {
+			return a.WANBytes > b.WANBytes
+		}
+		if a.LANBytes != b.LANBytes {
+			return a.LANBytes > b.LANBytes
+		}
+		return a.AccountID < b.AccountID
+	})
+
+	return report, nil
+}
+
+// getBitrates returns the bitrate of the first media version of each item, by rating key. Items
+// that no longer exist are left out.
+func (s *Library) getBitrates(ctx context.Context, ratingKeys []string, opts ...operations.Option) (map[string]int, error) {
+	bitrates := make(map[string]int, len(ratingKeys))
+
+	for start := 0; start < len(ratingKeys); start += metadataBatchSize {
+		end := start + metadataBatchSize
+		if end > len(ratingKeys) {
+			end = len(ratingKeys)
+		}
+
+		items, err := s.listMetadata(ctx, "/library/metadata/"+strings.Join(ratingKeys[start:end], ","), nil, "getMediaMetaData", opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting played items: %w", err)
+		}
+
+		for _, item := range items {
+			if len(item.Media) > 0 && item.Media[0].Bitrate > 0 {
+				bitrates[item.RatingKey] = item.Media[0].Bitrate
+			}
+		}
+	}
+
+	return bitrates, nil
+}
@@ -0,0 +1,73 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetStreamQualityReport(t *testing.T) {
+	now := time.Now().Unix()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/statistics/bandwidth":
+			if r.URL.Query().Get("timespan") != "4" {
+				t.Errorf("Expected hourly statistics, got: %s", r.URL.RawQuery)
+			}
+			// alice received 3.6 GB over an hour, 8000 kbps; bob 0.9 GB, 2000 kbps
+			fmt.Fprintf(w, `{"MediaContainer":{"Account":[{"id":1,"name":"alice"},{"id":2,"name":"bob"}],"StatisticsBandwidth":[
+				{"accountID":1,"at":%d,"lan":true,"bytes":3600000000},
+				{"accountID":2,"at":%d,"lan":false,"bytes":900000000},
+				{"accountID":2,"at":%d,"lan":false,"bytes":999999999}
+			]}}`, now, now, now-3*24*3600)
+		case "/statistics/media":
+			fmt.Fprintf(w, `{"MediaContainer":{"StatisticsMedia":[
+				{"accountID":1,"at":%d,"duration":3600},
+				{"accountID":2,"at":%d,"duration":3600}
+			]}}`, now, now)
+		case "/status/sessions/history/all":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"101","accountID":1},
+				{"ratingKey":"102","accountID":2}
+			]}}`))
+		case "/library/metadata/101,102":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"ratingKey":"101","Media":[{"bitrate":8000}]},
+				{"ratingKey":"102","Media":[{"bitrate":20000}]}
+			]}}`))
+		case "/status/sessions":
+			w.Write([]byte(`{"MediaContainer":{"Metadata":[
+				{"sessionKey":"1","User":{"id":"2","title":"bob"},"TranscodeSession":{"videoDecision":"transcode"}}
+			]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	report, err := client.Statistics.GetStreamQualityReport(context.Background(), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(report.Users) != 2 {
+		t.Fatalf("Expected 2 users, got: %+v", report.Users)
+	}
+
+	bob, alice := report.Users[0], report.Users[1]
+
+	if bob.User != "bob" || bob.WANBytes != 900000000 || bob.DeliveredKbps != 2000 || bob.SourceKbps != 20000 || !bob.LikelyTranscoding || bob.ActiveTranscodes != 1 {
+		t.Errorf("Unexpected report for bob: %+v", bob)
+	}
+	if alice.User != "alice" || alice.DeliveredKbps != 8000 || alice.LikelyTranscoding || alice.Plays != 1 || alice.WatchTime != time.Hour {
+		t.Errorf("Unexpected report for alice: %+v", alice)
+	}
+}
@@ -0,0 +1,229 @@
+package plexgo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/profiles"
+)
+
+// streamEndpoints are the universal transcoder endpoints of each protocol
+var streamEndpoints = map[string]string{
+	profiles.ProtocolHLS:  "/video/:/transcode/universal/start.m3u8",
+	profiles.ProtocolDASH: "/video/:/transcode/universal/start.mpd",
+	profiles.ProtocolHTTP: "/video/:/transcode/universal/start",
+}
+
+// SubtitleMode is how the transcoder delivers the selected subtitles
+type SubtitleMode string
+
+const (
+	SubtitlesAuto SubtitleMode = "auto" // Burn in only subtitles the client can't render
+	SubtitlesBurn SubtitleMode = "burn" // Always burn subtitles into the video
+	SubtitlesNone SubtitleMode = "none" // Leave subtitles out
+)
+
+// VideoQuality is a video quality the transcoder is tuned for, as offered by the Plex apps
+type VideoQuality struct {
+	Name            string // e.g. "8 Mbps 1080p"
+	MaxVideoBitrate int    // In kbps
+	VideoResolution string // Width x height, e.g. "1920x1080"
+}
+
+// VideoQualities are the qualities a stream can be limited to, highest first, e.g. for the
+// quality picker of a proxy app
+var VideoQualities = []VideoQuality{
+	{"40 Mbps 4K", 40000, "3840x2160"},
+	{"20 Mbps 1080p", 20000, "1920x1080"},
+	{"12 Mbps 1080p", 12000, "1920x1080"},
+	{"10 Mbps 1080p", 10000, "1920x1080"},
+	{"8 Mbps 1080p", 8000, "1920x1080"},
+	{"4 Mbps 720p", 4000, "1280x720"},
+	{"3 Mbps 720p", 3000, "1280x720"},
+	{"2 Mbps 720p", 2000, "1280x720"},
+	{"1.5 Mbps 480p", 1500, "720x480"},
+	{"720 kbps", 720, "576x320"},
+	{"320 kbps", 320, "420x240"},
+	{"208 kbps", 208, "284x160"},
+}
+
+// StreamOptions configures a stream URL built by Video.GetStreamURL. Zero values leave the
+// choice to the server.
+type StreamOptions struct {
+	Protocol   string // One of the profiles.Protocol constants; the profile's protocol or HLS if empty
+	MediaIndex int    // Version of the item to play
+	PartIndex  int    // Part of the version to play
+	Offset     time.Duration
+	Session    string            // Transcode session ID, generated if empty
+	Profile    *profiles.Profile // Client profile, e.g. profiles.Chrome()
+
+	// Quality overrides. MaxVideoBitrate and VideoResolution must match one of VideoQualities.
+	MaxVideoBitrate   int    // In kbps
+	VideoResolution   string // e.g. "1920x1080"
+	AutoAdjustQuality bool   // Let the server lower the quality when the connection slows down (HLS only)
+
+	Subtitles    SubtitleMode // SubtitlesBurn to burn subtitles in for players that can't render them
+	SubtitleSize int          // Size of burned in subtitles in percent, 100 if 0
+}
+
+// protocol returns the streaming protocol of the options
+func (o StreamOptions) protocol() string {
+	switch {
+	case o.Protocol != "":
+		return o.Protocol
+	case o.Profile != nil:
+		return o.Profile.Protocol
+	default:
+		return profiles.ProtocolHLS
+	}
+}
+
+// Validate checks the options against the values the transcoder knows
+func (o StreamOptions) Validate() error {
+	protocol := o.protocol()
+	if _, ok := streamEndpoints[protocol]; !ok {
+		return fmt.Errorf("invalid stream protocol %q", protocol)
+	}
+	if o.Profile != nil {
+		if err := o.Profile.Validate(); err != nil {
+			return fmt.Errorf("invalid client profile: %w", err)
+		}
+	}
+	if o.MediaIndex < 0 || o.PartIndex < 0 || o.Offset < 0 {
+		return fmt.Errorf("media index, part index and offset cannot be negative")
+	}
+
+	if o.MaxVideoBitrate != 0 && !knownVideoQuality(func(q VideoQuality) bool { return q.MaxVideoBitrate == o.MaxVideoBitrate }) {
+		return fmt.Errorf("invalid max video bitrate %d kbps", o.MaxVideoBitrate)
+	}
+	if o.VideoResolution != "" && !knownVideoQuality(func(q VideoQuality) bool { return q.VideoResolution == o.VideoResolution }) {
+		return fmt.Errorf("invalid video resolution %q", o.VideoResolution)
+	}
+	if o.AutoAdjustQuality && protocol != profiles.ProtocolHLS {
+		return fmt.Errorf("automatic quality adjustment requires HLS, not %s", protocol)
+	}
+
+	switch o.Subtitles {
+	case "", SubtitlesAuto, SubtitlesBurn, SubtitlesNone:
+	default:
+		return fmt.Errorf("invalid subtitle mode %q", o.Subtitles)
+	}
+	if o.SubtitleSize < 0 || o.SubtitleSize > 500 {
+		return fmt.Errorf("invalid subtitle size %d", o.SubtitleSize)
+	}
+	return nil
+}
+
+// knownVideoQuality returns true if any of VideoQualities matches
+func knownVideoQuality(match func(VideoQuality) bool) bool {
+	for _, quality := range VideoQualities {
+		if match(quality) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStreamURL builds a universal transcoder URL that plays an item with the given options, e.g.
+// for a proxy app to hand to a player. The URL carries the SDK's token, so it can be requested
+// without headers; treat it as a secret.
+func (s *Video) GetStreamURL(ctx context.Context, ratingKey int, streamOptions StreamOptions, opts ...operations.Option) (string, error) {
+	if err := streamOptions.Validate(); err != nil {
+		return "", err
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	protocol := streamOptions.protocol()
+	opURL, err := url.JoinPath(baseURL, streamEndpoints[protocol])
+	if err != nil {
+		return "", fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	if streamOptions.Profile != nil {
+		queryParams = streamOptions.Profile.Params()
+	}
+	queryParams.Set("protocol", protocol)
+	queryParams.Set("path", fmt.Sprintf("/library/metadata/%d", ratingKey))
+	queryParams.Set("mediaIndex", strconv.Itoa(streamOptions.MediaIndex))
+	queryParams.Set("partIndex", strconv.Itoa(streamOptions.PartIndex))
+	queryParams.Set("fastSeek", "1")
+	if streamOptions.Offset > 0 {
+		queryParams.Set("offset", strconv.FormatFloat(streamOptions.Offset.Seconds(), 'f', -1, 64))
+	}
+
+	session := streamOptions.Session
+	if session == "" {
+		session, err = newStreamSession()
+		if err != nil {
+			return "", err
+		}
+	}
+	queryParams.Set("session", session)
+	queryParams.Set("X-Plex-Session-Identifier", session)
+	queryParams.Set("X-Plex-Client-Identifier", session)
+
+	if streamOptions.MaxVideoBitrate > 0 {
+		queryParams.Set("maxVideoBitrate", strconv.Itoa(streamOptions.MaxVideoBitrate))
+	}
+	if streamOptions.VideoResolution != "" {
+		queryParams.Set("videoResolution", streamOptions.VideoResolution)
+	}
+	if streamOptions.AutoAdjustQuality {
+		queryParams.Set("autoAdjustQuality", "1")
+	}
+	if streamOptions.Subtitles != "" {
+		queryParams.Set("subtitles", string(streamOptions.Subtitles))
+	}
+	if streamOptions.SubtitleSize > 0 {
+		queryParams.Set("subtitleSize", strconv.Itoa(streamOptions.SubtitleSize))
+	}
+
+	token, err := s.streamToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	if token != "" {
+		queryParams.Set("X-Plex-Token", token)
+	}
+
+	return fmt.Sprintf("%s?%s", opURL, queryParams.Encode()), nil
+}
+
+// streamToken returns the token the SDK authenticates with, for URLs requested without headers
+func (s *Video) streamToken(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "http://localhost", nil)
+	if err != nil {
+		return "", err
+	}
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return "", err
+	}
+	return req.Header.Get("X-Plex-Token"), nil
+}
+
+// newStreamSession returns a random transcode session ID
+func newStreamSession() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating session ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,91 @@
+package plexgo
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/profiles"
+)
+
+func TestGetStreamURL(t *testing.T) {
+	client := New(WithServerURL("http://10.0.0.2:32400"), WithSecurity("secret"))
+	ctx := context.Background()
+
+	chrome := profiles.Chrome()
+	streamURL, err := client.Video.GetStreamURL(ctx, 23409, StreamOptions{
+		Profile:           &chrome,
+		Offset:            90 * time.Second,
+		Session:           "abc",
+		MaxVideoBitrate:   4000,
+		VideoResolution:   "1280x720",
+		AutoAdjustQuality: true,
+		Subtitles:         SubtitlesBurn,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	parsed, err := url.Parse(streamURL)
+	if err != nil {
+		t.Fatalf("Expected a valid URL, got: %v", err)
+	}
+	if parsed.Path != "/video/:/transcode/universal/start.m3u8" {
+		t.Errorf("Unexpected path: %s", parsed.Path)
+	}
+
+	query := parsed.Query()
+	want := map[string]string{
+		"path":              "/library/metadata/23409",
+		"protocol":          "hls",
+		"offset":            "90",
+		"session":           "abc",
+		"maxVideoBitrate":   "4000",
+		"videoResolution":   "1280x720",
+		"autoAdjustQuality": "1",
+		"subtitles":         "burn",
+		"X-Plex-Platform":   "Chrome",
+		"X-Plex-Token":      "secret",
+	}
+	for name, value := range want {
+		if query.Get(name) != value {
+			t.Errorf("Expected %s=%s, got: %q", name, value, query.Get(name))
+		}
+	}
+	if query.Get(profiles.HeaderProfileExtra) != chrome.Extra() {
+		t.Errorf("Expected the profile extra, got: %s", query.Get(profiles.HeaderProfileExtra))
+	}
+
+	// Sessions are generated when not set
+	first, _ := client.Video.GetStreamURL(ctx, 1, StreamOptions{Protocol: profiles.ProtocolDASH})
+	second, _ := client.Video.GetStreamURL(ctx, 1, StreamOptions{Protocol: profiles.ProtocolDASH})
+	if !strings.Contains(first, "start.mpd?") || first == second {
+		t.Errorf("Expected DASH URLs with distinct sessions, got: %s and %s", first, second)
+	}
+}
+
+func TestStreamOptionsValidate(t *testing.T) {
+	invalid := map[string]StreamOptions{
+		"protocol":    {Protocol: "rtsp"},
+		"bitrate":     {MaxVideoBitrate: 5000},
+		"resolution":  {VideoResolution: "1080p"},
+		"auto adjust": {Protocol: profiles.ProtocolHTTP, AutoAdjustQuality: true},
+		"subtitles":   {Subtitles: "always"},
+		"size":        {SubtitleSize: -1},
+		"offset":      {Offset: -time.Second},
+	}
+	for name, options := range invalid {
+		if err := options.Validate(); err == nil {
+			t.Errorf("Expected an error for an invalid %s", name)
+		}
+	}
+
+	for _, quality := range VideoQualities {
+		options := StreamOptions{MaxVideoBitrate: quality.MaxVideoBitrate, VideoResolution: quality.VideoResolution}
+		if err := options.Validate(); err != nil {
+			t.Errorf("Expected %s to be valid, got: %v", quality.Name, err)
+		}
+	}
+}
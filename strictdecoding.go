@@ -0,0 +1,193 @@
+package plexgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFieldsFunc receives the JSON fields of a response that the SDK's models don't have, as
+// paths such as "MediaContainer.Metadata[].newField"
+type UnknownFieldsFunc func(operationID string, fields []string)
+
+// UnknownFieldsError is returned with WithStrictDecoding when a response has fields the SDK's
+// models don't have
+type UnknownFieldsError struct {
+	OperationID string
+	Fields      []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("%s: unknown fields in response: %s", e.OperationID, strings.Join(e.Fields, ", "))
+}
+
+// WithStrictDecoding makes operations fail with an *UnknownFieldsError when a response has fields
+// the SDK's response models don't have, for code built against a pinned server version. By default
+// unknown fields are ignored. Strict decoding applies to the generated operations; the helpers that
+// only read the fields they need, such as the Collections service, always ignore unknown fields.
+func WithStrictDecoding() SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.StrictDecoding = true
+	}
+}
+
+// WithUnknownFieldsHandler reports the unknown fields of each response to fn, with or without
+// strict decoding, e.g. to find data the SDK's models are missing
+func WithUnknownFieldsHandler(fn UnknownFieldsFunc) SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.UnknownFieldsHandler = fn
+	}
+}
+
+// unmarshalResponse decodes the JSON body of a successful response into out, checking it for
+// unknown fields if strict decoding or an unknown fields handler is enabled, and for missing
+// fields if response validation is
+func (c *sdkConfiguration) unmarshalResponse(operationID string, rawBody []byte, out interface{}) error {
+	if c.StrictDecoding || c.UnknownFieldsHandler != nil {
+		if fields := unknownJSONFields(rawBody, reflect.TypeOf(out)); len(fields) > 0 {
+			if c.UnknownFieldsHandler != nil {
+				c.UnknownFieldsHandler(operationID, fields)
+			}
+			if c.StrictDecoding {
+				return &UnknownFieldsError{OperationID: operationID, Fields: fields}
+			}
+		}
+	}
+
+	return c.decodeResponse(operationID, rawBody, out)
+}
+
+// unknownJSONFields returns the paths of the fields in a JSON document that typ has no field for
+func unknownJSONFields(data []byte, typ reflect.Type) []string {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	collectUnknownFields(value, typ, "", seen)
+
+	fields := make([]string, 0, len(seen))
+	for field := range seen {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+// collectUnknownFields walks a decoded JSON value alongside the type it is decoded into
+func collectUnknownFields(value interface{}, typ reflect.Type, path string, seen map[string]bool) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		switch typ.Kind() {
+		case reflect.Map:
+			for key, item := range v {
+				collectUnknownFields(item, typ.Elem(), joinFieldPath(path, key), seen)
+			}
+		case reflect.Struct:
+			fields, open := jsonFields(typ)
+			if fields == nil {
+				// Unions and other types that decode objects themselves
+				return
+			}
+
+			for key, item := range v {
+				fieldType, ok := fields[key]
+				if !ok {
+					fieldType, ok = fields[strings.ToLower(key)]
+				}
+
+				switch {
+				case ok:
+					collectUnknownFields(item, fieldType, joinFieldPath(path, key), seen)
+				case !open:
+					seen[joinFieldPath(path, key)] = true
+				}
+			}
+		}
+	case []interface{}:
+		if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+			for _, item := range v {
+				collectUnknownFields(item, typ.Elem(), path+"[]", seen)
+			}
+		}
+	}
+}
+
+// jsonFields returns the types of a struct's fields by JSON name (and lowercased name, as JSON
+// names match case-insensitively), and whether it collects unknown fields as additional properties.
+// It returns nil for structs without JSON fields that unmarshal themselves, such as unions.
+func jsonFields(typ reflect.Type) (map[string]reflect.Type, bool) {
+	fields := map[string]reflect.Type{}
+	open := false
+	tagged := false
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.Tag.Get("additionalProperties") == "true" {
+			open = true
+			continue
+		}
+
+		tag, hasTag := field.Tag.Lookup("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			continue
+		}
+		if hasTag {
+			tagged = true
+		}
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				embeddedFields, embeddedOpen := jsonFields(embedded)
+				for k, v := range embeddedFields {
+					if _, ok := fields[k]; !ok {
+						fields[k] = v
+					}
+				}
+				open = open || embeddedOpen
+				tagged = tagged || embeddedFields != nil
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+		if _, ok := fields[strings.ToLower(name)]; !ok {
+			fields[strings.ToLower(name)] = field.Type
+		}
+	}
+
+	if !tagged && !open && reflect.PtrTo(typ).Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()) {
+		return nil, false
+	}
+
+	return fields, open
+}
+
+// joinFieldPath appends a field name to a path
+func joinFieldPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
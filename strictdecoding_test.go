@@ -0,0 +1,60 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestStrictDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":2,"refreshing":false,"Activity":[
+			{"uuid":"a1","type":"library.refresh.items","Context":{"librarySectionID":"1","accountID":1}},
+			{"uuid":"a2","type":"media.generate.bif","progress":10,"Response":{"ok":true}}
+		]}}`))
+	}))
+	defer server.Close()
+
+	expected := []string{
+		"MediaContainer.Activity[].Context.accountID",
+		"MediaContainer.Activity[].Response",
+		"MediaContainer.refreshing",
+	}
+
+	// Lenient by default
+	client := New(WithServerURL(server.URL))
+	res, err := client.Activities.GetServerActivities(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(res.Object.MediaContainer.Activity) != 2 {
+		t.Errorf("Expected 2 activities, got: %d", len(res.Object.MediaContainer.Activity))
+	}
+
+	var reported []string
+	var reportedOperation string
+	client = New(WithServerURL(server.URL), WithUnknownFieldsHandler(func(operationID string, fields []string) {
+		reportedOperation = operationID
+		reported = fields
+	}))
+	if _, err := client.Activities.GetServerActivities(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if reportedOperation != "getServerActivities" || !reflect.DeepEqual(reported, expected) {
+		t.Errorf("Expected unknown fields %v of getServerActivities, got %v of %s", expected, reported, reportedOperation)
+	}
+
+	client = New(WithServerURL(server.URL), WithStrictDecoding())
+	_, err = client.Activities.GetServerActivities(context.Background())
+	var unknownErr *UnknownFieldsError
+	if !errors.As(err, &unknownErr) {
+		t.Fatalf("Expected *UnknownFieldsError, got: %v", err)
+	}
+	if !reflect.DeepEqual(unknownErr.Fields, expected) {
+		t.Errorf("Expected unknown fields %v, got: %v", expected, unknownErr.Fields)
+	}
+}
@@ -0,0 +1,349 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Defaults of a TaskQueue whose MaxAttempts or RetryInterval is not set
+const (
+	defaultTaskMaxAttempts   = 5
+	defaultTaskRetryInterval = time.Minute
+)
+
+// taskSequence makes the IDs of tasks enqueued within the same nanosecond unique
+var taskSequence atomic.Int64
+
+// Task is pending work of a TaskQueue, e.g. a webhook delivery or a planned collection change
+type Task struct {
+	ID         string          `json:"id"`
+	Kind       string          `json:"kind"`    // Selects the handler, e.g. TaskKindCollectionWebhook
+	Payload    json.RawMessage `json:"payload"` // Arguments of the handler as JSON
+	Attempts   int             `json:"attempts"`
+	LastError  string          `json:"lastError,omitempty"` // Error of the last failed attempt
+	EnqueuedAt time.Time       `json:"enqueuedAt"`
+}
+
+// Decode decodes the payload of the task into v
+func (t Task) Decode(v interface{}) error {
+	if err := json.Unmarshal(t.Payload, v); err != nil {
+		return fmt.Errorf("error decoding payload of task %s: %w", t.ID, err)
+	}
+	return nil
+}
+
+// TaskHandler performs a task. A task whose handler returns an error stays queued and is retried.
+type TaskHandler func(ctx context.Context, task Task) error
+
+// TaskStore persists the pending tasks of a TaskQueue, so work enqueued before a restart is done
+// after it. Load returns no tasks and no error if none were saved yet. Save replaces the saved
+// tasks with tasks, in order.
+type TaskStore interface {
+	Load(ctx context.Context) ([]Task, error)
+	Save(ctx context.Context, tasks []Task) error
+}
+
+// fileTaskStore keeps the pending tasks in a JSON file
+type fileTaskStore struct {
+	path string
+}
+
+// FileTaskStore returns a store keeping the pending tasks of a queue in a JSON file
+func FileTaskStore(path string) TaskStore {
+	return fileTaskStore{path: path}
+}
+
+// Load reads the tasks from the file
+func (f fileTaskStore) Load(ctx context.Context) ([]Task, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(data, &tasks); err != nil {
+		return nil, fmt.Errorf("error decoding tasks: %w", err)
+	}
+	return tasks, nil
+}
+
+// Save writes the tasks to the file, replacing it atomically
+func (f fileTaskStore) Save(ctx context.Context, tasks []Task) error {
+	data, err := json.MarshalIndent(tasks, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := writeFileAtomic(f.path, data); err != nil {
+		return fmt.Errorf("error writing tasks: %w", err)
+	}
+	return nil
+}
+
+// memoryTaskStore keeps the pending tasks in memory, for queues without a Store
+type memoryTaskStore struct {
+	tasks []Task
+}
+
+func (m *memoryTaskStore) Load(ctx context.Context) ([]Task, error) {
+	return append([]Task(nil), m.tasks...), nil
+}
+
+func (m *memoryTaskStore) Save(ctx context.Context, tasks []Task) error {
+	m.tasks = append([]Task(nil), tasks...)
+	return nil
+}
+
+// TaskQueue holds background work in order, such as the webhook deliveries of a CollectionWatcher,
+// the visibility updates of a FeaturedRotation and the changes of a collection plan enqueued with
+// Collections.EnqueuePlan, and performs it with the handler registered for each kind of task.
+// Failed tasks stay queued and are retried. With a Store, pending tasks survive restarts. Create
+// one with NewTaskQueue and set its fields before using it.
+type TaskQueue struct {
+	Store         TaskStore     // Persists the pending tasks; kept in memory if nil
+	MaxAttempts   int           // Attempts before a failing task is dropped, 5 if not set
+	RetryInterval time.Duration // Time Run waits before retrying failed tasks, a minute if not set
+	OnError       func(error)   // Receives task and store errors, which don't stop Run
+
+	mu       sync.Mutex
+	process  sync.Mutex // Serializes Process
+	tasks    []Task
+	loaded   bool
+	handlers map[string]TaskHandler
+	wake     chan struct{}
+	track    func(context.Context) (context.Context, func(), error) // Of the client whose handlers were registered
+}
+
+// NewTaskQueue returns a queue persisting its tasks in store, or in memory if store is nil
+func NewTaskQueue(store TaskStore) *TaskQueue {
+	return &TaskQueue{
+		Store:    store,
+		handlers: map[string]TaskHandler{},
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Handle registers the handler of a kind of task, replacing any previous one. Tasks of kinds
+// without a handler stay queued.
+func (q *TaskQueue) Handle(kind string, handler TaskHandler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.handlers == nil {
+		q.handlers = map[string]TaskHandler{}
+	}
+	q.handlers[kind] = handler
+}
+
+// Enqueue adds a task with a payload encoded as JSON to the end of the queue and saves the queue
+func (q *TaskQueue) Enqueue(ctx context.Context, kind string, payload interface{}) (Task, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Task{}, fmt.Errorf("error encoding payload of %s task: %w", kind, err)
+	}
+
+	now := time.Now()
+	task := Task{
+		ID:         strconv.FormatInt(now.UnixNano(), 36) + "-" + strconv.FormatInt(taskSequence.Add(1), 36),
+		Kind:       kind,
+		Payload:    data,
+		EnqueuedAt: now,
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.load(ctx); err != nil {
+		return Task{}, err
+	}
+	if err := q.store().Save(ctx, append(q.tasks, task)); err != nil {
+		return Task{}, fmt.Errorf("error saving tasks: %w", err)
+	}
+	q.tasks = append(q.tasks, task)
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return task, nil
+}
+
+// Depth returns the number of pending tasks, e.g. for a metrics gauge
+func (q *TaskQueue) Depth(ctx context.Context) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.load(ctx); err != nil {
+		return 0, err
+	}
+	return len(q.tasks), nil
+}
+
+// Pending returns the pending tasks in order
+func (q *TaskQueue) Pending(ctx context.Context) ([]Task, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.load(ctx); err != nil {
+		return nil, err
+	}
+	return append([]Task{}, q.tasks...), nil
+}
+
+// Process performs each task pending when it is called once, in order, saving the queue after
+// each. Tasks that succeed are removed; failed tasks stay queued in place, unless they reached
+// MaxAttempts and are dropped. It returns the errors of the failed tasks, and stops at the first
+// store error.
+func (q *TaskQueue) Process(ctx context.Context) error {
+	q.process.Lock()
+	defer q.process.Unlock()
+
+	pending, err := q.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxAttempts := q.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultTaskMaxAttempts
+	}
+
+	var errs []error
+	for _, task := range pending {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		q.mu.Lock()
+		handler, ok := q.handlers[task.Kind]
+		q.mu.Unlock()
+		if !ok {
+			continue
+		}
+
+		taskErr := handler(ctx, task)
+		if taskErr != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if taskErr != nil {
+			taskErr = fmt.Errorf("error performing %s task %s: %w", task.Kind, task.ID, taskErr)
+			if task.Attempts+1 >= maxAttempts {
+				taskErr = fmt.Errorf("%w; dropped after %d attempts", taskErr, task.Attempts+1)
+			}
+			errs = append(errs, taskErr)
+		}
+
+		if err := q.finish(ctx, task.ID, taskErr, maxAttempts); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// finish removes a performed task from the queue, or records the failed attempt, and saves the queue
+func (q *TaskQueue) finish(ctx context.Context, taskID string, taskErr error, maxAttempts int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tasks := make([]Task, 0, len(q.tasks))
+	for _, task := range q.tasks {
+		if task.ID == taskID && taskErr != nil {
+			task.Attempts++
+			task.LastError = taskErr.Error()
+			if task.Attempts < maxAttempts {
+				tasks = append(tasks, task)
+			}
+			continue
+		}
+		if task.ID != taskID {
+			tasks = append(tasks, task)
+		}
+	}
+
+	if err := q.store().Save(ctx, tasks); err != nil {
+		return fmt.Errorf("error saving tasks: %w", err)
+	}
+	q.tasks = tasks
+	return nil
+}
+
+// Run processes the queue until ctx is done: whenever a task is enqueued, and every
+// RetryInterval while tasks are pending. Task and store errors are passed to OnError. It returns
+// ctx.Err() when stopped, including by PlexAPI.Close of a client whose handlers are registered.
+func (q *TaskQueue) Run(ctx context.Context) error {
+	q.mu.Lock()
+	track := q.track
+	q.mu.Unlock()
+	if track != nil {
+		var finish func()
+		var err error
+		if ctx, finish, err = track(ctx); err != nil {
+			return err
+		}
+		defer finish()
+	}
+
+	retryInterval := q.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = defaultTaskRetryInterval
+	}
+
+	for {
+		if err := q.Process(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			q.reportError(err)
+		}
+
+		var retry <-chan time.Time
+		if depth, err := q.Depth(ctx); err != nil || depth > 0 {
+			retry = time.After(retryInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-q.wake:
+		case <-retry:
+		}
+	}
+}
+
+// load reads the pending tasks from the store the first time the queue is used. It must be called
+// with mu held.
+func (q *TaskQueue) load(ctx context.Context) error {
+	if q.loaded {
+		return nil
+	}
+
+	tasks, err := q.store().Load(ctx)
+	if err != nil {
+		return fmt.Errorf("error loading tasks: %w", err)
+	}
+	q.tasks = tasks
+	q.loaded = true
+	return nil
+}
+
+// store returns Store, or an in-memory store if it is not set
+func (q *TaskQueue) store() TaskStore {
+	if q.Store == nil {
+		q.Store = &memoryTaskStore{}
+	}
+	return q.Store
+}
+
+// reportError passes an error to OnError, if set
+func (q *TaskQueue) reportError(err error) {
+	if q.OnError != nil {
+		q.OnError(err)
+	}
+}
@@ -0,0 +1,110 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTaskQueue(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "tasks.json")
+
+	var performed []string
+	failing := true
+	handler := func(ctx context.Context, task Task) error {
+		var name string
+		if err := task.Decode(&name); err != nil {
+			return err
+		}
+		if name == "flaky" && failing {
+			return errors.New("unavailable")
+		}
+		performed = append(performed, name)
+		return nil
+	}
+
+	queue := NewTaskQueue(FileTaskStore(path))
+	queue.Handle("test", handler)
+	for _, name := range []string{"first", "flaky", "last"} {
+		if _, err := queue.Enqueue(ctx, "test", name); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+	if _, err := queue.Enqueue(ctx, "unhandled", nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if err := queue.Process(ctx); err == nil {
+		t.Error("Expected the error of the flaky task")
+	}
+	if len(performed) != 2 || performed[0] != "first" || performed[1] != "last" {
+		t.Errorf("Expected the other tasks to be performed in order, got: %v", performed)
+	}
+
+	// A new queue on the same store picks up the pending tasks, as after a restart
+	restarted := NewTaskQueue(FileTaskStore(path))
+	restarted.Handle("test", handler)
+	pending, err := restarted.Pending(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(pending) != 2 || pending[0].Attempts != 1 || pending[0].LastError == "" || pending[1].Kind != "unhandled" {
+		t.Errorf("Expected the failed and the unhandled task to be pending, got: %+v", pending)
+	}
+
+	failing = false
+	if err := restarted.Process(ctx); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if depth, err := restarted.Depth(ctx); err != nil || depth != 1 {
+		t.Errorf("Expected the unhandled task to stay queued, got: %d %v", depth, err)
+	}
+
+	// Tasks that keep failing are dropped after MaxAttempts
+	dropping := NewTaskQueue(nil)
+	dropping.MaxAttempts = 2
+	dropping.Handle("test", func(ctx context.Context, task Task) error { return errors.New("unavailable") })
+	dropping.Enqueue(ctx, "test", "doomed")
+	dropping.Process(ctx)
+	dropping.Process(ctx)
+	if depth, _ := dropping.Depth(ctx); depth != 0 {
+		t.Errorf("Expected the task to be dropped, got depth %d", depth)
+	}
+}
+
+func TestTaskQueueRun(t *testing.T) {
+	deleted := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		deleted <- r.URL.Path
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	queue := NewTaskQueue(nil)
+	go queue.Run(ctx)
+
+	plan := &CollectionPlan{SectionID: 1, Changes: []PlannedChange{{Action: PlanDelete, Title: "Old Favorites", CollectionID: 7}}}
+	if err := client.Collections.EnqueuePlan(ctx, queue, plan); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	select {
+	case path := <-deleted:
+		if path != "/library/collections/7" {
+			t.Errorf("Expected collection 7 to be deleted, got: %s", path)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Expected the planned change to be applied")
+	}
+}
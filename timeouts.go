@@ -0,0 +1,92 @@
+package plexgo
+
+import (
+	"strings"
+	"time"
+)
+
+// listOperations are the operations that return whole containers of library items, which may be
+// large enough to take far longer than other reads on a big library
+var listOperations = map[string]bool{
+	"get-actors-library":         true,
+	"get-all-media-library":      true,
+	"get-countries-library":      true,
+	"get-genres-library":         true,
+	"get-library-items":          true,
+	"get-recently-added":         true,
+	"get-recently-added-library": true,
+	"get-search-all-libraries":   true,
+	"get-search-library":         true,
+	"get-watch-list":             true,
+	"getAllCollections":          true,
+	"getAllMediaLibrary":         true,
+	"getCollectionChildren":      true,
+	"getCollectionItems":         true,
+	"getLibraryItems":            true,
+	"getLibraryItemsByGUID":      true,
+	"getMetadataChildren":        true,
+	"getPhotoTimeline":           true,
+	"getPlaylistContents":        true,
+	"getPlaylists":               true,
+	"getSearchResults":           true,
+	"getSessionHistory":          true,
+	"getWatchList":               true,
+}
+
+// WithDefaultTimeouts sets the request timeouts of operations by kind: read for GET requests,
+// write for requests with other methods and list for operations that return whole containers of
+// library items, such as "getLibraryItems", which can take minutes on a big library. A zero
+// timeout leaves the operations of its kind to WithTimeout, or to the default HTTP client's 60
+// seconds without it. WithTimeoutForOperation and the operations.WithOperationTimeout option of a
+// call take precedence. The default HTTP client's 60 second timeout is otherwise replaced by the
+// default timeouts; a client set with WithClient keeps its own.
+func WithDefaultTimeouts(read time.Duration, write time.Duration, list time.Duration) SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.ReadTimeout = read
+		sdk.sdkConfiguration.WriteTimeout = write
+		sdk.sdkConfiguration.ListTimeout = list
+	}
+}
+
+// WithTimeoutForOperation overrides the request timeout of a single operation by its operation ID,
+// e.g. "getLibraryItems". It takes precedence over WithDefaultTimeouts and WithTimeout, but not
+// over the operations.WithOperationTimeout option of a call.
+func WithTimeoutForOperation(operationID string, timeout time.Duration) SDKOption {
+	return func(sdk *PlexAPI) {
+		if sdk.sdkConfiguration.OperationTimeouts == nil {
+			sdk.sdkConfiguration.OperationTimeouts = map[string]time.Duration{}
+		}
+		sdk.sdkConfiguration.OperationTimeouts[operationID] = timeout
+	}
+}
+
+// timeoutFor returns the request timeout of an operation: the call's override if set, then the
+// operation's, the default of its kind, the global timeout and finally the default client's timeout
+// it replaced. It is nil if there is none.
+func (c *sdkConfiguration) timeoutFor(operationID string, method string, override *time.Duration) *time.Duration {
+	if override != nil {
+		return override
+	}
+	if timeout, ok := c.OperationTimeouts[operationID]; ok {
+		return &timeout
+	}
+
+	var timeout time.Duration
+	switch method = strings.ToUpper(method); {
+	case method != "GET" && method != "HEAD":
+		timeout = c.WriteTimeout
+	case listOperations[operationID]:
+		timeout = c.ListTimeout
+	default:
+		timeout = c.ReadTimeout
+	}
+	if timeout > 0 {
+		return &timeout
+	}
+
+	if c.Timeout == nil && c.fallbackTimeout > 0 {
+		return &c.fallbackTimeout
+	}
+
+	return c.Timeout
+}
@@ -0,0 +1,99 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDefaultTimeouts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Heist","type":"collection"}]}}`))
+		case "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Heist","type":"collection"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	// Lists get a short timeout, reads a long one
+	client := New(WithServerURL(server.URL), WithDefaultTimeouts(5*time.Second, 5*time.Second, 20*time.Millisecond))
+	if _, err := client.Collections.GetAllCollections(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the list to time out, got: %v", err)
+	}
+	if _, err := client.Collections.GetCollection(ctx, 7); err != nil {
+		t.Errorf("Expected the read to finish, got: %v", err)
+	}
+
+	client = New(WithServerURL(server.URL), WithDefaultTimeouts(5*time.Second, 5*time.Second, 20*time.Millisecond), WithTimeoutForOperation("getAllCollections", 5*time.Second))
+	if _, err := client.Collections.GetAllCollections(ctx, 1); err != nil {
+		t.Errorf("Expected the operation's timeout to take precedence, got: %v", err)
+	}
+}
+
+func TestTimeoutFor(t *testing.T) {
+	global := time.Minute
+	call := time.Second
+	config := sdkConfiguration{
+		Timeout:           &global,
+		ReadTimeout:       10 * time.Second,
+		ListTimeout:       5 * time.Minute,
+		OperationTimeouts: map[string]time.Duration{"getServerCapabilities": 3 * time.Second},
+	}
+
+	tests := []struct {
+		operationID string
+		method      string
+		override    *time.Duration
+		want        time.Duration
+	}{
+		{"getServerCapabilities", "GET", &call, time.Second},
+		{"getServerCapabilities", "GET", nil, 3 * time.Second},
+		{"getLibraryItems", "GET", nil, 5 * time.Minute},
+		{"getCollection", "get", nil, 10 * time.Second},
+		{"updateCollectionSort", "PUT", nil, time.Minute}, // No write timeout, so the global one
+	}
+	for _, tt := range tests {
+		if got := config.timeoutFor(tt.operationID, tt.method, tt.override); got == nil || *got != tt.want {
+			t.Errorf("timeoutFor(%s, %s) = %v, want %s", tt.operationID, tt.method, got, tt.want)
+		}
+	}
+
+	if got := (&sdkConfiguration{}).timeoutFor("getCollection", "GET", nil); got != nil {
+		t.Errorf("Expected no timeout, got: %s", *got)
+	}
+}
+
+func TestDefaultTimeoutsFallback(t *testing.T) {
+	// Only reads have a default timeout, so writes keep the default client's 60 seconds
+	client := New(WithDefaultTimeouts(5*time.Second, 0, 0))
+	if got := client.sdkConfiguration.timeoutFor("updateCollectionSort", "PUT", nil); got == nil || *got != 60*time.Second {
+		t.Errorf("Expected the write to time out after 60 seconds, got: %v", got)
+	}
+	if got := client.sdkConfiguration.timeoutFor("getCollection", "GET", nil); got == nil || *got != 5*time.Second {
+		t.Errorf("Expected the read timeout, got: %v", got)
+	}
+
+	global := 2 * time.Minute
+	client = New(WithDefaultTimeouts(5*time.Second, 0, 0), WithTimeout(global))
+	if got := client.sdkConfiguration.timeoutFor("updateCollectionSort", "PUT", nil); got == nil || *got != global {
+		t.Errorf("Expected the global timeout, got: %v", got)
+	}
+
+	// A client set with WithClient keeps its own timeout
+	client = New(WithDefaultTimeouts(5*time.Second, 0, 0), WithClient(&http.Client{Timeout: time.Hour}))
+	if got := client.sdkConfiguration.timeoutFor("updateCollectionSort", "PUT", nil); got != nil {
+		t.Errorf("Expected no timeout, got: %s", *got)
+	}
+}
@@ -0,0 +1,88 @@
+package plexgo
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// tokenHeader is the header the SDK authenticates with
+const tokenHeader = "X-Plex-Token"
+
+// WithTokenInQuery sends the token as the X-Plex-Token query parameter instead of the header of
+// the same name, for every operation. Use it behind reverse proxies that strip X-Plex-* headers.
+// The token then shows up in URLs, including those in proxy and server logs. It is removed from
+// the URLs of request errors, and redirects to another host are refused so the token isn't sent
+// there; the latter only applies to an *http.Client, the default or one set with WithClient.
+func WithTokenInQuery() SDKOption {
+	return func(sdk *PlexAPI) {
+		sdk.sdkConfiguration.TokenInQuery = true
+	}
+}
+
+// tokenInQueryMiddleware moves the X-Plex-Token header of requests into their query
+func tokenInQueryMiddleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if token := req.Header.Get(tokenHeader); token != "" {
+				req = req.Clone(req.Context())
+				req.Header.Del(tokenHeader)
+
+				// The token is appended, as re-encoding the query would reorder smart filters
+				param := tokenHeader + "=" + url.QueryEscape(token)
+				if req.URL.RawQuery != "" {
+					param = "&" + param
+				}
+				req.URL.RawQuery += param
+
+				res, err := next.RoundTrip(req)
+				return res, redactTokenError(err, token)
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// redactTokenError removes the token from the URL net/http quotes in request errors, which end up
+// in logs and error responses. A redirect may have encoded it differently than the SDK.
+func redactTokenError(err error, token string) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	for _, encoded := range []string{url.QueryEscape(token), url.PathEscape(token), token} {
+		urlErr.URL = strings.ReplaceAll(urlErr.URL, encoded, "REDACTED")
+	}
+	return err
+}
+
+// errCrossHostRedirect is returned for a redirect to another host while the token is in the query
+var errCrossHostRedirect = errors.New("refusing to follow a redirect to another host with the token in the query")
+
+// withoutCrossHostRedirects returns a copy of an *http.Client that refuses redirects to another
+// host, which would receive the token in the redirected URL's query. Other clients are returned
+// as they are.
+func withoutCrossHostRedirects(client HTTPClient) HTTPClient {
+	httpClient, ok := client.(*http.Client)
+	if !ok {
+		return client
+	}
+
+	restricted := *httpClient
+	checkRedirect := httpClient.CheckRedirect
+	restricted.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if req.URL.Host != via[0].URL.Host {
+			return errCrossHostRedirect
+		}
+		if checkRedirect != nil {
+			return checkRedirect(req, via)
+		}
+		// The default policy of net/http
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+	return &restricted
+}
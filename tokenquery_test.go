@@ -0,0 +1,77 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithTokenInQuery(t *testing.T) {
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Plex-Token") != "" {
+			t.Errorf("Expected no token header, got one for %s", r.URL.Path)
+		}
+		queries = append(queries, r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/sections":
+			w.Write([]byte(`{"MediaContainer":{"size":0,"Directory":[]}}`))
+		case "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","type":"movie"}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithSecurity("secret token"), WithTokenInQuery())
+	ctx := context.Background()
+
+	if _, err := client.Library.GetAllLibraries(ctx); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := client.Collections.TestSmartFilter(ctx, 1, "type=1&year>>=2000&genre=Drama"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(queries) != 2 || queries[0] != "X-Plex-Token=secret+token" {
+		t.Fatalf("Expected the token in the query, got: %v", queries)
+	}
+	if !strings.HasSuffix(queries[1], "&X-Plex-Token=secret+token") {
+		t.Errorf("Expected the token to be appended to the query, got: %s", queries[1])
+	}
+}
+
+func TestWithTokenInQueryRedacts(t *testing.T) {
+	// A server that is down, so the request fails with net/http's error quoting the URL
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	client := New(WithServerURL(down.URL), WithSecurity("secret token"), WithTokenInQuery())
+	_, err := client.Library.GetAllLibraries(context.Background())
+	if err == nil || strings.Contains(err.Error(), "secret") || !strings.Contains(err.Error(), "X-Plex-Token=REDACTED") {
+		t.Errorf("Expected an error without the token, got: %v", err)
+	}
+
+	// Redirects to another host would carry the token along
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request to the other host, got: %s", r.URL)
+	}))
+	defer other.Close()
+	redirecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, other.URL+r.URL.RequestURI(), http.StatusFound)
+	}))
+	defer redirecting.Close()
+
+	client = New(WithServerURL(redirecting.URL), WithSecurity("secret token"), WithTokenInQuery())
+	_, err = client.Library.GetAllLibraries(context.Background())
+	if !errors.Is(err, errCrossHostRedirect) || strings.Contains(err.Error(), "secret") {
+		t.Errorf("Expected the redirect to be refused without the token in the error, got: %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+func TestUnexpectedContent(t *testing.T) {
+	loginPage := `<!DOCTYPE html>
+<html>
+  <head><title>Sign in</title></head>
+  <body>` + strings.Repeat("<p>Please sign in to continue</p>", 50) + `</body>
+</html>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/identity", "/library/collections/1":
+			http.Redirect(w, r, "/login", http.StatusFound)
+		case "/login":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(loginPage))
+		case "/activities":
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`<html><body><h1>502 Bad Gateway</h1></body></html>`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	// Generated and hand-written methods following a redirect to a login page
+	_, err := client.Server.GetServerIdentity(context.Background())
+	var contentErr *sdkerrors.UnexpectedContentError
+	if !errors.As(err, &contentErr) {
+		t.Fatalf("Expected *sdkerrors.UnexpectedContentError, got: %T %v", err, err)
+	}
+	if contentErr.URL != server.URL+"/login" || contentErr.StatusCode != http.StatusOK || contentErr.ContentType != "text/html; charset=utf-8" {
+		t.Errorf("Unexpected error fields: %+v", contentErr)
+	}
+	if !strings.HasPrefix(contentErr.Snippet, "<!DOCTYPE html> <html> <head><title>Sign in</title>") || len(contentErr.Snippet) > 203 {
+		t.Errorf("Unexpected snippet: %q", contentErr.Snippet)
+	}
+
+	_, err = client.Collections.GetCollection(context.Background(), 1)
+	if !errors.Is(err, sdkerrors.ErrUnexpectedContent) {
+		t.Errorf("Expected ErrUnexpectedContent, got: %T %v", err, err)
+	}
+
+	// Error responses from a proxy still unwrap to an SDK error with their status
+	_, err = client.Activities.GetServerActivities(context.Background())
+	var sdkErr *sdkerrors.SDKError
+	if !errors.Is(err, sdkerrors.ErrUnexpectedContent) || !errors.As(err, &sdkErr) || sdkErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected ErrUnexpectedContent unwrapping to a 502 SDK error, got: %T %v", err, err)
+	}
+
+	// JSON responses are unaffected
+	if _, err := client.Server.GetServerCapabilities(context.Background()); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
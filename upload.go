@@ -0,0 +1,161 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// Upload uploads a media file to a library section that accepts uploads, such as a photo or other
+// videos section used for camera uploads. path is where the file is stored relative to the
+// section's folder, e.g. "2024/Trip/IMG_0001.jpg", and may not leave it.
+//
+// The file is streamed with chunked transfer encoding, so it is never held in memory. Progress is
+// reported in bytes to WithProgress; the total is 0 unless r is an *os.File or has a
+// Len method, such as *bytes.Reader. Uploads are not retried, as r cannot be read again.
+func (s *Library) Upload(ctx context.Context, sectionID int, path string, r io.Reader, opts ...operations.Option) error {
+	if r == nil {
+		return fmt.Errorf("upload reader cannot be nil")
+	}
+
+	uploadPath, err := cleanUploadPath(path)
+	if err != nil {
+		return err
+	}
+
+	options := processOptions(opts)
+
+	var baseURL string
+	if options.ServerURL == nil {
+		serverURL, params := s.sdkConfiguration.GetServerDetails()
+		baseURL = utils.ReplaceParameters(serverURL, params)
+	} else {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/sections/%d/upload", sectionID))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("path", uploadPath)
+	opURL = fmt.Sprintf("%s?%s", opURL, queryParams.Encode())
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "uploadMedia",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	// Wrapping the reader hides its length, so the body is always sent chunked
+	body := &progressReader{r: r, total: uploadSize(r), options: options}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", opURL, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	reportProgress(options, 0, body.total, "uploading")
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cleanUploadPath checks that an upload path is a file path inside the section's folder
+func cleanUploadPath(uploadPath string) (string, error) {
+	cleaned := path.Clean(strings.ReplaceAll(uploadPath, "\\", "/"))
+	if uploadPath == "" || cleaned == "." || strings.HasSuffix(uploadPath, "/") {
+		return "", fmt.Errorf("upload path %q is not a file path", uploadPath)
+	}
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("upload path %q is outside the section", uploadPath)
+	}
+	return cleaned, nil
+}
+
+// uploadSize returns the number of bytes left in r, or 0 if unknown
+func uploadSize(r io.Reader) int {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return r.Len()
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := r.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0
+		}
+		size := info.Size()
+		if seeker, ok := r.(io.Seeker); ok {
+			if offset, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+				size -= offset
+			}
+		}
+		return int(size)
+	}
+	return 0
+}
+
+// progressReader reports the bytes read from an upload body
+type progressReader struct {
+	r       io.Reader
+	total   int
+	done    int
+	options *helperOptions
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.done += n
+		reportProgress(p.options, p.done, p.total, "uploading")
+	}
+	return n, err
+}
@@ -0,0 +1,88 @@
+package plexgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+func TestUpload(t *testing.T) {
+	var received []byte
+	var uploadPath string
+	var chunked bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/library/sections/4/upload" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("path") == "full.jpg" {
+			w.WriteHeader(http.StatusInsufficientStorage)
+			return
+		}
+
+		uploadPath = r.URL.Query().Get("path")
+		chunked = len(r.TransferEncoding) > 0 && r.TransferEncoding[0] == "chunked"
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	content := bytes.Repeat([]byte("jpeg"), 50000)
+	var done, total int
+	err := client.Library.Upload(ctx, 4, "2024/Trip/IMG_0001.jpg", bytes.NewReader(content), WithProgress(func(d, tt int, stage string) {
+		done, total = d, tt
+	}))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !bytes.Equal(received, content) || uploadPath != "2024/Trip/IMG_0001.jpg" || !chunked {
+		t.Errorf("Expected a chunked upload to 2024/Trip/IMG_0001.jpg, got %d bytes to %q (chunked: %v)", len(received), uploadPath, chunked)
+	}
+	if done != len(content) || total != len(content) {
+		t.Errorf("Expected progress %d/%d, got: %d/%d", len(content), len(content), done, total)
+	}
+
+	// Files report their size
+	file := filepath.Join(t.TempDir(), "clip.mp4")
+	if err := os.WriteFile(file, []byte("video"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	total = 0
+	err = client.Library.Upload(ctx, 4, `Clips\clip.mp4`, f, WithProgress(func(d, tt int, stage string) { total = tt }))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if total != 5 || uploadPath != "Clips/clip.mp4" || string(received) != "video" {
+		t.Errorf("Unexpected upload of %q (%d of %d bytes)", uploadPath, len(received), total)
+	}
+
+	for _, invalid := range []string{"", "/etc/passwd", "../other/file.jpg", "a/../../file.jpg", "folder/"} {
+		if err := client.Library.Upload(ctx, 4, invalid, bytes.NewReader(content)); err == nil {
+			t.Errorf("Expected an error for path %q", invalid)
+		}
+	}
+
+	err = client.Library.Upload(ctx, 4, "full.jpg", bytes.NewReader(content))
+	var sdkErr *sdkerrors.SDKError
+	if !errors.As(err, &sdkErr) || sdkErr.StatusCode != http.StatusInsufficientStorage {
+		t.Errorf("Expected an API error, got: %v", err)
+	}
+}
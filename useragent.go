@@ -0,0 +1,48 @@
+package plexgo
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WithUserAgent replaces the User-Agent sent with every request, e.g. for reverse proxies that
+// only let known clients through. App info added with WithAppInfo is appended to it.
+func WithUserAgent(userAgent string) SDKOption {
+	return func(sdk *PlexAPI) {
+		if userAgent = sanitizeUserAgent(userAgent); userAgent != "" {
+			sdk.sdkConfiguration.UserAgent = userAgent
+		}
+	}
+}
+
+// WithAppInfo appends the name and version of the application using the SDK to the User-Agent,
+// e.g. "myapp/1.2.0", so that server logs and proxies can tell applications apart. It can be
+// used more than once, and version can be "".
+func WithAppInfo(name string, version string) SDKOption {
+	return func(sdk *PlexAPI) {
+		product := strings.Join(strings.Fields(sanitizeUserAgent(name)), "-")
+		if product == "" {
+			return
+		}
+		if version = strings.Join(strings.Fields(sanitizeUserAgent(version)), "-"); version != "" {
+			product += "/" + version
+		}
+		sdk.sdkConfiguration.AppInfo = append(sdk.sdkConfiguration.AppInfo, product)
+	}
+}
+
+// userAgent returns the User-Agent with the app info appended
+func (c *sdkConfiguration) userAgent() string {
+	return strings.Join(append([]string{c.UserAgent}, c.AppInfo...), " ")
+}
+
+// sanitizeUserAgent drops the control characters of a User-Agent, which would otherwise let it
+// inject headers or be rejected by the HTTP client
+func sanitizeUserAgent(userAgent string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, userAgent))
+}
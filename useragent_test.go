@@ -0,0 +1,35 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":0}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithAppInfo("Movie Night", "1.2.0"))
+	if _, err := client.Activities.GetServerActivities(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.HasPrefix(userAgent, "speakeasy-sdk/go") || !strings.HasSuffix(userAgent, " Movie-Night/1.2.0") {
+		t.Errorf("Expected the app info after the default User-Agent, got: %q", userAgent)
+	}
+
+	client = New(WithServerURL(server.URL), WithAppInfo("sync", ""), WithUserAgent("Plex/1.0\r\nX-Injected: 1"))
+	if _, err := client.Activities.GetServerActivities(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if userAgent != "Plex/1.0X-Injected: 1 sync" {
+		t.Errorf("Expected the overridden User-Agent with the app info, got: %q", userAgent)
+	}
+}
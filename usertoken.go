@@ -0,0 +1,37 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// ErrUserNotShared is returned by GetUserToken when the server isn't shared with the user
+var ErrUserNotShared = errors.New("server is not shared with user")
+
+// GetUserToken gets the access token a user the server is shared with uses for the server with
+// machine identifier machineID, e.g. from Server.GetIdentity. A client created with
+// WithSecurity(token) acts as that user, so per-user state such as watch status, On Deck and
+// home rows is read and changed for them rather than the owner. Only the server owner can get
+// the tokens of shared users. The userID is the plex.tv ID from Users.GetUsers. It returns
+// ErrUserNotShared if the server isn't shared with the user.
+func (s *Users) GetUserToken(ctx context.Context, machineID string, userID int64, opts ...operations.Option) (string, error) {
+	shares, err := s.getSharedServers(ctx, machineID, opts...)
+	if err != nil {
+		return "", fmt.Errorf("error getting shared users: %w", err)
+	}
+
+	for _, share := range shares {
+		if share.UserID != userID {
+			continue
+		}
+		if share.AccessToken == "" {
+			return "", fmt.Errorf("no access token for user %d: only the server owner can get it", userID)
+		}
+		return share.AccessToken, nil
+	}
+
+	return "", fmt.Errorf("%w %d", ErrUserNotShared, userID)
+}
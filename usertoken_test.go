@@ -0,0 +1,41 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUserToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/servers/abc123/shared_servers" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.Write([]byte(`<MediaContainer size="2">
+			<SharedServer userID="1" username="alice" accessToken="alice-token" allLibraries="1" />
+			<SharedServer userID="2" username="bob" allLibraries="1" />
+		</MediaContainer>`))
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL), WithMiddleware(routePlexTV(server.URL)))
+
+	token, err := client.Users.GetUserToken(context.Background(), "abc123", 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if token != "alice-token" {
+		t.Errorf("Expected alice's token, got: %q", token)
+	}
+
+	if _, err := client.Users.GetUserToken(context.Background(), "abc123", 2); err == nil {
+		t.Error("Expected an error for a user without a token")
+	}
+
+	if _, err := client.Users.GetUserToken(context.Background(), "abc123", 3); !errors.Is(err, ErrUserNotShared) {
+		t.Errorf("Expected ErrUserNotShared, got: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// ErrLastVersion is returned by DeleteVersion for the only version of an item, which can't be
+// deleted without deleting the item
+var ErrLastVersion = errors.New("item has no other version")
+
+// ListVersions lists the versions of an item, e.g. a 720p and a 4K copy of the same movie, each
+// with its files
+func (s *Media) ListVersions(ctx context.Context, ratingKey string, opts ...operations.Option) ([]MediaVersion, error) {
+	items, err := newLibrary(s.sdkConfiguration).listMetadata(ctx, "/library/metadata/"+ratingKey, nil, "getMediaMetaData", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting item %s: %w", ratingKey, err)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("item %s not found", ratingKey)
+	}
+
+	versions := items[0].Media
+	if versions == nil {
+		versions = []MediaVersion{}
+	}
+	return versions, nil
+}
+
+// DeleteVersion deletes a version of an item and its files from disk, e.g. the 720p copy of a
+// movie once a 4K version has been added, keeping the item and its other versions. The server
+// only deletes media when "Allow media deletion" is enabled in its settings. ErrLastVersion is
+// returned for the item's only version.
+func (s *Media) DeleteVersion(ctx context.Context, ratingKey string, mediaID int64, opts ...operations.Option) error {
+	versions, err := s.ListVersions(ctx, ratingKey, opts...)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, version := range versions {
+		if version.ID == mediaID {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("version %d of item %s not found", mediaID, ratingKey)
+	}
+	if len(versions) == 1 {
+		return fmt.Errorf("%w: version %d of item %s", ErrLastVersion, mediaID, ratingKey)
+	}
+
+	options := processOptions(opts)
+	baseURL := newLibrary(s.sdkConfiguration).baseURL(opts)
+
+	opURL, err := url.JoinPath(baseURL, fmt.Sprintf("/library/metadata/%s/media/%d", ratingKey, mediaID))
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    "deleteMediaVersion",
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "DELETE", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", opURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = utils.ConsumeRawBody(httpRes)
+	return err
+}
@@ -0,0 +1,60 @@
+package plexgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVersions(t *testing.T) {
+	deleted := ""
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/10":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"10","type":"movie","title":"Heat","Media":[
+				{"id":100,"videoResolution":"720","Part":[{"id":1000,"file":"/movies/Heat (1995)/Heat 720p.mkv"}]},
+				{"id":101,"videoResolution":"4k","Part":[{"id":1001,"file":"/movies/Heat (1995)/Heat 2160p.mkv"}]}
+			]}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/metadata/11":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"11","type":"movie","title":"Ronin","Media":[{"id":110,"videoResolution":"1080"}]}]}}`))
+		case r.Method == "DELETE":
+			deleted = r.URL.Path
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+	ctx := context.Background()
+
+	versions, err := client.Media.ListVersions(ctx, "10")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(versions) != 2 || versions[1].VideoResolution != "4k" || versions[0].Part[0].File == "" {
+		t.Errorf("Unexpected versions: %+v", versions)
+	}
+
+	if err := client.Media.DeleteVersion(ctx, "10", 100); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if deleted != "/library/metadata/10/media/100" {
+		t.Errorf("Expected the 720p version to be deleted, got: %q", deleted)
+	}
+
+	deleted = ""
+	if err := client.Media.DeleteVersion(ctx, "10", 999); err == nil {
+		t.Error("Expected an error for a version of another item")
+	}
+	if err := client.Media.DeleteVersion(ctx, "11", 110); !errors.Is(err, ErrLastVersion) {
+		t.Errorf("Expected ErrLastVersion, got: %v", err)
+	}
+	if deleted != "" {
+		t.Errorf("Expected nothing to be deleted, got: %q", deleted)
+	}
+}
@@ -0,0 +1,154 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// VideoAttributeQuery selects media by their video and audio attributes. Empty fields are ignored;
+// an item matches when one of its media versions satisfies every set field.
+type VideoAttributeQuery struct {
+	VideoCodec         string // e.g. "hevc" or "h264"
+	VideoResolution    string // e.g. "4k" or "1080"
+	VideoProfile       string // e.g. "main 10"
+	Container          string // e.g. "mkv"
+	AudioCodec         string // Matches any audio stream, e.g. "truehd"
+	AudioChannelLayout string // Matches any audio stream, e.g. "7.1"
+	MinBitDepth        int    // e.g. 10
+	DolbyVision        bool   // Only match Dolby Vision video
+	HDR                bool   // Only match HDR10, HLG or Dolby Vision video
+}
+
+// needsStreams returns true if the query inspects stream attributes, which are only included in full item metadata
+func (q VideoAttributeQuery) needsStreams() bool {
+	return q.MinBitDepth > 0 || q.DolbyVision || q.HDR || q.AudioChannelLayout != "" || q.AudioCodec != ""
+}
+
+// matchesMedia returns true if the media version satisfies the media-level fields of the query
+func (q VideoAttributeQuery) matchesMedia(media MediaVersion) bool {
+	if q.VideoCodec != "" && !strings.EqualFold(media.VideoCodec, q.VideoCodec) {
+		return false
+	}
+	if q.VideoResolution != "" && !strings.EqualFold(media.VideoResolution, q.VideoResolution) {
+		return false
+	}
+	if q.VideoProfile != "" && !strings.EqualFold(media.VideoProfile, q.VideoProfile) {
+		return false
+	}
+	if q.Container != "" && !strings.EqualFold(media.Container, q.Container) {
+		return false
+	}
+	return true
+}
+
+// matchesStreams returns true if the streams of the media version satisfy the stream-level fields of the query
+func (q VideoAttributeQuery) matchesStreams(media MediaVersion) bool {
+	if q.MinBitDepth > 0 || q.DolbyVision || q.HDR {
+		videoStreams := media.Streams(StreamTypeVideo)
+		if len(videoStreams) == 0 {
+			return false
+		}
+
+		video := videoStreams[0]
+		if video.BitDepth < q.MinBitDepth {
+			return false
+		}
+		if q.DolbyVision && !video.IsDolbyVision() {
+			return false
+		}
+		if q.HDR && !video.IsHDR() {
+			return false
+		}
+	}
+
+	if q.AudioCodec != "" || q.AudioChannelLayout != "" {
+		found := false
+		for _, audio := range media.Streams(StreamTypeAudio) {
+			if q.AudioCodec != "" && !strings.EqualFold(audio.Codec, q.AudioCodec) {
+				continue
+			}
+			if q.AudioChannelLayout != "" && !strings.HasPrefix(audio.AudioChannelLayout, q.AudioChannelLayout) {
+				continue
+			}
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FindByVideoAttributes finds the items in a library section with a media version matching the query,
+// e.g. all Dolby Vision movies or all h264 movies that need a remux. Stream attributes (bit depth,
+// HDR, Dolby Vision and audio) are only available in full item metadata, so those queries fetch each
+// candidate item individually and return its full metadata.
+func (s *Library) FindByVideoAttributes(ctx context.Context, sectionID int, query VideoAttributeQuery, opts ...operations.Option) ([]Metadata, error) {
+	items, err := s.getLeafItems(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	options := processOptions(opts)
+
+	matches := []Metadata{}
+	reportProgress(options, 0, len(items), "inspecting items")
+	for i, item := range items {
+		match, err := s.matchVideoAttributes(ctx, item, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if match != nil {
+			matches = append(matches, *match)
+		}
+		reportProgress(options, i+1, len(items), "inspecting items")
+	}
+
+	return matches, nil
+}
+
+// matchVideoAttributes returns the item if it matches the query, fetching its full metadata when
+// the query inspects streams, or nil if it does not match
+func (s *Library) matchVideoAttributes(ctx context.Context, item Metadata, query VideoAttributeQuery, opts ...operations.Option) (*Metadata, error) {
+	if !anyMedia(item.Media, query.matchesMedia) {
+		return nil, nil
+	}
+
+	if !query.needsStreams() {
+		return &item, nil
+	}
+
+	ratingKey, err := strconv.Atoi(item.RatingKey)
+	if err != nil {
+		return nil, fmt.Errorf("error converting rating key %s to int: %w", item.RatingKey, err)
+	}
+
+	full, err := s.GetItem(ctx, ratingKey, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting metadata of %s: %w", item.Title, err)
+	}
+
+	if !anyMedia(full.Media, func(media MediaVersion) bool {
+		return query.matchesMedia(media) && query.matchesStreams(media)
+	}) {
+		return nil, nil
+	}
+
+	return full, nil
+}
+
+// anyMedia returns true if any media version satisfies the predicate
+func anyMedia(media []MediaVersion, predicate func(MediaVersion) bool) bool {
+	for _, m := range media {
+		if predicate(m) {
+			return true
+		}
+	}
+	return false
+}
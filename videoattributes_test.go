@@ -0,0 +1,81 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFindByVideoAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/library/sections/1/all":
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"ratingKey":"1","title":"Dune","type":"movie","Media":[{"id":1,"videoCodec":"hevc","videoResolution":"4k"}]},
+				{"ratingKey":"2","title":"Arrival","type":"movie","Media":[{"id":2,"videoCodec":"hevc","videoResolution":"4k"}]},
+				{"ratingKey":"3","title":"Heat","type":"movie","Media":[{"id":3,"videoCodec":"h264","videoResolution":"1080"}]}
+			]}}`))
+		case "/library/metadata/1":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"1","title":"Dune","type":"movie","Media":[
+				{"id":1,"videoCodec":"hevc","videoResolution":"4k","Part":[{"id":1,"Stream":[
+					{"id":10,"streamType":1,"codec":"hevc","bitDepth":10,"colorTrc":"smpte2084","DOVIPresent":true,"DOVIProfile":8},
+					{"id":11,"streamType":2,"codec":"truehd","channels":8,"audioChannelLayout":"7.1"}
+				]}]}
+			]}]}}`))
+		case "/library/metadata/2":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"2","title":"Arrival","type":"movie","Media":[
+				{"id":2,"videoCodec":"hevc","videoResolution":"4k","Part":[{"id":2,"Stream":[
+					{"id":20,"streamType":1,"codec":"hevc","bitDepth":10,"colorTrc":"smpte2084"},
+					{"id":21,"streamType":2,"codec":"eac3","channels":6,"audioChannelLayout":"5.1(side)"}
+				]}]}
+			]}]}}`))
+		case "/library/metadata/3":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"3","title":"Heat","type":"movie","Media":[
+				{"id":3,"videoCodec":"h264","videoResolution":"1080","Part":[{"id":3,"Stream":[
+					{"id":30,"streamType":1,"codec":"h264","bitDepth":8},
+					{"id":31,"streamType":2,"codec":"ac3","channels":6,"audioChannelLayout":"5.1(side)"}
+				]}]}
+			]}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	items, err := client.Library.FindByVideoAttributes(context.Background(), 1, VideoAttributeQuery{DolbyVision: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(items) != 1 || items[0].Title != "Dune" {
+		t.Fatalf("Expected only Dune to be Dolby Vision, got: %+v", items)
+	}
+
+	video := items[0].Media[0].Streams(StreamTypeVideo)[0]
+	if video.DOVIProfile != 8 || !video.IsHDR10() {
+		t.Errorf("Expected Dolby Vision profile 8 with an HDR10 base layer, got: %+v", video)
+	}
+
+	items, err = client.Library.FindByVideoAttributes(context.Background(), 1, VideoAttributeQuery{HDR: true, AudioChannelLayout: "5.1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(items) != 1 || items[0].Title != "Arrival" {
+		t.Errorf("Expected only Arrival to be HDR with 5.1 audio, got: %+v", items)
+	}
+
+	items, err = client.Library.FindByVideoAttributes(context.Background(), 1, VideoAttributeQuery{VideoCodec: "h264"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(items) != 1 || items[0].Title != "Heat" {
+		t.Errorf("Expected only Heat to be h264, got: %+v", items)
+	}
+}
@@ -0,0 +1,240 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// ViewingOrder is a named order of the items of a collection, e.g. the chronological order of a
+// franchise, loaded from an order file with LoadViewingOrder
+type ViewingOrder struct {
+	Name  string              `json:"name"`
+	Items []ViewingOrderEntry `json:"items"`
+}
+
+// ViewingOrderEntry places an item, identified by GUID, at a position of a viewing order
+type ViewingOrderEntry struct {
+	GUID     string `json:"guid"`     // e.g. "imdb://tt0120915" or "plex://movie/5d7768..."
+	Position int    `json:"position"` // 1 for the first item
+	Title    string `json:"title,omitempty"`
+}
+
+// ViewingOrderMismatch is a position where a collection's order differs from the viewing order
+// after it was applied
+type ViewingOrderMismatch struct {
+	Position int    // Position in the collection, 1 for the first item
+	Expected string // Rating key of the item that should be at the position
+	Actual   string // Rating key of the item that is, "" if the collection is shorter
+}
+
+// ViewingOrderResult reports how a viewing order was applied to a collection
+type ViewingOrderResult struct {
+	CollectionID int
+	Order        string
+	Moved        []string               // Rating keys of the items that were moved
+	Missing      []ViewingOrderEntry    // Entries matching no item of the collection
+	Unordered    []Metadata             // Items of the collection not in the order, left after the ordered ones
+	Mismatches   []ViewingOrderMismatch // Positions that didn't take the order, found when verifying
+}
+
+// InOrder returns true if the collection ended up in the viewing order
+func (r *ViewingOrderResult) InOrder() bool {
+	return len(r.Mismatches) == 0
+}
+
+// LoadViewingOrder reads a viewing order from a JSON order file, e.g.
+//
+//	{
+//	  "name": "Chronological",
+//	  "items": [
+//	    {"guid": "imdb://tt0120915", "position": 1, "title": "The Phantom Menace"},
+//	    {"guid": "imdb://tt0121765", "position": 2, "title": "Attack of the Clones"}
+//	  ]
+//	}
+//
+// Unknown fields are rejected so that typos are not silently ignored.
+func LoadViewingOrder(r io.Reader) (*ViewingOrder, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	var order ViewingOrder
+	if err := decoder.Decode(&order); err != nil {
+		return nil, fmt.Errorf("error reading viewing order: %w", err)
+	}
+
+	if err := order.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// Validate checks that every entry of the order has a GUID and a unique, positive position
+func (o *ViewingOrder) Validate() error {
+	positions := make(map[int]bool, len(o.Items))
+	for _, entry := range o.Items {
+		if normalizeGUID(entry.GUID) == "" {
+			return fmt.Errorf("viewing order %q has an invalid GUID %q", o.Name, entry.GUID)
+		}
+		if entry.Position <= 0 {
+			return fmt.Errorf("viewing order %q places %s at invalid position %d", o.Name, entry.GUID, entry.Position)
+		}
+		if positions[entry.Position] {
+			return fmt.Errorf("viewing order %q has more than one item at position %d", o.Name, entry.Position)
+		}
+		positions[entry.Position] = true
+	}
+	return nil
+}
+
+// ApplyViewingOrder puts the items of a collection in a viewing order, switching the collection
+// to custom sort and moving each item that is out of place with MoveCollectionItem. It then reads
+// the collection back and reports the positions that don't match. Items are matched on any of
+// their GUIDs; items the order doesn't mention are left after the ordered ones.
+func (s *Collections) ApplyViewingOrder(ctx context.Context, collectionID int, order *ViewingOrder, opts ...operations.Option) (*ViewingOrderResult, error) {
+	if err := order.Validate(); err != nil {
+		return nil, err
+	}
+
+	collection, err := s.GetCollection(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection: %w", err)
+	}
+	if err := checkCollectionUnmodified(collection, opts); err != nil {
+		return nil, err
+	}
+	if !collection.CanMutateMembers() {
+		return nil, &SmartCollectionError{CollectionID: collectionID, Operation: "move"}
+	}
+
+	// The collection changes with every move, so the check above is not repeated
+	opts = withoutIfUnmodifiedSince(opts)
+
+	members, err := s.orderMembers(ctx, collectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ViewingOrderResult{
+		CollectionID: collectionID,
+		Order:        order.Name,
+		Moved:        []string{},
+		Missing:      []ViewingOrderEntry{},
+		Unordered:    []Metadata{},
+		Mismatches:   []ViewingOrderMismatch{},
+	}
+
+	byGUID := map[string]Metadata{}
+	for _, item := range members {
+		for _, guid := range metadataGUIDs(item) {
+			byGUID[guid] = item
+		}
+	}
+
+	entries := append([]ViewingOrderEntry{}, order.Items...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Position < entries[j].Position })
+
+	desired := []string{}
+	ordered := map[string]bool{}
+	for _, entry := range entries {
+		item, ok := byGUID[normalizeGUID(entry.GUID)]
+		if !ok || ordered[item.RatingKey] {
+			result.Missing = append(result.Missing, entry)
+			continue
+		}
+		desired = append(desired, item.RatingKey)
+		ordered[item.RatingKey] = true
+	}
+	for _, item := range members {
+		if !ordered[item.RatingKey] {
+			result.Unordered = append(result.Unordered, item)
+		}
+	}
+
+	if collection.SortEnum().String() != CollectionSortCustom {
+		if _, err := s.UpdateCollectionSort(ctx, collectionID, CollectionSortCustom, opts...); err != nil {
+			return nil, fmt.Errorf("error switching %s to custom sort: %w", collection.Title, err)
+		}
+	}
+
+	current := make([]string, 0, len(members))
+	for _, item := range members {
+		current = append(current, item.RatingKey)
+	}
+
+	moved, err := s.moveIntoOrder(ctx, collectionID, current, desired, "applying viewing order", opts...)
+	result.Moved = append(result.Moved, moved...)
+	if err != nil {
+		return result, err
+	}
+
+	final, err := s.orderMembers(ctx, collectionID, opts...)
+	if err != nil {
+		return result, fmt.Errorf("error verifying order: %w", err)
+	}
+	for i, ratingKey := range desired {
+		actual := ""
+		if i < len(final) {
+			actual = final[i].RatingKey
+		}
+		if actual != ratingKey {
+			result.Mismatches = append(result.Mismatches, ViewingOrderMismatch{Position: i + 1, Expected: ratingKey, Actual: actual})
+		}
+	}
+
+	return result, nil
+}
+
+// moveIntoOrder moves the items of a collection, whose rating keys are current in their present
+// order, so that the desired items come first in the desired order. It returns the rating keys of
+// the moved items, which are also returned with an error.
+func (s *Collections) moveIntoOrder(ctx context.Context, collectionID int, current []string, desired []string, message string, opts ...operations.Option) ([]string, error) {
+	current = append([]string{}, current...)
+	moved := []string{}
+
+	options := processOptions(opts)
+	for i, ratingKey := range desired {
+		if i < len(current) && current[i] == ratingKey {
+			continue
+		}
+
+		after := ""
+		if i > 0 {
+			after = desired[i-1]
+		}
+		if err := s.MoveCollectionItem(ctx, collectionID, ratingKey, after, opts...); err != nil {
+			return moved, fmt.Errorf("error moving item %s: %w", ratingKey, err)
+		}
+		moved = append(moved, ratingKey)
+		reportProgress(options, i+1, len(desired), message)
+
+		// Mirror the move, so later items are compared with the server's new order
+		for j, key := range current {
+			if key == ratingKey {
+				current = append(current[:j], current[j+1:]...)
+				break
+			}
+		}
+		current = append(current[:i], append([]string{ratingKey}, current[i:]...)...)
+	}
+
+	return moved, nil
+}
+
+// orderMembers lists the items of a collection in their current order, with their GUIDs
+func (s *Collections) orderMembers(ctx context.Context, collectionID int, opts ...operations.Option) ([]Metadata, error) {
+	queryParams := url.Values{}
+	queryParams.Add("includeGuids", "1")
+
+	members, err := newLibrary(s.sdkConfiguration).listMetadata(ctx, fmt.Sprintf("/library/collections/%d/children", collectionID), queryParams, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collection items: %w", err)
+	}
+	return members, nil
+}
@@ -0,0 +1,92 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApplyViewingOrder(t *testing.T) {
+	order, err := LoadViewingOrder(strings.NewReader(`{
+		"name": "Chronological",
+		"items": [
+			{"guid": "imdb://tt3", "position": 3},
+			{"guid": "imdb://tt1", "position": 1, "title": "Episode I"},
+			{"guid": "com.plexapp.agents.imdb://tt2?lang=en", "position": 2},
+			{"guid": "imdb://tt9", "position": 4}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Rating keys of the collection, in order; item 10 is not in the viewing order
+	members := []string{"10", "3", "2", "1"}
+	sortSet := ""
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"7","title":"Star Wars","type":"collection","subtype":"movie","librarySectionID":1,"collectionSort":"0"}]}}`))
+		case r.Method == "GET" && r.URL.Path == "/library/collections/7/children":
+			items := []string{}
+			for _, key := range members {
+				items = append(items, fmt.Sprintf(`{"ratingKey":"%s","type":"movie","Guid":[{"id":"imdb://tt%s"}]}`, key, key))
+			}
+			fmt.Fprintf(w, `{"MediaContainer":{"size":%d,"Metadata":[%s]}}`, len(items), strings.Join(items, ","))
+		case r.Method == "PUT" && r.URL.Path == "/library/collections/7/prefs":
+			sortSet = r.URL.Query().Get("collectionSort")
+		case r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/move"):
+			key := strings.Split(r.URL.Path, "/")[5]
+			after := r.URL.Query().Get("after")
+			moved := []string{}
+			for _, member := range members {
+				if member != key {
+					moved = append(moved, member)
+				}
+			}
+			position := 0
+			for i, member := range moved {
+				if member == after {
+					position = i + 1
+				}
+			}
+			members = append(moved[:position], append([]string{key}, moved[position:]...)...)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	result, err := client.Collections.ApplyViewingOrder(context.Background(), 7, order, WithWaitForActivity(time.Second))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if sortSet != "2" {
+		t.Errorf("Expected the collection to be switched to custom sort, got: %q", sortSet)
+	}
+	if fmt.Sprint(members) != "[1 2 3 10]" {
+		t.Errorf("Expected the viewing order followed by the unordered item, got: %v", members)
+	}
+	if !result.InOrder() || len(result.Moved) != 3 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if len(result.Missing) != 1 || result.Missing[0].GUID != "imdb://tt9" {
+		t.Errorf("Expected imdb://tt9 to be missing, got: %+v", result.Missing)
+	}
+	if len(result.Unordered) != 1 || result.Unordered[0].RatingKey != "10" {
+		t.Errorf("Expected item 10 to be unordered, got: %+v", result.Unordered)
+	}
+
+	if _, err := LoadViewingOrder(strings.NewReader(`{"name": "Bad", "items": [{"guid": "imdb://tt1", "position": 1}, {"guid": "imdb://tt2", "position": 1}]}`)); err == nil {
+		t.Error("Expected an error for a duplicate position")
+	}
+}
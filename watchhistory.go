@@ -0,0 +1,313 @@
+package plexgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unfaiyted/plexgo/internal/hooks"
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/operations"
+	"github.com/unfaiyted/plexgo/models/sdkerrors"
+)
+
+// communityServerList contains the plex.tv community API servers
+var communityServerList = []string{
+	"https://community.plex.tv",
+}
+
+// watchHistoryPageSize is the number of entries requested per page of watch history
+const watchHistoryPageSize = 100
+
+// watchHistoryQuery fetches a page of the user's watch history from the community GraphQL API
+const watchHistoryQuery = `query GetWatchHistory($first: PaginationInt!, $after: String) {
+  activityFeed(first: $first, after: $after, types: [WATCH_HISTORY]) {
+    nodes {
+      ... on ActivityWatchHistory {
+        id
+        date
+        metadataItem {
+          id
+          type
+          title
+          year
+          index
+          parent { index }
+          grandparent { title }
+        }
+      }
+    }
+    pageInfo { endCursor hasNextPage }
+  }
+}`
+
+// WatchHistoryFilter selects the plex.tv watch history entries returned by GetWatchHistory
+type WatchHistoryFilter struct {
+	Type  string    // Only return entries of this type, e.g. "movie" or "episode"
+	Since time.Time // Only return entries watched after this time
+	Limit int       // Maximum number of entries to return, 0 for all
+}
+
+// WatchHistoryEntry is a single play recorded in the user's plex.tv watch history
+type WatchHistoryEntry struct {
+	ID               string
+	WatchedAt        time.Time
+	MetadataID       string // plex.tv metadata ID, the last part of the item's plex:// GUID
+	Type             string // e.g. "movie" or "episode"
+	Title            string
+	Year             int
+	Index            int    // Episode number
+	ParentIndex      int    // Season number
+	GrandparentTitle string // Show title
+}
+
+// GUID returns the plex:// GUID of the watched item, which matches the GUID of the item on any server
+func (e WatchHistoryEntry) GUID() string {
+	return fmt.Sprintf("plex://%s/%s", e.Type, e.MetadataID)
+}
+
+// GetWatchHistory gets the user's watch history from plex.tv, newest first. Unlike the history of a
+// single server (Sessions.GetSessionHistory), it includes plays on all of the user's servers.
+func (s *Plex) GetWatchHistory(ctx context.Context, filter WatchHistoryFilter, opts ...operations.Option) ([]WatchHistoryEntry, error) {
+	entries := []WatchHistoryEntry{}
+	after := ""
+
+	for {
+		variables := map[string]interface{}{"first": watchHistoryPageSize}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		var out struct {
+			ActivityFeed struct {
+				Nodes []struct {
+					ID           string `json:"id"`
+					Date         string `json:"date"`
+					MetadataItem *struct {
+						ID     string `json:"id"`
+						Type   string `json:"type"`
+						Title  string `json:"title"`
+						Year   int    `json:"year"`
+						Index  int    `json:"index"`
+						Parent *struct {
+							Index int `json:"index"`
+						} `json:"parent"`
+						Grandparent *struct {
+							Title string `json:"title"`
+						} `json:"grandparent"`
+					} `json:"metadataItem"`
+				} `json:"nodes"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"activityFeed"`
+		}
+		if err := s.communityQuery(ctx, watchHistoryQuery, variables, "getWatchHistory", &out, opts...); err != nil {
+			return nil, err
+		}
+
+		for _, node := range out.ActivityFeed.Nodes {
+			if node.MetadataItem == nil {
+				continue
+			}
+
+			watchedAt, err := time.Parse(time.RFC3339, node.Date)
+			if err != nil {
+				return nil, fmt.Errorf("invalid date %q in watch history entry %s: %w", node.Date, node.ID, err)
+			}
+
+			// The feed is sorted newest first, so every following entry is older too
+			if !filter.Since.IsZero() && !watchedAt.After(filter.Since) {
+				return entries, nil
+			}
+
+			entry := WatchHistoryEntry{
+				ID:         node.ID,
+				WatchedAt:  watchedAt,
+				MetadataID: node.MetadataItem.ID,
+				Type:       strings.ToLower(node.MetadataItem.Type),
+				Title:      node.MetadataItem.Title,
+				Year:       node.MetadataItem.Year,
+				Index:      node.MetadataItem.Index,
+			}
+			if node.MetadataItem.Parent != nil {
+				entry.ParentIndex = node.MetadataItem.Parent.Index
+			}
+			if node.MetadataItem.Grandparent != nil {
+				entry.GrandparentTitle = node.MetadataItem.Grandparent.Title
+			}
+
+			if filter.Type != "" && !strings.EqualFold(entry.Type, filter.Type) {
+				continue
+			}
+
+			entries = append(entries, entry)
+			if filter.Limit > 0 && len(entries) >= filter.Limit {
+				return entries, nil
+			}
+		}
+
+		if !out.ActivityFeed.PageInfo.HasNextPage || out.ActivityFeed.PageInfo.EndCursor == "" {
+			return entries, nil
+		}
+		after = out.ActivityFeed.PageInfo.EndCursor
+	}
+}
+
+// communityQuery runs a GraphQL query against the plex.tv community API and decodes its data into out
+func (s *Plex) communityQuery(ctx context.Context, query string, variables map[string]interface{}, operationID string, out interface{}, opts ...operations.Option) error {
+	options := processOptions(opts)
+
+	baseURL := utils.ReplaceParameters(communityServerList[0], map[string]string{})
+	if options.ServerURL != nil {
+		baseURL = *options.ServerURL
+	}
+
+	opURL, err := url.JoinPath(baseURL, "/api")
+	if err != nil {
+		return fmt.Errorf("error generating URL: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return fmt.Errorf("error serializing query: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		BaseURL:        baseURL,
+		Context:        ctx,
+		OperationID:    operationID,
+		OAuth2Scopes:   []string{},
+		SecuritySource: s.sdkConfiguration.Security,
+	}
+
+	timeout := s.sdkConfiguration.timeoutFor(hookCtx.OperationID, "POST", options.Timeout)
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", opURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+	setLanguage(req, options)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return err
+	}
+
+	req, err = s.sdkConfiguration.Hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return err
+	} else if utils.MatchStatusCodes([]string{"400", "401", "404", "4XX", "5XX"}, httpRes.StatusCode) {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return err
+		}
+		return sdkerrors.NewAPIErrorFromResponse(httpRes)
+	} else {
+		httpRes, err = s.sdkConfiguration.Hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return err
+		}
+	}
+
+	rawBody, err := utils.ConsumeRawBody(httpRes)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := s.sdkConfiguration.decodeJSON(rawBody, &response); err != nil {
+		return err
+	}
+
+	// GraphQL reports query errors in the body of a successful response
+	if len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("community API error: %s", strings.Join(messages, "; "))
+	}
+
+	return json.Unmarshal(response.Data, out)
+}
+
+// SyncWatchState reconciles the watch state of a library section with the user's plex.tv watch
+// history, for users whose plays are spread across multiple servers. Items that are unwatched on
+// this server but appear in the history are matched by GUID and marked as played, unless dryRun is
+// set. The items that were (or with dryRun would be) marked as played are returned.
+func (s *Library) SyncWatchState(ctx context.Context, sectionID int, history []WatchHistoryEntry, dryRun bool, opts ...operations.Option) ([]Metadata, error) {
+	watched := make(map[string]bool, len(history))
+	for _, entry := range history {
+		watched[entry.GUID()] = true
+	}
+
+	items, err := s.getLeafItems(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	unsynced := []Metadata{}
+	for _, item := range items {
+		if item.ViewCount == 0 && watched[item.GUID] {
+			unsynced = append(unsynced, item)
+		}
+	}
+
+	if dryRun {
+		return unsynced, nil
+	}
+
+	media := newMedia(s.sdkConfiguration)
+	options := processOptions(opts)
+
+	reportProgress(options, 0, len(unsynced), "marking played")
+	for i, item := range unsynced {
+		ratingKey, err := strconv.ParseFloat(item.RatingKey, 64)
+		if err != nil {
+			return unsynced[:i], fmt.Errorf("error converting rating key %s: %w", item.RatingKey, err)
+		}
+
+		if _, err := media.MarkPlayed(ctx, ratingKey, opts...); err != nil {
+			return unsynced[:i], fmt.Errorf("error marking %s as played: %w", item.Title, err)
+		}
+		reportProgress(options, i+1, len(unsynced), "marking played")
+	}
+
+	return unsynced, nil
+}
@@ -0,0 +1,127 @@
+package plexgo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestGetWatchHistory(t *testing.T) {
+	requestCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+
+		if r.URL.Path != "/api" || r.Method != "POST" {
+			t.Errorf("Expected POST /api, got: %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Error decoding request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if body.Variables["after"] == nil {
+			w.Write([]byte(`{"data":{"activityFeed":{"nodes":[
+				{"id":"a1","date":"2024-05-03T20:00:00Z","metadataItem":{"id":"e1","type":"EPISODE","title":"Pilot","index":1,"parent":{"index":1},"grandparent":{"title":"Severance"}}},
+				{"id":"a2","date":"2024-05-02T20:00:00Z","metadataItem":{"id":"m1","type":"MOVIE","title":"Heat","year":1995}}
+			],"pageInfo":{"endCursor":"c1","hasNextPage":true}}}}`))
+			return
+		}
+
+		if body.Variables["after"] != "c1" {
+			t.Errorf("Expected after=c1, got: %v", body.Variables["after"])
+		}
+		w.Write([]byte(`{"data":{"activityFeed":{"nodes":[
+			{"id":"a3","date":"2024-04-20T20:00:00Z","metadataItem":{"id":"m2","type":"MOVIE","title":"Ronin","year":1998}},
+			{"id":"a4","date":"2024-03-01T20:00:00Z","metadataItem":{"id":"m3","type":"MOVIE","title":"Collateral","year":2004}}
+		],"pageInfo":{"endCursor":"c2","hasNextPage":true}}}}`))
+	}))
+	defer server.Close()
+
+	client := New()
+
+	history, err := client.Plex.GetWatchHistory(context.Background(), WatchHistoryFilter{
+		Type:  "movie",
+		Since: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	}, operations.WithServerURL(server.URL))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// The second page reaches entries older than Since, so no third page is requested
+	if requestCount != 2 {
+		t.Errorf("Expected 2 requests, got: %d", requestCount)
+	}
+
+	if len(history) != 2 || history[0].Title != "Heat" || history[1].Title != "Ronin" {
+		t.Fatalf("Unexpected history: %+v", history)
+	}
+
+	if history[0].GUID() != "plex://movie/m1" {
+		t.Errorf("Expected GUID plex://movie/m1, got: %s", history[0].GUID())
+	}
+}
+
+func TestGetWatchHistoryError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":null,"errors":[{"message":"unauthorized"}]}`))
+	}))
+	defer server.Close()
+
+	client := New()
+
+	if _, err := client.Plex.GetWatchHistory(context.Background(), WatchHistoryFilter{}, operations.WithServerURL(server.URL)); err == nil {
+		t.Error("Expected an error, got nil")
+	}
+}
+
+func TestSyncWatchState(t *testing.T) {
+	var scrobbled []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/library/sections/1/all":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"ratingKey":"1","guid":"plex://movie/m1","type":"movie","title":"Heat"},
+				{"ratingKey":"2","guid":"plex://movie/m2","type":"movie","title":"Ronin","viewCount":1},
+				{"ratingKey":"3","guid":"plex://movie/m3","type":"movie","title":"Collateral"}
+			]}}`))
+		case r.Method == "GET" && r.URL.Path == "/:/scrobble":
+			scrobbled = append(scrobbled, r.URL.Query().Get("key"))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	history := []WatchHistoryEntry{
+		{MetadataID: "m1", Type: "movie", Title: "Heat"},
+		{MetadataID: "m2", Type: "movie", Title: "Ronin"},
+	}
+
+	items, err := client.Library.SyncWatchState(context.Background(), 1, history, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(items) != 1 || items[0].RatingKey != "1" {
+		t.Errorf("Expected only Heat to be marked as played, got: %+v", items)
+	}
+
+	if len(scrobbled) != 1 || scrobbled[0] != "1" {
+		t.Errorf("Expected a scrobble of item 1, got: %v", scrobbled)
+	}
+}
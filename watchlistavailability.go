@@ -0,0 +1,182 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/unfaiyted/plexgo/internal/utils"
+	"github.com/unfaiyted/plexgo/models/components"
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// discoverServerList contains the plex.tv Discover metadata servers
+var discoverServerList = []string{
+	"https://discover.provider.plex.tv",
+}
+
+// StreamingAvailability is a streaming service or store that offers a title
+type StreamingAvailability struct {
+	Platform  string  `json:"platform"`  // e.g. "netflix"
+	Title     string  `json:"title"`     // e.g. "Netflix"
+	URL       string  `json:"url"`       // Link to the title on the service
+	OfferType string  `json:"offerType"` // e.g. "subscription", "rent" or "buy"
+	Quality   string  `json:"quality,omitempty"`
+	Price     float64 `json:"price,omitempty"`
+	Currency  string  `json:"currency,omitempty"`
+}
+
+// ServerAvailability reports whether one of the user's servers has a title
+type ServerAvailability struct {
+	Name             string
+	ClientIdentifier string
+	Available        bool
+	RatingKey        string // Rating key of the title on the server, if available
+	Error            error  // Set if the server could not be reached
+}
+
+// WatchlistAvailability reports where a title can be played
+type WatchlistAvailability struct {
+	GUID     string
+	Services []StreamingAvailability
+	Servers  []ServerAvailability
+}
+
+// Available returns true if the title can be played on any service or server
+func (a *WatchlistAvailability) Available() bool {
+	if len(a.Services) > 0 {
+		return true
+	}
+	for _, server := range a.Servers {
+		if server.Available {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAvailability reports which streaming services and which of the user's servers can play a
+// title, e.g. a watchlist item, so integrations can request what is missing. The guid is the
+// title's plex:// GUID. Servers are the user's devices as returned by Plex.GetServerResources;
+// devices that are not servers are skipped, and servers that cannot be reached are reported with
+// an Error instead of failing the check. Pass nil to only check streaming services.
+func (s *Watchlist) CheckAvailability(ctx context.Context, guid string, servers []operations.PlexDevice, opts ...operations.Option) (*WatchlistAvailability, error) {
+	metadataID, err := discoverMetadataID(guid)
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		MediaContainer struct {
+			Availability []StreamingAvailability `json:"Availability,omitempty"`
+		} `json:"MediaContainer"`
+	}
+	path := fmt.Sprintf("/library/metadata/%s/availabilities", metadataID)
+	if err := s.discoverLibrary(opts).getJSON(ctx, path, nil, "getAvailabilities", &out, opts...); err != nil {
+		return nil, fmt.Errorf("error getting streaming availability: %w", err)
+	}
+
+	availability := &WatchlistAvailability{
+		GUID:     guid,
+		Services: out.MediaContainer.Availability,
+		Servers:  []ServerAvailability{},
+	}
+	if availability.Services == nil {
+		availability.Services = []StreamingAvailability{}
+	}
+
+	for _, device := range servers {
+		if !strings.Contains(device.Provides, "server") {
+			continue
+		}
+		availability.Servers = append(availability.Servers, s.checkServerAvailability(ctx, guid, device))
+	}
+
+	return availability, nil
+}
+
+// GetMetadata gets the plex.tv Discover metadata of a title, e.g. a watchlist item, by its plex://
+// GUID. Unlike watchlist entries, it includes the title's external GUIDs (TMDB, TVDB and IMDb).
+func (s *Watchlist) GetMetadata(ctx context.Context, guid string, opts ...operations.Option) (*Metadata, error) {
+	metadataID, err := discoverMetadataID(guid)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.discoverLibrary(opts).listMetadata(ctx, "/library/metadata/"+metadataID, nil, "getDiscoverMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("title %s not found", guid)
+	}
+
+	return &items[0], nil
+}
+
+// discoverLibrary returns a Library client for the plex.tv Discover provider, which serves the same
+// metadata endpoints as a server
+func (s *Watchlist) discoverLibrary(opts []operations.Option) *Library {
+	options := processOptions(opts)
+
+	baseURL := utils.ReplaceParameters(discoverServerList[0], map[string]string{})
+	if options.ServerURL != nil {
+		baseURL = *options.ServerURL
+	}
+
+	discoverConfig := s.sdkConfiguration
+	discoverConfig.ServerURL = baseURL
+
+	return newLibrary(discoverConfig)
+}
+
+// discoverMetadataID returns the Discover metadata ID of a plex:// GUID
+func discoverMetadataID(guid string) (string, error) {
+	metadataID := guid[strings.LastIndex(guid, "/")+1:]
+	if !strings.HasPrefix(guid, "plex://") || metadataID == "" {
+		return "", fmt.Errorf("invalid plex GUID %q", guid)
+	}
+	return metadataID, nil
+}
+
+// checkServerAvailability looks a title up by GUID on a server, trying each of its connections in turn
+func (s *Watchlist) checkServerAvailability(ctx context.Context, guid string, device operations.PlexDevice) ServerAvailability {
+	result := ServerAvailability{
+		Name:             device.Name,
+		ClientIdentifier: device.ClientIdentifier,
+	}
+
+	if len(device.Connections) == 0 {
+		result.Error = fmt.Errorf("server %s has no connections", device.Name)
+		return result
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("guid", guid)
+
+	for _, connection := range device.Connections {
+		serverConfig := s.sdkConfiguration
+		serverConfig.ServerURL = connection.URI
+		if device.AccessToken != "" {
+			accessToken := device.AccessToken
+			serverConfig.Security = utils.AsSecuritySource(&components.Security{AccessToken: &accessToken})
+		}
+
+		items, err := newLibrary(serverConfig).listMetadata(ctx, "/library/all", queryParams, "getLibraryItemsByGUID")
+		if err != nil {
+			result.Error = fmt.Errorf("error searching server %s at %s: %w", device.Name, connection.URI, err)
+			continue
+		}
+
+		result.Error = nil
+		if len(items) > 0 {
+			result.Available = true
+			result.RatingKey = items[0].RatingKey
+		}
+		return result
+	}
+
+	return result
+}
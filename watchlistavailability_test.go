@@ -0,0 +1,76 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+func TestCheckAvailability(t *testing.T) {
+	discover := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/metadata/5d7768/availabilities" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":1,"Availability":[
+			{"platform":"netflix","title":"Netflix","url":"https://www.netflix.com/title/1","offerType":"subscription","quality":"HD"}
+		]}}`))
+	}))
+	defer discover.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/library/all" || r.URL.Query().Get("guid") != "plex://movie/5d7768" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		if r.Header.Get("X-Plex-Token") != "server-token" {
+			t.Errorf("Expected the server's access token, got: %s", r.Header.Get("X-Plex-Token"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"42","guid":"plex://movie/5d7768","type":"movie","title":"Heat"}]}}`))
+	}))
+	defer server.Close()
+
+	client := New(WithSecurity("user-token"))
+
+	devices := []operations.PlexDevice{
+		{
+			Name:             "Home",
+			ClientIdentifier: "abc",
+			Provides:         "server",
+			AccessToken:      "server-token",
+			Connections: []operations.Connections{
+				{URI: "http://127.0.0.1:1"},
+				{URI: server.URL},
+			},
+		},
+		{Name: "Phone", Provides: "client,player"},
+	}
+
+	availability, err := client.Watchlist.CheckAvailability(context.Background(), "plex://movie/5d7768", devices, operations.WithServerURL(discover.URL))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(availability.Services) != 1 || availability.Services[0].Platform != "netflix" {
+		t.Errorf("Unexpected services: %+v", availability.Services)
+	}
+
+	// The unreachable first connection falls through to the second and the phone is skipped
+	if len(availability.Servers) != 1 {
+		t.Fatalf("Expected 1 server, got: %+v", availability.Servers)
+	}
+	if home := availability.Servers[0]; !home.Available || home.RatingKey != "42" || home.Error != nil {
+		t.Errorf("Unexpected server availability: %+v", home)
+	}
+
+	if !availability.Available() {
+		t.Error("Expected the title to be available")
+	}
+
+	if _, err := client.Watchlist.CheckAvailability(context.Background(), "tt0113277", nil); err == nil {
+		t.Error("Expected an error for a non-plex GUID, got nil")
+	}
+}
@@ -0,0 +1,124 @@
+package plexgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/unfaiyted/plexgo/models/operations"
+)
+
+// WatchNextEntry is a row of a watch-next feed: a collection and the next item to watch in it
+type WatchNextEntry struct {
+	Collection Collection `json:"collection"`
+	Next       Metadata   `json:"next"`       // A movie, or the next episode of a show of the collection
+	Show       *Metadata  `json:"show"`       // The show Next belongs to, nil for movies
+	InProgress bool       `json:"inProgress"` // Next was partly watched, or its show was started
+	Watched    int        `json:"watched"`    // Items watched so far, counting episodes for shows
+	Total      int        `json:"total"`
+}
+
+// Progress returns the fraction of the collection that has been watched, from 0 to 1
+func (e WatchNextEntry) Progress() float64 {
+	if e.Total == 0 {
+		return 0
+	}
+	return float64(e.Watched) / float64(e.Total)
+}
+
+// GetWatchNextFeed builds a watch-next row for each collection of a library section shown in the
+// library: the next item to watch in the collection's order and how much of it has been watched,
+// by the authenticated user. Collections that are hidden, empty or fully watched are left out;
+// collections with an item in progress come first, then the others in the section's order.
+func (s *Collections) GetWatchNextFeed(ctx context.Context, sectionID int, opts ...operations.Option) ([]WatchNextEntry, error) {
+	collections, err := s.GetAllCollections(ctx, sectionID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting collections: %w", err)
+	}
+
+	library := newLibrary(s.sdkConfiguration)
+	options := processOptions(opts)
+	feed := []WatchNextEntry{}
+
+	reportProgress(options, 0, len(collections), "building watch-next feed")
+	for i, collection := range collections {
+		if collection.CollectionMode == CollectionModeHide {
+			reportProgress(options, i+1, len(collections), "building watch-next feed")
+			continue
+		}
+
+		entry, err := library.watchNext(ctx, collection, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error getting next item of %s: %w", collection.Title, err)
+		}
+		if entry != nil {
+			feed = append(feed, *entry)
+		}
+		reportProgress(options, i+1, len(collections), "building watch-next feed")
+	}
+
+	sort.SliceStable(feed, func(i, j int) bool { return feed[i].InProgress && !feed[j].InProgress })
+
+	return feed, nil
+}
+
+// watchNext returns the watch-next row of a collection, nil if it has nothing left to watch
+func (s *Library) watchNext(ctx context.Context, collection Collection, opts ...operations.Option) (*WatchNextEntry, error) {
+	items, err := s.listMetadata(ctx, fmt.Sprintf("/library/collections/%s/children", collection.RatingKey), nil, "getCollectionChildren", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &WatchNextEntry{Collection: collection}
+	var next *Metadata
+	for i := range items {
+		item := &items[i]
+		watched := item.ViewCount > 0
+		if item.Type == "show" {
+			entry.Watched += item.ViewedLeafCount
+			entry.Total += item.LeafCount
+			watched = item.LeafCount > 0 && item.ViewedLeafCount >= item.LeafCount
+		} else {
+			if watched {
+				entry.Watched++
+			}
+			entry.Total++
+		}
+
+		if next == nil && !watched {
+			next = item
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	if next.Type != "show" {
+		entry.Next = *next
+		entry.InProgress = next.ViewOffset > 0
+		return entry, nil
+	}
+
+	episodes, err := s.listMetadata(ctx, fmt.Sprintf("/library/metadata/%s/allLeaves", next.RatingKey), nil, "getAllLeaves", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	viewedAt := make(map[string]int64, len(episodes))
+	for _, episode := range episodes {
+		if episode.ViewCount > 0 {
+			viewedAt[episode.RatingKey] = episode.LastViewedAt
+		}
+	}
+
+	episode := nextUnwatchedEpisode(episodes, viewedAt)
+	if episode == nil {
+		return nil, nil
+	}
+
+	show := *next
+	entry.Next = *episode
+	entry.Show = &show
+	entry.InProgress = episode.ViewOffset > 0 || show.ViewedLeafCount > 0
+	return entry, nil
+}
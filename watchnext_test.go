@@ -0,0 +1,68 @@
+package plexgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetWatchNextFeed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/library/sections/1/collections":
+			w.Write([]byte(`{"MediaContainer":{"size":4,"Metadata":[
+				{"ratingKey":"10","title":"Marvel","type":"collection","collectionMode":"-1"},
+				{"ratingKey":"11","title":"Star Trek","type":"collection","collectionMode":"-1"},
+				{"ratingKey":"12","title":"Hidden","type":"collection","collectionMode":"0"},
+				{"ratingKey":"13","title":"Finished","type":"collection","collectionMode":"-1"}
+			]}}`))
+		case "/library/collections/10/children":
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"ratingKey":"101","title":"Iron Man","type":"movie","viewCount":1},
+				{"ratingKey":"102","title":"The Incredible Hulk","type":"movie"},
+				{"ratingKey":"103","title":"Iron Man 2","type":"movie"}
+			]}}`))
+		case "/library/collections/11/children":
+			w.Write([]byte(`{"MediaContainer":{"size":2,"Metadata":[
+				{"ratingKey":"201","title":"The Original Series","type":"show","leafCount":2,"viewedLeafCount":2},
+				{"ratingKey":"202","title":"The Next Generation","type":"show","leafCount":3,"viewedLeafCount":1}
+			]}}`))
+		case "/library/metadata/202/allLeaves":
+			w.Write([]byte(`{"MediaContainer":{"size":3,"Metadata":[
+				{"ratingKey":"301","title":"Encounter at Farpoint","type":"episode","viewCount":1,"lastViewedAt":100},
+				{"ratingKey":"302","title":"The Naked Now","type":"episode","viewOffset":60000},
+				{"ratingKey":"303","title":"Code of Honor","type":"episode"}
+			]}}`))
+		case "/library/collections/13/children":
+			w.Write([]byte(`{"MediaContainer":{"size":1,"Metadata":[{"ratingKey":"401","title":"Alien","type":"movie","viewCount":2}]}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(WithServerURL(server.URL))
+
+	feed, err := client.Collections.GetWatchNextFeed(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(feed) != 2 {
+		t.Fatalf("Expected rows for Star Trek and Marvel, got: %+v", feed)
+	}
+
+	trek := feed[0]
+	if trek.Collection.Title != "Star Trek" || trek.Next.RatingKey != "302" || trek.Show == nil || trek.Show.RatingKey != "202" || !trek.InProgress {
+		t.Errorf("Expected the started show first, with its next episode, got: %+v", trek)
+	}
+	if trek.Watched != 3 || trek.Total != 5 || trek.Progress() != 0.6 {
+		t.Errorf("Expected 3 of 5 episodes watched, got: %d of %d", trek.Watched, trek.Total)
+	}
+
+	marvel := feed[1]
+	if marvel.Next.RatingKey != "102" || marvel.Show != nil || marvel.InProgress || marvel.Watched != 1 || marvel.Total != 3 {
+		t.Errorf("Expected The Incredible Hulk next, got: %+v", marvel)
+	}
+}